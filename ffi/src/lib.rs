@@ -2,6 +2,7 @@
 
 use glide_core::ConnectionRequest;
 use glide_core::client::Client as GlideClient;
+use glide_core::client::ConfigUpdate;
 use glide_core::cluster_scan_container::get_cluster_scan_cursor;
 use glide_core::command_request::SimpleRoutes;
 use glide_core::command_request::{Routes, SlotTypes};
@@ -23,7 +24,7 @@ use redis::cluster_routing::Routable;
 use redis::cluster_routing::{
     MultipleNodeRoutingInfo, Route, RoutingInfo, SingleNodeRoutingInfo, SlotAddr,
 };
-use redis::{ClusterScanArgs, RedisError};
+use redis::{ClusterScanArgs, ConnectionEvent, ConnectionEventListener, RedisError};
 use redis::{Cmd, Pipeline, PipelineRetryStrategy, RedisResult, Value};
 use std::ffi::CStr;
 use std::future::Future;
@@ -275,6 +276,90 @@ pub type PubSubCallback = unsafe extern "C-unwind" fn(
     pattern_len: i64,
 ) -> ();
 
+/// The kind of a connection lifecycle event, see [`ConnectionEventCallback`].
+#[repr(C)]
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub enum ConnectionEventKind {
+    /// A connection to a node was established.
+    ConnectionEventConnected = 0,
+    /// The connection to a node was closed by the server or the network.
+    ConnectionEventDisconnected = 1,
+    /// An attempt to reconnect to a node failed.
+    ConnectionEventReconnectAttempt = 2,
+}
+
+/// Callback that is called for the lifecycle events of the connections of a client.
+///
+/// The callback is called from the threads driving the connections, so it must return quickly.
+///
+/// # Parameters
+/// * `context`: The context passed to [`create_client_with_connection_events`], identifying the client to the caller.
+/// * `kind`: The kind of the event.
+/// * `address`: A null-terminated string with the address of the node.
+/// * `attempt`: The number of the failed reconnection attempt, starting from 1, or 0 for the other events.
+/// * `error`: A null-terminated string with the error of the event, or null if it has none.
+///
+/// # Safety
+/// The strings are only valid during the callback execution and will be freed
+/// automatically when the callback returns. Any data needed beyond the callback's
+/// execution must be copied.
+pub type ConnectionEventCallback = unsafe extern "C-unwind" fn(
+    context: usize,
+    kind: ConnectionEventKind,
+    address: *const c_char,
+    attempt: u32,
+    error: *const c_char,
+) -> ();
+
+/// Passes the connection lifecycle events of a client to a [`ConnectionEventCallback`].
+#[derive(Debug)]
+struct FfiConnectionEventListener {
+    callback: ConnectionEventCallback,
+    context: usize,
+}
+
+impl ConnectionEventListener for FfiConnectionEventListener {
+    fn on_connection_event(&self, event: ConnectionEvent) {
+        let (kind, address, attempt, error) = match event {
+            ConnectionEvent::Connected { address } => (
+                ConnectionEventKind::ConnectionEventConnected,
+                address,
+                0,
+                None,
+            ),
+            ConnectionEvent::Disconnected { address, error } => (
+                ConnectionEventKind::ConnectionEventDisconnected,
+                address,
+                0,
+                error,
+            ),
+            ConnectionEvent::ReconnectAttempt {
+                address,
+                attempt,
+                error,
+            } => (
+                ConnectionEventKind::ConnectionEventReconnectAttempt,
+                address,
+                attempt,
+                Some(error),
+            ),
+        };
+        let address = CString::new(address).unwrap_or_default();
+        let error = error.map(|error| CString::new(error).unwrap_or_default());
+        unsafe {
+            (self.callback)(
+                self.context,
+                kind,
+                address.as_ptr(),
+                attempt,
+                error
+                    .as_ref()
+                    .map_or(std::ptr::null(), |error| error.as_ptr()),
+            );
+        }
+    }
+}
+
 /// The connection response.
 ///
 /// It contains either a connection or an error. It is represented as a struct instead of a union for ease of use in the wrapper language.
@@ -758,10 +843,64 @@ unsafe fn process_push_notification(
     }
 }
 
+/// Passes the keys of an invalidation push of the client tracking to the callback, one key per call.
+///
+/// The invalidation of all the keys, pushed when the database is flushed, is passed as a null message.
+///
+/// # Safety
+/// This function is unsafe because it calls an FFI function (`pubsub_callback`) that may have undefined behavior.
+///
+/// The caller must ensure:
+/// - `pubsub_callback` is a valid function pointer to a properly implemented callback, that copies the message before
+///   returning.
+/// - `client_adapter_ptr` is a valid usize representing a client adapter pointer
+unsafe fn process_invalidation_notification(
+    push_msg: redis::PushInfo,
+    pubsub_callback: PubSubCallback,
+    client_adapter_ptr: usize,
+) {
+    let keys = match push_msg.data.first() {
+        Some(Value::Array(keys)) => keys.as_slice(),
+        _ => {
+            unsafe {
+                pubsub_callback(
+                    client_adapter_ptr,
+                    PushKind::PushInvalidate,
+                    std::ptr::null(),
+                    0,
+                    std::ptr::null(),
+                    0,
+                    std::ptr::null(),
+                    0,
+                );
+            }
+            return;
+        }
+    };
+    for key in keys {
+        let Value::BulkString(key) = key else {
+            continue;
+        };
+        unsafe {
+            pubsub_callback(
+                client_adapter_ptr,
+                PushKind::PushInvalidate,
+                key.as_ptr(),
+                key.len() as i64,
+                std::ptr::null(),
+                0,
+                std::ptr::null(),
+                0,
+            );
+        }
+    }
+}
+
 fn create_client_internal(
     connection_request_bytes: &[u8],
     client_type: ClientType,
     pubsub_callback: Option<PubSubCallback>,
+    connection_event_listener: Option<Arc<dyn ConnectionEventListener>>,
 ) -> Result<*const ClientAdapter, String> {
     let request = connection_request::ConnectionRequest::parse_from_bytes(connection_request_bytes)
         .map_err(|err| err.to_string())?;
@@ -779,11 +918,10 @@ fn create_client_internal(
     // Always create push channels to support dynamic pubsub
     let (push_tx, mut push_rx) = tokio::sync::mpsc::unbounded_channel();
 
+    let mut request = ConnectionRequest::from(request);
+    request.connection_event_listener = connection_event_listener;
     let client = runtime
-        .block_on(GlideClient::new(
-            ConnectionRequest::from(request),
-            Some(push_tx),
-        ))
+        .block_on(GlideClient::new(request, Some(push_tx)))
         .map_err(|err| err.to_string())?;
 
     // Create the client adapter that will be returned and used as conn_ptr
@@ -812,6 +950,13 @@ fn create_client_internal(
                 unsafe {
                     process_push_notification(push_msg, callback, client_adapter_ptr);
                 }
+            } else if push_msg.kind == redis::PushKind::Invalidate
+                && let Ok(guard) = callback_store.read()
+                && let Some(callback) = *guard
+            {
+                unsafe {
+                    process_invalidation_notification(push_msg, callback, client_adapter_ptr);
+                }
             }
         }
     });
@@ -844,6 +989,40 @@ pub unsafe extern "C-unwind" fn create_client(
     connection_request_len: usize,
     client_type: *const ClientType,
     pubsub_callback: PubSubCallback,
+) -> *const ConnectionResponse {
+    unsafe {
+        create_client_with_connection_events(
+            connection_request_bytes,
+            connection_request_len,
+            client_type,
+            pubsub_callback,
+            None,
+            0,
+        )
+    }
+}
+
+/// Creates a new `ClientAdapter` like [`create_client`], reporting the lifecycle events of its connections to
+/// `connection_event_callback`.
+///
+/// `connection_event_callback` is an optional callback for the connection lifecycle events. Pass null to create a client
+/// without it.
+/// `connection_event_context` is passed to `connection_event_callback` with each event. Unlike the client pointer, it is
+/// known to the caller before the client is created, so the events of the initial connections can be attributed.
+///
+/// # Safety
+///
+/// * The safety requirements of [`create_client`] apply.
+/// * If `connection_event_callback` is not null, it must be a valid function pointer that lives while the client is
+///   open/active.
+#[unsafe(no_mangle)]
+pub unsafe extern "C-unwind" fn create_client_with_connection_events(
+    connection_request_bytes: *const u8,
+    connection_request_len: usize,
+    client_type: *const ClientType,
+    pubsub_callback: PubSubCallback,
+    connection_event_callback: Option<ConnectionEventCallback>,
+    connection_event_context: usize,
 ) -> *const ConnectionResponse {
     assert!(!connection_request_bytes.is_null());
     let request_bytes =
@@ -856,8 +1035,19 @@ pub unsafe extern "C-unwind" fn create_client(
     } else {
         Some(pubsub_callback)
     };
+    let connection_event_listener = connection_event_callback.map(|callback| {
+        Arc::new(FfiConnectionEventListener {
+            callback,
+            context: connection_event_context,
+        }) as Arc<dyn ConnectionEventListener>
+    });
 
-    let response = match create_client_internal(request_bytes, client_type.clone(), callback_opt) {
+    let response = match create_client_internal(
+        request_bytes,
+        client_type.clone(),
+        callback_opt,
+        connection_event_listener,
+    ) {
         Err(err) => ConnectionResponse {
             conn_ptr: std::ptr::null(),
             connection_error_message: CString::into_raw(
@@ -1007,6 +1197,65 @@ unsafe fn free_command_response_elements(command_response: CommandResponse) {
     }
 }
 
+/// Deep-copies a `CommandResponse`, e.g. so that a response can be converted more than once by the caller.
+///
+/// The copy must be freed with [`free_command_response`], independently of the original.
+///
+/// # Safety
+///
+/// * `command_response_ptr` must be obtained from the `CommandResponse` returned in [`SuccessCallback`] from [`command`],
+///   or from `clone_command_response`, and must not have been freed.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn clone_command_response(
+    command_response_ptr: *const CommandResponse,
+) -> *mut CommandResponse {
+    if command_response_ptr.is_null() {
+        return std::ptr::null_mut();
+    }
+    let command_response = unsafe { clone_command_response_elements(&*command_response_ptr) };
+    Box::into_raw(Box::new(command_response))
+}
+
+/// Deep-copies the nested elements of `CommandResponse`.
+///
+/// # Safety
+///
+/// * The pointers of `command_response` must be valid, as described in [`free_command_response_elements`].
+unsafe fn clone_command_response_elements(command_response: &CommandResponse) -> CommandResponse {
+    let clone_elements = |elements: *mut CommandResponse, len: c_long| {
+        if elements.is_null() {
+            return (std::ptr::null_mut(), 0);
+        }
+        let elements = unsafe { from_raw_parts(elements, len as usize) };
+        convert_vec_to_pointer(
+            elements
+                .iter()
+                .map(|element| unsafe { clone_command_response_elements(element) })
+                .collect(),
+        )
+    };
+    let mut clone = command_response.clone();
+    if !command_response.string_value.is_null() {
+        let string_value = unsafe {
+            from_raw_parts(
+                command_response.string_value,
+                command_response.string_value_len as usize,
+            )
+        };
+        (clone.string_value, clone.string_value_len) =
+            convert_vec_to_pointer(string_value.to_vec());
+    }
+    (clone.array_value, clone.array_value_len) = clone_elements(
+        command_response.array_value,
+        command_response.array_value_len,
+    );
+    (clone.sets_value, clone.sets_value_len) =
+        clone_elements(command_response.sets_value, command_response.sets_value_len);
+    clone.map_key = unsafe { clone_command_response(command_response.map_key) };
+    clone.map_value = unsafe { clone_command_response(command_response.map_value) };
+    clone
+}
+
 /// Converts a double pointer to a vec.
 ///
 /// # Safety
@@ -1366,6 +1615,9 @@ pub unsafe extern "C-unwind" fn command_with_buffer(
     } else {
         Routes::default()
     };
+    if route.read_only {
+        cmd.set_read_only_hint(true);
+    }
 
     // Check inflight request limit
     if !client_adapter.core.client.reserve_inflight_request() {
@@ -1799,6 +2051,107 @@ pub unsafe extern "C-unwind" fn update_connection_password(
     })
 }
 
+/// Re-authenticates all the connections of the client with new credentials, which are then used for future reconnections.
+///
+/// `client_adapter_ptr` is a pointer to a valid `GlideClusterClient` returned in the `ConnectionResponse` from [`create_client`].
+/// `request_id` is a unique identifier for a valid payload buffer which is created in the client.
+/// `username` is a pointer to C string representation of the username. An empty username authenticates as the default user.
+/// `password` is a pointer to C string representation of the password.
+///
+/// # Safety
+///
+/// * `client_adapter_ptr` must be obtained from the `ConnectionResponse` returned from [`create_client`].
+/// * `client_adapter_ptr` must be valid until `close_client` is called.
+/// * `request_id` must be valid until it is passed in a call to [`free_command_response`].
+/// * `username` and `password` must point to valid C strings.
+#[unsafe(no_mangle)]
+pub unsafe extern "C-unwind" fn update_connection_credentials(
+    client_adapter_ptr: *const c_void,
+    request_id: usize,
+    username: *const c_char,
+    password: *const c_char,
+) -> *mut CommandResult {
+    let client_adapter = unsafe {
+        // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
+        Arc::increment_strong_count(client_adapter_ptr);
+        Arc::from_raw(client_adapter_ptr as *mut ClientAdapter)
+    };
+
+    // argument conversion to be used in the async block
+    let username = match unsafe { CStr::from_ptr(username).to_str() } {
+        Ok(username) => username,
+        Err(e) => {
+            return unsafe { client_adapter.handle_redis_error(RedisError::from(e), request_id) };
+        }
+    };
+    let password = match unsafe { CStr::from_ptr(password).to_str() } {
+        Ok(password) => password.to_string(),
+        Err(e) => {
+            return unsafe { client_adapter.handle_redis_error(RedisError::from(e), request_id) };
+        }
+    };
+    let username_option = if username.is_empty() {
+        None
+    } else {
+        Some(username.to_string())
+    };
+    let mut client = client_adapter.core.client.clone();
+    client_adapter.execute_request(request_id, async move {
+        client
+            .update_connection_credentials(username_option, password)
+            .await
+    })
+}
+
+/// Updates the settings of a running client without recreating it, e.g. its request timeout or its read strategy.
+///
+/// `client_adapter_ptr` is a pointer to a valid `GlideClusterClient` returned in the `ConnectionResponse` from [`create_client`].
+/// `request_id` is a unique identifier for a valid payload buffer which is created in the client.
+/// `config_update_bytes` is an array of bytes that will be parsed into a Protobuf `ConfigUpdate` object.
+/// `config_update_len` is the number of bytes in `config_update_bytes`.
+///
+/// # Safety
+///
+/// * `client_adapter_ptr` must be obtained from the `ConnectionResponse` returned from [`create_client`].
+/// * `client_adapter_ptr` must be valid until `close_client` is called.
+/// * `request_id` must be valid until it is passed in a call to [`free_command_response`].
+/// * `config_update_bytes` must point to `config_update_len` consecutive properly initialized bytes.
+#[unsafe(no_mangle)]
+pub unsafe extern "C-unwind" fn update_client_config(
+    client_adapter_ptr: *const c_void,
+    request_id: usize,
+    config_update_bytes: *const u8,
+    config_update_len: usize,
+) -> *mut CommandResult {
+    let client_adapter = unsafe {
+        // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
+        Arc::increment_strong_count(client_adapter_ptr);
+        Arc::from_raw(client_adapter_ptr as *mut ClientAdapter)
+    };
+
+    // argument conversion to be used in the async block
+    let bytes = unsafe { from_raw_parts(config_update_bytes, config_update_len) };
+    let config_update = match connection_request::ConfigUpdate::parse_from_bytes(bytes) {
+        Ok(config_update) => ConfigUpdate::from(config_update),
+        Err(e) => {
+            return unsafe {
+                client_adapter.handle_redis_error(
+                    RedisError::from((
+                        ErrorKind::ClientError,
+                        "Failed to parse the configuration update",
+                        e.to_string(),
+                    )),
+                    request_id,
+                )
+            };
+        }
+    };
+    let mut client = client_adapter.core.client.clone();
+    client_adapter.execute_request(request_id, async move {
+        client.update_config(config_update).await
+    })
+}
+
 /// Manually refresh the IAM authentication token.
 ///
 /// This function triggers an immediate refresh of the IAM token and updates the connection.
@@ -2305,6 +2658,20 @@ fn extract_command_name(request_type: RequestType, context: &str) -> Option<Stri
     Some(command_name)
 }
 
+/// Returns the name of the command of `request_type`, e.g. "GET", or null if the request type has no command.
+/// User-defined commands are named "CustomCommand", as their name is their first argument.
+///
+/// The returned string must be freed with [`free_c_string`].
+#[unsafe(no_mangle)]
+pub extern "C" fn get_command_name(request_type: RequestType) -> *mut c_char {
+    match extract_command_name(request_type, "get_command_name")
+        .and_then(|name| CString::new(name).ok())
+    {
+        Some(name) => name.into_raw(),
+        None => std::ptr::null_mut(),
+    }
+}
+
 /// Creates an OpenTelemetry span with the given name and returns a pointer to the span as u64.
 #[unsafe(no_mangle)]
 pub extern "C" fn create_otel_span(request_type: RequestType) -> u64 {
@@ -3086,6 +3453,28 @@ pub struct Statistics {
     pub subscription_out_of_sync_count: c_ulong,
     /// Timestamp of last successful subscription sync (milliseconds since epoch)
     pub subscription_last_sync_timestamp: c_ulong,
+    /// Number of MOVED redirects received from cluster nodes
+    pub moved_redirect_count: c_ulong,
+    /// Number of ASK redirects received from cluster nodes
+    pub ask_redirect_count: c_ulong,
+    /// Number of times a node circuit breaker opened
+    pub circuit_breaker_opened_count: c_ulong,
+    /// Number of times a node circuit breaker became half-open
+    pub circuit_breaker_half_opened_count: c_ulong,
+    /// Number of times a node circuit breaker closed
+    pub circuit_breaker_closed_count: c_ulong,
+    /// Number of requests rejected by an open circuit breaker
+    pub circuit_breaker_rejected_count: c_ulong,
+    /// Number of cluster slot refreshes completed
+    pub slot_refresh_count: c_ulong,
+    /// Number of cluster slot refreshes that found an unchanged topology and kept the slot map
+    pub slot_refresh_unchanged_count: c_ulong,
+    /// Total duration of the cluster slot refreshes, in milliseconds
+    pub slot_refresh_total_duration_ms: c_ulong,
+    /// Duration of the last cluster slot refresh, in milliseconds
+    pub slot_refresh_last_duration_ms: c_ulong,
+    /// Number of slot map updates applied from MOVED redirects, without a slot refresh
+    pub incremental_slot_update_count: c_ulong,
 }
 
 /// Get compression and connection statistics.
@@ -3109,6 +3498,18 @@ pub extern "C" fn get_statistics() -> Statistics {
         compression_skipped_count: Telemetry::compression_skipped_count() as c_ulong,
         subscription_out_of_sync_count: Telemetry::subscription_out_of_sync_count() as c_ulong,
         subscription_last_sync_timestamp: Telemetry::subscription_last_sync_timestamp() as c_ulong,
+        moved_redirect_count: Telemetry::moved_redirect_count() as c_ulong,
+        ask_redirect_count: Telemetry::ask_redirect_count() as c_ulong,
+        circuit_breaker_opened_count: Telemetry::circuit_breaker_opened_count() as c_ulong,
+        circuit_breaker_half_opened_count: Telemetry::circuit_breaker_half_opened_count()
+            as c_ulong,
+        circuit_breaker_closed_count: Telemetry::circuit_breaker_closed_count() as c_ulong,
+        circuit_breaker_rejected_count: Telemetry::circuit_breaker_rejected_count() as c_ulong,
+        slot_refresh_count: Telemetry::slot_refresh_count() as c_ulong,
+        slot_refresh_unchanged_count: Telemetry::slot_refresh_unchanged_count() as c_ulong,
+        slot_refresh_total_duration_ms: Telemetry::slot_refresh_total_duration_ms() as c_ulong,
+        slot_refresh_last_duration_ms: Telemetry::slot_refresh_last_duration_ms() as c_ulong,
+        incremental_slot_update_count: Telemetry::incremental_slot_update_count() as c_ulong,
     }
 }
 
@@ -5,7 +5,7 @@ use super::{setup_connection, AsyncStream, RedisRuntime};
 use crate::cmd::{cmd, Cmd};
 use crate::connection::{
     resp2_is_pub_sub_state_cleared, resp3_is_pub_sub_state_cleared, ConnectionAddr, ConnectionInfo,
-    Msg, RedisConnectionInfo,
+    Msg, RedisConnectionInfo, TcpSocketOptions,
 };
 #[cfg(feature = "tokio-comp")]
 use crate::parser::ValueCodec;
@@ -420,12 +420,13 @@ pub(crate) async fn connect_simple<T: RedisRuntime>(
     connection_info: &ConnectionInfo,
     _socket_addr: Option<SocketAddr>,
     tcp_nodelay: bool,
+    socket_options: TcpSocketOptions,
 ) -> RedisResult<(T, Option<IpAddr>)> {
     Ok(match connection_info.addr {
         ConnectionAddr::Tcp(ref host, port) => {
             if let Some(socket_addr) = _socket_addr {
                 return Ok::<_, RedisError>((
-                    <T>::connect_tcp(socket_addr, tcp_nodelay).await?,
+                    <T>::connect_tcp(socket_addr, tcp_nodelay, socket_options).await?,
                     Some(socket_addr.ip()),
                 ));
             }
@@ -434,7 +435,7 @@ pub(crate) async fn connect_simple<T: RedisRuntime>(
                 log_conn_creation("TCP", format!("{host}:{port}"), Some(socket_addr.ip()));
                 Box::pin(async move {
                     Ok::<_, RedisError>((
-                        <T>::connect_tcp(socket_addr, tcp_nodelay).await?,
+                        <T>::connect_tcp(socket_addr, tcp_nodelay, socket_options).await?,
                         Some(socket_addr.ip()),
                     ))
                 })
@@ -451,8 +452,15 @@ pub(crate) async fn connect_simple<T: RedisRuntime>(
         } => {
             if let Some(socket_addr) = _socket_addr {
                 return Ok::<_, RedisError>((
-                    <T>::connect_tcp_tls(host, socket_addr, insecure, tls_params, tcp_nodelay)
-                        .await?,
+                    <T>::connect_tcp_tls(
+                        host,
+                        socket_addr,
+                        insecure,
+                        tls_params,
+                        tcp_nodelay,
+                        socket_options,
+                    )
+                    .await?,
                     Some(socket_addr.ip()),
                 ));
             }
@@ -465,8 +473,15 @@ pub(crate) async fn connect_simple<T: RedisRuntime>(
                 );
                 Box::pin(async move {
                     Ok::<_, RedisError>((
-                        <T>::connect_tcp_tls(host, socket_addr, insecure, tls_params, tcp_nodelay)
-                            .await?,
+                        <T>::connect_tcp_tls(
+                            host,
+                            socket_addr,
+                            insecure,
+                            tls_params,
+                            tcp_nodelay,
+                            socket_options,
+                        )
+                        .await?,
                         Some(socket_addr.ip()),
                     ))
                 })
@@ -1,6 +1,6 @@
 //! Adds async IO support to redis.
 use crate::cmd::{cmd, Cmd};
-use crate::connection::{get_resp3_hello_command_error, RedisConnectionInfo};
+use crate::connection::{get_resp3_hello_command_error, RedisConnectionInfo, TcpSocketOptions};
 use crate::pipeline::PipelineRetryStrategy;
 use crate::types::{
     ErrorKind, FromRedisValue, InfoDict, ProtocolVersion, RedisError, RedisFuture, RedisResult,
@@ -26,7 +26,11 @@ pub mod tokio;
 #[async_trait]
 pub(crate) trait RedisRuntime: AsyncStream + Send + Sync + Sized + 'static {
     /// Performs a TCP connection
-    async fn connect_tcp(socket_addr: SocketAddr, tcp_nodelay: bool) -> RedisResult<Self>;
+    async fn connect_tcp(
+        socket_addr: SocketAddr,
+        tcp_nodelay: bool,
+        socket_options: TcpSocketOptions,
+    ) -> RedisResult<Self>;
 
     // Performs a TCP TLS connection
     async fn connect_tcp_tls(
@@ -35,6 +39,7 @@ pub(crate) trait RedisRuntime: AsyncStream + Send + Sync + Sized + 'static {
         insecure: bool,
         tls_params: &Option<TlsConnParams>,
         tcp_nodelay: bool,
+        socket_options: TcpSocketOptions,
     ) -> RedisResult<Self>;
 
     /// Performs a UNIX connection
@@ -238,6 +243,28 @@ where
     Ok(())
 }
 
+/// Enables the broadcasting client tracking of the keys starting with one of `prefixes`, or of all the keys if there is
+/// none. The server pushes the invalidations on the connection itself, which requires RESP3.
+async fn enable_client_tracking<C>(con: &mut C, prefixes: &[String]) -> RedisResult<()>
+where
+    C: ConnectionLike,
+{
+    let mut command = cmd("CLIENT");
+    command.arg("TRACKING").arg("ON").arg("BCAST");
+    for prefix in prefixes {
+        command.arg("PREFIX").arg(prefix);
+    }
+    let result: RedisResult<Value> = command.query_async(con).await;
+    match result {
+        Ok(Value::Okay) => Ok(()),
+        Err(err) => Err(err),
+        _ => fail!((
+            ErrorKind::ResponseError,
+            "Redis server refused to enable client tracking"
+        )),
+    }
+}
+
 mod connection;
 pub use connection::*;
 mod multiplexed_connection;
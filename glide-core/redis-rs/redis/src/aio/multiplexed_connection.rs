@@ -1,6 +1,6 @@
 use super::{ConnectionLike, Runtime};
-use crate::aio::setup_connection;
 use crate::aio::DisconnectNotifier;
+use crate::aio::{enable_client_tracking, setup_connection};
 use crate::client::GlideConnectionOptions;
 use crate::cmd::Cmd;
 #[cfg(feature = "tokio-comp")]
@@ -8,10 +8,13 @@ use crate::parser::ValueCodec;
 use crate::pipeline::PipelineRetryStrategy;
 use crate::push_manager::PushManager;
 use crate::types::{RedisError, RedisFuture, RedisResult, Value};
-use crate::{cmd, ConnectionInfo, ProtocolVersion, PushKind};
+use crate::{
+    cmd, ConnectionEvent, ConnectionEventListener, ConnectionInfo, ProtocolVersion, PushKind,
+};
 use ::tokio::{
     io::{AsyncRead, AsyncWrite},
     sync::{mpsc, oneshot},
+    time::{sleep, Sleep},
 };
 use arc_swap::ArcSwap;
 use futures_util::{
@@ -110,8 +113,18 @@ pin_project! {
         error: Option<RedisError>,
         push_manager: Arc<ArcSwap<PushManager>>,
         disconnect_notifier: Option<Box<dyn DisconnectNotifier>>,
+        // The listener notified of the passive disconnect, with the address of the connection.
+        connection_event_listener: Option<(Arc<dyn ConnectionEventListener>, String)>,
+        // The error of the last response read, reported as the cause of a passive disconnect.
+        read_error: Option<String>,
         is_stream_closed: Arc<AtomicBool>,
         response_sync_lost: bool,
+        // How long the writes are held back after the first unflushed request, so that the requests sent
+        // meanwhile are written to the socket together.
+        write_coalescing_window: Option<Duration>,
+        // When the held back writes are flushed. Set by the first request sent after a flush, and only cleared
+        // once the flush completes, so that the requests sent while it is pending are not held back again.
+        flush_deadline: Option<Pin<Box<Sleep>>>,
     }
 
         impl<T> PinnedDrop for PipelineSink<T> {
@@ -138,7 +151,9 @@ where
         sink_stream: T,
         push_manager: Arc<ArcSwap<PushManager>>,
         disconnect_notifier: Option<Box<dyn DisconnectNotifier>>,
+        connection_event_listener: Option<(Arc<dyn ConnectionEventListener>, String)>,
         is_stream_closed: Arc<AtomicBool>,
+        write_coalescing_window: Option<Duration>,
     ) -> Self
     where
         T: Sink<SinkItem, Error = RedisError> + Stream<Item = RedisResult<Value>> + 'static,
@@ -149,8 +164,12 @@ where
             error: None,
             push_manager,
             disconnect_notifier,
+            connection_event_listener,
+            read_error: None,
             is_stream_closed,
             response_sync_lost: false,
+            write_coalescing_window,
+            flush_deadline: None,
         }
     }
 
@@ -167,10 +186,21 @@ where
                     if let Some(disconnect_notifier) = self.as_mut().project().disconnect_notifier {
                         disconnect_notifier.notify_disconnect();
                     }
+                    let this = self.as_mut().project();
+                    if let Some((listener, address)) = this.connection_event_listener.take() {
+                        listener.on_connection_event(ConnectionEvent::Disconnected {
+                            address,
+                            error: this.read_error.take(),
+                        });
+                    }
                     self.is_stream_closed.store(true, Ordering::Relaxed);
                     return Poll::Ready(Err(()));
                 }
             };
+            if self.connection_event_listener.is_some() {
+                *self.as_mut().project().read_error =
+                    item.as_ref().err().map(|err| err.to_string());
+            }
             self.as_mut().send_result(item);
         }
     }
@@ -420,6 +450,11 @@ where
                 };
 
                 self_.in_flight.push_back(entry);
+                if let Some(window) = *self_.write_coalescing_window {
+                    if self_.flush_deadline.is_none() {
+                        *self_.flush_deadline = Some(Box::pin(sleep(window)));
+                    }
+                }
                 Ok(())
             }
             Err(err) => {
@@ -433,6 +468,12 @@ where
         mut self: Pin<&mut Self>,
         cx: &mut task::Context,
     ) -> Poll<Result<(), Self::Error>> {
+        // While the coalescing window is open, the requests stay buffered and only the responses are read
+        if let Some(deadline) = self.as_mut().project().flush_deadline.as_mut() {
+            if deadline.as_mut().poll(cx).is_pending() {
+                return self.poll_read(cx);
+            }
+        }
         ready!(self
             .as_mut()
             .project()
@@ -441,6 +482,7 @@ where
             .map_err(|err| {
                 self.as_mut().send_result(Err(err));
             }))?;
+        *self.as_mut().project().flush_deadline = None;
         self.poll_read(cx)
     }
 
@@ -467,6 +509,8 @@ where
     fn new<T>(
         sink_stream: T,
         disconnect_notifier: Option<Box<dyn DisconnectNotifier>>,
+        connection_event_listener: Option<(Arc<dyn ConnectionEventListener>, String)>,
+        write_coalescing_window: Option<Duration>,
     ) -> (Self, impl Future<Output = ()>)
     where
         T: Sink<SinkItem, Error = RedisError> + Stream<Item = RedisResult<Value>> + 'static,
@@ -484,7 +528,9 @@ where
             sink_stream,
             push_manager.clone(),
             disconnect_notifier,
+            connection_event_listener,
             is_stream_closed.clone(),
+            write_coalescing_window,
         );
         let f = stream::poll_fn(move |cx| receiver.poll_recv(cx))
             .map(Ok)
@@ -620,11 +666,18 @@ impl MultiplexedConnection {
     where
         C: Unpin + AsyncRead + AsyncWrite + Send + 'static,
     {
-        let codec = ValueCodec::default()
+        let codec = ValueCodec::with_max_response_size(glide_connection_options.max_response_size)
             .framed(stream)
             .and_then(|msg| async move { msg });
-        let (mut pipeline, driver) =
-            Pipeline::new(codec, glide_connection_options.disconnect_notifier);
+        let connection_event_listener = glide_connection_options.connection_event_listener;
+        let (mut pipeline, driver) = Pipeline::new(
+            codec,
+            glide_connection_options.disconnect_notifier,
+            connection_event_listener
+                .clone()
+                .map(|listener| (listener, connection_info.addr.to_string())),
+            glide_connection_options.write_coalescing_window,
+        );
         let driver = Box::pin(driver);
         let pm = PushManager::new(
             glide_connection_options.push_sender,
@@ -644,12 +697,20 @@ impl MultiplexedConnection {
             .build()
             .await?;
 
+        let client_tracking_prefixes = glide_connection_options.client_tracking_prefixes;
         let driver = {
-            let auth = setup_connection(
-                &connection_info.redis,
-                &mut con,
-                glide_connection_options.discover_az,
-            );
+            let auth = async {
+                setup_connection(
+                    &connection_info.redis,
+                    &mut con,
+                    glide_connection_options.discover_az,
+                )
+                .await?;
+                if let Some(prefixes) = &client_tracking_prefixes {
+                    enable_client_tracking(&mut con, prefixes).await?;
+                }
+                Ok::<_, RedisError>(())
+            };
 
             futures_util::pin_mut!(auth);
 
@@ -667,6 +728,11 @@ impl MultiplexedConnection {
             }
         };
 
+        if let Some(listener) = connection_event_listener {
+            listener.on_connection_event(ConnectionEvent::Connected {
+                address: connection_info.addr.to_string(),
+            });
+        }
         Ok((con, driver))
     }
 
@@ -12,10 +12,10 @@ use std::{
 use tokio::net::UnixStream as UnixStreamTokio;
 use tokio::{
     io::{AsyncRead, AsyncWrite, ReadBuf},
-    net::TcpStream as TcpStreamTokio,
+    net::{TcpSocket, TcpStream as TcpStreamTokio},
 };
 
-use crate::connection::create_rustls_config;
+use crate::connection::{create_rustls_config, TcpSocketOptions};
 use std::sync::Arc;
 use tokio_rustls::{client::TlsStream, TlsConnector};
 
@@ -25,17 +25,54 @@ use crate::tls::TlsConnParams;
 use super::Path;
 
 #[inline(always)]
-async fn connect_tcp(addr: &SocketAddr, tcp_nodelay: bool) -> io::Result<TcpStreamTokio> {
-    let socket = TcpStreamTokio::connect(addr).await?;
+async fn connect_tcp(
+    addr: &SocketAddr,
+    tcp_nodelay: bool,
+    socket_options: TcpSocketOptions,
+) -> io::Result<TcpStreamTokio> {
+    let socket =
+        if socket_options.send_buffer_size.is_none() && socket_options.recv_buffer_size.is_none() {
+            TcpStreamTokio::connect(addr).await?
+        } else {
+            // The buffer sizes are set before connecting, so that the receive buffer size is taken into account
+            // for the TCP window scaling negotiated on connection.
+            let socket = if addr.is_ipv4() {
+                TcpSocket::new_v4()?
+            } else {
+                TcpSocket::new_v6()?
+            };
+            if let Some(size) = socket_options.send_buffer_size {
+                socket.set_send_buffer_size(size)?;
+            }
+            if let Some(size) = socket_options.recv_buffer_size {
+                socket.set_recv_buffer_size(size)?;
+            }
+            socket.connect(*addr).await?
+        };
     socket.set_nodelay(tcp_nodelay)?;
     #[cfg(feature = "keep-alive")]
     {
-        //For now rely on system defaults
-        const KEEP_ALIVE: socket2::TcpKeepalive = socket2::TcpKeepalive::new();
+        // Rely on system defaults, unless a keepalive interval is configured
+        let mut keep_alive = socket2::TcpKeepalive::new();
+        if let Some(interval) = socket_options.keepalive_interval {
+            keep_alive = keep_alive.with_time(interval);
+            // The interval between probes isn't supported across all operation systems
+            #[cfg(any(
+                target_os = "android",
+                target_os = "freebsd",
+                target_os = "ios",
+                target_os = "linux",
+                target_os = "macos",
+                target_os = "windows"
+            ))]
+            {
+                keep_alive = keep_alive.with_interval(interval);
+            }
+        }
         //these are useless error that not going to happen
         let std_socket = socket.into_std()?;
         let socket2: socket2::Socket = std_socket.into();
-        socket2.set_tcp_keepalive(&KEEP_ALIVE)?;
+        socket2.set_tcp_keepalive(&keep_alive)?;
         // TCP_USER_TIMEOUT configuration isn't supported across all operation systems
         #[cfg(any(target_os = "android", target_os = "fuchsia", target_os = "linux"))]
         {
@@ -112,8 +149,12 @@ impl AsyncRead for Tokio {
 
 #[async_trait]
 impl RedisRuntime for Tokio {
-    async fn connect_tcp(socket_addr: SocketAddr, tcp_nodelay: bool) -> RedisResult<Self> {
-        Ok(connect_tcp(&socket_addr, tcp_nodelay)
+    async fn connect_tcp(
+        socket_addr: SocketAddr,
+        tcp_nodelay: bool,
+        socket_options: TcpSocketOptions,
+    ) -> RedisResult<Self> {
+        Ok(connect_tcp(&socket_addr, tcp_nodelay, socket_options)
             .await
             .map(Tokio::Tcp)?)
     }
@@ -124,6 +165,7 @@ impl RedisRuntime for Tokio {
         insecure: bool,
         tls_params: &Option<TlsConnParams>,
         tcp_nodelay: bool,
+        socket_options: TcpSocketOptions,
     ) -> RedisResult<Self> {
         let config = create_rustls_config(insecure, tls_params.clone())?;
         let tls_connector = TlsConnector::from(Arc::new(config));
@@ -131,7 +173,7 @@ impl RedisRuntime for Tokio {
         Ok(tls_connector
             .connect(
                 rustls_pki_types::ServerName::try_from(hostname)?.to_owned(),
-                connect_tcp(&socket_addr, tcp_nodelay).await?,
+                connect_tcp(&socket_addr, tcp_nodelay, socket_options).await?,
             )
             .await
             .map(|con| Tokio::TcpTls(Box::new(con)))?)
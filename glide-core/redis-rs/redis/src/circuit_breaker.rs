@@ -0,0 +1,268 @@
+//! Per-node circuit breakers.
+//!
+//! After a number of consecutive connection failures to a node, the circuit of the node opens: requests destined to it
+//! fail immediately, instead of waiting for the node to time out, until a cooldown elapses. A single probe request is
+//! then let through (half-open state). If the probe succeeds the circuit closes, otherwise it opens for another cooldown.
+
+use crate::types::{ErrorKind, RedisError};
+use logger_core::log_warn;
+use std::collections::HashMap;
+use std::sync::Mutex;
+use std::time::{Duration, Instant};
+use telemetrylib::Telemetry;
+
+/// The prefix of the error returned for requests rejected by an open circuit.
+pub const CIRCUIT_OPEN_ERROR: &str = "Circuit breaker is open for node";
+
+/// Configuration of the per-node circuit breakers.
+#[derive(Clone, Copy, Debug, PartialEq, Eq)]
+pub struct CircuitBreakerConfig {
+    /// Number of consecutive failures to a node that opens its circuit.
+    pub failure_threshold: u32,
+    /// How long the circuit stays open before a probe request is let through.
+    pub cooldown: Duration,
+}
+
+#[derive(Clone, Copy, Debug, PartialEq, Eq)]
+enum State {
+    Closed {
+        consecutive_failures: u32,
+    },
+    Open {
+        until: Instant,
+    },
+    /// A probe request was let through at `probe_sent_at`. Another probe is let through if the first one did not
+    /// complete within a cooldown, e.g. because it was cancelled.
+    HalfOpen {
+        probe_sent_at: Instant,
+    },
+}
+
+/// The circuit breakers of all the nodes of a client, by node address.
+#[derive(Debug)]
+pub struct CircuitBreakers {
+    config: CircuitBreakerConfig,
+    states: Mutex<HashMap<String, State>>,
+}
+
+impl CircuitBreakers {
+    /// Creates circuit breakers with the given configuration. All the circuits start closed.
+    pub fn new(config: CircuitBreakerConfig) -> Self {
+        Self {
+            config,
+            states: Mutex::new(HashMap::new()),
+        }
+    }
+
+    /// Checks whether a request may be sent to `address`.
+    /// Returns an error if the circuit of the node is open, or if it is half-open and the probe request is in flight.
+    pub fn check(&self, address: &str) -> Result<(), RedisError> {
+        let mut states = self.states.lock().unwrap();
+        let Some(state) = states.get_mut(address) else {
+            return Ok(());
+        };
+        let now = Instant::now();
+        match *state {
+            State::Closed { .. } => Ok(()),
+            State::Open { until } if now >= until => {
+                // The cooldown elapsed, let this request through as a probe.
+                *state = State::HalfOpen { probe_sent_at: now };
+                log_warn(
+                    "circuit_breaker",
+                    format!("Circuit for node {address} is half-open, sending a probe request"),
+                );
+                Telemetry::incr_circuit_breaker_half_opened_count();
+                Ok(())
+            }
+            State::HalfOpen { probe_sent_at } if now >= probe_sent_at + self.config.cooldown => {
+                *state = State::HalfOpen { probe_sent_at: now };
+                Ok(())
+            }
+            State::Open { .. } | State::HalfOpen { .. } => {
+                Telemetry::incr_circuit_breaker_rejected_count();
+                Err(Self::open_error(address))
+            }
+        }
+    }
+
+    /// Returns whether a request may be sent to `address`, like [`Self::check`], but without changing the state of its
+    /// circuit: an open circuit whose cooldown elapsed is available, but stays open until a request is checked. Used to
+    /// choose among candidate nodes, only the chosen one being checked.
+    pub fn is_available(&self, address: &str) -> bool {
+        let states = self.states.lock().unwrap();
+        let now = Instant::now();
+        match states.get(address) {
+            None | Some(State::Closed { .. }) => true,
+            Some(State::Open { until }) => now >= *until,
+            Some(State::HalfOpen { probe_sent_at }) => now >= *probe_sent_at + self.config.cooldown,
+        }
+    }
+
+    fn record_success(&self, address: &str) {
+        let mut states = self.states.lock().unwrap();
+        if let Some(state) = states.remove(address) {
+            if !matches!(state, State::Closed { .. }) {
+                log_warn(
+                    "circuit_breaker",
+                    format!("Circuit for node {address} is closed"),
+                );
+                Telemetry::incr_circuit_breaker_closed_count();
+            }
+        }
+    }
+
+    /// Records the result of a request sent to `address`. Only connection failures and timeouts count towards opening
+    /// the circuit: errors returned by the node itself show that it is reachable, and close the circuit like successes.
+    pub fn record_result<T>(&self, address: &str, result: &Result<T, RedisError>) {
+        match result {
+            Ok(_) => self.record_success(address),
+            Err(error) if Self::is_node_failure(error) => self.record_failure(address),
+            Err(_) => self.record_success(address),
+        }
+    }
+
+    fn record_failure(&self, address: &str) {
+        let mut states = self.states.lock().unwrap();
+        let state = states.entry(address.to_string()).or_insert(State::Closed {
+            consecutive_failures: 0,
+        });
+        let open = match *state {
+            State::Closed {
+                consecutive_failures,
+            } => {
+                let consecutive_failures = consecutive_failures.saturating_add(1);
+                *state = State::Closed {
+                    consecutive_failures,
+                };
+                consecutive_failures >= self.config.failure_threshold
+            }
+            // The probe request failed.
+            State::HalfOpen { .. } => true,
+            // A request sent before the circuit opened.
+            State::Open { .. } => false,
+        };
+        if open {
+            *state = State::Open {
+                until: Instant::now() + self.config.cooldown,
+            };
+            log_warn(
+                "circuit_breaker",
+                format!(
+                    "Circuit for node {address} is open for {:?}",
+                    self.config.cooldown
+                ),
+            );
+            Telemetry::incr_circuit_breaker_opened_count();
+        }
+    }
+
+    fn is_node_failure(error: &RedisError) -> bool {
+        error.is_unrecoverable_error() || error.is_timeout() || error.is_connection_refusal()
+    }
+
+    fn open_error(address: &str) -> RedisError {
+        RedisError::from((
+            ErrorKind::ClientError,
+            CIRCUIT_OPEN_ERROR,
+            address.to_string(),
+        ))
+    }
+
+    /// Returns whether `error` was returned because the circuit of a node is open.
+    pub fn is_open_error(error: &RedisError) -> bool {
+        error.kind() == ErrorKind::ClientError && error.to_string().contains(CIRCUIT_OPEN_ERROR)
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn breakers(cooldown: Duration) -> CircuitBreakers {
+        CircuitBreakers::new(CircuitBreakerConfig {
+            failure_threshold: 2,
+            cooldown,
+        })
+    }
+
+    fn io_error() -> RedisError {
+        RedisError::from(std::io::Error::from(std::io::ErrorKind::ConnectionReset))
+    }
+
+    #[test]
+    fn test_opens_after_consecutive_failures() {
+        let breakers = breakers(Duration::from_secs(60));
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        assert!(breakers.check("node:6379").is_ok());
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        let error = breakers.check("node:6379").unwrap_err();
+        assert!(CircuitBreakers::is_open_error(&error));
+        assert!(error.to_string().contains("node:6379"));
+        // other nodes are not affected
+        assert!(breakers.check("other:6379").is_ok());
+    }
+
+    #[test]
+    fn test_success_resets_failures() {
+        let breakers = breakers(Duration::from_secs(60));
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        breakers.record_result("node:6379", &Ok(()));
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        assert!(breakers.check("node:6379").is_ok());
+    }
+
+    #[test]
+    fn test_server_errors_do_not_count() {
+        let breakers = breakers(Duration::from_secs(60));
+        for _ in 0..3 {
+            breakers.record_result::<()>(
+                "node:6379",
+                &Err(RedisError::from((ErrorKind::TypeError, "WRONGTYPE"))),
+            );
+        }
+        assert!(breakers.check("node:6379").is_ok());
+    }
+
+    #[test]
+    fn test_half_open_probe() {
+        let cooldown = Duration::from_millis(50);
+        let breakers = breakers(cooldown);
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        assert!(breakers.check("node:6379").is_err());
+
+        // the cooldown elapsed, a single probe is let through
+        std::thread::sleep(cooldown);
+        assert!(breakers.check("node:6379").is_ok());
+        assert!(breakers.check("node:6379").is_err());
+
+        // the probe failed, the circuit opens again
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        assert!(breakers.check("node:6379").is_err());
+
+        // the probe succeeded, the circuit closes
+        std::thread::sleep(cooldown);
+        assert!(breakers.check("node:6379").is_ok());
+        breakers.record_result("node:6379", &Ok(()));
+        assert!(breakers.check("node:6379").is_ok());
+        assert!(breakers.check("node:6379").is_ok());
+    }
+
+    #[test]
+    fn test_is_available_does_not_change_state() {
+        let cooldown = Duration::from_millis(50);
+        let breakers = breakers(cooldown);
+        assert!(breakers.is_available("node:6379"));
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        breakers.record_result::<()>("node:6379", &Err(io_error()));
+        assert!(!breakers.is_available("node:6379"));
+
+        // peeking at the circuit does not consume the probe
+        std::thread::sleep(cooldown);
+        assert!(breakers.is_available("node:6379"));
+        assert!(breakers.is_available("node:6379"));
+        assert!(breakers.check("node:6379").is_ok());
+        assert!(!breakers.is_available("node:6379"));
+        assert!(breakers.check("node:6379").is_err());
+    }
+}
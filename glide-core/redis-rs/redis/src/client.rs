@@ -4,7 +4,9 @@ use std::time::Duration;
 use crate::aio::DisconnectNotifier;
 
 use crate::{
-    connection::{connect, Connection, ConnectionInfo, ConnectionLike, IntoConnectionInfo},
+    connection::{
+        connect, Connection, ConnectionInfo, ConnectionLike, IntoConnectionInfo, TcpSocketOptions,
+    },
     push_manager::PushInfo,
     retry_strategies::RetryStrategy,
     types::{ProtocolVersion, RedisResult, Value},
@@ -18,6 +20,7 @@ use std::pin::Pin;
 use std::sync::Arc;
 use tokio::sync::mpsc;
 
+use crate::connection_events::ConnectionEventListener;
 use crate::pubsub_synchronizer::PubSubSynchronizer;
 use crate::tls::{inner_build_with_tls, TlsCertificates};
 
@@ -101,8 +104,22 @@ pub struct GlideConnectionOptions {
     /// TCP_NODELAY socket option. When true, disables Nagle's algorithm for lower latency.
     /// When false, enables Nagle's algorithm to reduce network overhead.
     pub tcp_nodelay: bool,
+    /// TCP socket options, such as the keepalive interval and the socket buffer sizes.
+    pub tcp_socket_options: TcpSocketOptions,
+    /// The maximum size of a response, in bytes. Larger responses are aborted with a `ResponseTooLarge` error.
+    /// Unlimited if `None`.
+    pub max_response_size: Option<usize>,
+    /// How long the writes of the requests are held back to be written to the socket together, trading latency for
+    /// fewer write syscalls. The requests are written as soon as they are sent if `None`.
+    pub write_coalescing_window: Option<Duration>,
+    /// The key prefixes of the broadcasting client tracking enabled on the connection, so that the server pushes the
+    /// invalidations of the keys starting with them. Tracks all the keys if empty, and disabled if `None`. Not set for
+    /// management connections.
+    pub client_tracking_prefixes: Option<Vec<String>>,
     /// Optional PubSub synchronizer for managing subscription state
     pub pubsub_synchronizer: Option<Arc<dyn PubSubSynchronizer>>,
+    /// Optional listener of the connection lifecycle events. Not set for management connections.
+    pub connection_event_listener: Option<Arc<dyn ConnectionEventListener>>,
 }
 
 /// To enable async support you need to enable the feature: `tokio-comp`
@@ -125,8 +142,12 @@ impl Client {
                 // Note: tcp_nodelay is hardcoded to true (default) since this deprecated API
                 // doesn't accept GlideConnectionOptions. Modern code should use
                 // get_multiplexed_async_connection which allows configuring tcp_nodelay.
-                self.get_simple_async_connection::<crate::aio::tokio::Tokio>(None, true)
-                    .await?
+                self.get_simple_async_connection::<crate::aio::tokio::Tokio>(
+                    None,
+                    true,
+                    TcpSocketOptions::default(),
+                )
+                .await?
             }
         };
 
@@ -438,7 +459,11 @@ impl Client {
         T: crate::aio::RedisRuntime,
     {
         let (con, ip) = self
-            .get_simple_async_connection::<T>(socket_addr, glide_connection_options.tcp_nodelay)
+            .get_simple_async_connection::<T>(
+                socket_addr,
+                glide_connection_options.tcp_nodelay,
+                glide_connection_options.tcp_socket_options,
+            )
             .await?;
         crate::aio::MultiplexedConnection::new_with_response_timeout(
             &self.connection_info,
@@ -454,6 +479,7 @@ impl Client {
         &self,
         socket_addr: Option<SocketAddr>,
         tcp_nodelay: bool,
+        socket_options: TcpSocketOptions,
     ) -> RedisResult<(
         Pin<Box<dyn crate::aio::AsyncStream + Send + Sync>>,
         Option<IpAddr>,
@@ -461,9 +487,13 @@ impl Client {
     where
         T: crate::aio::RedisRuntime,
     {
-        let (conn, ip) =
-            crate::aio::connect_simple::<T>(&self.connection_info, socket_addr, tcp_nodelay)
-                .await?;
+        let (conn, ip) = crate::aio::connect_simple::<T>(
+            &self.connection_info,
+            socket_addr,
+            tcp_nodelay,
+            socket_options,
+        )
+        .await?;
         Ok((conn.boxed(), ip))
     }
 
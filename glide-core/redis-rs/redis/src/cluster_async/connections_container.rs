@@ -344,6 +344,14 @@ where
         }
     }
 
+    /// Replaces the strategy used to route the read-only commands.
+    pub(crate) fn set_read_from_replica_strategy(
+        &mut self,
+        read_from_replica_strategy: ReadFromReplicaStrategy,
+    ) {
+        self.read_from_replica_strategy = read_from_replica_strategy;
+    }
+
     /// Returns an iterator over the nodes in the `slot_map`, yielding tuples of
     /// (node address, (optional IP address, shard addresses)).
     pub(crate) fn slot_map_nodes(
@@ -702,6 +710,43 @@ where
         self.topology_hash
     }
 
+    /// Marks the slot map as no longer matching the topology hash, after it was updated locally, e.g.
+    /// upon a MOVED error, so that the next slot refresh applies the topology it finds to it.
+    pub(crate) fn invalidate_topology_hash(&mut self) {
+        self.topology_hash = 0;
+    }
+
+    /// Updates the slot map of the container to `slot_map`, found by a slot refresh along with `topology_hash`,
+    /// replacing only the changed slot ranges, see [`SlotMap::apply_topology`].
+    ///
+    /// # Returns
+    /// * `usize` - The number of slot ranges that were added or removed.
+    pub(crate) fn apply_topology(
+        &mut self,
+        slot_map: SlotMap,
+        topology_hash: TopologyHash,
+    ) -> usize {
+        self.topology_hash = topology_hash;
+        self.slot_map.apply_topology(slot_map)
+    }
+
+    /// Replaces the connections of the container with `connection_map`, keeping its slot map. Used when a
+    /// slot refresh found the same topology, to avoid rebuilding the slot map of large clusters.
+    pub(crate) fn update_connections(&self, connection_map: ConnectionsMap<Connection>) {
+        let removed_addresses: Vec<String> = self
+            .connection_map
+            .iter()
+            .filter(|item| !connection_map.0.contains_key(item.key()))
+            .map(|item| item.key().clone())
+            .collect();
+        for address in removed_addresses {
+            self.remove_node(&address);
+        }
+        for (address, node) in connection_map.0 {
+            self.replace_or_add_connection_for_address(address, node);
+        }
+    }
+
     /// Returns true if the connections container contains no connections.
     pub(crate) fn is_empty(&self) -> bool {
         self.connection_map.is_empty()
@@ -1587,4 +1632,62 @@ mod tests {
         new_addresses.sort();
         assert_eq!(current_addresses, new_addresses);
     }
+
+    #[test]
+    fn test_update_connections_keeps_slot_map() {
+        let container = create_container();
+
+        // The new connection map replaces a connection, drops a node and adds another one
+        let new_connection_map = DashMap::new();
+        for (address, connection) in [
+            ("primary1", 1),
+            ("primary2", 4),
+            ("primary3", 3),
+            ("replica2-1", 21),
+            ("replica3-1", 31),
+            ("replica3-3", 33),
+        ] {
+            new_connection_map.insert(
+                address.to_string(),
+                create_cluster_node(connection, false, None),
+            );
+        }
+        container.update_connections(ConnectionsMap(new_connection_map));
+
+        let mut addresses: Vec<_> = container
+            .all_node_connections()
+            .map(|conn| conn.0)
+            .collect();
+        addresses.sort();
+        assert_eq!(
+            addresses,
+            vec![
+                "primary1",
+                "primary2",
+                "primary3",
+                "replica2-1",
+                "replica3-1",
+                "replica3-3"
+            ]
+        );
+        // The slots are still routed with the same slot map, to the new connections
+        assert_eq!(
+            4,
+            container
+                .connection_for_route(&Route::new(1002, SlotAddr::Master))
+                .unwrap()
+                .1
+        );
+        assert!(container.is_primary(&"primary3".to_string()));
+    }
+
+    #[test]
+    fn test_invalidate_topology_hash() {
+        let mut container = create_container();
+        container.topology_hash = 42;
+
+        container.invalidate_topology_hash();
+
+        assert_eq!(container.get_current_topology_hash(), 0);
+    }
 }
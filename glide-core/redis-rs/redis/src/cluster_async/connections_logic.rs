@@ -62,7 +62,8 @@ where
 {
     if let Some(node) = node {
         // We won't check whether the DNS address of this node has changed and now points to a new IP.
-        // Instead, we depend on managed Redis services to close the connection for refresh if the node has changed.
+        // Instead, we depend on managed Redis services to close the connection for refresh if the node has changed,
+        // or on the periodic connections checks when DNS re-resolution is enabled.
         match check_node_connections(&node, params, conn_type, addr).await {
             None => Ok(node),
             Some(conn_type) => connect_and_check(
@@ -194,7 +195,12 @@ where
             connection_timeout: Some(params.connection_timeout),
             connection_retry_strategy: None,
             tcp_nodelay: params.tcp_nodelay,
+            tcp_socket_options: params.tcp_socket_options,
+            max_response_size: params.max_response_size,
+            write_coalescing_window: params.write_coalescing_window,
+            client_tracking_prefixes: None,
             pubsub_synchronizer: None,
+            connection_event_listener: None,
         },
     )
     .await
@@ -397,9 +403,11 @@ where
     let info = get_connection_info(node, params)?;
     // management connection does not require notifications or disconnect notifications
     // or pubsub synchronizer (subscriptions only exist on user connections)
+    // or connection events (only the user connections are reported)
     if is_management {
         glide_connection_options.disconnect_notifier = None;
         glide_connection_options.pubsub_synchronizer = None;
+        glide_connection_options.connection_event_listener = None;
     }
     C::connect(
         info,
@@ -33,7 +33,7 @@ pub mod testing {
 use crate::{
     client::GlideConnectionOptions,
     cluster_routing::{Routable, RoutingInfo, ShardUpdateResult},
-    cluster_slotmap::SlotMap,
+    cluster_slotmap::{ReadFromReplicaStrategy, SlotMap},
     cluster_topology::{
         calculate_topology, SlotRefreshState, TopologyHash,
         DEFAULT_NUMBER_OF_REFRESH_SLOTS_RETRIES, DEFAULT_REFRESH_SLOTS_RETRY_BASE_DURATION_MILLIS,
@@ -42,7 +42,7 @@ use crate::{
     cmd,
     commands::cluster_scan::{cluster_scan, ClusterScanArgs, ScanStateRC},
     types::ServerError,
-    FromRedisValue, InfoDict, PipelineRetryStrategy,
+    FromRedisValue, InfoDict, PipelineRetryStrategy, RetryStrategy,
 };
 use connections_container::{RefreshTaskNotifier, RefreshTaskState, RefreshTaskStatus};
 use dashmap::DashMap;
@@ -64,7 +64,7 @@ use std::{
         Arc, Mutex,
     },
     task::{self, Poll},
-    time::SystemTime,
+    time::{Instant, SystemTime},
 };
 use strum_macros::Display;
 #[cfg(feature = "tokio-comp")]
@@ -76,6 +76,7 @@ use telemetrylib::{GlideOpenTelemetry, GlideSpan, Telemetry};
 
 use crate::{
     aio::{get_socket_addrs, ConnectionLike, MultiplexedConnection, Runtime},
+    circuit_breaker::CircuitBreakers,
     cluster::slot_cmd,
     cluster_async::connections_logic::{
         get_host_and_port_from_addr, get_or_create_conn, ConnectionFuture, RefreshConnectionType,
@@ -83,11 +84,13 @@ use crate::{
     cluster_client::{ClusterParams, RetryParams},
     cluster_routing::{
         self, MultipleNodeRoutingInfo, Redirect, ResponsePolicy, Route, SingleNodeRoutingInfo,
+        SlotAddr,
     },
+    dns_resolution::DnsResolver,
     push_manager::PushInfo,
     types::ProtocolVersion,
-    Cmd, ConnectionInfo, ErrorKind, IntoConnectionInfo, RedisError, RedisFuture, RedisResult,
-    Value,
+    Cmd, ConnectionEvent, ConnectionInfo, ErrorKind, IntoConnectionInfo, RedisError, RedisFuture,
+    RedisResult, Value,
 };
 use futures::{
     future::Shared,
@@ -408,6 +411,39 @@ where
             .await
     }
 
+    /// Update the strategy used to route the read-only commands to replicas
+    ///
+    /// The availability zones of the nodes are only discovered when the client was created with an AZ affinity
+    /// strategy, so switching to an AZ affinity strategy is rejected otherwise.
+    ///
+    /// # Arguments
+    ///
+    /// * `read_from_replicas` - The new read strategy
+    ///
+    pub async fn update_read_from_replicas(
+        &mut self,
+        read_from_replicas: ReadFromReplicaStrategy,
+    ) -> RedisResult<Value> {
+        self.route_operation_request(Operation::UpdateReadFromReplicas(read_from_replicas))
+            .await
+    }
+
+    /// Update the backoff of the reconnection attempts to the cluster nodes
+    ///
+    /// Reconnections already in progress keep their current backoff.
+    ///
+    /// # Arguments
+    ///
+    /// * `retry_strategy` - The new reconnection backoff
+    ///
+    pub async fn update_reconnect_retry_strategy(
+        &mut self,
+        retry_strategy: RetryStrategy,
+    ) -> RedisResult<Value> {
+        self.route_operation_request(Operation::UpdateReconnectRetryStrategy(retry_strategy))
+            .await
+    }
+
     /// Get the username used to authenticate with all cluster servers
     pub async fn get_username(&mut self) -> RedisResult<Value> {
         self.route_operation_request(Operation::GetUsername).await
@@ -492,6 +528,10 @@ pub(crate) struct InnerCore<C> {
     /// This prevents validation from removing connections that were just created
     /// during topology discovery but haven't been assigned slots yet.
     pub(crate) topology_refresh_lock: tokio::sync::Mutex<()>,
+    /// Per-node circuit breakers, if enabled.
+    circuit_breakers: Option<CircuitBreakers>,
+    /// Re-resolves the hostnames of the connected nodes, if enabled.
+    dns_resolver: Option<DnsResolver>,
 }
 
 pub(crate) type Core<C> = Arc<InnerCore<C>>;
@@ -742,6 +782,8 @@ enum Operation {
     UpdateConnectionClientName(Option<String>),
     UpdateConnectionUsername(Option<String>),
     UpdateConnectionProtocol(ProtocolVersion),
+    UpdateReadFromReplicas(ReadFromReplicaStrategy),
+    UpdateReconnectRetryStrategy(RetryStrategy),
     GetUsername,
 }
 
@@ -924,6 +966,8 @@ pin_project! {
 
 struct PendingRequest<C> {
     retry: u32,
+    /// The MOVED or ASK redirects received for the request, as `<kind> <slot> <node>`.
+    redirects: Vec<String>,
     sender: oneshot::Sender<RedisResult<Response>>,
     info: RequestInfo<C>,
 }
@@ -964,6 +1008,15 @@ enum Next<C> {
     Done,
 }
 
+/// Returns the error of a request still redirected once it followed the maximum number of redirects.
+fn too_many_redirects_error(redirects: &[String]) -> RedisError {
+    RedisError::from((
+        ErrorKind::TooManyRedirects,
+        "Request exceeded the maximum number of redirects",
+        redirects.join(", "),
+    ))
+}
+
 impl<C> Future for Request<C> {
     type Output = Next<C>;
 
@@ -1008,8 +1061,25 @@ impl<C> Future for Request<C> {
             }
             Err((target, err)) => {
                 let request = this.request.as_mut().unwrap();
+                // The redirects are limited apart from the other retries, and are all reported once exhausted.
+                let redirected = if let Some((node, slot)) = err.redirect_node() {
+                    let kind = if err.kind() == ErrorKind::Ask {
+                        "ASK"
+                    } else {
+                        "MOVED"
+                    };
+                    request.redirects.push(format!("{kind} {slot} {node}"));
+                    true
+                } else {
+                    false
+                };
+                let exhausted = if redirected {
+                    request.redirects.len() > this.retry_params.max_redirects as usize
+                } else {
+                    request.retry >= this.retry_params.number_of_retries
+                };
                 // TODO - would be nice if we didn't need to repeat this code twice, with & without retries.
-                if request.retry >= this.retry_params.number_of_retries {
+                if exhausted {
                     let retry_method = err.retry_method();
                     let next = if err.kind() == ErrorKind::AllConnectionsUnavailable {
                         Next::ReconnectToInitialNodes { request: None }.into()
@@ -1039,10 +1109,17 @@ impl<C> Future for Request<C> {
                     } else {
                         Next::Done.into()
                     };
+                    let err = if redirected {
+                        too_many_redirects_error(&request.redirects)
+                    } else {
+                        err
+                    };
                     self.respond(Err(err));
                     return next;
                 }
-                request.retry = request.retry.saturating_add(1);
+                if !redirected {
+                    request.retry = request.retry.saturating_add(1);
+                }
                 // Record retry attempts metric if telemetry is initialized
                 if let Err(e) = GlideOpenTelemetry::record_retry_attempt() {
                     log_error(
@@ -1091,6 +1168,7 @@ impl<C> Future for Request<C> {
 
                 match err.retry_method() {
                     RetryMethod::AskRedirect => {
+                        Telemetry::incr_ask_redirect_count();
                         let mut request = this.request.take().unwrap();
                         request.info.set_redirect(
                             err.redirect_node()
@@ -1099,6 +1177,7 @@ impl<C> Future for Request<C> {
                         Next::Retry { request }.into()
                     }
                     RetryMethod::MovedRedirect => {
+                        Telemetry::incr_moved_redirect_count();
                         let mut request = this.request.take().unwrap();
                         let redirect_node = err.redirect_node();
                         request.info.set_redirect(
@@ -1217,7 +1296,12 @@ where
             connection_timeout: Some(cluster_params.connection_timeout),
             connection_retry_strategy: Some(connection_retry_strategy),
             tcp_nodelay: cluster_params.tcp_nodelay,
+            tcp_socket_options: cluster_params.tcp_socket_options,
+            max_response_size: cluster_params.max_response_size,
+            write_coalescing_window: cluster_params.write_coalescing_window,
+            client_tracking_prefixes: cluster_params.client_tracking_prefixes.clone(),
             pubsub_synchronizer,
+            connection_event_listener: cluster_params.connection_event_listener.clone(),
         };
 
         let connections = Self::create_initial_connections(
@@ -1242,6 +1326,8 @@ where
             initial_nodes: initial_nodes.to_vec(),
             glide_connection_options,
             topology_refresh_lock: tokio::sync::Mutex::new(()),
+            circuit_breakers: cluster_params.circuit_breaker.map(CircuitBreakers::new),
+            dns_resolver: cluster_params.dns_resolution.map(DnsResolver::new),
         });
         let mut connection = ClusterConnInner {
             inner,
@@ -1330,78 +1416,92 @@ where
         params: &ClusterParams,
         glide_connection_options: GlideConnectionOptions,
     ) -> RedisResult<ConnectionMap<C>> {
-        let initial_nodes: Vec<(String, Option<SocketAddr>)> =
-            Self::try_to_expand_initial_nodes(initial_nodes).await;
-        let connections =
-            stream::iter(initial_nodes.iter().cloned())
-                .map(|(node_addr, socket_addr)| {
-                    let params: ClusterParams = params.clone();
-                    let glide_connection_options = glide_connection_options.clone();
-                    // set subscriptions to none, they will be applied upon the topology discovery
-
-                    async move {
-                        let result = connect_and_check::<C>(
-                            &node_addr,
-                            params,
-                            socket_addr,
-                            RefreshConnectionType::AllConnections,
-                            None,
-                            glide_connection_options,
-                        )
-                        .await
-                        .get_node();
-                        // The PushManager is initialized with connection_info.addr
-                        // (the original hostname, e.g. "localhost:6379"), but the
-                        // ConnectionsMap key uses the resolved IP from socket_addr
-                        // (e.g. "127.0.0.1:6379"). When these differ, align them so
-                        // PubSub synchronization can match subscriptions to nodes.
-                        let (node_address, push_manager_needs_update) =
-                            if let Some(socket_addr) = socket_addr {
-                                let resolved = socket_addr.to_string();
-                                let differs = resolved != node_addr;
-                                (resolved, differs)
-                            } else {
-                                (node_addr, false)
-                            };
-                        if push_manager_needs_update {
-                            if let Ok(ref node) = result {
-                                node.user_connection
-                                    .conn
-                                    .clone()
-                                    .await
-                                    .update_push_manager_node_address(node_address.clone());
-                            }
-                        }
-                        result.map(|node| (node_address, node))
-                    }
-                })
-                .buffer_unordered(initial_nodes.len())
-                .fold(
-                    (
-                        ConnectionsMap(DashMap::with_capacity(initial_nodes.len())),
+        let initial_nodes: Vec<(String, Option<SocketAddr>)> = if params
+            .dns_resolution
+            .is_some_and(|config| config.prefer_hostname)
+        {
+            // Keep the hostnames, so that reconnecting to these nodes resolves them again instead of dialing their
+            // initial IPs.
+            initial_nodes
+                .iter()
+                .map(|info| (info.addr.to_string(), None))
+                .collect()
+        } else {
+            Self::try_to_expand_initial_nodes(initial_nodes).await
+        };
+        let mut results = stream::iter(initial_nodes.iter().cloned())
+            .map(|(node_addr, socket_addr)| {
+                let params: ClusterParams = params.clone();
+                let glide_connection_options = glide_connection_options.clone();
+                // set subscriptions to none, they will be applied upon the topology discovery
+
+                async move {
+                    let result = connect_and_check::<C>(
+                        &node_addr,
+                        params,
+                        socket_addr,
+                        RefreshConnectionType::AllConnections,
                         None,
-                    ),
-                    |connections: (ConnectionMap<C>, Option<String>),
-                     addr_conn_res: RedisResult<_>| async move {
-                        match addr_conn_res {
-                            Ok((addr, node)) => {
-                                connections.0 .0.insert(addr, node);
-                                (connections.0, None)
-                            }
-                            Err(e) => (connections.0, Some(e.to_string())),
+                        glide_connection_options,
+                    )
+                    .await
+                    .get_node();
+                    // The PushManager is initialized with connection_info.addr
+                    // (the original hostname, e.g. "localhost:6379"), but the
+                    // ConnectionsMap key uses the resolved IP from socket_addr
+                    // (e.g. "127.0.0.1:6379"). When these differ, align them so
+                    // PubSub synchronization can match subscriptions to nodes.
+                    let (node_address, push_manager_needs_update) =
+                        if let Some(socket_addr) = socket_addr {
+                            let resolved = socket_addr.to_string();
+                            let differs = resolved != node_addr;
+                            (resolved, differs)
+                        } else {
+                            (node_addr, false)
+                        };
+                    if push_manager_needs_update {
+                        if let Ok(ref node) = result {
+                            node.user_connection
+                                .conn
+                                .clone()
+                                .await
+                                .update_push_manager_node_address(node_address.clone());
                         }
-                    },
-                )
-                .await;
-        if connections.0 .0.is_empty() {
+                    }
+                    result.map(|node| (node_address, node))
+                }
+            })
+            .buffer_unordered(initial_nodes.len());
+        let connections: ConnectionMap<C> =
+            ConnectionsMap(DashMap::with_capacity(initial_nodes.len()));
+        let mut last_error = None;
+        while let Some(addr_conn_res) = results.next().await {
+            match addr_conn_res {
+                Ok((addr, node)) => {
+                    connections.0.insert(addr, node);
+                }
+                Err(e) => last_error = Some(e.to_string()),
+            }
+            if params.race_initial_nodes && !connections.0.is_empty() {
+                // Keep the connections that are already established and drop the pending ones: the topology is
+                // discovered from the connected nodes, and the other nodes are connected once found in it.
+                while let Some(Some(addr_conn_res)) = results.next().now_or_never() {
+                    if let Ok((addr, node)) = addr_conn_res {
+                        connections.0.insert(addr, node);
+                    }
+                }
+                break;
+            }
+        }
+        if connections.0.is_empty() {
             return Err(RedisError::from((
                 ErrorKind::IoError,
                 "Failed to create initial connections",
-                connections.1.unwrap_or("".to_string()),
+                last_error.unwrap_or("".to_string()),
             )));
         }
-        info!("Connected to initial nodes:\n{}", connections.0);
-        Ok(connections.0)
+        info!("Connected to initial nodes:\n{}", connections);
+        Ok(connections)
     }
 
     // Reconnect to the initial nodes provided by the user in the creation of the client,
@@ -1495,6 +1595,9 @@ where
             if con.is_closed() {
                 // transport is closed, need to refresh
                 addrs_to_refresh.insert(addr.clone());
+            } else if Self::is_node_address_stale(&inner, addr).await {
+                // the hostname of the node now resolves to another IP, need to reconnect to it
+                addrs_to_refresh.insert(addr.clone());
             }
         }
 
@@ -1595,9 +1698,10 @@ where
                 );
 
                 // We run infinite retries to reconnect until it succeeds or it's aborted from outside.
+                // The backoff is read from the cluster params, as it can be updated while the client is running.
                 let infinite_backoff_iter = inner_clone
-                    .glide_connection_options
-                    .connection_retry_strategy
+                    .get_cluster_param(|params| params.reconnect_retry_strategy)
+                    .expect(MUTEX_READ_ERR)
                     .unwrap_or_default()
                     .get_infinite_backoff_dur_iterator();
 
@@ -1606,7 +1710,9 @@ where
                     "No attempts performed",
                 )));
                 let mut first_attempt = true;
+                let mut attempt: u32 = 0;
                 for backoff_duration in infinite_backoff_iter {
+                    attempt = attempt.saturating_add(1);
                     let cluster_params = inner_clone
                         .cluster_params
                         .read()
@@ -1645,6 +1751,16 @@ where
                                 "Failed to refresh connection for node {}. Error: `{:?}`. Retrying in {:?}",
                                 address_clone_for_task, err, backoff_duration
                             );
+                            if let Some(listener) = &inner_clone
+                                .glide_connection_options
+                                .connection_event_listener
+                            {
+                                listener.on_connection_event(ConnectionEvent::ReconnectAttempt {
+                                    address: address_clone_for_task.clone(),
+                                    attempt,
+                                    error: err.to_string(),
+                                });
+                            }
                             tokio::time::sleep(backoff_duration).await;
                         }
                     }
@@ -2208,6 +2324,24 @@ where
         }
     }
 
+    /// Returns true if DNS re-resolution is enabled, and the hostname of `addr` no longer resolves to the IP of the
+    /// connection to the node.
+    async fn is_node_address_stale(inner: &Arc<InnerCore<C>>, addr: &str) -> bool {
+        let Some(dns_resolver) = &inner.dns_resolver else {
+            return false;
+        };
+        let Some((host, port)) = get_host_and_port_from_addr(addr) else {
+            return false;
+        };
+        let connected_ip = inner
+            .conn_lock
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .connection_details_for_address(addr)
+            .and_then(|(_, details)| details.ip);
+        dns_resolver.is_stale(host, port, connected_ip).await
+    }
+
     async fn connections_validation_task(inner: Arc<InnerCore<C>>, interval_duration: Duration) {
         loop {
             if let Some(disconnect_notifier) =
@@ -2289,6 +2423,7 @@ where
         curr_retry: usize,
         trigger: SlotRefreshTrigger,
     ) -> RedisResult<()> {
+        let started = Instant::now();
         let num_of_nodes = inner.conn_lock.read().expect(MUTEX_READ_ERR).len();
         const MAX_REQUESTED_NODES: usize = 10;
         let num_of_nodes_to_query = num_of_nodes.min(MAX_REQUESTED_NODES);
@@ -2393,20 +2528,31 @@ where
         }
 
         info!("refresh_slots found nodes:\n{new_connections}");
-        // Reset the current slot map and connection vector with the new ones
         let mut write_guard = inner.conn_lock.write().expect(MUTEX_WRITE_ERR);
-        // Clear the refresh tasks of the prev instance
-        // TODO - Maybe we can take the running refresh tasks and use them instead of running new connection creation
-        write_guard.refresh_conn_state.clear_refresh_state();
-        let read_from_replicas = inner
-            .get_cluster_param(|params| params.read_from_replicas.clone())
-            .expect(MUTEX_READ_ERR);
-        *write_guard = ConnectionsContainer::new(
-            new_slots,
-            new_connections,
-            read_from_replicas,
-            topology_hash,
-        );
+        // The topology hash of the container is reset when its slot map is updated upon a MOVED error,
+        // so an equal hash means that its slot map is still the one found.
+        let unchanged =
+            topology_hash != 0 && write_guard.get_current_topology_hash() == topology_hash;
+        if unchanged {
+            // Keep the slot map, along with the refresh tasks and the replica rotation of its slots,
+            // and only update the connections, which may have been reconnected.
+            debug!("refresh_slots: topology unchanged, keeping the slot map");
+            write_guard.update_connections(new_connections);
+        } else {
+            // Apply the changed slot ranges to the current slot map, keeping the unchanged shards and ranges
+            // along with their replica rotation, and update the connections.
+            // Clear the refresh tasks of the prev topology
+            // TODO - Maybe we can take the running refresh tasks and use them instead of running new connection creation
+            write_guard.refresh_conn_state.clear_refresh_state();
+            let read_from_replicas = inner
+                .get_cluster_param(|params| params.read_from_replicas.clone())
+                .expect(MUTEX_READ_ERR);
+            write_guard.set_read_from_replica_strategy(read_from_replicas);
+            let changed_ranges = write_guard.apply_topology(new_slots, topology_hash);
+            debug!("refresh_slots: topology changed, replaced {changed_ranges} slot ranges");
+            write_guard.update_connections(new_connections);
+        }
+        Telemetry::record_slot_refresh(started.elapsed().as_millis() as u64, unchanged);
 
         // Notify the PubSub synchronizer about the new topology (using same lock)
         // Since handle_topology_refresh is sync, no other task can benefit from us
@@ -2454,8 +2600,13 @@ where
         if let Some(curr_shard_addrs) = curr_shard_addrs {
             match curr_shard_addrs.attempt_shard_role_update(new_primary.clone()) {
                 // Scenario 1: No changes needed as the new primary is already the current slot owner.
+                ShardUpdateResult::AlreadyPrimary => return Ok(()),
                 // Scenario 2: Failover occurred and the new primary was promoted from a replica.
-                ShardUpdateResult::AlreadyPrimary | ShardUpdateResult::Promoted => return Ok(()),
+                ShardUpdateResult::Promoted => {
+                    let mut wlock_conn_container = inner.conn_lock.write().expect(MUTEX_WRITE_ERR);
+                    Self::slot_map_updated_upon_moved(&mut wlock_conn_container);
+                    return Ok(());
+                }
                 // The node was not found in this shard, proceed with further scenarios.
                 ShardUpdateResult::NodeNotFound => {}
             }
@@ -2464,36 +2615,43 @@ where
         // Scenario 3 & 4: Check if the new primary exists in other shards
 
         let mut wlock_conn_container = inner.conn_lock.write().expect(MUTEX_READ_ERR);
-        let mut nodes_iter = wlock_conn_container.slot_map_nodes();
-        for (node_addr, (ip_addr, shard_addrs_arc)) in &mut nodes_iter {
-            if node_addr == new_primary {
+        let found = wlock_conn_container
+            .slot_map_nodes()
+            .find(|(node_addr, _)| *node_addr == new_primary);
+        let result = match found {
+            Some((_, (ip_addr, shard_addrs_arc))) => {
                 let is_existing_primary = shard_addrs_arc.primary().eq(&new_primary);
                 if is_existing_primary {
                     // Scenario 3: Slot Migration - The new primary is an existing primary in another shard
                     // Update the associated addresses for `slot` to `shard_addrs`.
-                    drop(nodes_iter);
-                    return wlock_conn_container
+                    wlock_conn_container
                         .slot_map
-                        .update_slot_range(slot, shard_addrs_arc.clone());
+                        .update_slot_range(slot, shard_addrs_arc)
                 } else {
                     // Scenario 4: The MOVED error redirects to `new_primary` which is known as a replica in a shard that doesn’t own `slot`.
                     // Remove the replica from its existing shard and treat it as a new node in a new shard.
                     shard_addrs_arc.remove_replica(new_primary.clone())?;
-                    drop(nodes_iter);
-                    return wlock_conn_container.slot_map.add_new_primary(
-                        slot,
-                        new_primary,
-                        ip_addr,
-                    );
+                    wlock_conn_container
+                        .slot_map
+                        .add_new_primary(slot, new_primary, ip_addr)
                 }
             }
+            // Scenario 5: New Node - The new primary is not present in the current slots map, add it as a primary of a new shard.
+            None => wlock_conn_container
+                .slot_map
+                .add_new_primary(slot, new_primary, None),
+        };
+        if result.is_ok() {
+            Self::slot_map_updated_upon_moved(&mut wlock_conn_container);
         }
+        result
+    }
 
-        drop(nodes_iter);
-        // Scenario 5: New Node - The new primary is not present in the current slots map, add it as a primary of a new shard.
-        wlock_conn_container
-            .slot_map
-            .add_new_primary(slot, new_primary, None)
+    /// Records an update of the slot map upon a MOVED error. The topology hash of the container no
+    /// longer describes its slot map, so it is invalidated for the next slot refresh to rebuild it.
+    fn slot_map_updated_upon_moved(connections_container: &mut ConnectionsContainer<C>) {
+        connections_container.invalidate_topology_hash();
+        Telemetry::incr_incremental_slot_update_count();
     }
 
     async fn execute_on_multiple_nodes<'a>(
@@ -2526,6 +2684,7 @@ where
                             (Some(address.clone()), receiver),
                             Some(PendingRequest {
                                 retry: 0,
+                                redirects: Vec::new(),
                                 sender,
                                 info: RequestInfo {
                                     cmd: CmdArg::Cmd {
@@ -2625,15 +2784,54 @@ where
 
         // if we reached this point, we're sending the command only to single node, and we need to find the
         // right connection to the node.
-        let (address, mut conn) = Self::get_connection(routing, core, Some(cmd.clone()))
-            .await
-            .map_err(|err| (OperationTarget::NotFound, err))?;
+        let requested_route = match &routing {
+            InternalSingleNodeRouting::SpecificNode(route) => Some(*route),
+            _ => None,
+        };
+        let (mut address, mut conn) =
+            Self::get_connection(routing, core.clone(), Some(cmd.clone()))
+                .await
+                .map_err(|err| (OperationTarget::NotFound, err))?;
+        if let Some(circuit_breakers) = &core.circuit_breakers {
+            if let Err(err) = circuit_breakers.check(&address) {
+                // Reads that may be served by a replica are rerouted to one, if its own circuit is not open.
+                // The reads that may be served by any node are only rerouted if the client reads from replicas.
+                let replica_route = requested_route.filter(|route| match route.slot_addr() {
+                    SlotAddr::Master => false,
+                    SlotAddr::ReplicaOptional => core
+                        .get_cluster_param(|params| {
+                            params.read_from_replicas != ReadFromReplicaStrategy::AlwaysFromPrimary
+                        })
+                        .unwrap_or(false),
+                    SlotAddr::ReplicaRequired => true,
+                });
+                let Some(replica_route) = replica_route else {
+                    return Err((address.into(), err));
+                };
+                let replica_route = Route::new(replica_route.slot(), SlotAddr::ReplicaRequired);
+                let (replica_address, replica_conn) = Self::get_connection(
+                    InternalSingleNodeRouting::SpecificNode(replica_route),
+                    core.clone(),
+                    Some(cmd.clone()),
+                )
+                .await
+                .map_err(|_| (address.clone().into(), err))?;
+                circuit_breakers
+                    .check(&replica_address)
+                    .map_err(|err| (replica_address.clone().into(), err))?;
+                address = replica_address;
+                conn = replica_conn;
+            }
+        }
         // Update OTel span with actual routed node address
         if let Some(span) = cmd.span() {
             set_routed_node_on_span(&span, &address);
         }
-        conn.req_packed_command(&cmd)
-            .await
+        let result = conn.req_packed_command(&cmd).await;
+        if let Some(circuit_breakers) = &core.circuit_breakers {
+            circuit_breakers.record_result(&address, &result);
+        }
+        result
             .map(Response::Single)
             .map_err(|err| (address.into(), err))
     }
@@ -2732,6 +2930,40 @@ where
                         .expect(MUTEX_WRITE_ERR);
                     Ok(Response::Single(Value::Okay))
                 }
+                Operation::UpdateReadFromReplicas(read_from_replicas) => {
+                    let az_affinity = matches!(
+                        read_from_replicas,
+                        ReadFromReplicaStrategy::AZAffinity(_)
+                            | ReadFromReplicaStrategy::AZAffinityReplicasAndPrimary(_)
+                    );
+                    if az_affinity && !core.glide_connection_options.discover_az {
+                        return Err((
+                            OperationTarget::FanOut,
+                            RedisError::from((
+                                ErrorKind::UserOperationError,
+                                "AZ affinity read strategies can only be used by clients created with an AZ affinity read strategy",
+                            )),
+                        ));
+                    }
+                    core.set_cluster_param(|params| {
+                        params.read_from_replicas = read_from_replicas.clone()
+                    })
+                    .expect(MUTEX_WRITE_ERR);
+                    // The connections container is rebuilt from the cluster params on the next topology refresh, and
+                    // updated in place until then.
+                    core.conn_lock
+                        .write()
+                        .expect(MUTEX_WRITE_ERR)
+                        .set_read_from_replica_strategy(read_from_replicas);
+                    Ok(Response::Single(Value::Okay))
+                }
+                Operation::UpdateReconnectRetryStrategy(retry_strategy) => {
+                    core.set_cluster_param(|params| {
+                        params.reconnect_retry_strategy = Some(retry_strategy)
+                    })
+                    .expect(MUTEX_WRITE_ERR);
+                    Ok(Response::Single(Value::Okay))
+                }
                 Operation::GetUsername => {
                     let username = match core
                         .get_cluster_param(|params| params.username.clone())
@@ -3435,6 +3667,7 @@ where
             .unwrap()
             .push(PendingRequest {
                 retry: 0,
+                redirects: Vec::new(),
                 sender,
                 info,
             });
@@ -440,6 +440,7 @@ where
         receivers.push(receiver);
         pending_requests.push(PendingRequest {
             retry,
+            redirects: Vec::new(),
             sender,
             info: RequestInfo {
                 cmd: CmdArg::Pipeline {
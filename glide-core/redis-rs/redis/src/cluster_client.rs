@@ -1,12 +1,14 @@
+use crate::circuit_breaker::CircuitBreakerConfig;
 use crate::cluster_slotmap::ReadFromReplicaStrategy;
 #[cfg(feature = "cluster-async")]
 use crate::cluster_topology::{
     DEFAULT_SLOTS_REFRESH_MAX_JITTER_MILLI, DEFAULT_SLOTS_REFRESH_WAIT_DURATION,
 };
-use crate::connection::{ConnectionAddr, ConnectionInfo, IntoConnectionInfo};
+use crate::connection::{ConnectionAddr, ConnectionInfo, IntoConnectionInfo, TcpSocketOptions};
+use crate::dns_resolution::DnsResolutionConfig;
 use crate::types::{ErrorKind, ProtocolVersion, RedisError, RedisResult};
 use crate::{cluster, cluster::TlsMode};
-use crate::{PushInfo, RetryStrategy};
+use crate::{ConnectionEventListener, PushInfo, RetryStrategy};
 use rand::Rng;
 #[cfg(feature = "cluster-async")]
 use std::ops::Add;
@@ -46,13 +48,23 @@ struct BuilderParams {
     protocol: ProtocolVersion,
     reconnect_retry_strategy: Option<RetryStrategy>,
     refresh_topology_from_initial_nodes: bool,
+    race_initial_nodes: bool,
     database_id: i64,
     tcp_nodelay: bool,
+    tcp_socket_options: TcpSocketOptions,
+    max_response_size: Option<usize>,
+    write_coalescing_window: Option<Duration>,
+    client_tracking_prefixes: Option<Vec<String>>,
+    circuit_breaker: Option<CircuitBreakerConfig>,
+    dns_resolution: Option<DnsResolutionConfig>,
+    connection_event_listener: Option<Arc<dyn ConnectionEventListener>>,
 }
 
 #[derive(Clone)]
 pub(crate) struct RetryParams {
     pub(crate) number_of_retries: u32,
+    /// The number of MOVED or ASK redirects a request follows, counted apart from its other retries.
+    pub(crate) max_redirects: u32,
     max_wait_time: u64,
     min_wait_time: u64,
     exponent_base: u64,
@@ -68,6 +80,7 @@ impl Default for RetryParams {
         const DEFAULT_FACTOR: u64 = 10;
         Self {
             number_of_retries: DEFAULT_RETRIES,
+            max_redirects: DEFAULT_RETRIES,
             max_wait_time: DEFAULT_MAX_RETRY_WAIT_TIME,
             min_wait_time: DEFAULT_MIN_RETRY_WAIT_TIME,
             exponent_base: DEFAULT_EXPONENT_BASE,
@@ -149,8 +162,16 @@ pub struct ClusterParams {
     pub(crate) protocol: ProtocolVersion,
     pub(crate) reconnect_retry_strategy: Option<RetryStrategy>,
     pub(crate) refresh_topology_from_initial_nodes: bool,
+    pub(crate) race_initial_nodes: bool,
     pub(crate) database_id: i64,
     pub(crate) tcp_nodelay: bool,
+    pub(crate) tcp_socket_options: TcpSocketOptions,
+    pub(crate) max_response_size: Option<usize>,
+    pub(crate) write_coalescing_window: Option<Duration>,
+    pub(crate) client_tracking_prefixes: Option<Vec<String>>,
+    pub(crate) circuit_breaker: Option<CircuitBreakerConfig>,
+    pub(crate) dns_resolution: Option<DnsResolutionConfig>,
+    pub(crate) connection_event_listener: Option<Arc<dyn ConnectionEventListener>>,
 }
 
 impl ClusterParams {
@@ -181,8 +202,16 @@ impl ClusterParams {
             protocol: value.protocol,
             reconnect_retry_strategy: value.reconnect_retry_strategy,
             refresh_topology_from_initial_nodes: value.refresh_topology_from_initial_nodes,
+            race_initial_nodes: value.race_initial_nodes,
             database_id: value.database_id,
             tcp_nodelay: value.tcp_nodelay,
+            tcp_socket_options: value.tcp_socket_options,
+            max_response_size: value.max_response_size,
+            write_coalescing_window: value.write_coalescing_window,
+            client_tracking_prefixes: value.client_tracking_prefixes,
+            circuit_breaker: value.circuit_breaker,
+            dns_resolution: value.dns_resolution,
+            connection_event_listener: value.connection_event_listener,
         })
     }
 }
@@ -336,6 +365,13 @@ impl ClusterClientBuilder {
         self
     }
 
+    /// Sets the number of MOVED or ASK redirects a request follows for the new ClusterClient. The redirects do not count
+    /// as retries; a request still redirected afterwards fails with a `TooManyRedirects` error listing its redirects.
+    pub fn max_redirects(mut self, max_redirects: u32) -> ClusterClientBuilder {
+        self.builder_params.retries_configuration.max_redirects = max_redirects;
+        self
+    }
+
     /// Sets maximal wait time in milliseconds between retries for the new ClusterClient.
     pub fn max_retry_wait(mut self, max_wait: u64) -> ClusterClientBuilder {
         self.builder_params.retries_configuration.max_wait_time = max_wait;
@@ -496,6 +532,16 @@ impl ClusterClientBuilder {
         self
     }
 
+    /// Enables racing the connections to the initial nodes.
+    ///
+    /// The initial nodes are always dialed in parallel. When enabled, the topology is discovered as soon as the first
+    /// connection succeeds, instead of waiting for every initial node to connect or fail, so that an unreachable seed
+    /// does not delay the creation of the client by the connection timeout.
+    pub fn race_initial_nodes(mut self, race_initial_nodes: bool) -> ClusterClientBuilder {
+        self.builder_params.race_initial_nodes = race_initial_nodes;
+        self
+    }
+
     /// Sets the TCP_NODELAY socket option.
     ///
     /// When true, disables Nagle's algorithm for lower latency.
@@ -506,6 +552,77 @@ impl ClusterClientBuilder {
         self
     }
 
+    /// Sets the TCP socket options of the connections, such as the keepalive interval and the socket buffer sizes.
+    ///
+    /// The system defaults are used for the options that are not set.
+    pub fn tcp_socket_options(
+        mut self,
+        tcp_socket_options: TcpSocketOptions,
+    ) -> ClusterClientBuilder {
+        self.builder_params.tcp_socket_options = tcp_socket_options;
+        self
+    }
+
+    /// Sets the maximum size of a response, in bytes.
+    ///
+    /// Larger responses are aborted with a `ResponseTooLarge` error, instead of being buffered in memory.
+    pub fn max_response_size(mut self, max_response_size: usize) -> ClusterClientBuilder {
+        self.builder_params.max_response_size = Some(max_response_size);
+        self
+    }
+
+    /// Sets how long the writes of the requests are held back to be written to the sockets together.
+    ///
+    /// The requests sent to a node within the window are written in a single flush, which reduces the number of write
+    /// syscalls under high load at the cost of up to `write_coalescing_window` of added latency.
+    pub fn write_coalescing_window(
+        mut self,
+        write_coalescing_window: Duration,
+    ) -> ClusterClientBuilder {
+        self.builder_params.write_coalescing_window = Some(write_coalescing_window);
+        self
+    }
+
+    /// Enables the broadcasting client tracking of the keys starting with one of `prefixes`, or of all the keys if
+    /// `prefixes` is empty, on the user connections to the nodes.
+    ///
+    /// The nodes push the invalidations of the tracked keys they own to the push sender of the connections. Requires
+    /// RESP3.
+    pub fn client_tracking_prefixes(mut self, prefixes: Vec<String>) -> ClusterClientBuilder {
+        self.builder_params.client_tracking_prefixes = Some(prefixes);
+        self
+    }
+
+    /// Sets the listener of the lifecycle events of the user connections to the nodes.
+    pub fn connection_event_listener(
+        mut self,
+        connection_event_listener: Arc<dyn ConnectionEventListener>,
+    ) -> ClusterClientBuilder {
+        self.builder_params.connection_event_listener = Some(connection_event_listener);
+        self
+    }
+
+    /// Enables per-node circuit breakers.
+    ///
+    /// After `failure_threshold` consecutive connection failures to a node, requests destined to it fail immediately
+    /// for the configured cooldown, or are rerouted to a replica when they are reads that may be served by one.
+    /// Disabled if not set.
+    pub fn circuit_breaker(mut self, config: CircuitBreakerConfig) -> ClusterClientBuilder {
+        self.builder_params.circuit_breaker = Some(config);
+        self
+    }
+
+    /// Sets how the hostnames of the nodes are re-resolved.
+    ///
+    /// With a TTL, the hostnames of the connected nodes are resolved again along with the periodic connections checks
+    /// once their TTL expired, and the nodes whose hostname no longer resolves to the IP of their connection are
+    /// reconnected. With `prefer_hostname`, the initial nodes are connected with their hostname instead of with each
+    /// of the IPs it resolves to. Hostnames are not re-resolved if not set.
+    pub fn dns_resolution(mut self, config: DnsResolutionConfig) -> ClusterClientBuilder {
+        self.builder_params.dns_resolution = Some(config);
+        self
+    }
+
     /// Enables timing out on slow connection time.
     ///
     /// If enabled, the cluster will only wait the given time on each connection attempt to each node.
@@ -519,7 +519,7 @@ fn multi_shard<R>(
 where
     R: Routable + ?Sized,
 {
-    let is_readonly = is_readonly_cmd(cmd);
+    let is_readonly = routable.read_only_hint() || is_readonly_cmd(cmd);
     let mut routes = HashMap::new();
     let mut curr_arg_idx = 0;
     let incr_add_next_arg = |arg_indices: &mut Vec<usize>, mut curr_arg_idx: usize| {
@@ -851,6 +851,7 @@ impl RoutingInfo {
         R: Routable + ?Sized,
     {
         let cmd = &r.command()?[..];
+        let is_readonly = r.read_only_hint() || is_readonly_cmd(cmd);
         match base_routing(cmd) {
             RouteBy::AllNodes => Some(RoutingInfo::MultiNode((
                 MultipleNodeRoutingInfo::AllNodes,
@@ -874,13 +875,18 @@ impl RoutingInfo {
                 if key_count == 0 {
                     Some(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random))
                 } else {
-                    r.arg_idx(3).map(|key| RoutingInfo::for_key(cmd, key))
+                    r.arg_idx(3)
+                        .map(|key| RoutingInfo::for_key(is_readonly, key))
                 }
             }
 
-            RouteBy::SecondArg => r.arg_idx(2).map(|key| RoutingInfo::for_key(cmd, key)),
+            RouteBy::SecondArg => r
+                .arg_idx(2)
+                .map(|key| RoutingInfo::for_key(is_readonly, key)),
 
-            RouteBy::ThirdArg => r.arg_idx(3).map(|key| RoutingInfo::for_key(cmd, key)),
+            RouteBy::ThirdArg => r
+                .arg_idx(3)
+                .map(|key| RoutingInfo::for_key(is_readonly, key)),
 
             RouteBy::SecondArgAfterKeyCount => {
                 let key_count = r
@@ -890,14 +896,15 @@ impl RoutingInfo {
                 if key_count == 0 {
                     Some(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random))
                 } else {
-                    r.arg_idx(2).map(|key| RoutingInfo::for_key(cmd, key))
+                    r.arg_idx(2)
+                        .map(|key| RoutingInfo::for_key(is_readonly, key))
                 }
             }
 
             RouteBy::StreamsIndex => {
                 let streams_position = r.position(b"STREAMS")?;
                 r.arg_idx(streams_position + 1)
-                    .map(|key| RoutingInfo::for_key(cmd, key))
+                    .map(|key| RoutingInfo::for_key(is_readonly, key))
             }
 
             RouteBy::SecondArgSlot => r
@@ -912,7 +919,7 @@ impl RoutingInfo {
                 }),
 
             RouteBy::FirstKey => match r.arg_idx(1) {
-                Some(key) => Some(RoutingInfo::for_key(cmd, key)),
+                Some(key) => Some(RoutingInfo::for_key(is_readonly, key)),
                 None => Some(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random)),
             },
 
@@ -920,16 +927,19 @@ impl RoutingInfo {
         }
     }
 
-    fn for_key(cmd: &[u8], key: &[u8]) -> RoutingInfo {
+    fn for_key(is_readonly: bool, key: &[u8]) -> RoutingInfo {
         RoutingInfo::SingleNode(SingleNodeRoutingInfo::SpecificNode(get_route(
-            is_readonly_cmd(cmd),
+            is_readonly,
             key,
         )))
     }
 }
 
-/// Returns true if the given `routable` represents a readonly command.
+/// Returns true if the given `routable` represents a readonly command, or was marked as read-only by the caller.
 pub fn is_readonly(routable: &impl Routable) -> bool {
+    if routable.read_only_hint() {
+        return true;
+    }
     match routable.command() {
         Some(cmd) => is_readonly_cmd(cmd.as_slice()),
         None => false,
@@ -1205,6 +1215,12 @@ pub trait Routable {
 
     /// Returns index of argument that matches `candidate`, if it exists
     fn position(&self, candidate: &[u8]) -> Option<usize>;
+
+    /// Returns true if the caller marked the command as read-only, to route a command unknown to the client like the
+    /// known read-only commands.
+    fn read_only_hint(&self) -> bool {
+        false
+    }
 }
 
 impl Routable for Cmd {
@@ -1212,6 +1228,10 @@ impl Routable for Cmd {
         self.arg_idx(idx)
     }
 
+    fn read_only_hint(&self) -> bool {
+        self.read_only_hint()
+    }
+
     fn position(&self, candidate: &[u8]) -> Option<usize> {
         self.args_iter().position(|a| match a {
             Arg::Simple(d) => d.eq_ignore_ascii_case(candidate),
@@ -1712,6 +1732,24 @@ mod tests_routing {
                     )),
                 )),
             ),
+            (
+                cmd("SORT").arg("mylist").arg("ALPHA"),
+                Some(RoutingInfo::SingleNode(
+                    SingleNodeRoutingInfo::SpecificNode(Route::new(
+                        slot(b"mylist"),
+                        SlotAddr::Master,
+                    )),
+                )),
+            ),
+            (
+                cmd("SORT_RO").arg("mylist").arg("ALPHA"),
+                Some(RoutingInfo::SingleNode(
+                    SingleNodeRoutingInfo::SpecificNode(Route::new(
+                        slot(b"mylist"),
+                        SlotAddr::ReplicaOptional,
+                    )),
+                )),
+            ),
         ] {
             assert_eq!(
                 RoutingInfo::for_routable(cmd),
@@ -1722,6 +1760,31 @@ mod tests_routing {
         }
     }
 
+    #[test]
+    fn test_routing_info_with_read_only_hint() {
+        let mut module_read = cmd("BF.EXISTS");
+        module_read.arg("foo").arg("bar");
+        assert_eq!(
+            RoutingInfo::for_routable(&module_read),
+            Some(RoutingInfo::SingleNode(
+                SingleNodeRoutingInfo::SpecificNode(Route::new(slot(b"foo"), SlotAddr::Master))
+            ))
+        );
+        assert!(!crate::cluster_routing::is_readonly(&module_read));
+
+        module_read.set_read_only_hint(true);
+        assert_eq!(
+            RoutingInfo::for_routable(&module_read),
+            Some(RoutingInfo::SingleNode(
+                SingleNodeRoutingInfo::SpecificNode(Route::new(
+                    slot(b"foo"),
+                    SlotAddr::ReplicaOptional
+                ))
+            ))
+        );
+        assert!(crate::cluster_routing::is_readonly(&module_read));
+    }
+
     #[test]
     fn test_slot_for_packed_cmd() {
         assert!(matches!(RoutingInfo::for_routable(&parse_redis_value(&[
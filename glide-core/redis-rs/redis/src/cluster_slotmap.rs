@@ -292,6 +292,71 @@ impl SlotMap {
         self.update_slot_range(slot, shard_addrs)
     }
 
+    /// Updates the slot map to the topology of `new`, a slot map built from a more recent view of the cluster, e.g. by a
+    /// slot refresh, instead of replacing it. The shards whose primary and replicas are unchanged keep their shard
+    /// addresses, and the slot ranges that are unchanged keep their replica rotation, so that only the changed slot
+    /// ranges are replaced.
+    ///
+    /// # Returns
+    /// * `usize` - The number of slot ranges that were added or removed.
+    pub(crate) fn apply_topology(&mut self, new: SlotMap) -> usize {
+        // The shards of `new`, replaced by the equal shards of this slot map, by primary address.
+        let shards: HashMap<Arc<String>, Arc<ShardAddrs>> = new
+            .nodes_map
+            .iter()
+            .map(|entry| {
+                let shard_addrs = entry.value().1.clone();
+                let primary = shard_addrs.primary();
+                let current = self
+                    .nodes_map
+                    .get(&primary)
+                    .map(|current| current.value().1.clone())
+                    .filter(|current| **current == *shard_addrs);
+                (primary, current.unwrap_or(shard_addrs))
+            })
+            .collect();
+        let shard_for = |shard_addrs: &Arc<ShardAddrs>| {
+            shards
+                .get(&shard_addrs.primary())
+                .cloned()
+                .unwrap_or_else(|| shard_addrs.clone())
+        };
+
+        let mut slots = BTreeMap::new();
+        let mut kept = 0;
+        for (end, slot_value) in new.slots {
+            let addrs = shard_for(&slot_value.addrs);
+            let last_used_replica = match self.slots.get(&end) {
+                Some(current)
+                    if current.start == slot_value.start
+                        && Self::shard_addrs_equal(&current.addrs, &addrs) =>
+                {
+                    kept += 1;
+                    current.last_used_replica.clone()
+                }
+                _ => slot_value.last_used_replica,
+            };
+            slots.insert(
+                end,
+                SlotMapValue {
+                    start: slot_value.start,
+                    addrs,
+                    last_used_replica,
+                },
+            );
+        }
+        let changed = slots.len() + self.slots.len() - 2 * kept;
+
+        let nodes_map = DashMap::with_capacity(new.nodes_map.len());
+        for (node_addr, (ip, shard_addrs)) in new.nodes_map {
+            nodes_map.insert(node_addr, (ip, shard_for(&shard_addrs)));
+        }
+        self.slots = slots;
+        self.nodes_map = nodes_map;
+        self.read_from_replica = new.read_from_replica;
+        changed
+    }
+
     fn shard_addrs_equal(shard1: &Arc<ShardAddrs>, shard2: &Arc<ShardAddrs>) -> bool {
         Arc::ptr_eq(shard1, shard2)
     }
@@ -883,6 +948,81 @@ mod tests_cluster_slotmap {
         assert_equal_slot_maps(slot_map, expected_slots);
     }
 
+    #[test]
+    fn test_apply_topology_keeps_unchanged_shards_and_ranges() {
+        let mut slot_map = SlotMap::new(
+            vec![
+                create_slot(0, 5460, "node1:6379", vec!["replica1:6379"]),
+                create_slot(5461, 10922, "node2:6379", vec!["replica2:6379"]),
+                create_slot(10923, 16383, "node3:6379", vec!["replica3:6379"]),
+            ],
+            HashMap::new(),
+            ReadFromReplicaStrategy::RoundRobin,
+        );
+        let shard1 = slot_map.shard_addrs_for_slot(0).unwrap();
+        let shard2 = slot_map.shard_addrs_for_slot(5461).unwrap();
+        slot_map.node_address_for_slot(0, SlotAddr::ReplicaOptional);
+
+        // Slots 10000 to 10922 migrate to node1, and the replica of node3 is replaced.
+        let after_slots = vec![
+            create_slot(0, 5460, "node1:6379", vec!["replica1:6379"]),
+            create_slot(5461, 9999, "node2:6379", vec!["replica2:6379"]),
+            create_slot(10000, 10922, "node1:6379", vec!["replica1:6379"]),
+            create_slot(10923, 16383, "node3:6379", vec!["replica4:6379"]),
+        ];
+        let changed = slot_map.apply_topology(SlotMap::new(
+            after_slots.clone(),
+            HashMap::new(),
+            ReadFromReplicaStrategy::RoundRobin,
+        ));
+        assert_eq!(changed, 5);
+
+        // The unchanged shards are kept, along with the replica rotation of the unchanged range.
+        assert!(SlotMap::shard_addrs_equal(
+            &slot_map.shard_addrs_for_slot(10000).unwrap(),
+            &shard1
+        ));
+        assert!(SlotMap::shard_addrs_equal(
+            &slot_map.shard_addrs_for_slot(5461).unwrap(),
+            &shard2
+        ));
+        assert_eq!(
+            slot_map
+                .slots
+                .get(&5460)
+                .unwrap()
+                .last_used_replica
+                .load(std::sync::atomic::Ordering::Relaxed),
+            1
+        );
+        assert_eq!(
+            slot_map
+                .slots
+                .get(&9999)
+                .unwrap()
+                .last_used_replica
+                .load(std::sync::atomic::Ordering::Relaxed),
+            0
+        );
+        assert!(SlotMap::shard_addrs_equal(
+            &slot_map
+                .nodes_map()
+                .get(&"replica1:6379".to_string())
+                .unwrap()
+                .1,
+            &shard1
+        ));
+        assert!(slot_map
+            .nodes_map()
+            .get(&"replica3:6379".to_string())
+            .is_none());
+        assert_eq!(
+            *slot_map.shard_addrs_for_slot(16383).unwrap().replicas(),
+            vec![Arc::new("replica4:6379".to_string())]
+        );
+        assert_equal_slot_maps(slot_map, after_slots);
+    }
+
     #[test]
     fn test_update_slot_range_single_slot_range() {
         let test_slot = 8000;
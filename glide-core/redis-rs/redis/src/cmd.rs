@@ -35,6 +35,8 @@ pub struct Cmd {
     span: Option<GlideSpan>,
     //  A flag indicating whether this is a fenced command  (will have PING appended to ensure ordering)
     is_fenced: bool,
+    // A flag indicating whether the caller marked this command as read-only, e.g. a module command unknown to the client.
+    read_only_hint: bool,
 }
 
 /// The PING command used to fence other commands for ordering guarantees
@@ -344,6 +346,7 @@ impl Cmd {
             no_response: false,
             span: None,
             is_fenced: false,
+            read_only_hint: false,
         }
     }
 
@@ -356,6 +359,7 @@ impl Cmd {
             no_response: false,
             span: None,
             is_fenced: false,
+            read_only_hint: false,
         }
     }
 
@@ -648,6 +652,20 @@ impl Cmd {
     pub fn is_fenced(&self) -> bool {
         self.is_fenced
     }
+
+    /// Mark this command as read-only, so that it is routed like the read-only commands known to the client, e.g. to
+    /// a replica when reading from replicas. Meant for module or newer commands that the client does not know.
+    #[inline]
+    pub fn set_read_only_hint(&mut self, read_only: bool) -> &mut Cmd {
+        self.read_only_hint = read_only;
+        self
+    }
+
+    /// Check whether this command was marked as read-only.
+    #[inline]
+    pub fn read_only_hint(&self) -> bool {
+        self.read_only_hint
+    }
 }
 
 impl fmt::Debug for Cmd {
@@ -35,6 +35,18 @@ static CRYPTO_PROVIDER: OnceLock<()> = OnceLock::new();
 
 static DEFAULT_PORT: u16 = 6379;
 
+/// TCP socket options of the async connections. The system defaults are used for the options that are not set.
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq)]
+pub struct TcpSocketOptions {
+    /// Idle time before a TCP keepalive probe is sent, and time between the probes.
+    /// Only applied with the `keep-alive` feature.
+    pub keepalive_interval: Option<Duration>,
+    /// Size of the socket send buffer (SO_SNDBUF), in bytes.
+    pub send_buffer_size: Option<u32>,
+    /// Size of the socket receive buffer (SO_RCVBUF), in bytes.
+    pub recv_buffer_size: Option<u32>,
+}
+
 #[inline(always)]
 fn connect_tcp(addr: (&str, u16)) -> io::Result<TcpStream> {
     let socket = TcpStream::connect(addr)?;
@@ -927,8 +939,10 @@ pub fn connect(
 
 pub(crate) fn client_set_info_pipeline(lib_name: Option<&str>) -> Pipeline {
     let mut pipeline = crate::pipe();
-    let lib_name_value = lib_name.unwrap_or("UnknownClient");
-    let final_lib_name = option_env!("GLIDE_NAME").unwrap_or(lib_name_value);
+    // A library name set explicitly by the user takes precedence over the wrapper name set at build time.
+    let final_lib_name = lib_name
+        .or(option_env!("GLIDE_NAME"))
+        .unwrap_or("UnknownClient");
     pipeline
         .cmd("CLIENT")
         .arg("SETINFO")
@@ -1746,6 +1760,30 @@ mod tests {
         assert!(cmd_str.contains("Glide") || cmd_str.contains("UnknownClient"));
     }
 
+    #[test]
+    fn test_client_set_info_pipeline_custom_lib_name() {
+        let pipeline = client_set_info_pipeline(Some("CustomClient"));
+        let packed_commands = pipeline.get_packed_pipeline();
+        let cmd_str = String::from_utf8_lossy(&packed_commands);
+
+        // An explicit lib_name overrides GLIDE_NAME
+        assert!(cmd_str.contains("CustomClient"));
+        if let Some(glide_name) = option_env!("GLIDE_NAME") {
+            assert!(!cmd_str.contains(&format!("${}\r\n{glide_name}\r\n", glide_name.len())));
+        }
+    }
+
+    #[test]
+    fn test_client_set_info_pipeline_glide_name_without_lib_name() {
+        let pipeline = client_set_info_pipeline(None);
+        let packed_commands = pipeline.get_packed_pipeline();
+        let cmd_str = String::from_utf8_lossy(&packed_commands);
+
+        // Without an explicit lib_name, the wrapper name set at build time is used
+        let expected = option_env!("GLIDE_NAME").unwrap_or("UnknownClient");
+        assert!(cmd_str.contains(&format!("${}\r\n{expected}\r\n", expected.len())));
+    }
+
     #[test]
     fn test_client_set_info_pipeline_logic() {
         // Test the logic directly by simulating what happens when GLIDE_NAME is not set
@@ -0,0 +1,38 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+use std::fmt;
+
+/// An event in the lifecycle of the user connection to a node.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub enum ConnectionEvent {
+    /// A connection to the node was established and set up.
+    Connected {
+        /// The address of the node.
+        address: String,
+    },
+    /// The connection to the node was closed by the server or the network.
+    Disconnected {
+        /// The address of the node.
+        address: String,
+        /// The error that closed the connection, if any.
+        error: Option<String>,
+    },
+    /// An attempt to reconnect to the node failed. The next attempt is made after the backoff of the reconnect
+    /// retry strategy.
+    ReconnectAttempt {
+        /// The address of the node.
+        address: String,
+        /// The number of the attempt, starting from 1 for each disconnection.
+        attempt: u32,
+        /// The error the attempt failed with.
+        error: String,
+    },
+}
+
+/// Trait for receiving the events in the lifecycle of the connections of a client.
+///
+/// The events are reported from the tasks driving the connections, so the listener must return quickly and not block.
+pub trait ConnectionEventListener: Send + Sync + fmt::Debug {
+    /// Called for each event.
+    fn on_connection_event(&self, event: ConnectionEvent);
+}
@@ -0,0 +1,120 @@
+//! Re-resolution of node hostnames.
+//!
+//! When the IP behind a node hostname changes, e.g. on a failover of a managed service that updates its DNS records,
+//! the connections to the old IP may stay open, and the client keeps using a node that is no longer the one the
+//! hostname designates. The [`DnsResolver`] re-resolves the hostnames of the connected nodes once their TTL expired, so
+//! that the connections to a stale IP are re-established to the new one.
+
+use logger_core::log_info;
+use std::collections::HashMap;
+use std::net::IpAddr;
+use std::sync::Mutex;
+use std::time::{Duration, Instant};
+
+/// Configuration of the re-resolution of node hostnames.
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq)]
+pub struct DnsResolutionConfig {
+    /// How long a resolved hostname is trusted. Once elapsed, the hostname of a connected node is resolved again, and
+    /// the node is reconnected if it no longer resolves to the IP of its connection. Never re-resolved if not set.
+    pub ttl: Option<Duration>,
+    /// Reconnect to the initial nodes with their hostname, resolved on every connection attempt, instead of with
+    /// the IPs they resolved to when the client was created.
+    pub prefer_hostname: bool,
+}
+
+/// Tracks when the hostnames of the nodes were last resolved, to re-resolve them once per TTL.
+#[derive(Debug)]
+pub struct DnsResolver {
+    config: DnsResolutionConfig,
+    resolved_at: Mutex<HashMap<String, Instant>>,
+}
+
+impl DnsResolver {
+    /// Creates a resolver with the given configuration.
+    pub fn new(config: DnsResolutionConfig) -> Self {
+        Self {
+            config,
+            resolved_at: Mutex::new(HashMap::new()),
+        }
+    }
+
+    /// Returns whether connections should be re-established with hostnames rather than resolved IPs.
+    pub fn prefer_hostname(&self) -> bool {
+        self.config.prefer_hostname
+    }
+
+    /// Returns whether `host` should be resolved again, i.e. it is a hostname that was not resolved within the TTL.
+    /// Marks it as resolved now if so.
+    fn should_resolve(&self, host: &str, port: u16) -> bool {
+        let Some(ttl) = self.config.ttl else {
+            return false;
+        };
+        if host.parse::<IpAddr>().is_ok() {
+            return false;
+        }
+        let now = Instant::now();
+        let mut resolved_at = self.resolved_at.lock().unwrap();
+        match resolved_at.get(&format!("{host}:{port}")) {
+            Some(last) if now.duration_since(*last) < ttl => false,
+            _ => {
+                resolved_at.insert(format!("{host}:{port}"), now);
+                true
+            }
+        }
+    }
+
+    /// Returns true if the TTL of `host` expired and it no longer resolves to `connected_ip`, the IP of the current
+    /// connection to the node. Resolution failures are not considered as an address change.
+    #[cfg(feature = "aio")]
+    pub async fn is_stale(&self, host: &str, port: u16, connected_ip: Option<IpAddr>) -> bool {
+        let Some(connected_ip) = connected_ip else {
+            return false;
+        };
+        if !self.should_resolve(host, port) {
+            return false;
+        }
+        let Ok(mut socket_addrs) = crate::aio::get_socket_addrs(host, port).await else {
+            return false;
+        };
+        if socket_addrs.any(|socket_addr| socket_addr.ip() == connected_ip) {
+            return false;
+        }
+        log_info(
+            "DNS resolution",
+            format!("{host}:{port} no longer resolves to {connected_ip}, reconnecting"),
+        );
+        true
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_should_resolve_once_per_ttl() {
+        let resolver = DnsResolver::new(DnsResolutionConfig {
+            ttl: Some(Duration::from_millis(50)),
+            prefer_hostname: false,
+        });
+        assert!(resolver.should_resolve("node.example.com", 6379));
+        assert!(!resolver.should_resolve("node.example.com", 6379));
+        assert!(resolver.should_resolve("node.example.com", 6380));
+
+        std::thread::sleep(Duration::from_millis(60));
+        assert!(resolver.should_resolve("node.example.com", 6379));
+    }
+
+    #[test]
+    fn test_should_not_resolve_ips_or_without_ttl() {
+        let resolver = DnsResolver::new(DnsResolutionConfig {
+            ttl: Some(Duration::from_millis(50)),
+            prefer_hostname: false,
+        });
+        assert!(!resolver.should_resolve("127.0.0.1", 6379));
+        assert!(!resolver.should_resolve("::1", 6379));
+
+        let resolver = DnsResolver::new(DnsResolutionConfig::default());
+        assert!(!resolver.should_resolve("node.example.com", 6379));
+    }
+}
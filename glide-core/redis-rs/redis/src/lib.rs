@@ -345,8 +345,9 @@ pub use crate::commands::{
 pub use crate::connection::{
     parse_redis_url, transaction, Connection, ConnectionAddr, ConnectionInfo, ConnectionLike,
     IntoConnectionInfo, Msg, PubSub, PubSubChannelOrPattern, PubSubSubscriptionInfo,
-    PubSubSubscriptionKind, RedisConnectionInfo, TlsMode,
+    PubSubSubscriptionKind, RedisConnectionInfo, TcpSocketOptions, TlsMode,
 };
+pub use crate::connection_events::{ConnectionEvent, ConnectionEventListener};
 pub use crate::parser::{parse_redis_value, Parser};
 pub use crate::pipeline::{Pipeline, PipelineRetryStrategy};
 pub use crate::pubsub_synchronizer::PubSubSynchronizer;
@@ -436,6 +437,12 @@ mod cluster_pipeline;
 #[cfg(feature = "cluster")]
 pub mod cluster_routing;
 
+/// Per-node circuit breakers.
+pub mod circuit_breaker;
+
+/// Re-resolution of node hostnames.
+pub mod dns_resolution;
+
 #[cfg(feature = "cluster")]
 #[cfg_attr(docsrs, doc(cfg(feature = "cluster")))]
 pub mod cluster_topology;
@@ -454,6 +461,7 @@ mod client;
 mod cmd;
 mod commands;
 mod connection;
+mod connection_events;
 mod parser;
 mod pubsub_synchronizer;
 mod push_manager;
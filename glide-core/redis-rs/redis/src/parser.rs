@@ -326,9 +326,29 @@ mod aio_support {
     #[derive(Default)]
     pub struct ValueCodec {
         state: AnySendSyncPartialState,
+        /// The maximum size of a response, in bytes. Unlimited if `None`.
+        max_response_size: Option<usize>,
+        /// The number of bytes of the response being decoded that were already consumed by the parser.
+        partial_response_size: usize,
     }
 
     impl ValueCodec {
+        /// Creates a codec that aborts the responses larger than `max_response_size` bytes, if set.
+        pub fn with_max_response_size(max_response_size: Option<usize>) -> Self {
+            ValueCodec {
+                max_response_size,
+                ..Default::default()
+            }
+        }
+
+        fn response_too_large(max_response_size: usize) -> RedisError {
+            RedisError::from((
+                ErrorKind::ResponseTooLarge,
+                "Response exceeded the maximum response size",
+                format!("limit is {max_response_size} bytes"),
+            ))
+        }
+
         fn decode_stream(
             &mut self,
             bytes: &mut BytesMut,
@@ -355,9 +375,29 @@ mod aio_support {
             };
 
             bytes.advance(removed_len);
+            let Some(max_response_size) = self.max_response_size else {
+                return Ok(opt.map(Ok));
+            };
             match opt {
-                Some(result) => Ok(Some(Ok(result))),
-                None => Ok(None),
+                Some(result) => {
+                    let response_size = self.partial_response_size + removed_len;
+                    self.partial_response_size = 0;
+                    if response_size > max_response_size {
+                        // The response was fully consumed, so the connection can still be used.
+                        return Ok(Some(Err(Self::response_too_large(max_response_size))));
+                    }
+                    Ok(Some(Ok(result)))
+                }
+                None => {
+                    self.partial_response_size += removed_len;
+                    // The parser waits for a bulk string to be fully buffered before consuming it, so the buffered
+                    // bytes, which all belong to the response being decoded, count towards its size too. Aborting the
+                    // response in the middle leaves the rest of it on the connection, which can't be used anymore.
+                    if self.partial_response_size + bytes.len() > max_response_size {
+                        return Err(Self::response_too_large(max_response_size));
+                    }
+                    Ok(None)
+                }
             }
         }
     }
@@ -527,6 +567,57 @@ mod tests {
         assert_eq!(result, Ok(Value::Okay));
     }
 
+    #[cfg(feature = "aio")]
+    #[test]
+    fn decode_returns_error_for_complete_response_larger_than_max_response_size() {
+        use tokio_util::codec::Decoder;
+        let mut codec = ValueCodec::with_max_response_size(Some(10));
+
+        let mut bytes = bytes::BytesMut::from(b"$11\r\nhello world\r\n+OK\r\n".as_slice());
+        let result = codec.decode(&mut bytes).unwrap().unwrap();
+        assert_eq!(result.unwrap_err().kind(), ErrorKind::ResponseTooLarge);
+
+        let result = codec.decode(&mut bytes).unwrap().unwrap();
+        assert_eq!(result, Ok(Value::Okay));
+    }
+
+    #[cfg(feature = "aio")]
+    #[test]
+    fn decode_aborts_partial_response_larger_than_max_response_size() {
+        use tokio_util::codec::Decoder;
+        let mut codec = ValueCodec::with_max_response_size(Some(16));
+
+        let mut bytes = bytes::BytesMut::from(b"*2\r\n$5\r\nhello\r\n".as_slice());
+        assert_eq!(codec.decode(&mut bytes), Ok(None));
+
+        bytes.extend_from_slice(b"$1000\r\nworld");
+        let err = codec.decode(&mut bytes).unwrap_err();
+        assert_eq!(err.kind(), ErrorKind::ResponseTooLarge);
+        assert_eq!(
+            err.to_string(),
+            "Response exceeded the maximum response size - ResponseTooLarge: limit is 16 bytes"
+        );
+    }
+
+    #[cfg(feature = "aio")]
+    #[test]
+    fn decode_accepts_responses_within_max_response_size() {
+        use tokio_util::codec::Decoder;
+        let mut codec = ValueCodec::with_max_response_size(Some(11));
+
+        let mut bytes = bytes::BytesMut::from(b"$5\r\nhel".as_slice());
+        assert_eq!(codec.decode(&mut bytes), Ok(None));
+        bytes.extend_from_slice(b"lo\r\n$5\r\nworld\r\n");
+        assert_eq!(
+            codec.decode(&mut bytes),
+            Ok(Some(Ok(Value::BulkString(b"hello".to_vec()))))
+        );
+        assert_eq!(
+            codec.decode(&mut bytes),
+            Ok(Some(Ok(Value::BulkString(b"world".to_vec()))))
+        );
+    }
+
     #[test]
     fn parse_nested_error_and_handle_more_inputs() {
         // from https://redis.io/docs/interact/transactions/ -
@@ -162,6 +162,14 @@ pub enum ErrorKind {
     /// Response synchronization lost between commands and responses.
     /// The connection protocol is broken and must be reestablished.
     ProtocolDesync,
+
+    /// A response exceeded the maximum response size of the connection.
+    /// The response was aborted, and the connection must be reestablished.
+    ResponseTooLarge,
+
+    /// A cluster request was still redirected with MOVED or ASK after the maximum number of redirects.
+    /// The detail lists all the redirects of the request.
+    TooManyRedirects,
 }
 
 #[derive(PartialEq, Debug, Clone, Display, Copy)]
@@ -967,6 +975,8 @@ impl RedisError {
             ErrorKind::NotAllSlotsCovered => "not all slots are covered",
             ErrorKind::UserOperationError => "Wrong usage of management operation",
             ErrorKind::ProtocolDesync => "Response processing has goten out of sync",
+            ErrorKind::ResponseTooLarge => "response too large",
+            ErrorKind::TooManyRedirects => "too many redirects",
         }
     }
 
@@ -1174,6 +1184,8 @@ impl RedisError {
             ErrorKind::FatalSendError => RetryMethod::ReconnectAndRetry,
             ErrorKind::UserOperationError => RetryMethod::NoRetry,
             ErrorKind::ProtocolDesync => RetryMethod::NoRetry,
+            ErrorKind::ResponseTooLarge => RetryMethod::NoRetry,
+            ErrorKind::TooManyRedirects => RetryMethod::NoRetry,
         }
     }
 }
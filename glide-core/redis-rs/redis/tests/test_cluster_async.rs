@@ -1906,6 +1906,56 @@ mod cluster_async {
         assert_eq!(requests.load(atomic::Ordering::SeqCst), 3);
     }
 
+    #[test]
+    #[serial_test::serial]
+    fn test_async_cluster_ask_exhaust_redirects() {
+        let name = "node";
+
+        let requests = Arc::new(atomic::AtomicUsize::new(0));
+
+        // The redirects are not limited by the retries
+        let MockEnv {
+            runtime,
+            async_connection: mut connection,
+            handler: _handler,
+            ..
+        } = MockEnv::with_client_builder(
+            ClusterClient::builder(vec![&*format!("redis://{name}")])
+                .retries(0)
+                .max_redirects(2),
+            name,
+            {
+                let requests = requests.clone();
+                move |cmd: &[u8], port| {
+                    respond_startup_two_nodes(name, cmd)?;
+                    if contains_slice(cmd, b"ASKING") {
+                        return Err(Ok(Value::Okay));
+                    }
+                    requests.fetch_add(1, atomic::Ordering::SeqCst);
+                    match port {
+                        6379 => Err(parse_redis_value(b"-ASK 14000 node:6380\r\n")),
+                        6380 => Err(parse_redis_value(b"-ASK 14000 node:6379\r\n")),
+                        _ => panic!("Wrong node"),
+                    }
+                }
+            },
+        );
+
+        let result = runtime.block_on(
+            cmd("GET")
+                .arg("test")
+                .query_async::<_, Option<i32>>(&mut connection),
+        );
+
+        let err = result.unwrap_err();
+        assert_eq!(err.kind(), ErrorKind::TooManyRedirects);
+        assert_eq!(
+            err.detail(),
+            Some("ASK 14000 node:6380, ASK 14000 node:6379, ASK 14000 node:6380")
+        );
+        assert_eq!(requests.load(atomic::Ordering::SeqCst), 3);
+    }
+
     // Obtain the view index associated with the node with [called_port] port
     fn get_node_view_index(num_of_views: usize, ports: &Vec<u16>, called_port: u16) -> usize {
         let port_index = ports
@@ -0,0 +1,298 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+//! Transparent re-issuing of the blocking commands interrupted by a failover.
+//!
+//! A command blocking on a primary, e.g. `BLPOP` or `XREADGROUP ... BLOCK`, fails when the primary is demoted to a
+//! replica, with an `UNBLOCKED` error, or lost, with a dropped connection. The command is then re-issued with the part
+//! of its timeout that remains, and reaches the new primary once the connections are re-established and the topology
+//! is refreshed. The commands blocking forever are re-issued as they are. If the timeout expires in between, the
+//! response of a timed out command, `Nil`, is returned.
+//!
+//! Only the errors replied by the server guarantee that the command was not served, so a lost connection only
+//! re-issues `XREAD`: the other commands pop elements or deliver entries to a consumer, and the response lost with
+//! the connection may have carried them, so re-issuing them would consume the next ones as well.
+
+use super::{TimeUnit, parse_timeout_to_f64};
+use logger_core::log_warn;
+use redis::cluster_routing::Routable;
+use redis::{Arg, Cmd, ErrorKind, RedisError, RedisResult, Value};
+use std::future::Future;
+use std::time::{Duration, Instant};
+
+/// The delay before re-issuing a command, for the connections to be re-established and the topology to be refreshed.
+const REISSUE_DELAY: Duration = Duration::from_millis(100);
+
+/// The smallest timeout a command is re-issued with, since the timeouts in milliseconds are rounded down and a zero
+/// timeout blocks forever.
+const MIN_REISSUE_TIMEOUT: Duration = Duration::from_millis(1);
+
+/// Returns whether `err` is replied by a node demoted by a failover, i.e. whether the command was not served and would
+/// succeed on the new primary.
+fn is_failover_error(err: &RedisError) -> bool {
+    matches!(err.kind(), ErrorKind::ReadOnly | ErrorKind::MasterDown)
+        || err.code() == Some("UNBLOCKED")
+}
+
+/// Returns whether `err` is caused by the loss of the connection to the node serving the command, in which case the
+/// command may have been served.
+fn is_connection_error(err: &RedisError) -> bool {
+    err.is_connection_dropped()
+        || (err.is_io_error() && !err.is_timeout())
+        || matches!(
+            err.kind(),
+            ErrorKind::AllConnectionsUnavailable | ErrorKind::ConnectionNotFoundForRoute
+        )
+}
+
+/// Returns whether `cmd` can be re-issued when it may have been served, i.e. whether it leaves the data unchanged.
+fn is_non_destructive(cmd: &Cmd) -> bool {
+    cmd.command().as_deref() == Some(b"XREAD".as_slice())
+}
+
+/// Returns `cmd` with its timeout argument at `timeout_idx` replaced by `timeout`.
+fn with_timeout(cmd: &Cmd, timeout_idx: usize, time_unit: TimeUnit, timeout: Duration) -> Cmd {
+    let timeout = match time_unit {
+        TimeUnit::Seconds => format!("{:.3}", timeout.as_secs_f64()),
+        TimeUnit::Milliseconds => timeout.as_millis().to_string(),
+    };
+    let mut reissued = Cmd::new();
+    for (idx, arg) in cmd.args_iter().enumerate() {
+        match arg {
+            Arg::Simple(_) if idx == timeout_idx => reissued.arg(timeout.as_str()),
+            Arg::Simple(arg) => reissued.arg(arg),
+            Arg::Cursor => reissued.cursor_arg(0),
+        };
+    }
+    reissued
+        .set_span(cmd.span())
+        .set_read_only_hint(cmd.read_only_hint());
+    reissued
+}
+
+/// Sends the blocking command `cmd` with `send`, and re-issues it with the rest of its timeout, found at `timeout_idx`,
+/// whenever it is interrupted by a failover without being served.
+pub(super) async fn send_reissuing_on_failover<F, Fut>(
+    cmd: &Cmd,
+    timeout_idx: usize,
+    time_unit: TimeUnit,
+    mut send: F,
+) -> RedisResult<Value>
+where
+    F: FnMut(Cmd) -> Fut,
+    Fut: Future<Output = RedisResult<Value>>,
+{
+    let started = Instant::now();
+    let Ok(timeout) = parse_timeout_to_f64(cmd, timeout_idx) else {
+        return send(cmd.clone()).await;
+    };
+    // `None` if the command blocks forever
+    let timeout =
+        (timeout > 0.0).then(|| Duration::from_secs_f64(timeout / ((time_unit as i32) as f64)));
+
+    let non_destructive = is_non_destructive(cmd);
+    let mut attempt = cmd.clone();
+    loop {
+        let err = match send(attempt).await {
+            Err(err) if is_failover_error(&err) => err,
+            Err(err) if non_destructive && is_connection_error(&err) => err,
+            result => return result,
+        };
+        attempt = match timeout {
+            Some(timeout) => match timeout.checked_sub(started.elapsed() + REISSUE_DELAY) {
+                Some(remaining) if remaining >= MIN_REISSUE_TIMEOUT => {
+                    with_timeout(cmd, timeout_idx, time_unit, remaining)
+                }
+                _ => return Ok(Value::Nil),
+            },
+            None => cmd.clone(),
+        };
+        log_warn(
+            "send_reissuing_on_failover",
+            format!(
+                "Re-issuing the blocking command `{}` interrupted by a failover: {err}",
+                String::from_utf8_lossy(&cmd.command().unwrap_or_default())
+            ),
+        );
+        tokio::time::sleep(REISSUE_DELAY).await;
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::collections::VecDeque;
+    use std::io;
+    use std::sync::{Arc, Mutex};
+
+    fn args(cmd: &Cmd) -> Vec<String> {
+        cmd.args_iter()
+            .map(|arg| match arg {
+                Arg::Simple(arg) => String::from_utf8_lossy(arg).to_string(),
+                Arg::Cursor => "<cursor>".to_string(),
+            })
+            .collect()
+    }
+
+    fn dropped_connection() -> RedisError {
+        io::Error::from(io::ErrorKind::ConnectionReset).into()
+    }
+
+    fn unblocked() -> RedisError {
+        redis::parse_redis_value(
+            b"-UNBLOCKED force unblock from blocking operation, instance state changed\r\n",
+        )
+        .and_then(Value::extract_error)
+        .unwrap_err()
+    }
+
+    /// Sends the commands to a fake server answering with `responses`, and returns its response and the commands sent.
+    async fn send(
+        cmd: Cmd,
+        timeout_idx: usize,
+        time_unit: TimeUnit,
+        responses: Vec<RedisResult<Value>>,
+    ) -> (RedisResult<Value>, Vec<Vec<String>>) {
+        let responses = Arc::new(Mutex::new(VecDeque::from(responses)));
+        let sent = Arc::new(Mutex::new(Vec::new()));
+        let result = send_reissuing_on_failover(&cmd, timeout_idx, time_unit, |cmd| {
+            sent.lock().unwrap().push(args(&cmd));
+            let response = responses.lock().unwrap().pop_front().unwrap();
+            async move { response }
+        })
+        .await;
+        let sent = sent.lock().unwrap().clone();
+        (result, sent)
+    }
+
+    #[test]
+    fn test_is_failover_error() {
+        assert!(is_failover_error(&unblocked()));
+        assert!(is_failover_error(&RedisError::from((
+            ErrorKind::ReadOnly,
+            "You can't write against a read only replica."
+        ))));
+        assert!(!is_failover_error(&dropped_connection()));
+        assert!(!is_failover_error(&RedisError::from((
+            ErrorKind::TypeError,
+            "WRONGTYPE"
+        ))));
+    }
+
+    #[test]
+    fn test_is_connection_error() {
+        assert!(is_connection_error(&dropped_connection()));
+        assert!(is_connection_error(&RedisError::from((
+            ErrorKind::FatalReceiveError,
+            "connection lost"
+        ))));
+        assert!(!is_connection_error(&unblocked()));
+        assert!(!is_connection_error(
+            &io::Error::from(io::ErrorKind::TimedOut).into()
+        ));
+    }
+
+    #[tokio::test]
+    async fn test_reissues_with_remaining_timeout() {
+        let mut cmd = redis::cmd("BLPOP");
+        cmd.arg("list").arg("10");
+        let (result, sent) = send(
+            cmd,
+            2,
+            TimeUnit::Seconds,
+            vec![Err(unblocked()), Ok(Value::Int(1))],
+        )
+        .await;
+        assert_eq!(result, Ok(Value::Int(1)));
+        assert_eq!(sent.len(), 2);
+        assert_eq!(sent[0], vec!["BLPOP", "list", "10"]);
+        let remaining: f64 = sent[1][2].parse().unwrap();
+        assert!(remaining > 9.0 && remaining < 10.0, "{remaining}");
+    }
+
+    #[tokio::test]
+    async fn test_reissues_blocking_forever_unchanged() {
+        let mut cmd = redis::cmd("XREADGROUP");
+        cmd.arg("GROUP")
+            .arg("group")
+            .arg("consumer")
+            .arg("BLOCK")
+            .arg("0")
+            .arg("STREAMS")
+            .arg("stream")
+            .arg(">");
+        let (result, sent) = send(
+            cmd,
+            5,
+            TimeUnit::Milliseconds,
+            vec![Err(unblocked()), Err(unblocked()), Ok(Value::Nil)],
+        )
+        .await;
+        assert_eq!(result, Ok(Value::Nil));
+        assert_eq!(sent.len(), 3);
+        assert_eq!(sent[1], sent[0]);
+        assert_eq!(sent[2], sent[0]);
+    }
+
+    #[tokio::test]
+    async fn test_does_not_reissue_destructive_commands_on_connection_errors() {
+        let mut cmd = redis::cmd("BLPOP");
+        cmd.arg("list").arg("10");
+        let (result, sent) = send(cmd, 2, TimeUnit::Seconds, vec![Err(dropped_connection())]).await;
+        assert!(result.unwrap_err().is_connection_dropped());
+        assert_eq!(sent.len(), 1);
+    }
+
+    #[tokio::test]
+    async fn test_reissues_xread_on_connection_errors() {
+        let mut cmd = redis::cmd("XREAD");
+        cmd.arg("BLOCK")
+            .arg("0")
+            .arg("STREAMS")
+            .arg("stream")
+            .arg("0-0");
+        let (result, sent) = send(
+            cmd,
+            2,
+            TimeUnit::Milliseconds,
+            vec![Err(dropped_connection()), Ok(Value::Nil)],
+        )
+        .await;
+        assert_eq!(result, Ok(Value::Nil));
+        assert_eq!(sent.len(), 2);
+        assert_eq!(sent[1], sent[0]);
+    }
+
+    #[tokio::test]
+    async fn test_returns_nil_when_timeout_expires() {
+        let mut cmd = redis::cmd("XREAD");
+        cmd.arg("BLOCK")
+            .arg("50")
+            .arg("STREAMS")
+            .arg("stream")
+            .arg("$");
+        let (result, sent) = send(
+            cmd,
+            2,
+            TimeUnit::Milliseconds,
+            vec![Err(dropped_connection())],
+        )
+        .await;
+        assert_eq!(result, Ok(Value::Nil));
+        assert_eq!(sent.len(), 1);
+    }
+
+    #[tokio::test]
+    async fn test_returns_other_errors() {
+        let mut cmd = redis::cmd("BRPOP");
+        cmd.arg("list").arg("1");
+        let (result, sent) = send(
+            cmd,
+            2,
+            TimeUnit::Seconds,
+            vec![Err(RedisError::from((ErrorKind::TypeError, "WRONGTYPE")))],
+        )
+        .await;
+        assert_eq!(result.unwrap_err().kind(), ErrorKind::TypeError);
+        assert_eq!(sent.len(), 1);
+    }
+}
@@ -0,0 +1,695 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+//! Transparent key prefixing, so that several tenants can share a database without seeing the keys of each other.
+//!
+//! The keys of the commands are prefixed before the commands are routed, and the keys echoed in the responses, e.g. by
+//! `SCAN` or `BLPOP`, are stripped of the prefix. The patterns of `SCAN` and `KEYS` are restricted to the prefixed keys.
+//! The commands whose keys are unknown, e.g. the commands of most modules, are sent unchanged.
+
+use redis::{Arg, Cmd, Pipeline, Value, cluster_routing::Routable};
+
+/// The positions of the keys in the arguments of a command, the command name being at position 0.
+#[derive(Debug, PartialEq)]
+enum KeySpec {
+    /// The keys from `first` to `last`, every `step` arguments. A negative `last` counts from the end of the arguments,
+    /// -1 being the last argument.
+    Range {
+        first: usize,
+        last: isize,
+        step: usize,
+    },
+    /// The keys following the number of keys at `index`, e.g. `EVAL script numkeys key...`, preceded by a destination
+    /// key at position 1 if `destination` is set, e.g. `ZUNIONSTORE destination numkeys key...`.
+    NumKeys { index: usize, destination: bool },
+    /// The first half of the arguments following `STREAMS`, as in `XREAD`.
+    Streams,
+    /// The key at position 1, and the destination following `STORE` or `STOREDIST`, as in `GEORADIUS`.
+    StoreOption,
+    /// The key of `SORT`, its `STORE` destination, and its `BY` and `GET` patterns, which are key names too.
+    Sort,
+    /// The key at position 3 unless empty, and the keys following `KEYS`, as in `MIGRATE`.
+    Migrate,
+}
+
+const SINGLE_KEY: KeySpec = KeySpec::Range {
+    first: 1,
+    last: 1,
+    step: 1,
+};
+
+fn key_spec(name: &str, subcommand: Option<&str>) -> Option<KeySpec> {
+    let spec =
+        match name {
+            "APPEND"
+            | "BITCOUNT"
+            | "BITFIELD"
+            | "BITFIELD_RO"
+            | "BITPOS"
+            | "DECR"
+            | "DECRBY"
+            | "DUMP"
+            | "EXPIRE"
+            | "EXPIREAT"
+            | "EXPIRETIME"
+            | "GEOADD"
+            | "GEODIST"
+            | "GEOHASH"
+            | "GEOPOS"
+            | "GEORADIUS_RO"
+            | "GEORADIUSBYMEMBER_RO"
+            | "GEOSEARCH"
+            | "GET"
+            | "GETBIT"
+            | "GETDEL"
+            | "GETEX"
+            | "GETRANGE"
+            | "GETSET"
+            | "HDEL"
+            | "HEXISTS"
+            | "HEXPIRE"
+            | "HEXPIREAT"
+            | "HEXPIRETIME"
+            | "HGET"
+            | "HGETALL"
+            | "HGETEX"
+            | "HINCRBY"
+            | "HINCRBYFLOAT"
+            | "HKEYS"
+            | "HLEN"
+            | "HMGET"
+            | "HMSET"
+            | "HPERSIST"
+            | "HPEXPIRE"
+            | "HPEXPIREAT"
+            | "HPEXPIRETIME"
+            | "HPTTL"
+            | "HRANDFIELD"
+            | "HSCAN"
+            | "HSET"
+            | "HSETEX"
+            | "HSETNX"
+            | "HSTRLEN"
+            | "HTTL"
+            | "HVALS"
+            | "INCR"
+            | "INCRBY"
+            | "INCRBYFLOAT"
+            | "LINDEX"
+            | "LINSERT"
+            | "LLEN"
+            | "LPOP"
+            | "LPOS"
+            | "LPUSH"
+            | "LPUSHX"
+            | "LRANGE"
+            | "LREM"
+            | "LSET"
+            | "LTRIM"
+            | "MOVE"
+            | "PERSIST"
+            | "PEXPIRE"
+            | "PEXPIREAT"
+            | "PEXPIRETIME"
+            | "PFADD"
+            | "PSETEX"
+            | "PTTL"
+            | "RESTORE"
+            | "RPOP"
+            | "RPUSH"
+            | "RPUSHX"
+            | "SADD"
+            | "SCARD"
+            | "SET"
+            | "SETBIT"
+            | "SETEX"
+            | "SETNX"
+            | "SETRANGE"
+            | "SISMEMBER"
+            | "SMEMBERS"
+            | "SMISMEMBER"
+            | "SPOP"
+            | "SRANDMEMBER"
+            | "SREM"
+            | "SSCAN"
+            | "STRLEN"
+            | "SUBSTR"
+            | "TTL"
+            | "TYPE"
+            | "XACK"
+            | "XACKDEL"
+            | "XADD"
+            | "XAUTOCLAIM"
+            | "XCLAIM"
+            | "XDEL"
+            | "XDELEX"
+            | "XLEN"
+            | "XPENDING"
+            | "XRANGE"
+            | "XREVRANGE"
+            | "XSETID"
+            | "XTRIM"
+            | "ZADD"
+            | "ZCARD"
+            | "ZCOUNT"
+            | "ZINCRBY"
+            | "ZLEXCOUNT"
+            | "ZMSCORE"
+            | "ZPOPMAX"
+            | "ZPOPMIN"
+            | "ZRANDMEMBER"
+            | "ZRANGE"
+            | "ZRANGEBYLEX"
+            | "ZRANGEBYSCORE"
+            | "ZRANK"
+            | "ZREM"
+            | "ZREMRANGEBYLEX"
+            | "ZREMRANGEBYRANK"
+            | "ZREMRANGEBYSCORE"
+            | "ZREVRANGE"
+            | "ZREVRANGEBYLEX"
+            | "ZREVRANGEBYSCORE"
+            | "ZREVRANK"
+            | "ZSCAN"
+            | "ZSCORE"
+            | "JSON.ARRAPPEND"
+            | "JSON.ARRINDEX"
+            | "JSON.ARRINSERT"
+            | "JSON.ARRLEN"
+            | "JSON.ARRPOP"
+            | "JSON.ARRTRIM"
+            | "JSON.CLEAR"
+            | "JSON.DEBUG"
+            | "JSON.DEL"
+            | "JSON.FORGET"
+            | "JSON.GET"
+            | "JSON.NUMINCRBY"
+            | "JSON.NUMMULTBY"
+            | "JSON.OBJKEYS"
+            | "JSON.OBJLEN"
+            | "JSON.RESP"
+            | "JSON.SET"
+            | "JSON.STRAPPEND"
+            | "JSON.STRLEN"
+            | "JSON.TOGGLE"
+            | "JSON.TYPE" => SINGLE_KEY,
+            "BLMOVE" | "BRPOPLPUSH" | "COPY" | "GEOSEARCHSTORE" | "LCS" | "LMOVE" | "RENAME"
+            | "RENAMENX" | "RPOPLPUSH" | "SMOVE" | "ZRANGESTORE" => KeySpec::Range {
+                first: 1,
+                last: 2,
+                step: 1,
+            },
+            "DEL" | "EXISTS" | "MGET" | "PFCOUNT" | "PFMERGE" | "SDIFF" | "SDIFFSTORE"
+            | "SINTER" | "SINTERSTORE" | "SUNION" | "SUNIONSTORE" | "TOUCH" | "UNLINK"
+            | "WATCH" => KeySpec::Range {
+                first: 1,
+                last: -1,
+                step: 1,
+            },
+            "MSET" | "MSETNX" => KeySpec::Range {
+                first: 1,
+                last: -1,
+                step: 2,
+            },
+            "BLPOP" | "BRPOP" | "BZPOPMAX" | "BZPOPMIN" | "JSON.MGET" => KeySpec::Range {
+                first: 1,
+                last: -2,
+                step: 1,
+            },
+            "BITOP" => KeySpec::Range {
+                first: 2,
+                last: -1,
+                step: 1,
+            },
+            "LMPOP" | "SINTERCARD" | "ZDIFF" | "ZINTER" | "ZINTERCARD" | "ZMPOP" | "ZUNION" => {
+                KeySpec::NumKeys {
+                    index: 1,
+                    destination: false,
+                }
+            }
+            "BLMPOP" | "BZMPOP" | "EVAL" | "EVAL_RO" | "EVALSHA" | "EVALSHA_RO" | "FCALL"
+            | "FCALL_RO" => KeySpec::NumKeys {
+                index: 2,
+                destination: false,
+            },
+            "ZDIFFSTORE" | "ZINTERSTORE" | "ZUNIONSTORE" => KeySpec::NumKeys {
+                index: 2,
+                destination: true,
+            },
+            "XREAD" | "XREADGROUP" => KeySpec::Streams,
+            "GEORADIUS" | "GEORADIUSBYMEMBER" => KeySpec::StoreOption,
+            "SORT" | "SORT_RO" => KeySpec::Sort,
+            "MIGRATE" => KeySpec::Migrate,
+            "OBJECT" | "MEMORY" | "XINFO" | "XGROUP" => match subcommand? {
+                "ENCODING" | "FREQ" | "IDLETIME" | "REFCOUNT" | "USAGE" | "STREAM" | "GROUPS"
+                | "CONSUMERS" | "CREATE" | "CREATECONSUMER" | "DELCONSUMER" | "DESTROY"
+                | "SETID" => KeySpec::Range {
+                    first: 2,
+                    last: 2,
+                    step: 1,
+                },
+                _ => return None,
+            },
+            _ => return None,
+        };
+    Some(spec)
+}
+
+/// Returns the positions of the arguments of `args` that are keys, or key names such as the patterns of `SORT`.
+fn key_positions(args: &[Vec<u8>]) -> Vec<usize> {
+    let Some(name) = args.first() else {
+        return vec![];
+    };
+    let name = String::from_utf8_lossy(name).to_ascii_uppercase();
+    let subcommand = args
+        .get(1)
+        .map(|arg| String::from_utf8_lossy(arg).to_ascii_uppercase());
+    let Some(spec) = key_spec(&name, subcommand.as_deref()) else {
+        return vec![];
+    };
+    let len = args.len();
+    let is = |index: usize, keyword: &str| {
+        args.get(index)
+            .is_some_and(|arg| arg.eq_ignore_ascii_case(keyword.as_bytes()))
+    };
+    match spec {
+        KeySpec::Range { first, last, step } => {
+            let last = if last < 0 {
+                len as isize + last
+            } else {
+                last.min(len as isize - 1)
+            };
+            if last < first as isize {
+                return vec![];
+            }
+            (first..=last as usize).step_by(step).collect()
+        }
+        KeySpec::NumKeys { index, destination } => {
+            let numkeys = args
+                .get(index)
+                .and_then(|arg| std::str::from_utf8(arg).ok())
+                .and_then(|arg| arg.parse::<usize>().ok())
+                .unwrap_or(0);
+            let mut positions: Vec<usize> = if destination { vec![1] } else { vec![] };
+            positions.extend((index + 1..len).take(numkeys));
+            positions
+        }
+        KeySpec::Streams => match (1..len).find(|&i| is(i, "STREAMS")) {
+            Some(streams) => {
+                let keys = (len - streams - 1) / 2;
+                (streams + 1..streams + 1 + keys).collect()
+            }
+            None => vec![],
+        },
+        KeySpec::StoreOption => {
+            let mut positions = vec![1];
+            positions.extend(
+                (2..len.saturating_sub(1))
+                    .filter(|&i| is(i, "STORE") || is(i, "STOREDIST"))
+                    .map(|i| i + 1),
+            );
+            positions
+        }
+        KeySpec::Sort => {
+            let mut positions = vec![1];
+            let mut i = 2;
+            while i < len {
+                if is(i, "LIMIT") {
+                    i += 3;
+                } else if is(i, "BY") || is(i, "STORE") {
+                    positions.push(i + 1);
+                    i += 2;
+                } else if is(i, "GET") {
+                    if !is(i + 1, "#") {
+                        positions.push(i + 1);
+                    }
+                    i += 2;
+                } else {
+                    i += 1;
+                }
+            }
+            positions.retain(|&i| i < len);
+            positions
+        }
+        KeySpec::Migrate => {
+            let mut positions = vec![];
+            if args.get(3).is_some_and(|key| !key.is_empty()) {
+                positions.push(3);
+            }
+            if let Some(keys) = (6..len).find(|&i| is(i, "KEYS")) {
+                positions.extend(keys + 1..len);
+            }
+            positions
+        }
+    }
+}
+
+/// Escapes the glob-style special characters of `prefix`, so that it matches itself only in a pattern.
+fn escape_pattern(prefix: &[u8]) -> Vec<u8> {
+    let mut escaped = Vec::with_capacity(prefix.len());
+    for &byte in prefix {
+        if matches!(byte, b'*' | b'?' | b'[' | b']' | b'\\') {
+            escaped.push(b'\\');
+        }
+        escaped.push(byte);
+    }
+    escaped
+}
+
+/// Returns the pattern matching the prefixed keys matched by `pattern`, or all the prefixed keys if `pattern` is `None`.
+pub(crate) fn prefix_pattern(pattern: Option<&[u8]>, prefix: &[u8]) -> Vec<u8> {
+    let mut prefixed = escape_pattern(prefix);
+    prefixed.extend_from_slice(pattern.unwrap_or(b"*".as_slice()));
+    prefixed
+}
+
+fn prefixed(key: &[u8], prefix: &[u8]) -> Vec<u8> {
+    let mut prefixed = Vec::with_capacity(prefix.len() + key.len());
+    prefixed.extend_from_slice(prefix);
+    prefixed.extend_from_slice(key);
+    prefixed
+}
+
+/// Returns `cmd` with its keys prefixed by `prefix`, or `None` if the command has no known keys.
+pub(crate) fn prefix_command(cmd: &Cmd, prefix: &[u8]) -> Option<Cmd> {
+    let mut args = Vec::with_capacity(cmd.args_iter().len());
+    for arg in cmd.args_iter() {
+        match arg {
+            Arg::Simple(arg) => args.push(arg.to_vec()),
+            Arg::Cursor => return None,
+        }
+    }
+    let mut changed = false;
+    for position in key_positions(&args) {
+        args[position] = prefixed(&args[position], prefix);
+        changed = true;
+    }
+
+    let name = args
+        .first()
+        .map(|name| name.to_ascii_uppercase())
+        .unwrap_or_default();
+    match name.as_slice() {
+        b"KEYS" if args.len() > 1 => {
+            args[1] = prefix_pattern(Some(args[1].as_slice()), prefix);
+            changed = true;
+        }
+        b"SCAN" => {
+            let match_position = (2..args.len())
+                .step_by(2)
+                .find(|&i| args[i].eq_ignore_ascii_case(b"MATCH"));
+            match match_position {
+                Some(i) if i + 1 < args.len() => {
+                    args[i + 1] = prefix_pattern(Some(args[i + 1].as_slice()), prefix);
+                }
+                _ => {
+                    args.push(b"MATCH".to_vec());
+                    args.push(prefix_pattern(None, prefix));
+                }
+            }
+            changed = true;
+        }
+        _ => {}
+    }
+    if !changed {
+        return None;
+    }
+
+    let mut prefixed_cmd = Cmd::with_capacity(args.len(), args.iter().map(Vec::len).sum());
+    for arg in args {
+        prefixed_cmd.arg(arg);
+    }
+    prefixed_cmd
+        .set_span(cmd.span())
+        .set_no_response(cmd.is_no_response())
+        .set_fenced(cmd.is_fenced())
+        .set_read_only_hint(cmd.read_only_hint());
+    Some(prefixed_cmd)
+}
+
+/// Returns `pipeline` with the keys of its commands prefixed by `prefix`, or `None` if none of its commands has keys.
+pub(crate) fn prefix_pipeline(pipeline: &Pipeline, prefix: &[u8]) -> Option<Pipeline> {
+    let commands: Vec<Option<Cmd>> = pipeline
+        .cmd_iter()
+        .map(|cmd| prefix_command(cmd, prefix))
+        .collect();
+    if commands.iter().all(Option::is_none) {
+        return None;
+    }
+    let mut prefixed_pipeline = Pipeline::with_capacity(commands.len());
+    if pipeline.is_atomic() {
+        prefixed_pipeline.atomic();
+    }
+    prefixed_pipeline.set_pipeline_span(pipeline.span());
+    for (cmd, prefixed_cmd) in pipeline.cmd_iter().zip(commands) {
+        prefixed_pipeline.add_command(prefixed_cmd.unwrap_or_else(|| cmd.as_ref().clone()));
+    }
+    Some(prefixed_pipeline)
+}
+
+/// Strips `prefix` from `key`. Keys without the prefix, which belong to other tenants, are replaced by nil.
+pub(crate) fn strip_key(key: Value, prefix: &[u8]) -> Value {
+    match key {
+        Value::BulkString(key) => match key.strip_prefix(prefix) {
+            Some(stripped) => Value::BulkString(stripped.to_vec()),
+            None => Value::Nil,
+        },
+        value => value,
+    }
+}
+
+fn strip_keys(keys: Value, prefix: &[u8]) -> Value {
+    match keys {
+        Value::Array(keys) => {
+            Value::Array(keys.into_iter().map(|key| strip_key(key, prefix)).collect())
+        }
+        value => value,
+    }
+}
+
+/// Strips the prefix of the first element of an array response, e.g. the key of `BLPOP`.
+fn strip_first_key(response: Value, prefix: &[u8]) -> Value {
+    match response {
+        Value::Array(mut values) if !values.is_empty() => {
+            values[0] = strip_key(std::mem::replace(&mut values[0], Value::Nil), prefix);
+            Value::Array(values)
+        }
+        value => value,
+    }
+}
+
+/// Strips `prefix` from the keys echoed in `response`, the raw response to `cmd`.
+pub(crate) fn strip_response(cmd: &Cmd, response: Value, prefix: &[u8]) -> Value {
+    let name = cmd
+        .command()
+        .map(|name| name.to_ascii_uppercase())
+        .unwrap_or_default();
+    match name.as_slice() {
+        b"KEYS" => strip_keys(response, prefix),
+        b"RANDOMKEY" => strip_key(response, prefix),
+        b"SCAN" => match response {
+            Value::Array(mut values) if values.len() == 2 => {
+                values[1] = strip_keys(std::mem::replace(&mut values[1], Value::Nil), prefix);
+                Value::Array(values)
+            }
+            value => value,
+        },
+        b"BLPOP" | b"BRPOP" | b"BZPOPMAX" | b"BZPOPMIN" | b"LMPOP" | b"BLMPOP" | b"ZMPOP"
+        | b"BZMPOP" => strip_first_key(response, prefix),
+        b"XREAD" | b"XREADGROUP" => match response {
+            Value::Map(streams) => Value::Map(
+                streams
+                    .into_iter()
+                    .map(|(key, entries)| (strip_key(key, prefix), entries))
+                    .collect(),
+            ),
+            Value::Array(streams) => Value::Array(
+                streams
+                    .into_iter()
+                    .map(|stream| strip_first_key(stream, prefix))
+                    .collect(),
+            ),
+            value => value,
+        },
+        _ => response,
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn args(cmd: &Cmd) -> Vec<String> {
+        cmd.args_iter()
+            .map(|arg| match arg {
+                Arg::Simple(arg) => String::from_utf8_lossy(arg).to_string(),
+                Arg::Cursor => "<cursor>".to_string(),
+            })
+            .collect()
+    }
+
+    fn prefix(command: &[&str]) -> Vec<String> {
+        let mut cmd = Cmd::new();
+        for arg in command {
+            cmd.arg(*arg);
+        }
+        match prefix_command(&cmd, b"t1:") {
+            Some(prefixed) => args(&prefixed),
+            None => args(&cmd),
+        }
+    }
+
+    #[test]
+    fn test_prefix_command_keys() {
+        assert_eq!(prefix(&["GET", "k"]), ["GET", "t1:k"]);
+        assert_eq!(
+            prefix(&["set", "k", "v", "EX", "10"]),
+            ["set", "t1:k", "v", "EX", "10"]
+        );
+        assert_eq!(
+            prefix(&["MSET", "a", "1", "b", "2"]),
+            ["MSET", "t1:a", "1", "t1:b", "2"]
+        );
+        assert_eq!(prefix(&["DEL", "a", "b"]), ["DEL", "t1:a", "t1:b"]);
+        assert_eq!(prefix(&["RENAME", "a", "b"]), ["RENAME", "t1:a", "t1:b"]);
+        assert_eq!(
+            prefix(&["BLPOP", "a", "b", "0"]),
+            ["BLPOP", "t1:a", "t1:b", "0"]
+        );
+        assert_eq!(
+            prefix(&["BITOP", "AND", "d", "a"]),
+            ["BITOP", "AND", "t1:d", "t1:a"]
+        );
+        assert_eq!(
+            prefix(&["EVALSHA", "sha", "2", "a", "b", "arg"]),
+            ["EVALSHA", "sha", "2", "t1:a", "t1:b", "arg"]
+        );
+        assert_eq!(
+            prefix(&["ZUNIONSTORE", "d", "2", "a", "b", "WEIGHTS", "1", "2"]),
+            [
+                "ZUNIONSTORE",
+                "t1:d",
+                "2",
+                "t1:a",
+                "t1:b",
+                "WEIGHTS",
+                "1",
+                "2"
+            ]
+        );
+        assert_eq!(
+            prefix(&["LMPOP", "2", "a", "b", "LEFT"]),
+            ["LMPOP", "2", "t1:a", "t1:b", "LEFT"]
+        );
+        assert_eq!(
+            prefix(&["XREAD", "COUNT", "2", "STREAMS", "a", "b", "0", "0"]),
+            ["XREAD", "COUNT", "2", "STREAMS", "t1:a", "t1:b", "0", "0"]
+        );
+        assert_eq!(
+            prefix(&["OBJECT", "ENCODING", "k"]),
+            ["OBJECT", "ENCODING", "t1:k"]
+        );
+        assert_eq!(prefix(&["OBJECT", "HELP"]), ["OBJECT", "HELP"]);
+        assert_eq!(
+            prefix(&["GEORADIUS", "k", "0", "0", "1", "km", "STORE", "d"]),
+            ["GEORADIUS", "t1:k", "0", "0", "1", "km", "STORE", "t1:d"]
+        );
+        assert_eq!(
+            prefix(&[
+                "SORT", "k", "BY", "w_*", "LIMIT", "0", "5", "GET", "#", "GET", "o_*", "STORE", "d"
+            ]),
+            [
+                "SORT", "t1:k", "BY", "t1:w_*", "LIMIT", "0", "5", "GET", "#", "GET", "t1:o_*",
+                "STORE", "t1:d"
+            ]
+        );
+        assert_eq!(
+            prefix(&["MIGRATE", "h", "6379", "", "0", "5000", "KEYS", "a", "b"]),
+            [
+                "MIGRATE", "h", "6379", "", "0", "5000", "KEYS", "t1:a", "t1:b"
+            ]
+        );
+        assert_eq!(prefix(&["PING"]), ["PING"]);
+        assert_eq!(
+            prefix(&["FT.SEARCH", "idx", "*"]),
+            ["FT.SEARCH", "idx", "*"]
+        );
+    }
+
+    #[test]
+    fn test_prefix_command_patterns() {
+        assert_eq!(prefix(&["KEYS", "user:*"]), ["KEYS", "t1:user:*"]);
+        assert_eq!(prefix(&["SCAN", "0"]), ["SCAN", "0", "MATCH", "t1:*"]);
+        assert_eq!(
+            prefix(&["SCAN", "0", "COUNT", "10", "MATCH", "a*"]),
+            ["SCAN", "0", "COUNT", "10", "MATCH", "t1:a*"]
+        );
+        assert_eq!(
+            prefix_pattern(Some(b"a".as_slice()), b"t[1]*:"),
+            b"t\\[1\\]\\*:a".to_vec()
+        );
+    }
+
+    #[test]
+    fn test_prefix_command_keeps_flags() {
+        let mut cmd = redis::cmd("GET");
+        cmd.arg("k").set_read_only_hint(true).set_fenced(true);
+        let prefixed = prefix_command(&cmd, b"t1:").unwrap();
+        assert!(prefixed.read_only_hint());
+        assert!(prefixed.is_fenced());
+    }
+
+    #[test]
+    fn test_prefix_pipeline() {
+        let mut pipeline = Pipeline::new();
+        pipeline.atomic();
+        pipeline.add_command(redis::cmd("PING"));
+        let mut get = redis::cmd("GET");
+        get.arg("k");
+        pipeline.add_command(get);
+        let prefixed = prefix_pipeline(&pipeline, b"t1:").unwrap();
+        assert!(prefixed.is_atomic());
+        let commands: Vec<_> = prefixed.cmd_iter().map(|cmd| args(cmd)).collect();
+        assert_eq!(commands, [vec!["PING"], vec!["GET", "t1:k"]]);
+
+        let mut pipeline = Pipeline::new();
+        pipeline.add_command(redis::cmd("PING"));
+        assert!(prefix_pipeline(&pipeline, b"t1:").is_none());
+    }
+
+    #[test]
+    fn test_strip_response() {
+        let bulk = |s: &str| Value::BulkString(s.as_bytes().to_vec());
+
+        let scan = redis::cmd("SCAN");
+        let response = Value::Array(vec![
+            bulk("0"),
+            Value::Array(vec![bulk("t1:a"), bulk("t1:b")]),
+        ]);
+        assert_eq!(
+            strip_response(&scan, response, b"t1:"),
+            Value::Array(vec![bulk("0"), Value::Array(vec![bulk("a"), bulk("b")])])
+        );
+
+        let blpop = redis::cmd("BLPOP");
+        let response = Value::Array(vec![bulk("t1:list"), bulk("value")]);
+        assert_eq!(
+            strip_response(&blpop, response, b"t1:"),
+            Value::Array(vec![bulk("list"), bulk("value")])
+        );
+        assert_eq!(strip_response(&blpop, Value::Nil, b"t1:"), Value::Nil);
+
+        let randomkey = redis::cmd("RANDOMKEY");
+        assert_eq!(strip_response(&randomkey, bulk("t1:k"), b"t1:"), bulk("k"));
+        assert_eq!(strip_response(&randomkey, bulk("t2:k"), b"t1:"), Value::Nil);
+
+        let xread = redis::cmd("XREAD");
+        let response = Value::Map(vec![(bulk("t1:s"), Value::Array(vec![]))]);
+        assert_eq!(
+            strip_response(&xread, response, b"t1:"),
+            Value::Map(vec![(bulk("s"), Value::Array(vec![]))])
+        );
+
+        let get = redis::cmd("GET");
+        assert_eq!(strip_response(&get, bulk("t1:v"), b"t1:"), bulk("t1:v"));
+    }
+}
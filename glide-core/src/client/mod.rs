@@ -24,7 +24,7 @@ use redis::{
 pub use standalone_client::StandaloneClient;
 use std::io;
 use std::sync::Arc;
-use std::sync::atomic::{AtomicIsize, Ordering};
+use std::sync::atomic::{AtomicIsize, AtomicU64, Ordering};
 use std::thread;
 use std::thread::JoinHandle;
 use std::time::Duration;
@@ -32,6 +32,8 @@ use tokio::runtime::{Builder, Handle};
 pub use types::*;
 
 use self::value_conversion::{convert_to_expected_type, expected_type_for_cmd, get_value_type};
+mod blocking_failover;
+mod key_prefix;
 mod reconnecting_connection;
 mod standalone_client;
 mod value_conversion;
@@ -251,7 +253,8 @@ pub struct LazyClient {
 #[derive(Clone)]
 pub struct Client {
     internal_client: Arc<RwLock<ClientWrapper>>,
-    request_timeout: Duration,
+    /// The request timeout in milliseconds, shared by the clones of the client so that it can be updated at runtime.
+    request_timeout_ms: Arc<AtomicU64>,
     // Setting this counter to limit the inflight requests, in case of any queue is blocked, so we return error to the customer.
     inflight_requests_allowed: Arc<AtomicIsize>,
     // IAM token manager for automatic credential refresh
@@ -260,6 +263,10 @@ pub struct Client {
     compression_manager: Option<Arc<CompressionManager>>,
     pubsub_synchronizer: Arc<dyn PubSubSynchronizer>,
     otel_metadata: types::OTelMetadata,
+    // Optional prefix of the keys, added to the keys of the commands and stripped from the keys of the responses.
+    key_prefix: Option<Arc<[u8]>>,
+    // Whether the blocking commands interrupted by a failover are re-issued with the rest of their timeout.
+    reissue_blocking_commands_on_failover: bool,
 }
 
 async fn run_with_timeout<T>(
@@ -287,6 +294,7 @@ async fn run_with_timeout<T>(
 /// Extension to the request timeout for blocking commands to ensure we won't return with timeout error before the server responded
 const BLOCKING_CMD_TIMEOUT_EXTENSION: f64 = 0.5; // seconds
 
+#[derive(Clone, Copy, Debug, PartialEq)]
 enum TimeUnit {
     Milliseconds = 1000,
     Seconds = 1,
@@ -366,19 +374,30 @@ fn get_timeout_from_cmd_arg(
     }
 }
 
-fn get_request_timeout(cmd: &Cmd, default_timeout: Duration) -> RedisResult<Option<Duration>> {
+/// Returns the index and the unit of the timeout argument of the commands blocking until keys are ready, or `None` if
+/// `cmd` is not such a command, e.g. an `XREAD` without `BLOCK`.
+fn blocking_timeout_arg(cmd: &Cmd) -> Option<(usize, TimeUnit)> {
     let command = cmd.command().unwrap_or_default();
-    let timeout = match command.as_slice() {
+    match command.as_slice() {
         b"BLPOP" | b"BRPOP" | b"BLMOVE" | b"BZPOPMAX" | b"BZPOPMIN" | b"BRPOPLPUSH" => {
-            get_timeout_from_cmd_arg(cmd, cmd.args_iter().len() - 1, TimeUnit::Seconds)
+            Some((cmd.args_iter().len() - 1, TimeUnit::Seconds))
         }
-        b"BLMPOP" | b"BZMPOP" => get_timeout_from_cmd_arg(cmd, 1, TimeUnit::Seconds),
+        b"BLMPOP" | b"BZMPOP" => Some((1, TimeUnit::Seconds)),
         b"XREAD" | b"XREADGROUP" => cmd
             .position(b"BLOCK")
-            .map(|idx| get_timeout_from_cmd_arg(cmd, idx + 1, TimeUnit::Milliseconds))
-            .unwrap_or(Ok(RequestTimeoutOption::ClientConfig)),
-        b"WAIT" => get_timeout_from_cmd_arg(cmd, 2, TimeUnit::Milliseconds),
-        _ => Ok(RequestTimeoutOption::ClientConfig),
+            .map(|idx| (idx + 1, TimeUnit::Milliseconds)),
+        _ => None,
+    }
+}
+
+fn get_request_timeout(cmd: &Cmd, default_timeout: Duration) -> RedisResult<Option<Duration>> {
+    let command = cmd.command().unwrap_or_default();
+    let timeout = match blocking_timeout_arg(cmd) {
+        Some((timeout_idx, time_unit)) => get_timeout_from_cmd_arg(cmd, timeout_idx, time_unit),
+        None if command.as_slice() == b"WAIT" => {
+            get_timeout_from_cmd_arg(cmd, 2, TimeUnit::Milliseconds)
+        }
+        None => Ok(RequestTimeoutOption::ClientConfig),
     }?;
 
     match timeout {
@@ -390,6 +409,46 @@ fn get_request_timeout(cmd: &Cmd, default_timeout: Duration) -> RedisResult<Opti
     }
 }
 
+/// Sends `cmd` to the server, routed according to `routing` in cluster mode.
+async fn send_to_server(
+    client: &mut ClientWrapper,
+    cmd: &Cmd,
+    routing: Option<RoutingInfo>,
+) -> RedisResult<Value> {
+    match client {
+        ClientWrapper::Standalone(client) => client.send_command(cmd).await,
+        ClientWrapper::Cluster { client } => {
+            let final_routing = if let Some(RoutingInfo::SingleNode(
+                SingleNodeRoutingInfo::Random,
+            )) = routing
+            {
+                let cmd_name = cmd.command().unwrap_or_default();
+                let cmd_name = String::from_utf8_lossy(&cmd_name);
+                if redis::cluster_routing::is_readonly(cmd) {
+                    // A read-only command, go ahead and send it to a random node
+                    RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random)
+                } else {
+                    // A "Random" node was selected, but the command is a "@write" command
+                    // change the routing to "RandomPrimary"
+                    log_warn(
+                        "send_command",
+                        format!(
+                            "User provided 'Random' routing which is not suitable for the writeable command '{cmd_name}'. Changing it to 'RandomPrimary'"
+                        ),
+                    );
+                    RoutingInfo::SingleNode(SingleNodeRoutingInfo::RandomPrimary)
+                }
+            } else {
+                routing
+                    .or_else(|| RoutingInfo::for_routable(cmd))
+                    .unwrap_or(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random))
+            };
+            client.route_command(cmd, final_routing).await
+        }
+        ClientWrapper::Lazy(_) => unreachable!("Lazy client should have been initialized"),
+    }
+}
+
 impl Client {
     /// Checks if the given command is a SELECT command.
     /// Returns true if the command is "SELECT", false otherwise.
@@ -840,12 +899,18 @@ impl Client {
 
             let client = self.get_or_initialize_client().await?;
 
+            let key_prefix = self.key_prefix.clone();
+            let prefixed_cmd = key_prefix
+                .as_deref()
+                .and_then(|prefix| key_prefix::prefix_command(cmd, prefix));
+            let cmd: &Cmd = prefixed_cmd.as_ref().unwrap_or(&*cmd);
+
             if let Some(result) = self.pubsub_synchronizer.intercept_pubsub_command(cmd).await {
                 return result;
             }
 
             // let expected_type = expected_type_for_cmd(cmd);
-            let request_timeout = match get_request_timeout(cmd, self.request_timeout) {
+            let request_timeout = match get_request_timeout(cmd, self.request_timeout()) {
                 Ok(request_timeout) => request_timeout,
                 Err(err) => return Err(err),
             };
@@ -853,49 +918,47 @@ impl Client {
             // Clone compression_manager reference before moving into async block
             let compression_manager = self.compression_manager.clone();
 
+            // The blocking commands are re-issued as they are interrupted by a failover, with the rest of their timeout
+            let reissued_timeout_arg = self
+                .reissue_blocking_commands_on_failover
+                .then(|| blocking_timeout_arg(cmd))
+                .flatten();
+
             let result = run_with_timeout(request_timeout, async move {
                 let expected_type = expected_type_for_cmd(cmd);
-                let value  = match client {
-                    ClientWrapper::Standalone(mut client) => client.send_command(cmd).await,
-                    ClientWrapper::Cluster {mut client } => {
-                        let final_routing =
-                            if let Some(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random)) =
-                                routing
-                            {
-                                let cmd_name = cmd.command().unwrap_or_default();
-                                let cmd_name = String::from_utf8_lossy(&cmd_name);
-                                if redis::cluster_routing::is_readonly_cmd(cmd_name.as_bytes()) {
-                                // A read-only command, go ahead and send it to a random node
-                                    RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random)
-                                } else {
-                                // A "Random" node was selected, but the command is a "@write" command
-                                // change the routing to "RandomPrimary"
-                                    log_warn(
-                                        "send_command",
-                                        format!(
-                                            "User provided 'Random' routing which is not suitable for the writeable command '{cmd_name}'. Changing it to 'RandomPrimary'"
-                                        ),
-                                    );
-                                    RoutingInfo::SingleNode(SingleNodeRoutingInfo::RandomPrimary)
-                                }
-                            } else {
-                                routing
-                                    .or_else(|| RoutingInfo::for_routable(cmd))
-                                    .unwrap_or(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random))
-                            };
-                        client.route_command(cmd, final_routing).await
-                    },
-                    ClientWrapper::Lazy(_) => unreachable!("Lazy client should have been initialized"),
+                let value = match reissued_timeout_arg {
+                    Some((timeout_idx, time_unit)) => {
+                        blocking_failover::send_reissuing_on_failover(
+                            cmd,
+                            timeout_idx,
+                            time_unit,
+                            |cmd| {
+                                let mut client = client.clone();
+                                let routing = routing.clone();
+                                async move { send_to_server(&mut client, &cmd, routing).await }
+                            },
+                        )
+                        .await
+                    }
+                    None => {
+                        let mut client = client;
+                        send_to_server(&mut client, cmd, routing).await
+                    }
                 }
                 .and_then(|value| {
+                    let value = match key_prefix.as_deref() {
+                        Some(prefix) => key_prefix::strip_response(cmd, value, prefix),
+                        None => value,
+                    };
                     // Apply decompression if compression manager is available
-                    let processed_value = if let Some(ref compression_manager) = compression_manager {
+                    let processed_value = if let Some(ref compression_manager) = compression_manager
+                    {
                         // Extract request type from command for decompression
                         if let Some(request_type) = extract_request_type_from_cmd(cmd) {
                             match crate::compression::process_response_for_decompression(
                                 value.clone(),
                                 request_type,
-                                Some(compression_manager.as_ref())
+                                Some(compression_manager.as_ref()),
                             ) {
                                 Ok(decompressed_value) => decompressed_value,
                                 Err(e) => {
@@ -963,7 +1026,13 @@ impl Client {
     ) -> RedisResult<Value> {
         // Clone arguments before the async block (ScanStateRC is Arc, clone is cheap)
         let scan_state_cursor_clone = scan_state_cursor.clone();
-        let cluster_scan_args_clone = cluster_scan_args.clone(); // Assuming ClusterScanArgs is Clone
+        let mut cluster_scan_args_clone = cluster_scan_args.clone(); // Assuming ClusterScanArgs is Clone
+        if let Some(prefix) = self.key_prefix.as_deref() {
+            cluster_scan_args_clone.match_pattern = Some(key_prefix::prefix_pattern(
+                cluster_scan_args_clone.match_pattern.as_deref(),
+                prefix,
+            ));
+        }
 
         // Check and initialize if lazy *inside* the async block
         let client = self.get_or_initialize_client().await?;
@@ -981,6 +1050,13 @@ impl Client {
                 } else {
                     Value::BulkString(insert_cluster_scan_cursor(cursor).into())
                 };
+                let keys = match self.key_prefix.as_deref() {
+                    Some(prefix) => keys
+                        .into_iter()
+                        .map(|key| key_prefix::strip_key(key, prefix))
+                        .collect(),
+                    None => keys,
+                };
                 Ok(Value::Array(vec![cluster_cursor_id, Value::Array(keys)]))
             }
             // Lazy case is now handled by the initial check
@@ -994,6 +1070,7 @@ impl Client {
         command_count: usize,
         offset: usize,
         raise_on_error: bool,
+        key_prefix: Option<&[u8]>,
     ) -> RedisResult<Value> {
         assert_eq!(values.len(), 1);
         let value = values.pop();
@@ -1027,6 +1104,7 @@ impl Client {
             values,
             command_count,
             raise_on_error,
+            key_prefix,
         )
     }
 
@@ -1035,6 +1113,7 @@ impl Client {
         values: Vec<Value>,
         command_count: usize,
         raise_on_error: bool,
+        key_prefix: Option<&[u8]>,
     ) -> RedisResult<Value> {
         let values = values
             .into_iter()
@@ -1045,12 +1124,14 @@ impl Client {
                     Ok(value)
                 }
             })
-            .zip(
-                pipeline
-                    .cmd_iter()
-                    .map(|cmd| expected_type_for_cmd(cmd.as_ref())),
-            )
-            .map(|(value, expected_type)| convert_to_expected_type(value?, expected_type))
+            .zip(pipeline.cmd_iter())
+            .map(|(value, cmd)| {
+                let value = match key_prefix {
+                    Some(prefix) => key_prefix::strip_response(cmd, value?, prefix),
+                    None => value?,
+                };
+                convert_to_expected_type(value, expected_type_for_cmd(cmd.as_ref()))
+            })
             .try_fold(
                 Vec::with_capacity(command_count),
                 |mut acc, result| -> RedisResult<_> {
@@ -1074,6 +1155,12 @@ impl Client {
         Box::pin(async move {
             let client = self.get_or_initialize_client().await?;
 
+            let key_prefix = self.key_prefix.clone();
+            let prefixed_pipeline = key_prefix
+                .as_deref()
+                .and_then(|prefix| key_prefix::prefix_pipeline(pipeline, prefix));
+            let pipeline = prefixed_pipeline.as_ref().unwrap_or(pipeline);
+
             let command_count = pipeline.cmd_iter().count();
             // The offset is set to command_count + 1 to account for:
             // 1. The first command, which is the "MULTI" command, that returns "OK"
@@ -1083,7 +1170,7 @@ impl Client {
             let offset = command_count + 1;
 
             run_with_timeout(
-                Some(to_duration(transaction_timeout, self.request_timeout)),
+                Some(to_duration(transaction_timeout, self.request_timeout())),
                 async move {
                     match client {
                         ClientWrapper::Standalone(mut client) => {
@@ -1094,6 +1181,7 @@ impl Client {
                                 command_count,
                                 offset,
                                 raise_on_error,
+                                key_prefix.as_deref(),
                             )
                         }
                         ClientWrapper::Cluster { mut client } => {
@@ -1115,6 +1203,7 @@ impl Client {
                                 command_count,
                                 offset,
                                 raise_on_error,
+                                key_prefix.as_deref(),
                             )
                         }
                         ClientWrapper::Lazy(_) => {
@@ -1151,6 +1240,12 @@ impl Client {
         Box::pin(async move {
             let client = self.get_or_initialize_client().await?;
 
+            let key_prefix = self.key_prefix.clone();
+            let prefixed_pipeline = key_prefix
+                .as_deref()
+                .and_then(|prefix| key_prefix::prefix_pipeline(pipeline, prefix));
+            let pipeline = prefixed_pipeline.as_ref().unwrap_or(pipeline);
+
             let command_count = pipeline.cmd_iter().count();
             if pipeline.is_empty() {
                 return Err(RedisError::from((
@@ -1160,7 +1255,7 @@ impl Client {
             }
 
             run_with_timeout(
-                Some(to_duration(pipeline_timeout, self.request_timeout)),
+                Some(to_duration(pipeline_timeout, self.request_timeout())),
                 async move {
                     let values = match client {
                         ClientWrapper::Standalone(mut client) => {
@@ -1200,6 +1295,7 @@ impl Client {
                         values,
                         command_count,
                         raise_on_error,
+                        key_prefix.as_deref(),
                     )
                 },
             )
@@ -1272,7 +1368,7 @@ impl Client {
         password: Option<String>,
         immediate_auth: bool,
     ) -> RedisResult<Value> {
-        let timeout = self.request_timeout;
+        let timeout = self.request_timeout();
         // The password update operation is wrapped in a timeout to prevent it from blocking indefinitely.
         // If the operation times out, an error is returned.
         // Since the password update operation is not a command that go through the regular command pipeline,
@@ -1305,6 +1401,89 @@ impl Client {
         }
     }
 
+    /// Update the settings of the client that can be changed while it is running, without recreating it and dropping
+    /// its connections: the request timeout, the read strategy and the backoff of the reconnection attempts.
+    /// The settings that are `None` in `update` are left unchanged.
+    /// The read strategy is validated before anything is updated, so that a rejected update leaves the client unchanged.
+    pub async fn update_config(&mut self, update: ConfigUpdate) -> RedisResult<Value> {
+        if update.request_timeout == Some(0) {
+            return Err(RedisError::from((
+                ErrorKind::UserOperationError,
+                "The request timeout must be positive",
+            )));
+        }
+        let retry_strategy = update.connection_retry_strategy.map(to_retry_strategy);
+
+        if update.read_from.is_some() || retry_strategy.is_some() {
+            let mut client = self.get_or_initialize_client().await?;
+            match client {
+                ClientWrapper::Standalone(ref client) => {
+                    if let Some(read_from) = update.read_from {
+                        client.update_read_from(read_from)?;
+                    }
+                    if let Some(retry_strategy) = retry_strategy {
+                        client.update_retry_strategy(retry_strategy)?;
+                    }
+                }
+                ClientWrapper::Cluster { ref mut client } => {
+                    if let Some(read_from) = update.read_from {
+                        client
+                            .update_read_from_replicas(read_from_replica_strategy(read_from))
+                            .await?;
+                    }
+                    if let Some(retry_strategy) = retry_strategy {
+                        client
+                            .update_reconnect_retry_strategy(retry_strategy)
+                            .await?;
+                    }
+                }
+                ClientWrapper::Lazy(_) => unreachable!("Lazy client should have been initialized"),
+            }
+        }
+
+        if let Some(request_timeout) = update.request_timeout {
+            self.request_timeout_ms
+                .store(request_timeout as u64, Ordering::Relaxed);
+        }
+        Ok(Value::Okay)
+    }
+
+    /// The timeout of the requests that do not set their own timeout.
+    fn request_timeout(&self) -> Duration {
+        Duration::from_millis(self.request_timeout_ms.load(Ordering::Relaxed))
+    }
+
+    /// Re-authenticate all the connections with the given credentials using the `AUTH` command, and use them for
+    /// future reconnections.
+    /// If `username` is None, the connections are authenticated as the default user.
+    /// The stored credentials are only updated once all the connections were authenticated, so a failed update
+    /// leaves the client with its previous credentials.
+    pub async fn update_connection_credentials(
+        &mut self,
+        username: Option<String>,
+        password: String,
+    ) -> RedisResult<Value> {
+        if password.is_empty() {
+            return Err(RedisError::from((
+                ErrorKind::UserOperationError,
+                "Empty password provided for authentication",
+            )));
+        }
+
+        let routing = RoutingInfo::MultiNode((
+            MultipleNodeRoutingInfo::AllNodes,
+            Some(ResponsePolicy::AllSucceeded),
+        ));
+
+        // The username is always passed, so that `AUTH` switches the connections to the default user even if they
+        // were authenticated as another user.
+        let mut cmd = redis::cmd("AUTH");
+        cmd.arg(username.as_deref().unwrap_or("default"));
+        cmd.arg(password);
+        // A successful `AUTH` command updates the stored credentials, see `handle_auth_command`.
+        self.send_command(&mut cmd, Some(routing)).await
+    }
+
     /// Send AUTH command using IAM token (preferred) or the provided password
     async fn send_immediate_auth(&mut self, password: Option<String>) -> RedisResult<Value> {
         // Determine the password to use for authentication
@@ -1495,6 +1674,26 @@ fn to_duration(time_in_millis: Option<u32>, default: Duration) -> Duration {
         .unwrap_or(default)
 }
 
+fn read_from_replica_strategy(read_from: ReadFrom) -> ReadFromReplicaStrategy {
+    match read_from {
+        ReadFrom::AZAffinity(az) => ReadFromReplicaStrategy::AZAffinity(az),
+        ReadFrom::AZAffinityReplicasAndPrimary(az) => {
+            ReadFromReplicaStrategy::AZAffinityReplicasAndPrimary(az)
+        }
+        ReadFrom::PreferReplica => ReadFromReplicaStrategy::RoundRobin,
+        ReadFrom::Primary => ReadFromReplicaStrategy::AlwaysFromPrimary,
+    }
+}
+
+fn to_retry_strategy(strategy: ConnectionRetryStrategy) -> RetryStrategy {
+    RetryStrategy::new(
+        strategy.exponent_base,
+        strategy.factor,
+        strategy.number_of_retries,
+        strategy.jitter_percent,
+    )
+}
+
 async fn create_cluster_client(
     request: ConnectionRequest,
     push_sender: Option<mpsc::UnboundedSender<PushInfo>>,
@@ -1579,16 +1778,11 @@ async fn create_cluster_client(
 
     let mut builder = redis::cluster::ClusterClientBuilder::new(initial_nodes)
         .connection_timeout(connection_timeout)
-        .retries(DEFAULT_RETRIES);
-    let read_from_strategy = request.read_from.unwrap_or_default();
-    builder = builder.read_from(match read_from_strategy {
-        ReadFrom::AZAffinity(az) => ReadFromReplicaStrategy::AZAffinity(az),
-        ReadFrom::AZAffinityReplicasAndPrimary(az) => {
-            ReadFromReplicaStrategy::AZAffinityReplicasAndPrimary(az)
-        }
-        ReadFrom::PreferReplica => ReadFromReplicaStrategy::RoundRobin,
-        ReadFrom::Primary => ReadFromReplicaStrategy::AlwaysFromPrimary,
-    });
+        .retries(DEFAULT_RETRIES)
+        .max_redirects(request.max_redirects.unwrap_or(DEFAULT_RETRIES));
+    builder = builder.read_from(read_from_replica_strategy(
+        request.read_from.unwrap_or_default(),
+    ));
     if let Some(interval_duration) = periodic_topology_checks {
         builder = builder.periodic_topology_checks(interval_duration);
     }
@@ -1612,21 +1806,39 @@ async fn create_cluster_client(
         }
     }
 
-    let retry_strategy = match request.connection_retry_strategy {
-        Some(strategy) => RetryStrategy::new(
-            strategy.exponent_base,
-            strategy.factor,
-            strategy.number_of_retries,
-            strategy.jitter_percent,
-        ),
-        None => RetryStrategy::default(),
-    };
+    let retry_strategy = request
+        .connection_retry_strategy
+        .map(to_retry_strategy)
+        .unwrap_or_default();
     builder = builder.reconnect_retry_strategy(retry_strategy);
 
     builder =
         builder.refresh_topology_from_initial_nodes(request.refresh_topology_from_initial_nodes);
+    builder = builder.race_initial_nodes(request.race_dialing);
 
     builder = builder.tcp_nodelay(request.tcp_nodelay);
+    builder = builder.tcp_socket_options(request.tcp_socket_options);
+    if let Some(max_response_size) = request.max_response_size {
+        builder = builder.max_response_size(max_response_size);
+    }
+    if let Some(write_coalescing_window) = request.write_coalescing_window {
+        builder = builder.write_coalescing_window(write_coalescing_window);
+    }
+    if let Some(client_tracking_prefixes) = request.client_tracking_prefixes {
+        builder = builder.client_tracking_prefixes(client_tracking_prefixes);
+    }
+
+    if let Some(circuit_breaker) = request.circuit_breaker {
+        builder = builder.circuit_breaker(circuit_breaker);
+    }
+
+    if let Some(dns_resolution) = request.dns_resolution {
+        builder = builder.dns_resolution(dns_resolution);
+    }
+
+    if let Some(connection_event_listener) = request.connection_event_listener {
+        builder = builder.connection_event_listener(connection_event_listener);
+    }
 
     // Always use with Glide
     builder = builder.periodic_connections_checks(Some(CONNECTION_CHECKS_INTERVAL));
@@ -1819,8 +2031,30 @@ fn sanitized_request_string(request: &ConnectionRequest) -> String {
         request.inflight_requests_limit,
     );
 
+    let max_redirects = format_optional_value("Max redirects", request.max_redirects);
+
+    let circuit_breaker = format_optional_value(
+        "Circuit breaker",
+        request.circuit_breaker.map(|config| {
+            format!(
+                "failure threshold: {}, cooldown: {:?}",
+                config.failure_threshold, config.cooldown
+            )
+        }),
+    );
+
+    let dns_resolution = format_optional_value(
+        "DNS resolution",
+        request.dns_resolution.map(|config| {
+            format!(
+                "TTL: {:?}, prefer hostname: {}",
+                config.ttl, config.prefer_hostname
+            )
+        }),
+    );
+
     format!(
-        "\nAddresses: {addresses}{tls_mode}{cluster_mode}{request_timeout}{connection_timeout}{rfr_strategy}{connection_retry_strategy}{database_id}{protocol}{client_name}{periodic_checks}{pubsub_subscriptions}{inflight_requests_limit}",
+        "\nAddresses: {addresses}{tls_mode}{cluster_mode}{request_timeout}{connection_timeout}{rfr_strategy}{connection_retry_strategy}{database_id}{protocol}{client_name}{periodic_checks}{pubsub_subscriptions}{inflight_requests_limit}{max_redirects}{circuit_breaker}{dns_resolution}",
     )
 }
 
@@ -1918,12 +2152,15 @@ impl Client {
             // Create the Client first without IAM token manager
             let client = Self {
                 internal_client: internal_client_arc.clone(),
-                request_timeout,
+                request_timeout_ms: Arc::new(AtomicU64::new(request_timeout.as_millis() as u64)),
                 inflight_requests_allowed,
                 compression_manager: compression_manager.clone(),
                 iam_token_manager: None,
                 pubsub_synchronizer: pubsub_synchronizer.clone(),
                 otel_metadata,
+                key_prefix: request.key_prefix.clone().map(Arc::from),
+                reissue_blocking_commands_on_failover: request
+                    .reissue_blocking_commands_on_failover,
             };
 
             let client_arc = Arc::new(RwLock::new(client));
@@ -2348,7 +2585,7 @@ mod tests {
 
         Client {
             internal_client: Arc::new(RwLock::new(ClientWrapper::Lazy(Box::new(lazy_client)))),
-            request_timeout: Duration::from_millis(250),
+            request_timeout_ms: Arc::new(AtomicU64::new(250)),
             inflight_requests_allowed: Arc::new(AtomicIsize::new(1000)),
             iam_token_manager: None,
             compression_manager: None,
@@ -2360,6 +2597,8 @@ mod tests {
                 },
                 db_namespace: "0".to_string(),
             },
+            key_prefix: None,
+            reissue_blocking_commands_on_failover: false,
         }
     }
 
@@ -5,10 +5,13 @@ use async_trait::async_trait;
 use futures_intrusive::sync::ManualResetEvent;
 use logger_core::{log_debug, log_error, log_trace, log_warn};
 use redis::aio::{DisconnectNotifier, MultiplexedConnection};
+use redis::dns_resolution::DnsResolver;
 use redis::{
-    GlideConnectionOptions, PushInfo, RedisConnectionInfo, RedisError, RedisResult, RetryStrategy,
+    ConnectionAddr, ConnectionEvent, ConnectionEventListener, GlideConnectionOptions, PushInfo,
+    RedisConnectionInfo, RedisError, RedisResult, RetryStrategy, TcpSocketOptions,
 };
 use std::fmt;
+use std::net::IpAddr;
 use std::sync::Arc;
 use std::sync::Mutex;
 use std::sync::atomic::{AtomicBool, Ordering};
@@ -56,7 +59,11 @@ enum ConnectionState {
 
 struct InnerReconnectingConnection {
     state: Mutex<ConnectionState>,
+    /// The IP of the current connection, if it is a TCP connection.
+    connected_ip: Mutex<Option<IpAddr>>,
     backend: ConnectionBackend,
+    /// The backoff of the reconnection attempts, which can be updated while the client is running.
+    retry_strategy: Mutex<RetryStrategy>,
 }
 
 #[derive(Clone)]
@@ -74,14 +81,14 @@ impl fmt::Debug for ReconnectingConnection {
 async fn get_multiplexed_connection(
     client: &redis::Client,
     connection_options: &GlideConnectionOptions,
-) -> RedisResult<MultiplexedConnection> {
+) -> RedisResult<(MultiplexedConnection, Option<IpAddr>)> {
     run_with_timeout(
         Some(
             connection_options
                 .connection_timeout
                 .unwrap_or(DEFAULT_CONNECTION_TIMEOUT),
         ),
-        client.get_multiplexed_async_connection(connection_options.clone()),
+        client.get_multiplexed_async_connection_ip(connection_options.clone()),
     )
     .await
 }
@@ -117,6 +124,7 @@ impl TokioDisconnectNotifier {
     }
 }
 
+#[allow(clippy::too_many_arguments)]
 async fn create_connection(
     connection_backend: ConnectionBackend,
     retry_strategy: RetryStrategy,
@@ -124,7 +132,13 @@ async fn create_connection(
     discover_az: bool,
     connection_timeout: Duration,
     tcp_nodelay: bool,
+    tcp_socket_options: TcpSocketOptions,
+    max_response_size: Option<usize>,
+    write_coalescing_window: Option<Duration>,
+    client_tracking_prefixes: Option<Vec<String>>,
     pubsub_synchronizer: Option<Arc<dyn crate::pubsub::PubSubSynchronizer>>,
+    connection_event_listener: Option<Arc<dyn ConnectionEventListener>>,
+    connect_in_background: bool,
 ) -> Result<ReconnectingConnection, (ReconnectingConnection, RedisError)> {
     let client = {
         let guard = connection_backend
@@ -143,13 +157,29 @@ async fn create_connection(
         connection_timeout: Some(connection_timeout),
         connection_retry_strategy: Some(retry_strategy),
         tcp_nodelay,
+        tcp_socket_options,
+        max_response_size,
+        write_coalescing_window,
+        client_tracking_prefixes,
         pubsub_synchronizer,
+        connection_event_listener,
     };
 
+    // The connection is established by the reconnect task, without waiting for it
+    if connect_in_background {
+        let connection = ReconnectingConnection::disconnected(
+            connection_backend,
+            retry_strategy,
+            connection_options,
+        );
+        connection.reconnect(ReconnectReason::CreateError);
+        return Ok(connection);
+    }
+
     // Wrap retry loop in timeout so total time respects connection_timeout
     let action = || async {
         client
-            .get_multiplexed_async_connection(connection_options.clone())
+            .get_multiplexed_async_connection_ip(connection_options.clone())
             .await
             .map_err(|e| {
                 // Don't retry errors that won't resolve with retries
@@ -171,7 +201,7 @@ async fn create_connection(
     let result = timeout(connection_timeout, retry_future).await;
 
     match result {
-        Ok(Ok(connection)) => {
+        Ok(Ok((connection, connected_ip))) => {
             log_debug(
                 "connection creation",
                 format!(
@@ -186,7 +216,9 @@ async fn create_connection(
             Ok(ReconnectingConnection {
                 inner: Arc::new(InnerReconnectingConnection {
                     state: Mutex::new(ConnectionState::Connected(connection)),
+                    connected_ip: Mutex::new(connected_ip),
                     backend: connection_backend,
+                    retry_strategy: Mutex::new(retry_strategy),
                 }),
                 connection_options,
             })
@@ -206,13 +238,11 @@ async fn create_connection(
                         .addr
                 ),
             );
-            let connection = ReconnectingConnection {
-                inner: Arc::new(InnerReconnectingConnection {
-                    state: Mutex::new(ConnectionState::InitializedDisconnected),
-                    backend: connection_backend,
-                }),
+            let connection = ReconnectingConnection::disconnected(
+                connection_backend,
+                retry_strategy,
                 connection_options,
-            };
+            );
             connection.reconnect(ReconnectReason::CreateError);
             Err((connection, err))
         }
@@ -251,7 +281,13 @@ impl ReconnectingConnection {
         connection_timeout: Duration,
         tls_params: Option<redis::TlsConnParams>,
         tcp_nodelay: bool,
+        tcp_socket_options: TcpSocketOptions,
+        max_response_size: Option<usize>,
+        write_coalescing_window: Option<Duration>,
+        client_tracking_prefixes: Option<Vec<String>>,
         pubsub_synchronizer: Option<Arc<dyn crate::pubsub::PubSubSynchronizer>>,
+        connection_event_listener: Option<Arc<dyn ConnectionEventListener>>,
+        connect_in_background: bool,
     ) -> Result<ReconnectingConnection, (ReconnectingConnection, RedisError)> {
         log_debug(
             "connection creation",
@@ -271,11 +307,34 @@ impl ReconnectingConnection {
             discover_az,
             connection_timeout,
             tcp_nodelay,
+            tcp_socket_options,
+            max_response_size,
+            write_coalescing_window,
+            client_tracking_prefixes,
             pubsub_synchronizer,
+            connection_event_listener,
+            connect_in_background,
         )
         .await
     }
 
+    /// Creates a connection that is not connected yet, in the state of a connection whose creation failed.
+    fn disconnected(
+        backend: ConnectionBackend,
+        retry_strategy: RetryStrategy,
+        connection_options: GlideConnectionOptions,
+    ) -> Self {
+        ReconnectingConnection {
+            inner: Arc::new(InnerReconnectingConnection {
+                state: Mutex::new(ConnectionState::InitializedDisconnected),
+                connected_ip: Mutex::new(None),
+                backend,
+                retry_strategy: Mutex::new(retry_strategy),
+            }),
+            connection_options,
+        }
+    }
+
     pub(crate) fn node_address(&self) -> String {
         self.inner
             .backend
@@ -356,11 +415,14 @@ impl ReconnectingConnection {
             };
 
             let infinite_backoff_dur_iterator = connection_clone
-                .connection_options
-                .connection_retry_strategy
+                .inner
+                .retry_strategy
+                .lock()
                 .unwrap()
                 .get_infinite_backoff_dur_iterator();
+            let mut attempt: u32 = 0;
             for sleep_duration in infinite_backoff_dur_iterator {
+                attempt = attempt.saturating_add(1);
                 if connection_clone.is_dropped() {
                     log_debug(
                         "ReconnectingConnection",
@@ -372,12 +434,10 @@ impl ReconnectingConnection {
                 match get_multiplexed_connection(&client, &connection_clone.connection_options)
                     .await
                 {
-                    Ok(mut connection) => {
-                        if connection
-                            .send_packed_command(&redis::cmd("PING"))
-                            .await
-                            .is_err()
+                    Ok((mut connection, connected_ip)) => {
+                        if let Err(err) = connection.send_packed_command(&redis::cmd("PING")).await
                         {
+                            connection_clone.report_failed_reconnect_attempt(attempt, &err);
                             tokio::time::sleep(sleep_duration).await;
                             continue;
                         }
@@ -390,17 +450,51 @@ impl ReconnectingConnection {
                                 .connection_available_signal
                                 .set();
                             *guard = ConnectionState::Connected(connection);
+                            *connection_clone.inner.connected_ip.lock().unwrap() = connected_ip;
                         }
 
                         Telemetry::incr_total_connections(1);
                         return;
                     }
-                    Err(_) => tokio::time::sleep(sleep_duration).await,
+                    Err(err) => {
+                        connection_clone.report_failed_reconnect_attempt(attempt, &err);
+                        tokio::time::sleep(sleep_duration).await
+                    }
                 }
             }
         });
     }
 
+    /// Reports the failure of a reconnection attempt to the connection event listener, if any.
+    fn report_failed_reconnect_attempt(&self, attempt: u32, err: &RedisError) {
+        if let Some(listener) = &self.connection_options.connection_event_listener {
+            listener.on_connection_event(ConnectionEvent::ReconnectAttempt {
+                address: self.node_address(),
+                attempt,
+                error: err.to_string(),
+            });
+        }
+    }
+
+    /// Returns true if the hostname of the node no longer resolves to the IP of the current connection, according to
+    /// `dns_resolver`.
+    pub(super) async fn is_address_stale(&self, dns_resolver: &DnsResolver) -> bool {
+        let (host, port) = match &self
+            .inner
+            .backend
+            .get_backend_client()
+            .get_connection_info()
+            .addr
+        {
+            ConnectionAddr::Tcp(host, port) | ConnectionAddr::TcpTls { host, port, .. } => {
+                (host.clone(), *port)
+            }
+            ConnectionAddr::Unix(_) => return false,
+        };
+        let connected_ip = *self.inner.connected_ip.lock().unwrap();
+        dns_resolver.is_stale(&host, port, connected_ip).await
+    }
+
     pub fn is_connected(&self) -> bool {
         !matches!(
             *self.inner.state.lock().unwrap(),
@@ -496,6 +590,16 @@ impl ReconnectingConnection {
         client.update_protocol(new_protocol);
     }
 
+    /// Updates the backoff of the reconnection attempts. A reconnection already in progress keeps its current backoff.
+    pub(crate) fn update_retry_strategy(&self, retry_strategy: RetryStrategy) {
+        *self.inner.retry_strategy.lock().unwrap() = retry_strategy;
+    }
+
+    /// Returns whether the availability zone of the node is discovered when connecting to it.
+    pub(super) fn discovers_az(&self) -> bool {
+        self.connection_options.discover_az
+    }
+
     /// Returns the username if one was configured during client creation. Otherwise, returns None.
     pub(crate) fn get_username(&self) -> Option<String> {
         let client = self.inner.backend.get_backend_client();
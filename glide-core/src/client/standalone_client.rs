@@ -8,17 +8,22 @@ use futures::{StreamExt, future, stream};
 use logger_core::log_debug;
 use logger_core::log_warn;
 use redis::aio::ConnectionLike;
-use redis::cluster_routing::{self, ResponsePolicy, Routable, RoutingInfo, is_readonly_cmd};
-use redis::{PushInfo, RedisError, RedisResult, RetryStrategy, Value};
-use std::sync::Arc;
+use redis::circuit_breaker::CircuitBreakers;
+use redis::cluster_routing::{self, ResponsePolicy, Routable, RoutingInfo};
+use redis::dns_resolution::DnsResolver;
+use redis::{ConnectionEventListener, PushInfo, RedisError, RedisResult, RetryStrategy, Value};
 use std::sync::atomic::AtomicUsize;
 use std::sync::atomic::Ordering;
+use std::sync::{Arc, RwLock};
 use std::time::Duration;
 use telemetrylib::Telemetry;
 use tokio::sync::mpsc;
 use tokio::task;
 
-#[derive(Debug)]
+const READ_LOCK_ERR: &str = "Failed to acquire the read lock";
+const WRITE_LOCK_ERR: &str = "Failed to acquire the write lock";
+
+#[derive(Clone, Debug)]
 enum ReadFrom {
     Primary,
     PreferReplica {
@@ -39,9 +44,12 @@ struct DropWrapper {
     /// Connection to the primary node in the client.
     primary_index: usize,
     nodes: Vec<ReconnectingConnection>,
-    read_from: ReadFrom,
+    /// The read strategy, which can be updated while the client is running.
+    read_from: RwLock<ReadFrom>,
     /// When true, write commands are blocked and INFO REPLICATION is skipped during connection.
     read_only: bool,
+    /// Per-node circuit breakers, if enabled.
+    circuit_breakers: Option<CircuitBreakers>,
 }
 
 impl Drop for DropWrapper {
@@ -163,6 +171,11 @@ impl StandaloneClient {
         let connection_timeout = connection_request.get_connection_timeout();
 
         let tcp_nodelay = connection_request.tcp_nodelay;
+        let tcp_socket_options = connection_request.tcp_socket_options;
+        let max_response_size = connection_request.max_response_size;
+        let write_coalescing_window = connection_request.write_coalescing_window;
+        let client_tracking_prefixes = connection_request.client_tracking_prefixes.clone();
+        let connection_event_listener = connection_request.connection_event_listener.clone();
 
         let has_root_certs = !connection_request.root_certs.is_empty();
         let has_client_cert = !connection_request.client_cert.is_empty();
@@ -221,49 +234,74 @@ impl StandaloneClient {
         };
 
         let read_only = connection_request.read_only;
+        let circuit_breakers = connection_request.circuit_breaker.map(CircuitBreakers::new);
+        let dns_resolver = connection_request
+            .dns_resolution
+            .map(|config| Arc::new(DnsResolver::new(config)));
         let addresses = connection_request.addresses.clone();
         let read_from_option = connection_request.read_from.clone();
-
-        let mut stream = stream::iter(addresses.into_iter())
-            .map(move |address| {
-                let info = valkey_connection_info.clone();
-                let retry = retry_strategy;
-                let sender = push_sender.clone();
-                let tls = tls_mode.unwrap_or(TlsMode::NoTls);
-                let discover = discover_az;
-                let timeout = connection_timeout;
-                let params = tls_params.clone();
-                let nodelay = tcp_nodelay;
-                let sync = pubsub_synchronizer.clone();
-                let skip_replication = read_only;
-                async move {
-                    get_connection_and_replication_info(
-                        &address,
-                        &retry,
-                        &info,
-                        tls,
-                        &sender,
-                        discover,
-                        timeout,
-                        params,
-                        nodelay,
-                        &sync,
-                        skip_replication,
-                    )
-                    .await
-                    .map_err(|err| (format!("{}:{}", address.host, address.port), err))
-                }
+        let race_dialing = connection_request.race_dialing;
+        let eager_connections = connection_request.eager_connections;
+
+        let connect = move |address: NodeAddress, connect_in_background: bool| {
+            let info = valkey_connection_info.clone();
+            let retry = retry_strategy;
+            let sender = push_sender.clone();
+            let tls = tls_mode.unwrap_or(TlsMode::NoTls);
+            let discover = discover_az;
+            let timeout = connection_timeout;
+            let params = tls_params.clone();
+            let nodelay = tcp_nodelay;
+            let socket_options = tcp_socket_options;
+            let response_size = max_response_size;
+            let coalescing_window = write_coalescing_window;
+            let tracking_prefixes = client_tracking_prefixes.clone();
+            let sync = pubsub_synchronizer.clone();
+            let listener = connection_event_listener.clone();
+            let skip_replication = read_only;
+            async move {
+                get_connection_and_replication_info(
+                    &address,
+                    &retry,
+                    &info,
+                    tls,
+                    &sender,
+                    discover,
+                    timeout,
+                    params,
+                    nodelay,
+                    socket_options,
+                    response_size,
+                    coalescing_window,
+                    tracking_prefixes,
+                    &sync,
+                    listener,
+                    skip_replication,
+                    connect_in_background,
+                )
+                .await
+                .map_err(|err| (format!("{}:{}", address.host, address.port), err))
+            }
+        };
+        let mut stream = stream::iter(addresses.iter().cloned().enumerate())
+            .map(|(index, address)| {
+                let connecting = connect(address, false);
+                async move { (index, connecting.await) }
             })
             .buffer_unordered(node_count);
 
         let mut nodes = Vec::with_capacity(node_count);
         let mut addresses_and_errors = Vec::with_capacity(node_count);
         let mut primary_index = if read_only { Some(0) } else { None };
+        let mut pending: Vec<bool> = vec![true; node_count];
+        let mut connected = false;
 
-        while let Some(result) = stream.next().await {
+        while let Some((index, result)) = stream.next().await {
+            pending[index] = false;
             match result {
                 Ok((connection, replication_status)) => {
                     nodes.push(connection);
+                    connected = true;
                     // Only check for primary in normal mode (when replication_status is Some)
                     // and the node reports role:master
                     let is_primary = replication_status
@@ -289,6 +327,36 @@ impl StandaloneClient {
                     addresses_and_errors.push((Some(address), err));
                 }
             }
+            // With race dialing, the client is ready as soon as the primary, or any node in read-only mode, is connected
+            let ready = if read_only {
+                connected
+            } else {
+                primary_index.is_some()
+            };
+            if race_dialing && ready {
+                break;
+            }
+        }
+        drop(stream);
+
+        // The nodes still being dialed are connected in the background. Their roles are not checked, so a second
+        // primary among them is not detected.
+        for (address, _) in addresses
+            .iter()
+            .zip(pending)
+            .filter(|(_, is_pending)| *is_pending)
+        {
+            log_debug(
+                "client creation",
+                format!("Connecting to {address} in the background"),
+            );
+            match connect(address.clone(), true).await {
+                Ok((connection, _)) => nodes.push(connection),
+                Err((address, (connection, err))) => {
+                    nodes.push(connection);
+                    addresses_and_errors.push((Some(address), err));
+                }
+            }
         }
 
         // Validate we have required connections
@@ -323,6 +391,13 @@ impl StandaloneClient {
             }
         };
 
+        // With eager connections, every address must be connected, e.g. the replicas that would be read from
+        if eager_connections && !addresses_and_errors.is_empty() {
+            return Err(StandaloneClientConnectionError::FailedConnection(
+                addresses_and_errors,
+            ));
+        }
+
         if !addresses_and_errors.is_empty() {
             log_warn(
                 "client creation",
@@ -346,7 +421,7 @@ impl StandaloneClient {
         }
 
         for node in nodes.iter() {
-            Self::start_periodic_connection_check(node.clone());
+            Self::start_periodic_connection_check(node.clone(), dns_resolver.clone());
         }
 
         // Successfully created new client. Update the telemetry
@@ -356,8 +431,9 @@ impl StandaloneClient {
             inner: Arc::new(DropWrapper {
                 primary_index,
                 nodes,
-                read_from,
+                read_from: RwLock::new(read_from),
                 read_only,
+                circuit_breakers,
             }),
         })
     }
@@ -489,28 +565,27 @@ impl StandaloneClient {
             return self.get_primary_connection();
         }
 
-        match &self.inner.read_from {
+        // Cloned so that the lock is not held while looking up the availability zones of the replicas.
+        let read_from = self.inner.read_from.read().expect(READ_LOCK_ERR).clone();
+        match read_from {
             ReadFrom::Primary => self.get_primary_connection(),
             ReadFrom::PreferReplica {
                 latest_read_replica_index,
-            } => self.round_robin_read_from_replica(latest_read_replica_index),
+            } => self.round_robin_read_from_replica(&latest_read_replica_index),
             ReadFrom::AZAffinity {
                 client_az,
                 last_read_replica_index,
             } => {
-                self.round_robin_read_from_replica_az_awareness(
-                    last_read_replica_index,
-                    client_az.to_string(),
-                )
-                .await
+                self.round_robin_read_from_replica_az_awareness(&last_read_replica_index, client_az)
+                    .await
             }
             ReadFrom::AZAffinityReplicasAndPrimary {
                 client_az,
                 last_read_replica_index,
             } => {
                 self.round_robin_read_from_replica_az_awareness_replicas_and_primary(
-                    last_read_replica_index,
-                    client_az.to_string(),
+                    &last_read_replica_index,
+                    client_az,
                 )
                 .await
             }
@@ -612,8 +687,40 @@ impl StandaloneClient {
         cmd: &redis::Cmd,
         readonly: bool,
     ) -> RedisResult<Value> {
-        let reconnecting_connection = self.get_connection(readonly).await;
-        Self::send_request(cmd, reconnecting_connection).await
+        let mut reconnecting_connection = self.get_connection(readonly).await;
+        let Some(circuit_breakers) = &self.inner.circuit_breakers else {
+            return Self::send_request(cmd, reconnecting_connection).await;
+        };
+        let mut address = reconnecting_connection.node_address();
+        if let Err(err) = circuit_breakers.check(&address) {
+            // Reads are rerouted to a replica whose circuit is not open, unless they must be served by the primary.
+            if !readonly
+                || matches!(
+                    *self.inner.read_from.read().expect(READ_LOCK_ERR),
+                    ReadFrom::Primary
+                )
+            {
+                return Err(err);
+            }
+            let primary_address = self.get_primary_connection().node_address();
+            // The candidates are only peeked at, so that only the circuit of the chosen replica lets a probe through.
+            let replica = self.inner.nodes.iter().find(|node| {
+                let node_address = node.node_address();
+                node_address != address
+                    && node_address != primary_address
+                    && circuit_breakers.is_available(&node_address)
+            });
+            let Some(replica) =
+                replica.filter(|replica| circuit_breakers.check(&replica.node_address()).is_ok())
+            else {
+                return Err(err);
+            };
+            reconnecting_connection = replica;
+            address = replica.node_address();
+        }
+        let result = Self::send_request(cmd, reconnecting_connection).await;
+        circuit_breakers.record_result(&address, &result);
+        result
     }
 
     pub async fn send_command(&mut self, cmd: &redis::Cmd) -> RedisResult<Value> {
@@ -622,7 +729,7 @@ impl StandaloneClient {
         };
 
         // Block write commands in read-only mode
-        if self.inner.read_only && !is_readonly_cmd(cmd_bytes.as_slice()) {
+        if self.inner.read_only && !cluster_routing::is_readonly(cmd) {
             return Err(RedisError::from((
                 redis::ErrorKind::ReadOnly,
                 "write commands are not allowed in read-only mode",
@@ -633,7 +740,7 @@ impl StandaloneClient {
             let response_policy = ResponsePolicy::for_command(cmd_bytes.as_slice());
             return self.send_request_to_all_nodes(cmd, response_policy).await;
         }
-        self.send_request_to_single_node(cmd, is_readonly_cmd(cmd_bytes.as_slice()))
+        self.send_request_to_single_node(cmd, cluster_routing::is_readonly(cmd))
             .await
     }
 
@@ -700,7 +807,11 @@ impl StandaloneClient {
     // Monitors passive connection status and reconnects if necessary.
     // This function is cheaper alternative to start_heartbeat(),
     // as it avoids sending PING commands to the server, checking only the connection state.
-    fn start_periodic_connection_check(reconnecting_connection: ReconnectingConnection) {
+    // With DNS re-resolution, it also reconnects when the hostname of the node resolves to a new IP.
+    fn start_periodic_connection_check(
+        reconnecting_connection: ReconnectingConnection,
+        dns_resolver: Option<Arc<DnsResolver>>,
+    ) {
         task::spawn(async move {
             loop {
                 reconnecting_connection
@@ -732,6 +843,13 @@ impl StandaloneClient {
                         "connection checker has triggered reconnect",
                     );
                     reconnecting_connection.reconnect(ReconnectReason::ConnectionDropped);
+                    continue;
+                }
+
+                if let Some(dns_resolver) = &dns_resolver {
+                    if reconnecting_connection.is_address_stale(dns_resolver).await {
+                        reconnecting_connection.reconnect(ReconnectReason::ConnectionDropped);
+                    }
                 }
             }
         });
@@ -811,6 +929,34 @@ impl StandaloneClient {
         Ok(Value::Okay)
     }
 
+    /// Update the read strategy used to route the read-only commands.
+    ///
+    /// The availability zones of the nodes are only discovered when the client was created with an AZ affinity
+    /// strategy, so switching to an AZ affinity strategy is rejected otherwise.
+    pub fn update_read_from(&self, read_from: ClientReadFrom) -> RedisResult<Value> {
+        let az_affinity = matches!(
+            read_from,
+            ClientReadFrom::AZAffinity(_) | ClientReadFrom::AZAffinityReplicasAndPrimary(_)
+        );
+        if az_affinity && !self.get_primary_connection().discovers_az() {
+            return Err(RedisError::from((
+                redis::ErrorKind::UserOperationError,
+                "AZ affinity read strategies can only be used by clients created with an AZ affinity read strategy",
+            )));
+        }
+        *self.inner.read_from.write().expect(WRITE_LOCK_ERR) = get_read_from(Some(read_from));
+        Ok(Value::Okay)
+    }
+
+    /// Update the backoff of the reconnection attempts of all the connections.
+    pub fn update_retry_strategy(&self, retry_strategy: RetryStrategy) -> RedisResult<Value> {
+        for node in self.inner.nodes.iter() {
+            node.update_retry_strategy(retry_strategy);
+        }
+
+        Ok(Value::Okay)
+    }
+
     /// Retrieve the username used to authenticate with the server.
     pub fn get_username(&self) -> Option<String> {
         // All nodes in the client should have the same username configured, thus any connection would work here.
@@ -829,8 +975,14 @@ async fn get_connection_and_replication_info(
     connection_timeout: Duration,
     tls_params: Option<redis::TlsConnParams>,
     tcp_nodelay: bool,
+    tcp_socket_options: redis::TcpSocketOptions,
+    max_response_size: Option<usize>,
+    write_coalescing_window: Option<Duration>,
+    client_tracking_prefixes: Option<Vec<String>>,
     pubsub_synchronizer: &Option<Arc<dyn crate::pubsub::PubSubSynchronizer>>,
+    connection_event_listener: Option<Arc<dyn ConnectionEventListener>>,
     skip_replication_check: bool,
+    connect_in_background: bool,
 ) -> Result<(ReconnectingConnection, Option<Value>), (ReconnectingConnection, RedisError)> {
     let reconnecting_connection = ReconnectingConnection::new(
         address,
@@ -842,10 +994,21 @@ async fn get_connection_and_replication_info(
         connection_timeout,
         tls_params,
         tcp_nodelay,
+        tcp_socket_options,
+        max_response_size,
+        write_coalescing_window,
+        client_tracking_prefixes,
         pubsub_synchronizer.clone(),
+        connection_event_listener,
+        connect_in_background,
     )
     .await?;
 
+    // The role of a node connected in the background is not known
+    if connect_in_background {
+        return Ok((reconnecting_connection, None));
+    }
+
     let mut multiplexed_connection = match reconnecting_connection.get_connection().await {
         Ok(multiplexed_connection) => multiplexed_connection,
         Err(err) => {
@@ -4,8 +4,11 @@
 use logger_core::log_warn;
 #[allow(unused_imports)]
 use std::collections::HashSet;
+use std::sync::Arc;
 use std::time::Duration;
 
+#[cfg(feature = "proto")]
+use crate::compression::AutoDecompressionFormat;
 #[cfg(feature = "proto")]
 use crate::compression::CompressionBackendType;
 use crate::compression::CompressionConfig;
@@ -15,6 +18,9 @@ use crate::iam::ServiceType;
 #[cfg(feature = "proto")]
 #[allow(unused_imports)]
 use ::protobuf::EnumOrUnknown;
+use redis::circuit_breaker::CircuitBreakerConfig;
+use redis::dns_resolution::DnsResolutionConfig;
+use redis::{ConnectionEventListener, TcpSocketOptions};
 
 #[derive(Default, Clone, Debug)]
 pub struct ConnectionRequest {
@@ -40,8 +46,22 @@ pub struct ConnectionRequest {
     pub client_key: Vec<u8>,
     pub compression_config: Option<CompressionConfig>,
     pub tcp_nodelay: bool,
+    pub tcp_socket_options: TcpSocketOptions,
+    pub max_response_size: Option<usize>,
+    pub write_coalescing_window: Option<Duration>,
+    pub client_tracking_prefixes: Option<Vec<String>>,
+    pub key_prefix: Option<Vec<u8>>,
+    pub reissue_blocking_commands_on_failover: bool,
+    pub race_dialing: bool,
+    pub eager_connections: bool,
     pub pubsub_reconciliation_interval_ms: Option<u32>,
     pub read_only: bool,
+    pub max_redirects: Option<u32>,
+    pub circuit_breaker: Option<CircuitBreakerConfig>,
+    pub dns_resolution: Option<DnsResolutionConfig>,
+    /// The listener of the lifecycle events of the user connections. Not part of the protobuf request, it is set by
+    /// the wrappers that receive the events.
+    pub connection_event_listener: Option<Arc<dyn ConnectionEventListener>>,
 }
 
 /// Default connection timeout used when not specified in the request.
@@ -147,6 +167,15 @@ pub struct ConnectionRetryStrategy {
     pub jitter_percent: Option<u32>,
 }
 
+/// The settings of a running client to update, see [`crate::client::Client::update_config`]. The settings that are
+/// `None` are left unchanged.
+#[derive(Default, Clone, Debug)]
+pub struct ConfigUpdate {
+    pub request_timeout: Option<u32>,
+    pub read_from: Option<ReadFrom>,
+    pub connection_retry_strategy: Option<ConnectionRetryStrategy>,
+}
+
 #[cfg(feature = "proto")]
 fn chars_to_string_option(chars: &::protobuf::Chars) -> Option<String> {
     if chars.is_empty() {
@@ -161,42 +190,61 @@ pub(crate) fn none_if_zero(value: u32) -> Option<u32> {
     if value == 0 { None } else { Some(value) }
 }
 
+#[cfg(feature = "proto")]
+fn read_from_protobuf(read_from: protobuf::ReadFrom, client_az: &::protobuf::Chars) -> ReadFrom {
+    match read_from {
+        protobuf::ReadFrom::Primary => ReadFrom::Primary,
+        protobuf::ReadFrom::PreferReplica => ReadFrom::PreferReplica,
+        protobuf::ReadFrom::LowestLatency => todo!(),
+        protobuf::ReadFrom::AZAffinity => {
+            if let Some(client_az) = chars_to_string_option(client_az) {
+                ReadFrom::AZAffinity(client_az)
+            } else {
+                log_warn(
+                    "types",
+                    format!(
+                        "Failed to convert availability zone string: '{client_az:?}'. Falling back to `ReadFrom::PreferReplica`"
+                    ),
+                );
+                ReadFrom::PreferReplica
+            }
+        }
+        protobuf::ReadFrom::AZAffinityReplicasAndPrimary => {
+            if let Some(client_az) = chars_to_string_option(client_az) {
+                ReadFrom::AZAffinityReplicasAndPrimary(client_az)
+            } else {
+                log_warn(
+                    "types",
+                    format!(
+                        "Failed to convert availability zone string: '{client_az:?}'. Falling back to `ReadFrom::PreferReplica`"
+                    ),
+                );
+                ReadFrom::PreferReplica
+            }
+        }
+    }
+}
+
+#[cfg(feature = "proto")]
+fn retry_strategy_from_protobuf(
+    strategy: &protobuf::ConnectionRetryStrategy,
+) -> ConnectionRetryStrategy {
+    ConnectionRetryStrategy {
+        exponent_base: strategy.exponent_base,
+        factor: strategy.factor,
+        number_of_retries: strategy.number_of_retries,
+        jitter_percent: strategy.jitter_percent,
+    }
+}
+
 #[cfg(feature = "proto")]
 impl From<protobuf::ConnectionRequest> for ConnectionRequest {
     fn from(value: protobuf::ConnectionRequest) -> Self {
-        let read_from = value.read_from.enum_value().ok().map(|val| match val {
-            protobuf::ReadFrom::Primary => ReadFrom::Primary,
-            protobuf::ReadFrom::PreferReplica => ReadFrom::PreferReplica,
-            protobuf::ReadFrom::LowestLatency => todo!(),
-            protobuf::ReadFrom::AZAffinity => {
-                if let Some(client_az) = chars_to_string_option(&value.client_az) {
-                    ReadFrom::AZAffinity(client_az)
-                } else {
-                    log_warn(
-                        "types",
-                        format!(
-                            "Failed to convert availability zone string: '{:?}'. Falling back to `ReadFrom::PreferReplica`",
-                            value.client_az
-                        ),
-                    );
-                    ReadFrom::PreferReplica
-                }
-            }
-            protobuf::ReadFrom::AZAffinityReplicasAndPrimary => {
-                if let Some(client_az) = chars_to_string_option(&value.client_az) {
-                    ReadFrom::AZAffinityReplicasAndPrimary(client_az)
-                } else {
-                    log_warn(
-                        "types",
-                        format!(
-                            "Failed to convert availability zone string: '{:?}'. Falling back to `ReadFrom::PreferReplica`",
-                            value.client_az
-                        ),
-                    );
-                    ReadFrom::PreferReplica
-                }
-            },
-        });
+        let read_from = value
+            .read_from
+            .enum_value()
+            .ok()
+            .map(|val| read_from_protobuf(val, &value.client_az));
 
         let client_name = chars_to_string_option(&value.client_name);
         let lib_name = chars_to_string_option(&value.lib_name);
@@ -251,16 +299,10 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
         let cluster_mode_enabled = value.cluster_mode_enabled;
         let request_timeout = none_if_zero(value.request_timeout);
         let connection_timeout = none_if_zero(value.connection_timeout);
-        let connection_retry_strategy =
-            value
-                .connection_retry_strategy
-                .0
-                .map(|strategy| ConnectionRetryStrategy {
-                    exponent_base: strategy.exponent_base,
-                    factor: strategy.factor,
-                    number_of_retries: strategy.number_of_retries,
-                    jitter_percent: strategy.jitter_percent,
-                });
+        let connection_retry_strategy = value
+            .connection_retry_strategy
+            .0
+            .map(|strategy| retry_strategy_from_protobuf(&strategy));
         let periodic_checks = value
             .periodic_checks
             .map(|periodic_check| match periodic_check {
@@ -336,18 +378,84 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
                 }
             };
 
+            let auto_decompression_formats = proto_config
+                .auto_decompression_formats
+                .iter()
+                .filter_map(|format| match format.enum_value() {
+                    Ok(protobuf::AutoDecompressionFormat::GZIP) => {
+                        Some(AutoDecompressionFormat::Gzip)
+                    }
+                    Ok(protobuf::AutoDecompressionFormat::ZSTD_FRAME) => {
+                        Some(AutoDecompressionFormat::Zstd)
+                    }
+                    Err(_) => {
+                        log_warn(
+                            "types",
+                            format!("Unknown auto-decompression format: {format:?}. Ignoring it"),
+                        );
+                        None
+                    }
+                })
+                .collect();
+
             CompressionConfig {
                 enabled: proto_config.enabled,
                 backend,
                 compression_level: proto_config.compression_level,
                 min_compression_size: proto_config.min_compression_size as usize,
+                auto_decompression_formats,
             }
         });
 
         let tcp_nodelay = value.tcp_nodelay.unwrap_or(true);
+        let tcp_socket_options = TcpSocketOptions {
+            keepalive_interval: value
+                .tcp_keepalive_interval_ms
+                .filter(|&ms| ms != 0)
+                .map(|ms| Duration::from_millis(ms as u64)),
+            send_buffer_size: value.tcp_send_buffer_size.filter(|&size| size != 0),
+            recv_buffer_size: value.tcp_recv_buffer_size.filter(|&size| size != 0),
+        };
+        let max_response_size = value
+            .max_response_size
+            .filter(|&size| size != 0)
+            .map(|size| size as usize);
+        let write_coalescing_window = value
+            .write_coalescing_window_us
+            .filter(|&us| us != 0)
+            .map(|us| Duration::from_micros(us as u64));
+        let client_tracking_prefixes = value.client_tracking.as_ref().map(|tracking| {
+            tracking
+                .prefixes
+                .iter()
+                .map(|prefix| prefix.to_string())
+                .collect()
+        });
+        let key_prefix = (!value.key_prefix.is_empty()).then(|| value.key_prefix.to_vec());
+        let reissue_blocking_commands_on_failover = value.reissue_blocking_commands_on_failover;
+        let race_dialing = value.race_dialing;
+        let eager_connections = value.eager_connections;
         let pubsub_reconciliation_interval_ms =
             value.pubsub_reconciliation_interval_ms.filter(|&v| v != 0);
         let read_only = value.read_only.unwrap_or(false);
+        let max_redirects = value.max_redirects;
+        let circuit_breaker =
+            value
+                .circuit_breaker
+                .as_ref()
+                .map(|proto_config| CircuitBreakerConfig {
+                    failure_threshold: proto_config.failure_threshold,
+                    cooldown: Duration::from_millis(proto_config.cooldown_ms as u64),
+                });
+        let dns_resolution =
+            value
+                .dns_resolution
+                .as_ref()
+                .map(|proto_config| DnsResolutionConfig {
+                    ttl: (proto_config.ttl_ms != 0)
+                        .then(|| Duration::from_millis(proto_config.ttl_ms as u64)),
+                    prefer_hostname: proto_config.prefer_hostname,
+                });
 
         ConnectionRequest {
             read_from,
@@ -372,17 +480,50 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
             client_key,
             compression_config,
             tcp_nodelay,
+            tcp_socket_options,
+            max_response_size,
+            write_coalescing_window,
+            client_tracking_prefixes,
+            key_prefix,
+            reissue_blocking_commands_on_failover,
+            race_dialing,
+            eager_connections,
             pubsub_reconciliation_interval_ms,
             read_only,
+            max_redirects,
+            circuit_breaker,
+            dns_resolution,
+            connection_event_listener: None,
         }
     }
 }
+#[cfg(feature = "proto")]
+impl From<protobuf::ConfigUpdate> for ConfigUpdate {
+    fn from(value: protobuf::ConfigUpdate) -> Self {
+        let read_from = value
+            .read_from
+            .and_then(|val| val.enum_value().ok())
+            .map(|val| read_from_protobuf(val, &value.client_az));
+        let connection_retry_strategy = value
+            .connection_retry_strategy
+            .0
+            .map(|strategy| retry_strategy_from_protobuf(&strategy));
+
+        ConfigUpdate {
+            request_timeout: value.request_timeout,
+            read_from,
+            connection_retry_strategy,
+        }
+    }
+}
+
 #[cfg(test)]
 mod tests {
     #[cfg(feature = "proto")]
     mod protobuf_conversion_tests {
         use crate::ConnectionRequest;
-        use crate::compression::CompressionBackendType;
+        use crate::client::{ConfigUpdate, ConnectionRetryStrategy, ReadFrom};
+        use crate::compression::{AutoDecompressionFormat, CompressionBackendType};
         use crate::connection_request as protobuf;
         use ::protobuf::EnumOrUnknown;
 
@@ -478,5 +619,71 @@ mod tests {
             // Should fall back to Zstd for unknown backends
             assert_eq!(config.backend, CompressionBackendType::Zstd);
         }
+
+        #[test]
+        fn test_compression_config_conversion_auto_decompression_formats() {
+            let mut proto_request = protobuf::ConnectionRequest::new();
+            proto_request.addresses.push(protobuf::NodeAddress {
+                host: "localhost".into(),
+                port: 6379,
+                ..Default::default()
+            });
+
+            let mut compression_config = protobuf::CompressionConfig::new();
+            compression_config.enabled = true;
+            compression_config.min_compression_size = 64;
+            compression_config.auto_decompression_formats = vec![
+                protobuf::AutoDecompressionFormat::ZSTD_FRAME.into(),
+                // Unknown formats are ignored
+                EnumOrUnknown::from_i32(999),
+                protobuf::AutoDecompressionFormat::GZIP.into(),
+            ];
+
+            proto_request.compression_config = ::protobuf::MessageField::some(compression_config);
+
+            let request: ConnectionRequest = proto_request.into();
+            let config = request.compression_config.unwrap();
+            assert_eq!(
+                config.auto_decompression_formats,
+                vec![AutoDecompressionFormat::Zstd, AutoDecompressionFormat::Gzip]
+            );
+        }
+
+        #[test]
+        fn test_config_update_conversion_empty() {
+            let update: ConfigUpdate = protobuf::ConfigUpdate::new().into();
+            assert!(update.request_timeout.is_none());
+            assert!(update.read_from.is_none());
+            assert!(update.connection_retry_strategy.is_none());
+        }
+
+        #[test]
+        fn test_config_update_conversion() {
+            let mut proto_update = protobuf::ConfigUpdate::new();
+            proto_update.request_timeout = Some(500);
+            proto_update.read_from = Some(protobuf::ReadFrom::AZAffinity.into());
+            proto_update.client_az = "us-east-1a".into();
+            let mut retry_strategy = protobuf::ConnectionRetryStrategy::new();
+            retry_strategy.number_of_retries = 3;
+            retry_strategy.factor = 10;
+            retry_strategy.exponent_base = 2;
+            proto_update.connection_retry_strategy = ::protobuf::MessageField::some(retry_strategy);
+
+            let update: ConfigUpdate = proto_update.into();
+            assert_eq!(update.request_timeout, Some(500));
+            assert_eq!(
+                update.read_from,
+                Some(ReadFrom::AZAffinity("us-east-1a".to_string()))
+            );
+            assert_eq!(
+                update.connection_retry_strategy,
+                Some(ConnectionRetryStrategy {
+                    exponent_base: 2,
+                    factor: 10,
+                    number_of_retries: 3,
+                    jitter_percent: None,
+                })
+            );
+        }
     }
 }
@@ -213,12 +213,62 @@ impl std::str::FromStr for CompressionBackendType {
     }
 }
 
+/// Standard compression formats of values written by other producers, such as clients in other languages, which are
+/// detected by their magic bytes and decompressed on read along with the values compressed by Glide.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]
+pub enum AutoDecompressionFormat {
+    Gzip,
+    Zstd,
+}
+
+impl AutoDecompressionFormat {
+    pub fn format_name(&self) -> &'static str {
+        match self {
+            AutoDecompressionFormat::Gzip => "gzip",
+            AutoDecompressionFormat::Zstd => "zstd",
+        }
+    }
+
+    /// Checks if data starts with the magic bytes of the format
+    pub fn matches(&self, data: &[u8]) -> bool {
+        match self {
+            AutoDecompressionFormat::Gzip => data.starts_with(&GZIP_MAGIC),
+            AutoDecompressionFormat::Zstd => data.starts_with(&ZSTD_FRAME_MAGIC),
+        }
+    }
+
+    pub fn decompress(&self, data: &[u8]) -> CompressionResult<Vec<u8>> {
+        let result = match self {
+            AutoDecompressionFormat::Gzip => {
+                use std::io::Read;
+
+                let mut decompressed = Vec::new();
+                flate2::read::MultiGzDecoder::new(data)
+                    .read_to_end(&mut decompressed)
+                    .map(|_| decompressed)
+            }
+            AutoDecompressionFormat::Zstd => zstd::decode_all(data),
+        };
+        result.map_err(|e| {
+            CompressionError::decompression_failed(self.format_name(), data.len(), e.to_string())
+        })
+    }
+}
+
+impl std::fmt::Display for AutoDecompressionFormat {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        write!(f, "{}", self.format_name())
+    }
+}
+
 #[derive(Debug, Clone, PartialEq)]
 pub struct CompressionConfig {
     pub enabled: bool,
     pub backend: CompressionBackendType,
     pub compression_level: Option<i32>,
     pub min_compression_size: usize,
+    /// Formats of values without the Glide header that are decompressed on read
+    pub auto_decompression_formats: Vec<AutoDecompressionFormat>,
 }
 
 impl CompressionConfig {
@@ -228,6 +278,7 @@ impl CompressionConfig {
             backend,
             compression_level: backend.default_level(),
             min_compression_size: 64,
+            auto_decompression_formats: Vec::new(),
         }
     }
 
@@ -237,6 +288,7 @@ impl CompressionConfig {
             backend: CompressionBackendType::Zstd,
             compression_level: None,
             min_compression_size: 64,
+            auto_decompression_formats: Vec::new(),
         }
     }
 
@@ -250,6 +302,14 @@ impl CompressionConfig {
         self
     }
 
+    pub fn with_auto_decompression_formats(
+        mut self,
+        formats: Vec<AutoDecompressionFormat>,
+    ) -> Self {
+        self.auto_decompression_formats = formats;
+        self
+    }
+
     pub fn validate(&self) -> CompressionResult<()> {
         if self.min_compression_size < MIN_COMPRESSED_SIZE {
             return Err(CompressionError::invalid_configuration(
@@ -380,7 +440,7 @@ impl CompressionManager {
         }
 
         if !has_magic_header(value) {
-            return Ok(value.to_vec());
+            return self.decompress_foreign_value(value);
         }
 
         // Extract backend ID from header and route to appropriate backend
@@ -409,6 +469,24 @@ impl CompressionManager {
         }
     }
 
+    /// Decompresses a value without the Glide header if it starts with the magic bytes of one of the
+    /// auto-decompression formats, such as a value compressed with gzip by a client in another language
+    fn decompress_foreign_value(&self, value: &[u8]) -> CompressionResult<Vec<u8>> {
+        let Some(format) = self
+            .config
+            .auto_decompression_formats
+            .iter()
+            .find(|format| format.matches(value))
+        else {
+            return Ok(value.to_vec());
+        };
+
+        let decompressed = format.decompress(value)?;
+        Telemetry::incr_total_values_decompressed(1);
+        Telemetry::incr_total_bytes_decompressed(decompressed.len());
+        Ok(decompressed)
+    }
+
     pub fn config(&self) -> &CompressionConfig {
         &self.config
     }
@@ -801,6 +879,12 @@ pub const CURRENT_VERSION: u8 = 0x00;
 pub const HEADER_SIZE: usize = 5;
 pub const MIN_COMPRESSED_SIZE: usize = HEADER_SIZE + 1;
 
+/// Magic bytes of a gzip member (RFC 1952)
+pub const GZIP_MAGIC: [u8; 2] = [0x1f, 0x8b];
+
+/// Magic bytes of a zstd frame (RFC 8878), as written by zstd without the Glide header
+pub const ZSTD_FRAME_MAGIC: [u8; 4] = [0x28, 0xb5, 0x2f, 0xfd];
+
 /// Checks if data has a valid magic header (any version)
 pub fn has_magic_header(data: &[u8]) -> bool {
     data.len() >= HEADER_SIZE && data[0..3] == MAGIC_PREFIX
@@ -9,6 +9,7 @@ pub enum RequestErrorType {
     ExecAbort = 1,
     Timeout = 2,
     Disconnect = 3,
+    TooManyRedirects = 4,
 }
 
 pub fn error_type(error: &RedisError) -> RequestErrorType {
@@ -18,6 +19,8 @@ pub fn error_type(error: &RedisError) -> RequestErrorType {
         RequestErrorType::Disconnect
     } else if matches!(error.kind(), redis::ErrorKind::ExecAbortError) {
         RequestErrorType::ExecAbort
+    } else if matches!(error.kind(), redis::ErrorKind::TooManyRedirects) {
+        RequestErrorType::TooManyRedirects
     } else {
         RequestErrorType::Unspecified
     }
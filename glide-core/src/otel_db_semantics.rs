@@ -92,8 +92,8 @@ fn masking_pattern(cmd_name: &str) -> MaskingPattern {
         // HyperLogLog
         | "PFCOUNT" | "PFMERGE"
         // Stream (read/structural)
-        | "XACK" | "XCLAIM" | "XDEL" | "XGROUP" | "XINFO" | "XLEN" | "XPENDING"
-        | "XRANGE" | "XREAD" | "XREADGROUP" | "XREVRANGE" | "XTRIM"
+        | "XACK" | "XACKDEL" | "XCLAIM" | "XDEL" | "XDELEX" | "XGROUP" | "XINFO" | "XLEN"
+        | "XPENDING" | "XRANGE" | "XREAD" | "XREADGROUP" | "XREVRANGE" | "XTRIM"
         // Server (non-sensitive)
         | "COMMAND" | "DBSIZE" | "FLUSHALL" | "FLUSHDB" | "INFO" | "LOLWUT"
         | "PING" | "RESET" | "SELECT" | "SLOWLOG" | "SWAPDB" | "TIME"
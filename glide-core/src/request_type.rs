@@ -371,6 +371,8 @@ pub enum RequestType {
     XRevRange = 1419,
     XSetId = 1420,
     XTrim = 1421,
+    XAckDel = 1422,
+    XDelEx = 1423,
 
     //// String commands
     Append = 1501,
@@ -543,6 +545,8 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::XGroupCreate => RequestType::XGroupCreate,
             ProtobufRequestType::XGroupDestroy => RequestType::XGroupDestroy,
             ProtobufRequestType::XTrim => RequestType::XTrim,
+            ProtobufRequestType::XAckDel => RequestType::XAckDel,
+            ProtobufRequestType::XDelEx => RequestType::XDelEx,
             ProtobufRequestType::HSetNX => RequestType::HSetNX,
             ProtobufRequestType::SIsMember => RequestType::SIsMember,
             ProtobufRequestType::HVals => RequestType::HVals,
@@ -944,6 +948,8 @@ impl RequestType {
             RequestType::XGroupCreate => Some(get_two_word_command("XGROUP", "CREATE")),
             RequestType::XGroupDestroy => Some(get_two_word_command("XGROUP", "DESTROY")),
             RequestType::XTrim => Some(cmd("XTRIM")),
+            RequestType::XAckDel => Some(cmd("XACKDEL")),
+            RequestType::XDelEx => Some(cmd("XDELEX")),
             RequestType::HSetNX => Some(cmd("HSETNX")),
             RequestType::SIsMember => Some(cmd("SISMEMBER")),
             RequestType::HVals => Some(cmd("HVALS")),
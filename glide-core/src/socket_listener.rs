@@ -253,6 +253,9 @@ async fn write_result(
                     RequestErrorType::ExecAbort => response::RequestErrorType::ExecAbort,
                     RequestErrorType::Timeout => response::RequestErrorType::Timeout,
                     RequestErrorType::Disconnect => response::RequestErrorType::Disconnect,
+                    RequestErrorType::TooManyRedirects => {
+                        response::RequestErrorType::TooManyRedirects
+                    }
                 }
                 .into(),
                 message: error_message.into(),
@@ -690,13 +693,20 @@ fn handle_request(request: CommandRequest, mut client: Client, writer: Rc<Writer
                     }
                     command_request::Command::SingleCommand(command) => {
                         match get_redis_command(&command) {
-                            Ok(mut cmd) => match get_route(request.route.0, Some(&cmd)) {
-                                Ok(routes) => {
-                                    cmd.set_span(get_unsafe_span_from_ptr(request.root_span_ptr));
-                                    send_command(cmd, client, routes).await
+                            Ok(mut cmd) => {
+                                if request.route.as_ref().is_some_and(|route| route.read_only) {
+                                    cmd.set_read_only_hint(true);
+                                }
+                                match get_route(request.route.0, Some(&cmd)) {
+                                    Ok(routes) => {
+                                        cmd.set_span(get_unsafe_span_from_ptr(
+                                            request.root_span_ptr,
+                                        ));
+                                        send_command(cmd, client, routes).await
+                                    }
+                                    Err(e) => Err(e),
                                 }
-                                Err(e) => Err(e),
-                            },
+                            }
                             Err(e) => Err(e),
                         }
                     }
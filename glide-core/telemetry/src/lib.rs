@@ -32,6 +32,28 @@ pub struct Telemetry {
     subscription_out_of_sync_count: usize,
     /// Unix timestamp (in milliseconds) of the last time subscriptions were in sync
     subscription_last_sync_timestamp: u64,
+    /// Number of MOVED redirects received from cluster nodes
+    moved_redirect_count: usize,
+    /// Number of ASK redirects received from cluster nodes
+    ask_redirect_count: usize,
+    /// Number of times a node circuit breaker opened
+    circuit_breaker_opened_count: usize,
+    /// Number of times a node circuit breaker became half-open
+    circuit_breaker_half_opened_count: usize,
+    /// Number of times a node circuit breaker closed
+    circuit_breaker_closed_count: usize,
+    /// Number of requests rejected by an open circuit breaker
+    circuit_breaker_rejected_count: usize,
+    /// Number of cluster slot refreshes completed
+    slot_refresh_count: usize,
+    /// Number of cluster slot refreshes that found an unchanged topology and kept the slot map
+    slot_refresh_unchanged_count: usize,
+    /// Total duration of the cluster slot refreshes, in milliseconds
+    slot_refresh_total_duration_ms: u64,
+    /// Duration of the last cluster slot refresh, in milliseconds
+    slot_refresh_last_duration_ms: u64,
+    /// Number of slot map updates applied from MOVED redirects, without a slot refresh
+    incremental_slot_update_count: usize,
 }
 
 lazy_static! {
@@ -202,6 +224,156 @@ impl Telemetry {
             .subscription_last_sync_timestamp
     }
 
+    /// Increment the number of MOVED redirects
+    /// Return the new count after increment
+    pub fn incr_moved_redirect_count() -> usize {
+        let mut t = TELEMETRY.write().expect(MUTEX_WRITE_ERR);
+        t.moved_redirect_count = t.moved_redirect_count.saturating_add(1);
+        t.moved_redirect_count
+    }
+
+    /// Return the number of MOVED redirects
+    pub fn moved_redirect_count() -> usize {
+        TELEMETRY.read().expect(MUTEX_READ_ERR).moved_redirect_count
+    }
+
+    /// Increment the number of ASK redirects
+    /// Return the new count after increment
+    pub fn incr_ask_redirect_count() -> usize {
+        let mut t = TELEMETRY.write().expect(MUTEX_WRITE_ERR);
+        t.ask_redirect_count = t.ask_redirect_count.saturating_add(1);
+        t.ask_redirect_count
+    }
+
+    /// Return the number of ASK redirects
+    pub fn ask_redirect_count() -> usize {
+        TELEMETRY.read().expect(MUTEX_READ_ERR).ask_redirect_count
+    }
+
+    /// Increment the number of times a node circuit breaker opened
+    /// Return the new count after increment
+    pub fn incr_circuit_breaker_opened_count() -> usize {
+        let mut t = TELEMETRY.write().expect(MUTEX_WRITE_ERR);
+        t.circuit_breaker_opened_count = t.circuit_breaker_opened_count.saturating_add(1);
+        t.circuit_breaker_opened_count
+    }
+
+    /// Return the number of times a node circuit breaker opened
+    pub fn circuit_breaker_opened_count() -> usize {
+        TELEMETRY
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .circuit_breaker_opened_count
+    }
+
+    /// Increment the number of times a node circuit breaker became half-open
+    /// Return the new count after increment
+    pub fn incr_circuit_breaker_half_opened_count() -> usize {
+        let mut t = TELEMETRY.write().expect(MUTEX_WRITE_ERR);
+        t.circuit_breaker_half_opened_count = t.circuit_breaker_half_opened_count.saturating_add(1);
+        t.circuit_breaker_half_opened_count
+    }
+
+    /// Return the number of times a node circuit breaker became half-open
+    pub fn circuit_breaker_half_opened_count() -> usize {
+        TELEMETRY
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .circuit_breaker_half_opened_count
+    }
+
+    /// Increment the number of times a node circuit breaker closed
+    /// Return the new count after increment
+    pub fn incr_circuit_breaker_closed_count() -> usize {
+        let mut t = TELEMETRY.write().expect(MUTEX_WRITE_ERR);
+        t.circuit_breaker_closed_count = t.circuit_breaker_closed_count.saturating_add(1);
+        t.circuit_breaker_closed_count
+    }
+
+    /// Return the number of times a node circuit breaker closed
+    pub fn circuit_breaker_closed_count() -> usize {
+        TELEMETRY
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .circuit_breaker_closed_count
+    }
+
+    /// Increment the number of requests rejected by an open circuit breaker
+    /// Return the new count after increment
+    pub fn incr_circuit_breaker_rejected_count() -> usize {
+        let mut t = TELEMETRY.write().expect(MUTEX_WRITE_ERR);
+        t.circuit_breaker_rejected_count = t.circuit_breaker_rejected_count.saturating_add(1);
+        t.circuit_breaker_rejected_count
+    }
+
+    /// Return the number of requests rejected by an open circuit breaker
+    pub fn circuit_breaker_rejected_count() -> usize {
+        TELEMETRY
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .circuit_breaker_rejected_count
+    }
+
+    /// Record a completed cluster slot refresh that took `duration_ms` milliseconds, and whether
+    /// it found an unchanged topology
+    /// Return the number of slot refreshes after the increment
+    pub fn record_slot_refresh(duration_ms: u64, unchanged: bool) -> usize {
+        let mut t = TELEMETRY.write().expect(MUTEX_WRITE_ERR);
+        t.slot_refresh_count = t.slot_refresh_count.saturating_add(1);
+        if unchanged {
+            t.slot_refresh_unchanged_count = t.slot_refresh_unchanged_count.saturating_add(1);
+        }
+        t.slot_refresh_total_duration_ms =
+            t.slot_refresh_total_duration_ms.saturating_add(duration_ms);
+        t.slot_refresh_last_duration_ms = duration_ms;
+        t.slot_refresh_count
+    }
+
+    /// Return the number of cluster slot refreshes completed
+    pub fn slot_refresh_count() -> usize {
+        TELEMETRY.read().expect(MUTEX_READ_ERR).slot_refresh_count
+    }
+
+    /// Return the number of cluster slot refreshes that found an unchanged topology
+    pub fn slot_refresh_unchanged_count() -> usize {
+        TELEMETRY
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .slot_refresh_unchanged_count
+    }
+
+    /// Return the total duration of the cluster slot refreshes, in milliseconds
+    pub fn slot_refresh_total_duration_ms() -> u64 {
+        TELEMETRY
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .slot_refresh_total_duration_ms
+    }
+
+    /// Return the duration of the last cluster slot refresh, in milliseconds
+    pub fn slot_refresh_last_duration_ms() -> u64 {
+        TELEMETRY
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .slot_refresh_last_duration_ms
+    }
+
+    /// Increment the number of slot map updates applied from MOVED redirects
+    /// Return the new count after increment
+    pub fn incr_incremental_slot_update_count() -> usize {
+        let mut t = TELEMETRY.write().expect(MUTEX_WRITE_ERR);
+        t.incremental_slot_update_count = t.incremental_slot_update_count.saturating_add(1);
+        t.incremental_slot_update_count
+    }
+
+    /// Return the number of slot map updates applied from MOVED redirects
+    pub fn incremental_slot_update_count() -> usize {
+        TELEMETRY
+            .read()
+            .expect(MUTEX_READ_ERR)
+            .incremental_slot_update_count
+    }
+
     /// Reset the telemetry collected thus far
     pub fn reset() {
         *TELEMETRY.write().expect(MUTEX_WRITE_ERR) = Telemetry::default();
@@ -28,7 +28,9 @@ pub(crate) mod shared_client_tests {
     use std::collections::HashMap;
 
     use super::*;
-    use glide_core::client::{Client, DEFAULT_RESPONSE_TIMEOUT};
+    use glide_core::client::{
+        Client, ConfigUpdate, ConnectionRetryStrategy, DEFAULT_RESPONSE_TIMEOUT, ReadFrom,
+    };
     use glide_core::connection_request::ProtocolVersion;
     use redis::cluster_routing::{SingleNodeRoutingInfo, SlotAddr};
     use redis::{
@@ -1416,6 +1418,106 @@ pub(crate) mod shared_client_tests {
         });
     }
 
+    #[rstest]
+    #[serial_test::serial]
+    #[timeout(SHORT_CLUSTER_TEST_TIMEOUT)]
+    fn test_update_config_request_timeout(#[values(false, true)] use_cluster: bool) {
+        block_on_all(async {
+            let mut test_basics = setup_test_basics(
+                use_cluster,
+                TestConfiguration {
+                    shared_server: false,
+                    ..Default::default()
+                },
+            )
+            .await;
+            let result = test_basics
+                .client
+                .update_config(ConfigUpdate {
+                    request_timeout: Some(1), // milliseconds
+                    ..Default::default()
+                })
+                .await;
+            assert_eq!(result.unwrap(), Value::Okay);
+
+            let mut cmd = redis::Cmd::new();
+            // Create a long running command to ensure we get into timeout
+            cmd.arg("EVAL")
+                .arg(
+                    r#"
+                    while (true)
+                    do
+                    redis.call('ping')
+                    end
+                "#,
+                )
+                .arg("0");
+            let result = test_basics.client.send_command(&mut cmd, None).await;
+            assert!(result.is_err());
+            let err = result.unwrap_err();
+            assert!(err.is_timeout(), "{err}");
+        });
+    }
+
+    #[rstest]
+    #[serial_test::serial]
+    #[timeout(SHORT_CLUSTER_TEST_TIMEOUT)]
+    fn test_update_config_rejects_invalid_settings(#[values(false, true)] use_cluster: bool) {
+        block_on_all(async {
+            let mut test_basics = setup_test_basics(
+                use_cluster,
+                TestConfiguration {
+                    shared_server: true,
+                    ..Default::default()
+                },
+            )
+            .await;
+            let result = test_basics
+                .client
+                .update_config(ConfigUpdate {
+                    request_timeout: Some(0),
+                    ..Default::default()
+                })
+                .await;
+            assert_eq!(
+                result.unwrap_err().kind(),
+                redis::ErrorKind::UserOperationError
+            );
+
+            // The client was not created with an AZ affinity strategy, so the AZ of the nodes is unknown
+            let result = test_basics
+                .client
+                .update_config(ConfigUpdate {
+                    request_timeout: Some(5000),
+                    read_from: Some(ReadFrom::AZAffinity("us-east-1a".to_string())),
+                    ..Default::default()
+                })
+                .await;
+            assert_eq!(
+                result.unwrap_err().kind(),
+                redis::ErrorKind::UserOperationError
+            );
+
+            let result = test_basics
+                .client
+                .update_config(ConfigUpdate {
+                    read_from: Some(ReadFrom::PreferReplica),
+                    connection_retry_strategy: Some(ConnectionRetryStrategy {
+                        exponent_base: 2,
+                        factor: 10,
+                        number_of_retries: 3,
+                        jitter_percent: None,
+                    }),
+                    ..Default::default()
+                })
+                .await;
+            assert_eq!(result.unwrap(), Value::Okay);
+            let mut cmd = redis::cmd("PING");
+            let result = test_basics.client.send_command(&mut cmd, None).await;
+            assert_eq!(result.unwrap(), Value::SimpleString("PONG".to_string()));
+        });
+    }
+
     #[rstest]
     #[serial_test::serial]
     #[timeout(SHORT_CLUSTER_TEST_TIMEOUT)]
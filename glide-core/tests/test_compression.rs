@@ -760,4 +760,79 @@ mod compression_tests {
         let result = manager.try_decompress_value(&unsupported_data);
         assert_eq!(result, unsupported_data);
     }
+
+    #[test]
+    fn test_auto_decompression_of_foreign_formats() {
+        use flate2::{Compression, write::GzEncoder};
+        use glide_core::compression::zstd_backend::ZstdBackend;
+        use std::io::Write;
+
+        let original_data = b"Test data written by a producer in another language, compressed without the Glide header";
+        let mut encoder = GzEncoder::new(Vec::new(), Compression::default());
+        encoder.write_all(original_data).unwrap();
+        let gzip_compressed = encoder.finish().unwrap();
+        let zstd_compressed = zstd::encode_all(&original_data[..], 3).unwrap();
+        assert!(AutoDecompressionFormat::Gzip.matches(&gzip_compressed));
+        assert!(AutoDecompressionFormat::Zstd.matches(&zstd_compressed));
+
+        // Without auto-decompression, the values pass through
+        let manager = CompressionManager::new(
+            Box::new(ZstdBackend::new()),
+            CompressionConfig::new(CompressionBackendType::Zstd),
+        )
+        .unwrap();
+        assert_eq!(
+            manager.decompress_value(&gzip_compressed).unwrap(),
+            gzip_compressed
+        );
+        assert_eq!(
+            manager.decompress_value(&zstd_compressed).unwrap(),
+            zstd_compressed
+        );
+
+        // With auto-decompression, they are decompressed along with the values compressed by Glide
+        let config =
+            CompressionConfig::new(CompressionBackendType::Zstd).with_auto_decompression_formats(
+                vec![AutoDecompressionFormat::Gzip, AutoDecompressionFormat::Zstd],
+            );
+        let manager = CompressionManager::new(Box::new(ZstdBackend::new()), config).unwrap();
+        assert_eq!(
+            manager.decompress_value(&gzip_compressed).unwrap(),
+            original_data
+        );
+        assert_eq!(
+            manager.decompress_value(&zstd_compressed).unwrap(),
+            original_data
+        );
+        let glide_compressed = manager.compress_value(original_data);
+        assert_eq!(
+            manager.decompress_value(&glide_compressed).unwrap(),
+            original_data
+        );
+        assert_eq!(
+            manager.decompress_value(b"plain value").unwrap(),
+            b"plain value"
+        );
+
+        // Only the configured formats are decompressed
+        let config = CompressionConfig::new(CompressionBackendType::Zstd)
+            .with_auto_decompression_formats(vec![AutoDecompressionFormat::Gzip]);
+        let manager = CompressionManager::new(Box::new(ZstdBackend::new()), config).unwrap();
+        assert_eq!(
+            manager.decompress_value(&gzip_compressed).unwrap(),
+            original_data
+        );
+        assert_eq!(
+            manager.decompress_value(&zstd_compressed).unwrap(),
+            zstd_compressed
+        );
+
+        // A plain value starting with the magic bytes by chance fails to decompress, and is returned as is
+        let mut truncated = gzip_compressed.clone();
+        truncated.truncate(12);
+        let err = manager.decompress_value(&truncated).unwrap_err();
+        assert!(matches!(err, CompressionError::DecompressionFailed { .. }));
+        assert!(err.to_string().contains("GZIP"));
+        assert_eq!(manager.try_decompress_value(&truncated), truncated);
+    }
 }
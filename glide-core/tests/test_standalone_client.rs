@@ -418,6 +418,30 @@ mod standalone_client_tests {
         });
     }
 
+    #[rstest]
+    #[serial_test::serial]
+    #[timeout(SHORT_STANDALONE_TEST_TIMEOUT)]
+    fn test_eager_connections_fail_on_unreachable_replica(#[values(false, true)] eager: bool) {
+        let mocks = create_primary_mock_with_replicas(1);
+        let mut addresses = get_mock_addresses(&mocks);
+        // Use non-routable IP for fast connection failure
+        addresses.push(redis::ConnectionAddr::Tcp("192.0.2.1".to_string(), 6379));
+        let mut connection_request =
+            create_connection_request(addresses.as_slice(), &Default::default());
+        connection_request.eager_connections = eager;
+        block_on_all(async {
+            let client_res =
+                StandaloneClient::create_client(connection_request.into(), None, None, None)
+                    .await
+                    .map_err(ConnectionError::Standalone);
+            // Without eager connections, the unreachable replica is connected in the background
+            assert_eq!(client_res.is_err(), eager);
+            if let Err(error) = client_res {
+                assert!(error.to_string().contains("192.0.2.1:6379"));
+            }
+        });
+    }
+
     #[rstest]
     #[timeout(SHORT_STANDALONE_TEST_TIMEOUT)]
     fn test_send_acl_request_to_all_nodes() {
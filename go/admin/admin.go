@@ -0,0 +1,162 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package admin provides maintenance operations on the keyspace, meant to replace ad hoc scripts that block the server,
+// such as deleting the keys listed by `KEYS pattern`.
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/keyscan"
+)
+
+// DefaultDeleteBatchSize is the default number of keys requested from every `SCAN` call and deleted by every `UNLINK`
+// call of [DeleteByPattern].
+const DefaultDeleteBatchSize = 100
+
+// DeleteOptions are the optional arguments of [DeleteByPattern].
+type DeleteOptions struct {
+	// The number of keys requested from every `SCAN` call, and the maximum number of keys deleted by every `UNLINK` call.
+	// If zero, [DefaultDeleteBatchSize] is used.
+	BatchSize int
+	// The maximum number of keys deleted per second, to limit the load on the server. If zero, the keys are deleted as
+	// fast as they are listed.
+	MaxKeysPerSecond int
+	// Only count the matching keys, and pass them to OnBatch, without deleting them.
+	DryRun bool
+	// Called with every batch of matching keys, after they are deleted or, in dry-run mode, instead of deleting them.
+	// Optional.
+	OnBatch func(keys []string)
+}
+
+// DeleteResult is the outcome of [DeleteByPattern].
+type DeleteResult struct {
+	// The number of matching keys listed by `SCAN`. A key may be listed more than once if the keyspace is resized during
+	// the scan.
+	Matched int64
+	// The number of keys deleted by `UNLINK`, i.e. the matching keys that still existed. Zero in dry-run mode.
+	Deleted int64
+}
+
+// DeleteByPattern deletes the keys matching `pattern`, without blocking the server: the keys are listed incrementally
+// with `SCAN`, or with a cluster scan of all the primaries in cluster mode, and deleted in batches with `UNLINK`, which
+// frees the memory of the values in the background.
+//
+// The keys created or renamed during the deletion may or may not be deleted. Cancelling `ctx` stops the deletion, leaving
+// the keys that were not deleted yet.
+//
+// Since:
+//
+//	Valkey 4.0.0 and above.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to delete the keys with, either a [glide.Client] or a [glide.ClusterClient].
+//	pattern - The glob-style pattern of the keys to delete, e.g. "session:*".
+//	opts - The batch size, the rate limit and the dry-run mode, see [DeleteOptions].
+//
+// Return value:
+//
+//	The number of matching and deleted keys. If an error is returned, the keys counted before the error.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func DeleteByPattern(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	pattern string,
+	opts DeleteOptions,
+) (DeleteResult, error) {
+	var result DeleteResult
+	if opts.BatchSize < 0 || opts.MaxKeysPerSecond < 0 {
+		return result, errors.New("batch size and max keys per second must not be negative")
+	}
+	batchSize := opts.BatchSize
+	if batchSize == 0 {
+		batchSize = DefaultDeleteBatchSize
+	}
+	limiter := newRateLimiter(opts.MaxKeysPerSecond)
+
+	pending := make([]string, 0, batchSize)
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		if !opts.DryRun {
+			if err := limiter.wait(ctx, len(pending)); err != nil {
+				return err
+			}
+			deleted, err := client.Unlink(ctx, pending)
+			if err != nil {
+				return err
+			}
+			result.Deleted += deleted
+		}
+		if opts.OnBatch != nil {
+			opts.OnBatch(append([]string(nil), pending...))
+		}
+		pending = pending[:0]
+		return nil
+	}
+
+	err := keyscan.Scan(ctx, client, pattern, int64(batchSize), func(keys []string) error {
+		result.Matched += int64(len(keys))
+		for _, key := range keys {
+			pending = append(pending, key)
+			if len(pending) == batchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+	return result, flush()
+}
+
+// rateLimiter paces the deletions, so that on average at most `rate` keys are deleted per second since the first one.
+type rateLimiter struct {
+	rate    int
+	start   time.Time
+	granted int64
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+func newRateLimiter(rate int) *rateLimiter {
+	return &rateLimiter{rate: rate, sleep: sleepContext}
+}
+
+// wait blocks until `n` more keys can be deleted without exceeding the rate.
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	if l.rate == 0 {
+		return nil
+	}
+	if l.start.IsZero() {
+		l.start = time.Now()
+	}
+	// The first `rate` keys are deleted right away, and the next ones once their share of a second elapsed.
+	due := l.start.Add(time.Duration(float64(l.granted+int64(n)-int64(l.rate)) / float64(l.rate) * float64(time.Second)))
+	l.granted += int64(n)
+	if delay := time.Until(due); delay > 0 {
+		return l.sleep(ctx, delay)
+	}
+	return nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+// fakeClient stores the keys in memory, and records the keys unlinked by every call.
+type fakeClient struct {
+	*fakeclient.Client
+	unlinked [][]string
+}
+
+func newFakeClient(keys ...string) *fakeClient {
+	f := &fakeClient{Client: fakeclient.New()}
+	for _, key := range keys {
+		f.Strings[key] = "value"
+	}
+	return f
+}
+
+func (f *fakeClient) Unlink(ctx context.Context, keys []string) (int64, error) {
+	f.unlinked = append(f.unlinked, slices.Clone(keys))
+	return f.Client.Unlink(ctx, keys)
+}
+
+func keys(prefix string, n int) []string {
+	result := make([]string, n)
+	for i := range result {
+		result[i] = fmt.Sprintf("%s%03d", prefix, i)
+	}
+	return result
+}
+
+func TestDeleteByPattern(t *testing.T) {
+	client := newFakeClient(append(keys("session:", 25), keys("user:", 5)...)...)
+	var batches int
+	result, err := DeleteByPattern(context.Background(), client, "session:*", DeleteOptions{
+		BatchSize: 10,
+		OnBatch:   func(keys []string) { batches++ },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DeleteResult{Matched: 25, Deleted: 25}, result)
+	assert.Equal(t, 3, batches)
+	assert.Equal(t, []int{10, 10, 5}, []int{len(client.unlinked[0]), len(client.unlinked[1]), len(client.unlinked[2])})
+	assert.Len(t, client.Strings, 5)
+}
+
+func TestDeleteByPattern_DryRun(t *testing.T) {
+	client := newFakeClient(keys("session:", 25)...)
+	var listed []string
+	result, err := DeleteByPattern(context.Background(), client, "session:*", DeleteOptions{
+		DryRun:  true,
+		OnBatch: func(keys []string) { listed = append(listed, keys...) },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DeleteResult{Matched: 25}, result)
+	assert.Equal(t, keys("session:", 25), listed)
+	assert.Empty(t, client.unlinked)
+	assert.Len(t, client.Strings, 25)
+}
+
+func TestDeleteByPattern_InvalidOptions(t *testing.T) {
+	_, err := DeleteByPattern(context.Background(), newFakeClient(), "*", DeleteOptions{BatchSize: -1})
+	assert.Error(t, err)
+	_, err = DeleteByPattern(context.Background(), newFakeClient(), "*", DeleteOptions{MaxKeysPerSecond: -1})
+	assert.Error(t, err)
+}
+
+func TestDeleteByPattern_Canceled(t *testing.T) {
+	client := newFakeClient(keys("session:", 25)...)
+	ctx, cancel := context.WithCancel(context.Background())
+	result, err := DeleteByPattern(ctx, client, "session:*", DeleteOptions{
+		BatchSize:        10,
+		MaxKeysPerSecond: 10,
+		OnBatch:          func(keys []string) { cancel() },
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int64(10), result.Deleted)
+}
+
+func TestRateLimiter(t *testing.T) {
+	var slept []time.Duration
+	limiter := &rateLimiter{
+		rate:  100,
+		sleep: func(ctx context.Context, d time.Duration) error { slept = append(slept, d); return nil },
+	}
+	// The first 100 keys are not delayed, and the next 50 are delayed by half a second.
+	require.NoError(t, limiter.wait(context.Background(), 100))
+	assert.Empty(t, slept)
+	require.NoError(t, limiter.wait(context.Background(), 50))
+	require.Len(t, slept, 1)
+	assert.InDelta(t, 500*time.Millisecond, slept[0], float64(50*time.Millisecond))
+
+	require.NoError(t, newRateLimiter(0).wait(context.Background(), 1000))
+}
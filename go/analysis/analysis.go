@@ -0,0 +1,285 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package analysis estimates the cardinality of operations on very large sets, where the exact commands, e.g.
+// `SINTERCARD`, are too slow.
+//
+// The sets are summarized by HyperLogLog sketches, stored next to them, whose unions are counted cheaply with `PFCOUNT`
+// with a standard error of 0.81%. The sketches are either maintained along with their set, by adding the members with
+// [Add], or created on the fly by the first estimation involving their set and kept for a while, see [EstimateOptions].
+// Since members cannot be removed from a sketch, a sketch keeps counting the members removed from its set until it is
+// deleted with [DeleteSketches].
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+const (
+	// MaxIntersectionKeys is the maximum number of sets whose intersection can be estimated, since the estimation counts
+	// the union of every combination of the sets.
+	MaxIntersectionKeys = 8
+	// DefaultSketchTTL is the default time the sketches created on the fly are kept for.
+	DefaultSketchTTL = 10 * time.Minute
+)
+
+// sketchFunction defines `sketch(set, hll, ttl)`, which creates the sketch `hll` of `set` unless it exists, reading the
+// set with `SSCAN`, expires it after `ttl` milliseconds if positive, and returns whether it created it.
+const sketchFunction = `
+local function sketch(set, hll, ttl)
+	if redis.call('EXISTS', hll) == 1 then
+		return false
+	end
+	local cursor = '0'
+	repeat
+		local page = redis.call('SSCAN', set, cursor, 'COUNT', 1000)
+		cursor = page[1]
+		if #page[2] > 0 then
+			redis.call('PFADD', hll, unpack(page[2]))
+		end
+	until cursor == '0'
+	redis.call('PFADD', hll)
+	if ttl > 0 then
+		redis.call('PEXPIRE', hll, ttl)
+	end
+	return true
+end
+`
+
+// addScript adds the members ARGV to the set KEYS[1] and to its sketch KEYS[2], creating the sketch from the members
+// already in the set first.
+var addScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(sketchFunction + `
+if redis.call('EXISTS', KEYS[2]) == 0 and redis.call('EXISTS', KEYS[1]) == 1 then
+	sketch(KEYS[1], KEYS[2], 0)
+end
+redis.call('PFADD', KEYS[2], unpack(ARGV))
+return redis.call('SADD', KEYS[1], unpack(ARGV))
+`)
+})
+
+// estimateScript creates the missing sketches of the ARGV[1] sets KEYS[1..n], at KEYS[n+1..2n], expiring after ARGV[2]
+// milliseconds or deleted before returning if ARGV[2] is negative, and returns the cardinality of every set followed by
+// the count of the union of the sketches of every combination of the sets, the i-th set being in the combinations whose
+// index has the bit i set.
+var estimateScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(sketchFunction + `
+local n = tonumber(ARGV[1])
+local ttl = tonumber(ARGV[2])
+local counts = {}
+local created = {}
+for i = 1, n do
+	counts[i] = redis.call('SCARD', KEYS[i])
+	if counts[i] > 0 and sketch(KEYS[i], KEYS[n + i], ttl) then
+		created[#created + 1] = KEYS[n + i]
+	end
+end
+for combination = 1, 2 ^ n - 1 do
+	local sketches = {}
+	for i = 1, n do
+		if math.floor(combination / 2 ^ (i - 1)) % 2 == 1 then
+			sketches[#sketches + 1] = KEYS[n + i]
+		end
+	end
+	counts[n + combination] = redis.call('PFCOUNT', unpack(sketches))
+end
+if ttl < 0 and #created > 0 then
+	redis.call('DEL', unpack(created))
+end
+return counts
+`)
+})
+
+// EstimateOptions are the optional arguments of [EstimateIntersectionWithOptions].
+type EstimateOptions struct {
+	// How long the sketches created on the fly are kept for, to be reused by the next estimations. A sketch kept longer
+	// estimates a set that is more likely to have changed since. [DefaultSketchTTL] if zero, and deleted right after the
+	// estimation if negative.
+	SketchTTL time.Duration
+}
+
+// SketchKey returns the key of the sketch of the set at `key`, in the slot of `key`, e.g. "{tags:go}:hll" for "tags:go".
+func SketchKey(key string) string {
+	return utils.SameSlotKey(key, ":hll")
+}
+
+// Add adds `members` to the set at `key` with `SADD`, and to its sketch, so that the sketch is maintained along with the
+// set. The sketch of a set that already exists is created from its members first.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the set.
+//	members - The members to add.
+//
+// Return value:
+//
+//	The number of members added to the set, not counting the members already in the set.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func Add(ctx context.Context, client interfaces.BaseClientCommands, key string, members ...string) (int64, error) {
+	if len(members) == 0 {
+		return 0, errors.New("no member to add")
+	}
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*addScript(),
+		*options.NewScriptOptions().WithKeys([]string{key, SketchKey(key)}).WithArgs(members),
+	)
+	if err != nil {
+		return 0, err
+	}
+	added, ok := result.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected response: %v", result)
+	}
+	return added, nil
+}
+
+// DeleteSketches deletes the sketches of the sets at `keys`, e.g. after members were removed from the sets. The sketches
+// are created again by the next estimation, or by the next [Add].
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	keys - The keys of the sets.
+//
+// Return value:
+//
+//	The number of sketches deleted.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func DeleteSketches(ctx context.Context, client interfaces.BaseClientCommands, keys ...string) (int64, error) {
+	sketches := make([]string, len(keys))
+	for i, key := range keys {
+		sketches[i] = SketchKey(key)
+	}
+	return client.Del(ctx, sketches)
+}
+
+// EstimateIntersection estimates the number of members in the intersection of the sets at `keys`, keeping the sketches
+// created on the fly for [DefaultSketchTTL]. See [EstimateIntersectionWithOptions] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	keys - The keys of the sets, at most [MaxIntersectionKeys].
+//
+// Return value:
+//
+//	The estimated number of members in the intersection of the sets.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func EstimateIntersection(ctx context.Context, client interfaces.BaseClientCommands, keys []string) (int64, error) {
+	return EstimateIntersectionWithOptions(ctx, client, keys, EstimateOptions{})
+}
+
+// EstimateIntersectionWithOptions estimates the number of members in the intersection of the sets at `keys`, from the
+// counts of the unions of their sketches by the inclusion-exclusion principle. The sketches that do not exist are created
+// on the fly from their set, which reads the whole set once; the estimation is then a handful of `PFCOUNT` calls,
+// regardless of the size of the sets. The estimation and the creation of the sketches run in a single script.
+//
+// The error of the estimation is relative to the size of the unions rather than to the size of the intersection: the
+// estimation of a small intersection of large sets is coarse, and the error grows with the number of sets. The estimation
+// is bounded by the size of the smallest set, and is exact if a set is empty or if there is a single set.
+//
+// In cluster mode, the sets and their sketches must be in the same slot, i.e. the keys must share a hash tag.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	keys - The keys of the sets, at most [MaxIntersectionKeys].
+//	opts - How long the sketches created on the fly are kept, see [EstimateOptions].
+//
+// Return value:
+//
+//	The estimated number of members in the intersection of the sets.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func EstimateIntersectionWithOptions(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	keys []string,
+	opts EstimateOptions,
+) (int64, error) {
+	if len(keys) == 0 || len(keys) > MaxIntersectionKeys {
+		return 0, fmt.Errorf("the intersection of 1 to %d sets can be estimated, got %d", MaxIntersectionKeys, len(keys))
+	}
+	ttl := opts.SketchTTL
+	if ttl == 0 {
+		ttl = DefaultSketchTTL
+	}
+	scriptKeys := make([]string, 0, 2*len(keys))
+	scriptKeys = append(scriptKeys, keys...)
+	for _, key := range keys {
+		scriptKeys = append(scriptKeys, SketchKey(key))
+	}
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*estimateScript(),
+		*options.NewScriptOptions().
+			WithKeys(scriptKeys).
+			WithArgs([]string{strconv.Itoa(len(keys)), strconv.FormatInt(ttl.Milliseconds(), 10)}),
+	)
+	if err != nil {
+		return 0, err
+	}
+	values, ok := result.([]any)
+	if !ok || len(values) != len(keys)+(1<<len(keys))-1 {
+		return 0, fmt.Errorf("unexpected response: %v", result)
+	}
+	counts := make([]int64, len(values))
+	for i, value := range values {
+		if counts[i], ok = value.(int64); !ok {
+			return 0, fmt.Errorf("unexpected response: %v", result)
+		}
+	}
+	return intersection(counts[:len(keys)], counts[len(keys):]), nil
+}
+
+// intersection estimates the size of the intersection of sets of `cardinalities`, from the counts of the unions of every
+// combination of the sets, the count of the combination whose index plus one has the bit i set including the i-th set.
+func intersection(cardinalities []int64, unions []int64) int64 {
+	smallest := cardinalities[0]
+	for _, cardinality := range cardinalities[1:] {
+		smallest = min(smallest, cardinality)
+	}
+	if len(cardinalities) == 1 || smallest == 0 {
+		return smallest
+	}
+	// |A ∩ B ∩ ...| = Σ (-1)^(|S|+1) |∪ S| over the non-empty combinations S of the sets
+	var estimate int64
+	for i, union := range unions {
+		if setBits(i+1)%2 == 1 {
+			estimate += union
+		} else {
+			estimate -= union
+		}
+	}
+	return min(max(estimate, 0), smallest)
+}
+
+// setBits returns the number of bits set in `n`.
+func setBits(n int) int {
+	count := 0
+	for ; n != 0; n &= n - 1 {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,62 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// exactCounts returns the cardinality of `sets` followed by the exact count of the union of every combination of them,
+// as returned by the estimation script if the sketches were exact.
+func exactCounts(sets ...[]int) ([]int64, []int64) {
+	cardinalities := make([]int64, len(sets))
+	for i, set := range sets {
+		cardinalities[i] = int64(len(set))
+	}
+	unions := make([]int64, 1<<len(sets)-1)
+	for combination := 1; combination < 1<<len(sets); combination++ {
+		union := map[int]bool{}
+		for i, set := range sets {
+			if combination&(1<<i) != 0 {
+				for _, member := range set {
+					union[member] = true
+				}
+			}
+		}
+		unions[combination-1] = int64(len(union))
+	}
+	return cardinalities, unions
+}
+
+func span(from int, to int) []int {
+	members := make([]int, 0, to-from+1)
+	for member := from; member <= to; member++ {
+		members = append(members, member)
+	}
+	return members
+}
+
+func TestSketchKey(t *testing.T) {
+	assert.Equal(t, "{tags:go}:hll", SketchKey("tags:go"))
+	assert.Equal(t, "{tags}:go:hll", SketchKey("{tags}:go"))
+}
+
+func TestIntersection(t *testing.T) {
+	assert.Equal(t, int64(5), intersection(exactCounts(span(1, 10), span(6, 15))))
+	assert.Equal(t, int64(3), intersection(exactCounts(span(1, 10), span(6, 15), span(8, 20))))
+	assert.Equal(t, int64(0), intersection(exactCounts(span(1, 10), span(11, 20), span(1, 20))))
+	assert.Equal(t, int64(10), intersection(exactCounts(span(1, 10))))
+	assert.Equal(t, int64(1), intersection(exactCounts(
+		span(1, 100), span(50, 150), span(90, 200), span(1, 91), span(91, 92),
+	)))
+
+	// The estimation is bounded by the smallest set, and exact if a set is empty
+	cardinalities, unions := exactCounts(span(1, 10), span(1, 10))
+	unions[2] = 5
+	assert.Equal(t, int64(10), intersection(cardinalities, unions))
+	unions[2] = 30
+	assert.Equal(t, int64(0), intersection(cardinalities, unions))
+	assert.Equal(t, int64(0), intersection(exactCounts(span(1, 10), nil)))
+}
@@ -20,6 +20,8 @@ package glide
 //                     const uint8_t *message, int64_t message_len,
 //                     const uint8_t *channel, int64_t channel_len,
 //                     const uint8_t *pattern, int64_t pattern_len);
+// void connectionEventCallback(uintptr_t context, enum ConnectionEventKind kind,
+//                              const char *address, uint32_t attempt, const char *error);
 import "C"
 
 import (
@@ -27,8 +29,10 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 
@@ -51,6 +55,19 @@ type payload struct {
 
 type clientConfiguration interface {
 	ToProtobuf() (*protobuf.ConnectionRequest, error)
+	GetBufferPool() *config.BufferPoolConfiguration
+	GetIntrospectionCache() *config.IntrospectionCacheConfiguration
+	GetClientSideCache() *config.ClientSideCacheConfiguration
+	GetReadFrom() config.ReadFrom
+	GetAuditHook() config.AuditHook
+	GetLatencyBudgetShedding() float64
+	GetBlockingTimeoutMargin() time.Duration
+	GetCommandPolicy() *config.CommandPolicy
+	GetKeyPrefix() string
+	GetClientNameSuffix() string
+	GetCompatibilityFallbacks() bool
+	GetInterceptors() []config.Interceptor
+	GetConnectionLifecycleHooks() *config.ConnectionLifecycleHooks
 }
 
 type baseClient struct {
@@ -58,6 +75,47 @@ type baseClient struct {
 	coreClient     unsafe.Pointer
 	mu             *sync.Mutex
 	messageHandler *MessageHandler
+	buffers        *commandBuffers
+	// Nil unless the introspection cache is configured.
+	introspectionCache *utils.LRUCache[string, any]
+	// Nil unless the client-side cache is configured.
+	clientSideCache *clientSideCache
+	// The keys watched with WatchChanges.
+	keyWatchers *keyWatchers
+	// The latencies of the recent hedged reads, used to compute the hedging delay.
+	hedgeLatencies *utils.LatencyWindow
+	clusterMode    bool
+	// Whether the read strategy of the client reads from replicas, in which case custom commands are classified as
+	// read-only or not, see customCommandRoute. Updated by UpdateConfig.
+	readFromReplica *atomic.Bool
+	// The command table entries of the commands looked up by the client, by lowercase command name, see cachedCommandInfo.
+	customCommandInfo *sync.Map
+	// The replica selector of the read-only commands, see [config.ClusterClientConfiguration.WithReplicaSelector], or nil.
+	replicaSelection *replicaSelection
+	// Nil unless an audit hook is configured.
+	auditHook config.AuditHook
+	// The fraction of the latency budget of a request after which it is shed, or zero if requests are not shed.
+	sheddingFraction float64
+	// The time left for the reply of a blocking command before the deadline of its context, or zero if the timeouts of
+	// the blocking commands are not clamped.
+	blockingTimeoutMargin time.Duration
+	// The policy restricting the commands the client can send, or nil.
+	commandPolicy *config.CommandPolicy
+	// The suffixes appended to the names set with ClientSetName, or empty.
+	clientNameSuffix string
+	// Whether the commands the server does not know are emulated, see compatibility_fallbacks.go.
+	compatibilityFallbacks bool
+	// Whether a server did not know SINTERCARD, in which case it is emulated from then on.
+	sInterCardUnsupported *atomic.Bool
+	// Whether a server did not know the IFEQ condition of SET, in which case UpdateString compares the values with a
+	// script from then on.
+	setIfEqualUnsupported *atomic.Bool
+	// The prefix the core adds to the keys of the commands, or an empty string if the keys are not prefixed.
+	keyPrefix string
+	// The interceptors of the requests, the first one being the outermost. Empty unless configured.
+	interceptors []config.Interceptor
+	// The context identifying the connection lifecycle hooks of the client to the core, or zero if none are configured.
+	connectionEventsID uintptr
 }
 
 // setMessageHandler assigns a message handler to the client for processing pub/sub messages
@@ -154,19 +212,57 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 	if err != nil {
 		return nil, NewClosingError(err.Error())
 	}
-	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), mu: &sync.Mutex{}}
+	client := &baseClient{
+		pending:                make(map[unsafe.Pointer]struct{}),
+		mu:                     &sync.Mutex{},
+		buffers:                newCommandBuffers(config.GetBufferPool()),
+		hedgeLatencies:         newHedgeLatencyWindow(),
+		readFromReplica:        &atomic.Bool{},
+		customCommandInfo:      &sync.Map{},
+		auditHook:              config.GetAuditHook(),
+		sheddingFraction:       config.GetLatencyBudgetShedding(),
+		blockingTimeoutMargin:  config.GetBlockingTimeoutMargin(),
+		commandPolicy:          config.GetCommandPolicy(),
+		clientNameSuffix:       config.GetClientNameSuffix(),
+		compatibilityFallbacks: config.GetCompatibilityFallbacks(),
+		sInterCardUnsupported:  &atomic.Bool{},
+		setIfEqualUnsupported:  &atomic.Bool{},
+		keyPrefix:              config.GetKeyPrefix(),
+		interceptors:           config.GetInterceptors(),
+		keyWatchers:            newKeyWatchers(),
+	}
+	client.readFromReplica.Store(readsFromReplica(config.GetReadFrom()))
+	if cacheConfig := config.GetIntrospectionCache(); cacheConfig != nil {
+		client.introspectionCache = utils.NewLRUCache[string, any](cacheConfig.GetMaxEntries(), cacheConfig.GetTTL())
+	}
+
+	hooks := config.GetConnectionLifecycleHooks()
+	if cacheConfig := config.GetClientSideCache(); cacheConfig != nil {
+		client.clientSideCache = newClientSideCache(cacheConfig, client.keyPrefix)
+		// The invalidations pushed while a connection is down are missed
+		hooks = client.clientSideCache.withConnectionHooks(hooks)
+	}
+
+	var connectionEventCallback C.ConnectionEventCallback
+	if hooks != nil {
+		client.connectionEventsID = registerConnectionEvents(hooks)
+		connectionEventCallback = (C.ConnectionEventCallback)(unsafe.Pointer(C.connectionEventCallback))
+	}
 
 	cResponse := (*C.struct_ConnectionResponse)(
-		C.create_client(
+		C.create_client_with_connection_events(
 			(*C.uchar)(requestBytes),
 			C.uintptr_t(byteCount),
 			&clientType,
 			(C.PubSubCallback)(unsafe.Pointer(C.pubSubCallback)),
+			connectionEventCallback,
+			C.uintptr_t(client.connectionEventsID),
 		),
 	)
 	defer C.free_connection_response(cResponse)
 	cErr := cResponse.connection_error_message
 	if cErr != nil {
+		unregisterConnectionEvents(client.connectionEventsID)
 		message := C.GoString(cErr)
 		return nil, NewConnectionError(message)
 	}
@@ -189,6 +285,11 @@ func (client *baseClient) Close() {
 	}
 
 	unregisterClient(uintptr(client.coreClient))
+	unregisterConnectionEvents(client.connectionEventsID)
+	if client.messageHandler != nil {
+		// The push notifications blocked by a full queue would block the closing of the client
+		client.messageHandler.queue.release()
+	}
 
 	C.close_client(client.coreClient)
 	client.coreClient = nil
@@ -271,17 +372,29 @@ func routeToProtobuf(route config.Route) (*protobuf.Routes, error) {
 				},
 			}, nil
 		}
+	case readOnlyRoute:
+		return &protobuf.Routes{ReadOnly: true}, nil
 	default:
 		return nil, errors.New("invalid route type")
 	}
 }
 
-func (client *baseClient) executeCommandWithRoute(
+// prefixRoute returns `route` with its key prefixed if the client prefixes keys, so that the command is routed to the slot
+// of the prefixed keys the core sends it with.
+func (client *baseClient) prefixRoute(route config.Route) config.Route {
+	slotKeyRoute, ok := route.(*config.SlotKeyRoute)
+	if !ok || client.keyPrefix == "" {
+		return route
+	}
+	return config.NewSlotKeyRoute(slotKeyRoute.SlotType, client.keyPrefix+slotKeyRoute.SlotKey)
+}
+
+func (client *baseClient) sendCommandWithRoute(
 	ctx context.Context,
 	requestType C.RequestType,
 	args []string,
 	route config.Route,
-) (*C.struct_CommandResponse, error) {
+) (response *C.struct_CommandResponse, err error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
@@ -289,6 +402,21 @@ func (client *baseClient) executeCommandWithRoute(
 	default:
 		// Continue with execution
 	}
+	if client.auditHook != nil {
+		start := time.Now()
+		defer func() { client.audit(ctx, commandName(requestType, args), start, err) }()
+	}
+	if err := client.checkLatencyBudget(ctx); err != nil {
+		return nil, err
+	}
+	if err := client.checkRequestCommandPolicy(requestType, args); err != nil {
+		return nil, err
+	}
+	if replica, replicaRoute, ok := client.selectReplica(ctx, requestType, args, route); ok {
+		route = replicaRoute
+		start := time.Now()
+		defer func() { client.replicaSelection.selector.Done(replica, time.Since(start), err) }()
+	}
 	// Create span if OpenTelemetry is enabled and sampling is configured
 	var spanPtr uint64
 	otelInstance := GetOtelInstance()
@@ -303,29 +431,32 @@ func (client *baseClient) executeCommandWithRoute(
 		}
 		defer otelInstance.dropSpan(spanPtr)
 	}
+	// The core copies the arguments and the route before C.command returns, so the buffers can be reused afterwards.
 	var cArgsPtr *C.uintptr_t = nil
 	var argLengthsPtr *C.ulong = nil
 	if len(args) > 0 {
-		cArgs, argLengths := toCStrings(args)
-		cArgsPtr = &cArgs[0]
-		argLengthsPtr = &argLengths[0]
+		cArgs, argLengths := client.buffers.encodeArgs(args)
+		defer client.buffers.releaseArgs(cArgs, argLengths)
+		cArgsPtr = &(*cArgs)[0]
+		argLengthsPtr = &(*argLengths)[0]
 	}
 	var routeBytesPtr *C.uchar = nil
 	var routeBytesCount C.uintptr_t = 0
 	if route != nil {
-		routeProto, err := routeToProtobuf(route)
+		routeProto, err := routeToProtobuf(client.prefixRoute(route))
 		if err != nil {
 			return nil, errors.New("executeCommand failed due to invalid route")
 		}
-		msg, err := proto.Marshal(routeProto)
+		msg, err := client.buffers.encodeRoute(routeProto)
 		if err != nil {
 			return nil, err
 		}
+		defer client.buffers.releaseRoute(msg)
 
-		routeBytesCount = C.uintptr_t(len(msg))
-		routeCBytes := C.CBytes(msg)
-		defer C.free(routeCBytes)
-		routeBytesPtr = (*C.uchar)(routeCBytes)
+		routeBytesCount = C.uintptr_t(len(*msg))
+		if len(*msg) > 0 {
+			routeBytesPtr = (*C.uchar)(unsafe.Pointer(&(*msg)[0]))
+		}
 	}
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
 	resultChannel := make(chan payload, 1)
@@ -387,9 +518,7 @@ func (client *baseClient) executeCommandWithRoute(
 }
 
 // Zero copying conversion from go's []string into C pointers
-func toCStrings(args []string) ([]C.uintptr_t, []C.ulong) {
-	cStrings := make([]C.uintptr_t, len(args))
-	stringLengths := make([]C.ulong, len(args))
+func toCStrings(args []string, cStrings []C.uintptr_t, stringLengths []C.ulong) {
 	for i, str := range args {
 		bytes := utils.StringToBytes(str)
 		var ptr uintptr
@@ -399,15 +528,64 @@ func toCStrings(args []string) ([]C.uintptr_t, []C.ulong) {
 		cStrings[i] = C.uintptr_t(ptr)
 		stringLengths[i] = C.size_t(len(str))
 	}
+}
+
+// commandBuffers holds the pools of buffers used to pass the arguments and the route of a command to the core. The core
+// copies them before returning, so they can be reused by the next command instead of being allocated again.
+type commandBuffers struct {
+	args    *utils.SlicePool[C.uintptr_t]
+	lengths *utils.SlicePool[C.ulong]
+	route   *utils.SlicePool[byte]
+}
+
+func newCommandBuffers(bufferPool *config.BufferPoolConfiguration) *commandBuffers {
+	if !bufferPool.IsEnabled() {
+		// Nil pools allocate new buffers on every call.
+		return &commandBuffers{}
+	}
+	return &commandBuffers{
+		args:    utils.NewSlicePool[C.uintptr_t](bufferPool.GetMaxArgs()),
+		lengths: utils.NewSlicePool[C.ulong](bufferPool.GetMaxArgs()),
+		route:   utils.NewSlicePool[byte](bufferPool.GetMaxBytes()),
+	}
+}
+
+// encodeArgs converts `args` to the pointers and lengths expected by the core, in pooled buffers which must be returned
+// with releaseArgs once the core returns.
+func (buffers *commandBuffers) encodeArgs(args []string) (*[]C.uintptr_t, *[]C.ulong) {
+	cStrings := buffers.args.Get(len(args))
+	stringLengths := buffers.lengths.Get(len(args))
+	toCStrings(args, *cStrings, *stringLengths)
 	return cStrings, stringLengths
 }
 
-func (client *baseClient) executeBatch(
+func (buffers *commandBuffers) releaseArgs(cStrings *[]C.uintptr_t, stringLengths *[]C.ulong) {
+	buffers.args.Put(cStrings)
+	buffers.lengths.Put(stringLengths)
+}
+
+// encodeRoute marshals `route` into a pooled buffer, which must be returned with releaseRoute once the core returns.
+func (buffers *commandBuffers) encodeRoute(route proto.Message) (*[]byte, error) {
+	buf := buffers.route.Get(0)
+	msg, err := proto.MarshalOptions{}.MarshalAppend(*buf, route)
+	if err != nil {
+		buffers.route.Put(buf)
+		return nil, err
+	}
+	*buf = msg
+	return buf, nil
+}
+
+func (buffers *commandBuffers) releaseRoute(buf *[]byte) {
+	buffers.route.Put(buf)
+}
+
+func (client *baseClient) sendBatch(
 	ctx context.Context,
 	batch internal.Batch,
 	raiseOnError bool,
 	options *internal.BatchOptions,
-) ([]any, error) {
+) (result []any, err error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
@@ -415,6 +593,16 @@ func (client *baseClient) executeBatch(
 	default:
 		// Continue with execution
 	}
+	if client.auditHook != nil {
+		start := time.Now()
+		defer func() { client.audit(ctx, "Batch", start, err) }()
+	}
+	if err := client.checkLatencyBudget(ctx); err != nil {
+		return nil, err
+	}
+	if err := client.checkBatchCommandPolicy(batch); err != nil {
+		return nil, err
+	}
 	if len(batch.Errors) > 0 {
 		return nil, NewBatchError(batch.Errors)
 	}
@@ -454,7 +642,9 @@ func (client *baseClient) executeBatch(
 	batchInfo := createBatchInfo(pinner, batch)
 	var optionsPtr *C.BatchOptionsInfo
 	if options != nil {
-		batchOptionsInfo := createBatchOptionsInfo(pinner, *options)
+		prefixedOptions := *options
+		prefixedOptions.Route = client.prefixRoute(prefixedOptions.Route)
+		batchOptionsInfo := createBatchOptionsInfo(pinner, prefixedOptions)
 		optionsPtr = &batchOptionsInfo
 	}
 
@@ -730,6 +920,216 @@ func (client *baseClient) ResetConnectionPassword(ctx context.Context) (string,
 	return client.submitConnectionPasswordUpdate(ctx, "", false)
 }
 
+func (client *baseClient) submitConnectionCredentialsUpdate(
+	ctx context.Context,
+	username string,
+	password string,
+) (string, error) {
+	// Check if context is already done
+	select {
+	case <-ctx.Done():
+		return models.DefaultStringResponse, ctx.Err()
+	default:
+		// Continue with execution
+	}
+
+	// Create a channel to receive the result
+	resultChannel := make(chan payload, 1)
+	resultChannelPtr := unsafe.Pointer(&resultChannel)
+
+	pinner := pinner{}
+	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
+	defer pinner.Unpin()
+
+	client.mu.Lock()
+	if client.coreClient == nil {
+		client.mu.Unlock()
+		return models.DefaultStringResponse, NewClosingError("UpdateConnectionCredentials failed. The client is closed.")
+	}
+	client.pending[resultChannelPtr] = struct{}{}
+
+	username_cstring := C.CString(username)
+	defer C.free(unsafe.Pointer(username_cstring))
+	password_cstring := C.CString(password)
+	defer C.free(unsafe.Pointer(password_cstring))
+	C.update_connection_credentials(
+		client.coreClient,
+		C.uintptr_t(pinnedChannelPtr),
+		username_cstring,
+		password_cstring,
+	)
+	client.mu.Unlock()
+
+	// Wait for result or context cancellation
+	var payload payload
+	select {
+	case <-ctx.Done():
+		client.mu.Lock()
+		if client.pending != nil {
+			delete(client.pending, resultChannelPtr)
+		}
+		client.mu.Unlock()
+		// Start cleanup goroutine
+		go func() {
+			// Wait for payload on separate channel
+			if payload := <-resultChannel; payload.value != nil {
+				C.free_command_response(payload.value)
+			}
+		}()
+		return models.DefaultStringResponse, ctx.Err()
+	case payload = <-resultChannel:
+		// Continue with normal processing
+	}
+
+	client.mu.Lock()
+	if client.pending != nil {
+		delete(client.pending, resultChannelPtr)
+	}
+	client.mu.Unlock()
+
+	if payload.error != nil {
+		return models.DefaultStringResponse, payload.error
+	}
+
+	return handleOkResponse(payload.value)
+}
+
+// UpdateConnectionCredentials re-authenticates all the open connections with new credentials, and uses them for future
+// reconnections.
+//
+// This method allows rotating secrets without downtime: once the new credentials are accepted by the servers, for
+// instance after an ACL user got a second password, the client switches to them in place, without being recreated and
+// without losing in-flight commands. The old credentials can then be revoked on the server side.
+//
+// The connections are authenticated with the `AUTH` command, sent to all the nodes. The stored credentials are only
+// replaced once every connection was authenticated, so the client keeps its previous credentials if the update fails.
+//
+// Note:
+//
+//	This method does not change the credentials on the server side.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The new username. If empty, the connections are authenticated as the `default` user.
+//	password - The new password. Must not be empty.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+func (client *baseClient) UpdateConnectionCredentials(ctx context.Context, username string, password string) (string, error) {
+	return client.submitConnectionCredentialsUpdate(ctx, username, password)
+}
+
+func (client *baseClient) submitConfigUpdate(ctx context.Context, update *config.ConfigUpdate) (string, error) {
+	request, err := update.ToProtobuf()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	msg, err := proto.Marshal(request)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+
+	// Check if context is already done
+	select {
+	case <-ctx.Done():
+		return models.DefaultStringResponse, ctx.Err()
+	default:
+		// Continue with execution
+	}
+
+	// Create a channel to receive the result
+	resultChannel := make(chan payload, 1)
+	resultChannelPtr := unsafe.Pointer(&resultChannel)
+
+	pinner := pinner{}
+	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
+	defer pinner.Unpin()
+
+	client.mu.Lock()
+	if client.coreClient == nil {
+		client.mu.Unlock()
+		return models.DefaultStringResponse, NewClosingError("UpdateConfig failed. The client is closed.")
+	}
+	client.pending[resultChannelPtr] = struct{}{}
+
+	updateBytes := C.CBytes(msg)
+	defer C.free(updateBytes)
+	C.update_client_config(
+		client.coreClient,
+		C.uintptr_t(pinnedChannelPtr),
+		(*C.uchar)(updateBytes),
+		C.uintptr_t(len(msg)),
+	)
+	client.mu.Unlock()
+
+	// Wait for result or context cancellation
+	var payload payload
+	select {
+	case <-ctx.Done():
+		client.mu.Lock()
+		if client.pending != nil {
+			delete(client.pending, resultChannelPtr)
+		}
+		client.mu.Unlock()
+		// Start cleanup goroutine
+		go func() {
+			// Wait for payload on separate channel
+			if payload := <-resultChannel; payload.value != nil {
+				C.free_command_response(payload.value)
+			}
+		}()
+		return models.DefaultStringResponse, ctx.Err()
+	case payload = <-resultChannel:
+		// Continue with normal processing
+	}
+
+	client.mu.Lock()
+	if client.pending != nil {
+		delete(client.pending, resultChannelPtr)
+	}
+	client.mu.Unlock()
+
+	if payload.error != nil {
+		return models.DefaultStringResponse, payload.error
+	}
+
+	response, err := handleOkResponse(payload.value)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	if readFrom, ok := update.GetReadFrom(); ok {
+		client.readFromReplica.Store(readsFromReplica(readFrom))
+	}
+	return response, nil
+}
+
+// UpdateConfig updates the settings of the client while it is running, without recreating it and dropping its
+// connections: the request timeout, the read strategy and the reconnect strategy. The settings that are not set in
+// `update` are left unchanged.
+//
+// The update is validated before any setting is applied, so a rejected update leaves the client unchanged. The new
+// request timeout applies to the requests sent after the update, the new read strategy to the read-only commands sent
+// after the update, and the new reconnect strategy to the reconnections started after the update.
+//
+// Note:
+//
+//	Switching to an AZ affinity read strategy is only supported by clients created with an AZ affinity read strategy,
+//	as the availability zones of the nodes are only discovered by these clients.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	update - The settings to update. See [config.ConfigUpdate].
+//
+// Return value:
+//
+//	`"OK"` response on success.
+func (client *baseClient) UpdateConfig(ctx context.Context, update *config.ConfigUpdate) (string, error) {
+	return client.submitConfigUpdate(ctx, update)
+}
+
 // submitRefreshIamToken is the internal implementation for manually refreshing the IAM authentication token.
 //
 // This method sends a refresh request to the core client to generate a new IAM token and update
@@ -924,6 +1324,9 @@ func (client *baseClient) SetWithOptions(
 // Get string value associated with the given key, or models.CreateNilStringResult() is returned if no such key
 // exists.
 //
+// If the client-side cache is enabled with [config.ClientConfiguration.WithClientSideCache] or
+// [config.ClusterClientConfiguration.WithClientSideCache], the value of a cached key may be served from the cache.
+//
 // See [valkey.io] for details.
 //
 // Parameters:
@@ -937,6 +1340,9 @@ func (client *baseClient) SetWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/get/
 func (client *baseClient) Get(ctx context.Context, key string) (models.Result[string], error) {
+	if client.clientSideCache.tracks(key) {
+		return client.cachedGet(ctx, key)
+	}
 	result, err := client.executeCommand(ctx, C.Get, []string{key})
 	if err != nil {
 		return models.CreateNilStringResult(), err
@@ -945,6 +1351,33 @@ func (client *baseClient) Get(ctx context.Context, key string) (models.Result[st
 	return handleStringOrNilResponse(result)
 }
 
+// GetInto copies the value associated with the given key into `dst`, without allocating a new string for every response.
+// This is useful in high throughput workloads reading large values, where `dst` can be reused across calls.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to be retrieved from the database.
+//	dst - The buffer the value is copied to.
+//
+// Return value:
+//
+//	The length of the value, which has been copied to `dst[:n]`, or -1 if key does not exist.
+//	If the value is longer than `dst`, nothing is copied and [io.ErrShortBuffer] is returned together with the length of
+//	the value, so that the call can be retried with a buffer large enough.
+//
+// [valkey.io]: https://valkey.io/commands/get/
+func (client *baseClient) GetInto(ctx context.Context, key string, dst []byte) (int, error) {
+	result, err := client.executeCommand(ctx, C.Get, []string{key})
+	if err != nil {
+		return 0, err
+	}
+
+	return handleStringIntoResponse(result, dst)
+}
+
 // Get string value associated with the given key, or an empty string is returned [models.CreateNilStringResult()] if no such
 // value exists.
 //
@@ -1105,7 +1538,8 @@ func (client *baseClient) Move(ctx context.Context, key string, dbIndex int64) (
 //	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
 //	call will return the first encountered error, even though some requests may have succeeded
 //	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
+//	the request into sub-requests per slot to ensure atomicity, or [Client.MGetWithOptions] to get
+//	the values of the keys of the slots that succeeded.
 //
 // Parameters:
 //
@@ -1518,6 +1952,35 @@ func (client *baseClient) HGet(ctx context.Context, key string, field string) (m
 	return handleStringOrNilResponse(result)
 }
 
+// HGetInto copies the value associated with field in the hash stored at key into `dst`, without allocating a new string
+// for every response.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	field - The field in the hash stored at key to retrieve from the database.
+//	dst - The buffer the value is copied to.
+//
+// Return value:
+//
+//	The length of the value, which has been copied to `dst[:n]`, or -1 if field is not present in the hash or key does
+//	not exist.
+//	If the value is longer than `dst`, nothing is copied and [io.ErrShortBuffer] is returned together with the length of
+//	the value, so that the call can be retried with a buffer large enough.
+//
+// [valkey.io]: https://valkey.io/commands/hget/
+func (client *baseClient) HGetInto(ctx context.Context, key string, field string, dst []byte) (int, error) {
+	result, err := client.executeCommand(ctx, C.HGet, []string{key, field})
+	if err != nil {
+		return 0, err
+	}
+
+	return handleStringIntoResponse(result, dst)
+}
+
 // HGetAll returns all fields and values of the hash stored at key.
 //
 // See [valkey.io] for details.
@@ -1541,6 +2004,55 @@ func (client *baseClient) HGetAll(ctx context.Context, key string) (map[string]s
 	return handleStringToStringMapResponse(result)
 }
 
+// HGetAllOrdered returns all fields and values of the hash stored at key, like `HGetAll`, in the order returned by the
+// server. The small hashes, encoded as listpacks, keep their fields in insertion order, so that the order is
+// deterministic, e.g. to serialize the hash or to inspect its encoding.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//
+// Return value:
+//
+//	A slice of all fields and their values in the hash, in the order returned by the server, or an empty slice when
+//	key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/hgetall/
+func (client *baseClient) HGetAllOrdered(ctx context.Context, key string) ([]models.FieldValue, error) {
+	result, err := client.executeCommand(ctx, C.HGetAll, []string{key})
+	if err != nil {
+		return nil, err
+	}
+
+	return handleFieldValueArrayResponse(result)
+}
+
+// HGetAllSorted returns all fields and values of the hash stored at key, like `HGetAll`, sorted by field, so that the
+// result is iterated and serialized deterministically whatever the encoding of the hash, e.g. to compare JSON snapshots.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//
+// Return value:
+//
+//	All fields and their values in the hash, sorted by field, or an empty map when key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/hgetall/
+func (client *baseClient) HGetAllSorted(ctx context.Context, key string) (models.OrderedMap, error) {
+	fields, err := client.HGetAll(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewOrderedMap(fields), nil
+}
+
 // HMGet returns the values associated with the specified fields in the hash stored at key.
 //
 // See [valkey.io] for details.
@@ -2934,7 +3446,8 @@ func (client *baseClient) SInterStore(ctx context.Context, destination string, k
 //
 // Since:
 //
-//	Valkey 7.0 and above.
+//	Valkey 7.0 and above, or any version with the compatibility fallbacks of the client, see
+//	[config.ClientConfiguration.WithCompatibilityFallbacks].
 //
 // Note:
 //
@@ -2953,8 +3466,14 @@ func (client *baseClient) SInterStore(ctx context.Context, destination string, k
 //
 // [valkey.io]: https://valkey.io/commands/sintercard/
 func (client *baseClient) SInterCard(ctx context.Context, keys []string) (int64, error) {
+	if client.sInterCardUnsupported.Load() {
+		return client.emulateSInterCard(ctx, keys, 0)
+	}
 	result, err := client.executeCommand(ctx, C.SInterCard, append([]string{strconv.Itoa(len(keys))}, keys...))
 	if err != nil {
+		if client.isUnsupportedSInterCard(err) {
+			return client.emulateSInterCard(ctx, keys, 0)
+		}
 		return models.DefaultIntResponse, err
 	}
 
@@ -2965,7 +3484,8 @@ func (client *baseClient) SInterCard(ctx context.Context, keys []string) (int64,
 //
 // Since:
 //
-//	Valkey 7.0 and above.
+//	Valkey 7.0 and above, or any version with the compatibility fallbacks of the client, see
+//	[config.ClientConfiguration.WithCompatibilityFallbacks].
 //
 // Note:
 //
@@ -2987,6 +3507,9 @@ func (client *baseClient) SInterCard(ctx context.Context, keys []string) (int64,
 //
 // [valkey.io]: https://valkey.io/commands/sintercard/
 func (client *baseClient) SInterCardLimit(ctx context.Context, keys []string, limit int64) (int64, error) {
+	if client.sInterCardUnsupported.Load() {
+		return client.emulateSInterCard(ctx, keys, limit)
+	}
 	args := utils.Concat(
 		[]string{utils.IntToString(int64(len(keys)))},
 		keys,
@@ -2995,6 +3518,9 @@ func (client *baseClient) SInterCardLimit(ctx context.Context, keys []string, li
 
 	result, err := client.executeCommand(ctx, C.SInterCard, args)
 	if err != nil {
+		if client.isUnsupportedSInterCard(err) {
+			return client.emulateSInterCard(ctx, keys, limit)
+		}
 		return models.DefaultIntResponse, err
 	}
 
@@ -3508,7 +4034,8 @@ func (client *baseClient) LInsert(
 // [valkey.io]: https://valkey.io/commands/blpop/
 // [Blocking Commands]: https://glide.valkey.io/how-to/connection-management/#blocking-commands
 func (client *baseClient) BLPop(ctx context.Context, keys []string, timeout time.Duration) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.BLPop, append(keys, utils.FloatToString(timeout.Seconds())))
+	timeout = client.blockingTimeout(ctx, timeout)
+	result, err := client.executeCommand(ctx, C.BLPop, utils.KeysAndTimeout(keys, timeout))
 	if err != nil {
 		return nil, err
 	}
@@ -3541,7 +4068,8 @@ func (client *baseClient) BLPop(ctx context.Context, keys []string, timeout time
 // [valkey.io]: https://valkey.io/commands/brpop/
 // [Blocking Commands]: https://glide.valkey.io/how-to/connection-management/#blocking-commands
 func (client *baseClient) BRPop(ctx context.Context, keys []string, timeout time.Duration) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.BRPop, append(keys, utils.FloatToString(timeout.Seconds())))
+	timeout = client.blockingTimeout(ctx, timeout)
+	result, err := client.executeCommand(ctx, C.BRPop, utils.KeysAndTimeout(keys, timeout))
 	if err != nil {
 		return nil, err
 	}
@@ -3738,6 +4266,7 @@ func (client *baseClient) BLMPop(
 	listDirection constants.ListDirection,
 	timeout time.Duration,
 ) ([]models.KeyValues, error) {
+	timeout = client.blockingTimeout(ctx, timeout)
 	listDirectionStr, err := listDirection.ToString()
 	if err != nil {
 		return nil, err
@@ -3798,6 +4327,7 @@ func (client *baseClient) BLMPopCount(
 	count int64,
 	timeout time.Duration,
 ) ([]models.KeyValues, error) {
+	timeout = client.blockingTimeout(ctx, timeout)
 	listDirectionStr, err := listDirection.ToString()
 	if err != nil {
 		return nil, err
@@ -3930,6 +4460,7 @@ func (client *baseClient) BLMove(
 	whereTo constants.ListDirection,
 	timeout time.Duration,
 ) (models.Result[string], error) {
+	timeout = client.blockingTimeout(ctx, timeout)
 	whereFromStr, err := whereFrom.ToString()
 	if err != nil {
 		return models.CreateNilStringResult(), err
@@ -4729,6 +5260,7 @@ func (client *baseClient) XReadWithOptions(
 	keysAndIds map[string]string,
 	opts options.XReadOptions,
 ) (map[string]models.StreamResponse, error) {
+	opts.Block = client.blockingTimeout(ctx, opts.Block)
 	args, err := internal.CreateStreamCommandArgs(make([]string, 0, 5+2*len(keysAndIds)), keysAndIds, &opts)
 	if err != nil {
 		return nil, err
@@ -4809,6 +5341,7 @@ func (client *baseClient) XReadGroupWithOptions(
 	keysAndIds map[string]string,
 	opts options.XReadGroupOptions,
 ) (map[string]models.StreamResponse, error) {
+	opts.Block = client.blockingTimeout(ctx, opts.Block)
 	args, err := internal.CreateStreamCommandArgs([]string{constants.GroupKeyword, group, consumer}, keysAndIds, &opts)
 	if err != nil {
 		return nil, err
@@ -5186,7 +5719,7 @@ func (client *baseClient) ZCard(ctx context.Context, key string) (int64, error)
 // Blocks the connection until it removes and returns a member-score pair
 // with the lowest score from the first non-empty sorted set.
 // The given `keys` being checked in the order they are provided.
-// `BZPopMin` is the blocking variant of [Client.BZPopMin] and [ClusterClient.BZPopMin].
+// `BZPopMin` is the blocking variant of [Client.ZPopMin] and [ClusterClient.ZPopMin].
 //
 // Note:
 //   - When in cluster mode, all `keys` must map to the same hash slot.
@@ -5214,7 +5747,8 @@ func (client *baseClient) BZPopMin(
 	keys []string,
 	timeout time.Duration,
 ) (models.Result[models.KeyWithMemberAndScore], error) {
-	result, err := client.executeCommand(ctx, C.BZPopMin, append(keys, utils.FloatToString(timeout.Seconds())))
+	timeout = client.blockingTimeout(ctx, timeout)
+	result, err := client.executeCommand(ctx, C.BZPopMin, utils.KeysAndTimeout(keys, timeout))
 	if err != nil {
 		return models.CreateNilKeyWithMemberAndScoreResult(), err
 	}
@@ -5260,6 +5794,7 @@ func (client *baseClient) BZMPop(
 	scoreFilter constants.ScoreFilter,
 	timeout time.Duration,
 ) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	timeout = client.blockingTimeout(ctx, timeout)
 	scoreFilterStr, err := scoreFilter.ToString()
 	if err != nil {
 		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
@@ -5324,6 +5859,7 @@ func (client *baseClient) BZMPopWithOptions(
 	timeout time.Duration,
 	opts options.ZMPopOptions,
 ) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	timeout = client.blockingTimeout(ctx, timeout)
 	scoreFilterStr, err := scoreFilter.ToString()
 	if err != nil {
 		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
@@ -5429,13 +5965,9 @@ func (client *baseClient) ZRangeWithScores(
 		return nil, err
 	}
 
-	needsReverse := false
-	for _, arg := range args {
-		if arg == "REV" {
-			needsReverse = true
-			break
-		}
-	}
+	// The response is a map, so the members are sorted again in the order of the range. Only the query arguments are
+	// checked for "REV", as the key may be "REV" too.
+	needsReverse := slices.Contains(queryArgs, "REV")
 
 	return handleSortedSetWithScoresResponse(result, needsReverse)
 }
@@ -5913,6 +6445,70 @@ func (client *baseClient) XDel(ctx context.Context, key string, ids []string) (i
 	return handleIntResponse(result)
 }
 
+// Removes the specified entries by id from a stream, like `XDel`, and returns the outcome for every entry.
+//
+// Since:
+//
+//	Valkey 8.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//	ids - An array of entry ids.
+//
+// Return value:
+//
+//	The outcome of the deletion of every entry of `ids`, in order: [models.StreamEntryDeleted] if it was deleted, or
+//	[models.StreamEntryNotFound] if it does not exist in the stream.
+//
+// [valkey.io]: https://valkey.io/commands/xdelex/
+func (client *baseClient) XDelEx(ctx context.Context, key string, ids []string) ([]models.StreamEntryDeletionStatus, error) {
+	return client.XDelExWithPolicy(ctx, key, ids, "")
+}
+
+// Removes the specified entries by id from a stream, and returns the outcome for every entry. The policy defines how the
+// references to the entries in the pending entries lists of the consumer groups are handled.
+//
+// Since:
+//
+//	Valkey 8.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx    - The context for controlling the command execution.
+//	key    - The key of the stream.
+//	ids    - An array of entry ids.
+//	policy - The deletion policy, see [constants.StreamDeletionPolicy].
+//
+// Return value:
+//
+//	The outcome of the deletion of every entry of `ids`, in order: [models.StreamEntryDeleted] if it was deleted,
+//	[models.StreamEntryNotFound] if it does not exist in the stream, or [models.StreamEntryReferenced] if it was not
+//	deleted as it is still pending in a consumer group, with [constants.AckedPolicy].
+//
+// [valkey.io]: https://valkey.io/commands/xdelex/
+func (client *baseClient) XDelExWithPolicy(
+	ctx context.Context,
+	key string,
+	ids []string,
+	policy constants.StreamDeletionPolicy,
+) ([]models.StreamEntryDeletionStatus, error) {
+	args, err := internal.BuildStreamDeletionArgs([]string{key}, ids, policy)
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.executeCommand(ctx, C.XDelEx, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleStreamEntryDeletionStatusArrayResponse(result)
+}
+
 // Returns the score of `member` in the sorted set stored at `key`.
 //
 // See [valkey.io] for details.
@@ -6303,6 +6899,58 @@ func (client *baseClient) XGroupSetIdWithOptions(
 	return handleOkResponse(result)
 }
 
+// Sets the last generated ID of a stream, which is the ID of its last entry unless entries were deleted from the end of the
+// stream. New entries must have IDs greater than the last generated ID.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//	lastId - The new last generated ID of the stream. Must not be lower than the ID of the last entry of the stream.
+//
+// Return value:
+//
+//	`"OK"`.
+//
+// [valkey.io]: https://valkey.io/commands/xsetid/
+func (client *baseClient) XSetId(ctx context.Context, key string, lastId string) (string, error) {
+	return client.XSetIdWithOptions(ctx, key, lastId, *options.NewXSetIdOptions())
+}
+
+// Sets the last generated ID of a stream, along with the number of entries ever added to the stream and the maximal
+// deleted entry ID, as reported by `XINFO STREAM`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//	lastId - The new last generated ID of the stream. Must not be lower than the ID of the last entry of the stream.
+//	opts - The options for the command. See [options.XSetIdOptions] for details.
+//
+// Return value:
+//
+//	`"OK"`.
+//
+// [valkey.io]: https://valkey.io/commands/xsetid/
+func (client *baseClient) XSetIdWithOptions(
+	ctx context.Context,
+	key string,
+	lastId string,
+	opts options.XSetIdOptions,
+) (string, error) {
+	optionArgs, _ := opts.ToArgs()
+	args := append([]string{key, lastId}, optionArgs...)
+	result, err := client.executeCommand(ctx, C.XSetId, args)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
 // Removes all elements in the sorted set stored at `key` with a lexicographical order
 // between `rangeQuery.Start` and `rangeQuery.End`.
 //
@@ -6890,6 +7538,80 @@ func (client *baseClient) XAck(ctx context.Context, key string, group string, id
 	return handleIntResponse(result)
 }
 
+// Acknowledges the specified entries in the consumer group of a stream, like `XAck`, deletes them from the stream, and
+// returns the outcome for every entry.
+//
+// Since:
+//
+//	Valkey 8.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	group - The consumer group name.
+//	ids   - Stream entry IDs to acknowledge and delete.
+//
+// Return value:
+//
+//	The outcome of every entry of `ids`, in order: [models.StreamEntryDeleted] if it was acknowledged and deleted, or
+//	[models.StreamEntryNotFound] if it is not pending in the consumer group.
+//
+// [valkey.io]: https://valkey.io/commands/xackdel/
+func (client *baseClient) XAckDel(
+	ctx context.Context,
+	key string,
+	group string,
+	ids []string,
+) ([]models.StreamEntryDeletionStatus, error) {
+	return client.XAckDelWithPolicy(ctx, key, group, ids, "")
+}
+
+// Acknowledges the specified entries in the consumer group of a stream, deletes them from the stream, and returns the
+// outcome for every entry. The policy defines how the references to the entries in the pending entries lists of the
+// other consumer groups are handled.
+//
+// Since:
+//
+//	Valkey 8.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx    - The context for controlling the command execution.
+//	key    - The key of the stream.
+//	group  - The consumer group name.
+//	ids    - Stream entry IDs to acknowledge and delete.
+//	policy - The deletion policy, see [constants.StreamDeletionPolicy].
+//
+// Return value:
+//
+//	The outcome of every entry of `ids`, in order: [models.StreamEntryDeleted] if it was acknowledged and deleted,
+//	[models.StreamEntryNotFound] if it is not pending in the consumer group, or [models.StreamEntryReferenced] if it was
+//	acknowledged but not deleted as it is still pending in another consumer group, with [constants.AckedPolicy].
+//
+// [valkey.io]: https://valkey.io/commands/xackdel/
+func (client *baseClient) XAckDelWithPolicy(
+	ctx context.Context,
+	key string,
+	group string,
+	ids []string,
+	policy constants.StreamDeletionPolicy,
+) ([]models.StreamEntryDeletionStatus, error) {
+	args, err := internal.BuildStreamDeletionArgs([]string{key, group}, ids, policy)
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.executeCommand(ctx, C.XAckDel, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleStreamEntryDeletionStatusArrayResponse(result)
+}
+
 // Sets or clears the bit at offset in the string value stored at key.
 // The offset is a zero-based index, with `0` being the first element of
 // the list, `1` being the next element, and so on. The offset must be
@@ -6961,6 +7683,7 @@ func (client *baseClient) GetBit(ctx context.Context, key string, offset int64)
 //
 // [valkey.io]: https://valkey.io/commands/wait/
 func (client *baseClient) Wait(ctx context.Context, numberOfReplicas int64, timeout time.Duration) (int64, error) {
+	timeout = client.blockingTimeout(ctx, timeout)
 	result, err := client.executeCommand(
 		ctx,
 		C.Wait,
@@ -7012,7 +7735,8 @@ func (client *baseClient) BitCount(ctx context.Context, key string) (int64, erro
 //
 // Return value:
 //
-//	The size of the string stored in destination.
+//	The size of the string stored in destination. If the number of keys is invalid for the operation, an
+//	[options.BitOpArgumentError] is returned without contacting the server.
 //
 // [valkey.io]: https://valkey.io/commands/bitop/
 func (client *baseClient) BitOp(
@@ -7031,7 +7755,7 @@ func (client *baseClient) BitOp(
 	}
 	result, err := client.executeCommand(ctx, C.BitOp, args)
 	if err != nil {
-		return models.DefaultIntResponse, errors.New("bitop command execution failed")
+		return models.DefaultIntResponse, err
 	}
 	return handleIntResponse(result)
 }
@@ -8269,8 +8993,9 @@ func (client *baseClient) ZLexCount(ctx context.Context, key string, rangeQuery
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - An array of keys to check for elements.
-//	timeout - The maximum number of seconds to block (0 blocks indefinitely).
+//	keys - The keys of the sorted sets.
+//	timeout - The duration to wait for a blocking operation to complete. A value of
+//	  `0` will block indefinitely.
 //
 // Return value:
 //
@@ -8285,9 +9010,8 @@ func (client *baseClient) BZPopMax(
 	keys []string,
 	timeout time.Duration,
 ) (models.Result[models.KeyWithMemberAndScore], error) {
-	args := append(keys, utils.FloatToString(timeout.Seconds()))
-
-	result, err := client.executeCommand(ctx, C.BZPopMax, args)
+	timeout = client.blockingTimeout(ctx, timeout)
+	result, err := client.executeCommand(ctx, C.BZPopMax, utils.KeysAndTimeout(keys, timeout))
 	if err != nil {
 		return models.CreateNilKeyWithMemberAndScoreResult(), err
 	}
@@ -9459,7 +10183,7 @@ func (client *baseClient) InvokeScriptWithOptions(
 	return handleAnyResponse(response)
 }
 
-// executeScriptWithRoute executes a Lua script with the given hash, keys, args, and routing information.
+// sendScriptWithRoute executes a Lua script with the given hash, keys, args, and routing information.
 //
 // Parameters:
 //
@@ -9472,13 +10196,13 @@ func (client *baseClient) InvokeScriptWithOptions(
 // Return value:
 //
 //	A CommandResponse containing the result of the script execution.
-func (client *baseClient) executeScriptWithRoute(
+func (client *baseClient) sendScriptWithRoute(
 	ctx context.Context,
 	hash string,
 	keys []string,
 	args []string,
 	route config.Route,
-) (*C.struct_CommandResponse, error) {
+) (response *C.struct_CommandResponse, err error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
@@ -9486,38 +10210,51 @@ func (client *baseClient) executeScriptWithRoute(
 	default:
 		// Continue with execution
 	}
+	if client.auditHook != nil {
+		start := time.Now()
+		defer func() { client.audit(ctx, "EVALSHA", start, err) }()
+	}
+	if err := client.checkLatencyBudget(ctx); err != nil {
+		return nil, err
+	}
+	if err := client.checkCommandPolicy("EVALSHA"); err != nil {
+		return nil, err
+	}
 	var cKeysPtr *C.uintptr_t = nil
 	var keysLengthsPtr *C.ulong = nil
 	if len(keys) > 0 {
-		cKeys, keysLengths := toCStrings(keys)
-		cKeysPtr = &cKeys[0]
-		keysLengthsPtr = &keysLengths[0]
+		cKeys, keysLengths := client.buffers.encodeArgs(keys)
+		defer client.buffers.releaseArgs(cKeys, keysLengths)
+		cKeysPtr = &(*cKeys)[0]
+		keysLengthsPtr = &(*keysLengths)[0]
 	}
 
 	var cArgsPtr *C.uintptr_t = nil
 	var argsLengthsPtr *C.ulong = nil
 	if len(args) > 0 {
-		cArgs, argsLengths := toCStrings(args)
-		cArgsPtr = &cArgs[0]
-		argsLengthsPtr = &argsLengths[0]
+		cArgs, argsLengths := client.buffers.encodeArgs(args)
+		defer client.buffers.releaseArgs(cArgs, argsLengths)
+		cArgsPtr = &(*cArgs)[0]
+		argsLengthsPtr = &(*argsLengths)[0]
 	}
 
 	var routeBytesPtr *C.uchar = nil
 	var routeBytesCount C.uintptr_t = 0
 	if route != nil {
-		routeProto, err := routeToProtobuf(route)
+		routeProto, err := routeToProtobuf(client.prefixRoute(route))
 		if err != nil {
 			return nil, errors.New("ExecuteScript failed due to invalid route")
 		}
-		msg, err := proto.Marshal(routeProto)
+		msg, err := client.buffers.encodeRoute(routeProto)
 		if err != nil {
 			return nil, err
 		}
+		defer client.buffers.releaseRoute(msg)
 
-		routeBytesCount = C.uintptr_t(len(msg))
-		routeCBytes := C.CBytes(msg)
-		defer C.free(routeCBytes)
-		routeBytesPtr = (*C.uchar)(routeCBytes)
+		routeBytesCount = C.uintptr_t(len(*msg))
+		if len(*msg) > 0 {
+			routeBytesPtr = (*C.uchar)(unsafe.Pointer(&(*msg)[0]))
+		}
 	}
 
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
@@ -9754,20 +10491,54 @@ func (client *baseClient) Watch(ctx context.Context, keys []string) (string, err
 //	  - compression_skipped_count: Number of times compression was skipped
 //	  - subscription_out_of_sync_count: Number of times subscriptions were out of sync during reconciliation
 //	  - subscription_last_sync_timestamp: Timestamp of last successful subscription sync (milliseconds since epoch)
+//	  - moved_redirect_count: Number of MOVED redirects received from cluster nodes
+//	  - ask_redirect_count: Number of ASK redirects received from cluster nodes
+//	  - circuit_breaker_opened_count: Number of times a node circuit breaker opened
+//	  - circuit_breaker_half_opened_count: Number of times a node circuit breaker became half-open
+//	  - circuit_breaker_closed_count: Number of times a node circuit breaker closed
+//	  - circuit_breaker_rejected_count: Number of requests rejected by an open circuit breaker
+//	  - slot_refresh_count: Number of cluster slot refreshes completed
+//	  - slot_refresh_unchanged_count: Number of cluster slot refreshes that found an unchanged topology and kept the slot map
+//	  - slot_refresh_total_duration_ms: Total duration of the cluster slot refreshes, in milliseconds
+//	  - slot_refresh_last_duration_ms: Duration of the last cluster slot refresh, in milliseconds
+//	  - incremental_slot_update_count: Number of slot map updates applied from MOVED redirects, without a slot refresh
+//	  - pubsub_dropped_messages_count: Number of PubSub messages this client dropped because its queue was full, see
+//	    [config.ClientConfiguration.WithPubSubBackpressure]
 func (client *baseClient) GetStatistics() map[string]uint64 {
 	stats := C.get_statistics()
 	return map[string]uint64{
-		"total_connections":                uint64(stats.total_connections),
-		"total_clients":                    uint64(stats.total_clients),
-		"total_values_compressed":          uint64(stats.total_values_compressed),
-		"total_values_decompressed":        uint64(stats.total_values_decompressed),
-		"total_original_bytes":             uint64(stats.total_original_bytes),
-		"total_bytes_compressed":           uint64(stats.total_bytes_compressed),
-		"total_bytes_decompressed":         uint64(stats.total_bytes_decompressed),
-		"compression_skipped_count":        uint64(stats.compression_skipped_count),
-		"subscription_out_of_sync_count":   uint64(stats.subscription_out_of_sync_count),
-		"subscription_last_sync_timestamp": uint64(stats.subscription_last_sync_timestamp),
-	}
+		"total_connections":                 uint64(stats.total_connections),
+		"total_clients":                     uint64(stats.total_clients),
+		"total_values_compressed":           uint64(stats.total_values_compressed),
+		"total_values_decompressed":         uint64(stats.total_values_decompressed),
+		"total_original_bytes":              uint64(stats.total_original_bytes),
+		"total_bytes_compressed":            uint64(stats.total_bytes_compressed),
+		"total_bytes_decompressed":          uint64(stats.total_bytes_decompressed),
+		"compression_skipped_count":         uint64(stats.compression_skipped_count),
+		"subscription_out_of_sync_count":    uint64(stats.subscription_out_of_sync_count),
+		"subscription_last_sync_timestamp":  uint64(stats.subscription_last_sync_timestamp),
+		"moved_redirect_count":              uint64(stats.moved_redirect_count),
+		"ask_redirect_count":                uint64(stats.ask_redirect_count),
+		"circuit_breaker_opened_count":      uint64(stats.circuit_breaker_opened_count),
+		"circuit_breaker_half_opened_count": uint64(stats.circuit_breaker_half_opened_count),
+		"circuit_breaker_closed_count":      uint64(stats.circuit_breaker_closed_count),
+		"circuit_breaker_rejected_count":    uint64(stats.circuit_breaker_rejected_count),
+		"slot_refresh_count":                uint64(stats.slot_refresh_count),
+		"slot_refresh_unchanged_count":      uint64(stats.slot_refresh_unchanged_count),
+		"slot_refresh_total_duration_ms":    uint64(stats.slot_refresh_total_duration_ms),
+		"slot_refresh_last_duration_ms":     uint64(stats.slot_refresh_last_duration_ms),
+		"incremental_slot_update_count":     uint64(stats.incremental_slot_update_count),
+		"pubsub_dropped_messages_count":     client.pubSubDroppedCount(),
+	}
+}
+
+// pubSubDroppedCount returns the number of Pub/Sub messages dropped because the queue of the client was full, see
+// [config.ClientConfiguration.WithPubSubBackpressure].
+func (client *baseClient) pubSubDroppedCount() uint64 {
+	if handler := client.getMessageHandler(); handler != nil {
+		return handler.queue.DroppedCount()
+	}
+	return 0
 }
 
 // AllChannels represents "unsubscribe from all channels".
@@ -0,0 +1,33 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// BenchmarkCreateBatchInfo measures the cost of passing a batch of 1000 `SET` to the core, which does not depend on the
+// size of the values since their bytes are not copied.
+func BenchmarkCreateBatchInfo(b *testing.B) {
+	for _, size := range []int{16, 1024, 64 * 1024} {
+		value := strings.Repeat("v", size)
+		batch := pipeline.NewClusterBatch(false)
+		for i := 0; i < 1000; i++ {
+			batch.Set("key:"+strconv.Itoa(i), value)
+		}
+		b.Run(fmt.Sprintf("value_%dB", size), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(1000 * size))
+			for i := 0; i < b.N; i++ {
+				p := pinner{}
+				_ = createBatchInfo(p, batch.Batch)
+				p.Unpin()
+			}
+		})
+	}
+}
@@ -44,6 +44,33 @@ func ExampleClient_Exec_pipeline() {
 	// Output: [OK OK value1 value2]
 }
 
+func ExampleClient_Exec_template() {
+	var client *Client = getExampleClient() // example helper function
+	// Example 3: Batch instantiated from a template
+	template, err := pipeline.NewStandaloneTemplate("view", false, func(batch *pipeline.StandaloneBatch) {
+		batch.Set(pipeline.Param("page"), "content").Incr("views:" + pipeline.Param("page"))
+	})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, page := range []string{"home", "about", "home"} {
+		batch, err := template.Instantiate(map[string]string{"page": page})
+		if err != nil {
+			fmt.Println("Glide example failed with an error: ", err)
+		}
+		result, err := client.Exec(context.Background(), *batch, true)
+		if err != nil {
+			fmt.Println("Glide example failed with an error: ", err)
+		}
+		fmt.Println(result)
+	}
+
+	// Output:
+	// [OK 1]
+	// [OK 1]
+	// [OK 2]
+}
+
 func ExampleClient_ExecWithOptions_transaction() {
 	var client *Client = getExampleClient() // example helper function
 	// Example 1: Atomic Batch (Transaction)
@@ -159,6 +186,44 @@ func ExampleClusterClient_ExecWithOptions_route() {
 	// Output:
 }
 
+func ExampleClient_ExecTransaction() {
+	var client *Client = getExampleClient() // example helper function
+	batch := pipeline.NewStandaloneBatch(true)
+	batch.Set("key", "not a number").Incr("key").Get("key")
+
+	result, err := client.ExecTransaction(context.Background(), *batch, *pipeline.NewStandaloneBatchOptions())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, failed := range result.Failed() {
+		fmt.Println(failed.Index, failed.Command)
+	}
+	fmt.Println(result.Values())
+
+	// Output:
+	// 1 INCR
+	// [OK <nil> not a number]
+}
+
+func ExampleClusterClient_ExecTransaction() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	batch := pipeline.NewClusterBatch(true)
+	batch.Set("key", "not a number").Incr("key").Get("key")
+
+	result, err := client.ExecTransaction(context.Background(), *batch, *pipeline.NewClusterBatchOptions())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, failed := range result.Failed() {
+		fmt.Println(failed.Index, failed.Command)
+	}
+	fmt.Println(result.Values())
+
+	// Output:
+	// 1 INCR
+	// [OK <nil> not a number]
+}
+
 func ExampleClient_Watch_changedKey() {
 	var client *Client = getExampleClient() // example helper function
 	// Example 1: key is changed before transaction and transaction didn't execute
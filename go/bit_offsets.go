@@ -0,0 +1,87 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// BitSetMany sets or clears the bits at several offsets of the string stored at `key`, like [Client.SetBit] for every
+// offset, in a single `BITFIELD` command. If the key does not exist, it is created, with the bits that are not set being
+// `0`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the string.
+//	offsets - The zero-based indexes of the bits to set, less than `2^32`.
+//	value - true to set the bits to `1`, false to clear them.
+//
+// Return value:
+//
+//	The bits that were previously stored at the offsets, in the same order, true for `1`.
+//
+// [valkey.io]: https://valkey.io/commands/bitfield/
+func (client *baseClient) BitSetMany(ctx context.Context, key string, offsets []int64, value bool) ([]bool, error) {
+	if len(offsets) == 0 {
+		return []bool{}, nil
+	}
+	bit := int64(0)
+	if value {
+		bit = 1
+	}
+	subCommands := make([]options.BitFieldSubCommands, len(offsets))
+	for i, offset := range offsets {
+		subCommands[i] = options.NewBitFieldSet(options.UnsignedInt, 1, offset, bit)
+	}
+	results, err := client.BitField(ctx, key, subCommands)
+	if err != nil {
+		return nil, err
+	}
+	return bitsOf(results), nil
+}
+
+// BitGetMany returns the bits at several offsets of the string stored at `key`, like [Client.GetBit] for every offset, in
+// a single `BITFIELD_RO` command. The offsets beyond the end of the string, or of a key that does not exist, are `0`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the string.
+//	offsets - The zero-based indexes of the bits to return, less than `2^32`.
+//
+// Return value:
+//
+//	The bits stored at the offsets, in the same order, true for `1`.
+//
+// [valkey.io]: https://valkey.io/commands/bitfield_ro/
+func (client *baseClient) BitGetMany(ctx context.Context, key string, offsets []int64) ([]bool, error) {
+	if len(offsets) == 0 {
+		return []bool{}, nil
+	}
+	commands := make([]options.BitFieldROCommands, len(offsets))
+	for i, offset := range offsets {
+		commands[i] = options.NewBitFieldGet(options.UnsignedInt, 1, offset)
+	}
+	results, err := client.BitFieldRO(ctx, key, commands)
+	if err != nil {
+		return nil, err
+	}
+	return bitsOf(results), nil
+}
+
+// bitsOf converts the results of single-bit unsigned `BITFIELD` subcommands to bits.
+func bitsOf(results []models.Result[int64]) []bool {
+	bits := make([]bool, len(results))
+	for i, result := range results {
+		bits[i] = !result.IsNil() && result.Value() == 1
+	}
+	return bits
+}
@@ -64,6 +64,58 @@ func ExampleClusterClient_GetBit() {
 	// Output: 1
 }
 
+func ExampleClient_BitSetMany() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.SetBit(context.Background(), "feature_flags", 3, 1)
+	result, err := client.BitSetMany(context.Background(), "feature_flags", []int64{1, 3, 5}, true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result) // the previous bits
+
+	// Output: [false true false]
+}
+
+func ExampleClusterClient_BitSetMany() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.SetBit(context.Background(), "feature_flags", 3, 1)
+	result, err := client.BitSetMany(context.Background(), "feature_flags", []int64{1, 3, 5}, true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result) // the previous bits
+
+	// Output: [false true false]
+}
+
+func ExampleClient_BitGetMany() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.BitSetMany(context.Background(), "presence", []int64{0, 7}, true)
+	result, err := client.BitGetMany(context.Background(), "presence", []int64{0, 1, 7, 1000})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [true false true false]
+}
+
+func ExampleClusterClient_BitGetMany() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.BitSetMany(context.Background(), "presence", []int64{0, 7}, true)
+	result, err := client.BitGetMany(context.Background(), "presence", []int64{0, 1, 7, 1000})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [true false true false]
+}
+
 func ExampleClient_BitCount() {
 	var client *Client = getExampleClient() // example helper function
 
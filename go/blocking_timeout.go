@@ -0,0 +1,28 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"time"
+)
+
+// blockingTimeout returns the timeout of a blocking command sent with `ctx`, see
+// [config.ClientConfiguration.WithBlockingTimeoutClamping]: `timeout`, or the time left before the deadline of `ctx`
+// minus the margin of the client if it is shorter, so that the server times the command out before the context expires.
+// A `timeout` of `0` blocks indefinitely, and is clamped too, while a negative `timeout` is returned as is, for the
+// commands that do not block then. The clamped timeout is at least a millisecond, since `0` would block indefinitely.
+func (client *baseClient) blockingTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	if client.blockingTimeoutMargin == 0 || timeout < 0 {
+		return timeout
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return timeout
+	}
+	remaining := (time.Until(deadline) - client.blockingTimeoutMargin).Truncate(time.Millisecond)
+	if timeout != 0 && timeout <= remaining {
+		return timeout
+	}
+	return max(remaining, time.Millisecond)
+}
@@ -0,0 +1,43 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingTimeout(t *testing.T) {
+	client := &baseClient{blockingTimeoutMargin: 100 * time.Millisecond}
+
+	// Without a deadline, the timeout is sent as given
+	assert.Equal(t, 5*time.Second, client.blockingTimeout(context.Background(), 5*time.Second))
+	assert.Zero(t, client.blockingTimeout(context.Background(), 0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	// The timeouts ending before the deadline are kept
+	assert.Equal(t, time.Second, client.blockingTimeout(ctx, time.Second))
+	// The longer ones, and the indefinite ones, end the margin before the deadline
+	for _, timeout := range []time.Duration{5 * time.Second, 0} {
+		clamped := client.blockingTimeout(ctx, timeout)
+		assert.LessOrEqual(t, clamped, 1900*time.Millisecond)
+		assert.Greater(t, clamped, 1800*time.Millisecond)
+		assert.Zero(t, clamped%time.Millisecond)
+	}
+	// The commands that do not block are left as they are
+	assert.Equal(t, time.Duration(-1), client.blockingTimeout(ctx, -1))
+
+	// The timeouts of the contexts expiring within the margin are a millisecond, since 0 would block indefinitely
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, time.Millisecond, client.blockingTimeout(ctx, 0))
+	assert.Equal(t, time.Millisecond, client.blockingTimeout(ctx, time.Second))
+
+	// Clients without clamping send the timeouts as given
+	assert.Zero(t, (&baseClient{}).blockingTimeout(ctx, 0))
+	assert.Equal(t, time.Second, (&baseClient{}).blockingTimeout(ctx, time.Second))
+}
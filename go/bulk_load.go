@@ -0,0 +1,200 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+type keyValue struct {
+	key   string
+	value string
+}
+
+// BulkLoad writes the key/value pairs of `iterator`, e.g. to warm up a cache. The pairs are grouped by `opts.BatchSize`,
+// and every group is sent as a non-atomic pipeline, with at most `opts.Concurrency` pipelines in flight.
+//
+// Without expiry, a group is written with `MSET`: a single one in standalone mode, and one per hash slot in cluster mode so
+// that a failing slot does not fail the other keys. With an expiry, every key is written with its own `SET`. A key that
+// could not be written is reported in [models.BulkLoadResult.Failed], and does not stop the load.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution. The load stops when it is done.
+//	iterator - The key/value pairs to write.
+//	opts - The batch size, the concurrency, the expiry and the progress callback, see [options.BulkLoadOptions].
+//
+// Return value:
+//
+//	The number of keys written and the error of every key that failed. An error if `iterator` failed or `ctx` was done,
+//	along with the keys written before.
+func (client *baseClient) BulkLoad(
+	ctx context.Context,
+	iterator models.KeyValueIterator,
+	opts options.BulkLoadOptions,
+) (models.BulkLoadResult, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = options.DefaultBulkLoadBatchSize
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = options.DefaultBulkLoadConcurrency
+	}
+
+	result := models.BulkLoadResult{Failed: map[string]error{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	groups := make(chan []keyValue)
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range groups {
+				failed := client.loadGroup(ctx, group, opts.Expiry)
+				mu.Lock()
+				result.Loaded += int64(len(group) - len(failed))
+				for key, err := range failed {
+					result.Failed[key] = err
+				}
+				if opts.OnProgress != nil {
+					opts.OnProgress(result.Loaded, int64(len(result.Failed)))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	err := readGroups(ctx, nextKeyValue(iterator), batchSize, groups)
+	close(groups)
+	wg.Wait()
+	return result, err
+}
+
+// nextKeyValue returns a function returning the next pair of `iterator`, to be passed to readGroups.
+func nextKeyValue(iterator models.KeyValueIterator) func() (keyValue, error) {
+	return func() (keyValue, error) {
+		key, value, err := iterator.Next()
+		return keyValue{key, value}, err
+	}
+}
+
+// readGroups sends the elements returned by `next` to `groups` by groups of `batchSize`, until `next` returns [io.EOF] or
+// `ctx` is done.
+func readGroups[E any](ctx context.Context, next func() (E, error), batchSize int, groups chan<- []E) error {
+	group := make([]E, 0, batchSize)
+	send := func() error {
+		select {
+		case groups <- group:
+			group = make([]E, 0, batchSize)
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	for {
+		element, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		group = append(group, element)
+		if len(group) == batchSize {
+			if err := send(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(group) > 0 {
+		return send()
+	}
+	return nil
+}
+
+// loadGroup writes a group of pairs in a non-atomic pipeline, and returns the error of every key that failed.
+func (client *baseClient) loadGroup(ctx context.Context, group []keyValue, expiry time.Duration) map[string]error {
+	var batch internal.Batch
+	var commandKeys [][]string
+	if client.clusterMode {
+		b := pipeline.NewClusterBatch(false)
+		commandKeys = addLoadCommands(&b.BaseBatch, group, expiry, true)
+		batch = b.Batch
+	} else {
+		b := pipeline.NewStandaloneBatch(false)
+		commandKeys = addLoadCommands(&b.BaseBatch, group, expiry, false)
+		batch = b.Batch
+	}
+
+	failed := map[string]error{}
+	results, err := client.executeBatch(ctx, batch, false, nil)
+	if err != nil {
+		for _, pair := range group {
+			failed[pair.key] = err
+		}
+		return failed
+	}
+	for i, result := range results {
+		if err, ok := result.(error); ok {
+			for _, key := range commandKeys[i] {
+				failed[key] = err
+			}
+		}
+	}
+	return failed
+}
+
+// addLoadCommands adds the commands writing `group` to `batch`, and returns the keys written by every command. With an
+// expiry, every key is set on its own. Otherwise, the keys are set with `MSET`, one per slot if `bySlot`.
+func addLoadCommands[T pipeline.StandaloneBatch | pipeline.ClusterBatch](
+	batch *pipeline.BaseBatch[T],
+	group []keyValue,
+	expiry time.Duration,
+	bySlot bool,
+) [][]string {
+	var commandKeys [][]string
+	if expiry > 0 {
+		setOptions := *options.NewSetOptions().SetExpiry(options.NewExpiryIn(expiry))
+		for _, pair := range group {
+			batch.SetWithOptions(pair.key, pair.value, setOptions)
+			commandKeys = append(commandKeys, []string{pair.key})
+		}
+		return commandKeys
+	}
+
+	var slots []uint16
+	bySlotPairs := map[uint16]map[string]string{}
+	for _, pair := range group {
+		var slot uint16
+		if bySlot {
+			slot = utils.KeySlot(pair.key)
+		}
+		pairs, ok := bySlotPairs[slot]
+		if !ok {
+			pairs = map[string]string{}
+			bySlotPairs[slot] = pairs
+			slots = append(slots, slot)
+		}
+		pairs[pair.key] = pair.value
+	}
+	for _, slot := range slots {
+		pairs := bySlotPairs[slot]
+		batch.MSet(pairs)
+		keys := make([]string, 0, len(pairs))
+		for key := range pairs {
+			keys = append(keys, key)
+		}
+		commandKeys = append(commandKeys, keys)
+	}
+	return commandKeys
+}
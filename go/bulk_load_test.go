@@ -0,0 +1,86 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+type failingIterator struct {
+	remaining int
+}
+
+func (it *failingIterator) Next() (string, string, error) {
+	if it.remaining == 0 {
+		return "", "", errors.New("source failed")
+	}
+	it.remaining--
+	return "key", "value", nil
+}
+
+func TestReadGroups(t *testing.T) {
+	groups := make(chan []keyValue, 10)
+	iterator := models.NewMapIterator(map[string]string{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5"})
+	require.NoError(t, readGroups(context.Background(), nextKeyValue(iterator), 2, groups))
+	close(groups)
+	var sizes []int
+	pairs := map[string]string{}
+	for group := range groups {
+		sizes = append(sizes, len(group))
+		for _, pair := range group {
+			pairs[pair.key] = pair.value
+		}
+	}
+	assert.Equal(t, []int{2, 2, 1}, sizes)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5"}, pairs)
+	_, _, err := iterator.Next()
+	assert.Equal(t, io.EOF, err)
+
+	groups = make(chan []keyValue, 10)
+	err = readGroups(context.Background(), nextKeyValue(&failingIterator{remaining: 3}), 2, groups)
+	assert.EqualError(t, err, "source failed")
+	assert.Len(t, groups, 1)
+
+	// Nobody reads the groups
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = readGroups(ctx, nextKeyValue(&failingIterator{remaining: 3}), 2, make(chan []keyValue))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestAddLoadCommands(t *testing.T) {
+	group := []keyValue{{"{a}1", "1"}, {"{b}1", "2"}, {"{a}2", "3"}}
+
+	batch := pipeline.NewClusterBatch(false)
+	keys := addLoadCommands(&batch.BaseBatch, group, 0, true)
+	assert.Len(t, batch.Batch.Commands, 2)
+	assert.Equal(t, [][]string{{"{a}1", "{a}2"}, {"{b}1"}}, sortedKeys(keys))
+
+	standalone := pipeline.NewStandaloneBatch(false)
+	keys = addLoadCommands(&standalone.BaseBatch, group, 0, false)
+	assert.Len(t, standalone.Batch.Commands, 1)
+	assert.Equal(t, [][]string{{"{a}1", "{a}2", "{b}1"}}, sortedKeys(keys))
+
+	standalone = pipeline.NewStandaloneBatch(false)
+	keys = addLoadCommands(&standalone.BaseBatch, group, time.Minute, false)
+	assert.Len(t, standalone.Batch.Commands, 3)
+	assert.Equal(t, []string{"{a}1", "1", "EX", "60"}, standalone.Batch.Commands[0].Args)
+	assert.Equal(t, [][]string{{"{a}1"}, {"{b}1"}, {"{a}2"}}, keys)
+}
+
+func sortedKeys(commandKeys [][]string) [][]string {
+	for _, keys := range commandKeys {
+		slices.Sort(keys)
+	}
+	return commandKeys
+}
@@ -0,0 +1,194 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package bytelog provides append-only binary logs stored in string values, addressed by byte offset.
+package bytelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+// MaxSize is the maximum size in bytes of a string value, and so of a log.
+const MaxSize = 512 * 1024 * 1024
+
+// Log is an append-only binary log stored in a string value. Records are appended with `APPEND`, which returns the new
+// length of the value, so that the offset of every record is known without any bookkeeping, even with concurrent writers.
+// Records are read back with `GETRANGE`, given their offset and length.
+//
+// The log does not delimit the records: store their offset and length, or prefix every record with its length.
+//
+// Example:
+//
+//	events := bytelog.New(client, "events:2024-06-01")
+//	offset, err := events.Append(ctx, record)
+//	...
+//	data, err := events.ReadAt(ctx, offset, len(record))
+type Log struct {
+	client interfaces.BaseClientCommands
+	key    string
+}
+
+// New creates a [Log] stored at `key`. A log that does not exist is empty.
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the log.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands, key string) *Log {
+	return &Log{client: client, key: key}
+}
+
+// Key returns the key the log is stored at.
+func (l *Log) Key() string {
+	return l.key
+}
+
+// Append appends `data` to the log atomically, creating the log if it does not exist.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	data - The bytes to append.
+//
+// Return value:
+//
+//	The offset of `data` in the log, i.e. the size of the log before `data` was appended.
+func (l *Log) Append(ctx context.Context, data string) (int64, error) {
+	size, err := l.client.Append(ctx, l.key, data)
+	if err != nil {
+		return 0, err
+	}
+	return size - int64(len(data)), nil
+}
+
+// ReadAt reads `length` bytes of the log starting at `offset`, with the semantics of [io.ReaderAt]: if fewer bytes are
+// available, the bytes up to the end of the log are returned along with [io.EOF].
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	offset - The offset of the first byte to read. Must not be negative.
+//	length - The number of bytes to read. Must not be negative.
+//
+// Return value:
+//
+//	The bytes read.
+func (l *Log) ReadAt(ctx context.Context, offset int64, length int) (string, error) {
+	if offset < 0 || length < 0 {
+		return "", fmt.Errorf("offset and length must not be negative, got %d and %d", offset, length)
+	}
+	if length == 0 {
+		return "", nil
+	}
+	if offset >= MaxSize {
+		return "", io.EOF
+	}
+	// GETRANGE includes the end offset, and treats a negative one as counted from the end of the value.
+	end := min(offset+int64(length), MaxSize) - 1
+	data, err := l.client.GetRange(ctx, l.key, int(offset), int(end))
+	if err != nil {
+		return "", err
+	}
+	if len(data) < length {
+		return data, io.EOF
+	}
+	return data, nil
+}
+
+// WriteAt overwrites the bytes of the log starting at `offset` with `data` using `SETRANGE`, e.g. to fill a reserved
+// header. If `offset` is past the end of the log, the gap is filled with zero bytes.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	offset - The offset of the first byte to overwrite. Must not be negative.
+//	data - The bytes to write.
+//
+// Return value:
+//
+//	The size of the log after the write.
+func (l *Log) WriteAt(ctx context.Context, offset int64, data string) (int64, error) {
+	if offset < 0 {
+		return 0, fmt.Errorf("offset must not be negative, got %d", offset)
+	}
+	if offset+int64(len(data)) > MaxSize {
+		return 0, errors.New("the write would exceed the maximum size of a log")
+	}
+	return l.client.SetRange(ctx, l.key, int(offset), data)
+}
+
+// Size returns the size of the log in bytes, i.e. the offset of the next appended record. A log that does not exist is
+// empty.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The size of the log.
+func (l *Log) Size(ctx context.Context) (int64, error) {
+	return l.client.Strlen(ctx, l.key)
+}
+
+// Reader returns an [io.Reader] reading the log from `offset` up to its current end, `chunkSize` bytes per `GETRANGE`.
+// The reader returns [io.EOF] once it reached the end of the log; a new reader from the returned offset reads the records
+// appended since.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	offset - The offset of the first byte to read. Must not be negative.
+//	chunkSize - The number of bytes read by every `GETRANGE`. Must be positive.
+//
+// Return value:
+//
+//	A reader of the log. [Reader.Offset] returns the offset of the next byte it reads.
+func (l *Log) Reader(ctx context.Context, offset int64, chunkSize int) *Reader {
+	return &Reader{ctx: ctx, log: l, offset: offset, chunkSize: chunkSize}
+}
+
+// Reader reads a [Log] sequentially, see [Log.Reader].
+type Reader struct {
+	ctx       context.Context
+	log       *Log
+	offset    int64
+	chunkSize int
+	buffered  string
+}
+
+// Read implements [io.Reader].
+func (r *Reader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if len(r.buffered) == 0 {
+		if r.chunkSize <= 0 {
+			return 0, fmt.Errorf("chunk size must be positive, got %d", r.chunkSize)
+		}
+		data, err := r.log.ReadAt(r.ctx, r.offset, r.chunkSize)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, err
+		}
+		if len(data) == 0 {
+			return 0, io.EOF
+		}
+		r.buffered = data
+	}
+	n := copy(p, r.buffered)
+	r.buffered = r.buffered[n:]
+	r.offset += int64(n)
+	return n, nil
+}
+
+// Offset returns the offset of the next byte returned by the reader.
+func (r *Reader) Offset() int64 {
+	return r.offset
+}
@@ -0,0 +1,81 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package bytelog
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+func TestLog_AppendAndReadAt(t *testing.T) {
+	log := New(fakeclient.New(), "log")
+	offset, err := log.Append(context.Background(), "first")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), offset)
+	offset, err = log.Append(context.Background(), "second")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), offset)
+
+	data, err := log.ReadAt(context.Background(), 5, 6)
+	require.NoError(t, err)
+	assert.Equal(t, "second", data)
+
+	data, err = log.ReadAt(context.Background(), 8, 10)
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, "ond", data)
+
+	data, err = log.ReadAt(context.Background(), 20, 10)
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Empty(t, data)
+
+	data, err = log.ReadAt(context.Background(), 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, data)
+
+	_, err = log.ReadAt(context.Background(), -1, 1)
+	assert.Error(t, err)
+
+	size, err := log.Size(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), size)
+}
+
+func TestLog_WriteAt(t *testing.T) {
+	client := fakeclient.New()
+	log := New(client, "log")
+	size, err := log.WriteAt(context.Background(), 2, "ab")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), size)
+	assert.Equal(t, "\x00\x00ab", client.Strings["log"])
+
+	_, err = log.WriteAt(context.Background(), -1, "ab")
+	assert.Error(t, err)
+	_, err = log.WriteAt(context.Background(), MaxSize, "ab")
+	assert.Error(t, err)
+}
+
+func TestLog_Reader(t *testing.T) {
+	log := New(fakeclient.New(), "log")
+	_, err := log.Append(context.Background(), "0123456789")
+	require.NoError(t, err)
+
+	reader := log.Reader(context.Background(), 2, 3)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "23456789", string(data))
+	assert.Equal(t, int64(10), reader.Offset())
+
+	_, err = log.Append(context.Background(), "ab")
+	require.NoError(t, err)
+	data, err = io.ReadAll(log.Reader(context.Background(), reader.Offset(), 3))
+	require.NoError(t, err)
+	assert.Equal(t, "ab", string(data))
+
+	_, err = log.Reader(context.Background(), 0, 0).Read(make([]byte, 1))
+	assert.Error(t, err)
+}
@@ -0,0 +1,153 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package cache provides helpers for multi-tier caches that keep their local tiers consistent with Valkey by publishing
+// invalidation messages.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// DefaultInvalidationChannel is the default channel the invalidation messages are published to.
+const DefaultInvalidationChannel = "glide:cache:invalidate"
+
+// invalidateAndSetScript replaces the value of KEYS[1] with ARGV[1], expiring in ARGV[2] milliseconds unless it is "0",
+// and publishes KEYS[1] with the command ARGV[3] to the channel ARGV[4], or to the shard channel KEYS[2].
+var invalidateAndSetScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+local previous = redis.call('GETDEL', KEYS[1])
+if ARGV[2] == '0' then
+	redis.call('SET', KEYS[1], ARGV[1])
+else
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+end
+if ARGV[3] == 'SPUBLISH' then
+	redis.call('SPUBLISH', KEYS[2], KEYS[1])
+else
+	redis.call('PUBLISH', ARGV[4], KEYS[1])
+end
+return previous
+`)
+})
+
+// Invalidator replaces cached values and notifies the other tiers of the cache, such as the in-process caches of the
+// application instances, that their copy of the value is stale.
+//
+// The value is replaced and the key is published to the invalidation channel in a single script, so that no subscriber
+// is notified of a change that did not happen, and no change happens without a notification. The subscribers should drop
+// the key from their local tier, and read it again from Valkey when needed.
+//
+// By default, the messages are published with `PUBLISH` to [DefaultInvalidationChannel], which reaches the subscribers
+// connected to any node. With [Invalidator.WithShardChannel], they are published with `SPUBLISH` instead, which only
+// reaches the subscribers of the shard owning the channel: the keys must then be in the same slot as the channel, e.g.
+// share its hash tag.
+//
+// Example:
+//
+//	invalidator := cache.New(client).WithChannel("products:invalidate")
+//	previous, err := invalidator.InvalidateAndSet(ctx, "product:42", productJSON)
+type Invalidator struct {
+	client  interfaces.BaseClientCommands
+	channel string
+	sharded bool
+	expiry  time.Duration
+}
+
+// New creates an [Invalidator] publishing to [DefaultInvalidationChannel].
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands) *Invalidator {
+	return &Invalidator{client: client, channel: DefaultInvalidationChannel}
+}
+
+// WithChannel sets the channel the invalidation messages are published to with `PUBLISH`.
+func (i *Invalidator) WithChannel(channel string) *Invalidator {
+	i.channel = channel
+	i.sharded = false
+	return i
+}
+
+// WithShardChannel sets the shard channel the invalidation messages are published to with `SPUBLISH`. The keys passed to
+// [Invalidator.InvalidateAndSet] must be in the same slot as `channel`, e.g. "{products}:invalidate" for the keys
+// "{products}:42".
+//
+// Since:
+//
+//	Valkey 7.0.0 and above.
+func (i *Invalidator) WithShardChannel(channel string) *Invalidator {
+	i.channel = channel
+	i.sharded = true
+	return i
+}
+
+// WithExpiry sets the time to live of the values set by [Invalidator.InvalidateAndSet], rounded down to the millisecond.
+// If zero, the default, the values do not expire.
+func (i *Invalidator) WithExpiry(expiry time.Duration) *Invalidator {
+	i.expiry = expiry
+	return i
+}
+
+// InvalidateAndSet atomically deletes the value of `key` with `GETDEL`, sets it to `newValue`, and publishes `key` to the
+// invalidation channel. The time to live of the previous value is not kept.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the cached value.
+//	newValue - The new value.
+//
+// Return value:
+//
+//	The previous value of `key`, or a nil result if `key` did not exist.
+func (i *Invalidator) InvalidateAndSet(ctx context.Context, key string, newValue string) (models.Result[string], error) {
+	if i.expiry < 0 || (i.expiry > 0 && i.expiry < time.Millisecond) {
+		return models.CreateNilStringResult(), fmt.Errorf("expiry must be zero or at least a millisecond, got %v", i.expiry)
+	}
+	keys := []string{key}
+	command := "PUBLISH"
+	if i.sharded {
+		if utils.KeySlot(key) != utils.KeySlot(i.channel) {
+			return models.CreateNilStringResult(), fmt.Errorf(
+				"key %q is not in the same slot as the shard channel %q", key, i.channel,
+			)
+		}
+		keys = append(keys, i.channel)
+		command = "SPUBLISH"
+	}
+	result, err := i.client.InvokeScriptWithOptions(
+		ctx,
+		*invalidateAndSetScript(),
+		*options.NewScriptOptions().
+			WithKeys(keys).
+			WithArgs([]string{newValue, strconv.FormatInt(i.expiry.Milliseconds(), 10), command, i.channel}),
+	)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	if result == nil {
+		return models.CreateNilStringResult(), nil
+	}
+	previous, ok := result.(string)
+	if !ok {
+		return models.CreateNilStringResult(), fmt.Errorf("unexpected response: %v", result)
+	}
+	return models.CreateStringResult(previous), nil
+}
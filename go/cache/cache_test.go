@@ -0,0 +1,106 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+type published struct {
+	command string
+	channel string
+	message string
+}
+
+// fakeClient runs the invalidation script natively, and records the messages it publishes.
+type fakeClient struct {
+	*fakeclient.Client
+	published []published
+}
+
+func newFakeClient() *fakeClient {
+	f := &fakeClient{Client: fakeclient.New()}
+	f.WithScript(func(keys []string, args []string) (any, error) {
+		ctx := context.Background()
+		previous, err := f.GetDel(ctx, keys[0])
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Set(ctx, keys[0], args[0]); err != nil {
+			return nil, err
+		}
+		if expiry, _ := strconv.ParseInt(args[1], 10, 64); expiry != 0 {
+			f.PExpire(ctx, keys[0], time.Duration(expiry)*time.Millisecond)
+		}
+		channel := args[3]
+		if args[2] == "SPUBLISH" {
+			channel = keys[1]
+		}
+		f.published = append(f.published, published{command: args[2], channel: channel, message: keys[0]})
+		if previous.IsNil() {
+			return nil, nil
+		}
+		return previous.Value(), nil
+	})
+	return f
+}
+
+func TestInvalidator_InvalidateAndSet(t *testing.T) {
+	client := newFakeClient()
+	invalidator := New(client)
+
+	previous, err := invalidator.InvalidateAndSet(context.Background(), "product:1", "v1")
+	require.NoError(t, err)
+	assert.True(t, previous.IsNil())
+	previous, err = invalidator.InvalidateAndSet(context.Background(), "product:1", "v2")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", previous.Value())
+
+	assert.Equal(t, "v2", client.Strings["product:1"])
+	assert.NotContains(t, client.Expiries, "product:1")
+	assert.Equal(t, []published{
+		{command: "PUBLISH", channel: DefaultInvalidationChannel, message: "product:1"},
+		{command: "PUBLISH", channel: DefaultInvalidationChannel, message: "product:1"},
+	}, client.published)
+}
+
+func TestInvalidator_WithExpiry(t *testing.T) {
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	client := newFakeClient()
+	client.WithClock(fake)
+	_, err := New(client).WithExpiry(90*time.Second).InvalidateAndSet(context.Background(), "key", "value")
+	require.NoError(t, err)
+	assert.Equal(t, fake.Now().Add(90*time.Second), client.Expiries["key"])
+
+	_, err = New(client).WithExpiry(time.Microsecond).InvalidateAndSet(context.Background(), "key", "value")
+	assert.Error(t, err)
+	_, err = New(client).WithExpiry(-time.Second).InvalidateAndSet(context.Background(), "key", "value")
+	assert.Error(t, err)
+}
+
+func TestInvalidator_WithShardChannel(t *testing.T) {
+	client := newFakeClient()
+	invalidator := New(client).WithShardChannel("{products}:invalidate")
+
+	_, err := invalidator.InvalidateAndSet(context.Background(), "{products}:1", "value")
+	require.NoError(t, err)
+	assert.Equal(t, []published{
+		{command: "SPUBLISH", channel: "{products}:invalidate", message: "{products}:1"},
+	}, client.published)
+
+	_, err = invalidator.InvalidateAndSet(context.Background(), "product:1", "value")
+	assert.Error(t, err)
+	assert.NotContains(t, client.Strings, "product:1")
+
+	_, err = invalidator.WithChannel("products").InvalidateAndSet(context.Background(), "product:1", "value")
+	require.NoError(t, err)
+	assert.Equal(t, published{command: "PUBLISH", channel: "products", message: "product:1"}, client.published[1])
+}
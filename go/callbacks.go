@@ -70,6 +70,19 @@ func pubSubCallback(
 		return
 	}
 
+	if pushKind == C.PushInvalidate {
+		// Handled synchronously, so that the invalidations are applied in the order they were pushed
+		var key *string
+		if message != nil {
+			invalidated := string(C.GoBytes(message, message_len))
+			key = &invalidated
+		}
+		if client := getClientByPtr(uintptr(clientPtr)); client != nil {
+			client.clientSideCache.handleInvalidation(key)
+		}
+		return
+	}
+
 	msg := string(C.GoBytes(message, message_len))
 	cha := string(C.GoBytes(channel, channel_len))
 	pat := models.CreateNilStringResult()
@@ -77,7 +90,7 @@ func pubSubCallback(
 		pat = models.CreateStringResult(string(C.GoBytes(pattern, pattern_len)))
 	}
 
-	go func() {
+	deliver := func() {
 		// Process different types of push messages
 		message := models.NewPubSubMessageWithPattern(msg, cha, pat)
 
@@ -87,6 +100,10 @@ func pubSubCallback(
 			client := getClientByPtr(ptrValue)
 
 			if client != nil {
+				// The keyspace notifications of the keys watched with WatchChanges go to their watchers only
+				if client.keyWatchers.notify(message) {
+					return
+				}
 				// If the client has a message handler, use it
 				if handler := client.getMessageHandler(); handler != nil {
 					handler.handleMessage(message)
@@ -95,5 +112,33 @@ func pubSubCallback(
 				log.Printf("Client not found for pointer: %v\n", ptrValue)
 			}
 		}
-	}()
+	}
+	// The messages of a bounded queue are delivered in order, and block the push notifications while the queue is full
+	// with BlockPublisherEvents
+	if client := getClientByPtr(uintptr(clientPtr)); client != nil {
+		if handler := client.getMessageHandler(); handler != nil && handler.callback == nil && handler.queue.isBounded() {
+			deliver()
+			return
+		}
+	}
+	go deliver()
+}
+
+//export connectionEventCallback
+func connectionEventCallback(
+	context C.uintptr_t,
+	kind C.ConnectionEventKind,
+	address *C.char,
+	attempt C.uint32_t,
+	cErr *C.char,
+) {
+	events := getConnectionEvents(uintptr(context))
+	if events == nil {
+		return
+	}
+	var err error
+	if cErr != nil {
+		err = NewConnectionError(C.GoString(cErr))
+	}
+	events.handle(kind, C.GoString(address), int(attempt), err)
 }
@@ -0,0 +1,250 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package chaos injects faults into the requests of a client, e.g. latency, lost responses, redirects and connection
+// resets, so that applications can test their resilience to the errors of the client without a fault-injecting proxy.
+//
+// It is meant for tests: the faults are injected by an interceptor of the client, see [config.Interceptor], and are
+// indistinguishable from the real errors for the application.
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// Fault is a kind of fault injected into the requests.
+type Fault int
+
+const (
+	// Latency delays the requests before sending them.
+	Latency Fault = iota
+	// DroppedResponse sends the requests and drops their response: the requests fail with a [glide.TimeoutError] after
+	// a delay, although the commands were executed.
+	DroppedResponse
+	// MovedRedirect fails the requests with a [glide.TooManyRedirectsError], as if they were redirected with `MOVED` until
+	// the client gave up, without sending them.
+	MovedRedirect
+	// ConnectionReset sends the requests and fails them with a [glide.DisconnectError], as if the connection was reset
+	// before the response was received, although the commands were executed.
+	ConnectionReset
+)
+
+// String returns the name of the fault.
+func (f Fault) String() string {
+	switch f {
+	case Latency:
+		return "latency"
+	case DroppedResponse:
+		return "dropped response"
+	case MovedRedirect:
+		return "MOVED redirect"
+	case ConnectionReset:
+		return "connection reset"
+	default:
+		return fmt.Sprintf("Fault(%d)", int(f))
+	}
+}
+
+// Rule injects a fault into a fraction of the requests, optionally restricted to some commands or keys.
+type Rule struct {
+	fault Fault
+	rate  float64
+	delay time.Duration
+	// The names of the commands the fault is injected into, in uppercase, or empty for all the commands.
+	commands []string
+	// The glob-style pattern of the keys the fault is injected into, or empty for all the keys.
+	keyPattern string
+}
+
+// InjectLatency returns a [Rule] delaying a fraction `rate`, between 0 and 1, of the requests by `delay`.
+func InjectLatency(delay time.Duration, rate float64) *Rule {
+	return &Rule{fault: Latency, rate: rate, delay: delay}
+}
+
+// DropResponses returns a [Rule] dropping the response of a fraction `rate`, between 0 and 1, of the requests, which fail
+// with a [glide.TimeoutError] `timeout` after being sent, e.g. the request timeout of the client.
+func DropResponses(timeout time.Duration, rate float64) *Rule {
+	return &Rule{fault: DroppedResponse, rate: rate, delay: timeout}
+}
+
+// InjectMovedRedirects returns a [Rule] failing a fraction `rate`, between 0 and 1, of the requests with a
+// [glide.TooManyRedirectsError] without sending them.
+func InjectMovedRedirects(rate float64) *Rule {
+	return &Rule{fault: MovedRedirect, rate: rate}
+}
+
+// ResetConnections returns a [Rule] failing a fraction `rate`, between 0 and 1, of the requests with a
+// [glide.DisconnectError] after sending them.
+func ResetConnections(rate float64) *Rule {
+	return &Rule{fault: ConnectionReset, rate: rate}
+}
+
+// ForCommands restricts the rule to the commands named `names`, e.g. "GET" or "EVALSHA" for the scripts. A batch is
+// matched if any of its commands is.
+func (r *Rule) ForCommands(names ...string) *Rule {
+	for _, name := range names {
+		r.commands = append(r.commands, strings.ToUpper(name))
+	}
+	return r
+}
+
+// ForKeys restricts the rule to the commands with an argument matching the glob-style `pattern`, e.g. "session:*", as
+// matched by [path.Match]. The arguments are matched rather than the keys, since the positions of the keys are not known
+// for every command. A batch is matched if any of its commands is.
+func (r *Rule) ForKeys(pattern string) *Rule {
+	r.keyPattern = pattern
+	return r
+}
+
+// matches returns whether the rule applies to `cmd`.
+func (r *Rule) matches(cmd models.Command) bool {
+	if cmd.Batch != nil {
+		return slices.ContainsFunc(cmd.Batch, r.matches)
+	}
+	if len(r.commands) > 0 && !slices.Contains(r.commands, cmd.Name) {
+		return false
+	}
+	if r.keyPattern == "" {
+		return true
+	}
+	return slices.ContainsFunc(cmd.Args, func(arg string) bool {
+		matched, _ := path.Match(r.keyPattern, arg)
+		return matched
+	})
+}
+
+// Injector injects the faults of its rules into the requests of the clients it is the interceptor of.
+//
+// A request is matched against every rule in order, and a fault is drawn for every matching rule, so that a request can be
+// both delayed and failed. The faults failing a request end the matching.
+//
+// Example:
+//
+//	injector := chaos.New().
+//		Add(chaos.InjectLatency(50*time.Millisecond, 0.1)).
+//		Add(chaos.ResetConnections(0.01).ForCommands("SET").ForKeys("session:*"))
+//	client, err := glide.NewClient(config.NewClientConfiguration().
+//		WithAddress(&config.NodeAddress{Host: "localhost", Port: 6379}).
+//		WithInterceptor(injector.Interceptor()))
+type Injector struct {
+	mu       sync.Mutex
+	rules    []*Rule
+	random   *rand.Rand
+	disabled atomic.Bool
+	injected [ConnectionReset + 1]atomic.Int64
+}
+
+// New creates an [Injector] without rules, drawing the faults from a random seed.
+func New() *Injector {
+	return &Injector{random: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+// WithSeed draws the faults from `seed`, so that the same sequence of requests gets the same faults.
+func (i *Injector) WithSeed(seed int64) *Injector {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.random = rand.New(rand.NewSource(seed))
+	return i
+}
+
+// Add adds `rule` to the rules of the injector. The rules can be added while the clients run.
+func (i *Injector) Add(rule *Rule) *Injector {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.rules = append(i.rules, rule)
+	return i
+}
+
+// SetEnabled enables or disables the injection of the faults, e.g. to inject them during a part of a test only. The
+// injector is enabled when created.
+func (i *Injector) SetEnabled(enabled bool) {
+	i.disabled.Store(!enabled)
+}
+
+// Injected returns the number of times `fault` was injected.
+func (i *Injector) Injected(fault Fault) int64 {
+	if fault < 0 || int(fault) >= len(i.injected) {
+		return 0
+	}
+	return i.injected[fault].Load()
+}
+
+// faults draws the faults of the matching rules for `cmd`.
+func (i *Injector) faults(cmd models.Command) []*Rule {
+	if i.disabled.Load() {
+		return nil
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	var drawn []*Rule
+	for _, rule := range i.rules {
+		if rule.matches(cmd) && i.random.Float64() < rule.rate {
+			drawn = append(drawn, rule)
+			if rule.fault != Latency {
+				break
+			}
+		}
+	}
+	return drawn
+}
+
+// Interceptor returns the interceptor injecting the faults, to be added to the configuration of the clients with
+// `WithInterceptor`. It should be added last, so that the faults reach the other interceptors as real errors would.
+func (i *Injector) Interceptor() config.Interceptor {
+	return func(ctx context.Context, cmd models.Command, next config.Invoker) (any, error) {
+		for _, rule := range i.faults(cmd) {
+			i.injected[rule.fault].Add(1)
+			switch rule.fault {
+			case Latency:
+				if err := sleep(ctx, rule.delay); err != nil {
+					return nil, err
+				}
+			case DroppedResponse:
+				if _, err := next(ctx, cmd); err != nil {
+					return nil, err
+				}
+				if err := sleep(ctx, rule.delay); err != nil {
+					return nil, err
+				}
+				return nil, glide.NewTimeoutError("chaos: injected dropped response")
+			case MovedRedirect:
+				slot, node := int64(0), "chaos:6379"
+				if len(cmd.Args) > 0 {
+					slot = int64(utils.KeySlot(cmd.Args[0]))
+				}
+				redirect := glide.Redirect{Kind: "MOVED", Slot: slot, Node: node}
+				return nil, glide.NewTooManyRedirectsError("chaos: injected MOVED redirect", []glide.Redirect{redirect})
+			case ConnectionReset:
+				if _, err := next(ctx, cmd); err != nil {
+					return nil, err
+				}
+				return nil, glide.NewDisconnectError("chaos: injected connection reset")
+			}
+		}
+		return next(ctx, cmd)
+	}
+}
+
+// sleep waits for `delay`, or returns the error of `ctx` if it is done first.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
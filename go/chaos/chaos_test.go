@@ -0,0 +1,111 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package chaos
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// recorder is an invoker recording the commands it sends.
+type recorder struct {
+	sent []models.Command
+}
+
+func (r *recorder) invoke(ctx context.Context, cmd models.Command) (any, error) {
+	r.sent = append(r.sent, cmd)
+	return "OK", nil
+}
+
+func TestRule_Matches(t *testing.T) {
+	get := models.Command{Name: "GET", Args: []string{"session:1"}}
+	set := models.Command{Name: "SET", Args: []string{"user:1", "alice"}}
+	batch := models.Command{Name: "Batch", Batch: []models.Command{set, get}}
+
+	assert.True(t, ResetConnections(1).matches(get))
+	assert.True(t, ResetConnections(1).ForCommands("get").matches(get))
+	assert.False(t, ResetConnections(1).ForCommands("get").matches(set))
+	assert.True(t, ResetConnections(1).ForKeys("session:*").matches(get))
+	assert.False(t, ResetConnections(1).ForKeys("session:*").matches(set))
+	assert.False(t, ResetConnections(1).ForCommands("GET").ForKeys("user:*").matches(get))
+	assert.True(t, ResetConnections(1).ForCommands("GET").matches(batch))
+	assert.False(t, ResetConnections(1).ForCommands("DEL").matches(batch))
+}
+
+func TestInjector_Faults(t *testing.T) {
+	ctx := context.Background()
+	get := models.Command{Name: "GET", Args: []string{"session:1"}}
+
+	// A connection reset fails the request after sending it
+	next := &recorder{}
+	injector := New().Add(ResetConnections(1))
+	_, err := injector.Interceptor()(ctx, get, next.invoke)
+	var disconnectErr *glide.DisconnectError
+	assert.True(t, errors.As(err, &disconnectErr))
+	assert.Len(t, next.sent, 1)
+	assert.Equal(t, int64(1), injector.Injected(ConnectionReset))
+
+	// A MOVED redirect fails the request without sending it
+	next = &recorder{}
+	injector = New().Add(InjectMovedRedirects(1))
+	_, err = injector.Interceptor()(ctx, get, next.invoke)
+	var redirectErr *glide.TooManyRedirectsError
+	require.True(t, errors.As(err, &redirectErr))
+	require.Len(t, redirectErr.Redirects, 1)
+	assert.Equal(t, "MOVED", redirectErr.Redirects[0].Kind)
+	assert.Empty(t, next.sent)
+
+	// A dropped response times out after sending the request
+	next = &recorder{}
+	injector = New().Add(DropResponses(10*time.Millisecond, 1))
+	_, err = injector.Interceptor()(ctx, get, next.invoke)
+	var timeoutErr *glide.TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Len(t, next.sent, 1)
+
+	// Latency delays the request, and is combined with the other faults
+	next = &recorder{}
+	injector = New().Add(InjectLatency(20*time.Millisecond, 1)).Add(ResetConnections(1))
+	started := time.Now()
+	_, err = injector.Interceptor()(ctx, get, next.invoke)
+	assert.GreaterOrEqual(t, time.Since(started), 20*time.Millisecond)
+	assert.True(t, errors.As(err, &disconnectErr))
+	assert.Equal(t, int64(1), injector.Injected(Latency))
+
+	// The latency is cut short by the context
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = New().Add(InjectLatency(time.Hour, 1)).Interceptor()(timeoutCtx, get, next.invoke)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInjector_Rates(t *testing.T) {
+	ctx := context.Background()
+	get := models.Command{Name: "GET", Args: []string{"session:1"}}
+	next := &recorder{}
+	injector := New().WithSeed(42).Add(InjectMovedRedirects(0.25))
+	interceptor := injector.Interceptor()
+	failed := 0
+	for range 1000 {
+		if _, err := interceptor(ctx, get, next.invoke); err != nil {
+			failed++
+		}
+	}
+	assert.InDelta(t, 250, failed, 50)
+	assert.Equal(t, int64(failed), injector.Injected(MovedRedirect))
+
+	// No fault is injected while disabled
+	injector.SetEnabled(false)
+	for range 100 {
+		_, err := interceptor(ctx, get, next.invoke)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(failed), injector.Injected(MovedRedirect))
+}
@@ -0,0 +1,263 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package chunking stores large string values across several keys, for deployments where a proxy limits the size of a
+// single value.
+package chunking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// DefaultChunkSize is the default size in bytes above which a value is split into chunks, and the size of the chunks.
+const DefaultChunkSize = 512 * 1024
+
+// manifestPrefix starts the manifest stored at the key of a chunked value. It starts with a NUL byte, so that it is
+// unlikely to start a plain value. A plain value that does start with it is chunked anyway, so that it is not mistaken
+// for a manifest.
+const manifestPrefix = "\x00glide-chunks:v1:"
+
+// maxReadAttempts is the number of times Get reads a chunked value that is overwritten concurrently before failing.
+const maxReadAttempts = 3
+
+// setScript stores the value ARGV[2], a plain value or a manifest, at KEYS[1] and the chunks ARGV[3], ARGV[4] and so on,
+// and deletes the chunks of the previous value that are not overwritten, whose count is read from its manifest. The keys
+// of the chunks are derived from KEYS[2], the key of the first chunk, so that they are prefixed like it by the client.
+// ARGV[1] is the prefix of the manifests.
+var setScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+local chunkKey = string.sub(KEYS[2], 1, -2)
+local previous = 0
+local stored = redis.call('GET', KEYS[1])
+if stored and string.sub(stored, 1, #ARGV[1]) == ARGV[1] then
+	previous = tonumber(string.match(stored, '^(%d+):', #ARGV[1] + 1)) or 0
+end
+redis.call('SET', KEYS[1], ARGV[2])
+local chunks = #ARGV - 2
+for i = 0, chunks - 1 do
+	redis.call('SET', chunkKey .. i, ARGV[i + 3])
+end
+for i = chunks, previous - 1 do
+	redis.call('DEL', chunkKey .. i)
+end
+return chunks
+`)
+})
+
+// Codec stores string values at their key like `SET` and `GET` do, except that the values larger than the chunk size are
+// split into chunks stored at "key:chunk:0", "key:chunk:1" and so on, and the key holds a manifest of the chunks.
+//
+// In cluster mode, the keys of the chunks are in the same slot as the key, so that the chunks and the manifest are written
+// with a single script, read with a single `MGET` and deleted with a single `DEL`. If the key has no hash tag, the key of
+// the chunks is wrapped in a hash tag of the key, e.g. "{user:1}:chunk:0" for "user:1". The keys that contain a "}" but
+// no hash tag cannot be wrapped, so their values cannot be stored by the codec in cluster mode.
+//
+// Only the values written by the codec can be read by the codec, and the keys must be deleted with [Codec.Delete], so
+// that their chunks are deleted too.
+//
+// Example:
+//
+//	codec := chunking.New(client).WithChunkSize(256 * 1024)
+//	err := codec.Set(ctx, "report:2024", largeReport)
+//	...
+//	report, err := codec.Get(ctx, "report:2024")
+type Codec struct {
+	client    interfaces.BaseClientCommands
+	chunkSize int
+}
+
+// New creates a [Codec] with chunks of [DefaultChunkSize] bytes.
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands) *Codec {
+	return &Codec{client: client, chunkSize: DefaultChunkSize}
+}
+
+// WithChunkSize sets the size in bytes above which a value is split into chunks, and the size of the chunks. Must be
+// positive, and below the value size limit of the proxy.
+//
+// Values written with another chunk size can still be read.
+func (c *Codec) WithChunkSize(chunkSize int) *Codec {
+	c.chunkSize = chunkSize
+	return c
+}
+
+// Set stores `value` at `key`, split into chunks if it is larger than the chunk size. The chunks and the manifest are
+// written with a single script, which also deletes the chunks of the previous value of `key` that are not overwritten,
+// so that concurrent calls do not delete the chunks of each other.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to store the value at.
+//	value - The value to store.
+//
+// Return value:
+//
+//	An error if the value could not be stored. In that case, the previous value of `key` is left unchanged.
+func (c *Codec) Set(ctx context.Context, key string, value string) error {
+	if c.chunkSize <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", c.chunkSize)
+	}
+	args := []string{manifestPrefix, value}
+	if len(value) > c.chunkSize || strings.HasPrefix(value, manifestPrefix) {
+		chunks := (len(value) + c.chunkSize - 1) / c.chunkSize
+		args = make([]string, 0, chunks+2)
+		args = append(args, manifestPrefix, formatManifest(chunks, value))
+		for i := range chunks {
+			args = append(args, value[i*c.chunkSize:min((i+1)*c.chunkSize, len(value))])
+		}
+	}
+	_, err := c.client.InvokeScriptWithOptions(
+		ctx,
+		*setScript(),
+		*options.NewScriptOptions().WithKeys([]string{key, chunkKey(key, 0)}).WithArgs(args),
+	)
+	return err
+}
+
+// Get returns the value stored at `key` by [Codec.Set], reassembled from its chunks if it was chunked. The chunks are
+// checked against the length and the checksum recorded in the manifest, and read again if the value was overwritten
+// between reading the manifest and reading the chunks.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the value.
+//
+// Return value:
+//
+//	The value stored at `key`, or a nil result if `key` does not exist.
+func (c *Codec) Get(ctx context.Context, key string) (models.Result[string], error) {
+	for range maxReadAttempts {
+		stored, err := c.client.Get(ctx, key)
+		if err != nil || stored.IsNil() {
+			return stored, err
+		}
+		m, isManifest, err := parseManifest(stored.Value())
+		if err != nil {
+			return models.CreateNilStringResult(), fmt.Errorf("malformed chunk manifest at key %q: %w", key, err)
+		}
+		if !isManifest {
+			return stored, nil
+		}
+		chunks, err := c.client.MGet(ctx, chunkKeys(key, 0, m.chunks))
+		if err != nil {
+			return models.CreateNilStringResult(), err
+		}
+		var builder strings.Builder
+		builder.Grow(m.length)
+		for _, chunk := range chunks {
+			builder.WriteString(chunk.Value())
+		}
+		value := builder.String()
+		if len(value) == m.length && crc32.ChecksumIEEE([]byte(value)) == m.checksum {
+			return models.CreateStringResult(value), nil
+		}
+	}
+	return models.CreateNilStringResult(), fmt.Errorf(
+		"the chunks of key %q do not match their manifest, the value is either overwritten concurrently or corrupted",
+		key,
+	)
+}
+
+// Delete deletes `key` along with its chunks, atomically with a single `DEL`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the value.
+//
+// Return value:
+//
+//	true if `key` existed.
+func (c *Codec) Delete(ctx context.Context, key string) (bool, error) {
+	chunks, err := c.chunkCount(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	keys := append([]string{key}, chunkKeys(key, 0, chunks)...)
+	deleted, err := c.client.Del(ctx, keys)
+	return deleted > 0, err
+}
+
+// chunkCount returns the number of chunks of the value stored at `key`, zero if it is not chunked or does not exist.
+func (c *Codec) chunkCount(ctx context.Context, key string) (int, error) {
+	stored, err := c.client.Get(ctx, key)
+	if err != nil || stored.IsNil() {
+		return 0, err
+	}
+	m, _, err := parseManifest(stored.Value())
+	if err != nil {
+		return 0, fmt.Errorf("malformed chunk manifest at key %q: %w", key, err)
+	}
+	return m.chunks, nil
+}
+
+// manifest describes a chunked value. It is formatted as the prefix followed by the number of chunks, the length of the
+// value and its CRC-32 checksum, separated by ":".
+type manifest struct {
+	chunks   int
+	length   int
+	checksum uint32
+}
+
+func formatManifest(chunks int, value string) string {
+	return manifestPrefix + strconv.Itoa(chunks) + ":" + strconv.Itoa(len(value)) + ":" +
+		strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(value))), 16)
+}
+
+// parseManifest parses the manifest stored at a key, and returns false if the key holds a plain value.
+func parseManifest(stored string) (manifest, bool, error) {
+	fields, found := strings.CutPrefix(stored, manifestPrefix)
+	if !found {
+		return manifest{}, false, nil
+	}
+	parts := strings.Split(fields, ":")
+	if len(parts) != 3 {
+		return manifest{}, true, errors.New("expected 3 fields")
+	}
+	chunks, err := strconv.Atoi(parts[0])
+	if err != nil || chunks <= 0 {
+		return manifest{}, true, fmt.Errorf("invalid chunk count %q", parts[0])
+	}
+	length, err := strconv.Atoi(parts[1])
+	if err != nil || length < 0 {
+		return manifest{}, true, fmt.Errorf("invalid length %q", parts[1])
+	}
+	checksum, err := strconv.ParseUint(parts[2], 16, 32)
+	if err != nil {
+		return manifest{}, true, fmt.Errorf("invalid checksum %q", parts[2])
+	}
+	return manifest{chunks: chunks, length: length, checksum: uint32(checksum)}, true, nil
+}
+
+// chunkKey returns the key of the chunk `index` of the value stored at `key`, in the same slot as `key` unless `key` has
+// a "}" but no hash tag.
+func chunkKey(key string, index int) string {
+	return utils.SameSlotKey(key, ":chunk:"+strconv.Itoa(index))
+}
+
+// chunkKeys returns the keys of the chunks `from` to `to`, excluded.
+func chunkKeys(key string, from int, to int) []string {
+	keys := make([]string, 0, max(to-from, 0))
+	for i := from; i < to; i++ {
+		keys = append(keys, chunkKey(key, i))
+	}
+	return keys
+}
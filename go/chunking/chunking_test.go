@@ -0,0 +1,178 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package chunking
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// newFakeClient creates a fake client running the set script natively.
+func newFakeClient() *fakeclient.Client {
+	client := fakeclient.New()
+	return client.WithScript(func(keys []string, args []string) (any, error) {
+		ctx := context.Background()
+		chunkKey := strings.TrimSuffix(keys[1], "0")
+		stored, err := client.Get(ctx, keys[0])
+		if err != nil {
+			return nil, err
+		}
+		var previous int
+		if fields, ok := strings.CutPrefix(stored.Value(), args[0]); ok {
+			previous, _ = strconv.Atoi(strings.Split(fields, ":")[0])
+		}
+		if _, err := client.Set(ctx, keys[0], args[1]); err != nil {
+			return nil, err
+		}
+		chunks := len(args) - 2
+		for i, chunk := range args[2:] {
+			if _, err := client.Set(ctx, chunkKey+strconv.Itoa(i), chunk); err != nil {
+				return nil, err
+			}
+		}
+		for i := chunks; i < previous; i++ {
+			if _, err := client.Del(ctx, []string{chunkKey + strconv.Itoa(i)}); err != nil {
+				return nil, err
+			}
+		}
+		return int64(chunks), nil
+	})
+}
+
+func TestCodec_SmallValue(t *testing.T) {
+	client := newFakeClient()
+	codec := New(client).WithChunkSize(4)
+	require.NoError(t, codec.Set(context.Background(), "key", "abcd"))
+	assert.Equal(t, map[string]string{"key": "abcd"}, client.Strings)
+
+	value, err := codec.Get(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", value.Value())
+
+	value, err = codec.Get(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.True(t, value.IsNil())
+}
+
+func TestCodec_ChunkedValue(t *testing.T) {
+	client := newFakeClient()
+	codec := New(client).WithChunkSize(4)
+	require.NoError(t, codec.Set(context.Background(), "key", "abcdefghij"))
+	assert.Equal(t, "abcd", client.Strings["{key}:chunk:0"])
+	assert.Equal(t, "efgh", client.Strings["{key}:chunk:1"])
+	assert.Equal(t, "ij", client.Strings["{key}:chunk:2"])
+	assert.True(t, strings.HasPrefix(client.Strings["key"], manifestPrefix))
+
+	value, err := codec.Get(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefghij", value.Value())
+
+	// Values written with another chunk size can be read.
+	value, err = New(client).Get(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefghij", value.Value())
+
+	// The chunks that are not overwritten are deleted.
+	require.NoError(t, codec.Set(context.Background(), "key", "klmnop"))
+	assert.Len(t, client.Strings, 3)
+	value, err = codec.Get(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Equal(t, "klmnop", value.Value())
+
+	require.NoError(t, codec.Set(context.Background(), "key", "q"))
+	assert.Equal(t, map[string]string{"key": "q"}, client.Strings)
+}
+
+func TestCodec_ConcurrentSets(t *testing.T) {
+	client := newFakeClient()
+	codec := New(client).WithChunkSize(4)
+	values := make([]string, 8)
+	for i := range values {
+		values[i] = strings.Repeat(strconv.Itoa(i), 4*i+1)
+	}
+
+	var wg sync.WaitGroup
+	for _, value := range values {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				assert.NoError(t, codec.Set(context.Background(), "key", value))
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The last value is stored whole, without the chunks of the others
+	value, err := codec.Get(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Contains(t, values, value.Value())
+	chunks := 0
+	if len(value.Value()) > 4 {
+		chunks = (len(value.Value()) + 3) / 4
+	}
+	assert.Len(t, client.Strings, chunks+1, fmt.Sprint(client.Strings))
+}
+
+func TestCodec_Delete(t *testing.T) {
+	client := newFakeClient()
+	codec := New(client).WithChunkSize(4)
+	require.NoError(t, codec.Set(context.Background(), "key", "abcdefghij"))
+
+	deleted, err := codec.Delete(context.Background(), "key")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, client.Strings)
+
+	deleted, err = codec.Delete(context.Background(), "key")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
+
+func TestCodec_ValueLookingLikeManifest(t *testing.T) {
+	client := newFakeClient()
+	codec := New(client)
+	require.NoError(t, codec.Set(context.Background(), "key", manifestPrefix+"1:1:0"))
+	value, err := codec.Get(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Equal(t, manifestPrefix+"1:1:0", value.Value())
+}
+
+func TestCodec_CorruptedChunks(t *testing.T) {
+	client := newFakeClient()
+	codec := New(client).WithChunkSize(4)
+	require.NoError(t, codec.Set(context.Background(), "key", "abcdefghij"))
+	client.Strings["{key}:chunk:1"] = "EFGH"
+	_, err := codec.Get(context.Background(), "key")
+	assert.ErrorContains(t, err, "do not match their manifest")
+
+	delete(client.Strings, "{key}:chunk:1")
+	_, err = codec.Get(context.Background(), "key")
+	assert.Error(t, err)
+
+	client.Strings["key"] = manifestPrefix + "x"
+	_, err = codec.Get(context.Background(), "key")
+	assert.ErrorContains(t, err, "malformed chunk manifest")
+}
+
+func TestCodec_InvalidChunkSize(t *testing.T) {
+	assert.Error(t, New(newFakeClient()).WithChunkSize(0).Set(context.Background(), "key", "value"))
+}
+
+func TestChunkKey(t *testing.T) {
+	assert.Equal(t, "{user:1}:chunk:0", chunkKey("user:1", 0))
+	assert.Equal(t, "{user}:1:chunk:2", chunkKey("{user}:1", 2))
+	assert.Equal(t, "a}b:chunk:0", chunkKey("a}b", 0))
+	for _, key := range []string{"user:1", "{user}:1", "{user:2"} {
+		assert.Equal(t, utils.KeySlot(key), utils.KeySlot(chunkKey(key, 3)), key)
+	}
+}
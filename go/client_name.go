@@ -0,0 +1,14 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import "github.com/valkey-io/valkey-glide/go/v2/config"
+
+// suffixedClientName appends the suffixes of the client name configured with `WithClientNameSuffixes` to `name`, so that
+// the names set with ClientSetName identify the instance of the application too.
+func (client *baseClient) suffixedClientName(name string) string {
+	if client.clientNameSuffix == "" {
+		return name
+	}
+	return config.SuffixedClientName(name, client.clientNameSuffix)
+}
@@ -0,0 +1,146 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// clientSideCache caches the values read with `GET` from the keys tracked by the broadcasting client tracking of the
+// connections, and drops them when the server pushes their invalidation. See [config.ClientSideCacheConfiguration].
+//
+// A nil cache caches nothing.
+type clientSideCache struct {
+	// The prefixes of the cached keys, as passed to the commands, or empty if all the keys are cached.
+	prefixes []string
+	// The prefix the core adds to the keys, which the invalidated keys are pushed with.
+	keyPrefix string
+	// The values by key as stored on the server, i.e. with the prefix of the keys.
+	values *utils.LRUCache[string, models.Result[string]]
+	// Held while checking that no invalidation happened during a read and caching its value, so that a value read before
+	// an invalidation is not cached after it.
+	mu sync.Mutex
+	// Incremented by every invalidation.
+	generation uint64
+}
+
+func newClientSideCache(cacheConfig *config.ClientSideCacheConfiguration, keyPrefix string) *clientSideCache {
+	return &clientSideCache{
+		prefixes:  cacheConfig.GetPrefixes(),
+		keyPrefix: keyPrefix,
+		values:    utils.NewLRUCache[string, models.Result[string]](cacheConfig.GetMaxEntries(), cacheConfig.GetTTL()),
+	}
+}
+
+// tracks returns whether the values of `key` are cached.
+func (cache *clientSideCache) tracks(key string) bool {
+	if cache == nil {
+		return false
+	}
+	if len(cache.prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range cache.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// currentGeneration returns the generation to pass to put the value read from now on.
+func (cache *clientSideCache) currentGeneration() uint64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.generation
+}
+
+// put caches `value` for `key`, unless an invalidation happened since `generation`.
+func (cache *clientSideCache) put(key string, value models.Result[string], generation uint64) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.generation == generation {
+		cache.values.Put(cache.keyPrefix+key, value)
+	}
+}
+
+// invalidate drops the value of `serverKey`, the key as stored on the server.
+func (cache *clientSideCache) invalidate(serverKey string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.generation++
+	cache.values.Delete(serverKey)
+}
+
+// clear drops all the values, when the database is flushed or the invalidations may have been missed.
+func (cache *clientSideCache) clear() {
+	if cache == nil {
+		return
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.generation++
+	cache.values.Clear()
+}
+
+// withConnectionHooks returns `hooks` extended to clear the cache whenever a connection is established or lost.
+func (cache *clientSideCache) withConnectionHooks(hooks *config.ConnectionLifecycleHooks) *config.ConnectionLifecycleHooks {
+	extended := config.ConnectionLifecycleHooks{}
+	if hooks != nil {
+		extended = *hooks
+	}
+	onConnect, onDisconnect := extended.OnConnect, extended.OnDisconnect
+	extended.OnConnect = func(address string) {
+		cache.clear()
+		if onConnect != nil {
+			onConnect(address)
+		}
+	}
+	extended.OnDisconnect = func(address string, err error) {
+		cache.clear()
+		if onDisconnect != nil {
+			onDisconnect(address, err)
+		}
+	}
+	return &extended
+}
+
+// handleInvalidation drops the value of the key pushed by the server, or all the values if `key` is nil.
+func (cache *clientSideCache) handleInvalidation(key *string) {
+	if cache == nil {
+		return
+	}
+	if key == nil {
+		cache.clear()
+		return
+	}
+	cache.invalidate(*key)
+}
+
+// cachedGet serves `GET` from the client-side cache, reading and caching the value on a miss.
+func (client *baseClient) cachedGet(ctx context.Context, key string) (models.Result[string], error) {
+	cache := client.clientSideCache
+	if value, ok := cache.values.Get(cache.keyPrefix + key); ok {
+		return value, nil
+	}
+	generation := cache.currentGeneration()
+	result, err := client.executeCommand(ctx, C.Get, []string{key})
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	value, err := handleStringOrNilResponse(result)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	cache.put(key, value, generation)
+	return value, nil
+}
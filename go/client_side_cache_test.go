@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestClientSideCache_Tracks(t *testing.T) {
+	var disabled *clientSideCache
+	assert.False(t, disabled.tracks("config:a"))
+
+	cache := newClientSideCache(config.NewClientSideCacheConfiguration("config:", "product:"), "")
+	assert.True(t, cache.tracks("config:a"))
+	assert.True(t, cache.tracks("product:42"))
+	assert.False(t, cache.tracks("session:1"))
+
+	all := newClientSideCache(config.NewClientSideCacheConfiguration(), "")
+	assert.True(t, all.tracks("session:1"))
+}
+
+func TestClientSideCache_Invalidation(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfiguration("config:"), "tenant:")
+
+	generation := cache.currentGeneration()
+	cache.put("config:a", models.CreateStringResult("1"), generation)
+	cache.put("config:b", models.CreateNilStringResult(), generation)
+	value, ok := cache.values.Get("tenant:config:a")
+	assert.True(t, ok)
+	assert.Equal(t, "1", value.Value())
+	value, ok = cache.values.Get("tenant:config:b")
+	assert.True(t, ok)
+	assert.True(t, value.IsNil())
+
+	// The invalidated keys are pushed with the prefix of the keys
+	invalidated := "tenant:config:a"
+	cache.handleInvalidation(&invalidated)
+	_, ok = cache.values.Get("tenant:config:a")
+	assert.False(t, ok)
+	_, ok = cache.values.Get("tenant:config:b")
+	assert.True(t, ok)
+
+	// A value read before an invalidation is not cached
+	cache.put("config:a", models.CreateStringResult("stale"), generation)
+	_, ok = cache.values.Get("tenant:config:a")
+	assert.False(t, ok)
+
+	// A flush invalidates all the keys
+	cache.handleInvalidation(nil)
+	assert.Equal(t, 0, cache.values.Len())
+}
+
+func TestClientSideCache_ConnectionHooks(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfiguration(), "")
+	var connected, disconnected []string
+	hooks := cache.withConnectionHooks(&config.ConnectionLifecycleHooks{
+		OnConnect:    func(address string) { connected = append(connected, address) },
+		OnDisconnect: func(address string, err error) { disconnected = append(disconnected, address) },
+	})
+
+	cache.put("a", models.CreateStringResult("1"), cache.currentGeneration())
+	hooks.OnDisconnect("localhost:6379", errors.New("closed"))
+	assert.Equal(t, 0, cache.values.Len())
+
+	cache.put("a", models.CreateStringResult("1"), cache.currentGeneration())
+	hooks.OnConnect("localhost:6379")
+	assert.Equal(t, 0, cache.values.Len())
+
+	assert.Equal(t, []string{"localhost:6379"}, connected)
+	assert.Equal(t, []string{"localhost:6379"}, disconnected)
+	assert.Nil(t, hooks.OnReconnectAttempt)
+
+	// The cache is cleared even without user hooks
+	hooks = cache.withConnectionHooks(nil)
+	cache.put("a", models.CreateStringResult("1"), cache.currentGeneration())
+	hooks.OnConnect("localhost:6379")
+	assert.Equal(t, 0, cache.values.Len())
+}
@@ -0,0 +1,70 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package clock provides the clock the helpers computing expirations and due times read the current time from, so that
+// tests can control the time instead of sleeping.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// System returns the clock of the system, used by the helpers by default.
+func System() Clock {
+	return systemClock{}
+}
+
+// Fake is a [Clock] whose time only changes when it is set or advanced, for tests. It is safe for concurrent use.
+//
+// The time of the server is not affected: the keys given a time to live by the server still expire in real time.
+//
+// Example:
+//
+//	fake := clock.NewFake(time.Unix(1_700_000_000, 0))
+//	scheduler := delayqueue.New(client).WithClock(fake)
+//	err := scheduler.Schedule(ctx, "emails", payload, fake.Now().Add(time.Hour))
+//	...
+//	fake.Advance(time.Hour)
+//	items, err := scheduler.Poll(ctx, "emails", 10)
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake creates a [Fake] clock set to `now`.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the time the clock is set to.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance moves the clock forward by `d`, or backward if `d` is negative.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Set sets the clock to `now`.
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
@@ -0,0 +1,31 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystem(t *testing.T) {
+	before := time.Now()
+	now := System().Now()
+	assert.False(t, now.Before(before))
+	assert.False(t, now.After(time.Now()))
+}
+
+func TestFake(t *testing.T) {
+	start := time.UnixMilli(1_700_000_000_000)
+	fake := NewFake(start)
+	assert.Equal(t, start, fake.Now())
+
+	fake.Advance(time.Minute)
+	assert.Equal(t, start.Add(time.Minute), fake.Now())
+	fake.Advance(-time.Second)
+	assert.Equal(t, start.Add(59*time.Second), fake.Now())
+
+	fake.Set(start)
+	assert.Equal(t, start, fake.Now())
+}
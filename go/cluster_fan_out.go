@@ -0,0 +1,222 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// ForEachNode calls `fn` once for every node selected by `opts.Nodes`, with a route to that node, running at most
+// `opts.Concurrency` calls at a time.
+//
+// Commands routed with [config.AllNodes] or [config.AllPrimaries] fail as a whole as soon as a single node fails.
+// `ForEachNode` instead lets every node succeed or fail on its own, and reports the failed nodes in a [NodeErrors]. The
+// concurrency limit avoids opening a burst of requests on large clusters.
+//
+// The nodes are the ones currently known by the client. If they cannot be listed, the error is returned and `fn` is not
+// called. If `ctx` is done, `fn` is not called for the remaining nodes, which fail with the error of the context.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The nodes and the concurrency limit, see [options.FanOutOptions].
+//	fn - The function called for every node, with the address of the node and a route to it.
+//
+// Return value:
+//
+//	`nil` if `fn` succeeded on every node, a [NodeErrors] holding the error of every failed node otherwise.
+func (client *ClusterClient) ForEachNode(
+	ctx context.Context,
+	opts options.FanOutOptions,
+	fn func(ctx context.Context, address string, route config.Route) error,
+) error {
+	_, err := fanOut(ctx, client, opts, func(ctx context.Context, address string, route config.Route) (struct{}, error) {
+		return struct{}{}, fn(ctx, address, route)
+	})
+	return err
+}
+
+// InfoPerNode gets information and statistics about every node selected by `fanOutOptions.Nodes`, sending `INFO` to at
+// most `fanOutOptions.Concurrency` nodes at a time. See [ClusterClient.ForEachNode] for details.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	infoOptions - The sections of information to retrieve, see [options.InfoOptions].
+//	fanOutOptions - The nodes and the concurrency limit, see [options.FanOutOptions].
+//
+// Return value:
+//
+//	The information of every node that replied, by node address. If some nodes failed, their errors are returned in a
+//	[NodeErrors] together with the information of the other nodes.
+//
+// [valkey.io]: https://valkey.io/commands/info/
+func (client *ClusterClient) InfoPerNode(
+	ctx context.Context,
+	infoOptions options.InfoOptions,
+	fanOutOptions options.FanOutOptions,
+) (map[string]string, error) {
+	args, err := infoOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	return fanOut(ctx, client, fanOutOptions, func(ctx context.Context, _ string, route config.Route) (string, error) {
+		result, err := client.executeCommandWithRoute(ctx, C.Info, args, route)
+		if err != nil {
+			return models.DefaultStringResponse, err
+		}
+		return handleStringResponse(result)
+	})
+}
+
+// ConfigSetPerNode sets configuration parameters on every node selected by `fanOutOptions.Nodes`, sending `CONFIG SET` to
+// at most `fanOutOptions.Concurrency` nodes at a time. See [ClusterClient.ForEachNode] for details.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	parameters - A map consisting of configuration parameters and their respective values to set.
+//	fanOutOptions - The nodes and the concurrency limit, see [options.FanOutOptions].
+//
+// Return value:
+//
+//	`nil` if the parameters were set on every node, a [NodeErrors] holding the error of every failed node otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/config-set/
+func (client *ClusterClient) ConfigSetPerNode(
+	ctx context.Context,
+	parameters map[string]string,
+	fanOutOptions options.FanOutOptions,
+) error {
+	args := utils.MapToString(parameters)
+	return client.ForEachNode(ctx, fanOutOptions, func(ctx context.Context, _ string, route config.Route) error {
+		result, err := client.executeCommandWithRoute(ctx, C.ConfigSet, args, route)
+		if err != nil {
+			return err
+		}
+		_, err = handleOkResponse(result)
+		return err
+	})
+}
+
+// FlushAllPerNode deletes all the keys of all the databases of every node selected by `fanOutOptions.Nodes`, sending
+// `FLUSHALL` to at most `fanOutOptions.Concurrency` nodes at a time. See [ClusterClient.ForEachNode] for details.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	mode - The flushing mode, [options.SYNC] or [options.ASYNC].
+//	fanOutOptions - The nodes and the concurrency limit, see [options.FanOutOptions]. Only primaries accept writes.
+//
+// Return value:
+//
+//	`nil` if every node was flushed, a [NodeErrors] holding the error of every failed node otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/flushall/
+func (client *ClusterClient) FlushAllPerNode(
+	ctx context.Context,
+	mode options.FlushMode,
+	fanOutOptions options.FanOutOptions,
+) error {
+	args := []string{string(mode)}
+	return client.ForEachNode(ctx, fanOutOptions, func(ctx context.Context, _ string, route config.Route) error {
+		result, err := client.executeCommandWithRoute(ctx, C.FlushAll, args, route)
+		if err != nil {
+			return err
+		}
+		_, err = handleOkResponse(result)
+		return err
+	})
+}
+
+// fanOut lists the nodes selected by `opts.Nodes` and calls `fn` for each of them, at most `opts.Concurrency` at a time.
+// The results of the successful nodes are returned together with a NodeErrors if some nodes failed.
+func fanOut[T any](
+	ctx context.Context,
+	client *ClusterClient,
+	opts options.FanOutOptions,
+	fn func(ctx context.Context, address string, route config.Route) (T, error),
+) (map[string]T, error) {
+	nodes, err := client.ClusterMyIdWithRoute(ctx, options.RouteOption{Route: opts.Nodes})
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, 0, len(nodes.MultiValue()))
+	for address := range nodes.MultiValue() {
+		addresses = append(addresses, address)
+	}
+	return fanOutTo(ctx, addresses, opts.Concurrency, fn)
+}
+
+// fanOutTo calls `fn` for each of the nodes of `addresses`, at most `concurrency` at a time, or all at once if it is not
+// positive. The results of the successful nodes are returned together with a NodeErrors if some nodes failed.
+func fanOutTo[T any](
+	ctx context.Context,
+	addresses []string,
+	concurrency int,
+	fn func(ctx context.Context, address string, route config.Route) (T, error),
+) (map[string]T, error) {
+	if concurrency <= 0 || concurrency > len(addresses) {
+		concurrency = len(addresses)
+	}
+	semaphore := make(chan struct{}, max(concurrency, 1))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]T, len(addresses))
+	errs := map[string]error{}
+	setResult := func(address string, result T, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[address] = err
+		} else {
+			results[address] = result
+		}
+	}
+
+	for _, address := range addresses {
+		select {
+		case <-ctx.Done():
+			var zero T
+			setResult(address, zero, ctx.Err())
+			continue
+		case semaphore <- struct{}{}:
+		}
+		route, err := config.NewByAddressRouteWithHost(address)
+		if err != nil {
+			<-semaphore
+			var zero T
+			setResult(address, zero, err)
+			continue
+		}
+		wg.Add(1)
+		go func(address string, route config.Route) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			result, err := fn(ctx, address, route)
+			setResult(address, result, err)
+		}(address, route)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return results, NewNodeErrors(errs)
+	}
+	return results, nil
+}
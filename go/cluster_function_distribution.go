@@ -0,0 +1,298 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// LibraryDigest returns the SHA1 digest of `libraryCode` in hexadecimal, as reported in [models.LibraryStatus.Digest].
+func LibraryDigest(libraryCode string) string {
+	digest := sha1.Sum([]byte(libraryCode))
+	return hex.EncodeToString(digest[:])
+}
+
+// FunctionLoadPerNode loads the function library of `libraryCode` on every node selected by `fanOutOptions.Nodes`, and
+// verifies that every node has the library with the same code, sending `FUNCTION LIST` and `FUNCTION LOAD` to at most
+// `fanOutOptions.Concurrency` nodes at a time. See [ClusterClient.ForEachNode] for details.
+//
+// Unlike [ClusterClient.FunctionLoad], which fails as a whole as soon as a single node fails, the library is loaded on
+// every node on its own, and the nodes where it is loaded with the same code already are left as they are, so that the
+// library can be loaded again on the nodes that failed, or that joined the cluster since, see
+// [ClusterClient.WatchLibraries].
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	libraryCode - The source code that implements the library, starting with its name, e.g. `#!lua name=mylib`.
+//	replace - Whether the library should overwrite a library with the same name but another code.
+//	fanOutOptions - The nodes and the concurrency limit, see [options.FanOutOptions]. Only primaries accept
+//	  `FUNCTION LOAD`, and replicate the library to their replicas.
+//
+// Return value:
+//
+//	The status of the library on every node that replied, by node address. If the library could not be loaded or verified
+//	on some nodes, their errors are returned in a [NodeErrors] together with the status of the other nodes.
+//
+// [valkey.io]: https://valkey.io/commands/function-load/
+func (client *ClusterClient) FunctionLoadPerNode(
+	ctx context.Context,
+	libraryCode string,
+	replace bool,
+	fanOutOptions options.FanOutOptions,
+) (map[string]models.LibraryStatus, error) {
+	libraryName, err := libraryNameOf(libraryCode)
+	if err != nil {
+		return nil, err
+	}
+	digest := LibraryDigest(libraryCode)
+	return fanOut(
+		ctx,
+		client,
+		fanOutOptions,
+		func(ctx context.Context, _ string, route config.Route) (models.LibraryStatus, error) {
+			status, err := client.libraryStatus(ctx, libraryName, digest, route)
+			if err != nil || status.Verified {
+				return status, err
+			}
+			_, err = client.FunctionLoadWithRoute(ctx, libraryCode, replace, options.RouteOption{Route: route})
+			if err != nil {
+				return status, err
+			}
+			status, err = client.libraryStatus(ctx, libraryName, digest, route)
+			if err != nil {
+				return status, err
+			}
+			if !status.Verified {
+				return status, fmt.Errorf(
+					"library %q has digest %q after it was loaded, instead of %q",
+					libraryName,
+					status.Digest,
+					digest,
+				)
+			}
+			status.Loaded = true
+			return status, nil
+		},
+	)
+}
+
+// FunctionVerifyPerNode verifies that every node selected by `fanOutOptions.Nodes` has the function library of
+// `libraryCode` with the same code, sending `FUNCTION LIST` to at most `fanOutOptions.Concurrency` nodes at a time, e.g.
+// to check that a library loaded on the primaries reached their replicas. See [ClusterClient.ForEachNode] for details.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	libraryCode - The source code that implements the library, starting with its name, e.g. `#!lua name=mylib`.
+//	fanOutOptions - The nodes and the concurrency limit, see [options.FanOutOptions].
+//
+// Return value:
+//
+//	The status of the library on every node that replied, by node address, with [models.LibraryStatus.Verified] false for the
+//	nodes without the library or with another code. If some nodes failed, their errors are returned in a [NodeErrors]
+//	together with the status of the other nodes.
+//
+// [valkey.io]: https://valkey.io/commands/function-list/
+func (client *ClusterClient) FunctionVerifyPerNode(
+	ctx context.Context,
+	libraryCode string,
+	fanOutOptions options.FanOutOptions,
+) (map[string]models.LibraryStatus, error) {
+	libraryName, err := libraryNameOf(libraryCode)
+	if err != nil {
+		return nil, err
+	}
+	digest := LibraryDigest(libraryCode)
+	return fanOut(
+		ctx,
+		client,
+		fanOutOptions,
+		func(ctx context.Context, _ string, route config.Route) (models.LibraryStatus, error) {
+			return client.libraryStatus(ctx, libraryName, digest, route)
+		},
+	)
+}
+
+// FunctionFlushPerNode deletes all the function libraries of every node selected by `fanOutOptions.Nodes`, and verifies
+// that no library is left on them, sending `FUNCTION FLUSH` and `FUNCTION LIST` to at most `fanOutOptions.Concurrency`
+// nodes at a time. See [ClusterClient.ForEachNode] for details.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	mode - The flushing mode, [options.SYNC] or [options.ASYNC].
+//	fanOutOptions - The nodes and the concurrency limit, see [options.FanOutOptions]. Only primaries accept writes.
+//
+// Return value:
+//
+//	`nil` if every node was flushed, a [NodeErrors] holding the error of every failed node otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/function-flush/
+func (client *ClusterClient) FunctionFlushPerNode(
+	ctx context.Context,
+	mode options.FlushMode,
+	fanOutOptions options.FanOutOptions,
+) error {
+	return client.ForEachNode(ctx, fanOutOptions, func(ctx context.Context, _ string, route config.Route) error {
+		routeOption := options.RouteOption{Route: route}
+		var err error
+		if mode == options.ASYNC {
+			_, err = client.FunctionFlushAsyncWithRoute(ctx, routeOption)
+		} else {
+			_, err = client.FunctionFlushSyncWithRoute(ctx, routeOption)
+		}
+		if err != nil {
+			return err
+		}
+		libraries, err := client.FunctionListWithRoute(ctx, models.FunctionListQuery{}, routeOption)
+		if err != nil {
+			return err
+		}
+		if left := len(libraries.SingleValue()); left > 0 {
+			return fmt.Errorf("%d function libraries are left after the flush", left)
+		}
+		return nil
+	})
+}
+
+// WatchLibraries keeps the function libraries of `libraryCodes` loaded on every node selected by `fanOutOptions.Nodes`,
+// such as the primaries that join the cluster after the libraries were loaded, or that restarted without persistence.
+// Every `pollInterval`, it loads the libraries with [ClusterClient.FunctionLoadPerNode] on the nodes where they are
+// missing or have another code, and calls `handler` for every node where a library was loaded or failed to load, until
+// `ctx` is cancelled or the nodes cannot be listed.
+//
+// The libraries replace the libraries with the same name but another code, so that every node runs the same code.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the watch. Cancelling it stops the watch.
+//	libraryCodes - The source codes of the libraries, each starting with the name of its library.
+//	pollInterval - The interval between the checks of the nodes.
+//	fanOutOptions - The nodes and the concurrency limit, see [options.FanOutOptions]. Only primaries accept
+//	  `FUNCTION LOAD`.
+//	handler - Called with the address of a node, the name of a library, and `nil` once the library was loaded on the node
+//	  or the error of the node otherwise, from the goroutine calling WatchLibraries.
+//
+// Return value:
+//
+//	The error that stopped the watch: the error of `ctx` once it is cancelled, or the error listing the nodes.
+func (client *ClusterClient) WatchLibraries(
+	ctx context.Context,
+	libraryCodes []string,
+	pollInterval time.Duration,
+	fanOutOptions options.FanOutOptions,
+	handler func(address string, libraryName string, err error),
+) error {
+	if pollInterval <= 0 {
+		return errors.New("the poll interval must be positive")
+	}
+	libraryNames := make([]string, len(libraryCodes))
+	for i, libraryCode := range libraryCodes {
+		libraryName, err := libraryNameOf(libraryCode)
+		if err != nil {
+			return err
+		}
+		libraryNames[i] = libraryName
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		for i, libraryCode := range libraryCodes {
+			statuses, err := client.FunctionLoadPerNode(ctx, libraryCode, true, fanOutOptions)
+			var nodeErrs *NodeErrors
+			if err != nil && !errors.As(err, &nodeErrs) {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return fmt.Errorf("failed to load library %q: %w", libraryNames[i], err)
+			}
+			for address, status := range statuses {
+				if status.Loaded {
+					handler(address, libraryNames[i], nil)
+				}
+			}
+			if nodeErrs != nil {
+				for address, err := range nodeErrs.Errors {
+					handler(address, libraryNames[i], err)
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// libraryStatus returns the status of the library named `libraryName` on the node of `route`, compared to the code of
+// digest `digest`.
+func (client *ClusterClient) libraryStatus(
+	ctx context.Context,
+	libraryName string,
+	digest string,
+	route config.Route,
+) (models.LibraryStatus, error) {
+	libraries, err := client.FunctionListWithRoute(
+		ctx,
+		models.FunctionListQuery{LibraryName: libraryName, WithCode: true},
+		options.RouteOption{Route: route},
+	)
+	if err != nil {
+		return models.LibraryStatus{}, err
+	}
+	// The library name of FUNCTION LIST is a pattern
+	for _, library := range libraries.SingleValue() {
+		if library.Name == libraryName {
+			libraryDigest := LibraryDigest(library.Code)
+			return models.LibraryStatus{Digest: libraryDigest, Verified: libraryDigest == digest}, nil
+		}
+	}
+	return models.LibraryStatus{}, nil
+}
+
+// libraryNameOf returns the name of the library of `libraryCode`, given by its first line, e.g. `#!lua name=mylib`.
+func libraryNameOf(libraryCode string) (string, error) {
+	shebang, _, _ := strings.Cut(libraryCode, "\n")
+	if strings.HasPrefix(shebang, "#!") {
+		for _, field := range strings.Fields(shebang)[1:] {
+			if name, ok := strings.CutPrefix(field, "name="); ok && name != "" {
+				return name, nil
+			}
+		}
+	}
+	return "", errors.New("the library code must start with the name of the library, e.g. `#!lua name=mylib`")
+}
@@ -0,0 +1,35 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLibraryNameOf(t *testing.T) {
+	for code, name := range map[string]string{
+		"#!lua name=mylib\nredis.register_function('f', function() return 1 end)": "mylib",
+		"#!lua name=mylib":                     "mylib",
+		"#!lua name=mylib\r\nreturn 1":         "mylib",
+		"#! lua name=mylib\nreturn 1":          "mylib",
+		"#!lua engine=x name=other\nreturn 1":  "other",
+		"#!lua name=mylib other=value\nreturn": "mylib",
+	} {
+		actual, err := libraryNameOf(code)
+		assert.NoError(t, err, code)
+		assert.Equal(t, name, actual, code)
+	}
+
+	for _, code := range []string{"", "#!lua\nreturn 1", "#!lua name=\nreturn 1", "return 1\n#!lua name=mylib"} {
+		_, err := libraryNameOf(code)
+		assert.Error(t, err, code)
+	}
+}
+
+func TestLibraryDigest(t *testing.T) {
+	assert.Equal(t, "da39a3ee5e6b4b0d3255bfef95601890afd80709", LibraryDigest(""))
+	assert.Equal(t, LibraryDigest("#!lua name=mylib\nreturn 1"), LibraryDigest("#!lua name=mylib\nreturn 1"))
+	assert.NotEqual(t, LibraryDigest("#!lua name=mylib\nreturn 1"), LibraryDigest("#!lua name=mylib\nreturn 2"))
+}
@@ -0,0 +1,178 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// MigrateSlot moves a hash slot, together with all of its keys, from the `source` primary to the `target` primary.
+//
+// The migration follows the procedure described in the cluster specification:
+//  1. The target node is marked as `IMPORTING` the slot from the source node.
+//  2. The source node is marked as `MIGRATING` the slot to the target node.
+//  3. The keys of the slot are fetched from the source node with `CLUSTER GETKEYSINSLOT` and moved to the target node with
+//     `MIGRATE`, `BatchSize` keys at a time, until the slot is empty. `OnProgress` is called after every batch.
+//  4. The slot is assigned to the target node with `CLUSTER SETSLOT NODE`, first on the target node, then on the source
+//     node, and finally on every other primary so that the new ownership propagates quickly.
+//
+// While the migration is in progress, the client transparently follows the `ASK` redirections returned by the source node.
+// If the migration fails midway, the slot is left in the migrating/importing state, and calling `MigrateSlot` again with
+// the same arguments resumes it.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The hash slot number (0-16383).
+//	source - The address of the primary currently serving the slot.
+//	target - The address of the primary that should serve the slot.
+//	opts - The migration options, see [options.MigrateSlotOptions].
+//
+// Return value:
+//
+//	The total number of migrated keys.
+//
+// [valkey.io]: https://valkey.io/topics/cluster-spec/#live-resharding
+func (client *ClusterClient) MigrateSlot(
+	ctx context.Context,
+	slot int64,
+	source config.ByAddressRoute,
+	target config.ByAddressRoute,
+	opts options.MigrateSlotOptions,
+) (int64, error) {
+	if opts.BatchSize <= 0 {
+		return 0, fmt.Errorf("migrate slot batch size must be positive, got %d", opts.BatchSize)
+	}
+	timeout, err := utils.DurationToMilliseconds(opts.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid migrate slot timeout: %w", err)
+	}
+	sourceRoute := options.RouteOption{Route: &source}
+	targetRoute := options.RouteOption{Route: &target}
+
+	sourceId, err := client.ClusterMyIdWithRoute(ctx, sourceRoute)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the ID of the source node: %w", err)
+	}
+	targetId, err := client.ClusterMyIdWithRoute(ctx, targetRoute)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the ID of the target node: %w", err)
+	}
+
+	importing := options.NewSetSlotImporting(sourceId.SingleValue())
+	if _, err = client.ClusterSetSlot(ctx, slot, *importing, targetRoute); err != nil {
+		return 0, fmt.Errorf("failed to set slot %d as importing on the target node: %w", slot, err)
+	}
+	migrating := options.NewSetSlotMigrating(targetId.SingleValue())
+	if _, err = client.ClusterSetSlot(ctx, slot, *migrating, sourceRoute); err != nil {
+		return 0, fmt.Errorf("failed to set slot %d as migrating on the source node: %w", slot, err)
+	}
+
+	migrateArgs := []string{
+		target.Host,
+		utils.IntToString(int64(target.Port)),
+		"",
+		"0",
+		strconv.FormatUint(uint64(timeout), 10),
+	}
+	if opts.Replace {
+		migrateArgs = append(migrateArgs, "REPLACE")
+	}
+	if opts.Password != "" {
+		if opts.Username != "" {
+			migrateArgs = append(migrateArgs, "AUTH2", opts.Username, opts.Password)
+		} else {
+			migrateArgs = append(migrateArgs, "AUTH", opts.Password)
+		}
+	}
+	migrateArgs = append(migrateArgs, "KEYS")
+
+	var migrated int64
+	for {
+		keys, err := client.clusterGetKeysInSlotWithRoute(ctx, slot, opts.BatchSize, sourceRoute)
+		if err != nil {
+			return migrated, fmt.Errorf("failed to get the keys of slot %d from the source node: %w", slot, err)
+		}
+		if len(keys) == 0 {
+			break
+		}
+		if err = client.migrateKeys(ctx, append(migrateArgs, keys...), sourceRoute); err != nil {
+			return migrated, fmt.Errorf("failed to migrate the keys of slot %d: %w", slot, err)
+		}
+		migrated += int64(len(keys))
+		if opts.OnProgress != nil {
+			opts.OnProgress(models.SlotMigrationProgress{Slot: slot, BatchKeys: int64(len(keys)), MigratedKeys: migrated})
+		}
+	}
+
+	nodeSubcommand := *options.NewSetSlotNode(targetId.SingleValue())
+	if _, err = client.ClusterSetSlot(ctx, slot, nodeSubcommand, targetRoute); err != nil {
+		return migrated, fmt.Errorf("failed to assign slot %d on the target node: %w", slot, err)
+	}
+	if _, err = client.ClusterSetSlot(ctx, slot, nodeSubcommand, sourceRoute); err != nil {
+		return migrated, fmt.Errorf("failed to assign slot %d on the source node: %w", slot, err)
+	}
+
+	// Propagating the new owner to the other primaries is an optimization, the cluster bus eventually does the same.
+	primaries, err := client.ClusterMyIdWithRoute(ctx, options.RouteOption{Route: config.AllPrimaries})
+	if err == nil {
+		for address, id := range primaries.MultiValue() {
+			if id == sourceId.SingleValue() || id == targetId.SingleValue() {
+				continue
+			}
+			route, err := config.NewByAddressRouteWithHost(address)
+			if err != nil {
+				continue
+			}
+			_, _ = client.ClusterSetSlot(ctx, slot, nodeSubcommand, options.RouteOption{Route: route})
+		}
+	}
+
+	return migrated, nil
+}
+
+func (client *ClusterClient) clusterGetKeysInSlotWithRoute(
+	ctx context.Context,
+	slot int64,
+	count int64,
+	route options.RouteOption,
+) ([]string, error) {
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.ClusterGetKeysInSlot,
+		[]string{utils.IntToString(slot), utils.IntToString(count)},
+		route.Route,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// migrateKeys runs `MIGRATE` and accepts both "OK" and "NOKEY" (the keys were deleted or expired meanwhile).
+func (client *ClusterClient) migrateKeys(ctx context.Context, args []string, route options.RouteOption) error {
+	result, err := client.executeCommandWithRoute(ctx, C.Migrate, args, route.Route)
+	if err != nil {
+		return err
+	}
+	response, err := handleStringResponse(result)
+	if err != nil {
+		return err
+	}
+	if response != "OK" && response != "NOKEY" {
+		return fmt.Errorf("unexpected MIGRATE response: %s", response)
+	}
+	return nil
+}
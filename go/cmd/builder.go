@@ -0,0 +1,181 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package cmd builds the arguments of raw commands, e.g. for the commands of modules or the commands the clients do not
+// support yet, from typed parts instead of hand-formatted strings.
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Builder builds the arguments of a raw command, to run with `CustomCommand` or `CustomCommandWithOptions`. Every part is
+// appended in order, formatted the way the server parses it. The first invalid part, e.g. a duration that is not a whole
+// number of seconds, is reported by [Builder.ToArgs].
+//
+// The builder records the keys of the command, so that [Builder.Route] routes it to the node owning them in cluster mode.
+// The client only knows the key positions of the commands of the server and of the official modules, and routes the other
+// commands by their first argument, which is wrong for the commands whose first argument is a subcommand or an option.
+//
+// Example:
+//
+//	builder := cmd.New("CF.INSERT").
+//		Key("filter").
+//		Flag("CAPACITY").Int(10_000).
+//		FlagIf(noCreate, "NOCREATE").
+//		Flag("ITEMS").Args(items...)
+//	args, err := builder.ToArgs()
+//	...
+//	result, err := clusterClient.CustomCommandWithRoute(ctx, args, builder.Route())
+type Builder struct {
+	args     []string
+	keys     []string
+	readOnly bool
+	err      error
+}
+
+// New creates a [Builder] for the command `name`, followed by its `subcommands` if any, e.g. `New("CLIENT", "NO-EVICT")`.
+func New(name string, subcommands ...string) *Builder {
+	return &Builder{args: append([]string{name}, subcommands...)}
+}
+
+// Key appends `key`, and records it as a key of the command.
+func (b *Builder) Key(key string) *Builder {
+	b.keys = append(b.keys, key)
+	return b.Arg(key)
+}
+
+// Keys appends `keys`, and records them as keys of the command.
+func (b *Builder) Keys(keys ...string) *Builder {
+	for _, key := range keys {
+		b.Key(key)
+	}
+	return b
+}
+
+// Arg appends a string argument as is, e.g. a value or a field.
+func (b *Builder) Arg(arg string) *Builder {
+	b.args = append(b.args, arg)
+	return b
+}
+
+// Args appends string arguments as is.
+func (b *Builder) Args(args ...string) *Builder {
+	b.args = append(b.args, args...)
+	return b
+}
+
+// Int appends an integer argument.
+func (b *Builder) Int(value int64) *Builder {
+	return b.Arg(utils.IntToString(value))
+}
+
+// Float appends a floating-point argument, with the shortest representation that parses back to `value`. The infinities
+// are appended as "+inf" and "-inf". NaN is invalid.
+func (b *Builder) Float(value float64) *Builder {
+	switch {
+	case math.IsNaN(value):
+		return b.fail(errors.New("NaN is not a valid argument"))
+	case math.IsInf(value, 1):
+		return b.Arg("+inf")
+	case math.IsInf(value, -1):
+		return b.Arg("-inf")
+	}
+	return b.Arg(utils.FloatToString(value))
+}
+
+// Seconds appends `duration` as a number of seconds, e.g. for `EX`. The duration must be a non-negative whole number of
+// seconds, so that it is not rounded silently.
+func (b *Builder) Seconds(duration time.Duration) *Builder {
+	if duration < 0 || duration%time.Second != 0 {
+		return b.fail(fmt.Errorf("%v is not a non-negative whole number of seconds", duration))
+	}
+	return b.Int(int64(duration / time.Second))
+}
+
+// Millis appends `duration` as a number of milliseconds, e.g. for `PX`. The duration must be a non-negative whole number
+// of milliseconds, so that it is not rounded silently.
+func (b *Builder) Millis(duration time.Duration) *Builder {
+	if duration < 0 || duration%time.Millisecond != 0 {
+		return b.fail(fmt.Errorf("%v is not a non-negative whole number of milliseconds", duration))
+	}
+	return b.Int(duration.Milliseconds())
+}
+
+// UnixSeconds appends `t` as a Unix timestamp in seconds, rounded down, e.g. for `EXAT`.
+func (b *Builder) UnixSeconds(t time.Time) *Builder {
+	return b.Int(t.Unix())
+}
+
+// UnixMillis appends `t` as a Unix timestamp in milliseconds, rounded down, e.g. for `PXAT`.
+func (b *Builder) UnixMillis(t time.Time) *Builder {
+	return b.Int(t.UnixMilli())
+}
+
+// Flag appends a keyword, e.g. "NX", or the name of an option followed by its value, e.g. "LIMIT". The typed constants
+// of the options, e.g. [constants.GeoUnit], can be passed with a conversion: `Flag(string(constants.GeoUnitMeters))`.
+// An empty flag is invalid.
+//
+// [constants.GeoUnit]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2/constants#GeoUnit
+func (b *Builder) Flag(flag string) *Builder {
+	if flag == "" {
+		return b.fail(errors.New("a flag must not be empty"))
+	}
+	return b.Arg(flag)
+}
+
+// FlagIf appends the keyword `flag` only if `enabled` is true.
+func (b *Builder) FlagIf(enabled bool, flag string) *Builder {
+	if !enabled {
+		return b
+	}
+	return b.Flag(flag)
+}
+
+// ReadOnly marks the command as a read-only command, see [Builder.Options].
+func (b *Builder) ReadOnly() *Builder {
+	b.readOnly = true
+	return b
+}
+
+// ToArgs returns the arguments of the command, starting with its name, or the error of the first invalid part.
+func (b *Builder) ToArgs() ([]string, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return append([]string(nil), b.args...), nil
+}
+
+// KeyNames returns the keys of the command, in order.
+func (b *Builder) KeyNames() []string {
+	return append([]string(nil), b.keys...)
+}
+
+// Options returns the options to run the command with `CustomCommandWithOptions`: the command is routed according to
+// the `ReadFrom` strategy of the client if it was marked with [Builder.ReadOnly].
+func (b *Builder) Options() options.CustomCommandOptions {
+	return options.CustomCommandOptions{ReadOnlyHint: b.readOnly}
+}
+
+// Route returns the route of the command in cluster mode: the primary owning its first key, or nil if the command has no
+// key, in which case the client routes it on its own. The keys of a command must all be in the same slot.
+func (b *Builder) Route() config.Route {
+	if len(b.keys) == 0 {
+		return nil
+	}
+	return config.NewSlotKeyRoute(config.SlotTypePrimary, b.keys[0])
+}
+
+// fail records `err` if it is the first invalid part.
+func (b *Builder) fail(err error) *Builder {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
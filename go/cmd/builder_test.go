@@ -0,0 +1,69 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestBuilder_ToArgs(t *testing.T) {
+	builder := New("CF.INSERT").
+		Key("filter").
+		Flag("CAPACITY").Int(10_000).
+		FlagIf(false, "NOCREATE").
+		FlagIf(true, "NX").
+		Float(0.25).Float(math.Inf(1)).Float(math.Inf(-1)).
+		Seconds(90*time.Second).
+		Millis(1500*time.Millisecond).
+		UnixSeconds(time.UnixMilli(1_700_000_000_999)).
+		UnixMillis(time.UnixMilli(1_700_000_000_999)).
+		Flag("ITEMS").Args("a", "b")
+	args, err := builder.ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"CF.INSERT", "filter", "CAPACITY", "10000", "NX", "0.25", "+inf", "-inf", "90", "1500", "1700000000",
+		"1700000000999", "ITEMS", "a", "b",
+	}, args)
+
+	args, err = New("CLIENT", "NO-EVICT").Arg("on").ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CLIENT", "NO-EVICT", "on"}, args)
+}
+
+func TestBuilder_InvalidParts(t *testing.T) {
+	for name, builder := range map[string]*Builder{
+		"NaN":                     New("CMD").Float(math.NaN()),
+		"fractional seconds":      New("CMD").Seconds(1500 * time.Millisecond),
+		"negative seconds":        New("CMD").Seconds(-time.Second),
+		"fractional milliseconds": New("CMD").Millis(1500 * time.Microsecond),
+		"empty flag":              New("CMD").Flag(""),
+	} {
+		_, err := builder.Int(1).ToArgs()
+		assert.Error(t, err, name)
+	}
+
+	// The first error is reported
+	_, err := New("CMD").Seconds(time.Millisecond).Float(math.NaN()).ToArgs()
+	assert.ErrorContains(t, err, "seconds")
+}
+
+func TestBuilder_KeysAndRoute(t *testing.T) {
+	builder := New("XINFO", "STREAM").Key("{user}:events")
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "{user}:events"), builder.Route())
+	assert.False(t, builder.Options().ReadOnlyHint)
+	assert.True(t, builder.ReadOnly().Options().ReadOnlyHint)
+
+	builder = New("MYMOD.MERGE").Keys("{a}1", "{a}2")
+	assert.Equal(t, []string{"{a}1", "{a}2"}, builder.KeyNames())
+	args, err := builder.ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MYMOD.MERGE", "{a}1", "{a}2"}, args)
+
+	assert.Nil(t, New("PING").Route())
+}
@@ -0,0 +1,59 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+)
+
+// checkCommandPolicy returns a [PolicyError] if the command named `command` is not permitted by the command policy of the
+// client.
+func (client *baseClient) checkCommandPolicy(command string) error {
+	if client.commandPolicy == nil || client.commandPolicy.Permits(command) {
+		return nil
+	}
+	return newCommandPolicyError(command)
+}
+
+// checkRequestCommandPolicy returns a [PolicyError] if the command of a request is not permitted by the command policy of
+// the client. The custom commands are checked with their subcommand, as the typed commands of the container commands are
+// named with theirs, e.g. "CONFIG SET".
+func (client *baseClient) checkRequestCommandPolicy(requestType C.RequestType, args []string) error {
+	command := commandName(requestType, args)
+	if client.commandPolicy == nil || requestType != C.CustomCommand || len(args) < 2 {
+		return client.checkCommandPolicy(command)
+	}
+	subcommand := command + " " + strings.ToUpper(args[1])
+	if client.commandPolicy.Permits(subcommand) {
+		return nil
+	}
+	// The second argument is reported only if it is what denies the command, as it may be a key or a value
+	if client.commandPolicy.Permits(command) {
+		return newCommandPolicyError(subcommand)
+	}
+	return newCommandPolicyError(command)
+}
+
+func newCommandPolicyError(command string) *PolicyError {
+	return NewPolicyError(fmt.Sprintf("command %s is not permitted by the command policy of the client", command), command)
+}
+
+// checkBatchCommandPolicy returns a [PolicyError] for the first command of `batch` that is not permitted by the command
+// policy of the client.
+func (client *baseClient) checkBatchCommandPolicy(batch internal.Batch) error {
+	if client.commandPolicy == nil {
+		return nil
+	}
+	for _, cmd := range batch.Commands {
+		if err := client.checkRequestCommandPolicy(C.RequestType(cmd.RequestType), cmd.Args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
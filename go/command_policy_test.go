@@ -0,0 +1,26 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+)
+
+func TestCheckCommandPolicy(t *testing.T) {
+	// Every command is permitted without a policy
+	assert.NoError(t, (&baseClient{}).checkCommandPolicy("FLUSHALL"))
+	assert.NoError(t, (&baseClient{}).checkBatchCommandPolicy(internal.Batch{}))
+
+	client := &baseClient{commandPolicy: config.NewCommandPolicy(nil, []string{config.DangerousCommandsTag})}
+	assert.NoError(t, client.checkCommandPolicy("GET"))
+	err := client.checkCommandPolicy("FLUSHALL")
+	var policyErr *PolicyError
+	require.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, "FLUSHALL", policyErr.Command)
+}
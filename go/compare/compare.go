@@ -0,0 +1,294 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package compare compares the keyspaces of two servers, e.g. to validate a migration from one server or cluster to
+// another, such as from Redis OSS to Valkey.
+//
+// The keys are compared by existence, type, TTL and value. The values are compared by the digests of their `DUMP`
+// serializations, so that any data type is supported without reading the values field by field.
+package compare
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/keyscan"
+)
+
+// DefaultTTLTolerance is the default maximum difference between the TTLs of a key on both sides.
+const DefaultTTLTolerance = time.Second
+
+// scanCount is the number of keys requested from every `SCAN` call.
+const scanCount = 1000
+
+// dumpFooterLength is the length of the footer of a `DUMP` serialization: the version of the RDB format, on 2 bytes, and
+// a CRC64 checksum, on 8 bytes.
+const dumpFooterLength = 10
+
+// DifferenceKind is the kind of a [Difference] between the keyspaces.
+type DifferenceKind int
+
+const (
+	// The key only exists on the side A.
+	OnlyInA DifferenceKind = iota
+	// The key only exists on the side B.
+	OnlyInB
+	// The key has a different type on both sides.
+	TypeMismatch
+	// The key has a different value on both sides.
+	ValueMismatch
+	// The key has a different TTL on both sides, beyond the tolerance of the [Options], or only expires on one side.
+	TTLMismatch
+)
+
+func (kind DifferenceKind) String() string {
+	switch kind {
+	case OnlyInA:
+		return "OnlyInA"
+	case OnlyInB:
+		return "OnlyInB"
+	case TypeMismatch:
+		return "TypeMismatch"
+	case ValueMismatch:
+		return "ValueMismatch"
+	case TTLMismatch:
+		return "TTLMismatch"
+	}
+	return fmt.Sprintf("DifferenceKind(%d)", int(kind))
+}
+
+// KeyState is the state of a key on one side of a comparison.
+type KeyState struct {
+	// Whether the key exists. The other fields are zero if it does not.
+	Exists bool
+	// The type of the key, as returned by `TYPE`, e.g. "string".
+	Type string
+	// The remaining time to live of the key, or -1 if the key does not expire.
+	TTL time.Duration
+	// The SHA-256 digest of the `DUMP` serialization of the value, in hexadecimal, without the RDB version and checksum of
+	// the serialization. Empty if the values are not compared.
+	Digest string
+}
+
+// Difference is a key that differs between the keyspaces.
+type Difference struct {
+	// The key.
+	Key string
+	// The first difference found, in the order of the [DifferenceKind] constants.
+	Kind DifferenceKind
+	// The state of the key on the side A.
+	A KeyState
+	// The state of the key on the side B.
+	B KeyState
+}
+
+// Options are the optional arguments of [Keyspaces].
+type Options struct {
+	// The glob-style pattern of the keys to compare. All the keys by default.
+	Pattern string
+	// The maximum difference between the TTLs of a key on both sides, which are read at different times.
+	// [DefaultTTLTolerance] by default.
+	TTLTolerance time.Duration
+	// Do not compare the TTLs.
+	IgnoreTTL bool
+	// Do not compare the values, to only compare the existence, type and TTL of the keys, without serializing the values.
+	IgnoreValues bool
+}
+
+// Comparison is a comparison of two keyspaces in progress, started by [Keyspaces].
+type Comparison struct {
+	differences chan Difference
+	// Set before `differences` is closed.
+	err      error
+	compared int64
+}
+
+// Keyspaces compares the keys of the sides A and B, and streams the keys that differ. The keys are listed with `SCAN` on
+// both sides, or with a cluster scan of all the primaries in cluster mode: the keys of A are compared with the same keys
+// of B, and the keys of B that do not exist in A are reported as [OnlyInB].
+//
+// The values are compared by the digests of their `DUMP` serializations, which depend on the encoding of the values: equal
+// values with different encodings on both sides, e.g. because of different `*-max-listpack-entries` configurations, are
+// reported as a [ValueMismatch]. The RDB version of the serializations is ignored, so that the values of servers of
+// different versions can be compared.
+//
+// The sides are compared as they are, without stopping the writes: a key written during the comparison may be reported,
+// e.g. a key that expires between the reads of both sides.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the comparison. Cancelling it stops the comparison.
+//	a - The client of the side A, either a [glide.Client] or a [glide.ClusterClient].
+//	b - The client of the side B, either a [glide.Client] or a [glide.ClusterClient].
+//	opts - The keys to compare and how to compare them, see [Options].
+//
+// Return value:
+//
+//	The comparison, streaming the differences with [Comparison.Differences].
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func Keyspaces(ctx context.Context, a, b interfaces.BaseClientCommands, opts Options) *Comparison {
+	if opts.Pattern == "" {
+		opts.Pattern = "*"
+	}
+	if opts.TTLTolerance == 0 {
+		opts.TTLTolerance = DefaultTTLTolerance
+	}
+	comparison := &Comparison{differences: make(chan Difference)}
+	go func() {
+		defer close(comparison.differences)
+		comparison.err = comparison.run(ctx, a, b, opts)
+	}()
+	return comparison
+}
+
+// Differences returns the channel receiving the keys that differ, closed once the comparison is done or failed, see
+// [Comparison.Err]. The channel must be drained, or the context of the comparison cancelled, for the comparison to end.
+func (c *Comparison) Differences() <-chan Difference {
+	return c.differences
+}
+
+// Err returns the error that stopped the comparison, or nil if it completed. Only valid once the channel of
+// [Comparison.Differences] is closed.
+func (c *Comparison) Err() error {
+	return c.err
+}
+
+// Compared returns the number of keys compared, i.e. the number of distinct keys of both sides. Only valid once the
+// channel of [Comparison.Differences] is closed.
+func (c *Comparison) Compared() int64 {
+	return c.compared
+}
+
+func (c *Comparison) run(ctx context.Context, a, b interfaces.BaseClientCommands, opts Options) error {
+	err := keyscan.Scan(ctx, a, opts.Pattern, scanCount, func(keys []string) error {
+		for _, key := range keys {
+			stateA, err := keyState(ctx, a, key, opts)
+			if err != nil {
+				return err
+			}
+			if !stateA.Exists {
+				// Deleted or expired since the scan.
+				continue
+			}
+			stateB, err := keyState(ctx, b, key, opts)
+			if err != nil {
+				return err
+			}
+			c.compared++
+			if kind, ok := differs(stateA, stateB, opts); ok {
+				if err := c.report(ctx, Difference{Key: key, Kind: kind, A: stateA, B: stateB}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return keyscan.Scan(ctx, b, opts.Pattern, scanCount, func(keys []string) error {
+		for _, key := range keys {
+			exists, err := a.Exists(ctx, []string{key})
+			if err != nil {
+				return err
+			}
+			if exists > 0 {
+				// Compared with the keys of A.
+				continue
+			}
+			stateB, err := keyState(ctx, b, key, opts)
+			if err != nil {
+				return err
+			}
+			if !stateB.Exists {
+				continue
+			}
+			c.compared++
+			if err := c.report(ctx, Difference{Key: key, Kind: OnlyInB, B: stateB}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// report sends `difference` to the receiver of the differences, unless `ctx` is done first.
+func (c *Comparison) report(ctx context.Context, difference Difference) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case c.differences <- difference:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// keyState reads the state of `key` with `client`.
+func keyState(ctx context.Context, client interfaces.BaseClientCommands, key string, opts Options) (KeyState, error) {
+	keyType, err := client.Type(ctx, key)
+	if err != nil || keyType == "none" {
+		return KeyState{}, err
+	}
+	state := KeyState{Exists: true, Type: keyType, TTL: -1}
+	if !opts.IgnoreTTL {
+		ttl, err := client.PTTL(ctx, key)
+		if err != nil {
+			return KeyState{}, err
+		}
+		if ttl == -2 {
+			return KeyState{}, nil
+		}
+		if ttl >= 0 {
+			state.TTL = time.Duration(ttl) * time.Millisecond
+		}
+	}
+	if !opts.IgnoreValues {
+		value, err := client.Dump(ctx, key)
+		if err != nil {
+			return KeyState{}, err
+		}
+		if value.IsNil() {
+			return KeyState{}, nil
+		}
+		state.Digest = digest(value.Value())
+	}
+	return state, nil
+}
+
+// digest returns the digest of a `DUMP` serialization, without its footer.
+func digest(serialized string) string {
+	if len(serialized) >= dumpFooterLength {
+		serialized = serialized[:len(serialized)-dumpFooterLength]
+	}
+	sum := sha256.Sum256([]byte(serialized))
+	return hex.EncodeToString(sum[:])
+}
+
+// differs returns the first difference between the states of a key on both sides, if any.
+func differs(a, b KeyState, opts Options) (DifferenceKind, bool) {
+	switch {
+	case !b.Exists:
+		return OnlyInA, true
+	case a.Type != b.Type:
+		return TypeMismatch, true
+	case a.Digest != b.Digest:
+		return ValueMismatch, true
+	case !opts.IgnoreTTL && !ttlsMatch(a.TTL, b.TTL, opts.TTLTolerance):
+		return TTLMismatch, true
+	}
+	return 0, false
+}
+
+func ttlsMatch(a, b, tolerance time.Duration) bool {
+	if a < 0 || b < 0 {
+		return a == b
+	}
+	return max(a-b, b-a) <= tolerance
+}
@@ -0,0 +1,85 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package compare
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+func differences(t *testing.T, comparison *Comparison) []Difference {
+	var result []Difference
+	for difference := range comparison.Differences() {
+		result = append(result, difference)
+	}
+	require.NoError(t, comparison.Err())
+	return result
+}
+
+func TestKeyspaces(t *testing.T) {
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	a := fakeclient.New().WithClock(fake)
+	for _, key := range []string{"same", "ttl-close", "only-a", "type", "ttl", "persistent"} {
+		a.Strings[key] = "1"
+	}
+	a.Hashes["value"] = map[string]string{"field": "1"}
+	a.Expiries["ttl-close"] = fake.Now().Add(10 * time.Second)
+	a.Expiries["ttl"] = fake.Now().Add(10 * time.Second)
+
+	// The serializations of the values differ by the version of the RDB format
+	b := fakeclient.New().WithClock(fake)
+	b.RDBVersion = "\x0c\x00"
+	for _, key := range []string{"same", "ttl-close", "ttl", "persistent"} {
+		b.Strings[key] = "1"
+	}
+	b.Lists["only-b"] = []string{"1"}
+	b.Lists["type"] = []string{"1"}
+	b.Hashes["value"] = map[string]string{"field": "2"}
+	b.Expiries["ttl-close"] = fake.Now().Add(9500 * time.Millisecond)
+	b.Expiries["ttl"] = fake.Now().Add(5 * time.Second)
+	b.Expiries["persistent"] = fake.Now().Add(5 * time.Second)
+
+	comparison := Keyspaces(context.Background(), a, b, Options{})
+	kinds := map[string]DifferenceKind{}
+	for _, difference := range differences(t, comparison) {
+		kinds[difference.Key] = difference.Kind
+	}
+	assert.Equal(t, map[string]DifferenceKind{
+		"only-a":     OnlyInA,
+		"only-b":     OnlyInB,
+		"type":       TypeMismatch,
+		"value":      ValueMismatch,
+		"ttl":        TTLMismatch,
+		"persistent": TTLMismatch,
+	}, kinds)
+	assert.Equal(t, int64(8), comparison.Compared())
+
+	// The TTLs and values are not compared if ignored
+	comparison = Keyspaces(context.Background(), a, b, Options{Pattern: "[tv]*", IgnoreTTL: true, IgnoreValues: true})
+	result := differences(t, comparison)
+	require.Len(t, result, 1)
+	assert.Equal(t, Difference{
+		Key:  "type",
+		Kind: TypeMismatch,
+		A:    KeyState{Exists: true, Type: "string", TTL: -1},
+		B:    KeyState{Exists: true, Type: "list", TTL: -1},
+	}, result[0])
+}
+
+func TestKeyspaces_Cancelled(t *testing.T) {
+	a := fakeclient.New()
+	a.Strings["key"] = "1"
+	b := fakeclient.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	comparison := Keyspaces(ctx, a, b, Options{})
+	for range comparison.Differences() {
+	}
+	assert.ErrorIs(t, comparison.Err(), context.Canceled)
+}
@@ -0,0 +1,66 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// The emulations of the commands missing on older servers, used with
+// [config.ClientConfiguration.WithCompatibilityFallbacks].
+var (
+	// sInterCardScript counts the members of the intersection of the sets of KEYS, up to the limit of ARGV[1] unless it is
+	// 0, like `SINTERCARD`.
+	sInterCardScript = sync.OnceValue(func() *options.Script {
+		return options.NewScript(`
+local count = #redis.call('SINTER', unpack(KEYS))
+local limit = tonumber(ARGV[1])
+if limit > 0 and count > limit then
+	return limit
+end
+return count
+`)
+	})
+)
+
+// isUnsupportedSInterCard returns whether `err`, the error of `SINTERCARD`, reports that the server does not know the
+// command and that it should be emulated, in which case it is emulated from then on.
+func (client *baseClient) isUnsupportedSInterCard(err error) bool {
+	if !client.compatibilityFallbacks || !isUnknownCommand(err) {
+		return false
+	}
+	client.sInterCardUnsupported.Store(true)
+	return true
+}
+
+// emulateSInterCard emulates `SINTERCARD` of `keys`, up to `limit` unless it is 0, with [sInterCardScript].
+func (client *baseClient) emulateSInterCard(ctx context.Context, keys []string, limit int64) (int64, error) {
+	if limit < 0 {
+		return models.DefaultIntResponse, fmt.Errorf("the limit must not be negative, got %d", limit)
+	}
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*sInterCardScript(),
+		*options.NewScriptOptions().WithKeys(keys).WithArgs([]string{utils.IntToString(limit)}),
+	)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	count, ok := result.(int64)
+	if !ok {
+		return models.DefaultIntResponse, fmt.Errorf("unexpected response: %v", result)
+	}
+	return count, nil
+}
+
+// isUnknownCommand returns whether `err` reports that the server does not know the command.
+func isUnknownCommand(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "unknown command")
+}
@@ -0,0 +1,28 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUnsupportedSInterCard(t *testing.T) {
+	unknown := errors.New("An error was signalled by the server: - ResponseError: ERR unknown command 'SINTERCARD'")
+	other := errors.New("An error was signalled by the server: - ResponseError: CROSSSLOT Keys don't hash to the same slot")
+
+	// Without the fallbacks, the errors are returned
+	client := &baseClient{sInterCardUnsupported: &atomic.Bool{}}
+	assert.False(t, client.isUnsupportedSInterCard(unknown))
+	assert.False(t, client.sInterCardUnsupported.Load())
+
+	client.compatibilityFallbacks = true
+	assert.False(t, client.isUnsupportedSInterCard(other))
+	assert.False(t, client.sInterCardUnsupported.Load())
+	// Once a server does not know the command, it is emulated from then on
+	assert.True(t, client.isUnsupportedSInterCard(unknown))
+	assert.True(t, client.sInterCardUnsupported.Load())
+}
@@ -0,0 +1,84 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import "fmt"
+
+// DefaultBufferPoolMaxArgs is the default maximum number of arguments of a command whose encoding buffers are reused.
+const DefaultBufferPoolMaxArgs = 1024
+
+// DefaultBufferPoolMaxBytes is the default maximum size in bytes of a reused route encoding buffer.
+const DefaultBufferPoolMaxBytes = 4096
+
+// BufferPoolConfiguration represents the configuration of the buffers the client reuses to encode commands.
+//
+// Every command needs a few buffers to pass its arguments and its route to the Rust core. The core copies them before
+// the command is sent, so the client returns them to a pool and reuses them for the next commands instead of allocating new
+// ones, which reduces the pressure on the garbage collector under heavy load. Buffers larger than the configured limits
+// are not retained, so that an occasional large command does not keep a large buffer alive.
+//
+// Pooling is enabled by default with [DefaultBufferPoolMaxArgs] and [DefaultBufferPoolMaxBytes].
+type BufferPoolConfiguration struct {
+	// Whether pooling is enabled.
+	enabled bool
+	// Maximum number of arguments of a command whose buffers are returned to the pool.
+	maxArgs int
+	// Maximum size in bytes of a route buffer returned to the pool.
+	maxBytes int
+}
+
+// NewBufferPoolConfiguration returns a [BufferPoolConfiguration] with pooling enabled and the default limits.
+func NewBufferPoolConfiguration() *BufferPoolConfiguration {
+	return &BufferPoolConfiguration{
+		enabled:  true,
+		maxArgs:  DefaultBufferPoolMaxArgs,
+		maxBytes: DefaultBufferPoolMaxBytes,
+	}
+}
+
+// WithEnabled sets whether pooling is enabled. When disabled, new buffers are allocated for every command.
+func (c *BufferPoolConfiguration) WithEnabled(enabled bool) *BufferPoolConfiguration {
+	c.enabled = enabled
+	return c
+}
+
+// WithMaxArgs sets the maximum number of arguments of a command whose buffers are returned to the pool. Must be positive.
+func (c *BufferPoolConfiguration) WithMaxArgs(maxArgs int) *BufferPoolConfiguration {
+	c.maxArgs = maxArgs
+	return c
+}
+
+// WithMaxBytes sets the maximum size in bytes of a route buffer returned to the pool. Must be positive.
+func (c *BufferPoolConfiguration) WithMaxBytes(maxBytes int) *BufferPoolConfiguration {
+	c.maxBytes = maxBytes
+	return c
+}
+
+// IsEnabled returns whether pooling is enabled.
+func (c *BufferPoolConfiguration) IsEnabled() bool {
+	return c.enabled
+}
+
+// GetMaxArgs returns the maximum number of arguments of a command whose buffers are returned to the pool.
+func (c *BufferPoolConfiguration) GetMaxArgs() int {
+	return c.maxArgs
+}
+
+// GetMaxBytes returns the maximum size in bytes of a route buffer returned to the pool.
+func (c *BufferPoolConfiguration) GetMaxBytes() int {
+	return c.maxBytes
+}
+
+// Validate checks that the limits are positive when pooling is enabled.
+func (c *BufferPoolConfiguration) Validate() error {
+	if !c.enabled {
+		return nil
+	}
+	if c.maxArgs <= 0 {
+		return fmt.Errorf("buffer pool max args must be positive, got %d", c.maxArgs)
+	}
+	if c.maxBytes <= 0 {
+		return fmt.Errorf("buffer pool max bytes must be positive, got %d", c.maxBytes)
+	}
+	return nil
+}
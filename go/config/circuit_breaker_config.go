@@ -0,0 +1,92 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// DefaultCircuitBreakerFailureThreshold is the default number of consecutive failures to a node that opens its circuit.
+const DefaultCircuitBreakerFailureThreshold = 5
+
+// DefaultCircuitBreakerCooldown is the default duration a circuit stays open.
+const DefaultCircuitBreakerCooldown = 5 * time.Second
+
+// CircuitBreakerConfiguration represents the configuration of the per-node circuit breakers of the client.
+//
+// After a number of consecutive connection failures or timeouts to a node, the circuit of the node opens: for the duration
+// of the cooldown, commands destined to it fail immediately with a `CircuitBreakerOpenError` instead of waiting for the
+// node. In cluster mode, the read commands routed to a replica, or that may be served by one as the client does not read
+// from the primary only (see `ReadFrom`), are rerouted to a replica. Errors returned by the node itself, such as
+// `WRONGTYPE`, show that the node is reachable and do not count as failures.
+//
+// Once the cooldown elapsed, a single command is sent to the node as a probe. If it succeeds the circuit closes,
+// otherwise it opens for another cooldown.
+//
+// The state changes of the circuits are logged as warnings and counted in the statistics returned by `GetStatistics`.
+type CircuitBreakerConfiguration struct {
+	failureThreshold uint32
+	cooldown         time.Duration
+}
+
+// NewCircuitBreakerConfiguration returns a [CircuitBreakerConfiguration] with [DefaultCircuitBreakerFailureThreshold]
+// and [DefaultCircuitBreakerCooldown].
+func NewCircuitBreakerConfiguration() *CircuitBreakerConfiguration {
+	return &CircuitBreakerConfiguration{
+		failureThreshold: DefaultCircuitBreakerFailureThreshold,
+		cooldown:         DefaultCircuitBreakerCooldown,
+	}
+}
+
+// WithFailureThreshold sets the number of consecutive failures to a node that opens its circuit. Must be positive.
+func (c *CircuitBreakerConfiguration) WithFailureThreshold(failureThreshold uint32) *CircuitBreakerConfiguration {
+	c.failureThreshold = failureThreshold
+	return c
+}
+
+// WithCooldown sets how long a circuit stays open before a probe command is sent to the node. Must be at least a
+// millisecond.
+func (c *CircuitBreakerConfiguration) WithCooldown(cooldown time.Duration) *CircuitBreakerConfiguration {
+	c.cooldown = cooldown
+	return c
+}
+
+// GetFailureThreshold returns the number of consecutive failures to a node that opens its circuit.
+func (c *CircuitBreakerConfiguration) GetFailureThreshold() uint32 {
+	return c.failureThreshold
+}
+
+// GetCooldown returns how long a circuit stays open before a probe command is sent to the node.
+func (c *CircuitBreakerConfiguration) GetCooldown() time.Duration {
+	return c.cooldown
+}
+
+// Validate checks that the failure threshold is positive and that the cooldown is at least a millisecond.
+func (c *CircuitBreakerConfiguration) Validate() error {
+	if c.failureThreshold == 0 {
+		return errors.New("circuit breaker failure threshold must be positive")
+	}
+	if c.cooldown < time.Millisecond {
+		return fmt.Errorf("circuit breaker cooldown must be at least 1ms, got %v", c.cooldown)
+	}
+	return nil
+}
+
+func (c *CircuitBreakerConfiguration) toProtobuf() (*protobuf.CircuitBreakerConfig, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	cooldown, err := utils.DurationToMilliseconds(c.cooldown)
+	if err != nil {
+		return nil, err
+	}
+	return &protobuf.CircuitBreakerConfig{
+		FailureThreshold: c.failureThreshold,
+		CooldownMs:       cooldown,
+	}, nil
+}
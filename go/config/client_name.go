@@ -0,0 +1,92 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// ClientNameSuffix is a piece of metadata appended to the client name, so that the connections of the instances of an
+// application can be told apart in the output of `CLIENT LIST`, see [ClientConfiguration.WithClientNameSuffixes].
+type ClientNameSuffix int
+
+const (
+	// The hostname of the machine, e.g. the name of the pod in Kubernetes.
+	HostnameSuffix ClientNameSuffix = iota
+	// The ID of the process.
+	PidSuffix
+	// A random UUID, generated once per configuration.
+	UUIDSuffix
+)
+
+// clientNameSeparator separates the client name and its suffixes, e.g. "orders:orders-7f9c5:4242".
+const clientNameSeparator = ":"
+
+func (suffix ClientNameSuffix) String() string {
+	switch suffix {
+	case HostnameSuffix:
+		return "HostnameSuffix"
+	case PidSuffix:
+		return "PidSuffix"
+	case UUIDSuffix:
+		return "UUIDSuffix"
+	}
+	return fmt.Sprintf("ClientNameSuffix(%d)", int(suffix))
+}
+
+// resolveClientNameSuffix returns the values of `suffixes`, each preceded by the separator.
+func resolveClientNameSuffix(suffixes []ClientNameSuffix) string {
+	sb := strings.Builder{}
+	for _, suffix := range suffixes {
+		var value string
+		switch suffix {
+		case HostnameSuffix:
+			value, _ = os.Hostname()
+		case PidSuffix:
+			value = strconv.Itoa(os.Getpid())
+		case UUIDSuffix:
+			value = uuid.NewString()
+		}
+		if value = sanitizeClientName(value); value != "" {
+			sb.WriteString(clientNameSeparator)
+			sb.WriteString(value)
+		}
+	}
+	return sb.String()
+}
+
+// sanitizeClientName replaces the characters that a client name cannot contain, such as spaces, with underscores.
+func sanitizeClientName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+// SuffixedClientName returns `name` followed by `suffix`, as returned by `GetClientNameSuffix`, or `suffix` alone without
+// its leading separator if `name` is empty.
+func SuffixedClientName(name string, suffix string) string {
+	if name == "" {
+		return strings.TrimPrefix(suffix, clientNameSeparator)
+	}
+	return name + suffix
+}
+
+// newClientNameSuffix returns a function resolving the values of `suffixes` on its first call, and returning them again
+// on the next calls, or nil if there are no suffixes.
+func newClientNameSuffix(suffixes []ClientNameSuffix) func() string {
+	if len(suffixes) == 0 {
+		return nil
+	}
+	suffixes = slices.Clone(suffixes)
+	return sync.OnceValue(func() string { return resolveClientNameSuffix(suffixes) })
+}
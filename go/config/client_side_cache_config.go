@@ -0,0 +1,96 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultClientSideCacheMaxEntries is the default maximum number of values kept by the client-side cache.
+	DefaultClientSideCacheMaxEntries = 10000
+	// DefaultClientSideCacheTTL is the default time to live of the values kept by the client-side cache.
+	DefaultClientSideCacheTTL = time.Minute
+)
+
+// ClientSideCacheConfiguration represents the configuration of the client-side cache of the values read with `GET`.
+//
+// The client enables the broadcasting mode of client tracking, `CLIENT TRACKING ON BCAST`, on its connections for the
+// keys starting with the configured prefixes, and caches the values of these keys read with `GET`, including the keys
+// that do not exist. The server pushes the invalidation of a key under the prefixes whenever it is modified by any client,
+// and the client drops it from its cache. Unlike the default tracking mode, the server does not remember the keys read by
+// every connection, so the reads have no tracking overhead; in exchange, the client receives the invalidations of all the
+// keys under the prefixes, read or not, so the prefixes should only cover well-known namespaces worth caching, e.g.
+// "config:" or "product:".
+//
+// The invalidations are received asynchronously: a value modified by any client, including this one, may be read from
+// the cache until its invalidation is received, usually within a round trip. The cache is flushed when a connection is
+// established or lost, since the invalidations pushed meanwhile are missed, and the values expire after a time to live
+// in case an invalidation is lost anyway. When full, the least recently used value is evicted.
+//
+// Requires RESP3. The prefixes are the prefixes of the keys passed to the commands: if the keys are prefixed with
+// [ClientConfiguration.WithKeyPrefix], the prefix of the keys is added to them.
+type ClientSideCacheConfiguration struct {
+	// The prefixes of the cached keys, or empty if all the keys are cached.
+	prefixes []string
+	// Maximum number of values in the cache.
+	maxEntries int
+	// How long a value is served from the cache at most.
+	ttl time.Duration
+}
+
+// NewClientSideCacheConfiguration returns a [ClientSideCacheConfiguration] caching the keys starting with one of
+// `prefixes`, or all the keys if there is none, with up to [DefaultClientSideCacheMaxEntries] values expiring after
+// [DefaultClientSideCacheTTL].
+func NewClientSideCacheConfiguration(prefixes ...string) *ClientSideCacheConfiguration {
+	return &ClientSideCacheConfiguration{
+		prefixes:   prefixes,
+		maxEntries: DefaultClientSideCacheMaxEntries,
+		ttl:        DefaultClientSideCacheTTL,
+	}
+}
+
+// WithMaxEntries sets the maximum number of values in the cache. Must be positive.
+func (c *ClientSideCacheConfiguration) WithMaxEntries(maxEntries int) *ClientSideCacheConfiguration {
+	c.maxEntries = maxEntries
+	return c
+}
+
+// WithTTL sets how long a value is served from the cache at most, in case its invalidation is lost. Must be positive.
+func (c *ClientSideCacheConfiguration) WithTTL(ttl time.Duration) *ClientSideCacheConfiguration {
+	c.ttl = ttl
+	return c
+}
+
+// GetPrefixes returns the prefixes of the cached keys, or an empty slice if all the keys are cached.
+func (c *ClientSideCacheConfiguration) GetPrefixes() []string {
+	return c.prefixes
+}
+
+// GetMaxEntries returns the maximum number of values in the cache.
+func (c *ClientSideCacheConfiguration) GetMaxEntries() int {
+	return c.maxEntries
+}
+
+// GetTTL returns how long a value is served from the cache at most.
+func (c *ClientSideCacheConfiguration) GetTTL() time.Duration {
+	return c.ttl
+}
+
+// Validate checks that the maximum number of entries and the time to live are positive, and that the prefixes are not
+// empty.
+func (c *ClientSideCacheConfiguration) Validate() error {
+	if c.maxEntries <= 0 {
+		return fmt.Errorf("client-side cache max entries must be positive, got %d", c.maxEntries)
+	}
+	if c.ttl <= 0 {
+		return fmt.Errorf("client-side cache TTL must be positive, got %v", c.ttl)
+	}
+	for _, prefix := range c.prefixes {
+		if prefix == "" {
+			return fmt.Errorf("client-side cache prefixes must not be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DangerousCommandsTag is the tag of the commands that may harm a server or its data when run by mistake, such as
+// `FLUSHALL`, `KEYS` and `DEBUG`, for a [CommandPolicy]. It mirrors the `@dangerous` ACL category of the server.
+const DangerousCommandsTag = "@dangerous"
+
+// commandTags are the commands of the tags of a command policy, by tag.
+var commandTags = map[string][]string{
+	DangerousCommandsTag: {
+		"ACL", "BGREWRITEAOF", "BGSAVE", "CLIENT", "CLUSTER", "CONFIG", "DEBUG", "FAILOVER", "FLUSHALL", "FLUSHDB", "INFO",
+		"KEYS", "LASTSAVE", "LATENCY", "MIGRATE", "MODULE", "MONITOR", "PFDEBUG", "PFSELFTEST", "REPLICAOF", "RESTORE",
+		"RESTORE-ASKING", "ROLE", "SAVE", "SHUTDOWN", "SLAVEOF", "SLOWLOG", "SORT", "SWAPDB",
+	},
+}
+
+// CommandPolicy restricts the commands a client can send, see [ClientConfiguration.WithCommandPolicy]. The commands are
+// identified by their name, e.g. "FLUSHALL" for `FLUSHALL ASYNC`, or by a tag, e.g. [DangerousCommandsTag]. The
+// subcommands of the container commands can also be identified by the container and subcommand names, e.g. "CONFIG SET":
+// a subcommand is permitted only if neither it nor its container command is denied, and, if there are allowed commands,
+// either of them is allowed.
+type CommandPolicy struct {
+	// The allowed commands, by name in uppercase. Empty to allow every command that is not denied.
+	allow map[string]struct{}
+	// The denied commands, by name in uppercase.
+	deny map[string]struct{}
+	// The unknown tags of the lists, reported by Validate.
+	unknownTags []string
+}
+
+// NewCommandPolicy returns a [CommandPolicy] allowing the commands of `allow`, or every command if it is empty, except
+// the commands of `deny`. The commands are given by name, case-insensitively, or by tag.
+func NewCommandPolicy(allow []string, deny []string) *CommandPolicy {
+	policy := &CommandPolicy{}
+	policy.allow = policy.commands(allow)
+	policy.deny = policy.commands(deny)
+	return policy
+}
+
+// commands returns the commands of `names`, with the commands of their tags.
+func (policy *CommandPolicy) commands(names []string) map[string]struct{} {
+	commands := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if !strings.HasPrefix(name, "@") {
+			commands[strings.ToUpper(name)] = struct{}{}
+			continue
+		}
+		tagged, ok := commandTags[strings.ToLower(name)]
+		if !ok {
+			policy.unknownTags = append(policy.unknownTags, name)
+		}
+		for _, command := range tagged {
+			commands[command] = struct{}{}
+		}
+	}
+	return commands
+}
+
+// Permits returns whether the command named `command` can be sent. The name of a subcommand, e.g. "CONFIG SET", is matched
+// both as is and by its container command, e.g. "CONFIG".
+func (policy *CommandPolicy) Permits(command string) bool {
+	command = strings.ToUpper(command)
+	names := []string{command}
+	if container, _, ok := strings.Cut(command, " "); ok {
+		names = append(names, container)
+	}
+	for _, name := range names {
+		if _, ok := policy.deny[name]; ok {
+			return false
+		}
+	}
+	if len(policy.allow) == 0 {
+		return true
+	}
+	for _, name := range names {
+		if _, ok := policy.allow[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the tags of the policy are known.
+func (policy *CommandPolicy) Validate() error {
+	if len(policy.unknownTags) > 0 {
+		return fmt.Errorf("unknown command tags: %s", strings.Join(policy.unknownTags, ", "))
+	}
+	return nil
+}
@@ -28,6 +28,19 @@ const (
 	LZ4
 )
 
+// AutoDecompressionFormat is a standard compression format of the values written by other producers, such as the clients
+// of other languages compressing the values themselves. The values are detected by the magic bytes of the format, see
+// [CompressionConfiguration.WithAutoDecompression].
+type AutoDecompressionFormat int
+
+const (
+	// Gzip decompresses the values starting with the magic bytes of gzip, `1f 8b`.
+	Gzip AutoDecompressionFormat = iota
+	// ZstdFrame decompresses the values starting with the magic bytes of a zstd frame, `28 b5 2f fd`. The values compressed
+	// with ZSTD by the clients of Glide have a header of their own, and are decompressed without it.
+	ZstdFrame
+)
+
 // CompressionConfiguration represents the configuration for automatic value compression.
 //
 // When enabled, values sent to the server will be compressed using the specified backend
@@ -43,6 +56,8 @@ type CompressionConfiguration struct {
 	compressionLevel *int32
 	// Minimum size in bytes for values to be compressed. Defaults to 64.
 	minCompressionSize uint32
+	// The formats of the values written by other producers that are decompressed on retrieval. None by default.
+	autoDecompressionFormats []AutoDecompressionFormat
 }
 
 // NewCompressionConfiguration returns a [CompressionConfiguration] with compression enabled,
@@ -83,6 +98,18 @@ func (c *CompressionConfiguration) WithMinCompressionSize(size uint32) *Compress
 	return c
 }
 
+// WithAutoDecompression sets the formats of the values written by other producers that are decompressed on retrieval,
+// along with the values compressed by the client, so that values compressed by the clients of other languages are read
+// transparently, e.g. a value compressed with gzip by a Python producer.
+//
+// The values are detected by the magic bytes of the formats. A value starting with the magic bytes of a format without
+// being compressed in that format is returned as is. Values are decompressed only when compression is enabled, and are
+// still compressed on writes with the backend of the configuration.
+func (c *CompressionConfiguration) WithAutoDecompression(formats ...AutoDecompressionFormat) *CompressionConfiguration {
+	c.autoDecompressionFormats = formats
+	return c
+}
+
 // Validate checks that the compression configuration is valid.
 func (c *CompressionConfiguration) Validate() error {
 	if c.minCompressionSize < MinCompressionSize {
@@ -110,5 +137,12 @@ func (c *CompressionConfiguration) toProtobuf() (*protobuf.CompressionConfig, er
 		pbConfig.CompressionLevel = c.compressionLevel
 	}
 
+	for _, format := range c.autoDecompressionFormats {
+		pbConfig.AutoDecompressionFormats = append(
+			pbConfig.AutoDecompressionFormats,
+			protobuf.AutoDecompressionFormat(format),
+		)
+	}
+
 	return pbConfig, nil
 }
@@ -3,13 +3,16 @@
 package config
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 )
 
 const (
@@ -185,6 +188,70 @@ func mapReadFrom(readFrom ReadFrom) protobuf.ReadFrom {
 	return protobuf.ReadFrom_Primary
 }
 
+// ProtocolVersion is the serialization protocol used to communicate with the server.
+type ProtocolVersion int
+
+const (
+	// RESP3 is the default protocol. Required for the client to receive push notifications, such as pubsub messages.
+	RESP3 ProtocolVersion = iota
+	// RESP2 is the protocol used by servers older than 6.0, and by some proxies that do not support RESP3 push messages.
+	// Command results are converted by the client, so they have the same types with both protocols.
+	RESP2
+)
+
+func mapProtocol(protocol ProtocolVersion) (protobuf.ProtocolVersion, error) {
+	switch protocol {
+	case RESP3:
+		return protobuf.ProtocolVersion_RESP3, nil
+	case RESP2:
+		return protobuf.ProtocolVersion_RESP2, nil
+	default:
+		return protobuf.ProtocolVersion_RESP3, fmt.Errorf("invalid protocol version: %d", protocol)
+	}
+}
+
+// AuditHook is called by the client after every request, with the context of the request and its [models.RequestAudit],
+// e.g. to log the requests along with the request metadata attached to their context by `glide.WithRequestMetadata`.
+//
+// The hook is called synchronously before the command returns, so it should not block.
+type AuditHook func(ctx context.Context, audit models.RequestAudit)
+
+// Invoker sends a request, or passes it to the next interceptor of the client, and returns its response.
+type Invoker func(ctx context.Context, cmd models.Command) (any, error)
+
+// Interceptor is called by the client for every command, script and batch, with the context of the request, its
+// [models.Command], and the [Invoker] sending it, e.g. to retry, cache, measure or rewrite requests without forking the
+// client. An interceptor typically calls `next` once and returns its response and error, possibly after changing the
+// context or the arguments of the command, or after recording the outcome of the request.
+//
+// The response of a command or a script is opaque: an interceptor must return a response returned by an invoker for the
+// same request, or an error. The responses are immutable and can be returned more than once, e.g. from a cache, or
+// after calling `next` several times to retry the request. The response of a batch is the `[]any` slice of the results
+// of its commands, which an interceptor may also build on its own.
+//
+// The interceptors are called in the order they were added, the first one being the outermost. The cluster scans are not
+// intercepted.
+type Interceptor func(ctx context.Context, cmd models.Command, next Invoker) (any, error)
+
+// ConnectionLifecycleHooks are called by the client for the lifecycle events of its connections to the servers, see
+// [ClientConfiguration.WithConnectionLifecycleHooks]. Any of the hooks may be nil.
+//
+// The hooks of a client are called in the order of the events, from a goroutine of their own, so a slow hook delays
+// the following events but not the requests.
+type ConnectionLifecycleHooks struct {
+	// OnConnect is called when a connection to the server at `address` is established, initially or after a reconnection.
+	OnConnect func(address string)
+	// OnDisconnect is called when the connection to the server at `address` is closed by the server or the network, with
+	// the error that closed it, or nil if the connection was closed without error.
+	OnDisconnect func(address string, err error)
+	// OnReconnectAttempt is called when an attempt to reconnect to the server at `address` fails with `err`. The attempts
+	// are numbered from 1 after each disconnection.
+	OnReconnectAttempt func(address string, attempt int, err error)
+}
+
+// The longest window the writes of the requests may be held back for, see WithWriteCoalescingWindow.
+const maxWriteCoalescingWindow = 10 * time.Millisecond
+
 type baseClientConfiguration struct {
 	addresses         []NodeAddress
 	useTLS            bool
@@ -192,11 +259,56 @@ type baseClientConfiguration struct {
 	readFrom          ReadFrom
 	requestTimeout    time.Duration
 	clientName        string
+	libName           string
 	clientAZ          string
+	protocol          ProtocolVersion
 	reconnectStrategy *BackoffStrategy
 	lazyConnect       bool
 	DatabaseId        *int `json:"database_id,omitempty"`
 	compressionConfig *CompressionConfiguration
+	bufferPool        *BufferPoolConfiguration
+	// Not set by default, in which case introspection results are not cached.
+	introspectionCache *IntrospectionCacheConfiguration
+	// Not set by default, in which case the values read with `GET` are not cached.
+	clientSideCache *ClientSideCacheConfiguration
+	// Not set by default, in which case circuit breakers are disabled.
+	circuitBreaker *CircuitBreakerConfiguration
+	// Not set by default, in which case hostnames are not re-resolved.
+	dnsResolution *DnsResolutionConfiguration
+	// Not set by default, in which case requests are not audited.
+	auditHook AuditHook
+	// Zero by default, in which case requests are not shed.
+	sheddingFraction float64
+	// Zero by default, in which case the timeouts of the blocking commands are not clamped to the deadlines of their
+	// contexts.
+	blockingTimeoutMargin time.Duration
+	// Not set by default, in which case every command can be sent.
+	commandPolicy *CommandPolicy
+	// Zero by default, in which case the size of the responses is not limited.
+	maxResponseSize uint64
+	// Zero by default, in which case the requests are written to the connections as soon as they are sent.
+	writeCoalescingWindow time.Duration
+	// False by default, in which case the blocking commands interrupted by a failover fail.
+	reissueBlockingCommandsOnFailover bool
+	// False by default, in which case the client waits for every address to connect or fail before it is created.
+	raceDialing bool
+	// False by default, in which case the nodes that cannot be connected when the client is created are connected in the
+	// background.
+	eagerConnections bool
+	// Empty by default, in which case the keys are not prefixed.
+	keyPrefix string
+	// Empty by default, in which case the requests are not intercepted.
+	interceptors []Interceptor
+	// Not set by default, in which case the connection lifecycle events are not reported.
+	connectionLifecycleHooks *ConnectionLifecycleHooks
+	// Not set by default, in which case the client name is not suffixed.
+	clientNameSuffix func() string
+	// False by default, in which case the commands the server does not know fail.
+	compatibilityFallbacks bool
+	// Zero by default, in which case the queue of the Pub/Sub messages is not bounded.
+	pubSubQueueCapacity int
+	// The policy applied once the queue of the Pub/Sub messages holds pubSubQueueCapacity messages.
+	pubSubBackpressurePolicy PubSubBackpressurePolicy
 }
 
 func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest, error) {
@@ -216,6 +328,13 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 	}
 
 	request.ReadFrom = mapReadFrom(config.readFrom)
+
+	protocol, err := mapProtocol(config.protocol)
+	if err != nil {
+		return nil, err
+	}
+	request.Protocol = protocol
+
 	if config.requestTimeout != 0 {
 		requestTimeout, err := utils.DurationToMilliseconds(config.requestTimeout)
 		if err != nil {
@@ -224,8 +343,12 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 		request.RequestTimeout = requestTimeout
 	}
 
-	if config.clientName != "" {
-		request.ClientName = config.clientName
+	if clientName := SuffixedClientName(config.clientName, config.GetClientNameSuffix()); clientName != "" {
+		request.ClientName = clientName
+	}
+
+	if config.libName != "" {
+		request.LibName = config.libName
 	}
 
 	if config.clientAZ != "" {
@@ -259,9 +382,211 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 		request.CompressionConfig = compressionPb
 	}
 
+	if config.sheddingFraction < 0 || config.sheddingFraction > 1 {
+		return nil, fmt.Errorf("latency budget shedding fraction must be between 0 and 1, got %v", config.sheddingFraction)
+	}
+
+	if config.blockingTimeoutMargin < 0 {
+		return nil, fmt.Errorf("blocking timeout margin must not be negative, got %v", config.blockingTimeoutMargin)
+	}
+
+	if config.commandPolicy != nil {
+		if err := config.commandPolicy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid command policy: %w", err)
+		}
+	}
+
+	if config.maxResponseSize != 0 {
+		maxResponseSize := config.maxResponseSize
+		request.MaxResponseSize = &maxResponseSize
+	}
+
+	if config.writeCoalescingWindow < 0 || config.writeCoalescingWindow > maxWriteCoalescingWindow {
+		return nil, fmt.Errorf(
+			"write coalescing window must be between 0 and %v, got %v",
+			maxWriteCoalescingWindow,
+			config.writeCoalescingWindow,
+		)
+	}
+	if windowUs := uint32(config.writeCoalescingWindow.Microseconds()); windowUs != 0 {
+		request.WriteCoalescingWindowUs = &windowUs
+	}
+
+	request.ReissueBlockingCommandsOnFailover = config.reissueBlockingCommandsOnFailover
+	request.RaceDialing = config.raceDialing
+
+	if config.eagerConnections && (config.lazyConnect || config.raceDialing) {
+		return nil, errors.New("eager connections cannot be combined with lazy connect or race dialing")
+	}
+	request.EagerConnections = config.eagerConnections
+
+	if config.keyPrefix != "" {
+		request.KeyPrefix = []byte(config.keyPrefix)
+	}
+
+	if config.bufferPool != nil {
+		if err := config.bufferPool.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid buffer pool configuration: %w", err)
+		}
+	}
+
+	if config.introspectionCache != nil {
+		if err := config.introspectionCache.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid introspection cache configuration: %w", err)
+		}
+	}
+
+	if config.clientSideCache != nil {
+		if err := config.clientSideCache.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid client-side cache configuration: %w", err)
+		}
+		if config.protocol == RESP2 {
+			return nil, errors.New("the client-side cache requires the RESP3 protocol")
+		}
+		// The server tracks the prefixed keys
+		prefixes := make([]string, 0, len(config.clientSideCache.prefixes))
+		for _, prefix := range config.clientSideCache.prefixes {
+			prefixes = append(prefixes, config.keyPrefix+prefix)
+		}
+		if len(prefixes) == 0 && config.keyPrefix != "" {
+			prefixes = append(prefixes, config.keyPrefix)
+		}
+		request.ClientTracking = &protobuf.ClientTrackingConfig{Prefixes: prefixes}
+	}
+
+	if config.circuitBreaker != nil {
+		circuitBreakerPb, err := config.circuitBreaker.toProtobuf()
+		if err != nil {
+			return nil, fmt.Errorf("invalid circuit breaker configuration: %w", err)
+		}
+		request.CircuitBreaker = circuitBreakerPb
+	}
+
+	if config.dnsResolution != nil {
+		dnsResolutionPb, err := config.dnsResolution.toProtobuf()
+		if err != nil {
+			return nil, fmt.Errorf("invalid DNS resolution configuration: %w", err)
+		}
+		request.DnsResolution = dnsResolutionPb
+	}
+
 	return &request, nil
 }
 
+// setTcpSocketOptions sets the TCP socket options of the connections that are not left to the system defaults.
+func setTcpSocketOptions(
+	request *protobuf.ConnectionRequest,
+	keepAliveInterval time.Duration,
+	sendBufferSize uint32,
+	recvBufferSize uint32,
+) error {
+	if keepAliveInterval != 0 {
+		if keepAliveInterval < time.Second {
+			return fmt.Errorf("TCP keepalive interval must be at least 1s, got %v", keepAliveInterval)
+		}
+		interval, err := utils.DurationToMilliseconds(keepAliveInterval)
+		if err != nil {
+			return fmt.Errorf("setting TCP keepalive interval returned an error: %w", err)
+		}
+		request.TcpKeepaliveIntervalMs = &interval
+	}
+	if sendBufferSize != 0 {
+		request.TcpSendBufferSize = &sendBufferSize
+	}
+	if recvBufferSize != 0 {
+		request.TcpRecvBufferSize = &recvBufferSize
+	}
+	return nil
+}
+
+// GetBufferPool returns the configuration of the buffers used to encode commands. If none was set with
+// WithBufferPool, the default configuration is returned.
+func (config *baseClientConfiguration) GetBufferPool() *BufferPoolConfiguration {
+	if config.bufferPool != nil {
+		return config.bufferPool
+	}
+	return NewBufferPoolConfiguration()
+}
+
+// GetIntrospectionCache returns the configuration of the local cache of introspection results, or nil if the results are
+// not cached.
+func (config *baseClientConfiguration) GetIntrospectionCache() *IntrospectionCacheConfiguration {
+	return config.introspectionCache
+}
+
+// GetClientSideCache returns the configuration of the client-side cache, or nil if the values are not cached.
+func (config *baseClientConfiguration) GetClientSideCache() *ClientSideCacheConfiguration {
+	return config.clientSideCache
+}
+
+// GetReadFrom returns the read strategy of the client.
+func (config *baseClientConfiguration) GetReadFrom() ReadFrom {
+	return config.readFrom
+}
+
+// GetAuditHook returns the hook called after every request, or nil if requests are not audited.
+func (config *baseClientConfiguration) GetAuditHook() AuditHook {
+	return config.auditHook
+}
+
+// GetLatencyBudgetShedding returns the fraction of the latency budget of a request after which it is shed, or zero if
+// requests are not shed.
+func (config *baseClientConfiguration) GetLatencyBudgetShedding() float64 {
+	return config.sheddingFraction
+}
+
+// GetBlockingTimeoutMargin returns the time left for the reply of a blocking command before the deadline of its context,
+// or zero if the timeouts of the blocking commands are not clamped.
+func (config *baseClientConfiguration) GetBlockingTimeoutMargin() time.Duration {
+	return config.blockingTimeoutMargin
+}
+
+// GetEagerConnections returns whether the creation of the client fails if any of the nodes cannot be connected.
+func (config *baseClientConfiguration) GetEagerConnections() bool {
+	return config.eagerConnections
+}
+
+// GetCompatibilityFallbacks returns whether the commands the server does not know are emulated, where possible.
+func (config *baseClientConfiguration) GetCompatibilityFallbacks() bool {
+	return config.compatibilityFallbacks
+}
+
+// GetPubSubBackpressure returns the maximum number of Pub/Sub messages queued by the client, or zero if the queue is not
+// bounded, and the policy applied once the queue is full.
+func (config *baseClientConfiguration) GetPubSubBackpressure() (int, PubSubBackpressurePolicy) {
+	return config.pubSubQueueCapacity, config.pubSubBackpressurePolicy
+}
+
+// GetCommandPolicy returns the policy restricting the commands the client can send, or nil if every command can be sent.
+func (config *baseClientConfiguration) GetCommandPolicy() *CommandPolicy {
+	return config.commandPolicy
+}
+
+// GetClientNameSuffix returns the suffixes appended to the client name, each preceded by ":", e.g. ":orders-7f9c5:4242",
+// or an empty string if the client name is not suffixed. The suffixes are resolved on the first call.
+func (config *baseClientConfiguration) GetClientNameSuffix() string {
+	if config.clientNameSuffix == nil {
+		return ""
+	}
+	return config.clientNameSuffix()
+}
+
+// GetKeyPrefix returns the prefix of the keys of the client, or an empty string if the keys are not prefixed.
+func (config *baseClientConfiguration) GetKeyPrefix() string {
+	return config.keyPrefix
+}
+
+// GetInterceptors returns the interceptors of the requests, in the order they were added.
+func (config *baseClientConfiguration) GetInterceptors() []Interceptor {
+	return slices.Clone(config.interceptors)
+}
+
+// GetConnectionLifecycleHooks returns the hooks called for the connection lifecycle events, or nil if the events are not
+// reported.
+func (config *baseClientConfiguration) GetConnectionLifecycleHooks() *ConnectionLifecycleHooks {
+	return config.connectionLifecycleHooks
+}
+
 // BackoffStrategy defines how and when the client should attempt to reconnect after a connection failure.
 // The time between retry attempts increases exponentially according to the formula:
 //
@@ -353,6 +678,9 @@ func (config *ClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequest, er
 	}
 
 	if config.subscriptionConfig != nil {
+		if config.protocol == RESP2 {
+			return nil, errors.New("pubsub subscriptions require the RESP3 protocol")
+		}
 		request.PubsubSubscriptions = config.subscriptionConfig.toProtobuf()
 	}
 
@@ -369,6 +697,16 @@ func (config *ClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequest, er
 		request.TcpNodelay = config.AdvancedClientConfiguration.tcpNoDelay
 	}
 
+	err = setTcpSocketOptions(
+		request,
+		config.AdvancedClientConfiguration.tcpKeepAliveInterval,
+		config.AdvancedClientConfiguration.tcpSendBufferSize,
+		config.AdvancedClientConfiguration.tcpRecvBufferSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Handle PubSub reconciliation interval
 	if config.AdvancedClientConfiguration.pubsubReconciliationIntervalMs != nil {
 		intervalMs := uint32(*config.AdvancedClientConfiguration.pubsubReconciliationIntervalMs)
@@ -465,6 +803,33 @@ func (config *ClientConfiguration) WithClientName(clientName string) *ClientConf
 	return config
 }
 
+// WithClientNameSuffixes appends metadata to the client name, each piece preceded by ":", e.g. "orders:orders-7f9c5:4242"
+// for the client name "orders" with [HostnameSuffix] and [PidSuffix], so that the connections of the instances of an
+// application can be told apart in the output of `CLIENT LIST`. The suffixed name is set on every connection, including
+// the reconnections, and the suffixes are appended to the names set with `ClientSetName` too. The suffixes are resolved
+// once per configuration, so that the clients created from the same configuration share them, e.g. the same
+// [UUIDSuffix]. If the client name is not set, the client name is made of the suffixes.
+func (config *ClientConfiguration) WithClientNameSuffixes(suffixes ...ClientNameSuffix) *ClientConfiguration {
+	config.clientNameSuffix = newClientNameSuffix(suffixes)
+	return config
+}
+
+// WithLibName sets the library name reported to the server with `CLIENT SETINFO LIB-NAME` during connection establishment,
+// and visible in the output of `CLIENT INFO` and `CLIENT LIST`. If not set, "GlideGo" is used. The library version
+// (`LIB-VER`) is always set to the version of the client. Requires Valkey 7.2 or above; ignored by older servers.
+func (config *ClientConfiguration) WithLibName(libName string) *ClientConfiguration {
+	config.libName = libName
+	return config
+}
+
+// WithProtocol sets the serialization protocol used to communicate with the server. If not set, [RESP3] is used.
+// [RESP2] may be needed with proxies that do not handle RESP3 push messages, but it cannot be used together with pubsub
+// subscriptions.
+func (config *ClientConfiguration) WithProtocol(protocol ProtocolVersion) *ClientConfiguration {
+	config.protocol = protocol
+	return config
+}
+
 // WithClientAZ sets the client's Availability Zone (AZ) to be used for the client.
 func (config *ClientConfiguration) WithClientAZ(clientAZ string) *ClientConfiguration {
 	config.clientAZ = clientAZ
@@ -494,6 +859,203 @@ func (config *ClientConfiguration) WithCompressionConfiguration(
 	return config
 }
 
+// WithBufferPool sets the configuration of the buffers the client reuses to encode commands. If not set, pooling is
+// enabled with the default limits. See [BufferPoolConfiguration] for details.
+func (config *ClientConfiguration) WithBufferPool(bufferPool *BufferPoolConfiguration) *ClientConfiguration {
+	config.bufferPool = bufferPool
+	return config
+}
+
+// WithIntrospectionCache enables the local cache of the results of introspection commands, such as `COMMAND INFO`. If
+// not set, the results are not cached. See [IntrospectionCacheConfiguration] for details.
+func (config *ClientConfiguration) WithIntrospectionCache(
+	introspectionCache *IntrospectionCacheConfiguration,
+) *ClientConfiguration {
+	config.introspectionCache = introspectionCache
+	return config
+}
+
+// WithClientSideCache enables the client-side cache of the values read with `GET`, invalidated by the server with the
+// broadcasting mode of client tracking. If not set, the values are not cached. See [ClientSideCacheConfiguration] for
+// details.
+func (config *ClientConfiguration) WithClientSideCache(clientSideCache *ClientSideCacheConfiguration) *ClientConfiguration {
+	config.clientSideCache = clientSideCache
+	return config
+}
+
+// WithAuditHook sets the hook called after every request, see [AuditHook]. If not set, requests are not audited.
+func (config *ClientConfiguration) WithAuditHook(hook AuditHook) *ClientConfiguration {
+	config.auditHook = hook
+	return config
+}
+
+// WithLatencyBudgetShedding enables the shedding of the requests that already used more than `fraction` of their latency
+// budget before being sent, e.g. 0.8. Such requests are likely to miss their deadline anyway, so they fail fast with a
+// `glide.BudgetExceededError` instead of adding to the load of a server that is already slow. The latency budget of a
+// request is attached to its context with `glide.WithLatencyBudget`; the requests without one are never shed. `fraction`
+// must be between 0 and 1; if not set or zero, requests are not shed.
+func (config *ClientConfiguration) WithLatencyBudgetShedding(fraction float64) *ClientConfiguration {
+	config.sheddingFraction = fraction
+	return config
+}
+
+// WithBlockingTimeoutClamping clamps the timeouts of the blocking commands, such as `BLPOP` or `XREAD` with `BLOCK`, to the
+// deadlines of their contexts minus `margin`, e.g. 100 milliseconds. Without it, a command whose context expires before
+// its timeout fails with the error of the context while the server keeps blocking on it; with it, the server times the
+// command out first, and the command returns its empty reply before the deadline. A timeout of `0`, which blocks
+// indefinitely, is clamped too. `margin` should cover the round trip to the server; if not set or zero, the timeouts are
+// sent as given.
+func (config *ClientConfiguration) WithBlockingTimeoutClamping(margin time.Duration) *ClientConfiguration {
+	config.blockingTimeoutMargin = margin
+	return config
+}
+
+// WithCommandPolicy restricts the commands the client can send to the commands of `allow`, or to every command if it is
+// empty, except the commands of `deny`, e.g. to hand out clients that cannot run `FLUSHALL` regardless of the ACLs of the
+// server. The commands are given by name, case-insensitively, or by tag, e.g. [DangerousCommandsTag]. The commands that
+// are not permitted fail with a `glide.PolicyError` without being sent; a batch fails if any of its commands is not
+// permitted. The subcommands can be given with their container command, e.g. "CONFIG SET", and are also matched by the
+// container command, e.g. "CONFIG". Scripts are identified as `EVALSHA`, and cluster scans as `SCAN`. If not set, every
+// command can be sent.
+func (config *ClientConfiguration) WithCommandPolicy(allow []string, deny []string) *ClientConfiguration {
+	config.commandPolicy = NewCommandPolicy(allow, deny)
+	return config
+}
+
+// WithMaxResponseSize sets the maximum size in bytes of a response, e.g. to protect the application from fetching a
+// multi-gigabyte value into memory by mistake. Larger responses are aborted and the commands fail with a
+// `glide.ResponseTooLargeError`. A response that is aborted before being fully received leaves the rest of it on the
+// connection, so the connection is reestablished. If not set or zero, the size of the responses is not limited.
+func (config *ClientConfiguration) WithMaxResponseSize(bytes uint64) *ClientConfiguration {
+	config.maxResponseSize = bytes
+	return config
+}
+
+// WithWriteCoalescingWindow sets how long the requests are held back before being written to a server connection, so that
+// the requests sent to the same node within the window are written together in a single write syscall. This improves the
+// throughput of services sending many small requests at a very high rate, at the cost of up to `window` of added latency
+// per request; windows of 50µs to 200µs are typical. `window` is rounded down to microseconds and must not exceed 10ms.
+// If not set or zero, the requests are written as soon as they are sent.
+//
+// The requests are coalesced by the core, on the connections to the servers: they are still passed to the core one by
+// one, as in-process calls that involve no syscall.
+func (config *ClientConfiguration) WithWriteCoalescingWindow(window time.Duration) *ClientConfiguration {
+	config.writeCoalescingWindow = window
+	return config
+}
+
+// WithReissueBlockingCommandsOnFailover sets whether the blocking commands, i.e. `BLPOP`, `BRPOP`, `BLMOVE`, `BLMPOP`,
+// `BRPOPLPUSH`, `BZPOPMIN`, `BZPOPMAX`, `BZMPOP` and `XREAD` or `XREADGROUP` with `BLOCK`, are transparently re-issued
+// when the primary they block on fails over, instead of failing. A command is re-issued on the new primary once it is
+// reachable, with the part of its timeout that remains; if the timeout expires in between, the command returns as if it
+// timed out. Disabled by default.
+//
+// The commands are only re-issued when the demoted primary replies that they were not served. When the connection to the
+// primary is lost instead, the response lost with it may have popped elements or delivered entries to the consumer, so
+// only `XREAD` is re-issued; the other commands fail, as re-issuing them could consume the next elements or entries
+// while the previous ones are lost.
+func (config *ClientConfiguration) WithReissueBlockingCommandsOnFailover(enabled bool) *ClientConfiguration {
+	config.reissueBlockingCommandsOnFailover = enabled
+	return config
+}
+
+// WithRaceDialing sets whether the client is created as soon as the primary is connected, instead of waiting for every
+// address to connect or fail. The addresses are always dialed in parallel: with race dialing, an unreachable replica does
+// not delay the creation of the client by the connection timeout. The addresses that are still being dialed are connected
+// in the background, and their roles are not checked. Disabled by default.
+func (config *ClientConfiguration) WithRaceDialing(enabled bool) *ClientConfiguration {
+	config.raceDialing = enabled
+	return config
+}
+
+// WithEagerConnections sets whether the client connects to every address when it is created, and fails with the errors
+// of all the addresses that could not be connected, e.g. an unreachable replica. Without it, the client is created once
+// the primary is connected, and the other addresses are connected in the background, adding the latency of the connection
+// to their first requests. Cannot be combined with [ClientConfiguration.WithLazyConnect] or
+// [ClientConfiguration.WithRaceDialing]. Disabled by default.
+func (config *ClientConfiguration) WithEagerConnections(enabled bool) *ClientConfiguration {
+	config.eagerConnections = enabled
+	return config
+}
+
+// WithCompatibilityFallbacks sets whether the commands missing on older servers are emulated, so that the same code runs
+// against servers of different versions. Currently, `SINTERCARD`, added in Valkey 7.0, is emulated with a Lua script
+// counting the members of `SINTER`, which builds the whole intersection on the server. A command is emulated once a
+// server replies that it does not know it, and from then on. Only the commands sent by the methods of the client are
+// emulated, not the commands of the batches. Disabled by default.
+func (config *ClientConfiguration) WithCompatibilityFallbacks(enabled bool) *ClientConfiguration {
+	config.compatibilityFallbacks = enabled
+	return config
+}
+
+// WithPubSubBackpressure bounds the queue of the Pub/Sub messages received without a callback, returned by `GetQueue`, to
+// `capacity` messages, so that a slow consumer does not run the process out of memory. Once the queue is full, the
+// received messages are handled by `policy`: the oldest or the newest message is dropped, or the delivery of the
+// messages is blocked until the queue has room. The dropped messages are counted in the `pubsub_dropped_messages_count`
+// statistic of `GetStatistics`. The messages of a bounded queue are delivered in the order they were received. A
+// `capacity` of zero or less leaves the queue unbounded, which is the default.
+func (config *ClientConfiguration) WithPubSubBackpressure(
+	capacity int,
+	policy PubSubBackpressurePolicy,
+) *ClientConfiguration {
+	config.pubSubQueueCapacity = capacity
+	config.pubSubBackpressurePolicy = policy
+	return config
+}
+
+// WithKeyPrefix sets a prefix that is transparently added to the keys of all the commands, and stripped from the keys
+// echoed in the responses, e.g. by `SCAN`, `KEYS`, `RANDOMKEY` or `BLPOP`, so that several tenants can share a database
+// without seeing the keys of each other. `SCAN`, `KEYS` and `RANDOMKEY` only return the keys of the client's tenant.
+// The keys of the commands of the server and of the JSON module are prefixed; the other commands, e.g. the commands of
+// other modules sent with `CustomCommand`, are sent unchanged. Keys passed to scripts as arguments instead of keys are not
+// prefixed either. If not set or empty, the keys are not prefixed.
+func (config *ClientConfiguration) WithKeyPrefix(prefix string) *ClientConfiguration {
+	config.keyPrefix = prefix
+	return config
+}
+
+// WithInterceptor adds an interceptor of the requests, see [Interceptor]. The interceptors are called in the order they
+// were added, the first one being the outermost.
+func (config *ClientConfiguration) WithInterceptor(interceptor Interceptor) *ClientConfiguration {
+	config.interceptors = append(config.interceptors, interceptor)
+	return config
+}
+
+// WithConnectionLifecycleHooks sets the hooks called when a connection to a server is established, when it is closed by
+// the server or the network, and when an attempt to reconnect fails, e.g. to log, alert or warm caches when connections
+// bounce. Any of the hooks may be nil. See [ConnectionLifecycleHooks] for details.
+func (config *ClientConfiguration) WithConnectionLifecycleHooks(
+	onConnect func(address string),
+	onDisconnect func(address string, err error),
+	onReconnectAttempt func(address string, attempt int, err error),
+) *ClientConfiguration {
+	config.connectionLifecycleHooks = &ConnectionLifecycleHooks{
+		OnConnect:          onConnect,
+		OnDisconnect:       onDisconnect,
+		OnReconnectAttempt: onReconnectAttempt,
+	}
+	return config
+}
+
+// WithCircuitBreaker enables per-node circuit breakers, which fail commands destined to a node fast after consecutive
+// connection failures to it. If not set, circuit breakers are disabled. See [CircuitBreakerConfiguration] for details.
+func (config *ClientConfiguration) WithCircuitBreaker(
+	circuitBreaker *CircuitBreakerConfiguration,
+) *ClientConfiguration {
+	config.circuitBreaker = circuitBreaker
+	return config
+}
+
+// WithDnsResolution sets how the hostnames of the nodes are re-resolved, so that the client reconnects to a node whose
+// hostname now resolves to a new IP. If not set, hostnames are not re-resolved. See [DnsResolutionConfiguration] for
+// details.
+func (config *ClientConfiguration) WithDnsResolution(
+	dnsResolution *DnsResolutionConfiguration,
+) *ClientConfiguration {
+	config.dnsResolution = dnsResolution
+	return config
+}
+
 // WithAdvancedConfiguration sets the advanced configuration settings for the client.
 func (config *ClientConfiguration) WithAdvancedConfiguration(
 	advancedConfig *AdvancedClientConfiguration,
@@ -539,6 +1101,8 @@ func (config *ClientConfiguration) GetSubscription() *StandaloneSubscriptionConf
 type ClusterClientConfiguration struct {
 	baseClientConfiguration
 	subscriptionConfig *ClusterSubscriptionConfig
+	maxRedirects       *uint32
+	replicaSelector    ReplicaSelector
 	AdvancedClusterClientConfiguration
 }
 
@@ -566,15 +1130,32 @@ func (config *ClusterClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequ
 		request.ConnectionTimeout = connectionTimeout
 	}
 	if config.subscriptionConfig != nil {
+		if config.protocol == RESP2 {
+			return nil, errors.New("pubsub subscriptions require the RESP3 protocol")
+		}
 		request.PubsubSubscriptions = config.subscriptionConfig.toProtobuf()
 	}
 	request.RefreshTopologyFromInitialNodes = config.AdvancedClusterClientConfiguration.refreshTopologyFromInitialNodes
 
+	if config.maxRedirects != nil {
+		request.MaxRedirects = config.maxRedirects
+	}
+
 	// Handle TCP_NODELAY configuration
 	if config.AdvancedClusterClientConfiguration.tcpNoDelay != nil {
 		request.TcpNodelay = config.AdvancedClusterClientConfiguration.tcpNoDelay
 	}
 
+	err = setTcpSocketOptions(
+		request,
+		config.AdvancedClusterClientConfiguration.tcpKeepAliveInterval,
+		config.AdvancedClusterClientConfiguration.tcpSendBufferSize,
+		config.AdvancedClusterClientConfiguration.tcpRecvBufferSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Handle PubSub reconciliation interval
 	if config.AdvancedClusterClientConfiguration.pubsubReconciliationIntervalMs != nil {
 		intervalMs := uint32(*config.AdvancedClusterClientConfiguration.pubsubReconciliationIntervalMs)
@@ -673,12 +1254,68 @@ func (config *ClusterClientConfiguration) WithClientName(clientName string) *Clu
 	return config
 }
 
+// WithClientNameSuffixes appends metadata to the client name, each piece preceded by ":", e.g. "orders:orders-7f9c5:4242"
+// for the client name "orders" with [HostnameSuffix] and [PidSuffix], so that the connections of the instances of an
+// application can be told apart in the output of `CLIENT LIST`. The suffixed name is set on every connection, including
+// the reconnections and the connections to the nodes that join the cluster, and the suffixes are appended to the names
+// set with `ClientSetName` too. The suffixes are resolved once per configuration, so that the clients created from the
+// same configuration share them, e.g. the same [UUIDSuffix]. If the client name is not set, the client name is made of
+// the suffixes.
+func (config *ClusterClientConfiguration) WithClientNameSuffixes(suffixes ...ClientNameSuffix) *ClusterClientConfiguration {
+	config.clientNameSuffix = newClientNameSuffix(suffixes)
+	return config
+}
+
+// WithLibName sets the library name reported to the server with `CLIENT SETINFO LIB-NAME` during connection establishment,
+// and visible in the output of `CLIENT INFO` and `CLIENT LIST`. If not set, "GlideGo" is used. The library version
+// (`LIB-VER`) is always set to the version of the client. Requires Valkey 7.2 or above; ignored by older servers.
+func (config *ClusterClientConfiguration) WithLibName(libName string) *ClusterClientConfiguration {
+	config.libName = libName
+	return config
+}
+
+// WithProtocol sets the serialization protocol used to communicate with the server. If not set, [RESP3] is used.
+// [RESP2] may be needed with proxies that do not handle RESP3 push messages, but it cannot be used together with pubsub
+// subscriptions.
+func (config *ClusterClientConfiguration) WithProtocol(protocol ProtocolVersion) *ClusterClientConfiguration {
+	config.protocol = protocol
+	return config
+}
+
 // WithClientAZ sets the client's Availability Zone (AZ) to be used for the client.
 func (config *ClusterClientConfiguration) WithClientAZ(clientAZ string) *ClusterClientConfiguration {
 	config.clientAZ = clientAZ
 	return config
 }
 
+// WithMaxRedirects sets the maximum number of MOVED or ASK redirects a request follows. The redirects are counted apart
+// from the retries after other errors, such as TRYAGAIN or CLUSTERDOWN. A request still redirected afterwards fails with a
+// `TooManyRedirectsError` listing all its redirects. If not set, a request follows up to 3 redirects.
+//
+// The number of redirects received by the client is available in the statistics returned by `GetStatistics`.
+func (config *ClusterClientConfiguration) WithMaxRedirects(maxRedirects uint32) *ClusterClientConfiguration {
+	config.maxRedirects = &maxRedirects
+	return config
+}
+
+// WithReplicaSelector sets the [ReplicaSelector] picking the replica the read-only commands are sent to, instead of the
+// selection of the [ReadFrom] strategy, e.g. to send the reads to the replica with the fewest reads in flight. It only
+// applies while the client reads from replicas, i.e. with a strategy other than [Primary], to the commands whose keys are
+// located by the command table of the server and map to the same slot; the other commands are routed by the strategy.
+// The replicas of every shard are listed with `CLUSTER NODES` in the background, and listed again every 10 seconds; the
+// commands sent before they are first listed are routed by the strategy. If not set, the replicas are selected by the
+// strategy.
+func (config *ClusterClientConfiguration) WithReplicaSelector(selector ReplicaSelector) *ClusterClientConfiguration {
+	config.replicaSelector = selector
+	return config
+}
+
+// GetReplicaSelector returns the selector of the replicas the read-only commands are sent to, or nil if they are selected
+// by the [ReadFrom] strategy.
+func (config *ClusterClientConfiguration) GetReplicaSelector() ReplicaSelector {
+	return config.replicaSelector
+}
+
 // WithReconnectStrategy sets the [BackoffStrategy] used to determine how and when to reconnect, in case of connection
 // failures. If not set, a default backoff strategy will be used.
 func (config *ClusterClientConfiguration) WithReconnectStrategy(
@@ -704,6 +1341,206 @@ func (config *ClusterClientConfiguration) WithCompressionConfiguration(
 	return config
 }
 
+// WithBufferPool sets the configuration of the buffers the client reuses to encode commands. If not set, pooling is
+// enabled with the default limits. See [BufferPoolConfiguration] for details.
+func (config *ClusterClientConfiguration) WithBufferPool(bufferPool *BufferPoolConfiguration) *ClusterClientConfiguration {
+	config.bufferPool = bufferPool
+	return config
+}
+
+// WithIntrospectionCache enables the local cache of the results of introspection commands, such as `COMMAND INFO`. If
+// not set, the results are not cached. See [IntrospectionCacheConfiguration] for details.
+func (config *ClusterClientConfiguration) WithIntrospectionCache(
+	introspectionCache *IntrospectionCacheConfiguration,
+) *ClusterClientConfiguration {
+	config.introspectionCache = introspectionCache
+	return config
+}
+
+// WithClientSideCache enables the client-side cache of the values read with `GET`, invalidated by the server with the
+// broadcasting mode of client tracking. If not set, the values are not cached. See [ClientSideCacheConfiguration] for
+// details.
+func (config *ClusterClientConfiguration) WithClientSideCache(
+	clientSideCache *ClientSideCacheConfiguration,
+) *ClusterClientConfiguration {
+	config.clientSideCache = clientSideCache
+	return config
+}
+
+// WithAuditHook sets the hook called after every request, see [AuditHook]. If not set, requests are not audited.
+func (config *ClusterClientConfiguration) WithAuditHook(hook AuditHook) *ClusterClientConfiguration {
+	config.auditHook = hook
+	return config
+}
+
+// WithLatencyBudgetShedding enables the shedding of the requests that already used more than `fraction` of their latency
+// budget before being sent, e.g. 0.8. Such requests are likely to miss their deadline anyway, so they fail fast with a
+// `glide.BudgetExceededError` instead of adding to the load of a server that is already slow. The latency budget of a
+// request is attached to its context with `glide.WithLatencyBudget`; the requests without one are never shed. `fraction`
+// must be between 0 and 1; if not set or zero, requests are not shed.
+func (config *ClusterClientConfiguration) WithLatencyBudgetShedding(fraction float64) *ClusterClientConfiguration {
+	config.sheddingFraction = fraction
+	return config
+}
+
+// WithBlockingTimeoutClamping clamps the timeouts of the blocking commands, such as `BLPOP` or `XREAD` with `BLOCK`, to the
+// deadlines of their contexts minus `margin`, e.g. 100 milliseconds. Without it, a command whose context expires before
+// its timeout fails with the error of the context while the server keeps blocking on it; with it, the server times the
+// command out first, and the command returns its empty reply before the deadline. A timeout of `0`, which blocks
+// indefinitely, is clamped too. `margin` should cover the round trip to the server; if not set or zero, the timeouts are
+// sent as given.
+func (config *ClusterClientConfiguration) WithBlockingTimeoutClamping(margin time.Duration) *ClusterClientConfiguration {
+	config.blockingTimeoutMargin = margin
+	return config
+}
+
+// WithCommandPolicy restricts the commands the client can send to the commands of `allow`, or to every command if it is
+// empty, except the commands of `deny`, e.g. to hand out clients that cannot run `FLUSHALL` regardless of the ACLs of the
+// server. The commands are given by name, case-insensitively, or by tag, e.g. [DangerousCommandsTag]. The commands that
+// are not permitted fail with a `glide.PolicyError` without being sent; a batch fails if any of its commands is not
+// permitted. The subcommands can be given with their container command, e.g. "CONFIG SET", and are also matched by the
+// container command, e.g. "CONFIG". Scripts are identified as `EVALSHA`, and cluster scans as `SCAN`. If not set, every
+// command can be sent.
+func (config *ClusterClientConfiguration) WithCommandPolicy(allow []string, deny []string) *ClusterClientConfiguration {
+	config.commandPolicy = NewCommandPolicy(allow, deny)
+	return config
+}
+
+// WithMaxResponseSize sets the maximum size in bytes of a response, e.g. to protect the application from fetching a
+// multi-gigabyte value into memory by mistake. Larger responses are aborted and the commands fail with a
+// `glide.ResponseTooLargeError`. A response that is aborted before being fully received leaves the rest of it on the
+// connection, so the connection is reestablished. If not set or zero, the size of the responses is not limited.
+func (config *ClusterClientConfiguration) WithMaxResponseSize(bytes uint64) *ClusterClientConfiguration {
+	config.maxResponseSize = bytes
+	return config
+}
+
+// WithWriteCoalescingWindow sets how long the requests are held back before being written to a server connection, so that
+// the requests sent to the same node within the window are written together in a single write syscall. This improves the
+// throughput of services sending many small requests at a very high rate, at the cost of up to `window` of added latency
+// per request; windows of 50µs to 200µs are typical. `window` is rounded down to microseconds and must not exceed 10ms.
+// If not set or zero, the requests are written as soon as they are sent.
+//
+// The requests are coalesced by the core, on the connections to the servers: they are still passed to the core one by
+// one, as in-process calls that involve no syscall.
+func (config *ClusterClientConfiguration) WithWriteCoalescingWindow(window time.Duration) *ClusterClientConfiguration {
+	config.writeCoalescingWindow = window
+	return config
+}
+
+// WithReissueBlockingCommandsOnFailover sets whether the blocking commands, i.e. `BLPOP`, `BRPOP`, `BLMOVE`, `BLMPOP`,
+// `BRPOPLPUSH`, `BZPOPMIN`, `BZPOPMAX`, `BZMPOP` and `XREAD` or `XREADGROUP` with `BLOCK`, are transparently re-issued
+// when the primary they block on fails over, instead of failing. A command is re-issued on the new primary once it is
+// reachable, with the part of its timeout that remains; if the timeout expires in between, the command returns as if it
+// timed out. Disabled by default.
+//
+// The commands are only re-issued when the demoted primary replies that they were not served. When the connection to the
+// primary is lost instead, the response lost with it may have popped elements or delivered entries to the consumer, so
+// only `XREAD` is re-issued; the other commands fail, as re-issuing them could consume the next elements or entries
+// while the previous ones are lost.
+func (config *ClusterClientConfiguration) WithReissueBlockingCommandsOnFailover(enabled bool) *ClusterClientConfiguration {
+	config.reissueBlockingCommandsOnFailover = enabled
+	return config
+}
+
+// WithRaceDialing sets whether the topology is discovered as soon as the first seed address is connected, instead of
+// waiting for every seed address to connect or fail. The seed addresses are always dialed in parallel: with race dialing,
+// an unreachable seed does not delay the creation of the client by the connection timeout. The nodes that are still being
+// dialed are connected once discovered in the topology. Disabled by default.
+func (config *ClusterClientConfiguration) WithRaceDialing(enabled bool) *ClusterClientConfiguration {
+	config.raceDialing = enabled
+	return config
+}
+
+// WithEagerConnections sets whether the client connects to every node of the cluster when it is created, by sending a
+// `PING` to every node listed by `CLUSTER NODES`, and fails with a `glide.NodeErrors` holding the error of every node that
+// could not be connected. Without it, the client is created once the topology is discovered, and the nodes that could not
+// be connected are connected in the background, adding the latency of the connection to their first requests. Cannot be
+// combined with [ClusterClientConfiguration.WithLazyConnect] or [ClusterClientConfiguration.WithRaceDialing]. Disabled by
+// default.
+func (config *ClusterClientConfiguration) WithEagerConnections(enabled bool) *ClusterClientConfiguration {
+	config.eagerConnections = enabled
+	return config
+}
+
+// WithCompatibilityFallbacks sets whether the commands missing on older servers are emulated, so that the same code runs
+// against servers of different versions. Currently, `SINTERCARD`, added in Valkey 7.0, is emulated with a Lua script
+// counting the members of `SINTER`, which builds the whole intersection on the server. A command is emulated once a
+// server replies that it does not know it, and from then on. Only the commands sent by the methods of the client are
+// emulated, not the commands of the batches. Disabled by default.
+func (config *ClusterClientConfiguration) WithCompatibilityFallbacks(enabled bool) *ClusterClientConfiguration {
+	config.compatibilityFallbacks = enabled
+	return config
+}
+
+// WithPubSubBackpressure bounds the queue of the Pub/Sub messages received without a callback, returned by `GetQueue`, to
+// `capacity` messages, so that a slow consumer does not run the process out of memory. Once the queue is full, the
+// received messages are handled by `policy`: the oldest or the newest message is dropped, or the delivery of the
+// messages is blocked until the queue has room. The dropped messages are counted in the `pubsub_dropped_messages_count`
+// statistic of `GetStatistics`. The messages of a bounded queue are delivered in the order they were received. A
+// `capacity` of zero or less leaves the queue unbounded, which is the default.
+func (config *ClusterClientConfiguration) WithPubSubBackpressure(
+	capacity int,
+	policy PubSubBackpressurePolicy,
+) *ClusterClientConfiguration {
+	config.pubSubQueueCapacity = capacity
+	config.pubSubBackpressurePolicy = policy
+	return config
+}
+
+// WithKeyPrefix sets a prefix that is transparently added to the keys of all the commands, and stripped from the keys
+// echoed in the responses, e.g. by `SCAN`, `KEYS`, `RANDOMKEY` or `BLPOP`, so that several tenants can share a database
+// without seeing the keys of each other. `SCAN`, `KEYS` and `RANDOMKEY` only return the keys of the client's tenant.
+// The keys of the commands of the server and of the JSON module are prefixed; the other commands, e.g. the commands of
+// other modules sent with `CustomCommand`, are sent unchanged. Keys passed to scripts as arguments instead of keys are not
+// prefixed either. If not set or empty, the keys are not prefixed.
+func (config *ClusterClientConfiguration) WithKeyPrefix(prefix string) *ClusterClientConfiguration {
+	config.keyPrefix = prefix
+	return config
+}
+
+// WithInterceptor adds an interceptor of the requests, see [Interceptor]. The interceptors are called in the order they
+// were added, the first one being the outermost.
+func (config *ClusterClientConfiguration) WithInterceptor(interceptor Interceptor) *ClusterClientConfiguration {
+	config.interceptors = append(config.interceptors, interceptor)
+	return config
+}
+
+// WithConnectionLifecycleHooks sets the hooks called when a connection to a server is established, when it is closed by
+// the server or the network, and when an attempt to reconnect fails, e.g. to log, alert or warm caches when connections
+// bounce. Any of the hooks may be nil. See [ConnectionLifecycleHooks] for details.
+func (config *ClusterClientConfiguration) WithConnectionLifecycleHooks(
+	onConnect func(address string),
+	onDisconnect func(address string, err error),
+	onReconnectAttempt func(address string, attempt int, err error),
+) *ClusterClientConfiguration {
+	config.connectionLifecycleHooks = &ConnectionLifecycleHooks{
+		OnConnect:          onConnect,
+		OnDisconnect:       onDisconnect,
+		OnReconnectAttempt: onReconnectAttempt,
+	}
+	return config
+}
+
+// WithCircuitBreaker enables per-node circuit breakers, which fail commands destined to a node fast after consecutive
+// connection failures to it. If not set, circuit breakers are disabled. See [CircuitBreakerConfiguration] for details.
+func (config *ClusterClientConfiguration) WithCircuitBreaker(
+	circuitBreaker *CircuitBreakerConfiguration,
+) *ClusterClientConfiguration {
+	config.circuitBreaker = circuitBreaker
+	return config
+}
+
+// WithDnsResolution sets how the hostnames of the nodes are re-resolved, so that the client reconnects to a node whose
+// hostname now resolves to a new IP. If not set, hostnames are not re-resolved. See [DnsResolutionConfiguration] for
+// details.
+func (config *ClusterClientConfiguration) WithDnsResolution(
+	dnsResolution *DnsResolutionConfiguration,
+) *ClusterClientConfiguration {
+	config.dnsResolution = dnsResolution
+	return config
+}
+
 // WithAdvancedConfiguration sets the advanced configuration settings for the client.
 func (config *ClusterClientConfiguration) WithAdvancedConfiguration(
 	advancedConfig *AdvancedClusterClientConfiguration,
@@ -822,6 +1659,9 @@ type AdvancedClientConfiguration struct {
 	connectionTimeout              time.Duration
 	tlsConfig                      *TlsConfiguration
 	tcpNoDelay                     *bool
+	tcpKeepAliveInterval           time.Duration
+	tcpSendBufferSize              uint32
+	tcpRecvBufferSize              uint32
 	pubsubReconciliationIntervalMs *int
 }
 
@@ -867,6 +1707,37 @@ func (config *AdvancedClientConfiguration) WithTcpNoDelay(
 	return config
 }
 
+// WithTcpKeepAliveInterval sets the idle time after which a TCP keepalive probe is sent on the client connections, and
+// the time between the probes on the platforms that support it. Lower values detect dead connections sooner, e.g.
+// behind load balancers or NATs that silently drop idle connections. Must be at least a second.
+// If not set, the system defaults are used.
+func (config *AdvancedClientConfiguration) WithTcpKeepAliveInterval(
+	interval time.Duration,
+) *AdvancedClientConfiguration {
+	config.tcpKeepAliveInterval = interval
+	return config
+}
+
+// WithTcpSendBufferSize sets the size in bytes of the socket send buffer (SO_SNDBUF) of the client connections.
+// Larger buffers can improve the throughput of high-bandwidth or long-haul connections.
+// If not set, the system default is used.
+func (config *AdvancedClientConfiguration) WithTcpSendBufferSize(
+	size uint32,
+) *AdvancedClientConfiguration {
+	config.tcpSendBufferSize = size
+	return config
+}
+
+// WithTcpRecvBufferSize sets the size in bytes of the socket receive buffer (SO_RCVBUF) of the client connections.
+// Larger buffers can improve the throughput of high-bandwidth or long-haul connections.
+// If not set, the system default is used.
+func (config *AdvancedClientConfiguration) WithTcpRecvBufferSize(
+	size uint32,
+) *AdvancedClientConfiguration {
+	config.tcpRecvBufferSize = size
+	return config
+}
+
 // WithPubSubReconciliationIntervalMs sets the interval in milliseconds between PubSub subscription
 // reconciliation attempts. The reconciliation process ensures that the client's desired subscriptions
 // match the actual subscriptions on the server.
@@ -884,6 +1755,9 @@ type AdvancedClusterClientConfiguration struct {
 	refreshTopologyFromInitialNodes bool
 	tlsConfig                       *TlsConfiguration
 	tcpNoDelay                      *bool
+	tcpKeepAliveInterval            time.Duration
+	tcpSendBufferSize               uint32
+	tcpRecvBufferSize               uint32
 	pubsubReconciliationIntervalMs  *int
 }
 
@@ -939,6 +1813,37 @@ func (config *AdvancedClusterClientConfiguration) WithTcpNoDelay(
 	return config
 }
 
+// WithTcpKeepAliveInterval sets the idle time after which a TCP keepalive probe is sent on the client connections, and
+// the time between the probes on the platforms that support it. Lower values detect dead connections sooner, e.g.
+// behind load balancers or NATs that silently drop idle connections. Must be at least a second.
+// If not set, the system defaults are used.
+func (config *AdvancedClusterClientConfiguration) WithTcpKeepAliveInterval(
+	interval time.Duration,
+) *AdvancedClusterClientConfiguration {
+	config.tcpKeepAliveInterval = interval
+	return config
+}
+
+// WithTcpSendBufferSize sets the size in bytes of the socket send buffer (SO_SNDBUF) of the client connections.
+// Larger buffers can improve the throughput of high-bandwidth or long-haul connections.
+// If not set, the system default is used.
+func (config *AdvancedClusterClientConfiguration) WithTcpSendBufferSize(
+	size uint32,
+) *AdvancedClusterClientConfiguration {
+	config.tcpSendBufferSize = size
+	return config
+}
+
+// WithTcpRecvBufferSize sets the size in bytes of the socket receive buffer (SO_RCVBUF) of the client connections.
+// Larger buffers can improve the throughput of high-bandwidth or long-haul connections.
+// If not set, the system default is used.
+func (config *AdvancedClusterClientConfiguration) WithTcpRecvBufferSize(
+	size uint32,
+) *AdvancedClusterClientConfiguration {
+	config.tcpRecvBufferSize = size
+	return config
+}
+
 // WithPubSubReconciliationIntervalMs sets the interval in milliseconds between PubSub subscription
 // reconciliation attempts. The reconciliation process ensures that the client's desired subscriptions
 // match the actual subscriptions on the server.
@@ -3,13 +3,18 @@
 package config
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 )
 
 // Test certificate constants
@@ -396,6 +401,164 @@ func TestConfig_LazyConnect(t *testing.T) {
 	assert.False(t, defaultClusterResult.LazyConnect)
 }
 
+func TestConfig_LibName(t *testing.T) {
+	clientResult, err := NewClientConfiguration().WithLibName("MyApp").ToProtobuf()
+	if err != nil {
+		t.Fatalf("Failed to convert client config to protobuf: %v", err)
+	}
+	assert.Equal(t, "MyApp", clientResult.LibName)
+
+	clusterResult, err := NewClusterClientConfiguration().WithLibName("MyApp").ToProtobuf()
+	if err != nil {
+		t.Fatalf("Failed to convert cluster config to protobuf: %v", err)
+	}
+	assert.Equal(t, "MyApp", clusterResult.LibName)
+
+	// Not set by default, so the core uses the name of the wrapper
+	defaultResult, err := NewClientConfiguration().ToProtobuf()
+	if err != nil {
+		t.Fatalf("Failed to convert client config to protobuf: %v", err)
+	}
+	assert.Empty(t, defaultResult.LibName)
+}
+
+func TestConfig_Protocol(t *testing.T) {
+	defaultResult, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.ProtocolVersion_RESP3, defaultResult.Protocol)
+
+	clientResult, err := NewClientConfiguration().WithProtocol(RESP2).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.ProtocolVersion_RESP2, clientResult.Protocol)
+
+	clusterResult, err := NewClusterClientConfiguration().WithProtocol(RESP2).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.ProtocolVersion_RESP2, clusterResult.Protocol)
+
+	_, err = NewClientConfiguration().WithProtocol(ProtocolVersion(7)).ToProtobuf()
+	assert.ErrorContains(t, err, "invalid protocol version")
+
+	_, err = NewClientConfiguration().
+		WithProtocol(RESP2).
+		WithSubscriptionConfig(NewStandaloneSubscriptionConfig().WithSubscription(ExactChannelMode, "channel")).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "RESP3")
+
+	_, err = NewClusterClientConfiguration().
+		WithProtocol(RESP2).
+		WithSubscriptionConfig(NewClusterSubscriptionConfig().WithSubscription(ShardedClusterChannelMode, "channel")).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "RESP3")
+}
+
+func TestClusterConfig_MaxRedirects(t *testing.T) {
+	defaultResult, err := NewClusterClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, defaultResult.MaxRedirects)
+
+	result, err := NewClusterClientConfiguration().WithMaxRedirects(10).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), *result.MaxRedirects)
+}
+
+func TestConfig_BufferPool(t *testing.T) {
+	defaultPool := NewClientConfiguration().GetBufferPool()
+	assert.True(t, defaultPool.IsEnabled())
+	assert.Equal(t, DefaultBufferPoolMaxArgs, defaultPool.GetMaxArgs())
+	assert.Equal(t, DefaultBufferPoolMaxBytes, defaultPool.GetMaxBytes())
+
+	bufferPool := NewBufferPoolConfiguration().WithMaxArgs(64).WithMaxBytes(512)
+	assert.Same(t, bufferPool, NewClientConfiguration().WithBufferPool(bufferPool).GetBufferPool())
+	assert.Same(t, bufferPool, NewClusterClientConfiguration().WithBufferPool(bufferPool).GetBufferPool())
+
+	disabled := NewBufferPoolConfiguration().WithEnabled(false).WithMaxArgs(0)
+	_, err := NewClientConfiguration().WithBufferPool(disabled).ToProtobuf()
+	assert.NoError(t, err)
+
+	_, err = NewClientConfiguration().WithBufferPool(NewBufferPoolConfiguration().WithMaxArgs(0)).ToProtobuf()
+	assert.ErrorContains(t, err, "buffer pool max args must be positive")
+
+	_, err = NewClusterClientConfiguration().WithBufferPool(NewBufferPoolConfiguration().WithMaxBytes(-1)).ToProtobuf()
+	assert.ErrorContains(t, err, "buffer pool max bytes must be positive")
+}
+
+func TestConfig_IntrospectionCache(t *testing.T) {
+	assert.Nil(t, NewClientConfiguration().GetIntrospectionCache())
+
+	cache := NewIntrospectionCacheConfiguration(5 * time.Second).WithMaxEntries(10)
+	assert.Equal(t, 5*time.Second, cache.GetTTL())
+	assert.Equal(t, 10, cache.GetMaxEntries())
+	assert.Same(t, cache, NewClientConfiguration().WithIntrospectionCache(cache).GetIntrospectionCache())
+	assert.Same(t, cache, NewClusterClientConfiguration().WithIntrospectionCache(cache).GetIntrospectionCache())
+
+	_, err := NewClientConfiguration().WithIntrospectionCache(cache).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultIntrospectionCacheMaxEntries, NewIntrospectionCacheConfiguration(time.Second).GetMaxEntries())
+
+	_, err = NewClientConfiguration().WithIntrospectionCache(NewIntrospectionCacheConfiguration(0)).ToProtobuf()
+	assert.ErrorContains(t, err, "introspection cache TTL must be positive")
+
+	invalid := NewIntrospectionCacheConfiguration(time.Second).WithMaxEntries(0)
+	_, err = NewClusterClientConfiguration().WithIntrospectionCache(invalid).ToProtobuf()
+	assert.ErrorContains(t, err, "introspection cache max entries must be positive")
+}
+
+func TestConfig_CircuitBreaker(t *testing.T) {
+	request, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, request.CircuitBreaker)
+
+	circuitBreaker := NewCircuitBreakerConfiguration()
+	assert.Equal(t, uint32(DefaultCircuitBreakerFailureThreshold), circuitBreaker.GetFailureThreshold())
+	assert.Equal(t, DefaultCircuitBreakerCooldown, circuitBreaker.GetCooldown())
+
+	circuitBreaker.WithFailureThreshold(3).WithCooldown(1500 * time.Millisecond)
+	request, err = NewClientConfiguration().WithCircuitBreaker(circuitBreaker).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), request.CircuitBreaker.FailureThreshold)
+	assert.Equal(t, uint32(1500), request.CircuitBreaker.CooldownMs)
+
+	clusterRequest, err := NewClusterClientConfiguration().WithCircuitBreaker(circuitBreaker).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), clusterRequest.CircuitBreaker.FailureThreshold)
+
+	_, err = NewClientConfiguration().
+		WithCircuitBreaker(NewCircuitBreakerConfiguration().WithFailureThreshold(0)).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "circuit breaker failure threshold must be positive")
+
+	_, err = NewClusterClientConfiguration().
+		WithCircuitBreaker(NewCircuitBreakerConfiguration().WithCooldown(time.Microsecond)).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "circuit breaker cooldown must be at least 1ms")
+}
+
+func TestConfig_DnsResolution(t *testing.T) {
+	request, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, request.DnsResolution)
+
+	dnsResolution := NewDnsResolutionConfiguration()
+	assert.Equal(t, time.Duration(0), dnsResolution.GetTTL())
+	assert.False(t, dnsResolution.GetPreferHostname())
+
+	dnsResolution.WithTTL(30 * time.Second).WithPreferHostname(true)
+	request, err = NewClientConfiguration().WithDnsResolution(dnsResolution).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(30000), request.DnsResolution.TtlMs)
+	assert.True(t, request.DnsResolution.PreferHostname)
+
+	clusterRequest, err := NewClusterClientConfiguration().WithDnsResolution(dnsResolution).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(30000), clusterRequest.DnsResolution.TtlMs)
+	assert.True(t, clusterRequest.DnsResolution.PreferHostname)
+
+	_, err = NewClusterClientConfiguration().
+		WithDnsResolution(NewDnsResolutionConfiguration().WithTTL(time.Microsecond)).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "DNS resolution TTL must be zero or at least 1ms")
+}
+
 func TestConfig_DatabaseId(t *testing.T) {
 	// Test standalone client with database ID
 	standaloneConfig := NewClientConfiguration().WithDatabaseId(5)
@@ -891,6 +1054,59 @@ func TestClusterConfig_TcpNoDelay(t *testing.T) {
 	assert.Nil(t, request.TcpNodelay)
 }
 
+func TestStandaloneConfig_TcpSocketOptions(t *testing.T) {
+	config := NewClientConfiguration().
+		WithAdvancedConfiguration(
+			NewAdvancedClientConfiguration().
+				WithTcpKeepAliveInterval(30 * time.Second).
+				WithTcpSendBufferSize(1 << 20).
+				WithTcpRecvBufferSize(2 << 20),
+		)
+
+	request, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(30000), *request.TcpKeepaliveIntervalMs)
+	assert.Equal(t, uint32(1<<20), *request.TcpSendBufferSize)
+	assert.Equal(t, uint32(2<<20), *request.TcpRecvBufferSize)
+
+	// Not set, the system defaults are used
+	request, err = NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, request.TcpKeepaliveIntervalMs)
+	assert.Nil(t, request.TcpSendBufferSize)
+	assert.Nil(t, request.TcpRecvBufferSize)
+
+	_, err = NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithTcpKeepAliveInterval(time.Millisecond)).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "TCP keepalive interval must be at least 1s")
+}
+
+func TestClusterConfig_TcpSocketOptions(t *testing.T) {
+	config := NewClusterClientConfiguration().
+		WithAdvancedConfiguration(
+			NewAdvancedClusterClientConfiguration().
+				WithTcpKeepAliveInterval(30 * time.Second).
+				WithTcpSendBufferSize(1 << 20).
+				WithTcpRecvBufferSize(2 << 20),
+		)
+
+	request, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(30000), *request.TcpKeepaliveIntervalMs)
+	assert.Equal(t, uint32(1<<20), *request.TcpSendBufferSize)
+	assert.Equal(t, uint32(2<<20), *request.TcpRecvBufferSize)
+
+	request, err = NewClusterClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, request.TcpKeepaliveIntervalMs)
+
+	_, err = NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithTcpKeepAliveInterval(time.Millisecond)).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "TCP keepalive interval must be at least 1s")
+}
+
 // ============================================================================
 // Compression Configuration Tests
 // ============================================================================
@@ -954,6 +1170,23 @@ func TestCompressionConfiguration_AllFieldsSet(t *testing.T) {
 	assert.Equal(t, uint32(256), pb.MinCompressionSize)
 }
 
+func TestCompressionConfiguration_WithAutoDecompression(t *testing.T) {
+	pb, err := NewCompressionConfiguration().toProtobuf()
+	assert.NoError(t, err)
+	assert.Empty(t, pb.AutoDecompressionFormats)
+
+	compressionConfig := NewCompressionConfiguration().
+		WithAutoDecompression(Gzip, ZstdFrame)
+
+	pb, err = compressionConfig.toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]protobuf.AutoDecompressionFormat{protobuf.AutoDecompressionFormat_GZIP, protobuf.AutoDecompressionFormat_ZSTD_FRAME},
+		pb.AutoDecompressionFormats,
+	)
+}
+
 func TestCompressionConfiguration_ValidationMinSizeTooSmall(t *testing.T) {
 	compressionConfig := NewCompressionConfiguration().
 		WithMinCompressionSize(MinCompressionSize - 1)
@@ -1314,3 +1547,431 @@ func TestConfig_ReadOnly_FluentAPI(t *testing.T) {
 	assert.Equal(t, "localhost", result.Addresses[0].Host)
 	assert.Equal(t, uint32(6379), result.Addresses[0].Port)
 }
+
+func TestConfigUpdate_Empty(t *testing.T) {
+	update := NewConfigUpdate()
+	_, ok := update.GetReadFrom()
+	assert.False(t, ok)
+
+	request, err := update.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, request.RequestTimeout)
+	assert.Nil(t, request.ReadFrom)
+	assert.Nil(t, request.ConnectionRetryStrategy)
+}
+
+func TestConfigUpdate_AllFields(t *testing.T) {
+	update := NewConfigUpdate().
+		WithRequestTimeout(2 * time.Second).
+		WithReadFrom(AzAffinity).
+		WithClientAZ("us-east-1a").
+		WithReconnectStrategy(NewBackoffStrategy(3, 10, 2))
+	readFrom, ok := update.GetReadFrom()
+	assert.True(t, ok)
+	assert.Equal(t, AzAffinity, readFrom)
+
+	request, err := update.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2000), *request.RequestTimeout)
+	assert.Equal(t, protobuf.ReadFrom_AZAffinity, *request.ReadFrom)
+	assert.Equal(t, "us-east-1a", request.ClientAz)
+	assert.Equal(t, uint32(3), request.ConnectionRetryStrategy.NumberOfRetries)
+	assert.Equal(t, uint32(10), request.ConnectionRetryStrategy.Factor)
+	assert.Equal(t, uint32(2), request.ConnectionRetryStrategy.ExponentBase)
+}
+
+func TestConfigUpdate_PrimaryIsSet(t *testing.T) {
+	request, err := NewConfigUpdate().WithReadFrom(Primary).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.ReadFrom_Primary, *request.ReadFrom)
+}
+
+func TestConfigUpdate_InvalidRequestTimeout(t *testing.T) {
+	_, err := NewConfigUpdate().WithRequestTimeout(0).ToProtobuf()
+	assert.ErrorContains(t, err, "request timeout must be positive")
+
+	_, err = NewConfigUpdate().WithRequestTimeout(-time.Second).ToProtobuf()
+	assert.ErrorContains(t, err, "request timeout must be positive")
+}
+
+func TestConfigUpdate_AzAffinityRequiresClientAZ(t *testing.T) {
+	_, err := NewConfigUpdate().WithReadFrom(AzAffinityReplicaAndPrimary).ToProtobuf()
+	assert.ErrorContains(t, err, "client AZ must be set")
+}
+
+func TestNamedClientConfig_Standalone(t *testing.T) {
+	namedConfig := NamedClientConfig{
+		Addresses:      []string{"host1:1234", "host2", "[::1]:5678"},
+		UseTLS:         true,
+		Password:       "password",
+		ClientName:     "sessions",
+		DatabaseId:     2,
+		RequestTimeout: 500 * time.Millisecond,
+		ReadFrom:       "PreferReplica",
+	}
+	clientConfig, err := namedConfig.ToClientConfiguration()
+	assert.NoError(t, err)
+	request, err := clientConfig.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, []*protobuf.NodeAddress{
+		{Host: "host1", Port: 1234},
+		{Host: "host2", Port: DefaultPort},
+		{Host: "::1", Port: 5678},
+	}, request.Addresses)
+	assert.Equal(t, protobuf.TlsMode_SecureTls, request.TlsMode)
+	assert.Equal(t, "password", request.AuthenticationInfo.Password)
+	assert.Equal(t, "", request.AuthenticationInfo.Username)
+	assert.Equal(t, "sessions", request.ClientName)
+	assert.Equal(t, uint32(2), request.DatabaseId)
+	assert.Equal(t, uint32(500), request.RequestTimeout)
+	assert.Equal(t, protobuf.ReadFrom_PreferReplica, request.ReadFrom)
+	assert.False(t, request.ClusterModeEnabled)
+
+	_, err = namedConfig.ToClusterClientConfiguration()
+	assert.Error(t, err)
+}
+
+func TestNamedClientConfig_Cluster(t *testing.T) {
+	namedConfig := NamedClientConfig{
+		Addresses: []string{"host1:1234"},
+		Cluster:   true,
+		Username:  "user",
+		Password:  "password",
+		ReadFrom:  "azAffinity",
+		ClientAZ:  "us-east-1a",
+	}
+	clientConfig, err := namedConfig.ToClusterClientConfiguration()
+	assert.NoError(t, err)
+	request, err := clientConfig.ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.ClusterModeEnabled)
+	assert.Equal(t, "user", request.AuthenticationInfo.Username)
+	assert.Equal(t, protobuf.ReadFrom_AZAffinity, request.ReadFrom)
+	assert.Equal(t, "us-east-1a", request.ClientAz)
+
+	_, err = namedConfig.ToClientConfiguration()
+	assert.Error(t, err)
+}
+
+func TestNamedClientConfig_Invalid(t *testing.T) {
+	for _, namedConfig := range []NamedClientConfig{
+		{},
+		{Addresses: []string{""}},
+		{Addresses: []string{"host:0"}},
+		{Addresses: []string{"host:"}},
+		{Addresses: []string{"host"}, ReadFrom: "replica"},
+		{Addresses: []string{"host"}, RequestTimeout: -time.Second},
+	} {
+		_, err := namedConfig.ToClientConfiguration()
+		assert.Error(t, err, "%+v", namedConfig)
+	}
+}
+
+func TestParseReadFrom(t *testing.T) {
+	for name, expected := range map[string]ReadFrom{
+		"":                             Primary,
+		"primary":                      Primary,
+		"preferReplica":                PreferReplica,
+		"AZAFFINITY":                   AzAffinity,
+		"azAffinityReplicasAndPrimary": AzAffinityReplicaAndPrimary,
+	} {
+		readFrom, err := ParseReadFrom(name)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, readFrom, name)
+	}
+	_, err := ParseReadFrom("nearest")
+	assert.Error(t, err)
+}
+
+func TestConfig_AuditHook(t *testing.T) {
+	assert.Nil(t, NewClientConfiguration().GetAuditHook())
+
+	var audited []string
+	hook := func(ctx context.Context, audit models.RequestAudit) { audited = append(audited, audit.Command) }
+	NewClientConfiguration().WithAuditHook(hook).GetAuditHook()(context.Background(), models.RequestAudit{Command: "GET"})
+	NewClusterClientConfiguration().
+		WithAuditHook(hook).
+		GetAuditHook()(context.Background(), models.RequestAudit{Command: "SET"})
+	assert.Equal(t, []string{"GET", "SET"}, audited)
+}
+
+func TestConfig_LatencyBudgetShedding(t *testing.T) {
+	assert.Zero(t, NewClientConfiguration().GetLatencyBudgetShedding())
+	assert.Equal(t, 0.8, NewClientConfiguration().WithLatencyBudgetShedding(0.8).GetLatencyBudgetShedding())
+	assert.Equal(t, 0.5, NewClusterClientConfiguration().WithLatencyBudgetShedding(0.5).GetLatencyBudgetShedding())
+
+	for _, fraction := range []float64{-0.1, 1.5} {
+		_, err := NewClientConfiguration().WithLatencyBudgetShedding(fraction).ToProtobuf()
+		assert.Error(t, err)
+		_, err = NewClusterClientConfiguration().WithLatencyBudgetShedding(fraction).ToProtobuf()
+		assert.Error(t, err)
+	}
+	_, err := NewClientConfiguration().WithLatencyBudgetShedding(1).ToProtobuf()
+	assert.NoError(t, err)
+}
+
+func TestConfig_BlockingTimeoutClamping(t *testing.T) {
+	assert.Zero(t, NewClientConfiguration().GetBlockingTimeoutMargin())
+	margin := 100 * time.Millisecond
+	assert.Equal(t, margin, NewClientConfiguration().WithBlockingTimeoutClamping(margin).GetBlockingTimeoutMargin())
+	assert.Equal(t, margin, NewClusterClientConfiguration().WithBlockingTimeoutClamping(margin).GetBlockingTimeoutMargin())
+
+	_, err := NewClientConfiguration().WithBlockingTimeoutClamping(-time.Millisecond).ToProtobuf()
+	assert.Error(t, err)
+	_, err = NewClusterClientConfiguration().WithBlockingTimeoutClamping(-time.Millisecond).ToProtobuf()
+	assert.Error(t, err)
+	_, err = NewClientConfiguration().WithBlockingTimeoutClamping(margin).ToProtobuf()
+	assert.NoError(t, err)
+}
+
+func TestConfig_CommandPolicy(t *testing.T) {
+	assert.Nil(t, NewClientConfiguration().GetCommandPolicy())
+
+	policy := NewClusterClientConfiguration().WithCommandPolicy(nil, []string{"@dangerous", "eval"}).GetCommandPolicy()
+	assert.True(t, policy.Permits("GET"))
+	assert.False(t, policy.Permits("FLUSHALL"))
+	assert.False(t, policy.Permits("keys"))
+	assert.False(t, policy.Permits("EVAL"))
+
+	// The denied commands are denied even if allowed
+	policy = NewClientConfiguration().WithCommandPolicy([]string{"get", "SET", "FLUSHALL"}, []string{"@DANGEROUS"}).
+		GetCommandPolicy()
+	assert.True(t, policy.Permits("get"))
+	assert.True(t, policy.Permits("SET"))
+	assert.False(t, policy.Permits("FLUSHALL"))
+	assert.False(t, policy.Permits("DEL"))
+
+	// The subcommands are matched by their container command too, as named by the typed commands and custom commands
+	policy = NewClientConfiguration().WithCommandPolicy(nil, []string{DangerousCommandsTag, "client kill"}).
+		GetCommandPolicy()
+	assert.False(t, policy.Permits("CONFIG SET"))
+	assert.False(t, policy.Permits("cluster reset"))
+	assert.False(t, policy.Permits("CLIENT KILL"))
+	assert.True(t, policy.Permits("XINFO STREAM"))
+	policy = NewClientConfiguration().WithCommandPolicy([]string{"CONFIG GET", "xinfo"}, []string{"XINFO GROUPS"}).
+		GetCommandPolicy()
+	assert.True(t, policy.Permits("CONFIG GET"))
+	assert.False(t, policy.Permits("CONFIG SET"))
+	assert.False(t, policy.Permits("CONFIG"))
+	assert.True(t, policy.Permits("XINFO STREAM"))
+	assert.False(t, policy.Permits("XINFO GROUPS"))
+
+	_, err := NewClientConfiguration().WithCommandPolicy([]string{"GET"}, []string{"@dangerous"}).ToProtobuf()
+	assert.NoError(t, err)
+	_, err = NewClientConfiguration().WithCommandPolicy(nil, []string{"@unknown"}).ToProtobuf()
+	assert.ErrorContains(t, err, "@unknown")
+}
+
+func TestConfig_MaxResponseSize(t *testing.T) {
+	request, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, request.MaxResponseSize)
+
+	request, err = NewClientConfiguration().WithMaxResponseSize(64 << 20).ToProtobuf()
+	assert.NoError(t, err)
+	assert.NotNil(t, request.MaxResponseSize)
+	assert.Equal(t, uint64(64<<20), *request.MaxResponseSize)
+
+	request, err = NewClusterClientConfiguration().WithMaxResponseSize(1024).ToProtobuf()
+	assert.NoError(t, err)
+	assert.NotNil(t, request.MaxResponseSize)
+	assert.Equal(t, uint64(1024), *request.MaxResponseSize)
+}
+
+func TestConfig_WriteCoalescingWindow(t *testing.T) {
+	request, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, request.WriteCoalescingWindowUs)
+
+	request, err = NewClientConfiguration().WithWriteCoalescingWindow(100 * time.Microsecond).ToProtobuf()
+	assert.NoError(t, err)
+	assert.NotNil(t, request.WriteCoalescingWindowUs)
+	assert.Equal(t, uint32(100), *request.WriteCoalescingWindowUs)
+
+	request, err = NewClusterClientConfiguration().WithWriteCoalescingWindow(2 * time.Millisecond).ToProtobuf()
+	assert.NoError(t, err)
+	assert.NotNil(t, request.WriteCoalescingWindowUs)
+	assert.Equal(t, uint32(2000), *request.WriteCoalescingWindowUs)
+
+	_, err = NewClientConfiguration().WithWriteCoalescingWindow(-time.Microsecond).ToProtobuf()
+	assert.ErrorContains(t, err, "write coalescing window")
+
+	_, err = NewClusterClientConfiguration().WithWriteCoalescingWindow(time.Second).ToProtobuf()
+	assert.ErrorContains(t, err, "write coalescing window")
+}
+
+func TestConfig_ReissueBlockingCommandsOnFailover(t *testing.T) {
+	request, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.False(t, request.ReissueBlockingCommandsOnFailover)
+
+	request, err = NewClientConfiguration().WithReissueBlockingCommandsOnFailover(true).ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.ReissueBlockingCommandsOnFailover)
+
+	request, err = NewClusterClientConfiguration().WithReissueBlockingCommandsOnFailover(true).ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.ReissueBlockingCommandsOnFailover)
+}
+
+func TestConfig_RaceDialing(t *testing.T) {
+	request, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.False(t, request.RaceDialing)
+
+	request, err = NewClientConfiguration().WithRaceDialing(true).ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.RaceDialing)
+
+	request, err = NewClusterClientConfiguration().WithRaceDialing(true).ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.RaceDialing)
+}
+
+func TestConfig_EagerConnections(t *testing.T) {
+	config := NewClientConfiguration()
+	assert.False(t, config.GetEagerConnections())
+	request, err := config.WithEagerConnections(true).ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.EagerConnections)
+	assert.True(t, config.GetEagerConnections())
+
+	request, err = NewClusterClientConfiguration().WithEagerConnections(true).ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.EagerConnections)
+
+	_, err = NewClientConfiguration().WithEagerConnections(true).WithLazyConnect(true).ToProtobuf()
+	assert.Error(t, err)
+	_, err = NewClusterClientConfiguration().WithEagerConnections(true).WithRaceDialing(true).ToProtobuf()
+	assert.Error(t, err)
+}
+
+func TestConfig_ClientNameSuffixes(t *testing.T) {
+	hostname, err := os.Hostname()
+	assert.NoError(t, err)
+	pid := strconv.Itoa(os.Getpid())
+
+	config := NewClientConfiguration().WithClientName("orders")
+	assert.Empty(t, config.GetClientNameSuffix())
+	config.WithClientNameSuffixes(HostnameSuffix, PidSuffix, UUIDSuffix)
+	suffix := config.GetClientNameSuffix()
+	parts := strings.Split(suffix, ":")
+	if !assert.Len(t, parts, 4) {
+		return
+	}
+	assert.Equal(t, []string{"", sanitizeClientName(hostname), pid}, parts[:3])
+	assert.Len(t, parts[3], 36)
+	// The suffixes are resolved once
+	assert.Equal(t, suffix, config.GetClientNameSuffix())
+	request, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, "orders"+suffix, request.ClientName)
+
+	request, err = NewClusterClientConfiguration().WithClientNameSuffixes(PidSuffix).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, pid, request.ClientName)
+
+	assert.Equal(t, "a_b_c", sanitizeClientName("a b\nc"))
+	assert.Equal(t, "name:1", SuffixedClientName("name", ":1"))
+	assert.Equal(t, "1", SuffixedClientName("", ":1"))
+}
+
+func TestConfig_ClientSideCache(t *testing.T) {
+	config := NewClientConfiguration()
+	assert.Nil(t, config.GetClientSideCache())
+	request, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, request.ClientTracking)
+
+	cacheConfig := NewClientSideCacheConfiguration("config:", "product:").WithMaxEntries(100).WithTTL(time.Second)
+	assert.Equal(t, []string{"config:", "product:"}, cacheConfig.GetPrefixes())
+	assert.Equal(t, 100, cacheConfig.GetMaxEntries())
+	assert.Equal(t, time.Second, cacheConfig.GetTTL())
+	config = NewClientConfiguration().WithClientSideCache(cacheConfig)
+	assert.Same(t, cacheConfig, config.GetClientSideCache())
+	request, err = config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"config:", "product:"}, request.ClientTracking.Prefixes)
+
+	// The server tracks the prefixed keys
+	request, err = NewClusterClientConfiguration().
+		WithKeyPrefix("{tenant}:").
+		WithClientSideCache(NewClientSideCacheConfiguration("config:")).
+		ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"{tenant}:config:"}, request.ClientTracking.Prefixes)
+
+	request, err = NewClientConfiguration().
+		WithKeyPrefix("tenant:").
+		WithClientSideCache(NewClientSideCacheConfiguration()).
+		ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tenant:"}, request.ClientTracking.Prefixes)
+
+	request, err = NewClientConfiguration().WithClientSideCache(NewClientSideCacheConfiguration()).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Empty(t, request.ClientTracking.Prefixes)
+
+	_, err = NewClientConfiguration().
+		WithProtocol(RESP2).
+		WithClientSideCache(NewClientSideCacheConfiguration("config:")).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "RESP3")
+
+	_, err = NewClientConfiguration().
+		WithClientSideCache(NewClientSideCacheConfiguration("config:").WithMaxEntries(0)).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "max entries")
+
+	_, err = NewClusterClientConfiguration().
+		WithClientSideCache(NewClientSideCacheConfiguration("config:").WithTTL(0)).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "TTL")
+
+	_, err = NewClientConfiguration().WithClientSideCache(NewClientSideCacheConfiguration("")).ToProtobuf()
+	assert.ErrorContains(t, err, "prefixes must not be empty")
+}
+
+func TestConfig_KeyPrefix(t *testing.T) {
+	request, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.Empty(t, request.KeyPrefix)
+
+	config := NewClientConfiguration().WithKeyPrefix("tenant1:")
+	assert.Equal(t, "tenant1:", config.GetKeyPrefix())
+	request, err = config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("tenant1:"), request.KeyPrefix)
+
+	request, err = NewClusterClientConfiguration().WithKeyPrefix("{tenant2}:").ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("{tenant2}:"), request.KeyPrefix)
+}
+
+func TestConfig_Interceptors(t *testing.T) {
+	assert.Empty(t, NewClientConfiguration().GetInterceptors())
+
+	interceptor := func(ctx context.Context, cmd models.Command, next Invoker) (any, error) { return next(ctx, cmd) }
+	config := NewClientConfiguration().WithInterceptor(interceptor).WithInterceptor(interceptor)
+	assert.Len(t, config.GetInterceptors(), 2)
+	assert.Len(t, NewClusterClientConfiguration().WithInterceptor(interceptor).GetInterceptors(), 1)
+}
+
+func TestConfig_ConnectionLifecycleHooks(t *testing.T) {
+	assert.Nil(t, NewClientConfiguration().GetConnectionLifecycleHooks())
+
+	var connected string
+	config := NewClientConfiguration().WithConnectionLifecycleHooks(func(address string) { connected = address }, nil, nil)
+	hooks := config.GetConnectionLifecycleHooks()
+	assert.NotNil(t, hooks.OnConnect)
+	hooks.OnConnect("localhost:6379")
+	assert.Equal(t, "localhost:6379", connected)
+	assert.Nil(t, hooks.OnDisconnect)
+	assert.Nil(t, hooks.OnReconnectAttempt)
+
+	clusterHooks := NewClusterClientConfiguration().
+		WithConnectionLifecycleHooks(nil, nil, func(address string, attempt int, err error) {}).
+		GetConnectionLifecycleHooks()
+	assert.Nil(t, clusterHooks.OnConnect)
+	assert.NotNil(t, clusterHooks.OnReconnectAttempt)
+}
@@ -0,0 +1,102 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// ConfigUpdate represents the settings of a running client to update, without recreating the client and dropping its
+// connections. The settings that are not set are left unchanged.
+//
+// Only the request timeout, the read strategy and the reconnect strategy can be updated. The new request timeout applies
+// to the requests sent after the update, and the new reconnect strategy to the reconnections started after the update.
+type ConfigUpdate struct {
+	requestTimeout    *time.Duration
+	readFrom          *ReadFrom
+	clientAZ          string
+	reconnectStrategy *BackoffStrategy
+}
+
+// NewConfigUpdate returns a [ConfigUpdate] that leaves all the settings unchanged. For further configuration, use the
+// [ConfigUpdate] With* methods.
+func NewConfigUpdate() *ConfigUpdate {
+	return &ConfigUpdate{}
+}
+
+// WithRequestTimeout sets the duration that the client should wait for a request to complete, see
+// [ClientConfiguration.WithRequestTimeout]. Must be positive.
+func (update *ConfigUpdate) WithRequestTimeout(requestTimeout time.Duration) *ConfigUpdate {
+	update.requestTimeout = &requestTimeout
+	return update
+}
+
+// WithReadFrom sets the [ReadFrom] strategy of the client.
+//
+// The availability zones of the nodes are only discovered by clients created with an AZ affinity strategy, so only
+// these clients can switch to an AZ affinity strategy, along with [ConfigUpdate.WithClientAZ].
+func (update *ConfigUpdate) WithReadFrom(readFrom ReadFrom) *ConfigUpdate {
+	update.readFrom = &readFrom
+	return update
+}
+
+// WithClientAZ sets the availability zone of the client, required when switching to an AZ affinity read strategy with
+// [ConfigUpdate.WithReadFrom].
+func (update *ConfigUpdate) WithClientAZ(clientAZ string) *ConfigUpdate {
+	update.clientAZ = clientAZ
+	return update
+}
+
+// WithReconnectStrategy sets the [BackoffStrategy] used to determine how and when to reconnect, in case of connection
+// failures.
+func (update *ConfigUpdate) WithReconnectStrategy(strategy *BackoffStrategy) *ConfigUpdate {
+	update.reconnectStrategy = strategy
+	return update
+}
+
+// GetReadFrom returns the new read strategy of the client, and false if it is left unchanged.
+func (update *ConfigUpdate) GetReadFrom() (ReadFrom, bool) {
+	if update.readFrom == nil {
+		return Primary, false
+	}
+	return *update.readFrom, true
+}
+
+func (update *ConfigUpdate) ToProtobuf() (*protobuf.ConfigUpdate, error) {
+	request := protobuf.ConfigUpdate{}
+
+	if update.requestTimeout != nil {
+		if *update.requestTimeout <= 0 {
+			return nil, fmt.Errorf("request timeout must be positive, got %v", *update.requestTimeout)
+		}
+		requestTimeout, err := utils.DurationToMilliseconds(*update.requestTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("setting request timeout returned an error: %w", err)
+		}
+		request.RequestTimeout = &requestTimeout
+	}
+
+	if update.readFrom != nil {
+		readFrom := mapReadFrom(*update.readFrom)
+		if readFrom == protobuf.ReadFrom_AZAffinity || readFrom == protobuf.ReadFrom_AZAffinityReplicasAndPrimary {
+			if update.clientAZ == "" {
+				return nil, errors.New(
+					"client AZ must be set when using AZ affinity or AZ affinity with replicas and primary",
+				)
+			}
+			request.ClientAz = update.clientAZ
+		}
+		request.ReadFrom = &readFrom
+	}
+
+	if update.reconnectStrategy != nil {
+		request.ConnectionRetryStrategy = update.reconnectStrategy.toProtobuf()
+	}
+
+	return &request, nil
+}
@@ -0,0 +1,79 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// DnsResolutionConfiguration represents how the client re-resolves the hostnames of the nodes.
+//
+// By default, a connection to a node stays open as long as the node accepts it, even if the hostname of the node now
+// resolves to another IP, e.g. after a failover of a managed service that updates its DNS records. With a TTL, the
+// hostnames of the connected nodes are resolved again along with the periodic connection checks once their TTL expired,
+// and the nodes whose hostname no longer resolves to the IP of their connection are reconnected to the new IP.
+//
+// In cluster mode, the client connects to each of the IPs the seed addresses resolve to. With prefer hostname, it
+// connects to the seed addresses with their hostname instead, so that reconnecting to them resolves the hostname again
+// rather than dialing a cached IP.
+type DnsResolutionConfiguration struct {
+	ttl            time.Duration
+	preferHostname bool
+}
+
+// NewDnsResolutionConfiguration returns a [DnsResolutionConfiguration] that neither re-resolves hostnames nor prefers
+// them over the resolved IPs.
+func NewDnsResolutionConfiguration() *DnsResolutionConfiguration {
+	return &DnsResolutionConfiguration{}
+}
+
+// WithTTL sets how long a resolved hostname is trusted before it is resolved again. Zero disables the re-resolution.
+// Must be zero or at least a millisecond.
+func (c *DnsResolutionConfiguration) WithTTL(ttl time.Duration) *DnsResolutionConfiguration {
+	c.ttl = ttl
+	return c
+}
+
+// WithPreferHostname sets whether the seed addresses of a cluster are connected with their hostname rather than with
+// the IPs they resolve to.
+func (c *DnsResolutionConfiguration) WithPreferHostname(preferHostname bool) *DnsResolutionConfiguration {
+	c.preferHostname = preferHostname
+	return c
+}
+
+// GetTTL returns how long a resolved hostname is trusted before it is resolved again, or zero if hostnames are not
+// re-resolved.
+func (c *DnsResolutionConfiguration) GetTTL() time.Duration {
+	return c.ttl
+}
+
+// GetPreferHostname returns whether the seed addresses of a cluster are connected with their hostname.
+func (c *DnsResolutionConfiguration) GetPreferHostname() bool {
+	return c.preferHostname
+}
+
+// Validate checks that the TTL is either zero or at least a millisecond.
+func (c *DnsResolutionConfiguration) Validate() error {
+	if c.ttl != 0 && c.ttl < time.Millisecond {
+		return fmt.Errorf("DNS resolution TTL must be zero or at least 1ms, got %v", c.ttl)
+	}
+	return nil
+}
+
+func (c *DnsResolutionConfiguration) toProtobuf() (*protobuf.DnsResolutionConfig, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	ttl, err := utils.DurationToMilliseconds(c.ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &protobuf.DnsResolutionConfig{
+		TtlMs:          ttl,
+		PreferHostname: c.preferHostname,
+	}, nil
+}
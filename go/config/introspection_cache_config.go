@@ -0,0 +1,58 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultIntrospectionCacheMaxEntries is the default maximum number of introspection results kept by the client.
+const DefaultIntrospectionCacheMaxEntries = 64
+
+// IntrospectionCacheConfiguration represents the configuration of the local cache of introspection results.
+//
+// Some frameworks call introspection commands on every request. When the cache is configured, the results of
+// `COMMAND INFO`, `CLUSTER SHARDS` and `INFO SERVER` are kept by the client for the configured time to live, and served
+// without contacting the server. When full, the least recently used result is evicted.
+//
+// Since cached results may be stale for up to the time to live, keep it short, e.g. a few seconds.
+type IntrospectionCacheConfiguration struct {
+	// How long a result is served from the cache.
+	ttl time.Duration
+	// Maximum number of results in the cache.
+	maxEntries int
+}
+
+// NewIntrospectionCacheConfiguration returns an [IntrospectionCacheConfiguration] caching the results for `ttl`, with up
+// to [DefaultIntrospectionCacheMaxEntries] results.
+func NewIntrospectionCacheConfiguration(ttl time.Duration) *IntrospectionCacheConfiguration {
+	return &IntrospectionCacheConfiguration{ttl: ttl, maxEntries: DefaultIntrospectionCacheMaxEntries}
+}
+
+// WithMaxEntries sets the maximum number of results in the cache. Must be positive.
+func (c *IntrospectionCacheConfiguration) WithMaxEntries(maxEntries int) *IntrospectionCacheConfiguration {
+	c.maxEntries = maxEntries
+	return c
+}
+
+// GetTTL returns how long a result is served from the cache.
+func (c *IntrospectionCacheConfiguration) GetTTL() time.Duration {
+	return c.ttl
+}
+
+// GetMaxEntries returns the maximum number of results in the cache.
+func (c *IntrospectionCacheConfiguration) GetMaxEntries() int {
+	return c.maxEntries
+}
+
+// Validate checks that the time to live and the maximum number of entries are positive.
+func (c *IntrospectionCacheConfiguration) Validate() error {
+	if c.ttl <= 0 {
+		return fmt.Errorf("introspection cache TTL must be positive, got %v", c.ttl)
+	}
+	if c.maxEntries <= 0 {
+		return fmt.Errorf("introspection cache max entries must be positive, got %d", c.maxEntries)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ManagerConfig is the declarative configuration of the named clients of a `glide.Manager`, e.g. "cache" and
+// "sessions". It only holds plain fields, so that it can be decoded from YAML, JSON or environment variables.
+//
+// Example YAML:
+//
+//	clients:
+//	  cache:
+//	    addresses: ["cache-0001.example.com:6379"]
+//	    cluster: true
+//	    readFrom: preferReplica
+//	  sessions:
+//	    addresses: ["sessions.example.com:6379"]
+//	    databaseId: 1
+//	    requestTimeout: 500ms
+type ManagerConfig struct {
+	// The configuration of every client, by name.
+	Clients map[string]NamedClientConfig `json:"clients" yaml:"clients"`
+}
+
+// NamedClientConfig is the declarative configuration of a client of a `glide.Manager`. The settings that are not set are
+// left at the default of [ClientConfiguration] or [ClusterClientConfiguration].
+//
+// For the settings not exposed here, create the client with [ClientConfiguration] or [ClusterClientConfiguration] and
+// register it with `glide.Manager.Register`.
+type NamedClientConfig struct {
+	// The addresses of the nodes, as "host:port". If the port is omitted, [DefaultPort] is used. At least one address is
+	// required.
+	Addresses []string `json:"addresses" yaml:"addresses" env:"ADDRESSES"`
+	// Whether the nodes form a cluster, in which case a `glide.ClusterClient` is created instead of a `glide.Client`.
+	Cluster bool `json:"cluster" yaml:"cluster" env:"CLUSTER"`
+	// Whether to connect with TLS, see [ClientConfiguration.WithUseTLS].
+	UseTLS bool `json:"useTLS" yaml:"useTLS" env:"USE_TLS"`
+	// The username to authenticate with. If empty, the default user is used along with Password.
+	Username string `json:"username" yaml:"username" env:"USERNAME"`
+	// The password to authenticate with. If empty, the client does not authenticate.
+	Password string `json:"password" yaml:"password" env:"PASSWORD"`
+	// The name of the client, see [ClientConfiguration.WithClientName].
+	ClientName string `json:"clientName" yaml:"clientName" env:"CLIENT_NAME"`
+	// The database to select, see [ClientConfiguration.WithDatabaseId].
+	DatabaseId int `json:"databaseId" yaml:"databaseId" env:"DATABASE_ID"`
+	// The request timeout, see [ClientConfiguration.WithRequestTimeout].
+	RequestTimeout time.Duration `json:"requestTimeout" yaml:"requestTimeout" env:"REQUEST_TIMEOUT"`
+	// The read strategy, one of "primary", "preferReplica", "azAffinity" or "azAffinityReplicasAndPrimary", see
+	// [ReadFrom]. If empty, [Primary] is used.
+	ReadFrom string `json:"readFrom" yaml:"readFrom" env:"READ_FROM"`
+	// The availability zone of the client, required by the AZ affinity read strategies.
+	ClientAZ string `json:"clientAZ" yaml:"clientAZ" env:"CLIENT_AZ"`
+	// Whether to connect on the first request instead of when the client is created, see
+	// [ClientConfiguration.WithLazyConnect].
+	LazyConnect bool `json:"lazyConnect" yaml:"lazyConnect" env:"LAZY_CONNECT"`
+}
+
+// ParseReadFrom returns the [ReadFrom] strategy named `name`, one of "primary", "preferReplica", "azAffinity" or
+// "azAffinityReplicasAndPrimary". The name is case-insensitive, and an empty name is [Primary].
+func ParseReadFrom(name string) (ReadFrom, error) {
+	switch strings.ToLower(name) {
+	case "", "primary":
+		return Primary, nil
+	case "preferreplica":
+		return PreferReplica, nil
+	case "azaffinity":
+		return AzAffinity, nil
+	case "azaffinityreplicasandprimary":
+		return AzAffinityReplicaAndPrimary, nil
+	}
+	return Primary, fmt.Errorf("unknown read strategy %q", name)
+}
+
+// ToClientConfiguration returns the [ClientConfiguration] of a standalone client.
+func (config NamedClientConfig) ToClientConfiguration() (*ClientConfiguration, error) {
+	if config.Cluster {
+		return nil, errors.New("the configuration is of a cluster client")
+	}
+	readFrom, addresses, err := config.parse()
+	if err != nil {
+		return nil, err
+	}
+	result := NewClientConfiguration().
+		WithUseTLS(config.UseTLS).
+		WithLazyConnect(config.LazyConnect).
+		WithReadFrom(readFrom).
+		WithClientAZ(config.ClientAZ).
+		WithClientName(config.ClientName).
+		WithRequestTimeout(config.RequestTimeout)
+	for i := range addresses {
+		result.WithAddress(&addresses[i])
+	}
+	if credentials := config.credentials(); credentials != nil {
+		result.WithCredentials(credentials)
+	}
+	if config.DatabaseId != 0 {
+		result.WithDatabaseId(config.DatabaseId)
+	}
+	return result, nil
+}
+
+// ToClusterClientConfiguration returns the [ClusterClientConfiguration] of a cluster client.
+func (config NamedClientConfig) ToClusterClientConfiguration() (*ClusterClientConfiguration, error) {
+	if !config.Cluster {
+		return nil, errors.New("the configuration is of a standalone client")
+	}
+	readFrom, addresses, err := config.parse()
+	if err != nil {
+		return nil, err
+	}
+	result := NewClusterClientConfiguration().
+		WithUseTLS(config.UseTLS).
+		WithLazyConnect(config.LazyConnect).
+		WithReadFrom(readFrom).
+		WithClientAZ(config.ClientAZ).
+		WithClientName(config.ClientName).
+		WithRequestTimeout(config.RequestTimeout)
+	for i := range addresses {
+		result.WithAddress(&addresses[i])
+	}
+	if credentials := config.credentials(); credentials != nil {
+		result.WithCredentials(credentials)
+	}
+	if config.DatabaseId != 0 {
+		result.WithDatabaseId(config.DatabaseId)
+	}
+	return result, nil
+}
+
+// parse validates the configuration, and returns the parsed read strategy and addresses.
+func (config NamedClientConfig) parse() (ReadFrom, []NodeAddress, error) {
+	if len(config.Addresses) == 0 {
+		return Primary, nil, errors.New("at least one address is required")
+	}
+	if config.RequestTimeout < 0 {
+		return Primary, nil, fmt.Errorf("request timeout must not be negative, got %v", config.RequestTimeout)
+	}
+	readFrom, err := ParseReadFrom(config.ReadFrom)
+	if err != nil {
+		return Primary, nil, err
+	}
+	addresses := make([]NodeAddress, 0, len(config.Addresses))
+	for _, address := range config.Addresses {
+		parsed, err := parseNodeAddress(address)
+		if err != nil {
+			return Primary, nil, err
+		}
+		addresses = append(addresses, parsed)
+	}
+	return readFrom, addresses, nil
+}
+
+func (config NamedClientConfig) credentials() *ServerCredentials {
+	if config.Password == "" {
+		return nil
+	}
+	if config.Username == "" {
+		return NewServerCredentialsWithDefaultUsername(config.Password)
+	}
+	return NewServerCredentials(config.Username, config.Password)
+}
+
+// parseNodeAddress parses a "host:port" address, where the port is optional.
+func parseNodeAddress(address string) (NodeAddress, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		// No port, or an IPv6 address without brackets and port.
+		if strings.Trim(address, "[]") == "" {
+			return NodeAddress{}, fmt.Errorf("invalid address %q", address)
+		}
+		return NodeAddress{Host: strings.Trim(address, "[]"), Port: DefaultPort}, nil
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil || portNumber <= 0 || portNumber > 65535 {
+		return NodeAddress{}, fmt.Errorf("invalid port in address %q", address)
+	}
+	if host == "" {
+		host = DefaultHost
+	}
+	return NodeAddress{Host: host, Port: portNumber}, nil
+}
@@ -49,6 +49,25 @@ func (config *BaseSubscriptionConfig) GetContext() any {
 	return config.context
 }
 
+// PubSubBackpressurePolicy is what the client does with a received message while its queue of messages is full, see
+// [ClientConfiguration.WithPubSubBackpressure].
+type PubSubBackpressurePolicy int
+
+const (
+	// DropOldest drops the oldest message of the queue to make room for the received message.
+	DropOldest PubSubBackpressurePolicy = iota
+	// DropNewest drops the received message.
+	DropNewest
+	// BlockPublisherEvents stops the delivery of the push notifications received by the client until the queue has room,
+	// so that the messages wait on the connections. The server eventually disconnects the client if its output buffer
+	// limit for Pub/Sub clients is reached.
+	BlockPublisherEvents
+)
+
+func (policy PubSubBackpressurePolicy) String() string {
+	return [...]string{"DROP_OLDEST", "DROP_NEWEST", "BLOCK_PUBLISHER_EVENTS"}[policy]
+}
+
 // *** StandaloneSubscriptionConfig ***
 
 type PubSubChannelMode int
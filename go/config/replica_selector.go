@@ -0,0 +1,173 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Replica is a replica a read-only command can be sent to, see [ReplicaSelector].
+type Replica struct {
+	// The address of the replica, e.g. "10.0.0.2:6379".
+	Address string
+	// The availability zone of the replica, as set by its `availability-zone` configuration, or empty if it is not set or
+	// if the server is older than Valkey 8.0.
+	AvailabilityZone string
+}
+
+// ReplicaSelector picks the replica a read-only command is sent to, among the replicas of the shard of its keys, see
+// [ClusterClientConfiguration.WithReplicaSelector]. It is called concurrently, and must be safe for concurrent use.
+//
+// The selectors provided are [NewRoundRobinReplicaSelector], [NewLeastOutstandingReplicaSelector],
+// [NewLowestLatencyReplicaSelector] and [NewAZAffinityReplicaSelector], which can be combined with the others.
+type ReplicaSelector interface {
+	// Select returns the index in `replicas` of the replica to send a read to. `replicas` is never empty. An index out of
+	// the range of `replicas` lets the client route the read as set by its [ReadFrom] strategy.
+	Select(replicas []Replica) int
+	// Done is called once the read sent to `replica`, as selected by Select, completed, with its round trip time and its
+	// error, if any.
+	Done(replica Replica, latency time.Duration, err error)
+}
+
+type roundRobinReplicaSelector struct {
+	next atomic.Uint64
+}
+
+// NewRoundRobinReplicaSelector returns a [ReplicaSelector] sending the reads to the replicas of a shard in turn.
+func NewRoundRobinReplicaSelector() ReplicaSelector {
+	return &roundRobinReplicaSelector{}
+}
+
+func (s *roundRobinReplicaSelector) Select(replicas []Replica) int {
+	return int((s.next.Add(1) - 1) % uint64(len(replicas)))
+}
+
+func (s *roundRobinReplicaSelector) Done(Replica, time.Duration, error) {}
+
+type leastOutstandingReplicaSelector struct {
+	roundRobin roundRobinReplicaSelector
+	// The number of reads sent to every replica that did not complete yet, by address.
+	outstanding sync.Map
+}
+
+// NewLeastOutstandingReplicaSelector returns a [ReplicaSelector] sending the reads to the replica of a shard with the
+// fewest reads in flight, so that a slow replica receives fewer reads. The ties are broken in turn.
+func NewLeastOutstandingReplicaSelector() ReplicaSelector {
+	return &leastOutstandingReplicaSelector{}
+}
+
+func (s *leastOutstandingReplicaSelector) Select(replicas []Replica) int {
+	start := s.roundRobin.Select(replicas)
+	selected := start
+	var fewest int64 = -1
+	for i := range replicas {
+		index := (start + i) % len(replicas)
+		if outstanding := s.counter(replicas[index]).Load(); fewest < 0 || outstanding < fewest {
+			selected, fewest = index, outstanding
+		}
+	}
+	s.counter(replicas[selected]).Add(1)
+	return selected
+}
+
+func (s *leastOutstandingReplicaSelector) Done(replica Replica, _ time.Duration, _ error) {
+	s.counter(replica).Add(-1)
+}
+
+func (s *leastOutstandingReplicaSelector) counter(replica Replica) *atomic.Int64 {
+	counter, _ := s.outstanding.LoadOrStore(replica.Address, &atomic.Int64{})
+	return counter.(*atomic.Int64)
+}
+
+const (
+	// lowestLatencySmoothing is the weight of the latest round trip time in the average latency of a replica.
+	lowestLatencySmoothing = 0.2
+	// lowestLatencyErrorPenalty is the round trip time recorded for a failed read.
+	lowestLatencyErrorPenalty = time.Second
+)
+
+type lowestLatencyReplicaSelector struct {
+	roundRobin roundRobinReplicaSelector
+	mu         sync.Mutex
+	// The moving average of the round trip times of every replica, by address.
+	latencies map[string]time.Duration
+}
+
+// NewLowestLatencyReplicaSelector returns a [ReplicaSelector] sending the reads to the replica of a shard with the lowest
+// moving average of the round trip times of its reads. A failed read counts as a round trip of a second. The replicas
+// without a read yet are selected first, so that every replica is measured. The ties are broken in turn.
+func NewLowestLatencyReplicaSelector() ReplicaSelector {
+	return &lowestLatencyReplicaSelector{latencies: map[string]time.Duration{}}
+}
+
+func (s *lowestLatencyReplicaSelector) Select(replicas []Replica) int {
+	start := s.roundRobin.Select(replicas)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	selected := start
+	var lowest time.Duration = -1
+	for i := range replicas {
+		index := (start + i) % len(replicas)
+		latency, ok := s.latencies[replicas[index].Address]
+		if !ok {
+			return index
+		}
+		if lowest < 0 || latency < lowest {
+			selected, lowest = index, latency
+		}
+	}
+	return selected
+}
+
+func (s *lowestLatencyReplicaSelector) Done(replica Replica, latency time.Duration, err error) {
+	if err != nil {
+		latency = lowestLatencyErrorPenalty
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if average, ok := s.latencies[replica.Address]; ok {
+		latency = average + time.Duration(lowestLatencySmoothing*float64(latency-average))
+	}
+	s.latencies[replica.Address] = latency
+}
+
+type azAffinityReplicaSelector struct {
+	availabilityZone string
+	next             ReplicaSelector
+}
+
+// NewAZAffinityReplicaSelector returns a [ReplicaSelector] sending the reads to the replicas of a shard in the
+// availability zone `availabilityZone`, e.g. the zone of the client, picked by `next`. If no replica of the shard is in
+// the zone, `next` picks among all of them.
+//
+// Example:
+//
+//	selector := config.NewAZAffinityReplicaSelector("us-east-1a", config.NewLeastOutstandingReplicaSelector())
+func NewAZAffinityReplicaSelector(availabilityZone string, next ReplicaSelector) ReplicaSelector {
+	return &azAffinityReplicaSelector{availabilityZone: availabilityZone, next: next}
+}
+
+func (s *azAffinityReplicaSelector) Select(replicas []Replica) int {
+	var local []Replica
+	var indexes []int
+	for i, replica := range replicas {
+		if replica.AvailabilityZone == s.availabilityZone {
+			local = append(local, replica)
+			indexes = append(indexes, i)
+		}
+	}
+	if len(local) == 0 {
+		return s.next.Select(replicas)
+	}
+	selected := s.next.Select(local)
+	if selected < 0 || selected >= len(local) {
+		return -1
+	}
+	return indexes[selected]
+}
+
+func (s *azAffinityReplicaSelector) Done(replica Replica, latency time.Duration, err error) {
+	s.next.Done(replica, latency, err)
+}
@@ -0,0 +1,65 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testReplicas = []Replica{
+	{Address: "10.0.0.1:6379", AvailabilityZone: "az1"},
+	{Address: "10.0.0.2:6379", AvailabilityZone: "az2"},
+	{Address: "10.0.0.3:6379", AvailabilityZone: "az2"},
+}
+
+func TestRoundRobinReplicaSelector(t *testing.T) {
+	selector := NewRoundRobinReplicaSelector()
+	var selected []int
+	for i := 0; i < 4; i++ {
+		selected = append(selected, selector.Select(testReplicas))
+	}
+	assert.Equal(t, []int{0, 1, 2, 0}, selected)
+}
+
+func TestLeastOutstandingReplicaSelector(t *testing.T) {
+	selector := NewLeastOutstandingReplicaSelector()
+	assert.Equal(t, 0, selector.Select(testReplicas))
+	assert.Equal(t, 1, selector.Select(testReplicas))
+	assert.Equal(t, 2, selector.Select(testReplicas))
+
+	// The reads of the second replica completed
+	selector.Done(testReplicas[1], time.Millisecond, nil)
+	assert.Equal(t, 1, selector.Select(testReplicas))
+	selector.Done(testReplicas[0], time.Millisecond, nil)
+	selector.Done(testReplicas[2], time.Millisecond, nil)
+	assert.Equal(t, 2, selector.Select(testReplicas))
+}
+
+func TestLowestLatencyReplicaSelector(t *testing.T) {
+	selector := NewLowestLatencyReplicaSelector()
+	// The replicas without a read are selected first
+	for i := range testReplicas {
+		index := selector.Select(testReplicas)
+		assert.Equal(t, i, index)
+		selector.Done(testReplicas[index], time.Duration(3-i)*time.Millisecond, nil)
+	}
+	assert.Equal(t, 2, selector.Select(testReplicas))
+
+	// A failure counts as a slow read
+	selector.Done(testReplicas[2], time.Millisecond, errors.New("timeout"))
+	assert.Equal(t, 1, selector.Select(testReplicas))
+}
+
+func TestAZAffinityReplicaSelector(t *testing.T) {
+	selector := NewAZAffinityReplicaSelector("az2", NewRoundRobinReplicaSelector())
+	assert.Equal(t, 1, selector.Select(testReplicas))
+	assert.Equal(t, 2, selector.Select(testReplicas))
+	assert.Equal(t, 1, selector.Select(testReplicas))
+
+	// No replica in the zone
+	assert.Equal(t, 0, selector.Select(testReplicas[:1]))
+}
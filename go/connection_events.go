@@ -0,0 +1,96 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// Registry of the connection lifecycle hooks of the clients, by the context passed to the core when the client is created.
+// Unlike the client pointers, the contexts are known before the initial connections are established.
+var (
+	connectionEventsRegistry   = make(map[uintptr]*connectionEvents)
+	connectionEventsRegistryMu sync.RWMutex
+	lastConnectionEventsID     uintptr
+)
+
+// connectionEvents calls the connection lifecycle hooks of a client in the order of the events, from a goroutine of its
+// own, so that the threads of the core are not blocked by the hooks.
+type connectionEvents struct {
+	hooks   config.ConnectionLifecycleHooks
+	mu      sync.Mutex
+	pending []func()
+	running bool
+}
+
+// registerConnectionEvents registers `hooks` and returns the context identifying them to the core.
+func registerConnectionEvents(hooks *config.ConnectionLifecycleHooks) uintptr {
+	connectionEventsRegistryMu.Lock()
+	defer connectionEventsRegistryMu.Unlock()
+	lastConnectionEventsID++
+	connectionEventsRegistry[lastConnectionEventsID] = &connectionEvents{hooks: *hooks}
+	return lastConnectionEventsID
+}
+
+// unregisterConnectionEvents removes the hooks identified by `id` from the registry. The events reported afterwards are
+// dropped.
+func unregisterConnectionEvents(id uintptr) {
+	connectionEventsRegistryMu.Lock()
+	defer connectionEventsRegistryMu.Unlock()
+	delete(connectionEventsRegistry, id)
+}
+
+func getConnectionEvents(id uintptr) *connectionEvents {
+	connectionEventsRegistryMu.RLock()
+	defer connectionEventsRegistryMu.RUnlock()
+	return connectionEventsRegistry[id]
+}
+
+// dispatch queues `hook` after the hooks of the previous events, starting a goroutine to call them if none is running.
+func (events *connectionEvents) dispatch(hook func()) {
+	events.mu.Lock()
+	defer events.mu.Unlock()
+	events.pending = append(events.pending, hook)
+	if !events.running {
+		events.running = true
+		go events.run()
+	}
+}
+
+func (events *connectionEvents) run() {
+	for {
+		events.mu.Lock()
+		if len(events.pending) == 0 {
+			events.running = false
+			events.mu.Unlock()
+			return
+		}
+		hook := events.pending[0]
+		events.pending = events.pending[1:]
+		events.mu.Unlock()
+		hook()
+	}
+}
+
+// handle dispatches the event of kind `kind` to the matching hook, if set. `err` is nil if the event has no error.
+func (events *connectionEvents) handle(kind C.ConnectionEventKind, address string, attempt int, err error) {
+	switch kind {
+	case C.ConnectionEventConnected:
+		if onConnect := events.hooks.OnConnect; onConnect != nil {
+			events.dispatch(func() { onConnect(address) })
+		}
+	case C.ConnectionEventDisconnected:
+		if onDisconnect := events.hooks.OnDisconnect; onDisconnect != nil {
+			events.dispatch(func() { onDisconnect(address, err) })
+		}
+	case C.ConnectionEventReconnectAttempt:
+		if onReconnectAttempt := events.hooks.OnReconnectAttempt; onReconnectAttempt != nil {
+			events.dispatch(func() { onReconnectAttempt(address, attempt, err) })
+		}
+	}
+}
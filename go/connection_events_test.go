@@ -0,0 +1,33 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectionEvents_Dispatch(t *testing.T) {
+	events := &connectionEvents{}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var calls []int
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		events.dispatch(func() {
+			defer wg.Done()
+			mu.Lock()
+			defer mu.Unlock()
+			calls = append(calls, i)
+		})
+	}
+	wg.Wait()
+
+	// The hooks are called in the order of the events
+	for i, call := range calls {
+		assert.Equal(t, i, call)
+	}
+	assert.Len(t, calls, 100)
+}
@@ -138,3 +138,51 @@ func ExampleClusterClient_ClientGetNameWithOptions() {
 
 	// Output: true
 }
+
+func ExampleClusterClient_ClientInfo() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.ClientInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Cmd)
+
+	// Output: client|info
+}
+
+func ExampleClusterClient_ClientInfoWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.ClientInfoWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsMultiValue())
+
+	// Output: true
+}
+
+func ExampleClusterClient_ClientTrackingInfo() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.ClientTrackingInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Flags)
+
+	// Output: [off]
+}
+
+func ExampleClusterClient_Hello() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.Hello(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Proto)
+	fmt.Println(result.Mode)
+
+	// Output:
+	// 3
+	// cluster
+}
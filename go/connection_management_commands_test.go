@@ -80,3 +80,42 @@ func ExampleClient_ClientGetName() {
 
 	// Output: true
 }
+
+func ExampleClient_ClientInfo() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.ClientInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Cmd)
+
+	// Output: client|info
+}
+
+func ExampleClient_ClientTrackingInfo() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.ClientTrackingInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Flags)
+	fmt.Println(result.Redirect)
+
+	// Output:
+	// [off]
+	// -1
+}
+
+func ExampleClient_Hello() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.Hello(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Proto)
+	fmt.Println(result.Mode)
+
+	// Output:
+	// 3
+	// standalone
+}
@@ -34,6 +34,8 @@ const (
 	ForceKeyword        string = "FORCE"      // ValKey API string to designate FORCE
 	JustIdKeyword       string = "JUSTID"     // ValKey API string to designate JUSTID
 	EntriesReadKeyword  string = "ENTRIESREAD"
+	EntriesAddedKeyword string = "ENTRIESADDED"
+	MaxDeletedIdKeyword string = "MAXDELETEDID"
 	MakeStreamKeyword   string = "MKSTREAM"
 	NoMakeStreamKeyword string = "NOMKSTREAM"
 	BlockKeyword        string = "BLOCK"
@@ -44,6 +46,7 @@ const (
 	StreamsKeyword      string = "STREAMS"
 	WithCodeKeyword     string = "WITHCODE"
 	LibraryNameKeyword  string = "LIBRARYNAME"
+	IdsKeyword          string = "IDS"
 )
 
 type InfBoundary string
@@ -325,3 +328,18 @@ const (
 	// in case of name collisions. Note that this policy doesn't prevent function name collisions, only libraries.
 	ReplacePolicy FunctionRestorePolicy = "REPLACE"
 )
+
+// StreamDeletionPolicy defines how `XDelEx` and `XAckDel` handle the references to the deleted entries in the pending
+// entries lists of the consumer groups of the stream.
+// See https://valkey.io/commands/xdelex/ for details.
+type StreamDeletionPolicy string
+
+const (
+	// KeepRefPolicy deletes the entries, and keeps their references in the pending entries lists, like `XDEL`.
+	// This is the default policy.
+	KeepRefPolicy StreamDeletionPolicy = "KEEPREF"
+	// DelRefPolicy deletes the entries, and removes their references from the pending entries lists.
+	DelRefPolicy StreamDeletionPolicy = "DELREF"
+	// AckedPolicy only deletes the entries that were acknowledged by all the consumer groups.
+	AckedPolicy StreamDeletionPolicy = "ACKED"
+)
@@ -0,0 +1,166 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package counter provides integer counters stored in Valkey, optionally sharded across several keys for very hot
+// counters.
+package counter
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+// Counter is an integer counter stored in Valkey.
+//
+// By default, the counter is stored in a single key, incremented with `INCRBY`. A very hot counter may bottleneck the node
+// holding its key: with [Counter.WithShards], the counter is split across several keys, every increment goes to one of
+// them at random, and reading the counter sums them. In cluster mode, the keys of the shards are spread across the slots,
+// unless the key of the counter has a hash tag.
+//
+// Example:
+//
+//	hits := counter.New(client, "page:hits").WithShards(8).WithExpiry(24 * time.Hour)
+//	err := hits.Add(ctx, 1)
+//	...
+//	total, err := hits.Get(ctx)
+type Counter struct {
+	client interfaces.BaseClientCommands
+	key    string
+	// The keys of the shards, or only the key of the counter if it is not sharded.
+	keys   []string
+	expiry time.Duration
+}
+
+// New creates a [Counter] stored at `key`, neither sharded nor expiring.
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the counter.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands, key string) *Counter {
+	return &Counter{client: client, key: key, keys: []string{key}}
+}
+
+// WithShards splits the counter across `shards` keys, named after the key of the counter followed by ":" and the index of
+// the shard, e.g. "hits:0" to "hits:7". A counter with at most one shard is stored at its key.
+//
+// The value of a counter is not carried over when changing its number of shards.
+func (c *Counter) WithShards(shards int) *Counter {
+	if shards <= 1 {
+		c.keys = []string{c.key}
+		return c
+	}
+	c.keys = make([]string, shards)
+	for i := range c.keys {
+		c.keys[i] = c.key + ":" + strconv.Itoa(i)
+	}
+	return c
+}
+
+// WithExpiry sets the time to live of the counter. The expiry is set when an increment creates a key of the counter, so
+// that the counter expires `expiry` after its first increment rather than after its last one. Zero, the default, never
+// expires the counter.
+func (c *Counter) WithExpiry(expiry time.Duration) *Counter {
+	c.expiry = expiry
+	return c
+}
+
+// Keys returns the keys the counter is stored at.
+func (c *Counter) Keys() []string {
+	return append([]string(nil), c.keys...)
+}
+
+// Add increments the counter by `amount`, or decrements it if `amount` is negative.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	amount - The amount to add to the counter.
+//
+// Return value:
+//
+//	An error if the counter could not be incremented, e.g. if one of its keys holds a value that is not an integer.
+func (c *Counter) Add(ctx context.Context, amount int64) error {
+	key := c.keys[0]
+	if len(c.keys) > 1 {
+		key = c.keys[rand.Intn(len(c.keys))]
+	}
+	value, err := c.client.IncrBy(ctx, key, amount)
+	if err != nil {
+		return err
+	}
+	// INCRBY returns the amount itself when it creates the key.
+	if c.expiry > 0 && value == amount {
+		_, err = c.client.PExpire(ctx, key, c.expiry)
+	}
+	return err
+}
+
+// Get returns the value of the counter, i.e. the sum of its shards. A counter that was never incremented is zero.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The value of the counter.
+func (c *Counter) Get(ctx context.Context) (int64, error) {
+	values, err := c.client.MGet(ctx, c.keys)
+	if err != nil {
+		return 0, err
+	}
+	var sum int64
+	for i, value := range values {
+		if value.IsNil() {
+			continue
+		}
+		n, err := strconv.ParseInt(value.Value(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("counter key %q holds a non-integer value: %w", c.keys[i], err)
+		}
+		sum += n
+	}
+	return sum, nil
+}
+
+// Reset deletes the keys of the counter, and returns its value before the reset. Every key is read and deleted
+// atomically with `GETDEL`, so that no increment is lost: an increment is either counted in the returned value, or in the
+// counter after the reset.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The value of the counter before the reset. If an error is returned, the value of the shards reset before the error.
+func (c *Counter) Reset(ctx context.Context) (int64, error) {
+	var sum int64
+	for _, key := range c.keys {
+		value, err := c.client.GetDel(ctx, key)
+		if err != nil {
+			return sum, err
+		}
+		if value.IsNil() {
+			continue
+		}
+		n, err := strconv.ParseInt(value.Value(), 10, 64)
+		if err != nil {
+			return sum, fmt.Errorf("counter key %q held a non-integer value: %w", key, err)
+		}
+		sum += n
+	}
+	return sum, nil
+}
@@ -0,0 +1,85 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package counter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+func TestCounter(t *testing.T) {
+	client := fakeclient.New()
+	counter := New(client, "hits")
+	assert.Equal(t, []string{"hits"}, counter.Keys())
+
+	value, err := counter.Get(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, value)
+
+	require.NoError(t, counter.Add(context.Background(), 5))
+	require.NoError(t, counter.Add(context.Background(), -2))
+	value, err = counter.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), value)
+	assert.Empty(t, client.Expiries)
+
+	value, err = counter.Reset(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), value)
+	value, err = counter.Get(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, value)
+}
+
+func TestCounter_Sharded(t *testing.T) {
+	client := fakeclient.New()
+	counter := New(client, "hits").WithShards(4)
+	assert.Equal(t, []string{"hits:0", "hits:1", "hits:2", "hits:3"}, counter.Keys())
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, counter.Add(context.Background(), 1))
+	}
+	// The increments are spread across the shards
+	assert.Greater(t, len(client.Strings), 1)
+	value, err := counter.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), value)
+
+	value, err = counter.Reset(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), value)
+	assert.Empty(t, client.Strings)
+
+	assert.Equal(t, []string{"hits"}, counter.WithShards(1).Keys())
+}
+
+func TestCounter_Expiry(t *testing.T) {
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	client := fakeclient.New().WithClock(fake)
+	counter := New(client, "hits").WithExpiry(time.Minute)
+
+	require.NoError(t, counter.Add(context.Background(), 1))
+	assert.Equal(t, fake.Now().Add(time.Minute), client.Expiries["hits"])
+
+	// The expiry is only set when the key is created
+	client.Expiries["hits"] = fake.Now().Add(time.Second)
+	require.NoError(t, counter.Add(context.Background(), 1))
+	assert.Equal(t, fake.Now().Add(time.Second), client.Expiries["hits"])
+}
+
+func TestCounter_NonIntegerValue(t *testing.T) {
+	client := fakeclient.New()
+	counter := New(client, "hits")
+	client.Strings["hits"] = "abc"
+
+	_, err := counter.Get(context.Background())
+	assert.ErrorContains(t, err, "non-integer")
+	_, err = counter.Reset(context.Background())
+	assert.ErrorContains(t, err, "non-integer")
+}
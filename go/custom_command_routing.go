@@ -0,0 +1,81 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// readOnlyRoute marks a command as read-only, so that the client routes it like the read-only commands it knows, e.g. to
+// a replica when reading from replicas, while still deriving the node from the arguments of the command.
+type readOnlyRoute struct{}
+
+func (readOnlyRoute) IsMultiNode() bool {
+	return false
+}
+
+// readsFromReplica returns whether the read strategy `readFrom` may send read-only commands to replicas.
+func readsFromReplica(readFrom config.ReadFrom) bool {
+	return readFrom != config.Primary
+}
+
+// customCommandRoute returns the route of a custom command sent without an explicit route. The client only knows the
+// read-only commands of the server and of the official modules, and sends the other commands to primaries. A custom
+// command is marked as read-only if it was hinted so, or if the client reads from replicas and the command table of the
+// server flags it as read-only.
+func (client *baseClient) customCommandRoute(
+	ctx context.Context,
+	args []string,
+	opts options.CustomCommandOptions,
+) config.Route {
+	if opts.ReadOnlyHint || (client.readFromReplica.Load() && client.isReadOnlyCustomCommand(ctx, args)) {
+		return readOnlyRoute{}
+	}
+	return nil
+}
+
+// isReadOnlyCustomCommand returns whether the command table of the server flags the command `args` as read-only.
+func (client *baseClient) isReadOnlyCustomCommand(ctx context.Context, args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	info, ok := client.cachedCommandInfo(ctx, args[0])
+	return ok && isReadOnlyCommand(info, args)
+}
+
+// cachedCommandInfo returns the command table entry of the command `name`, and false if it could not be looked up. The
+// entry of every command is looked up once with [Client.CommandInfo], and kept for the lifetime of the client.
+func (client *baseClient) cachedCommandInfo(ctx context.Context, name string) (models.CommandInfo, bool) {
+	name = strings.ToLower(name)
+	info, ok := client.customCommandInfo.Load(name)
+	if !ok {
+		commandInfo, err := client.CommandInfo(ctx, []string{name})
+		if err != nil {
+			// Looked up again by the next call.
+			return models.CommandInfo{}, false
+		}
+		// Unknown commands are kept as well, with no flags, so that they are not looked up again.
+		info, _ = client.customCommandInfo.LoadOrStore(name, commandInfo[name])
+	}
+	return info.(models.CommandInfo), true
+}
+
+// isReadOnlyCommand returns whether the command table entry `info` flags the command `args` as read-only. The entry of
+// the subcommand is used for container commands, such as `CONFIG GET`.
+func isReadOnlyCommand(info models.CommandInfo, args []string) bool {
+	if len(args) > 1 {
+		subcommand := info.Name + "|" + strings.ToLower(args[1])
+		for _, sub := range info.Subcommands {
+			if sub.Name == subcommand {
+				return slices.Contains(sub.Flags, "readonly")
+			}
+		}
+	}
+	return slices.Contains(info.Flags, "readonly")
+}
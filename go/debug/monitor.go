@@ -0,0 +1,308 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package debug provides tools to inspect the traffic of a server and the routing of a client, meant for debugging and not
+// for production use.
+package debug
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+)
+
+// defaultConnectionTimeout is the timeout of the connection to the server, unless the configuration sets one.
+const defaultConnectionTimeout = 2 * time.Second
+
+// MonitorLine is a command processed by the server, as reported by `MONITOR`.
+type MonitorLine struct {
+	// The time the command was processed at, with a microsecond precision.
+	Time time.Time
+	// The database the command was run against.
+	Database int
+	// The address of the client that sent the command, e.g. "127.0.0.1:60866", "unix:/tmp/valkey.sock", or "lua" for the
+	// commands called by scripts.
+	ClientAddress string
+	// The name and the arguments of the command.
+	Args []string
+}
+
+// MonitorOptions are the optional arguments of [Monitor].
+type MonitorOptions struct {
+	// Must be true. `MONITOR` makes the server format and send every command it processes, which can halve its throughput,
+	// and exposes the arguments of all the commands, including the values and the credentials. Setting this acknowledges
+	// it.
+	EnableDangerousCommands bool
+	// The address of the server to monitor. If nil, the first address of the configuration is monitored.
+	Address *config.NodeAddress
+}
+
+// Monitor streams the commands processed by a server to `handler`, until `ctx` is cancelled or the connection fails.
+//
+// `MONITOR` turns the connection into a stream, so it cannot run on the connections of a client, which are shared by
+// concurrent requests. Instead, Monitor opens a dedicated connection, with the address, the TLS settings and the
+// credentials of `cfg`, and closes it when it returns. In cluster mode, every node reports its own commands only: monitor
+// a node by passing its address in `opts`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the monitoring. Cancelling it stops the monitoring.
+//	cfg - The configuration of the connection, typically the one of the client to debug.
+//	handler - Called with every command processed by the server, in order, from the goroutine calling Monitor.
+//	opts - Must enable the dangerous commands, see [MonitorOptions].
+//
+// Return value:
+//
+//	The error that stopped the monitoring: the error of `ctx` once it is cancelled, or the connection error.
+func Monitor(ctx context.Context, cfg *config.ClientConfiguration, handler func(MonitorLine), opts MonitorOptions) error {
+	if !opts.EnableDangerousCommands {
+		return errors.New(
+			"MONITOR degrades the performance of the server and exposes all the commands it processes, " +
+				"set MonitorOptions.EnableDangerousCommands to run it",
+		)
+	}
+	request, err := cfg.ToProtobuf()
+	if err != nil {
+		return err
+	}
+	conn, err := dial(ctx, request, opts.Address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Closing the connection unblocks the reads once `ctx` is cancelled.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	reader := bufio.NewReader(conn)
+	if err := handshake(conn, reader, request); err != nil {
+		return contextError(ctx, err)
+	}
+	for {
+		line, err := readSimpleString(reader)
+		if err != nil {
+			return contextError(ctx, err)
+		}
+		parsed, err := parseMonitorLine(line)
+		if err != nil {
+			return err
+		}
+		handler(parsed)
+	}
+}
+
+// contextError returns the error of `ctx` if it is cancelled, as the error of the connection is then caused by closing it.
+func contextError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func dial(ctx context.Context, request *protobuf.ConnectionRequest, address *config.NodeAddress) (net.Conn, error) {
+	var host string
+	var port uint32
+	if address != nil {
+		host, port = address.Host, uint32(address.Port)
+	} else if len(request.Addresses) > 0 {
+		host, port = request.Addresses[0].Host, request.Addresses[0].Port
+	} else {
+		return nil, errors.New("no address to monitor")
+	}
+	if host == "" {
+		host = config.DefaultHost
+	}
+	if port == 0 {
+		port = config.DefaultPort
+	}
+
+	timeout := defaultConnectionTimeout
+	if request.ConnectionTimeout != 0 {
+		timeout = time.Duration(request.ConnectionTimeout) * time.Millisecond
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	target := net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10))
+	if request.TlsMode == protobuf.TlsMode_NoTls {
+		return dialer.DialContext(ctx, "tcp", target)
+	}
+
+	tlsConfig := &tls.Config{ServerName: host, InsecureSkipVerify: request.TlsMode == protobuf.TlsMode_InsecureTls}
+	if len(request.RootCerts) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		for _, certs := range request.RootCerts {
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(certs) {
+				return nil, errors.New("invalid root certificates")
+			}
+		}
+	}
+	return (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", target)
+}
+
+// handshake authenticates the connection, names it after the client, and starts the monitoring.
+func handshake(conn net.Conn, reader *bufio.Reader, request *protobuf.ConnectionRequest) error {
+	var commands [][]string
+	if auth := request.AuthenticationInfo; auth != nil {
+		if auth.IamCredentials != nil {
+			return errors.New("monitoring with IAM authentication is not supported")
+		}
+		if auth.Password != "" {
+			if auth.Username != "" {
+				commands = append(commands, []string{"AUTH", auth.Username, auth.Password})
+			} else {
+				commands = append(commands, []string{"AUTH", auth.Password})
+			}
+		}
+	}
+	if request.ClientName != "" {
+		commands = append(commands, []string{"CLIENT", "SETNAME", request.ClientName})
+	}
+	commands = append(commands, []string{"MONITOR"})
+
+	for _, command := range commands {
+		if _, err := conn.Write(encodeCommand(command)); err != nil {
+			return err
+		}
+		if _, err := readSimpleString(reader); err != nil {
+			return fmt.Errorf("%s failed: %w", command[0], err)
+		}
+	}
+	return nil
+}
+
+// encodeCommand encodes `args` as a RESP array of bulk strings.
+func encodeCommand(args []string) []byte {
+	var builder strings.Builder
+	builder.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		builder.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n" + arg + "\r\n")
+	}
+	return []byte(builder.String())
+}
+
+// readSimpleString reads a RESP simple string, the type of the replies to the handshake and of the monitored commands,
+// and returns the RESP errors as errors.
+func readSimpleString(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+	if len(line) == 0 {
+		return "", errors.New("unexpected empty reply")
+	}
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return "", errors.New(line[1:])
+	default:
+		return "", fmt.Errorf("unexpected reply %q", line)
+	}
+}
+
+// parseMonitorLine parses a line reported by `MONITOR`, e.g. `1339518083.107412 [0 127.0.0.1:60866] "set" "key" "va\"l"`.
+func parseMonitorLine(line string) (MonitorLine, error) {
+	timestamp, rest, found := strings.Cut(line, " [")
+	if !found {
+		return MonitorLine{}, fmt.Errorf("malformed MONITOR line %q", line)
+	}
+	source, args, found := strings.Cut(rest, "] ")
+	if !found {
+		return MonitorLine{}, fmt.Errorf("malformed MONITOR line %q", line)
+	}
+	seconds, micros, _ := strings.Cut(timestamp, ".")
+	sec, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return MonitorLine{}, fmt.Errorf("malformed MONITOR timestamp %q", timestamp)
+	}
+	usec, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil && micros != "" {
+		return MonitorLine{}, fmt.Errorf("malformed MONITOR timestamp %q", timestamp)
+	}
+	db, address, _ := strings.Cut(source, " ")
+	database, err := strconv.Atoi(db)
+	if err != nil {
+		return MonitorLine{}, fmt.Errorf("malformed MONITOR database %q", db)
+	}
+	parsedArgs, err := parseQuotedArgs(args)
+	if err != nil {
+		return MonitorLine{}, fmt.Errorf("malformed MONITOR arguments %q: %w", args, err)
+	}
+	return MonitorLine{
+		Time:          time.Unix(sec, usec*int64(time.Microsecond)),
+		Database:      database,
+		ClientAddress: address,
+		Args:          parsedArgs,
+	}, nil
+}
+
+// parseQuotedArgs parses the space-separated, double-quoted and escaped arguments of a `MONITOR` line.
+func parseQuotedArgs(s string) ([]string, error) {
+	var args []string
+	for i := 0; i < len(s); {
+		if s[i] == ' ' {
+			i++
+			continue
+		}
+		if s[i] != '"' {
+			return nil, fmt.Errorf("expected a quote at offset %d", i)
+		}
+		var arg strings.Builder
+		i++
+		for {
+			if i >= len(s) {
+				return nil, errors.New("unterminated quote")
+			}
+			c := s[i]
+			if c == '"' {
+				i++
+				break
+			}
+			if c != '\\' {
+				arg.WriteByte(c)
+				i++
+				continue
+			}
+			if i+1 >= len(s) {
+				return nil, errors.New("unterminated escape sequence")
+			}
+			switch s[i+1] {
+			case 'n':
+				arg.WriteByte('\n')
+			case 'r':
+				arg.WriteByte('\r')
+			case 't':
+				arg.WriteByte('\t')
+			case 'a':
+				arg.WriteByte('\a')
+			case 'b':
+				arg.WriteByte('\b')
+			case 'x':
+				if i+3 >= len(s) {
+					return nil, errors.New("unterminated escape sequence")
+				}
+				b, err := strconv.ParseUint(s[i+2:i+4], 16, 8)
+				if err != nil {
+					return nil, fmt.Errorf("invalid escape sequence %q", s[i:i+4])
+				}
+				arg.WriteByte(byte(b))
+				i += 2
+			default:
+				arg.WriteByte(s[i+1])
+			}
+			i += 2
+		}
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
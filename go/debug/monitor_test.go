@@ -0,0 +1,106 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package debug
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestParseMonitorLine(t *testing.T) {
+	line, err := parseMonitorLine(`1339518083.107412 [0 127.0.0.1:60866] "set" "key" "va\"l\x00\n\\"`)
+	require.NoError(t, err)
+	assert.Equal(t, MonitorLine{
+		Time:          time.Unix(1339518083, 107412000),
+		Database:      0,
+		ClientAddress: "127.0.0.1:60866",
+		Args:          []string{"set", "key", "va\"l\x00\n\\"},
+	}, line)
+
+	line, err = parseMonitorLine(`1339518087.877697 [3 lua] "get" ""`)
+	require.NoError(t, err)
+	assert.Equal(t, 3, line.Database)
+	assert.Equal(t, "lua", line.ClientAddress)
+	assert.Equal(t, []string{"get", ""}, line.Args)
+
+	for _, malformed := range []string{
+		"OK",
+		`abc [0 lua] "get"`,
+		`1339518083.1 [x lua] "get"`,
+		`1339518083.1 [0 lua] get`,
+		`1339518083.1 [0 lua] "get`,
+		`1339518083.1 [0 lua] "\xzz"`,
+	} {
+		_, err = parseMonitorLine(malformed)
+		assert.Error(t, err, malformed)
+	}
+}
+
+func TestEncodeCommand(t *testing.T) {
+	assert.Equal(t, "*2\r\n$4\r\nAUTH\r\n$6\r\nsecret\r\n", string(encodeCommand([]string{"AUTH", "secret"})))
+}
+
+// serveMonitor accepts a single connection, checks the handshake, and sends `lines` as monitored commands.
+func serveMonitor(t *testing.T, listener net.Listener, lines []string) {
+	conn, err := listener.Accept()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for _, expected := range [][]string{{"AUTH", "user", "password"}, {"MONITOR"}} {
+		buffer := make([]byte, len(encodeCommand(expected)))
+		_, err := io.ReadFull(reader, buffer)
+		if !assert.NoError(t, err) || !assert.Equal(t, string(encodeCommand(expected)), string(buffer)) {
+			return
+		}
+		conn.Write([]byte("+OK\r\n"))
+	}
+	for _, line := range lines {
+		conn.Write([]byte("+" + line + "\r\n"))
+	}
+	// Keep the connection open until the client closes it.
+	reader.ReadByte()
+}
+
+func TestMonitor(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go serveMonitor(t, listener, []string{
+		`1339518083.107412 [0 127.0.0.1:60866] "set" "key" "value"`,
+		`1339518083.107500 [0 127.0.0.1:60866] "get" "key"`,
+	})
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	cfg := config.NewClientConfiguration().
+		WithAddress(&config.NodeAddress{Host: "127.0.0.1", Port: port}).
+		WithCredentials(config.NewServerCredentials("user", "password"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var lines []MonitorLine
+	err = Monitor(ctx, cfg, func(line MonitorLine) {
+		lines = append(lines, line)
+		if len(lines) == 2 {
+			cancel()
+		}
+	}, MonitorOptions{EnableDangerousCommands: true})
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, lines, 2)
+	assert.Equal(t, []string{"set", "key", "value"}, lines[0].Args)
+	assert.Equal(t, []string{"get", "key"}, lines[1].Args)
+}
+
+func TestMonitor_RequiresDangerousCommands(t *testing.T) {
+	cfg := config.NewClientConfiguration()
+	err := Monitor(context.Background(), cfg, func(MonitorLine) {}, MonitorOptions{})
+	assert.ErrorContains(t, err, "EnableDangerousCommands")
+}
@@ -0,0 +1,212 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package debug
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// slotVerifierQueueSize is the number of sampled commands waiting to be verified. The commands sampled while the queue is
+// full are not verified.
+const slotVerifierQueueSize = 256
+
+// SlotMismatch is a key whose hash slot computed by the client differs from the one computed by the server, so that the
+// commands with the key may be routed to the wrong node.
+type SlotMismatch struct {
+	// The name of the command the key was sampled from, e.g. "GET".
+	Command string
+	// The key.
+	Key string
+	// The slot computed by the client.
+	ClientSlot int64
+	// The slot computed by the server, as returned by `CLUSTER KEYSLOT`.
+	ServerSlot int64
+}
+
+// SlotVerifier cross-checks the hash slots computed by the client against the server for a sample of the keys sent by a
+// cluster client, catching the routing bugs, e.g. in the handling of the hash tags.
+//
+// The verifier samples the commands with its interceptor, and verifies their keys in the background with `CLUSTER KEYSLOT`,
+// so that the sampled commands are not delayed. The keys of a command are located with `COMMAND INFO`. The commands with
+// subcommands, e.g. `XINFO STREAM`, and the commands whose keys cannot be located this way, e.g. `ZUNIONSTORE`, are not
+// verified, except the scripts and the functions.
+//
+// Example:
+//
+//	verifier := debug.NewSlotVerifier(0.01, func(mismatch debug.SlotMismatch) {
+//		log.Printf("key %q is in slot %d, not %d", mismatch.Key, mismatch.ServerSlot, mismatch.ClientSlot)
+//	})
+//	client, err := glide.NewClusterClient(config.NewClusterClientConfiguration().
+//		WithAddress(&config.NodeAddress{Host: "localhost", Port: 7000}).
+//		WithInterceptor(verifier.Interceptor()))
+//	if err != nil {
+//		return err
+//	}
+//	go verifier.Run(ctx, client)
+type SlotVerifier struct {
+	rate     float64
+	handler  func(SlotMismatch)
+	sampled  chan models.Command
+	verified atomic.Int64
+	// The command infos by command name, or nil for the commands whose keys cannot be located. Only used by Run.
+	infos map[string]*models.CommandInfo
+}
+
+// slotVerifierRequestKey marks the contexts of the requests sent by a verifier, which are not sampled.
+type slotVerifierRequestKey struct{}
+
+// NewSlotVerifier creates a [SlotVerifier] sampling a fraction `sampleRate`, between 0 and 1, of the commands, and calling
+// `handler` with every mismatch.
+func NewSlotVerifier(sampleRate float64, handler func(SlotMismatch)) *SlotVerifier {
+	return &SlotVerifier{
+		rate:    sampleRate,
+		handler: handler,
+		sampled: make(chan models.Command, slotVerifierQueueSize),
+		infos:   map[string]*models.CommandInfo{},
+	}
+}
+
+// Interceptor returns the interceptor sampling the commands, to be added to the configuration of the cluster client with
+// `WithInterceptor`. The commands of the batches are sampled individually.
+func (v *SlotVerifier) Interceptor() config.Interceptor {
+	return func(ctx context.Context, cmd models.Command, next config.Invoker) (any, error) {
+		if ctx.Value(slotVerifierRequestKey{}) == nil {
+			if cmd.Batch != nil {
+				for _, batched := range cmd.Batch {
+					v.sample(batched)
+				}
+			} else {
+				v.sample(cmd)
+			}
+		}
+		return next(ctx, cmd)
+	}
+}
+
+// sample queues `cmd` to be verified with the sample rate, unless the queue is full.
+func (v *SlotVerifier) sample(cmd models.Command) {
+	if rand.Float64() >= v.rate {
+		return
+	}
+	select {
+	case v.sampled <- cmd:
+	default:
+	}
+}
+
+// Verified returns the number of keys verified so far.
+func (v *SlotVerifier) Verified() int64 {
+	return v.verified.Load()
+}
+
+// Run verifies the keys of the sampled commands with `client`, which should be the client the verifier is the interceptor
+// of, until `ctx` is cancelled. The keys that cannot be verified, e.g. because of a connection error, are skipped.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the verification. Cancelling it stops the verification.
+//	client - The cluster client sending `CLUSTER KEYSLOT` and `COMMAND INFO`.
+//
+// Return value:
+//
+//	The error of `ctx` once it is cancelled.
+func (v *SlotVerifier) Run(ctx context.Context, client interfaces.GlideClusterClientCommands) error {
+	ctx = context.WithValue(ctx, slotVerifierRequestKey{}, true)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case cmd := <-v.sampled:
+			v.verify(ctx, client, cmd)
+		}
+	}
+}
+
+// verify verifies the slots of the keys of `cmd`.
+func (v *SlotVerifier) verify(ctx context.Context, client interfaces.GlideClusterClientCommands, cmd models.Command) {
+	keys, ok := scriptKeys(cmd)
+	if !ok {
+		info, err := v.commandInfo(ctx, client, cmd.Name)
+		if err != nil || info == nil {
+			return
+		}
+		keys = commandKeys(*info, cmd.Args)
+	}
+	for _, key := range keys {
+		serverSlot, err := client.ClusterKeySlot(ctx, key)
+		if err != nil {
+			continue
+		}
+		v.verified.Add(1)
+		if clientSlot := int64(utils.KeySlot(key)); clientSlot != serverSlot {
+			v.handler(SlotMismatch{Command: cmd.Name, Key: key, ClientSlot: clientSlot, ServerSlot: serverSlot})
+		}
+	}
+}
+
+// commandInfo returns the info of the command `name`, or nil if its keys cannot be located with it.
+func (v *SlotVerifier) commandInfo(
+	ctx context.Context,
+	client interfaces.GlideClusterClientCommands,
+	name string,
+) (*models.CommandInfo, error) {
+	name = strings.ToLower(name)
+	if info, ok := v.infos[name]; ok {
+		return info, nil
+	}
+	infos, err := client.CommandInfo(ctx, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	var located *models.CommandInfo
+	if info, ok := infos[name]; ok && len(info.Subcommands) == 0 && !slices.Contains(info.Flags, "movablekeys") {
+		located = &info
+	}
+	v.infos[name] = located
+	return located, nil
+}
+
+// commandKeys returns the keys in `args`, the arguments following the command name, located with `info`.
+func commandKeys(info models.CommandInfo, args []string) []string {
+	if info.FirstKey <= 0 || info.Step <= 0 {
+		return nil
+	}
+	// The positions count the command name
+	last := info.LastKey
+	if last < 0 {
+		last += int64(len(args)) + 1
+	}
+	var keys []string
+	for position := info.FirstKey; position <= last && position <= int64(len(args)); position += info.Step {
+		keys = append(keys, args[position-1])
+	}
+	return keys
+}
+
+// scriptKeys returns the keys of `cmd` and true if it calls a script or a function, whose arguments are the script or the
+// function, the number of keys, the keys and the other arguments.
+func scriptKeys(cmd models.Command) ([]string, bool) {
+	switch cmd.Name {
+	case "EVAL", "EVAL_RO", "EVALSHA", "EVALSHA_RO", "FCALL", "FCALL_RO":
+	default:
+		return nil, false
+	}
+	if len(cmd.Args) < 2 {
+		return nil, true
+	}
+	count, err := strconv.Atoi(cmd.Args[1])
+	if err != nil || count < 0 || count > len(cmd.Args)-2 {
+		return nil, true
+	}
+	return cmd.Args[2 : 2+count], true
+}
@@ -0,0 +1,84 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// newSlotClient returns a cluster client knowing the commands sampled by the tests.
+func newSlotClient() *fakeclient.ClusterClient {
+	client := fakeclient.NewCluster()
+	client.CommandInfos = map[string]models.CommandInfo{
+		"get":         {Name: "get", FirstKey: 1, LastKey: 1, Step: 1},
+		"mset":        {Name: "mset", FirstKey: 1, LastKey: -1, Step: 2},
+		"ping":        {Name: "ping"},
+		"zunionstore": {Name: "zunionstore", Flags: []string{"write", "movablekeys"}, FirstKey: 1, LastKey: 1, Step: 1},
+	}
+	return client
+}
+
+func TestCommandKeys(t *testing.T) {
+	get := models.CommandInfo{FirstKey: 1, LastKey: 1, Step: 1}
+	assert.Equal(t, []string{"a"}, commandKeys(get, []string{"a"}))
+	mset := models.CommandInfo{FirstKey: 1, LastKey: -1, Step: 2}
+	assert.Equal(t, []string{"a", "b"}, commandKeys(mset, []string{"a", "1", "b", "2"}))
+	copyInfo := models.CommandInfo{FirstKey: 1, LastKey: 2, Step: 1}
+	assert.Equal(t, []string{"a", "b"}, commandKeys(copyInfo, []string{"a", "b", "REPLACE"}))
+	assert.Empty(t, commandKeys(models.CommandInfo{}, []string{"a"}))
+	assert.Empty(t, commandKeys(get, nil))
+}
+
+func TestScriptKeys(t *testing.T) {
+	keys, ok := scriptKeys(models.Command{Name: "EVALSHA", Args: []string{"sha", "2", "a", "b", "arg"}})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, keys)
+	keys, ok = scriptKeys(models.Command{Name: "FCALL", Args: []string{"fn", "3", "a"}})
+	assert.True(t, ok)
+	assert.Empty(t, keys)
+	_, ok = scriptKeys(models.Command{Name: "GET", Args: []string{"a"}})
+	assert.False(t, ok)
+}
+
+func TestSlotVerifier(t *testing.T) {
+	ctx := context.Background()
+	var mismatches []SlotMismatch
+	verifier := NewSlotVerifier(1, func(mismatch SlotMismatch) { mismatches = append(mismatches, mismatch) })
+	client := newSlotClient()
+	client.KeySlots["{user}:2"] = 42
+
+	// The commands are sampled, except the ones sent by the verifier
+	next := func(ctx context.Context, cmd models.Command) (any, error) { return "OK", nil }
+	interceptor := verifier.Interceptor()
+	batch := models.Command{Name: "Batch", Batch: []models.Command{
+		{Name: "MSET", Args: []string{"{user}:1", "a", "{user}:2", "b"}},
+		{Name: "GET", Args: []string{"{user}:1"}},
+	}}
+	_, _ = interceptor(ctx, batch, next)
+	_, _ = interceptor(ctx, models.Command{Name: "ZUNIONSTORE", Args: []string{"dest", "1", "src"}}, next)
+	_, _ = interceptor(ctx, models.Command{Name: "PING"}, next)
+	_, _ = interceptor(context.WithValue(ctx, slotVerifierRequestKey{}, true), models.Command{Name: "GET"}, next)
+	assert.Len(t, verifier.sampled, 4)
+
+	for len(verifier.sampled) > 0 {
+		verifier.verify(ctx, client, <-verifier.sampled)
+	}
+	assert.Equal(t, int64(3), verifier.Verified())
+	assert.Equal(t, []SlotMismatch{{
+		Command:    "MSET",
+		Key:        "{user}:2",
+		ClientSlot: int64(utils.KeySlot("{user}:2")),
+		ServerSlot: 42,
+	}}, mismatches)
+
+	// The command infos are cached
+	commands := len(client.Commands)
+	verifier.verify(ctx, client, models.Command{Name: "GET", Args: []string{"key"}})
+	assert.Equal(t, []string{"CLUSTER"}, client.Commands[commands:])
+}
@@ -0,0 +1,55 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// delIfTypeScript deletes KEYS[1] if its type, as reported by `TYPE`, is ARGV[1].
+var delIfTypeScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+if redis.call('TYPE', KEYS[1]).ok ~= ARGV[1] then
+	return 0
+end
+return redis.call('DEL', KEYS[1])
+`)
+})
+
+// DelIfType removes `key` only if it holds a value of type `objectType`, e.g. in the cleanup jobs that must not delete a
+// key recreated with another type by the application. The type is checked and the key deleted atomically, so that the
+// key cannot change in between, unlike with `TYPE` followed by `DEL`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to delete.
+//	objectType - The type the value of `key` must have to be deleted.
+//
+// Return value:
+//
+//	`true` if `key` was removed, `false` if it does not exist or holds a value of another type.
+func (client *baseClient) DelIfType(ctx context.Context, key string, objectType constants.ObjectType) (bool, error) {
+	if objectType == "" {
+		return false, errors.New("the type of the key to delete must not be empty")
+	}
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*delIfTypeScript(),
+		*options.NewScriptOptions().WithKeys([]string{key}).WithArgs([]string{string(objectType)}),
+	)
+	if err != nil {
+		return false, err
+	}
+	deleted, ok := result.(int64)
+	if !ok {
+		return false, fmt.Errorf("unexpected response: %v", result)
+	}
+	return deleted == 1, nil
+}
@@ -0,0 +1,178 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package delayqueue provides delay queues stored in sorted sets, for job schedulers that run jobs at a given time.
+package delayqueue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// MaxPollBatch is the maximum number of items moved by a single [Scheduler.Poll].
+const MaxPollBatch = 1000
+
+// pollScript moves the items of the queue KEYS[1] due at ARGV[1] at the latest, up to ARGV[2] of them, to the end of the
+// processing list KEYS[2].
+var pollScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+if #items > 0 then
+	redis.call('ZREM', KEYS[1], unpack(items))
+	redis.call('RPUSH', KEYS[2], unpack(items))
+end
+return items
+`)
+})
+
+// Scheduler schedules items in delay queues, and hands them out once they are due.
+//
+// A queue is a sorted set scored by the time its items are due, in milliseconds since the Unix epoch. [Scheduler.Poll]
+// atomically moves the due items to the processing list of the queue, so that every item is handed out to a single
+// worker, and is not lost if the worker fails before processing it: once processed, the worker removes it from the
+// processing list with [Scheduler.Ack]. The items left in the processing list by failed workers can be rescheduled by
+// reading the list at [ProcessingKey].
+//
+// The items are the members of the sorted set, so scheduling an item that is already in the queue only reschedules it.
+// Include a unique job ID in the items to schedule the same job several times.
+//
+// The due time of the items is compared to the clock of the worker polling the queue, so the clocks of the workers
+// should be synchronized. Tests can control the time with [Scheduler.WithClock].
+//
+// In cluster mode, the processing list is in the same slot as the queue, see [ProcessingKey].
+//
+// Example:
+//
+//	scheduler := delayqueue.New(client)
+//	err := scheduler.Schedule(ctx, "emails", `{"id":"42","to":"user@example.com"}`, time.Now().Add(time.Hour))
+//	...
+//	items, err := scheduler.Poll(ctx, "emails", 10)
+//	for _, item := range items {
+//		send(item)
+//		err = scheduler.Ack(ctx, "emails", item)
+//	}
+type Scheduler struct {
+	client interfaces.BaseClientCommands
+	clock  clock.Clock
+}
+
+// New creates a [Scheduler].
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands) *Scheduler {
+	return &Scheduler{client: client, clock: clock.System()}
+}
+
+// WithClock sets the clock the due time of the items is compared to, e.g. a [clock.Fake] in tests. The clock of the
+// system by default.
+func (s *Scheduler) WithClock(c clock.Clock) *Scheduler {
+	s.clock = c
+	return s
+}
+
+// ProcessingKey returns the key of the processing list of `queue`, in the same slot as `queue`, e.g.
+// "{emails}:processing" for "emails".
+func ProcessingKey(queue string) string {
+	return utils.SameSlotKey(queue, ":processing")
+}
+
+// Schedule adds `payload` to `queue`, to be handed out by [Scheduler.Poll] from `fireAt` on. If `payload` is already in
+// `queue`, it is rescheduled.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	queue - The key of the queue.
+//	payload - The item to schedule.
+//	fireAt - The time from which the item is due, rounded down to the millisecond.
+//
+// Return value:
+//
+//	An error if the item could not be scheduled.
+func (s *Scheduler) Schedule(ctx context.Context, queue string, payload string, fireAt time.Time) error {
+	_, err := s.client.ZAdd(ctx, queue, map[string]float64{payload: float64(fireAt.UnixMilli())})
+	return err
+}
+
+// Poll atomically removes up to `batch` due items from `queue`, in the order they are due, and appends them to the
+// processing list of `queue`, see [ProcessingKey].
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	queue - The key of the queue.
+//	batch - The maximum number of items to hand out, between 1 and [MaxPollBatch].
+//
+// Return value:
+//
+//	The due items, or an empty slice if no item is due.
+func (s *Scheduler) Poll(ctx context.Context, queue string, batch int) ([]string, error) {
+	if batch <= 0 || batch > MaxPollBatch {
+		return nil, fmt.Errorf("batch must be between 1 and %d, got %d", MaxPollBatch, batch)
+	}
+	result, err := s.client.InvokeScriptWithOptions(
+		ctx,
+		*pollScript(),
+		*options.NewScriptOptions().
+			WithKeys([]string{queue, ProcessingKey(queue)}).
+			WithArgs([]string{strconv.FormatInt(s.clock.Now().UnixMilli(), 10), strconv.Itoa(batch)}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	values, ok := result.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response: %v", result)
+	}
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		item, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response: %v", result)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Ack removes `payload` from the processing list of `queue` once it is processed.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	queue - The key of the queue.
+//	payload - The item handed out by [Scheduler.Poll].
+//
+// Return value:
+//
+//	true if `payload` was in the processing list.
+func (s *Scheduler) Ack(ctx context.Context, queue string, payload string) (bool, error) {
+	removed, err := s.client.LRem(ctx, ProcessingKey(queue), 1, payload)
+	return removed > 0, err
+}
+
+// Len returns the number of items in `queue` that were not handed out yet, due or not.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	queue - The key of the queue.
+//
+// Return value:
+//
+//	The number of scheduled items.
+func (s *Scheduler) Len(ctx context.Context, queue string) (int64, error) {
+	return s.client.ZCard(ctx, queue)
+}
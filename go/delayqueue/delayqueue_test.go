@@ -0,0 +1,109 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package delayqueue
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+// newFakeClient creates a fake client running the poll script natively.
+func newFakeClient() *fakeclient.Client {
+	client := fakeclient.New()
+	return client.WithScript(func(keys []string, args []string) (any, error) {
+		queue, processing := keys[0], keys[1]
+		now, _ := strconv.ParseFloat(args[0], 64)
+		limit, _ := strconv.Atoi(args[1])
+
+		scores := client.SortedSets[queue]
+		var due []string
+		for member, score := range scores {
+			if score <= now {
+				due = append(due, member)
+			}
+		}
+		sort.Slice(due, func(i, j int) bool {
+			return scores[due[i]] < scores[due[j]] || scores[due[i]] == scores[due[j]] && due[i] < due[j]
+		})
+		items := []any{}
+		for _, member := range due[:min(limit, len(due))] {
+			delete(scores, member)
+			client.Lists[processing] = append(client.Lists[processing], member)
+			items = append(items, member)
+		}
+		return items, nil
+	})
+}
+
+func TestProcessingKey(t *testing.T) {
+	assert.Equal(t, "{emails}:processing", ProcessingKey("emails"))
+	assert.Equal(t, "{tenant:1}:emails:processing", ProcessingKey("{tenant:1}:emails"))
+}
+
+func TestScheduler_ScheduleAndPoll(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	scheduler := New(client).WithClock(fake)
+	now := fake.Now()
+
+	require.NoError(t, scheduler.Schedule(ctx, "jobs", "late", now.Add(time.Minute)))
+	require.NoError(t, scheduler.Schedule(ctx, "jobs", "second", now.Add(-time.Second)))
+	require.NoError(t, scheduler.Schedule(ctx, "jobs", "first", now.Add(-time.Minute)))
+	require.NoError(t, scheduler.Schedule(ctx, "jobs", "third", now))
+	assert.Equal(t, float64(now.Add(time.Minute).UnixMilli()), client.SortedSets["jobs"]["late"])
+
+	items, err := scheduler.Poll(ctx, "jobs", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, items)
+	items, err = scheduler.Poll(ctx, "jobs", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"third"}, items)
+	items, err = scheduler.Poll(ctx, "jobs", 10)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	assert.Equal(t, []string{"first", "second", "third"}, client.Lists["{jobs}:processing"])
+	length, err := scheduler.Len(ctx, "jobs")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), length)
+
+	fake.Advance(time.Minute)
+	items, err = scheduler.Poll(ctx, "jobs", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"late"}, items)
+}
+
+func TestScheduler_Ack(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	scheduler := New(client)
+	require.NoError(t, scheduler.Schedule(ctx, "jobs", "job", time.Now().Add(-time.Second)))
+	_, err := scheduler.Poll(ctx, "jobs", 1)
+	require.NoError(t, err)
+
+	acked, err := scheduler.Ack(ctx, "jobs", "job")
+	require.NoError(t, err)
+	assert.True(t, acked)
+	assert.Empty(t, client.Lists["{jobs}:processing"])
+
+	acked, err = scheduler.Ack(ctx, "jobs", "job")
+	require.NoError(t, err)
+	assert.False(t, acked)
+}
+
+func TestScheduler_PollInvalidBatch(t *testing.T) {
+	scheduler := New(newFakeClient())
+	_, err := scheduler.Poll(context.Background(), "jobs", 0)
+	assert.Error(t, err)
+	_, err = scheduler.Poll(context.Background(), "jobs", MaxPollBatch+1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,128 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package diagnostics provides checks of the servers a client is connected to, reporting the conditions that break the
+// logic of the applications without failing any command, such as a drift of the clocks of the servers.
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// ClockDrift is the drift of the clock of a server from the local clock, measured with `TIME`.
+type ClockDrift struct {
+	// The address of the node, e.g. "127.0.0.1:7000", or empty for a standalone client.
+	Node string
+	// The time of the server, as returned by `TIME`.
+	ServerTime time.Time
+	// The time of the server minus the local time halfway through the round trip of `TIME`: positive if the clock of the
+	// server is ahead of the local clock, negative if it is behind.
+	Drift time.Duration
+	// The round trip time of `TIME`. The drift is accurate to half of it.
+	RoundTrip time.Duration
+}
+
+// CheckClockDrift compares the time of every server with the local clock, sending `TIME` to every server.
+//
+// The expirations set with `EXPIREAT` or `PEXPIREAT` from the local time, e.g. with [time.Time.Unix], are applied by the
+// servers with their own clocks, and happen early or late by the drift of their clocks. Different drifts among the nodes
+// of a cluster make a key expire at another time after a failover or a slot migration.
+//
+// With a [glide.Client], the server the commands are sent to is checked. With a [glide.ClusterClient], every node,
+// primaries and replicas, is checked on its own with `ForEachNode`, so that a failing node does not fail the others: the
+// errors of the failed nodes are returned in a [glide.NodeErrors].
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The [glide.Client] or [glide.ClusterClient] connected to the servers to check.
+//
+// Return value:
+//
+//	The drift of every server that replied, by node address, or by the empty string for a standalone client. If some
+//	nodes of a cluster failed, their errors are returned together with the drifts of the other nodes.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+// [glide.NodeErrors]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#NodeErrors
+func CheckClockDrift(ctx context.Context, client interfaces.BaseClientCommands) (map[string]ClockDrift, error) {
+	switch client := client.(type) {
+	case interfaces.GlideClusterClientCommands:
+		var mu sync.Mutex
+		drifts := map[string]ClockDrift{}
+		fanOutOptions := options.NewFanOutOptions().SetNodes(config.AllNodes)
+		err := client.ForEachNode(ctx, *fanOutOptions, func(ctx context.Context, address string, route config.Route) error {
+			drift, err := measureClockDrift(address, func() ([]string, error) {
+				reply, err := client.TimeWithOptions(ctx, options.RouteOption{Route: route})
+				return reply.SingleValue(), err
+			})
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			drifts[address] = drift
+			mu.Unlock()
+			return nil
+		})
+		return drifts, err
+	case interfaces.GlideClientCommands:
+		drift, err := measureClockDrift("", func() ([]string, error) {
+			return client.Time(ctx)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]ClockDrift{"": drift}, nil
+	default:
+		return nil, fmt.Errorf("unsupported client type %T", client)
+	}
+}
+
+// measureClockDrift measures the drift of the clock of `node` with `sendTime`, which sends `TIME` to the node.
+func measureClockDrift(node string, sendTime func() ([]string, error)) (ClockDrift, error) {
+	sent := time.Now()
+	reply, err := sendTime()
+	received := time.Now()
+	if err != nil {
+		return ClockDrift{}, err
+	}
+	serverTime, err := parseTime(reply)
+	if err != nil {
+		return ClockDrift{}, fmt.Errorf("unexpected TIME reply %v from node %q: %w", reply, node, err)
+	}
+	return clockDrift(node, serverTime, sent, received), nil
+}
+
+// clockDrift returns the drift of `serverTime`, returned by `TIME` sent at `sent` and received at `received`.
+func clockDrift(node string, serverTime time.Time, sent time.Time, received time.Time) ClockDrift {
+	roundTrip := received.Sub(sent)
+	return ClockDrift{
+		Node:       node,
+		ServerTime: serverTime,
+		Drift:      serverTime.Sub(sent.Add(roundTrip / 2)),
+		RoundTrip:  roundTrip,
+	}
+}
+
+// parseTime parses the reply of `TIME`, the Unix time in seconds and the microseconds elapsed in the current second.
+func parseTime(reply []string) (time.Time, error) {
+	if len(reply) != 2 {
+		return time.Time{}, fmt.Errorf("expected 2 elements, got %d", len(reply))
+	}
+	seconds, err := strconv.ParseInt(reply[0], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	micros, err := strconv.ParseInt(reply[1], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(seconds, micros*int64(time.Microsecond)), nil
+}
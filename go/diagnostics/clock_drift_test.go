@@ -0,0 +1,48 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+func TestCheckClockDrift(t *testing.T) {
+	client := fakeclient.NewCluster("127.0.0.1:7000", "127.0.0.1:7001", "127.0.0.1:7002")
+	client.Nodes["127.0.0.1:7001"].ClockOffset = -time.Minute
+	client.Nodes["127.0.0.1:7002"].Err = errors.New("connection refused")
+	drifts, err := CheckClockDrift(context.Background(), client)
+	assert.Error(t, err)
+	require.Len(t, drifts, 2)
+	assert.Equal(t, "127.0.0.1:7001", drifts["127.0.0.1:7001"].Node)
+	assert.InDelta(t, 0, float64(drifts["127.0.0.1:7000"].Drift), float64(time.Second))
+	assert.InDelta(t, float64(-time.Minute), float64(drifts["127.0.0.1:7001"].Drift), float64(time.Second))
+}
+
+func TestClockDrift(t *testing.T) {
+	sent := time.UnixMilli(1_700_000_000_000)
+	drift := clockDrift("node:7000", sent.Add(3*time.Second), sent, sent.Add(20*time.Millisecond))
+	assert.Equal(t, ClockDrift{
+		Node:       "node:7000",
+		ServerTime: sent.Add(3 * time.Second),
+		Drift:      3*time.Second - 10*time.Millisecond,
+		RoundTrip:  20 * time.Millisecond,
+	}, drift)
+}
+
+func TestParseTime(t *testing.T) {
+	serverTime, err := parseTime([]string{"1700000000", "250000"})
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMilli(1_700_000_000_250), serverTime)
+
+	_, err = parseTime([]string{"1700000000"})
+	assert.Error(t, err)
+	_, err = parseTime([]string{"1700000000", "x"})
+	assert.Error(t, err)
+}
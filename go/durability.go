@@ -0,0 +1,98 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// SetAndWait sets `key` to `value` like [Client.Set], then waits for `numReplicas` replicas to acknowledge the write, so
+// that a subsequent read from a replica sees the value.
+//
+// See [Client.WithDurability] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to store.
+//	value - The value to store with the given key.
+//	numReplicas - The number of replicas that must acknowledge the write.
+//	timeout - How long to wait for the replicas to acknowledge the write. 0 waits indefinitely.
+//
+// Return value:
+//
+//	The number of replicas that acknowledged the write, which is lower than `numReplicas` if the timeout was reached.
+func (client *baseClient) SetAndWait(
+	ctx context.Context,
+	key string,
+	value string,
+	numReplicas int64,
+	timeout time.Duration,
+) (int64, error) {
+	return client.WithDurability(
+		ctx,
+		key,
+		*options.NewDurabilityOptions(numReplicas, timeout),
+		func(ctx context.Context) error {
+			_, err := client.Set(ctx, key, value)
+			return err
+		},
+	)
+}
+
+// WithDurability runs `write`, then waits for the replicas to acknowledge it by sending `WAIT` to the primary owning `key`,
+// the key `write` modifies. `WAIT` is only sent if `write` succeeded.
+//
+// `WAIT` does not make the write strongly consistent: a write that was not acknowledged by enough replicas is not rolled
+// back, and may still be lost on a failover. It bounds the replication lag, for instance to read your own writes from
+// replicas. Since the client has a single connection to every node, the acknowledgement also covers the other writes the
+// client sent to the primary before `write`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key modified by `write`, which selects the primary to send `WAIT` to in cluster mode.
+//	opts - The number of replicas to wait for and the timeout, see [options.DurabilityOptions].
+//	write - The write to run, e.g. a call to another command of the client.
+//
+// Return value:
+//
+//	The number of replicas that acknowledged the write, which is lower than `opts.NumReplicas` if the timeout was
+//	reached. If `write` fails, its error is returned.
+//
+// [valkey.io]: https://valkey.io/commands/wait/
+func (client *baseClient) WithDurability(
+	ctx context.Context,
+	key string,
+	opts options.DurabilityOptions,
+	write func(ctx context.Context) error,
+) (int64, error) {
+	if err := write(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	var route config.Route
+	if client.clusterMode {
+		route = config.NewSlotKeyRoute(config.SlotTypePrimary, key)
+	}
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.Wait,
+		[]string{utils.IntToString(opts.NumReplicas), utils.IntToString(opts.Timeout.Milliseconds())},
+		route,
+	)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
@@ -0,0 +1,45 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// connectAllNodes sends a `PING` to every node listed by `CLUSTER NODES`, all at once, so that every node is connected
+// before the first request, see [config.ClusterClientConfiguration.WithEagerConnections]. The nodes are listed by a
+// single node, rather than by every node as in [ClusterClient.ForEachNode], so that an unreachable node is reported in
+// the [NodeErrors] returned along with the other unreachable nodes.
+func (client *ClusterClient) connectAllNodes(ctx context.Context) error {
+	nodes, err := client.ClusterNodes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list the nodes of the cluster: %w", err)
+	}
+	ping := func(ctx context.Context, _ string, route config.Route) (struct{}, error) {
+		_, err := client.PingWithOptions(ctx, options.ClusterPingOptions{RouteOption: &options.RouteOption{Route: route}})
+		return struct{}{}, err
+	}
+	_, err = fanOutTo(ctx, clusterNodeAddresses(nodes), 0, ping)
+	return err
+}
+
+// clusterNodeAddresses returns the addresses of the nodes of the reply of `CLUSTER NODES`, except the nodes that are
+// failing, without an address, or still joining the cluster.
+func clusterNodeAddresses(nodes string) []string {
+	var addresses []string
+	for _, line := range strings.Split(nodes, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		if address := nodeAddress(fields); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,22 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterNodeAddresses(t *testing.T) {
+	nodes := "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001,host1 myself,master - 0 0 1 connected 0-5460\n" +
+		"07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 0 " +
+		"1426238317239 4 connected\n" +
+		"67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1 127.0.0.1:30002@31002 master,fail - 1426238316232 1426238315000 2 " +
+		"disconnected 5461-10922\n" +
+		"292f8b365bb7edb5e285caf0b7e6ddc7265d2f4f 127.0.0.1:30003@31003 master,fail? - 0 1426238318243 3 connected " +
+		"10923-16383\n" +
+		"6ec23923021cf3ffec47632106199cb7f496ce01 :0@0 noaddr,slave - 0 0 0 disconnected\n"
+	assert.Equal(t, []string{"127.0.0.1:30001", "127.0.0.1:30004", "127.0.0.1:30003"}, clusterNodeAddresses(nodes))
+	assert.Empty(t, clusterNodeAddresses(""))
+}
@@ -8,7 +8,11 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // ConnectionError is a client error that occurs when there is an error while connecting or when a connection
@@ -78,6 +82,209 @@ func NewConfigurationError(message string) *ConfigurationError {
 
 func (e *ConfigurationError) Error() string { return e.msg }
 
+// TooManyRedirectsError is a client error that occurs when a cluster request is still redirected with MOVED or ASK after
+// following the maximum number of redirects, see [config.ClusterClientConfiguration.WithMaxRedirects]. This usually
+// indicates that the cluster topology is changing, e.g. during resharding or failover, or that the nodes disagree about
+// the slot ownership.
+type TooManyRedirectsError struct {
+	msg string
+	// The redirects of the request, in the order they were received. The last one was not followed.
+	Redirects []Redirect
+}
+
+// Redirect is a MOVED or ASK redirect of a cluster request.
+type Redirect struct {
+	// The kind of the redirect, "MOVED" or "ASK".
+	Kind string
+	// The slot of the request.
+	Slot int64
+	// The address of the node the request was redirected to.
+	Node string
+}
+
+func NewTooManyRedirectsError(message string, redirects []Redirect) *TooManyRedirectsError {
+	return &TooManyRedirectsError{msg: message, Redirects: redirects}
+}
+
+func (e *TooManyRedirectsError) Error() string { return e.msg }
+
+// parseTooManyRedirectsError parses the redirects listed by the core in a `TooManyRedirects` error, e.g.
+// "Request exceeded the maximum number of redirects - TooManyRedirects: MOVED 3999 127.0.0.1:6381, ASK 3999 10.0.0.2:7000".
+func parseTooManyRedirectsError(errorMessage string) *TooManyRedirectsError {
+	var redirects []Redirect
+	if _, list, found := strings.Cut(errorMessage, "TooManyRedirects: "); found {
+		for _, redirect := range strings.Split(list, ", ") {
+			fields := strings.Fields(redirect)
+			if len(fields) != 3 {
+				continue
+			}
+			slot, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				continue
+			}
+			redirects = append(redirects, Redirect{Kind: fields[0], Slot: slot, Node: fields[2]})
+		}
+	}
+	return NewTooManyRedirectsError(errorMessage, redirects)
+}
+
+// NodeErrors is returned by the per-node helpers of [ClusterClient], such as [ClusterClient.ForEachNode], when the command
+// failed on some of the nodes. The results of the other nodes are still returned.
+type NodeErrors struct {
+	// The error of every failed node, by node address.
+	Errors map[string]error
+}
+
+func NewNodeErrors(errs map[string]error) *NodeErrors {
+	return &NodeErrors{Errors: errs}
+}
+
+func (e *NodeErrors) Error() string {
+	addresses := make([]string, 0, len(e.Errors))
+	for address := range e.Errors {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "the command failed on %d nodes:", len(e.Errors))
+	for _, address := range addresses {
+		fmt.Fprintf(&sb, "\n- %s: %s", address, e.Errors[address].Error())
+	}
+	return sb.String()
+}
+
+// Unwrap returns the errors of the failed nodes, so that they can be matched with [errors.Is] and [errors.As].
+func (e *NodeErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// KeyErrors is returned by [Client.MGetWithOptions] and [ClusterClient.MGetWithOptions] with [options.MGetBestEffort]
+// when some of the keys could not be got. The values of the other keys are still returned.
+type KeyErrors struct {
+	// The error of every key that could not be got, by key.
+	Errors map[string]error
+}
+
+func NewKeyErrors(errs map[string]error) *KeyErrors {
+	return &KeyErrors{Errors: errs}
+}
+
+func (e *KeyErrors) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for key := range e.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "the command failed for %d keys:", len(e.Errors))
+	for _, key := range keys {
+		fmt.Fprintf(&sb, "\n- %s: %s", key, e.Errors[key].Error())
+	}
+	return sb.String()
+}
+
+// Unwrap returns the errors of the failed keys, so that they can be matched with [errors.Is] and [errors.As].
+func (e *KeyErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// CircuitBreakerOpenError is a client error that occurs when a command is destined to a node whose circuit breaker is
+// open, after consecutive connection failures to the node, see [config.CircuitBreakerConfiguration]. The command was not
+// sent.
+type CircuitBreakerOpenError struct {
+	msg string
+	// The address of the node.
+	Node string
+}
+
+func NewCircuitBreakerOpenError(message string, node string) *CircuitBreakerOpenError {
+	return &CircuitBreakerOpenError{msg: message, Node: node}
+}
+
+func (e *CircuitBreakerOpenError) Error() string { return e.msg }
+
+// Matches the error returned by the core for commands rejected by an open circuit breaker, e.g.
+// "Circuit breaker is open for node - ClientError: 127.0.0.1:6379".
+var circuitBreakerOpenErrorRegex = regexp.MustCompile(`Circuit breaker is open for node - \w+: (\S+)`)
+
+func parseCircuitBreakerOpenError(errorMessage string) error {
+	match := circuitBreakerOpenErrorRegex.FindStringSubmatch(errorMessage)
+	if match == nil {
+		return nil
+	}
+	return NewCircuitBreakerOpenError(errorMessage, match[1])
+}
+
+// BudgetExceededError is a client error that occurs when a request is shed because it already used too much of its latency
+// budget before being sent, see [WithLatencyBudget] and [config.ClientConfiguration.WithLatencyBudgetShedding]. The
+// request was not sent.
+type BudgetExceededError struct {
+	msg string
+	// The latency budget of the request.
+	Budget time.Duration
+	// The time elapsed since the start of the budget when the request was shed.
+	Elapsed time.Duration
+}
+
+func NewBudgetExceededError(message string, budget time.Duration, elapsed time.Duration) *BudgetExceededError {
+	return &BudgetExceededError{msg: message, Budget: budget, Elapsed: elapsed}
+}
+
+func (e *BudgetExceededError) Error() string { return e.msg }
+
+// PolicyError is a client error that occurs when a command is not permitted by the command policy of the client, see
+// [config.ClientConfiguration.WithCommandPolicy]. The request was not sent.
+type PolicyError struct {
+	msg string
+	// The name of the command that is not permitted, in uppercase.
+	Command string
+}
+
+func NewPolicyError(message string, command string) *PolicyError {
+	return &PolicyError{msg: message, Command: command}
+}
+
+func (e *PolicyError) Error() string { return e.msg }
+
+// ResponseTooLargeError is a client error that occurs when the response to a command exceeds the maximum response size of
+// the client, see [config.ClientConfiguration.WithMaxResponseSize]. The response was aborted; the command may have been
+// executed by the server.
+type ResponseTooLargeError struct {
+	msg string
+	// The maximum response size of the client, in bytes.
+	Limit uint64
+}
+
+func NewResponseTooLargeError(message string, limit uint64) *ResponseTooLargeError {
+	return &ResponseTooLargeError{msg: message, Limit: limit}
+}
+
+func (e *ResponseTooLargeError) Error() string { return e.msg }
+
+// Matches the error returned by the core for aborted responses, e.g.
+// "Response exceeded the maximum response size - ResponseTooLarge: limit is 1048576 bytes".
+var responseTooLargeErrorRegex = regexp.MustCompile(`ResponseTooLarge: limit is (\d+) bytes`)
+
+func parseResponseTooLargeError(errorMessage string) error {
+	match := responseTooLargeErrorRegex.FindStringSubmatch(errorMessage)
+	if match == nil {
+		return nil
+	}
+	limit, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		return nil
+	}
+	return NewResponseTooLargeError(errorMessage, limit)
+}
+
 type BatchError struct {
 	errors []error
 }
@@ -107,7 +314,15 @@ func GoError(cErrorType uint32, errorMessage string) error {
 		return &TimeoutError{errorMessage}
 	case C.Disconnect:
 		return &DisconnectError{errorMessage}
+	case C.TooManyRedirects:
+		return parseTooManyRedirectsError(errorMessage)
 	default:
+		if err := parseCircuitBreakerOpenError(errorMessage); err != nil {
+			return err
+		}
+		if err := parseResponseTooLargeError(errorMessage); err != nil {
+			return err
+		}
 		return errors.New(errorMessage)
 	}
 }
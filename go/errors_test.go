@@ -0,0 +1,84 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTooManyRedirectsError(t *testing.T) {
+	message := "Request exceeded the maximum number of redirects - TooManyRedirects: " +
+		"MOVED 3999 127.0.0.1:6381, ASK 3999 10.0.0.2:7000, MOVED 3999 127.0.0.1:6380"
+	err := parseTooManyRedirectsError(message)
+	assert.Equal(t, message, err.Error())
+	assert.Equal(
+		t,
+		[]Redirect{
+			{Kind: "MOVED", Slot: 3999, Node: "127.0.0.1:6381"},
+			{Kind: "ASK", Slot: 3999, Node: "10.0.0.2:7000"},
+			{Kind: "MOVED", Slot: 3999, Node: "127.0.0.1:6380"},
+		},
+		err.Redirects,
+	)
+
+	// Only the errors of the dedicated type are redirect errors, whatever their message
+	var redirectsErr *TooManyRedirectsError
+	assert.False(t, errors.As(GoError(0, "An error was signalled by the server - Moved: 3999 127.0.0.1:6381"), &redirectsErr))
+}
+
+func TestGoError_CircuitBreakerOpen(t *testing.T) {
+	message := "Circuit breaker is open for node - ClientError: 127.0.0.1:6379"
+	err := GoError(0, message)
+	var circuitErr *CircuitBreakerOpenError
+	require.ErrorAs(t, err, &circuitErr)
+	assert.Equal(t, "127.0.0.1:6379", circuitErr.Node)
+	assert.Equal(t, message, circuitErr.Error())
+
+	err = GoError(0, "Received connection error `Connection refused`")
+	_, isCircuitErr := err.(*CircuitBreakerOpenError)
+	assert.False(t, isCircuitErr)
+}
+
+func TestNodeErrors(t *testing.T) {
+	timeoutErr := &TimeoutError{"timed out"}
+	err := error(NewNodeErrors(map[string]error{
+		"10.0.0.2:7000": timeoutErr,
+		"10.0.0.1:7000": errors.New("ERR unknown option"),
+	}))
+	assert.Equal(
+		t,
+		"the command failed on 2 nodes:\n- 10.0.0.1:7000: ERR unknown option\n- 10.0.0.2:7000: timed out",
+		err.Error(),
+	)
+	assert.ErrorIs(t, err, timeoutErr)
+	var nodeErrs *NodeErrors
+	require.ErrorAs(t, err, &nodeErrs)
+	assert.Len(t, nodeErrs.Errors, 2)
+}
+
+func TestKeyErrors(t *testing.T) {
+	timeoutErr := &TimeoutError{"timed out"}
+	err := error(NewKeyErrors(map[string]error{"b": timeoutErr, "a": errors.New("ERR connection refused")}))
+	assert.Equal(t, "the command failed for 2 keys:\n- a: ERR connection refused\n- b: timed out", err.Error())
+	assert.ErrorIs(t, err, timeoutErr)
+	var keyErrs *KeyErrors
+	require.ErrorAs(t, err, &keyErrs)
+	assert.Len(t, keyErrs.Errors, 2)
+}
+
+func TestGoError_ResponseTooLarge(t *testing.T) {
+	message := "Response exceeded the maximum response size - ResponseTooLarge: limit is 1048576 bytes"
+	err := GoError(0, message)
+	var tooLargeErr *ResponseTooLargeError
+	require.ErrorAs(t, err, &tooLargeErr)
+	assert.Equal(t, uint64(1048576), tooLargeErr.Limit)
+	assert.Equal(t, message, tooLargeErr.Error())
+
+	err = GoError(0, "ERR value is not an integer or out of range")
+	_, isTooLargeErr := err.(*ResponseTooLargeError)
+	assert.False(t, isTooLargeErr)
+}
@@ -0,0 +1,168 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// The expire commands reply 0 both if the key does not exist and if the condition is not met. The script checks whether
+// the key exists in the same atomic step, so that the two cases can be told apart.
+var expireWithStatusScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+if redis.call(ARGV[1], KEYS[1], ARGV[2], ARGV[3]) == 1 then
+	return 1
+end
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 2
+end
+return 0
+`)
+})
+
+// ExpireWithStatus sets a timeout on key if `expireCondition` is met, like `ExpireWithOptions`, and reports why the
+// timeout was not set, if it was not.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to expire.
+//	expireTime - Duration for the key to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
+//
+// Return value:
+//
+//	[models.ExpireSet] if the timeout was set, [models.ExpireKeyMissing] if `key` does not exist, or
+//	[models.ExpireConditionNotMet] if `expireCondition` was not met.
+//
+// [valkey.io]: https://valkey.io/commands/expire/
+func (client *baseClient) ExpireWithStatus(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	expireCondition constants.ExpireCondition,
+) (models.ExpireStatus, error) {
+	return client.expireWithStatus(ctx, "EXPIRE", key, utils.FloatToString(expireTime.Seconds()), expireCondition)
+}
+
+// ExpireAtWithStatus sets an absolute Unix timestamp, in seconds, as the expiry of key if `expireCondition` is met, like
+// `ExpireAtWithOptions`, and reports why the expiry was not set, if it was not.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to expire.
+//	expireTime - The timestamp for expiry.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
+//
+// Return value:
+//
+//	[models.ExpireSet] if the expiry was set, [models.ExpireKeyMissing] if `key` does not exist, or
+//	[models.ExpireConditionNotMet] if `expireCondition` was not met.
+//
+// [valkey.io]: https://valkey.io/commands/expireat/
+func (client *baseClient) ExpireAtWithStatus(
+	ctx context.Context,
+	key string,
+	expireTime time.Time,
+	expireCondition constants.ExpireCondition,
+) (models.ExpireStatus, error) {
+	return client.expireWithStatus(ctx, "EXPIREAT", key, utils.IntToString(expireTime.Unix()), expireCondition)
+}
+
+// PExpireWithStatus sets a timeout on key, with a millisecond precision, if `expireCondition` is met, like
+// `PExpireWithOptions`, and reports why the timeout was not set, if it was not.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to expire.
+//	expireTime - Duration for the key to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
+//
+// Return value:
+//
+//	[models.ExpireSet] if the timeout was set, [models.ExpireKeyMissing] if `key` does not exist, or
+//	[models.ExpireConditionNotMet] if `expireCondition` was not met.
+//
+// [valkey.io]: https://valkey.io/commands/pexpire/
+func (client *baseClient) PExpireWithStatus(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	expireCondition constants.ExpireCondition,
+) (models.ExpireStatus, error) {
+	return client.expireWithStatus(ctx, "PEXPIRE", key, utils.IntToString(expireTime.Milliseconds()), expireCondition)
+}
+
+// PExpireAtWithStatus sets an absolute Unix timestamp, in milliseconds, as the expiry of key if `expireCondition` is met,
+// like `PExpireAtWithOptions`, and reports why the expiry was not set, if it was not.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to expire.
+//	expireTime - The timestamp for expiry.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
+//
+// Return value:
+//
+//	[models.ExpireSet] if the expiry was set, [models.ExpireKeyMissing] if `key` does not exist, or
+//	[models.ExpireConditionNotMet] if `expireCondition` was not met.
+//
+// [valkey.io]: https://valkey.io/commands/pexpireat/
+func (client *baseClient) PExpireAtWithStatus(
+	ctx context.Context,
+	key string,
+	expireTime time.Time,
+	expireCondition constants.ExpireCondition,
+) (models.ExpireStatus, error) {
+	return client.expireWithStatus(ctx, "PEXPIREAT", key, utils.IntToString(expireTime.UnixMilli()), expireCondition)
+}
+
+func (client *baseClient) expireWithStatus(
+	ctx context.Context,
+	command string,
+	key string,
+	expireTime string,
+	expireCondition constants.ExpireCondition,
+) (models.ExpireStatus, error) {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return models.ExpireKeyMissing, err
+	}
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*expireWithStatusScript(),
+		*options.NewScriptOptions().WithKeys([]string{key}).WithArgs([]string{command, expireTime, expireConditionStr}),
+	)
+	if err != nil {
+		return models.ExpireKeyMissing, err
+	}
+	switch result {
+	case int64(1):
+		return models.ExpireSet, nil
+	case int64(0):
+		return models.ExpireKeyMissing, nil
+	case int64(2):
+		return models.ExpireConditionNotMet, nil
+	default:
+		return models.ExpireKeyMissing, fmt.Errorf("unexpected response: %v", result)
+	}
+}
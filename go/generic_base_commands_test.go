@@ -47,6 +47,44 @@ func ExampleClusterClient_Del() {
 	// 2
 }
 
+func ExampleClient_DelIfType() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	deleted, err := client.DelIfType(context.Background(), "key1", constants.ObjectTypeList)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(deleted)
+	deleted, err = client.DelIfType(context.Background(), "key1", constants.ObjectTypeString)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(deleted)
+
+	// Output:
+	// false
+	// true
+}
+
+func ExampleClusterClient_DelIfType() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	deleted, err := client.DelIfType(context.Background(), "key1", constants.ObjectTypeList)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(deleted)
+	deleted, err = client.DelIfType(context.Background(), "key1", constants.ObjectTypeString)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(deleted)
+
+	// Output:
+	// false
+	// true
+}
+
 func ExampleClient_Exists() {
 	var client *Client = getExampleClient() // example helper function
 	result, err := client.Set(context.Background(), "key1", "someValue")
@@ -149,6 +187,60 @@ func ExampleClusterClient_ExpireWithOptions() {
 	// true
 }
 
+func ExampleClient_ExpireWithStatus() {
+	var client *Client = getExampleClient() // example helper function
+	status, err := client.ExpireWithStatus(context.Background(), "missing_key", 10*time.Second, constants.HasNoExpiry)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(status)
+
+	client.Set(context.Background(), "key", "someValue")
+	status, err = client.ExpireWithStatus(context.Background(), "key", 10*time.Second, constants.HasExistingExpiry)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(status)
+
+	status, err = client.ExpireWithStatus(context.Background(), "key", 10*time.Second, constants.HasNoExpiry)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(status)
+
+	// Output:
+	// KeyMissing
+	// ConditionNotMet
+	// Set
+}
+
+func ExampleClusterClient_ExpireWithStatus() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	status, err := client.ExpireWithStatus(context.Background(), "missing_key", 10*time.Second, constants.HasNoExpiry)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(status)
+
+	client.Set(context.Background(), "key", "someValue")
+	status, err = client.ExpireWithStatus(context.Background(), "key", 10*time.Second, constants.HasExistingExpiry)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(status)
+
+	status, err = client.ExpireWithStatus(context.Background(), "key", 10*time.Second, constants.HasNoExpiry)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(status)
+
+	// Output:
+	// KeyMissing
+	// ConditionNotMet
+	// Set
+}
+
 func ExampleClient_ExpireAt() {
 	var client *Client = getExampleClient() // example helper function
 	result, err := client.Set(context.Background(), "key", "someValue")
@@ -489,6 +581,21 @@ func ExampleClient_PTTL() {
 	// -1
 }
 
+func ExampleClient_TTLs() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	client.Expire(context.Background(), "key1", 100*time.Second)
+	client.Set(context.Background(), "key2", "someValue")
+	result, err := client.TTLs(context.Background(), []string{"key1", "key2", "missing"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result["key1"] > 0, result["key2"], result["missing"])
+
+	// Output:
+	// true -1 -2
+}
+
 func ExampleClusterClient_PTTL() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 	result, err := client.Set(context.Background(), "key", "someValue")
@@ -1172,6 +1279,38 @@ func ExampleClusterClient_Wait() {
 	// true
 }
 
+func ExampleClient_SetAndWait() {
+	var client *Client = getExampleClient() // example helper function
+	acknowledged, err := client.SetAndWait(context.Background(), "key1", "someValue", 1, time.Second)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(acknowledged >= 1)
+
+	// Output:
+	// true
+}
+
+func ExampleClusterClient_WithDurability() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	acknowledged, err := client.WithDurability(
+		context.Background(),
+		"key1",
+		*options.NewDurabilityOptions(1, time.Second),
+		func(ctx context.Context) error {
+			_, err := client.Incr(ctx, "key1")
+			return err
+		},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(acknowledged >= 1)
+
+	// Output:
+	// true
+}
+
 func ExampleClient_Copy() {
 	var client *Client = getExampleClient() // example helper function
 	result, err := client.Set(context.Background(), "key1", "someValue")
@@ -26,6 +26,23 @@ func ExampleClusterClient_CustomCommand() {
 	// Output: PONG
 }
 
+func ExampleClusterClient_CustomCommandWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	client.Set(context.Background(), "my_key", "my_value")
+	// Read-only commands can be sent to replicas when the client reads from replicas
+	result, err := client.CustomCommandWithOptions(
+		context.Background(),
+		[]string{"GET", "my_key"},
+		*options.NewCustomCommandOptions().WithReadOnlyHint(),
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.SingleValue())
+
+	// Output: my_value
+}
+
 func ExampleClusterClient_CustomCommandWithRoute() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 
@@ -24,6 +24,23 @@ func ExampleClient_CustomCommand() {
 	// Output: PONG
 }
 
+func ExampleClient_CustomCommandWithOptions() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "my_key", "my_value")
+	// Read-only commands can be sent to replicas when the client reads from replicas
+	result, err := client.CustomCommandWithOptions(
+		context.Background(),
+		[]string{"GET", "my_key"},
+		*options.NewCustomCommandOptions().WithReadOnlyHint(),
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: my_value
+}
+
 func ExampleClient_Move() {
 	var client *Client = getExampleClient() // example helper function
 	key := uuid.New().String()
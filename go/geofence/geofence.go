@@ -0,0 +1,202 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package geofence provides an index of places stored in Valkey geospatial sets, to look up the places around a position
+// or within an area.
+package geofence
+
+import (
+	"context"
+	"sort"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Place is a place of an [Index] returned by a lookup, along with its distance to the position it was looked up from.
+type Place struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	// The distance in meters from the position the place was looked up from. For [Index.Within], the distance to the
+	// center of the closest circle containing the place.
+	Distance float64
+}
+
+// Circle is a circular area, defined by its center and its radius in meters.
+type Circle struct {
+	Latitude  float64
+	Longitude float64
+	Radius    float64
+}
+
+// Index is an index of named places, stored in the geospatial set at a key.
+//
+// Example:
+//
+//	stores := geofence.New(client, "stores")
+//	_, err := stores.AddPlace(ctx, "downtown", 40.7128, -74.0060)
+//	...
+//	// The 5 closest stores within 2 km
+//	places, err := stores.Nearby(ctx, 40.73, -73.99, 2000, 5)
+type Index struct {
+	client interfaces.BaseClientCommands
+	key    string
+}
+
+// New creates an [Index] stored in the geospatial set at `key`.
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the geospatial set.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands, key string) *Index {
+	return &Index{client: client, key: key}
+}
+
+// Key returns the key of the geospatial set of the index.
+func (i *Index) Key() string {
+	return i.key
+}
+
+// AddPlace adds a place to the index, or moves it if it is already indexed.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	name - The name of the place.
+//	latitude - The latitude of the place, between -85.05112878 and 85.05112878 degrees.
+//	longitude - The longitude of the place, between -180 and 180 degrees.
+//
+// Return value:
+//
+//	true if the place was added, false if it was already indexed.
+func (i *Index) AddPlace(ctx context.Context, name string, latitude float64, longitude float64) (bool, error) {
+	added, err := i.client.GeoAdd(
+		ctx,
+		i.key,
+		map[string]options.GeospatialData{name: {Latitude: latitude, Longitude: longitude}},
+	)
+	return added > 0, err
+}
+
+// AddPlaces adds several places to the index, or moves the ones that are already indexed.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	places - The positions of the places, by name.
+//
+// Return value:
+//
+//	The number of places added, not counting the ones that were already indexed.
+func (i *Index) AddPlaces(ctx context.Context, places map[string]options.GeospatialData) (int64, error) {
+	return i.client.GeoAdd(ctx, i.key, places)
+}
+
+// RemovePlaces removes places from the index.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	names - The names of the places to remove.
+//
+// Return value:
+//
+//	The number of places removed, not counting the ones that were not indexed.
+func (i *Index) RemovePlaces(ctx context.Context, names ...string) (int64, error) {
+	return i.client.ZRem(ctx, i.key, names)
+}
+
+// Nearby returns the places within `radius` meters of a position, from the closest to the farthest.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	latitude - The latitude of the position.
+//	longitude - The longitude of the position.
+//	radius - The radius of the lookup, in meters.
+//	limit - The maximum number of places to return, the closest ones. 0 returns all the places within the radius.
+//
+// Return value:
+//
+//	The places within the radius, from the closest to the farthest.
+func (i *Index) Nearby(
+	ctx context.Context,
+	latitude float64,
+	longitude float64,
+	radius float64,
+	limit int64,
+) ([]Place, error) {
+	return i.search(ctx, Circle{Latitude: latitude, Longitude: longitude, Radius: radius}, limit)
+}
+
+// Within returns the places within an area approximated by circles, e.g. a polygon covered by circles, from the closest
+// to the farthest from the center of the closest circle containing them. A place within several circles is returned
+// once.
+//
+// Every circle is looked up with its own `GEOSEARCH`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	circles - The circles covering the area.
+//
+// Return value:
+//
+//	The places within at least one of the circles.
+func (i *Index) Within(ctx context.Context, circles []Circle) ([]Place, error) {
+	closest := map[string]Place{}
+	for _, circle := range circles {
+		places, err := i.search(ctx, circle, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, place := range places {
+			if known, ok := closest[place.Name]; !ok || place.Distance < known.Distance {
+				closest[place.Name] = place
+			}
+		}
+	}
+	places := make([]Place, 0, len(closest))
+	for _, place := range closest {
+		places = append(places, place)
+	}
+	sort.Slice(places, func(a, b int) bool {
+		if places[a].Distance == places[b].Distance {
+			return places[a].Name < places[b].Name
+		}
+		return places[a].Distance < places[b].Distance
+	})
+	return places, nil
+}
+
+// search returns the places within `circle`, from the closest to the farthest, at most `limit` of them unless 0.
+func (i *Index) search(ctx context.Context, circle Circle, limit int64) ([]Place, error) {
+	locations, err := i.client.GeoSearchWithFullOptions(
+		ctx,
+		i.key,
+		&options.GeoCoordOrigin{
+			GeospatialData: options.GeospatialData{Latitude: circle.Latitude, Longitude: circle.Longitude},
+		},
+		*options.NewCircleSearchShape(circle.Radius, constants.GeoUnitMeters),
+		*options.NewGeoSearchResultOptions().SetSortOrder(options.ASC).SetCount(limit),
+		*options.NewGeoSearchInfoOptions().SetWithDist(true).SetWithCoord(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+	places := make([]Place, len(locations))
+	for j, location := range locations {
+		places[j] = Place{
+			Name:      location.Name,
+			Latitude:  location.Coord.Latitude,
+			Longitude: location.Coord.Longitude,
+			Distance:  location.Dist,
+		}
+	}
+	return places, nil
+}
@@ -0,0 +1,68 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package geofence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func placeNames(places []Place) []string {
+	names := make([]string, len(places))
+	for i, place := range places {
+		names[i] = place.Name
+	}
+	return names
+}
+
+func TestIndex_Within(t *testing.T) {
+	ctx := context.Background()
+	index := New(fakeclient.New(), "places")
+	// On the equator, 0.001 degree is about 111 meters
+	added, err := index.AddPlaces(ctx, map[string]options.GeospatialData{
+		"a": {Latitude: 0, Longitude: 0.0001},
+		"b": {Latitude: 0.0027, Longitude: 0},
+		"c": {Latitude: 0.0036, Longitude: 0.0002},
+		"d": {Latitude: 0.02, Longitude: 0},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), added)
+
+	places, err := index.Within(ctx, []Circle{
+		{Latitude: 0, Longitude: 0, Radius: 500},
+		{Latitude: 0.0036, Longitude: 0, Radius: 500},
+		{Latitude: 10, Longitude: 0, Radius: 500},
+	})
+	require.NoError(t, err)
+	// Every place is reported with its distance to the closest circle
+	assert.Equal(t, []string{"a", "c", "b"}, placeNames(places))
+	assert.InDelta(t, 11, places[0].Distance, 1)
+	assert.InDelta(t, 22, places[1].Distance, 1)
+	assert.InDelta(t, 100, places[2].Distance, 1)
+	assert.InDelta(t, 0.0027, places[2].Latitude, 1e-5)
+
+	places, err = index.Within(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, places)
+}
+
+func TestIndex_Nearby(t *testing.T) {
+	ctx := context.Background()
+	index := New(fakeclient.New(), "places")
+	for name, latitude := range map[string]float64{"a": 0.001, "b": 0.002, "c": 0.003} {
+		_, err := index.AddPlace(ctx, name, latitude, 0)
+		require.NoError(t, err)
+	}
+
+	places, err := index.Nearby(ctx, 0, 0, 1000, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, placeNames(places))
+	places, err = index.Nearby(ctx, 0, 0, 250, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, placeNames(places))
+}
@@ -0,0 +1,186 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// GEOSEARCH reports distances rounded to 4 decimal places. The iterator pads the shrinking radius by this amount so that
+// no member is lost to rounding, and de-duplicates the members that may reappear on the next page because of it.
+const geoSearchDistancePrecision = 0.0001
+
+// GeoSearchIterator streams the members of a geospatial index that lie within a circle, one page at a time.
+//
+// A single `GEOSEARCH` over a dense area can return hundreds of thousands of members. The iterator instead requests at most
+// `pageSize` members per round trip, sorted from the farthest to the nearest, and shrinks the search radius after every page
+// to the distance of the nearest member returned so far. Every member is returned exactly once, and the members are
+// returned in descending order of distance from the center point.
+//
+// The search area must be a circle created by [options.NewCircleSearchShape]. Distances are always requested, so every
+// returned [options.Location] has its `Dist` field populated in the unit of the search shape.
+//
+// The iterator bounds the size of the replies, not the work of the server: `GEOSEARCH` collects and sorts every member
+// within the radius before applying `COUNT`, so every page costs as much as a search of the whole remaining area. Iterating
+// over N members thus costs the server O(N²/pageSize) in total, against O(N) for a single search. `COUNT ANY` cannot be
+// used instead, as it returns arbitrary members rather than the farthest ones the radius shrinks to. Choose a `pageSize`
+// that keeps the number of pages small, e.g. in the thousands for areas of hundreds of thousands of members.
+//
+// Note that the iterator does not provide a point-in-time view: members added or removed while iterating may or may not be
+// returned.
+//
+// Example:
+//
+//	iter, err := glide.NewGeoSearchIterator(
+//	    client,
+//	    "places",
+//	    &options.GeoCoordOrigin{GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37}},
+//	    *options.NewCircleSearchShape(200, constants.GeoUnitKilometers),
+//	    100,
+//	)
+//	for iter.HasNext() {
+//	    page, err := iter.Next(ctx)
+//	    ...
+//	}
+type GeoSearchIterator struct {
+	client      interfaces.GeoSpatialCommands
+	key         string
+	searchFrom  options.GeoSearchOrigin
+	maxRadius   float64
+	radius      float64
+	unit        constants.GeoUnit
+	pageSize    int64
+	infoOptions options.GeoSearchInfoOptions
+	// Members already returned whose distance is close enough to the current radius to be returned again.
+	boundary map[string]float64
+	finished bool
+}
+
+// NewGeoSearchIterator creates a [GeoSearchIterator] over the members of the geospatial index stored at `key` that are
+// within the circle given by `searchByShape` around `searchFrom`.
+//
+// Parameters:
+//
+//	client - The client used to run the `GEOSEARCH` commands, either a [Client] or a [ClusterClient].
+//	key - The key of the sorted set.
+//	searchFrom - The query's center point, either an [options.GeoMemberOrigin] or an [options.GeoCoordOrigin].
+//	searchByShape - The query's shape. Must be a `BYRADIUS` shape.
+//	pageSize - The maximum number of members to return per page. Must be positive.
+//
+// Return value:
+//
+//	A [GeoSearchIterator] positioned before the first page.
+func NewGeoSearchIterator(
+	client interfaces.GeoSpatialCommands,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	pageSize int64,
+) (*GeoSearchIterator, error) {
+	return NewGeoSearchIteratorWithInfoOptions(
+		client,
+		key,
+		searchFrom,
+		searchByShape,
+		pageSize,
+		*options.NewGeoSearchInfoOptions(),
+	)
+}
+
+// NewGeoSearchIteratorWithInfoOptions creates a [GeoSearchIterator] like [NewGeoSearchIterator], additionally requesting
+// the coordinates and/or the geohash of every member as specified by `infoOptions`. The distance is always requested.
+func NewGeoSearchIteratorWithInfoOptions(
+	client interfaces.GeoSpatialCommands,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	pageSize int64,
+	infoOptions options.GeoSearchInfoOptions,
+) (*GeoSearchIterator, error) {
+	if searchByShape.Shape != constants.BYRADIUS {
+		return nil, errors.New("geosearch iterator only supports BYRADIUS search shapes")
+	}
+	if searchByShape.Radius <= 0 {
+		return nil, errors.New("geosearch iterator radius must be positive")
+	}
+	if pageSize <= 0 {
+		return nil, errors.New("geosearch iterator page size must be positive")
+	}
+	infoOptions.WithDist = true
+	return &GeoSearchIterator{
+		client:      client,
+		key:         key,
+		searchFrom:  searchFrom,
+		maxRadius:   searchByShape.Radius,
+		radius:      searchByShape.Radius,
+		unit:        searchByShape.Unit,
+		pageSize:    pageSize,
+		infoOptions: infoOptions,
+		boundary:    map[string]float64{},
+	}, nil
+}
+
+// HasNext returns `false` once all members within the search area have been returned.
+func (iter *GeoSearchIterator) HasNext() bool {
+	return !iter.finished
+}
+
+// Next fetches the next page of members, ordered from the farthest to the nearest. The last page may be empty.
+//
+// If an error is returned the iterator is left unchanged, so `Next` may be called again to retry the same page.
+func (iter *GeoSearchIterator) Next(ctx context.Context) ([]options.Location, error) {
+	if iter.finished {
+		return []options.Location{}, nil
+	}
+
+	count := iter.pageSize + int64(len(iter.boundary))
+	locations, err := iter.client.GeoSearchWithFullOptions(
+		ctx,
+		iter.key,
+		iter.searchFrom,
+		*options.NewCircleSearchShape(iter.radius, iter.unit),
+		*options.NewGeoSearchResultOptions().SetSortOrder(options.DESC).SetCount(count),
+		iter.infoOptions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	page := make([]options.Location, 0, len(locations))
+	for _, location := range locations {
+		if _, seen := iter.boundary[location.Name]; seen {
+			continue
+		}
+		page = append(page, location)
+	}
+
+	if int64(len(locations)) < count {
+		iter.finished = true
+		iter.boundary = nil
+		return page, nil
+	}
+
+	// The page is sorted by descending distance, so the last member is the nearest one returned so far. Anything not
+	// returned yet is at most that far away (up to rounding), which becomes the radius of the next search.
+	nearest := locations[len(locations)-1].Dist
+	iter.radius = math.Min(nearest+geoSearchDistancePrecision, iter.maxRadius)
+	boundary := map[string]float64{}
+	for name, dist := range iter.boundary {
+		if dist <= iter.radius+geoSearchDistancePrecision {
+			boundary[name] = dist
+		}
+	}
+	for _, location := range page {
+		if location.Dist <= iter.radius+geoSearchDistancePrecision {
+			boundary[location.Name] = location.Dist
+		}
+	}
+	iter.boundary = boundary
+	return page, nil
+}
@@ -595,3 +595,41 @@ func AddInitialGeoData(client interfaces.BaseClientCommands, key string) {
 		fmt.Println("GeoSearch glide example failed with an error: ", err)
 	}
 }
+
+func ExampleGeoSearchIterator() {
+	client := getExampleClient()
+
+	key := uuid.New().String()
+
+	client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{
+		"Palermo": {Longitude: 13.361389, Latitude: 38.115556},
+		"Catania": {Longitude: 15.087269, Latitude: 37.502669},
+		"edge1":   {Longitude: 12.758489, Latitude: 38.788135},
+	})
+
+	iter, err := NewGeoSearchIterator(
+		client,
+		key,
+		&options.GeoCoordOrigin{GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37}},
+		*options.NewCircleSearchShape(300, constants.GeoUnitKilometers),
+		2,
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for iter.HasNext() {
+		page, err := iter.Next(context.Background())
+		if err != nil {
+			fmt.Println("Glide example failed with an error: ", err)
+			break
+		}
+		for _, location := range page {
+			fmt.Println(location.Name, location.Dist)
+		}
+	}
+
+	// Output:
+	// edge1 279.7405
+	// Palermo 190.4424
+	// Catania 56.4413
+}
@@ -65,6 +65,7 @@ func NewClient(config *config.ClientConfiguration) (*Client, error) {
 	} else {
 		client.setMessageHandler(NewMessageHandler(nil, nil))
 	}
+	client.messageHandler.queue.setBackpressure(config.GetPubSubBackpressure())
 
 	return &Client{*client}, nil
 }
@@ -130,6 +131,34 @@ func (client *Client) ExecWithOptions(
 	return client.executeBatch(ctx, batch.Batch, raiseOnError, &converted)
 }
 
+// ExecTransaction executes a transaction, and reports the outcome of every command, along with its index and name, so
+// that the failed commands can be told apart from the others, e.g. an `INCR` on a key holding a non-numeric string. The
+// commands of a transaction are executed even if some of them fail.
+//
+// See [Valkey Transactions (Atomic Batches)] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	batch - An atomic `StandaloneBatch` object containing the commands of the transaction.
+//	options - A [pipeline.StandaloneBatchOptions] object containing execution options.
+//
+// Return value:
+//
+// The outcome of every command of the transaction, see [models.TransactionResult]. If the transaction was aborted
+// because of a `WATCH` command, its `Aborted` field is true. An error is returned if the transaction could not be
+// executed, e.g. if a command was rejected while queued.
+//
+// [Valkey Transactions (Atomic Batches)]: https://valkey.io/docs/topics/transactions/
+func (client *Client) ExecTransaction(
+	ctx context.Context,
+	batch pipeline.StandaloneBatch,
+	options pipeline.StandaloneBatchOptions,
+) (models.TransactionResult, error) {
+	converted := options.Convert()
+	return client.executeTransaction(ctx, batch.Batch, &converted)
+}
+
 // CustomCommand executes a single command, specified by args, without checking inputs. Every part of the command,
 // including the command name and subcommands, should be added as a separate value in args. The returning value depends on
 // the executed command.
@@ -151,7 +180,36 @@ func (client *Client) ExecWithOptions(
 //
 // [Valkey GLIDE Documentation]: https://glide.valkey.io/concepts/client-features/custom-commands/
 func (client *Client) CustomCommand(ctx context.Context, args []string) (any, error) {
-	res, err := client.executeCommand(ctx, C.CustomCommand, args)
+	return client.CustomCommandWithOptions(ctx, args, options.CustomCommandOptions{})
+}
+
+// CustomCommandWithOptions executes a single command, specified by args, without checking inputs, like
+// [Client.CustomCommand].
+//
+// When the client reads from replicas, see [config.ReadFrom], a custom command is sent to a replica if it only reads data.
+// The client looks up every custom command once in the command table of the server, and treats the commands flagged as
+// "readonly" as read-only commands. Use [options.CustomCommandOptions.WithReadOnlyHint] for read commands that the server
+// does not flag as "readonly", e.g. some module commands.
+//
+// See [Valkey GLIDE Documentation] for details on the restrictions and limitations of the custom command API.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	args - Arguments for the custom command including the command name.
+//	opts - The read-only hint of the command, see [options.CustomCommandOptions].
+//
+// Return value:
+//
+//	The returned value for the custom command.
+//
+// [Valkey GLIDE Documentation]: https://glide.valkey.io/concepts/client-features/custom-commands/
+func (client *Client) CustomCommandWithOptions(
+	ctx context.Context,
+	args []string,
+	opts options.CustomCommandOptions,
+) (any, error) {
+	res, err := client.executeCommandWithRoute(ctx, C.CustomCommand, args, client.customCommandRoute(ctx, args, opts))
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +268,29 @@ func (client *Client) ConfigGet(ctx context.Context, args []string) (map[string]
 	return handleStringToStringMapResponse(res)
 }
 
+// ConfigGetSorted gets the values of configuration parameters, like [Client.ConfigGet], sorted by parameter name, so that
+// the result is iterated and serialized deterministically, e.g. to compare configuration snapshots.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	args - A slice of configuration parameter names to retrieve values for.
+//
+// Return value:
+//
+//	The values of the configuration parameters, sorted by parameter name.
+//
+// [valkey.io]: https://valkey.io/commands/config-get/
+func (client *Client) ConfigGetSorted(ctx context.Context, args []string) (models.OrderedMap, error) {
+	parameters, err := client.ConfigGet(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewOrderedMap(parameters), nil
+}
+
 // Select changes the currently selected database.
 //
 // WARNING: This command is NOT RECOMMENDED for production use.
@@ -270,6 +351,9 @@ func (client *Client) Info(ctx context.Context) (string, error) {
 //
 // Starting from server version 7, command supports multiple section arguments.
 //
+// If the introspection cache is enabled with [config.ClientConfiguration.WithIntrospectionCache], the result of the
+// "server" section alone may be served from the cache.
+//
 // See [valkey.io] for details.
 //
 // Parameters:
@@ -287,12 +371,47 @@ func (client *Client) InfoWithOptions(ctx context.Context, options options.InfoO
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
+	cacheable := isServerSectionOnly(options.Sections)
+	if cacheable {
+		if cached, ok := client.introspectionCache.Get(infoServerCacheKey); ok {
+			return cached.(string), nil
+		}
+	}
 	result, err := client.executeCommand(ctx, C.Info, optionArgs)
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
 
-	return handleStringResponse(result)
+	info, err := handleStringResponse(result)
+	if err == nil && cacheable {
+		client.introspectionCache.Put(infoServerCacheKey, info)
+	}
+	return info, err
+}
+
+// Returns the replication state of the server, parsed from the "replication" section of `INFO` into a
+// [models.ReplicationStatus].
+//
+// On a primary, the status lists the connected replicas along with their lag, e.g. to gate the reads from replicas on
+// an acceptable lag.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The replication state of the server.
+//
+// [valkey.io]: https://valkey.io/commands/info/
+func (client *Client) ReplicationStatus(ctx context.Context) (models.ReplicationStatus, error) {
+	result, err := client.executeCommand(ctx, C.Info, []string{string(constants.Replication)})
+	if err != nil {
+		return models.ReplicationStatus{}, err
+	}
+	return handleReplicationStatusResponse(result)
 }
 
 // Returns the number of keys in the currently selected database.
@@ -531,6 +650,94 @@ func (client *Client) ClientId(ctx context.Context) (int64, error) {
 	return handleIntResponse(result)
 }
 
+// Returns information and statistics about the current connection, parsed into a [models.ClientInfo].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The connection information.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *Client) ClientInfo(ctx context.Context) (models.ClientInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientInfo, []string{})
+	if err != nil {
+		return models.ClientInfo{}, err
+	}
+	return handleClientInfoResponse(result)
+}
+
+// Returns the client-side caching (tracking) state of the current connection, parsed into a
+// [models.ClientTrackingInfo].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The tracking flags, the redirect client ID and the broadcasting prefixes of the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-trackinginfo/
+func (client *Client) ClientTrackingInfo(ctx context.Context) (models.ClientTrackingInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientTrackingInfo, []string{})
+	if err != nil {
+		return models.ClientTrackingInfo{}, err
+	}
+	return handleClientTrackingInfoResponse(result)
+}
+
+// Returns information about the server and the current connection.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The server name and version, the connection protocol and ID, the server mode and role, and the loaded modules.
+//
+// [valkey.io]: https://valkey.io/commands/hello/
+func (client *Client) Hello(ctx context.Context) (models.HelloResponse, error) {
+	return client.HelloWithOptions(ctx, *options.NewHelloOptions())
+}
+
+// Switches the protocol of the connection, authenticates and/or sets the connection name, and returns information about
+// the server and the connection. The client remembers the protocol, credentials and connection name, and uses them when
+// reconnecting.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	helloOptions - The [options.HelloOptions] for the command.
+//
+// Return value:
+//
+//	The server name and version, the connection protocol and ID, the server mode and role, and the loaded modules.
+//
+// [valkey.io]: https://valkey.io/commands/hello/
+func (client *Client) HelloWithOptions(ctx context.Context, helloOptions options.HelloOptions) (models.HelloResponse, error) {
+	args, err := helloOptions.ToArgs()
+	if err != nil {
+		return models.HelloResponse{}, err
+	}
+	result, err := client.executeCommand(ctx, C.Hello, args)
+	if err != nil {
+		return models.HelloResponse{}, err
+	}
+	return handleHelloResponse(result)
+}
+
 // Returns UNIX TIME of the last DB save timestamp or startup timestamp if no save was made since then.
 //
 // See [valkey.io] for details.
@@ -602,7 +809,8 @@ func (client *Client) ClientGetName(ctx context.Context) (models.Result[string],
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	connectionName - Connection name of the current connection.
+//	connectionName - Connection name of the current connection. The suffixes of the client name configured with
+//	  `WithClientNameSuffixes`, if any, are appended to it.
 //
 // Return value:
 //
@@ -610,6 +818,7 @@ func (client *Client) ClientGetName(ctx context.Context) (models.Result[string],
 //
 // [valkey.io]: https://valkey.io/commands/client-setname/
 func (client *Client) ClientSetName(ctx context.Context, connectionName string) (string, error) {
+	connectionName = client.suffixedClientName(connectionName)
 	result, err := client.executeCommand(ctx, C.ClientSetName, []string{connectionName})
 	if err != nil {
 		return models.DefaultStringResponse, err
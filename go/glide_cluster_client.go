@@ -61,19 +61,33 @@ type ClusterClient struct {
 //	      in case of disconnections.
 //	  - **Pub/Sub Subscriptions**: Predefine Pub/Sub channels and patterns to subscribe to upon connection establishment.
 //	      Supports exact channels, patterns, and sharded channels (available since Valkey version 7.0).
+//	  - **Eager Connections**: If enabled with `WithEagerConnections`, every node of the cluster is connected before the
+//	      client is returned, and the creation fails with the errors of the nodes that could not be connected.
 func NewClusterClient(config *config.ClusterClientConfiguration) (*ClusterClient, error) {
 	client, err := createClient(config)
 	if err != nil {
 		return nil, err
 	}
+	client.clusterMode = true
 	if config.HasSubscription() {
 		subConfig := config.GetSubscription()
 		client.setMessageHandler(NewMessageHandler(subConfig.GetCallback(), subConfig.GetContext()))
 	} else {
 		client.setMessageHandler(NewMessageHandler(nil, nil))
 	}
+	client.messageHandler.queue.setBackpressure(config.GetPubSubBackpressure())
 
-	return &ClusterClient{*client}, nil
+	clusterClient := &ClusterClient{*client}
+	if selector := config.GetReplicaSelector(); selector != nil {
+		clusterClient.replicaSelection = newReplicaSelection(clusterClient, selector)
+	}
+	if config.GetEagerConnections() {
+		if err := clusterClient.connectAllNodes(context.Background()); err != nil {
+			clusterClient.Close()
+			return nil, err
+		}
+	}
+	return clusterClient, nil
 }
 
 // Executes a batch by processing the queued commands.
@@ -188,6 +202,37 @@ func (client *ClusterClient) ExecWithOptions(
 	return client.executeBatch(ctx, batch.Batch, raiseOnError, &converted)
 }
 
+// ExecTransaction executes a transaction, and reports the outcome of every command, along with its index and name, so
+// that the failed commands can be told apart from the others, e.g. an `INCR` on a key holding a non-numeric string. The
+// commands of a transaction are executed even if some of them fail.
+//
+// See [Valkey Transactions (Atomic Batches)] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	batch - An atomic `ClusterBatch` object containing the commands of the transaction.
+//	options - A [pipeline.ClusterBatchOptions] object containing execution options.
+//
+// Return value:
+//
+// The outcome of every command of the transaction, see [models.TransactionResult]. If the transaction was aborted
+// because of a `WATCH` command, its `Aborted` field is true. An error is returned if the transaction could not be
+// executed, e.g. if a command was rejected while queued.
+//
+// [Valkey Transactions (Atomic Batches)]: https://valkey.io/docs/topics/transactions/
+func (client *ClusterClient) ExecTransaction(
+	ctx context.Context,
+	batch pipeline.ClusterBatch,
+	options pipeline.ClusterBatchOptions,
+) (models.TransactionResult, error) {
+	if options.RetryStrategy != nil {
+		return models.TransactionResult{}, errors.New("retry strategy is not supported for atomic batches (transactions)")
+	}
+	converted := options.Convert()
+	return client.executeTransaction(ctx, batch.Batch, &converted)
+}
+
 // CustomCommand executes a single command, specified by args, without checking inputs. Every part of the command,
 // including the command name and subcommands, should be added as a separate value in args. The returning value depends on
 // the executed command.
@@ -211,7 +256,36 @@ func (client *ClusterClient) ExecWithOptions(
 //
 // [Valkey GLIDE Documentation]: https://glide.valkey.io/concepts/client-features/custom-commands/
 func (client *ClusterClient) CustomCommand(ctx context.Context, args []string) (models.ClusterValue[any], error) {
-	res, err := client.executeCommand(ctx, C.CustomCommand, args)
+	return client.CustomCommandWithOptions(ctx, args, options.CustomCommandOptions{})
+}
+
+// CustomCommandWithOptions executes a single command, specified by args, without checking inputs, like
+// [ClusterClient.CustomCommand].
+//
+// When the client reads from replicas, see [config.ReadFrom], a custom command is sent to a replica of the slot of its key
+// if it only reads data. The client looks up every custom command once in the command table of the server, and treats
+// the commands flagged as "readonly" as read-only commands. Use [options.CustomCommandOptions.WithReadOnlyHint] for read
+// commands that the server does not flag as "readonly", e.g. some module commands.
+//
+// See [Valkey GLIDE Documentation] for details on the restrictions and limitations of the custom command API.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	args - Arguments for the custom command including the command name.
+//	opts - The read-only hint of the command, see [options.CustomCommandOptions].
+//
+// Return value:
+//
+//	The returned value for the custom command.
+//
+// [Valkey GLIDE Documentation]: https://glide.valkey.io/concepts/client-features/custom-commands/
+func (client *ClusterClient) CustomCommandWithOptions(
+	ctx context.Context,
+	args []string,
+	opts options.CustomCommandOptions,
+) (models.ClusterValue[any], error) {
+	res, err := client.executeCommandWithRoute(ctx, C.CustomCommand, args, client.customCommandRoute(ctx, args, opts))
 	if err != nil {
 		return models.CreateEmptyClusterValue[any](), err
 	}
@@ -275,6 +349,9 @@ func (client *ClusterClient) Info(ctx context.Context) (map[string]string, error
 //
 // Starting from server version 7, command supports multiple section arguments.
 //
+// If the introspection cache is enabled with [config.ClusterClientConfiguration.WithIntrospectionCache], the result of
+// the "server" section alone, routed to all nodes or all primaries, may be served from the cache.
+//
 // See [valkey.io] for details.
 //
 // Parameters:
@@ -297,6 +374,19 @@ func (client *ClusterClient) InfoWithOptions(
 	if err != nil {
 		return models.CreateEmptyClusterValue[string](), err
 	}
+	cacheKey, cacheable := "", false
+	if options.InfoOptions != nil && isServerSectionOnly(options.Sections) {
+		var route config.Route
+		if options.RouteOption != nil {
+			route = options.Route
+		}
+		cacheKey, cacheable = infoServerCacheKeyForRoute(route)
+	}
+	if cacheable {
+		if cached, ok := client.introspectionCache.Get(cacheKey); ok {
+			return models.CreateClusterMultiValue[string](utils.DeepCopy(cached).(map[string]string)), nil
+		}
+	}
 	if options.RouteOption == nil || options.RouteOption.Route == nil {
 		response, err := client.executeCommand(ctx, C.Info, optionArgs)
 		if err != nil {
@@ -306,6 +396,9 @@ func (client *ClusterClient) InfoWithOptions(
 		if err != nil {
 			return models.CreateEmptyClusterValue[string](), err
 		}
+		if cacheable {
+			client.introspectionCache.Put(cacheKey, utils.DeepCopy(data))
+		}
 		return models.CreateClusterMultiValue[string](data), nil
 	}
 	response, err := client.executeCommandWithRoute(ctx, C.Info, optionArgs, options.Route)
@@ -317,6 +410,9 @@ func (client *ClusterClient) InfoWithOptions(
 		if err != nil {
 			return models.CreateEmptyClusterValue[string](), err
 		}
+		if cacheable {
+			client.introspectionCache.Put(cacheKey, utils.DeepCopy(data))
+		}
 		return models.CreateClusterMultiValue[string](data), nil
 	}
 	data, err := handleStringResponse(response)
@@ -326,6 +422,36 @@ func (client *ClusterClient) InfoWithOptions(
 	return models.CreateClusterSingleValue[string](data), nil
 }
 
+// Returns the replication state of every shard, parsed from the "replication" section of `INFO` of the primaries into
+// [models.ReplicationStatus]. The command will be routed to all primary nodes.
+//
+// The status of every primary lists its connected replicas along with their lag, e.g. to gate the reads from replicas
+// on an acceptable lag.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The replication state of every shard, by address of its primary.
+//
+// [valkey.io]: https://valkey.io/commands/info/
+func (client *ClusterClient) ReplicationStatus(ctx context.Context) (map[string]models.ReplicationStatus, error) {
+	response, err := client.executeCommandWithRoute(
+		ctx,
+		C.Info,
+		[]string{string(constants.Replication)},
+		config.AllPrimaries,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return handleReplicationStatusMapResponse(response)
+}
+
 // CustomCommandWithRoute executes a single command, specified by args, without checking inputs. Every part of the command,
 // including the command name and subcommands, should be added as a separate value in args. The returning value depends on
 // the executed command.
@@ -653,6 +779,9 @@ func (client *ClusterClient) clusterScan(
 	default:
 		// Continue with execution
 	}
+	if err := client.checkCommandPolicy("SCAN"); err != nil {
+		return nil, err
+	}
 
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
 	resultChannel := make(chan payload, 1)
@@ -682,9 +811,10 @@ func (client *ClusterClient) clusterScan(
 	var cArgsPtr *C.uintptr_t = nil
 	var argLengthsPtr *C.ulong = nil
 	if len(args) > 0 {
-		cArgs, argLengths := toCStrings(args)
-		cArgsPtr = &cArgs[0]
-		argLengthsPtr = &argLengths[0]
+		cArgs, argLengths := client.buffers.encodeArgs(args)
+		defer client.buffers.releaseArgs(cArgs, argLengths)
+		cArgsPtr = &(*cArgs)[0]
+		argLengthsPtr = &(*argLengths)[0]
 	}
 
 	C.request_cluster_scan(
@@ -951,6 +1081,195 @@ func (client *ClusterClient) ClientIdWithOptions(
 	return models.CreateClusterSingleValue[int64](data), nil
 }
 
+// Returns information and statistics about the current connection, parsed into a [models.ClientInfo].
+// The command will be routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The connection information.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *ClusterClient) ClientInfo(ctx context.Context) (models.ClientInfo, error) {
+	response, err := client.executeCommand(ctx, C.ClientInfo, []string{})
+	if err != nil {
+		return models.ClientInfo{}, err
+	}
+	return handleClientInfoResponse(response)
+}
+
+// Returns information and statistics about the connections to the nodes defined by the route, parsed into
+// [models.ClientInfo].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	The connection information. For multi-node routes, a map of node addresses to connection information.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *ClusterClient) ClientInfoWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.ClientInfo], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientInfo, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.ClientInfo](), err
+	}
+	if opts.Route != nil &&
+		(opts.Route).IsMultiNode() {
+		data, err := handleClientInfoMapResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[models.ClientInfo](), err
+		}
+		return models.CreateClusterMultiValue[models.ClientInfo](data), nil
+	}
+	data, err := handleClientInfoResponse(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.ClientInfo](), err
+	}
+	return models.CreateClusterSingleValue[models.ClientInfo](data), nil
+}
+
+// Returns the client-side caching (tracking) state of the current connection, parsed into a
+// [models.ClientTrackingInfo]. The command will be routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The tracking flags, the redirect client ID and the broadcasting prefixes of the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-trackinginfo/
+func (client *ClusterClient) ClientTrackingInfo(ctx context.Context) (models.ClientTrackingInfo, error) {
+	response, err := client.executeCommand(ctx, C.ClientTrackingInfo, []string{})
+	if err != nil {
+		return models.ClientTrackingInfo{}, err
+	}
+	return handleClientTrackingInfoResponse(response)
+}
+
+// Returns the client-side caching (tracking) state of the connections to the nodes defined by the route, parsed into
+// [models.ClientTrackingInfo].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	The tracking information. For multi-node routes, a map of node addresses to tracking information.
+//
+// [valkey.io]: https://valkey.io/commands/client-trackinginfo/
+func (client *ClusterClient) ClientTrackingInfoWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.ClientTrackingInfo], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientTrackingInfo, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.ClientTrackingInfo](), err
+	}
+	if opts.Route != nil &&
+		(opts.Route).IsMultiNode() {
+		data, err := handleClientTrackingInfoMapResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[models.ClientTrackingInfo](), err
+		}
+		return models.CreateClusterMultiValue[models.ClientTrackingInfo](data), nil
+	}
+	data, err := handleClientTrackingInfoResponse(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.ClientTrackingInfo](), err
+	}
+	return models.CreateClusterSingleValue[models.ClientTrackingInfo](data), nil
+}
+
+// Returns information about the server and the current connection. The command will be routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The server name and version, the connection protocol and ID, the server mode and role, and the loaded modules.
+//
+// [valkey.io]: https://valkey.io/commands/hello/
+func (client *ClusterClient) Hello(ctx context.Context) (models.HelloResponse, error) {
+	response, err := client.executeCommand(ctx, C.Hello, []string{})
+	if err != nil {
+		return models.HelloResponse{}, err
+	}
+	return handleHelloResponse(response)
+}
+
+// Switches the protocol of the connections, authenticates and/or sets the connection name, and returns information about
+// the servers and the connections. The client remembers the protocol, credentials and connection name, and uses them
+// for all connections, including new ones.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	helloOptions - The [options.HelloOptions] for the command.
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	The server and connection information. For multi-node routes, a map of node addresses to information.
+//
+// [valkey.io]: https://valkey.io/commands/hello/
+func (client *ClusterClient) HelloWithOptions(
+	ctx context.Context,
+	helloOptions options.HelloOptions,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[models.HelloResponse], error) {
+	args, err := helloOptions.ToArgs()
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.HelloResponse](), err
+	}
+	response, err := client.executeCommandWithRoute(ctx, C.Hello, args, routeOptions.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.HelloResponse](), err
+	}
+	if routeOptions.Route != nil &&
+		(routeOptions.Route).IsMultiNode() {
+		data, err := handleHelloMapResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[models.HelloResponse](), err
+		}
+		return models.CreateClusterMultiValue[models.HelloResponse](data), nil
+	}
+	data, err := handleHelloResponse(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.HelloResponse](), err
+	}
+	return models.CreateClusterSingleValue[models.HelloResponse](data), nil
+}
+
 // Returns UNIX TIME of the last DB save timestamp or startup timestamp if no save was made since then.
 // The command is routed to a random node by default, which is safe for read-only commands.
 //
@@ -1137,6 +1456,30 @@ func (client *ClusterClient) ConfigGet(ctx context.Context,
 	return data, nil
 }
 
+// ConfigGetSorted gets the values of configuration parameters from a random node, like [ClusterClient.ConfigGet], sorted
+// by parameter name, so that the result is iterated and serialized deterministically, e.g. to compare configuration
+// snapshots.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	parameters - An array of configuration parameter names to retrieve values for.
+//
+// Return value:
+//
+//	The values of the configuration parameters, sorted by parameter name.
+//
+// [valkey.io]: https://valkey.io/commands/config-get/
+func (client *ClusterClient) ConfigGetSorted(ctx context.Context, parameters []string) (models.OrderedMap, error) {
+	values, err := client.ConfigGet(ctx, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewOrderedMap(values), nil
+}
+
 // Get the values of configuration parameters.
 //
 // Note:
@@ -1181,7 +1524,8 @@ func (client *ClusterClient) ConfigGetWithOptions(ctx context.Context,
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	connectionName - Connection name of the current connection.
+//	connectionName - Connection name of the current connection. The suffixes of the client name configured with
+//	  `WithClientNameSuffixes`, if any, are appended to it.
 //
 // Return value:
 //
@@ -1189,6 +1533,7 @@ func (client *ClusterClient) ConfigGetWithOptions(ctx context.Context,
 //
 // [valkey.io]: https://valkey.io/commands/client-setname/
 func (client *ClusterClient) ClientSetName(ctx context.Context, connectionName string) (string, error) {
+	connectionName = client.suffixedClientName(connectionName)
 	response, err := client.executeCommand(ctx, C.ClientSetName, []string{connectionName})
 	if err != nil {
 		return models.DefaultStringResponse, err
@@ -1205,7 +1550,8 @@ func (client *ClusterClient) ClientSetName(ctx context.Context, connectionName s
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	connectionName - Connection name of the current connection.
+//	connectionName - Connection name of the current connection. The suffixes of the client name configured with
+//	  `WithClientNameSuffixes`, if any, are appended to it.
 //	opts - Specifies the routing configuration for the command. The client will route the
 //	        command to the nodes defined by route.
 //
@@ -1218,6 +1564,7 @@ func (client *ClusterClient) ClientSetNameWithOptions(ctx context.Context,
 	connectionName string,
 	opts options.RouteOption,
 ) (string, error) {
+	connectionName = client.suffixedClientName(connectionName)
 	response, err := client.executeCommandWithRoute(ctx, C.ClientSetName, []string{connectionName}, opts.Route)
 	if err != nil {
 		return models.DefaultStringResponse, err
@@ -2901,6 +3248,8 @@ func (client *ClusterClient) ClusterNodesWithRoute(
 // ClusterShards returns the mapping of cluster slots to shards.
 // Each shard contains information about the primary and replicas.
 // The command will be routed to a random node.
+// If the introspection cache is enabled with [config.ClusterClientConfiguration.WithIntrospectionCache], the result may
+// be served from the cache.
 //
 // Since: Valkey 7.0 and above.
 //
@@ -2916,11 +3265,19 @@ func (client *ClusterClient) ClusterNodesWithRoute(
 //
 // [valkey.io]: https://valkey.io/commands/cluster-shards/
 func (client *ClusterClient) ClusterShards(ctx context.Context) ([]map[string]any, error) {
+	if cached, ok := client.introspectionCache.Get(clusterShardsCacheKey); ok {
+		return utils.DeepCopy(cached).([]map[string]any), nil
+	}
 	result, err := client.executeCommand(ctx, C.ClusterShards, []string{})
 	if err != nil {
 		return nil, err
 	}
-	return handleArrayOfMapsResponse(result)
+	shards, err := handleArrayOfMapsResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	client.introspectionCache.Put(clusterShardsCacheKey, utils.DeepCopy(shards))
+	return shards, nil
 }
 
 // ClusterShardsWithRoute returns the mapping of cluster slots to shards with routing options.
@@ -3214,3 +3571,198 @@ func (client *ClusterClient) ClusterLinksWithRoute(
 	}
 	return models.CreateClusterSingleValue[[]map[string]any](data), nil
 }
+
+// ClusterFailover forces the replica the command is routed to to start a manual failover of its primary.
+//
+// The command must be routed to a replica, usually with a [config.ByAddressRoute]. Routing it to a primary returns an error.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`"OK"` if the failover was accepted. The failover itself is performed asynchronously.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-failover/
+func (client *ClusterClient) ClusterFailover(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterFailover, []string{}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// ClusterFailoverWithMode forces the replica the command is routed to to start a manual failover of its primary, using the
+// given [options.FailoverMode].
+//
+// The command must be routed to a replica, usually with a [config.ByAddressRoute]. Routing it to a primary returns an error.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	mode - Either [options.FailoverForce] or [options.FailoverTakeover].
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`"OK"` if the failover was accepted. The failover itself is performed asynchronously.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-failover/
+func (client *ClusterClient) ClusterFailoverWithMode(
+	ctx context.Context,
+	mode options.FailoverMode,
+	route options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterFailover, []string{string(mode)}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// ClusterSetSlot alters the state of a hash slot in the node the command is routed to. This is the building block of slot
+// migration: the target node is marked as importing, the source node as migrating, the keys are moved with `MIGRATE`, and
+// finally the slot is assigned to the target node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The hash slot number (0-16383).
+//	subcommand - The new state of the slot, see [options.ClusterSetSlotSubcommand].
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`"OK"` on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-setslot/
+func (client *ClusterClient) ClusterSetSlot(
+	ctx context.Context,
+	slot int64,
+	subcommand options.ClusterSetSlotSubcommand,
+	route options.RouteOption,
+) (string, error) {
+	subcommandArgs, err := subcommand.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	args := append([]string{utils.IntToString(slot)}, subcommandArgs...)
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterSetslot, args, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// ClusterMeet connects the node the command is routed to with the node at the given address, adding it to the cluster.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	host - The IP address or hostname of the node to meet.
+//	port - The port of the node to meet.
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`"OK"` if the handshake was started. The handshake itself is performed asynchronously.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-meet/
+func (client *ClusterClient) ClusterMeet(
+	ctx context.Context,
+	host string,
+	port int64,
+	route options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.ClusterMeet,
+		[]string{host, utils.IntToString(port)},
+		route.Route,
+	)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// ClusterForget removes the node with the given ID from the node table of the node the command is routed to. To remove a
+// node from the cluster, the command must be sent to every other node within 60 seconds.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	nodeId - The ID of the node to forget.
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`"OK"` on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-forget/
+func (client *ClusterClient) ClusterForget(ctx context.Context, nodeId string, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterForget, []string{nodeId}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// ClusterAddSlots assigns the given hash slots to the node the command is routed to. The slots must not be assigned to any
+// other node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slots - The hash slot numbers (0-16383) to assign.
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`"OK"` on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-addslots/
+func (client *ClusterClient) ClusterAddSlots(ctx context.Context, slots []int64, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterAddSlots, utils.IntsToStrings(slots), route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// ClusterDelSlots makes the node the command is routed to forget which primary serves the given hash slots.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slots - The hash slot numbers (0-16383) to unassign.
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`"OK"` on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-delslots/
+func (client *ClusterClient) ClusterDelSlots(ctx context.Context, slots []int64, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterDelSlots, utils.IntsToStrings(slots), route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
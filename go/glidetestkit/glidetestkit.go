@@ -0,0 +1,301 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package glidetestkit runs the integration tests of the libraries built on Valkey GLIDE against real servers: it starts
+// local standalone servers and clusters with the `utils/cluster_manager.py` script of the Valkey GLIDE repository, and
+// skips the tests of the features missing from the version of the servers.
+//
+// The script requires Python 3 and the `valkey-server` binaries of the version to test on the `PATH`, as the integration
+// tests of Valkey GLIDE itself do:
+//
+//	func TestMyLibrary(t *testing.T) {
+//		servers := glidetestkit.NewClusterManager("").StartForTest(t, true, 1)
+//		glidetestkit.SkipIfServerVersionLowerThan(t, servers.ServerVersionForTest(t), "7.0.0")
+//		client, err := glide.NewClusterClient(servers.ClusterClientConfig())
+//		...
+//	}
+package glidetestkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// HomeDirEnv is the environment variable holding the path of the Valkey GLIDE repository, whose script and TLS
+// certificates are used unless set explicitly. If it is not set, the repository is the parent of the parent of the
+// working directory of the tests, as for the `go/integTest` directory of the repository.
+const HomeDirEnv = "GLIDE_HOME_DIR"
+
+// ClusterManager runs the `utils/cluster_manager.py` script of the Valkey GLIDE repository, which starts and stops local
+// servers.
+type ClusterManager struct {
+	script string
+	tls    bool
+}
+
+// NewClusterManager creates a [ClusterManager] running the script at `script`, or at `utils/cluster_manager.py` of the
+// repository of [HomeDirEnv] if `script` is empty.
+func NewClusterManager(script string) *ClusterManager {
+	if script == "" {
+		script = filepath.Join(homeDir(), "utils", "cluster_manager.py")
+	}
+	return &ClusterManager{script: script}
+}
+
+// WithTLS sets whether the servers started and stopped by the manager accept TLS connections only.
+func (m *ClusterManager) WithTLS(tls bool) *ClusterManager {
+	m.tls = tls
+	return m
+}
+
+// Run runs the script with the arguments `args`, preceded by `--tls` if the manager was created with [WithTLS], and
+// returns its combined output. The error of a failed run includes its output.
+func (m *ClusterManager) Run(ctx context.Context, args ...string) (string, error) {
+	if m.tls {
+		args = append([]string{"--tls"}, args...)
+	}
+	output, err := exec.CommandContext(ctx, "python3", append([]string{m.script}, args...)...).CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("%s failed: %w\n%s", filepath.Base(m.script), err, output)
+	}
+	return string(output), nil
+}
+
+// Start starts a local standalone server with `replicas` replicas, or a cluster with `replicas` replicas per shard if
+// `clusterMode` is set. `args` are passed to the `start` action of the script, e.g. "--shard-count", "5".
+//
+// Return value:
+//
+//	The servers started, to be stopped with [ClusterManager.Stop].
+func (m *ClusterManager) Start(ctx context.Context, clusterMode bool, replicas int, args ...string) (*Deployment, error) {
+	command := []string{"start", "-r", strconv.Itoa(replicas)}
+	if clusterMode {
+		command = append(command, "--cluster-mode")
+	}
+	output, err := m.Run(ctx, append(command, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	folder, addresses, err := ParseStartOutput(output)
+	if err != nil {
+		return nil, err
+	}
+	return &Deployment{Addresses: addresses, Folder: folder, ClusterMode: clusterMode, TLS: m.tls}, nil
+}
+
+// StartForTest starts the servers like [ClusterManager.Start], and stops them once `t` and its subtests completed. `t`
+// fails if the servers cannot be started.
+func (m *ClusterManager) StartForTest(t testing.TB, clusterMode bool, replicas int, args ...string) *Deployment {
+	t.Helper()
+	deployment, err := m.Start(context.Background(), clusterMode, replicas, args...)
+	if err != nil {
+		t.Fatalf("Failed to start the servers: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := m.Stop(context.Background(), deployment); err != nil {
+			t.Logf("Failed to stop the servers: %v", err)
+		}
+	})
+	return deployment
+}
+
+// Stop stops the servers of `deployment`, started with [ClusterManager.Start], and deletes their folder.
+func (m *ClusterManager) Stop(ctx context.Context, deployment *Deployment) error {
+	if deployment.Folder == "" {
+		return errors.New("the servers were not started by the cluster manager")
+	}
+	_, err := m.Run(ctx, "stop", "--cluster-folder", deployment.Folder)
+	return err
+}
+
+// StopAll stops all the servers whose folder name starts with `prefix`, e.g. the servers left running by an interrupted
+// test run, and deletes their folders unless `keepFolders` is set.
+func (m *ClusterManager) StopAll(ctx context.Context, prefix string, keepFolders bool) error {
+	args := []string{"stop", "--prefix", prefix}
+	if keepFolders {
+		args = append(args, "--keep-folder")
+	}
+	_, err := m.Run(ctx, args...)
+	return err
+}
+
+// Deployment is a standalone server with its replicas, or a cluster, to run tests against.
+type Deployment struct {
+	// The addresses of the servers.
+	Addresses []config.NodeAddress
+	// The folder of the servers started by [ClusterManager.Start], or empty if they were started otherwise.
+	Folder string
+	// Whether the servers are a cluster.
+	ClusterMode bool
+	// Whether the servers accept TLS connections only.
+	TLS bool
+	// The root certificates the TLS connections to the servers are verified with, or nil to use the certificates of
+	// `utils/tls_crts/ca.crt` in the repository of [HomeDirEnv], which signed the certificates of the servers started by
+	// [ClusterManager.Start].
+	RootCertificates []byte
+}
+
+// NewDeployment creates a [Deployment] of the servers already running at `addresses`, e.g. "localhost:6379,localhost:6380"
+// as passed to the `-standalone-endpoints` and `-cluster-endpoints` flags of the integration tests of Valkey GLIDE.
+func NewDeployment(addresses string, clusterMode bool, tls bool) (*Deployment, error) {
+	nodes, err := ParseAddresses(addresses)
+	if err != nil {
+		return nil, err
+	}
+	return &Deployment{Addresses: nodes, ClusterMode: clusterMode, TLS: tls}, nil
+}
+
+// ClientConfig returns the configuration of a standalone client connecting to the servers of the deployment.
+func (d *Deployment) ClientConfig() *config.ClientConfiguration {
+	clientConfig := config.NewClientConfiguration().WithUseTLS(d.TLS)
+	for i := range d.Addresses {
+		clientConfig.WithAddress(&d.Addresses[i])
+	}
+	if certificates := d.rootCertificates(); certificates != nil {
+		clientConfig.WithAdvancedConfiguration(
+			config.NewAdvancedClientConfiguration().
+				WithTlsConfiguration(config.NewTlsConfiguration().WithRootCertificates(certificates)),
+		)
+	}
+	return clientConfig
+}
+
+// ClusterClientConfig returns the configuration of a cluster client connecting to the servers of the deployment.
+func (d *Deployment) ClusterClientConfig() *config.ClusterClientConfiguration {
+	clientConfig := config.NewClusterClientConfiguration().WithUseTLS(d.TLS)
+	for i := range d.Addresses {
+		clientConfig.WithAddress(&d.Addresses[i])
+	}
+	if certificates := d.rootCertificates(); certificates != nil {
+		clientConfig.WithAdvancedConfiguration(
+			config.NewAdvancedClusterClientConfiguration().
+				WithTlsConfiguration(config.NewTlsConfiguration().WithRootCertificates(certificates)),
+		)
+	}
+	return clientConfig
+}
+
+// ServerVersion returns the version of the servers of the deployment, e.g. "8.1.0", as reported by `INFO SERVER`.
+func (d *Deployment) ServerVersion(ctx context.Context) (string, error) {
+	if len(d.Addresses) == 0 {
+		return "", errors.New("the deployment has no servers")
+	}
+	var info string
+	if d.ClusterMode {
+		client, err := glide.NewClusterClient(d.ClusterClientConfig().WithRequestTimeout(5 * time.Second))
+		if err != nil {
+			return "", err
+		}
+		defer client.Close()
+		reply, err := client.InfoWithOptions(ctx, options.ClusterInfoOptions{
+			InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Server}},
+			RouteOption: &options.RouteOption{Route: config.RandomRoute},
+		})
+		if err != nil {
+			return "", err
+		}
+		info = reply.SingleValue()
+	} else {
+		client, err := glide.NewClient(d.ClientConfig().WithRequestTimeout(5 * time.Second))
+		if err != nil {
+			return "", err
+		}
+		defer client.Close()
+		info, err = client.InfoWithOptions(ctx, options.InfoOptions{Sections: []constants.Section{constants.Server}})
+		if err != nil {
+			return "", err
+		}
+	}
+	return ParseServerVersion(info)
+}
+
+// ServerVersionForTest returns the version of the servers like [Deployment.ServerVersion]. `t` fails if the version
+// cannot be read.
+func (d *Deployment) ServerVersionForTest(t testing.TB) string {
+	t.Helper()
+	version, err := d.ServerVersion(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to read the server version: %v", err)
+	}
+	return version
+}
+
+// rootCertificates returns the root certificates the TLS connections to the servers are verified with, or nil if the
+// servers do not accept TLS connections, or if the certificates of the repository cannot be loaded.
+func (d *Deployment) rootCertificates() []byte {
+	if !d.TLS {
+		return nil
+	}
+	if d.RootCertificates != nil {
+		return d.RootCertificates
+	}
+	path, err := filepath.Abs(filepath.Join(homeDir(), "utils", "tls_crts", "ca.crt"))
+	if err != nil {
+		return nil
+	}
+	certificates, err := config.LoadRootCertificatesFromFile(path)
+	if err != nil {
+		return nil
+	}
+	return certificates
+}
+
+// ParseStartOutput parses the output of the `start` action of the script into the folder and the addresses of the
+// servers started.
+func ParseStartOutput(output string) (string, []config.NodeAddress, error) {
+	folder := ""
+	var addresses []config.NodeAddress
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if value, ok := strings.CutPrefix(line, "CLUSTER_FOLDER="); ok {
+			folder = value
+		} else if value, ok := strings.CutPrefix(line, "CLUSTER_NODES="); ok {
+			nodes, err := ParseAddresses(value)
+			if err != nil {
+				return "", nil, err
+			}
+			addresses = nodes
+		}
+	}
+	if folder == "" || len(addresses) == 0 {
+		return "", nil, fmt.Errorf("no servers in the output of the cluster manager:\n%s", output)
+	}
+	return folder, addresses, nil
+}
+
+// ParseAddresses parses the comma-separated addresses `addresses`, e.g. "localhost:6379,localhost:6380".
+func ParseAddresses(addresses string) ([]config.NodeAddress, error) {
+	var nodes []config.NodeAddress
+	for _, address := range strings.Split(addresses, ",") {
+		address = strings.TrimSpace(address)
+		separator := strings.LastIndex(address, ":")
+		if separator < 0 {
+			return nil, fmt.Errorf("no port in address %q", address)
+		}
+		port, err := strconv.Atoi(address[separator+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid port in address %q: %w", address, err)
+		}
+		nodes = append(nodes, config.NodeAddress{Host: address[:separator], Port: port})
+	}
+	return nodes, nil
+}
+
+func homeDir() string {
+	if home := os.Getenv(HomeDirEnv); home != "" {
+		return home
+	}
+	return filepath.Join("..", "..")
+}
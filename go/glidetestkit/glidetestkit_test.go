@@ -0,0 +1,57 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetestkit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestParseStartOutput(t *testing.T) {
+	output := "2025-01-01 INFO Created Cluster Redis in 1.2 seconds\n" +
+		"CLUSTER_FOLDER=/tmp/clusters/cluster-2025\n" +
+		"CLUSTER_NODES=127.0.0.1:6379,127.0.0.1:6380\n"
+	folder, addresses, err := ParseStartOutput(output)
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/clusters/cluster-2025", folder)
+	assert.Equal(t, []config.NodeAddress{{Host: "127.0.0.1", Port: 6379}, {Host: "127.0.0.1", Port: 6380}}, addresses)
+
+	_, _, err = ParseStartOutput("Traceback (most recent call last):\n")
+	assert.Error(t, err)
+}
+
+func TestParseAddresses(t *testing.T) {
+	addresses, err := ParseAddresses("localhost:6379, ::1:6380")
+	require.NoError(t, err)
+	assert.Equal(t, []config.NodeAddress{{Host: "localhost", Port: 6379}, {Host: "::1", Port: 6380}}, addresses)
+
+	_, err = ParseAddresses("localhost")
+	assert.Error(t, err)
+	_, err = ParseAddresses("localhost:port")
+	assert.Error(t, err)
+}
+
+func TestParseServerVersion(t *testing.T) {
+	version, err := ParseServerVersion("# Server\r\nredis_version:7.2.4\r\nvalkey_version:8.1.0\r\n")
+	require.NoError(t, err)
+	assert.Equal(t, "8.1.0", version)
+
+	version, err = ParseServerVersion("# Server\r\nredis_version:6.2.14\r\n")
+	require.NoError(t, err)
+	assert.Equal(t, "6.2.14", version)
+
+	_, err = ParseServerVersion("# Server\r\n")
+	assert.Error(t, err)
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, CompareVersions("7.2.0", "7.2.0"))
+	assert.Equal(t, 0, CompareVersions("7.2", "7.2.0"))
+	assert.Equal(t, -1, CompareVersions("7.2.4", "8.0.0"))
+	assert.Equal(t, 1, CompareVersions("10.0.0", "9.0.0"))
+	assert.Equal(t, 1, CompareVersions("7.10.0", "7.9.0"))
+	assert.Equal(t, 0, CompareVersions("9.0.0-rc1", "9.0.0"))
+}
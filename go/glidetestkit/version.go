@@ -0,0 +1,70 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetestkit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// ParseServerVersion returns the version of the server reporting `info`, the reply of `INFO SERVER`. The version of
+// Valkey, `valkey_version`, takes precedence over the version of the protocol it is compatible with, `redis_version`.
+func ParseServerVersion(info string) (string, error) {
+	versions := map[string]string{}
+	for _, line := range strings.Split(info, "\n") {
+		field, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if ok && (field == "valkey_version" || field == "redis_version") {
+			versions[field] = value
+		}
+	}
+	if version, ok := versions["valkey_version"]; ok {
+		return version, nil
+	}
+	if version, ok := versions["redis_version"]; ok {
+		return version, nil
+	}
+	return "", fmt.Errorf("no server version in INFO output: %s", info)
+}
+
+// CompareVersions compares the versions `a` and `b`, e.g. "7.2.4" and "8.0.0", number by number, and returns -1 if `a` is
+// lower than `b`, 0 if they are equal and 1 if `a` is greater than `b`. The missing numbers count as 0, and the suffixes
+// of the numbers, e.g. "-rc1", are ignored.
+func CompareVersions(a, b string) int {
+	numbersA := strings.Split(a, ".")
+	numbersB := strings.Split(b, ".")
+	for i := 0; i < max(len(numbersA), len(numbersB)); i++ {
+		numberA, numberB := versionNumber(numbersA, i), versionNumber(numbersB, i)
+		if numberA < numberB {
+			return -1
+		}
+		if numberA > numberB {
+			return 1
+		}
+	}
+	return 0
+}
+
+// SkipIfServerVersionLowerThan skips `t` if `serverVersion`, as returned by [Deployment.ServerVersion], is lower than
+// `version`, the version that added the feature tested.
+func SkipIfServerVersionLowerThan(t testing.TB, serverVersion string, version string) {
+	t.Helper()
+	if CompareVersions(serverVersion, version) < 0 {
+		t.Skipf("This feature is added in version %s", version)
+	}
+}
+
+// versionNumber returns the number at `index` of the numbers of a version, or 0 if it is missing.
+func versionNumber(numbers []string, index int) int {
+	if index >= len(numbers) {
+		return 0
+	}
+	digits := numbers[index]
+	end := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' })
+	if end >= 0 {
+		digits = digits[:end]
+	}
+	number, _ := strconv.Atoi(digits)
+	return number
+}
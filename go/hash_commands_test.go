@@ -4,6 +4,7 @@ package glide
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -59,6 +60,51 @@ func ExampleClusterClient_HGet() {
 	// true
 }
 
+func ExampleClient_HGetInto() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.HSet(context.Background(), "my_hash", map[string]string{"field1": "someValue"})
+	buf := make([]byte, 64)
+	n, err := client.HGetInto(context.Background(), "my_hash", "field1", buf)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(string(buf[:n]))
+
+	n, err = client.HGetInto(context.Background(), "my_hash", "nonexistent_field", buf)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(n)
+
+	// Output:
+	// someValue
+	// -1
+}
+
+func ExampleClient_HGetHedged() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.HSet(context.Background(), "my_hash", map[string]string{"field1": "someValue"})
+	result, err := client.HGetHedged(context.Background(), "my_hash", "field1", *options.NewHedgeOptions())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+
+	// A fixed hedging delay instead of the 99th percentile of the observed latencies
+	opts := options.NewHedgeOptions().SetDelay(5 * time.Millisecond)
+	result, err = client.HGetHedged(context.Background(), "my_hash", "nonexistent_field", *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsNil())
+
+	// Output:
+	// someValue
+	// true
+}
+
 func ExampleClient_HGetAll() {
 	var client *Client = getExampleClient() // example helper function
 
@@ -83,6 +129,87 @@ func ExampleClient_HGetAll() {
 	// someOtherValue
 }
 
+func ExampleClient_HGetAllOrdered() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.HSet(context.Background(), "my_hash", map[string]string{"field2": "someValue"})
+	client.HSet(context.Background(), "my_hash", map[string]string{"field1": "someOtherValue"})
+	payload, err := client.HGetAllOrdered(context.Background(), "my_hash")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, entry := range payload {
+		fmt.Println(entry.Field, entry.Value)
+	}
+
+	// Output:
+	// field2 someValue
+	// field1 someOtherValue
+}
+
+func ExampleClient_HGetAllSorted() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.HSet(context.Background(), "my_hash", map[string]string{"field2": "someValue", "field1": "someOtherValue"})
+	payload, err := client.HGetAllSorted(context.Background(), "my_hash")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(string(data))
+
+	// Output:
+	// {"field1":"someOtherValue","field2":"someValue"}
+}
+
+func ExampleClusterClient_HGetInto() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.HSet(context.Background(), "my_hash", map[string]string{"field1": "someValue"})
+	buf := make([]byte, 64)
+	n, err := client.HGetInto(context.Background(), "my_hash", "field1", buf)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(string(buf[:n]))
+
+	n, err = client.HGetInto(context.Background(), "my_hash", "nonexistent_field", buf)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(n)
+
+	// Output:
+	// someValue
+	// -1
+}
+
+func ExampleClusterClient_HGetHedged() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.HSet(context.Background(), "my_hash", map[string]string{"field1": "someValue"})
+	result, err := client.HGetHedged(context.Background(), "my_hash", "field1", *options.NewHedgeOptions())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+
+	// A fixed hedging delay instead of the 99th percentile of the observed latencies
+	opts := options.NewHedgeOptions().SetDelay(5 * time.Millisecond)
+	result, err = client.HGetHedged(context.Background(), "my_hash", "nonexistent_field", *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsNil())
+
+	// Output:
+	// someValue
+	// true
+}
+
 func ExampleClusterClient_HGetAll() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 
@@ -0,0 +1,179 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// The number of recent hedged read latencies kept to compute the hedging delay, and the number of reads observed before
+// the delay is computed from them.
+const (
+	hedgeLatencyWindowSize = 1024
+	hedgeMinLatencySamples = 32
+)
+
+func newHedgeLatencyWindow() *utils.LatencyWindow {
+	return utils.NewLatencyWindow(hedgeLatencyWindowSize, hedgeMinLatencySamples)
+}
+
+// GetHedged gets the string value associated with the given key like [Client.Get], sending a duplicate read to another
+// replica if the first one has not completed after the hedging delay. The first successful response is returned, and the
+// other request is cancelled.
+//
+// Hedging lowers the tail latency of latency-sensitive reads, at the cost of duplicate reads for the slowest ones. It is
+// most effective when reading from replicas, see [config.ReadFrom].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to be retrieved from the database.
+//	opts - The hedging delay, see [options.HedgeOptions].
+//
+// Return value:
+//
+//	If key exists, returns the value of key as a String. Otherwise, return [models.CreateNilStringResult()].
+//
+// [valkey.io]: https://valkey.io/commands/get/
+func (client *baseClient) GetHedged(
+	ctx context.Context,
+	key string,
+	opts options.HedgeOptions,
+) (models.Result[string], error) {
+	result, err := client.executeHedgedRead(ctx, C.Get, []string{key}, key, opts)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+
+	return handleStringOrNilResponse(result)
+}
+
+// HGetHedged gets the value associated with field in the hash stored at key like [Client.HGet], sending a duplicate read
+// to another replica if the first one has not completed after the hedging delay. See [Client.GetHedged] for details.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	field - The field in the hash stored at key to retrieve from the database.
+//	opts - The hedging delay, see [options.HedgeOptions].
+//
+// Return value:
+//
+//	The models.Result[string] associated with field, or [models.CreateNilStringResult()] when field is not
+//	present in the hash or key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/hget/
+func (client *baseClient) HGetHedged(
+	ctx context.Context,
+	key string,
+	field string,
+	opts options.HedgeOptions,
+) (models.Result[string], error) {
+	result, err := client.executeHedgedRead(ctx, C.HGet, []string{key, field}, key, opts)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+
+	return handleStringOrNilResponse(result)
+}
+
+type hedgedResponse struct {
+	response *C.struct_CommandResponse
+	err      error
+}
+
+// executeHedgedRead sends a read command on `key`, and a duplicate if the first one has not completed after the hedging
+// delay. The first successful response is returned, and the other request is cancelled. If the first request fails
+// before the delay, its error is returned without hedging.
+func (client *baseClient) executeHedgedRead(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	key string,
+	opts options.HedgeOptions,
+) (*C.struct_CommandResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered, so that the request that lost does not block once the result is returned.
+	responses := make(chan hedgedResponse, 2)
+	send := func(route config.Route) {
+		go func() {
+			response, err := client.executeCommandWithRoute(ctx, requestType, args, route)
+			responses <- hedgedResponse{response, err}
+		}()
+	}
+
+	start := time.Now()
+	send(nil)
+	pending := 1
+	timer := time.NewTimer(client.hedgeDelay(opts))
+	defer timer.Stop()
+	hedge := timer.C
+
+	var firstErr error
+	for pending > 0 {
+		select {
+		case <-hedge:
+			hedge = nil
+			send(client.hedgeRoute(key))
+			pending++
+		case result := <-responses:
+			pending--
+			if result.err == nil {
+				client.hedgeLatencies.Record(time.Since(start))
+				// The other request is cancelled when returning. Free its response in case it completed meanwhile.
+				go func(pending int) {
+					for ; pending > 0; pending-- {
+						if other := <-responses; other.response != nil {
+							C.free_command_response(other.response)
+						}
+					}
+				}(pending)
+				return result.response, nil
+			}
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			if hedge != nil {
+				// Failed before the hedging delay, do not duplicate the read.
+				return nil, result.err
+			}
+		}
+	}
+	return nil, firstErr
+}
+
+// hedgeDelay returns the delay after which a hedged read is duplicated.
+func (client *baseClient) hedgeDelay(opts options.HedgeOptions) time.Duration {
+	if opts.Delay > 0 {
+		return opts.Delay
+	}
+	if delay, ok := client.hedgeLatencies.Percentile(opts.Percentile); ok {
+		return delay
+	}
+	return options.DefaultHedgeDelay
+}
+
+// hedgeRoute returns the route of the duplicate of a hedged read on `key`. In cluster mode, the duplicate is sent to a
+// replica of the slot of the key. Standalone clients send it according to their read strategy.
+func (client *baseClient) hedgeRoute(key string) config.Route {
+	if client.clusterMode {
+		return config.NewSlotKeyRoute(config.SlotTypeReplica, key)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/admin"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestDeleteByPattern() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		prefix := uuid.NewString() + ":"
+		keys := make(map[string]string, 50)
+		for range 50 {
+			keys[prefix+uuid.NewString()] = "value"
+		}
+		suite.verifyOK(client.MSet(ctx, keys))
+		other := uuid.NewString()
+		suite.verifyOK(client.Set(ctx, other, "value"))
+
+		result, err := admin.DeleteByPattern(ctx, client, prefix+"*", admin.DeleteOptions{DryRun: true})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, result.Matched, int64(50))
+		assert.Zero(t, result.Deleted)
+
+		result, err = admin.DeleteByPattern(ctx, client, prefix+"*", admin.DeleteOptions{
+			BatchSize:        20,
+			MaxKeysPerSecond: 1000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), result.Deleted)
+
+		result, err = admin.DeleteByPattern(ctx, client, prefix+"*", admin.DeleteOptions{})
+		require.NoError(t, err)
+		assert.Zero(t, result.Matched)
+		exists, err := client.Exists(ctx, []string{other})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), exists)
+	})
+}
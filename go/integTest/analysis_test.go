@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/analysis"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestEstimateIntersection() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		tag := "{" + uuid.NewString() + "}"
+		first, second, third := tag+":first", tag+":second", tag+":third"
+
+		members := func(from int, to int) []string {
+			members := make([]string, 0, to-from)
+			for i := from; i < to; i++ {
+				members = append(members, fmt.Sprint("member-", i))
+			}
+			return members
+		}
+		// The sketch of the first set is maintained, the sketches of the others are created on the fly
+		_, err := client.SAdd(ctx, first, members(0, 5000))
+		require.NoError(t, err)
+		added, err := analysis.Add(ctx, client, first, members(5000, 10000)...)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5000), added)
+		_, err = client.SAdd(ctx, second, members(5000, 15000))
+		require.NoError(t, err)
+		_, err = client.SAdd(ctx, third, members(8000, 20000))
+		require.NoError(t, err)
+
+		estimate, err := analysis.EstimateIntersection(ctx, client, []string{first, second})
+		require.NoError(t, err)
+		assert.InDelta(t, 5000, estimate, 500)
+		estimate, err = analysis.EstimateIntersection(ctx, client, []string{first, second, third})
+		require.NoError(t, err)
+		assert.InDelta(t, 2000, estimate, 500)
+
+		ttl, err := client.PTTL(ctx, analysis.SketchKey(second))
+		require.NoError(t, err)
+		assert.Greater(t, ttl, int64(0))
+		ttl, err = client.PTTL(ctx, analysis.SketchKey(first))
+		require.NoError(t, err)
+		assert.Equal(t, int64(-1), ttl)
+
+		// The sketches created on the fly may be deleted right away
+		deleted, err := analysis.DeleteSketches(ctx, client, second, third)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+		estimate, err = analysis.EstimateIntersectionWithOptions(
+			ctx,
+			client,
+			[]string{second, third},
+			analysis.EstimateOptions{SketchTTL: -time.Second},
+		)
+		require.NoError(t, err)
+		assert.InDelta(t, 7000, estimate, 700)
+		exists, err := client.Exists(ctx, []string{analysis.SketchKey(second), analysis.SketchKey(third)})
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+
+		// A single set and an empty set are counted exactly
+		estimate, err = analysis.EstimateIntersection(ctx, client, []string{third})
+		require.NoError(t, err)
+		assert.Equal(t, int64(12000), estimate)
+		estimate, err = analysis.EstimateIntersection(ctx, client, []string{first, tag + ":missing"})
+		require.NoError(t, err)
+		assert.Zero(t, estimate)
+
+		_, err = analysis.EstimateIntersection(ctx, client, nil)
+		assert.Error(t, err)
+	})
+}
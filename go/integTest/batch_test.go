@@ -95,6 +95,45 @@ func (suite *GlideTestSuite) TestBatchRaiseOnError() {
 	})
 }
 
+func (suite *GlideTestSuite) TestExecTransaction() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := "{ExecTransaction}" + uuid.NewString()
+		batch := pipeline.NewClusterBatch(true).Set(key, "hello").LPop(key).Incr(key).Get(key)
+
+		var result models.TransactionResult
+		var err error
+		switch c := client.(type) {
+		case *glide.ClusterClient:
+			result, err = c.ExecTransaction(ctx, *batch, *pipeline.NewClusterBatchOptions())
+		case *glide.Client:
+			standaloneBatch := pipeline.NewStandaloneBatch(true).Set(key, "hello").LPop(key).Incr(key).Get(key)
+			result, err = c.ExecTransaction(ctx, *standaloneBatch, *pipeline.NewStandaloneBatchOptions())
+		}
+		suite.NoError(err)
+
+		// The failed commands are reported with their index and name
+		suite.False(result.Aborted)
+		suite.Equal([]any{"OK", nil, nil, "hello"}, result.Values())
+		failed := result.Failed()
+		suite.Require().Len(failed, 2)
+		suite.Equal(1, failed[0].Index)
+		suite.Equal("LPOP", failed[0].Command)
+		suite.ErrorContains(failed[0].Err, "wrong kind of value")
+		suite.Equal(2, failed[1].Index)
+		suite.Equal("INCR", failed[1].Command)
+		var commandErr *models.CommandError
+		suite.Require().ErrorAs(result.Err(), &commandErr)
+		suite.Equal(1, commandErr.Index)
+
+		// Only transactions are supported
+		if c, ok := client.(*glide.ClusterClient); ok {
+			_, err = c.ExecTransaction(ctx, *pipeline.NewClusterBatch(false).Get(key), *pipeline.NewClusterBatchOptions())
+			suite.Error(err)
+		}
+	})
+}
+
 func (suite *GlideTestSuite) TestBatchDumpRestore() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := "{prefix}" + uuid.NewString()
@@ -334,6 +373,11 @@ func (suite *GlideTestSuite) TestBatchConvertersHandleServerError() {
 				ZRankWithScore(key1, "d").
 				ZRevRankWithScore(key1, "d")
 		}
+		if suite.serverVersion >= "8.2.0" {
+			transaction.
+				XDelEx(key1, []string{"0-0"}).
+				XAckDel(key1, "g", []string{"0-0"})
+		}
 
 		res, err := runBatchOnClient(client, transaction, false, nil)
 		suite.NoError(err)
@@ -1836,7 +1880,7 @@ func CreateSortedSetTests(batch *pipeline.ClusterBatch, isAtomic bool, serverVer
 	batch.ZCard(key)
 	testData = append(testData, CommandTestData{ExpectedResponse: int64(1), TestName: "ZCard(key)"})
 
-	batch.BZPopMin([]string{key}, 1)
+	batch.BZPopMin([]string{key}, time.Second)
 	testData = append(
 		testData,
 		CommandTestData{
@@ -1893,12 +1937,12 @@ func CreateSortedSetTests(batch *pipeline.ClusterBatch, isAtomic bool, serverVer
 	batch.ZRange(key, rangeQuery)
 	testData = append(testData, CommandTestData{ExpectedResponse: []string{"member2"}, TestName: "ZRange(key, 0, -1)"})
 
-	batch.BZPopMax([]string{key}, 1)
+	batch.BZPopMax([]string{key}, time.Second)
 	testData = append(
 		testData,
 		CommandTestData{
 			ExpectedResponse: models.KeyWithMemberAndScore{Key: key, Member: "member2", Score: 2},
-			TestName:         "BZPopMax(key, 1)",
+			TestName:         "BZPopMax([key])",
 		},
 	)
 
@@ -2406,6 +2450,10 @@ func CreateStreamTest(batch *pipeline.ClusterBatch, isAtomic bool, serverVer str
 		batch.XDel(streamKey1, []string{"0-3", "0-5"})
 		testData = append(testData, CommandTestData{ExpectedResponse: int64(1), TestName: "XDel(streamKey1, 0-3,0-5)"})
 
+		// XSETID command
+		batch.XSetId(streamKey1, "0-10")
+		testData = append(testData, CommandTestData{ExpectedResponse: "OK", TestName: "XSetId(streamKey1, 0-10)"})
+
 		// Add entry to streamKey3 and create group
 		xaddOpts5 := options.NewXAddOptions().SetId("1-0")
 		batch.XAddWithOptions(streamKey3, []models.FieldValue{{Field: "f0", Value: "v0"}}, *xaddOpts5)
@@ -2443,6 +2491,56 @@ func CreateStreamTest(batch *pipeline.ClusterBatch, isAtomic bool, serverVer str
 			testData,
 			CommandTestData{ExpectedResponse: "OK", TestName: "XGroupSetId(streamKey2, groupName3, 1-0)"},
 		)
+
+		xsetIdOpts := options.NewXSetIdOptions().SetEntriesAdded(5).SetMaxDeletedId("0-1")
+		batch.XSetIdWithOptions(streamKey2, "2-0", *xsetIdOpts)
+		testData = append(
+			testData,
+			CommandTestData{ExpectedResponse: "OK", TestName: "XSetIdWithOptions(streamKey2, 2-0, 5, 0-1)"},
+		)
+	}
+
+	if serverVer >= "8.2.0" {
+		streamKey5 := atomicPrefix + "5-" + uuid.NewString()
+		groupName4 := "{groupName}-4-" + uuid.NewString()
+
+		for _, id := range []string{"0-1", "0-2", "0-3"} {
+			xaddOpts := options.NewXAddOptions().SetId(id)
+			batch.XAddWithOptions(streamKey5, []models.FieldValue{{Field: "f", Value: "v"}}, *xaddOpts)
+			testData = append(testData, CommandTestData{ExpectedResponse: id, TestName: "XAdd(streamKey5, f=v, " + id + ")"})
+		}
+		batch.XGroupCreate(streamKey5, groupName4, "0")
+		testData = append(
+			testData,
+			CommandTestData{ExpectedResponse: "OK", TestName: "XGroupCreate(streamKey5, groupName4, 0)"},
+		)
+		batch.XReadGroup(groupName4, consumer1, map[string]string{streamKey5: ">"})
+		testData = append(testData, CommandTestData{
+			ExpectedResponse: map[string]models.StreamResponse{},
+			CheckTypeOnly:    true,
+			TestName:         "XReadGroup(groupName4, consumer1, streamKey5, >)",
+		})
+
+		batch.XDelExWithPolicy(streamKey5, []string{"0-1", "0-9"}, constants.AckedPolicy)
+		testData = append(testData, CommandTestData{
+			ExpectedResponse: []models.StreamEntryDeletionStatus{models.StreamEntryReferenced, models.StreamEntryNotFound},
+			TestName:         "XDelExWithPolicy(streamKey5, 0-1 0-9, ACKED)",
+		})
+		batch.XAckDel(streamKey5, groupName4, []string{"0-1"})
+		testData = append(testData, CommandTestData{
+			ExpectedResponse: []models.StreamEntryDeletionStatus{models.StreamEntryDeleted},
+			TestName:         "XAckDel(streamKey5, groupName4, 0-1)",
+		})
+		batch.XAckDelWithPolicy(streamKey5, groupName4, []string{"0-2", "0-1"}, constants.DelRefPolicy)
+		testData = append(testData, CommandTestData{
+			ExpectedResponse: []models.StreamEntryDeletionStatus{models.StreamEntryDeleted, models.StreamEntryNotFound},
+			TestName:         "XAckDelWithPolicy(streamKey5, groupName4, 0-2 0-1, DELREF)",
+		})
+		batch.XDelEx(streamKey5, []string{"0-3"})
+		testData = append(testData, CommandTestData{
+			ExpectedResponse: []models.StreamEntryDeletionStatus{models.StreamEntryDeleted},
+			TestName:         "XDelEx(streamKey5, 0-3)",
+		})
 	}
 
 	batch.XInfoStream(streamKey1)
@@ -2732,3 +2830,28 @@ func makeFullTestName(client interfaces.BaseClientCommands, testName string, isA
 	}
 	return fullTestName
 }
+
+func (suite *GlideTestSuite) TestBatchTemplate() {
+	template, err := pipeline.NewClusterTemplate("view", true, func(batch *pipeline.ClusterBatch) {
+		batch.Set(pipeline.Param("page"), "content").
+			Incr(pipeline.Param("page") + ":views").
+			Get(pipeline.Param("page") + ":views")
+	})
+	suite.NoError(err)
+	suite.Equal([]string{"page"}, template.Params())
+
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		page := "{" + uuid.NewString() + "}"
+		// Every instance increments the same counter
+		for views := int64(1); views <= 2; views++ {
+			batch, err := template.Instantiate(map[string]string{"page": page})
+			suite.NoError(err)
+			result, err := runBatchOnClient(client, batch, true, nil)
+			suite.NoError(err)
+			suite.Equal([]any{"OK", views, fmt.Sprint(views)}, result)
+		}
+
+		_, err := template.Instantiate(map[string]string{})
+		suite.Error(err)
+	})
+}
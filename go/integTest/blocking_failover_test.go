@@ -0,0 +1,82 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestReissueBlockingCommandsOnFailover() {
+	t := suite.T()
+	ctx := context.Background()
+	client, err := suite.client(suite.defaultClientConfig().WithReissueBlockingCommandsOnFailover(true))
+	require.NoError(t, err)
+	defer client.Close()
+	admin := suite.defaultClient()
+
+	// Returns whether a client is blocked on `BLPOP`
+	blocked := func() bool {
+		clients, err := admin.CustomCommand(ctx, []string{"CLIENT", "LIST"})
+		list, ok := clients.(string)
+		return err == nil && ok && strings.Contains(list, "cmd=blpop")
+	}
+
+	type popped struct {
+		result []string
+		err    error
+	}
+	// Blocks on `BLPOP` and interrupts the command with `interrupt`, given the ID of the connection it blocks on
+	blpop := func(key string, interrupt func(id any)) <-chan popped {
+		id, err := client.CustomCommand(ctx, []string{"CLIENT", "ID"})
+		require.NoError(t, err)
+		done := make(chan popped, 1)
+		go func() {
+			result, err := client.BLPop(ctx, []string{key}, 10*time.Second)
+			done <- popped{result, err}
+		}()
+		require.Eventually(t, blocked, 5*time.Second, 50*time.Millisecond)
+		interrupt(id)
+		return done
+	}
+	wait := func(done <-chan popped) popped {
+		select {
+		case result := <-done:
+			return result
+		case <-time.After(10 * time.Second):
+			t.Fatal("BLPOP did not return")
+			return popped{}
+		}
+	}
+
+	// The command is unblocked with an error, as by a failover, and re-issued
+	key := uuid.NewString()
+	done := blpop(key, func(id any) {
+		_, err := admin.CustomCommand(ctx, []string{"CLIENT", "UNBLOCK", fmt.Sprint(id), "ERROR"})
+		require.NoError(t, err)
+	})
+	require.Eventually(t, blocked, 5*time.Second, 50*time.Millisecond)
+	_, err = admin.LPush(ctx, key, []string{"value"})
+	require.NoError(t, err)
+	result := wait(done)
+	require.NoError(t, result.err)
+	assert.Equal(t, []string{key, "value"}, result.result)
+
+	// The connection the command blocks on is lost, and the command, which may have popped an element, fails
+	done = blpop(uuid.NewString(), func(id any) {
+		_, err := admin.CustomCommand(ctx, []string{"CLIENT", "KILL", "ID", fmt.Sprint(id)})
+		require.NoError(t, err)
+	})
+	assert.Error(t, wait(done).err)
+
+	// The blocking commands still time out
+	timedOut, err := client.BLPop(ctx, []string{uuid.NewString()}, 100*time.Millisecond)
+	require.NoError(t, err)
+	assert.Nil(t, timedOut)
+}
@@ -0,0 +1,53 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func (suite *GlideTestSuite) TestBlockingTimeoutClamping() {
+	t := suite.T()
+	client, err := suite.client(suite.defaultClientConfig().WithBlockingTimeoutClamping(100 * time.Millisecond))
+	require.NoError(t, err)
+	clusterClient, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithBlockingTimeoutClamping(100 * time.Millisecond),
+	)
+	require.NoError(t, err)
+	key := uuid.NewString()
+
+	for _, blockingPop := range []func(ctx context.Context) ([]string, error){
+		func(ctx context.Context) ([]string, error) { return client.BLPop(ctx, []string{key}, 0) },
+		func(ctx context.Context) ([]string, error) {
+			return clusterClient.BLPop(ctx, []string{key}, 10*time.Second)
+		},
+	} {
+		// The server times the command out before the context expires, instead of blocking indefinitely
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		start := time.Now()
+		result, err := blockingPop(ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.NoError(t, ctx.Err())
+		cancel()
+	}
+
+	streamKey := uuid.NewString()
+	_, err = client.XAdd(context.Background(), streamKey, []models.FieldValue{{Field: "field", Value: "value"}})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	streams, err := client.XReadWithOptions(ctx, map[string]string{streamKey: "$"}, *options.NewXReadOptions().SetBlock(0))
+	assert.NoError(t, err)
+	assert.Empty(t, streams)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
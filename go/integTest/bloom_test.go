@@ -0,0 +1,211 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/servermodules/bloom"
+)
+
+// skipIfCommandNotLoaded skips the test if the servers do not know `command`, e.g. if the module providing it is not loaded.
+func (suite *GlideTestSuite) skipIfCommandNotLoaded(command string) {
+	info, err := suite.defaultClient().CommandInfo(context.Background(), []string{command})
+	require.NoError(suite.T(), err)
+	if _, ok := info[strings.ToLower(command)]; !ok {
+		suite.T().Skipf("%s is not available, the module providing it is not loaded", command)
+	}
+}
+
+func (suite *GlideTestSuite) TestBloomFilter() {
+	suite.skipIfCommandNotLoaded("BF.ADD")
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.New().String()
+
+		suite.verifyOK(bloom.BFReserveWithOptions(
+			context.Background(), client, key, 0.001, 1000, *bloom.NewBFReserveOptions().SetExpansion(2),
+		))
+		added, err := bloom.BFAdd(context.Background(), client, key, "a")
+		require.NoError(t, err)
+		assert.True(t, added)
+		added, err = bloom.BFAdd(context.Background(), client, key, "a")
+		require.NoError(t, err)
+		assert.False(t, added)
+
+		addedItems, err := bloom.BFMAdd(context.Background(), client, key, []string{"a", "b", "c"})
+		require.NoError(t, err)
+		assert.Equal(t, []bool{false, true, true}, addedItems)
+
+		exists, err := bloom.BFExists(context.Background(), client, key, "b")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		existing, err := bloom.BFMExists(context.Background(), client, key, []string{"a", "d"})
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, existing)
+
+		info, err := bloom.BFInfo(context.Background(), client, key)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), info.Capacity)
+		assert.Equal(t, int64(3), info.Items)
+		assert.Equal(t, int64(1), info.Filters)
+		assert.Equal(t, int64(2), info.ExpansionRate)
+		assert.Positive(t, info.Size)
+
+		_, err = bloom.BFReserve(context.Background(), client, key, 0.01, 100)
+		assert.Error(t, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestBloomFilter_Insert() {
+	suite.skipIfCommandNotLoaded("BF.INSERT")
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.New().String()
+
+		_, err := bloom.BFInsert(
+			context.Background(), client, key, []string{"a"}, *bloom.NewBFInsertOptions().SetNoCreate(true),
+		)
+		assert.Error(t, err)
+
+		added, err := bloom.BFInsert(
+			context.Background(),
+			client,
+			key,
+			[]string{"a", "b", "a"},
+			*bloom.NewBFInsertOptions().SetCapacity(500).SetErrorRate(0.01).SetNonScaling(true),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, true, false}, added)
+
+		info, err := bloom.BFInfo(context.Background(), client, key)
+		require.NoError(t, err)
+		assert.Equal(t, int64(500), info.Capacity)
+		assert.Equal(t, int64(2), info.Items)
+		assert.Zero(t, info.ExpansionRate)
+	})
+}
+
+func (suite *GlideTestSuite) TestCuckooFilter() {
+	suite.skipIfCommandNotLoaded("CF.ADD")
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.New().String()
+
+		suite.verifyOK(bloom.CFReserveWithOptions(
+			context.Background(), client, key, 1000, *bloom.NewCFReserveOptions().SetBucketSize(4),
+		))
+		added, err := bloom.CFAdd(context.Background(), client, key, "a")
+		require.NoError(t, err)
+		assert.True(t, added)
+		added, err = bloom.CFAddNX(context.Background(), client, key, "a")
+		require.NoError(t, err)
+		assert.False(t, added)
+
+		inserted, err := bloom.CFInsert(context.Background(), client, key, []string{"a", "b"}, *bloom.NewCFInsertOptions())
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, true}, inserted)
+		insertedNX, err := bloom.CFInsertNX(
+			context.Background(), client, key, []string{"b", "c"}, *bloom.NewCFInsertOptions(),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{bloom.CFItemExists, bloom.CFItemAdded}, insertedNX)
+
+		count, err := bloom.CFCount(context.Background(), client, key, "a")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		exists, err := bloom.CFExists(context.Background(), client, key, "c")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		existing, err := bloom.CFMExists(context.Background(), client, key, []string{"b", "d"})
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, existing)
+
+		deleted, err := bloom.CFDel(context.Background(), client, key, "c")
+		require.NoError(t, err)
+		assert.True(t, deleted)
+
+		info, err := bloom.CFInfo(context.Background(), client, key)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), info.BucketSize)
+		assert.Equal(t, int64(4), info.ItemsInserted)
+		assert.Equal(t, int64(1), info.ItemsDeleted)
+	})
+}
+
+func (suite *GlideTestSuite) TestCountMinSketch() {
+	suite.skipIfCommandNotLoaded("CMS.INCRBY")
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		prefix := "{" + uuid.New().String() + "}"
+		key1, key2, merged := prefix+"1", prefix+"2", prefix+"merged"
+
+		suite.verifyOK(bloom.CMSInitByDim(context.Background(), client, key1, 2000, 5))
+		suite.verifyOK(bloom.CMSInitByProb(context.Background(), client, key2, 0.001, 0.01))
+		_, err := bloom.CMSInitByDim(context.Background(), client, key1, 2000, 5)
+		assert.Error(t, err)
+
+		counts, err := bloom.CMSIncrBy(context.Background(), client, key1, []bloom.CMSIncrement{
+			{Item: "a", Increment: 5},
+			{Item: "b", Increment: 2},
+			{Item: "a", Increment: 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{5, 2, 6}, counts)
+
+		counts, err = bloom.CMSQuery(context.Background(), client, key1, []string{"a", "b", "c"})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{6, 2, 0}, counts)
+
+		info, err := bloom.CMSInfo(context.Background(), client, key1)
+		require.NoError(t, err)
+		assert.Equal(t, bloom.CountMinSketchInfo{Width: 2000, Depth: 5, Count: 8}, info)
+
+		// Sketches can only be merged with the same dimensions
+		suite.verifyOK(bloom.CMSInitByDim(context.Background(), client, merged, 2000, 5))
+		_, err = bloom.CMSMerge(context.Background(), client, merged, []string{key1, key2})
+		assert.Error(t, err)
+		suite.verifyOK(bloom.CMSMergeWithWeights(context.Background(), client, merged, []string{key1}, []int64{3}))
+		counts, err = bloom.CMSQuery(context.Background(), client, merged, []string{"a"})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{18}, counts)
+
+		_, err = bloom.CMSMergeWithWeights(context.Background(), client, merged, []string{key1}, []int64{1, 2})
+		assert.Error(t, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestTopK() {
+	suite.skipIfCommandNotLoaded("TOPK.ADD")
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.New().String()
+
+		suite.verifyOK(bloom.TopKReserveWithOptions(
+			context.Background(), client, key, 2, *bloom.NewTopKReserveOptions(50, 4, 0.9),
+		))
+		expelled, err := bloom.TopKAdd(context.Background(), client, key, []string{"a", "a", "a", "b", "b", "c"})
+		require.NoError(t, err)
+		require.Len(t, expelled, 6)
+		assert.True(t, expelled[0].IsNil())
+
+		inTopK, err := bloom.TopKQuery(context.Background(), client, key, []string{"a", "b", "c"})
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, true, false}, inTopK)
+
+		items, err := bloom.TopKList(context.Background(), client, key)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, items)
+
+		itemsWithCount, err := bloom.TopKListWithCount(context.Background(), client, key)
+		require.NoError(t, err)
+		assert.Equal(t, []bloom.TopKItem{{Item: "a", Count: 3}, {Item: "b", Count: 2}}, itemsWithCount)
+
+		suite.verifyOK(bloom.TopKReserve(context.Background(), client, uuid.New().String(), 10))
+	})
+}
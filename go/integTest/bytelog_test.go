@@ -0,0 +1,44 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/bytelog"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestByteLog() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		log := bytelog.New(client, uuid.NewString())
+
+		offset, err := log.Append(ctx, "header")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), offset)
+		offset, err = log.Append(ctx, "\x00\x01\x02record")
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), offset)
+
+		data, err := log.ReadAt(ctx, offset, 9)
+		require.NoError(t, err)
+		assert.Equal(t, "\x00\x01\x02record", data)
+		data, err = log.ReadAt(ctx, 12, 10)
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, "ord", data)
+
+		size, err := log.WriteAt(ctx, 0, "HEADER")
+		require.NoError(t, err)
+		assert.Equal(t, int64(15), size)
+
+		all, err := io.ReadAll(log.Reader(ctx, 0, 4))
+		require.NoError(t, err)
+		assert.Equal(t, "HEADER\x00\x01\x02record", string(all))
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/cache"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestCacheInvalidateAndSet() {
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		key := uuid.NewString()
+
+		invalidator := cache.New(client)
+		previous, err := invalidator.InvalidateAndSet(ctx, key, "v1")
+		require.NoError(t, err)
+		assert.True(t, previous.IsNil())
+		previous, err = invalidator.WithExpiry(time.Minute).InvalidateAndSet(ctx, key, "v2")
+		require.NoError(t, err)
+		assert.Equal(t, "v1", previous.Value())
+
+		value, err := client.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, "v2", value.Value())
+		ttl, err := client.PTTL(ctx, key)
+		require.NoError(t, err)
+		assert.Greater(t, ttl, int64(0))
+
+		// The shard channel must be in the slot of the keys
+		sharded := cache.New(client).WithShardChannel("{cache-test}:invalidate")
+		previous, err = sharded.InvalidateAndSet(ctx, "{cache-test}:"+uuid.NewString(), "v1")
+		require.NoError(t, err)
+		assert.True(t, previous.IsNil())
+		_, err = sharded.InvalidateAndSet(ctx, "{other}:"+uuid.NewString(), "v1")
+		assert.Error(t, err)
+	})
+}
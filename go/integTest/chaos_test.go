@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/chaos"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+func (suite *GlideTestSuite) TestChaosInjector() {
+	t := suite.T()
+	ctx := context.Background()
+	injector := chaos.New().Add(chaos.ResetConnections(1).ForCommands("SET").ForKeys("chaos:*"))
+	client, err := suite.client(suite.defaultClientConfig().WithInterceptor(injector.Interceptor()))
+	require.NoError(t, err)
+	defer client.Close()
+
+	// The command is executed, but the client sees a reset connection
+	key := "chaos:" + uuid.NewString()
+	_, err = client.Set(ctx, key, "value")
+	var disconnectErr *glide.DisconnectError
+	assert.True(t, errors.As(err, &disconnectErr))
+	value, err := client.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+
+	// The other commands and keys are not affected
+	_, err = client.Set(ctx, uuid.NewString(), "value")
+	assert.NoError(t, err)
+
+	// The batches with a matching command are affected
+	batch := pipeline.NewStandaloneBatch(false).Set(key, "other").Get(key)
+	_, err = client.Exec(ctx, *batch, false)
+	assert.True(t, errors.As(err, &disconnectErr))
+	assert.Equal(t, int64(2), injector.Injected(chaos.ConnectionReset))
+
+	injector.SetEnabled(false)
+	_, err = client.Set(ctx, key, "value")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,48 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/chunking"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestChunking() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		codec := chunking.New(client).WithChunkSize(1024)
+		key := uuid.NewString()
+		value := strings.Repeat("0123456789", 1000)
+
+		require.NoError(t, codec.Set(ctx, key, value))
+		exists, err := client.Exists(ctx, []string{"{" + key + "}:chunk:9"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), exists)
+		result, err := codec.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, value, result.Value())
+
+		require.NoError(t, codec.Set(ctx, key, "small"))
+		result, err = codec.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, "small", result.Value())
+		exists, err = client.Exists(ctx, []string{"{" + key + "}:chunk:0"})
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+
+		require.NoError(t, codec.Set(ctx, key, value))
+		deleted, err := codec.Delete(ctx, key)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		result, err = codec.Get(ctx, key)
+		require.NoError(t, err)
+		assert.True(t, result.IsNil())
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+const circuitBreakerTestCooldown = 2 * time.Second
+
+type circuitBreakerTestClient interface {
+	interfaces.BaseClientCommands
+	StatisticsProvider
+}
+
+// testCircuitBreaker kills the connections of `client` until its circuit opens, then checks that commands fail fast
+// until the cooldown elapses and the circuit closes.
+func (suite *GlideTestSuite) testCircuitBreaker(client circuitBreakerTestClient, killConnections func() error) {
+	ctx := context.Background()
+	key := uuid.NewString()
+	initialStats := client.GetStatistics()
+
+	var circuitErr *glide.CircuitBreakerOpenError
+	assert.Eventually(suite.T(), func() bool {
+		assert.NoError(suite.T(), killConnections())
+		_, err := client.Get(ctx, key)
+		return errors.As(err, &circuitErr)
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NotNil(suite.T(), circuitErr)
+	assert.NotEmpty(suite.T(), circuitErr.Node)
+
+	stats := client.GetStatistics()
+	assert.Greater(suite.T(), stats["circuit_breaker_opened_count"], initialStats["circuit_breaker_opened_count"])
+	assert.Greater(suite.T(), stats["circuit_breaker_rejected_count"], initialStats["circuit_breaker_rejected_count"])
+
+	// Once the cooldown elapsed, a probe is sent to the node and the circuit closes
+	time.Sleep(circuitBreakerTestCooldown)
+	assert.Eventually(suite.T(), func() bool {
+		_, err := client.Get(ctx, key)
+		return err == nil
+	}, 5*time.Second, 100*time.Millisecond)
+	stats = client.GetStatistics()
+	assert.Greater(suite.T(), stats["circuit_breaker_closed_count"], initialStats["circuit_breaker_closed_count"])
+}
+
+func (suite *GlideTestSuite) TestCircuitBreaker() {
+	ctx := context.Background()
+	circuitBreaker := config.NewCircuitBreakerConfiguration().
+		WithFailureThreshold(1).
+		WithCooldown(circuitBreakerTestCooldown)
+	client, err := suite.client(suite.defaultClientConfig().WithCircuitBreaker(circuitBreaker))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	adminClient := suite.defaultClient()
+	defer adminClient.Close()
+
+	suite.testCircuitBreaker(client, func() error {
+		_, err := adminClient.CustomCommand(ctx, []string{"CLIENT", "KILL", "TYPE", "NORMAL"})
+		return err
+	})
+}
+
+func (suite *GlideTestSuite) TestCircuitBreakerCluster() {
+	ctx := context.Background()
+	circuitBreaker := config.NewCircuitBreakerConfiguration().
+		WithFailureThreshold(1).
+		WithCooldown(circuitBreakerTestCooldown)
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithCircuitBreaker(circuitBreaker))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	adminClient := suite.defaultClusterClient()
+	defer adminClient.Close()
+
+	suite.testCircuitBreaker(client, func() error {
+		_, err := adminClient.CustomCommandWithRoute(ctx, []string{"CLIENT", "KILL", "TYPE", "NORMAL"}, config.AllNodes)
+		return err
+	})
+}
+
+func (suite *GlideTestSuite) TestCircuitBreakerServerErrors() {
+	ctx := context.Background()
+	circuitBreaker := config.NewCircuitBreakerConfiguration().WithFailureThreshold(1)
+	client, err := suite.client(suite.defaultClientConfig().WithCircuitBreaker(circuitBreaker))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	// Errors returned by the server do not open the circuit
+	key := uuid.NewString()
+	_, err = client.LPush(ctx, key, []string{"value"})
+	require.NoError(suite.T(), err)
+	for i := 0; i < 3; i++ {
+		_, err = client.Get(ctx, key)
+		assert.Error(suite.T(), err)
+		var circuitErr *glide.CircuitBreakerOpenError
+		assert.False(suite.T(), errors.As(err, &circuitErr))
+	}
+	_, err = client.Del(ctx, []string{key})
+	assert.NoError(suite.T(), err)
+}
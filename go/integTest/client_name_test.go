@@ -0,0 +1,54 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func (suite *GlideTestSuite) TestClientNameSuffixes_Standalone() {
+	t := suite.T()
+	ctx := context.Background()
+	suffix := ":" + strconv.Itoa(os.Getpid())
+	client, err := suite.client(
+		suite.defaultClientConfig().WithClientName("orders").WithClientNameSuffixes(config.PidSuffix),
+	)
+	require.NoError(t, err)
+
+	name, err := client.ClientGetName(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "orders"+suffix, name.Value())
+
+	// The names set with CLIENT SETNAME are suffixed too
+	_, err = client.ClientSetName(ctx, "billing")
+	require.NoError(t, err)
+	name, err = client.ClientGetName(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "billing"+suffix, name.Value())
+}
+
+func (suite *GlideTestSuite) TestClientNameSuffixes_Cluster() {
+	t := suite.T()
+	ctx := context.Background()
+	suffix := ":" + strconv.Itoa(os.Getpid())
+	client, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithClientName("orders").WithClientNameSuffixes(config.PidSuffix),
+	)
+	require.NoError(t, err)
+
+	name, err := client.ClientGetName(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "orders"+suffix, name.Value())
+
+	_, err = client.ClientSetName(ctx, "billing")
+	require.NoError(t, err)
+	name, err = client.ClientGetName(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "billing"+suffix, name.Value())
+}
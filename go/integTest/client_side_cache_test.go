@@ -0,0 +1,77 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func (suite *GlideTestSuite) TestClientSideCache() {
+	t := suite.T()
+	prefix := "csc:" + uuid.NewString() + ":"
+	client, err := suite.client(
+		suite.defaultClientConfig().WithClientSideCache(config.NewClientSideCacheConfiguration(prefix)),
+	)
+	require.NoError(t, err)
+	clusterClient, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithClientSideCache(config.NewClientSideCacheConfiguration(prefix)),
+	)
+	require.NoError(t, err)
+	writer, clusterWriter := suite.defaultClient(), suite.defaultClusterClient()
+	ctx := context.Background()
+
+	for _, commands := range []struct {
+		set func(key, value string) error
+		del func(key string) error
+		get func(key string) (models.Result[string], error)
+	}{
+		{
+			set: func(key, value string) error { _, err := writer.Set(ctx, key, value); return err },
+			del: func(key string) error { _, err := writer.Del(ctx, []string{key}); return err },
+			get: func(key string) (models.Result[string], error) { return client.Get(ctx, key) },
+		},
+		{
+			set: func(key, value string) error { _, err := clusterWriter.Set(ctx, key, value); return err },
+			del: func(key string) error { _, err := clusterWriter.Del(ctx, []string{key}); return err },
+			get: func(key string) (models.Result[string], error) { return clusterClient.Get(ctx, key) },
+		},
+	} {
+		key := prefix + uuid.NewString()
+		value, err := commands.get(key)
+		require.NoError(t, err)
+		assert.True(t, value.IsNil())
+
+		// The keys modified by another client are invalidated, including the keys missing when cached
+		require.NoError(t, commands.set(key, "v1"))
+		assert.Eventually(t, func() bool {
+			value, err := commands.get(key)
+			return err == nil && value.Value() == "v1"
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.NoError(t, commands.set(key, "v2"))
+		assert.Eventually(t, func() bool {
+			value, err := commands.get(key)
+			return err == nil && value.Value() == "v2"
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.NoError(t, commands.del(key))
+		assert.Eventually(t, func() bool {
+			value, err := commands.get(key)
+			return err == nil && value.IsNil()
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// The keys outside of the prefixes are read from the server
+		other := uuid.NewString()
+		require.NoError(t, commands.set(other, "v1"))
+		value, err = commands.get(other)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", value.Value())
+	}
+}
@@ -4,10 +4,13 @@ package integTest
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
@@ -1143,6 +1146,55 @@ func (suite *GlideTestSuite) TestUpdateConnectionPasswordCluster_ImmediateAuthWr
 	suite.NoError(err)
 }
 
+func (suite *GlideTestSuite) TestUpdateConnectionCredentialsCluster() {
+	ctx := context.Background()
+	username := "rotation_user_" + uuid.NewString()
+	oldPwd := uuid.NewString()
+	newPwd := uuid.NewString()
+
+	adminClient := suite.defaultClusterClient()
+	defer adminClient.Close()
+
+	aclSetUser := func(rules ...string) {
+		command := append([]string{"ACL", "SETUSER", username}, rules...)
+		_, err := adminClient.CustomCommandWithRoute(ctx, command, config.AllNodes)
+		suite.NoError(err)
+	}
+	aclSetUser("on", ">"+oldPwd, "~*", "+@all")
+	defer adminClient.CustomCommandWithRoute(ctx, []string{"ACL", "DELUSER", username}, config.AllNodes)
+
+	testClient := suite.defaultClusterClient()
+	defer testClient.Close()
+
+	result, err := testClient.UpdateConnectionCredentials(ctx, username, oldPwd)
+	suite.NoError(err)
+	suite.Equal("OK", result)
+	whoami, err := testClient.AclWhoAmI(ctx)
+	suite.NoError(err)
+	suite.Equal(username, whoami)
+
+	// A wrong password is rejected and the client keeps its credentials
+	_, err = testClient.UpdateConnectionCredentials(ctx, username, uuid.NewString())
+	suite.Error(err)
+	whoami, err = testClient.AclWhoAmI(ctx)
+	suite.NoError(err)
+	suite.Equal(username, whoami)
+
+	// Rotate the password: add the new one, switch the client to it, then revoke the old one
+	aclSetUser(">" + newPwd)
+	_, err = testClient.UpdateConnectionCredentials(ctx, username, newPwd)
+	suite.NoError(err)
+	aclSetUser("<" + oldPwd)
+
+	// Kill the connections of the user on all nodes, the client reconnects with the new password
+	_, err = adminClient.CustomCommandWithRoute(ctx, []string{"CLIENT", "KILL", "USER", username}, config.AllNodes)
+	suite.NoError(err)
+	assert.Eventually(suite.T(), func() bool {
+		whoami, err := testClient.AclWhoAmI(ctx)
+		return err == nil && whoami == username
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
 func (suite *GlideTestSuite) TestClusterLolwut() {
 	client := suite.defaultClusterClient()
 
@@ -1302,6 +1354,63 @@ func (suite *GlideTestSuite) TestClientIdWithOptionsCluster() {
 	assert.True(t, response.IsMultiValue())
 }
 
+func (suite *GlideTestSuite) TestClientInfoCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	info, err := client.ClientInfo(context.Background())
+	require.NoError(t, err)
+	assert.Greater(t, info.Id, int64(0))
+	assert.Equal(t, "client|info", info.Cmd)
+
+	response, err := client.ClientInfoWithOptions(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	assert.True(t, response.IsMultiValue())
+	for _, nodeInfo := range response.MultiValue() {
+		assert.Greater(t, nodeInfo.Id, int64(0))
+		assert.NotEmpty(t, nodeInfo.LocalAddr)
+	}
+}
+
+func (suite *GlideTestSuite) TestClientTrackingInfoCluster() {
+	suite.SkipIfServerVersionLowerThan("6.2.0", suite.T())
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	info, err := client.ClientTrackingInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"off"}, info.Flags)
+	assert.Equal(t, int64(-1), info.Redirect)
+
+	response, err := client.ClientTrackingInfoWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+	assert.True(t, response.IsMultiValue())
+	for _, nodeInfo := range response.MultiValue() {
+		assert.True(t, nodeInfo.HasFlag("off"))
+	}
+}
+
+func (suite *GlideTestSuite) TestHelloCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	hello, err := client.Hello(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), hello.Proto)
+	assert.Equal(t, "cluster", hello.Mode)
+
+	response, err := client.HelloWithOptions(
+		context.Background(),
+		*options.NewHelloOptions(),
+		options.RouteOption{Route: config.AllPrimaries},
+	)
+	require.NoError(t, err)
+	assert.True(t, response.IsMultiValue())
+	for _, nodeHello := range response.MultiValue() {
+		assert.Equal(t, "master", nodeHello.Role)
+	}
+}
+
 func (suite *GlideTestSuite) TestLastSaveCluster() {
 	client := suite.defaultClusterClient()
 	t := suite.T()
@@ -3263,3 +3372,477 @@ func (suite *GlideTestSuite) TestClusterLinks() {
 	assert.NoError(t, err)
 	assert.NotNil(t, clusterResult.SingleValue())
 }
+
+// primaryNodeIds returns the node ID of every primary, keyed by the "host:port" address of the node.
+func (suite *GlideTestSuite) primaryNodeIds(client *glide.ClusterClient) map[string]string {
+	result, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(suite.T(), err)
+	return result.MultiValue()
+}
+
+func (suite *GlideTestSuite) TestClusterFailover_OnPrimary() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	slot, err := client.ClusterKeySlot(context.Background(), "{failover}")
+	require.NoError(t, err)
+	route := options.RouteOption{Route: config.NewSlotIdRoute(config.SlotTypePrimary, int32(slot))}
+
+	// CLUSTER FAILOVER must be sent to a replica
+	_, err = client.ClusterFailover(context.Background(), route)
+	assert.Error(t, err)
+
+	_, err = client.ClusterFailoverWithMode(context.Background(), options.FailoverForce, route)
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestClusterSetSlot() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	slot, err := client.ClusterKeySlot(context.Background(), "{setslot}")
+	require.NoError(t, err)
+	route := options.RouteOption{Route: config.NewSlotIdRoute(config.SlotTypePrimary, int32(slot))}
+
+	ownerId, err := client.ClusterMyIdWithRoute(context.Background(), route)
+	require.NoError(t, err)
+
+	// Both are no-ops for the node that already owns the slot
+	result, err := client.ClusterSetSlot(context.Background(), slot, *options.NewSetSlotStable(), route)
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	result, err = client.ClusterSetSlot(
+		context.Background(),
+		slot,
+		*options.NewSetSlotNode(ownerId.SingleValue()),
+		route,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	// A node ID is required by all states but STABLE
+	_, err = client.ClusterSetSlot(context.Background(), slot, *options.NewSetSlotMigrating(""), route)
+	assert.ErrorContains(t, err, "requires a node ID")
+
+	_, err = client.ClusterSetSlot(context.Background(), slot, *options.NewSetSlotImporting("unknown-node"), route)
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestClusterMeetAndForget() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	nodes := suite.primaryNodeIds(client)
+	require.GreaterOrEqual(t, len(nodes), 2)
+	addresses := make([]string, 0, len(nodes))
+	for address := range nodes {
+		addresses = append(addresses, address)
+	}
+	route, err := config.NewByAddressRouteWithHost(addresses[0])
+	require.NoError(t, err)
+	other, err := config.NewByAddressRouteWithHost(addresses[1])
+	require.NoError(t, err)
+
+	// Meeting a node that is already part of the cluster is harmless
+	result, err := client.ClusterMeet(context.Background(), other.Host, int64(other.Port), options.RouteOption{Route: route})
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	// A node can't forget itself
+	_, err = client.ClusterForget(context.Background(), nodes[addresses[0]], options.RouteOption{Route: route})
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestClusterAddAndDelSlots_Errors() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	slot, err := client.ClusterKeySlot(context.Background(), "{addslots}")
+	require.NoError(t, err)
+	route := options.RouteOption{Route: config.NewSlotIdRoute(config.SlotTypePrimary, int32(slot))}
+
+	// The slot is already served by the node
+	_, err = client.ClusterAddSlots(context.Background(), []int64{slot}, route)
+	assert.Error(t, err)
+
+	// Out of range slots are rejected
+	_, err = client.ClusterAddSlots(context.Background(), []int64{16384}, route)
+	assert.Error(t, err)
+	_, err = client.ClusterDelSlots(context.Background(), []int64{-1}, route)
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestMigrateSlot() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	slot, err := client.ClusterKeySlot(context.Background(), "{migrateslot}")
+	require.NoError(t, err)
+	ownerId, err := client.ClusterMyIdWithRoute(
+		context.Background(),
+		options.RouteOption{Route: config.NewSlotIdRoute(config.SlotTypePrimary, int32(slot))},
+	)
+	require.NoError(t, err)
+
+	nodes := suite.primaryNodeIds(client)
+	require.GreaterOrEqual(t, len(nodes), 2)
+	var sourceAddress, targetAddress string
+	for address, id := range nodes {
+		if id == ownerId.SingleValue() {
+			sourceAddress = address
+		} else if targetAddress == "" {
+			targetAddress = address
+		}
+	}
+	source, err := config.NewByAddressRouteWithHost(sourceAddress)
+	require.NoError(t, err)
+	target, err := config.NewByAddressRouteWithHost(targetAddress)
+	require.NoError(t, err)
+
+	keyCount := 10
+	for i := 0; i < keyCount; i++ {
+		_, err = client.Set(context.Background(), fmt.Sprintf("{migrateslot}%d", i), fmt.Sprint(i))
+		require.NoError(t, err)
+	}
+
+	var progress []models.SlotMigrationProgress
+	opts := options.NewMigrateSlotOptions().
+		SetBatchSize(3).
+		SetReplace(true).
+		SetOnProgress(func(p models.SlotMigrationProgress) { progress = append(progress, p) })
+
+	migrated, err := client.MigrateSlot(context.Background(), slot, *source, *target, *opts)
+	require.NoError(t, err)
+	// Move the slot back to keep the cluster layout intact for the other tests
+	defer func() {
+		_, err := client.MigrateSlot(context.Background(), slot, *target, *source, *options.NewMigrateSlotOptions())
+		assert.NoError(t, err)
+	}()
+
+	assert.Equal(t, int64(keyCount), migrated)
+	require.Len(t, progress, 4)
+	assert.Equal(t, int64(3), progress[0].BatchKeys)
+	assert.Equal(t, int64(1), progress[3].BatchKeys)
+	assert.Equal(t, int64(keyCount), progress[3].MigratedKeys)
+	assert.Equal(t, slot, progress[3].Slot)
+
+	newOwnerId, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: target})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		id, err := client.ClusterMyIdWithRoute(
+			context.Background(),
+			options.RouteOption{Route: config.NewSlotIdRoute(config.SlotTypePrimary, int32(slot))},
+		)
+		return err == nil && id.SingleValue() == newOwnerId.SingleValue()
+	}, 5*time.Second, 100*time.Millisecond)
+
+	for i := 0; i < keyCount; i++ {
+		value, err := client.Get(context.Background(), fmt.Sprintf("{migrateslot}%d", i))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprint(i), value.Value())
+	}
+
+	_, err = client.MigrateSlot(context.Background(), slot, *target, *source, *options.NewMigrateSlotOptions().SetBatchSize(0))
+	assert.ErrorContains(t, err, "batch size")
+}
+
+func (suite *GlideTestSuite) TestClusterClient_WithMaxRedirects() {
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithMaxRedirects(10))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	value, err := client.Get(context.Background(), key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+
+	stats := client.GetStatistics()
+	assert.Contains(suite.T(), stats, "moved_redirect_count")
+	assert.Contains(suite.T(), stats, "ask_redirect_count")
+}
+
+func (suite *GlideTestSuite) TestForEachNode() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	primaries, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	allNodes, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+
+	// Every node is visited once, with a route to that node, and no more than 2 at a time
+	var running, maxRunning atomic.Int32
+	var mu sync.Mutex
+	visited := map[string]string{}
+	fanOutOptions := options.NewFanOutOptions().SetNodes(config.AllNodes).SetConcurrency(2)
+	err = client.ForEachNode(context.Background(), *fanOutOptions,
+		func(ctx context.Context, address string, route config.Route) error {
+			current := running.Add(1)
+			defer running.Add(-1)
+			for {
+				previous := maxRunning.Load()
+				if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			id, err := client.ClusterMyIdWithRoute(ctx, options.RouteOption{Route: route})
+			if err != nil {
+				return err
+			}
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			visited[address] = id.SingleValue()
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, allNodes.MultiValue(), visited)
+	assert.LessOrEqual(t, maxRunning.Load(), int32(2))
+
+	// The errors are reported per node
+	err = client.ForEachNode(context.Background(), *options.NewFanOutOptions(),
+		func(ctx context.Context, address string, route config.Route) error {
+			return errors.New("failed on " + address)
+		})
+	var nodeErrs *glide.NodeErrors
+	require.ErrorAs(t, err, &nodeErrs)
+	assert.Len(t, nodeErrs.Errors, len(primaries.MultiValue()))
+	for address, err := range nodeErrs.Errors {
+		assert.EqualError(t, err, "failed on "+address)
+	}
+
+	// Cancelled before any node is visited
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = client.ForEachNode(ctx, *options.NewFanOutOptions(), func(context.Context, string, config.Route) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func (suite *GlideTestSuite) TestInfoPerNode() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	infoOptions := options.InfoOptions{Sections: []constants.Section{constants.Replication}}
+	result, err := client.InfoPerNode(context.Background(), infoOptions, *options.NewFanOutOptions())
+	require.NoError(t, err)
+	primaries, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	assert.Len(t, result, len(primaries.MultiValue()))
+	for _, info := range result {
+		assert.Contains(t, info, "role:master")
+	}
+
+	fanOutOptions := options.NewFanOutOptions().SetNodes(config.AllNodes).SetConcurrency(0)
+	result, err = client.InfoPerNode(context.Background(), infoOptions, *fanOutOptions)
+	require.NoError(t, err)
+	allNodes, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+	assert.Len(t, result, len(allNodes.MultiValue()))
+}
+
+func (suite *GlideTestSuite) TestFunctionLoadPerNode() {
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	ctx := context.Background()
+
+	libName := "per_node_" + strings.ReplaceAll(uuid.NewString(), "-", "_")
+	code := GenerateLuaLibCode(libName, map[string]string{libName: "return args[1]"}, true)
+	otherCode := GenerateLuaLibCode(libName, map[string]string{libName: "return args[2]"}, true)
+	primaries, err := client.ClusterMyIdWithRoute(ctx, options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+
+	statuses, err := client.FunctionLoadPerNode(ctx, code, false, *options.NewFanOutOptions())
+	require.NoError(t, err)
+	assert.Len(t, statuses, len(primaries.MultiValue()))
+	for _, status := range statuses {
+		assert.Equal(t, models.LibraryStatus{Digest: glide.LibraryDigest(code), Verified: true, Loaded: true}, status)
+	}
+
+	// The nodes with the library are left as they are
+	statuses, err = client.FunctionLoadPerNode(ctx, code, false, *options.NewFanOutOptions())
+	require.NoError(t, err)
+	for _, status := range statuses {
+		assert.Equal(t, models.LibraryStatus{Digest: glide.LibraryDigest(code), Verified: true}, status)
+	}
+
+	statuses, err = client.FunctionVerifyPerNode(ctx, otherCode, *options.NewFanOutOptions())
+	require.NoError(t, err)
+	assert.Len(t, statuses, len(primaries.MultiValue()))
+	for _, status := range statuses {
+		assert.Equal(t, models.LibraryStatus{Digest: glide.LibraryDigest(code)}, status)
+	}
+
+	// Another code of the library replaces it only with replace
+	_, err = client.FunctionLoadPerNode(ctx, otherCode, false, *options.NewFanOutOptions())
+	var nodeErrs *glide.NodeErrors
+	require.ErrorAs(t, err, &nodeErrs)
+	assert.Len(t, nodeErrs.Errors, len(primaries.MultiValue()))
+	statuses, err = client.FunctionLoadPerNode(ctx, otherCode, true, *options.NewFanOutOptions())
+	require.NoError(t, err)
+	for _, status := range statuses {
+		assert.Equal(t, models.LibraryStatus{Digest: glide.LibraryDigest(otherCode), Verified: true, Loaded: true}, status)
+	}
+
+	err = client.FunctionFlushPerNode(ctx, options.SYNC, *options.NewFanOutOptions())
+	require.NoError(t, err)
+	statuses, err = client.FunctionVerifyPerNode(ctx, otherCode, *options.NewFanOutOptions())
+	require.NoError(t, err)
+	for _, status := range statuses {
+		assert.Equal(t, models.LibraryStatus{}, status)
+	}
+
+	_, err = client.FunctionLoadPerNode(ctx, "return 1", false, *options.NewFanOutOptions())
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestWatchLibraries() {
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	libName := "watched_" + strings.ReplaceAll(uuid.NewString(), "-", "_")
+	code := GenerateLuaLibCode(libName, map[string]string{libName: "return args[1]"}, true)
+	primaries, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+
+	var loads atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- client.WatchLibraries(
+			ctx,
+			[]string{code},
+			100*time.Millisecond,
+			*options.NewFanOutOptions(),
+			func(address string, library string, err error) {
+				assert.NoError(t, err, address)
+				assert.Equal(t, libName, library)
+				loads.Add(1)
+			},
+		)
+	}()
+
+	nodeCount := int32(len(primaries.MultiValue()))
+	assert.Eventually(t, func() bool { return loads.Load() == nodeCount }, 5*time.Second, 50*time.Millisecond)
+
+	// The library is loaded again on the nodes that lost it
+	_, err = client.FunctionDeleteWithRoute(context.Background(), libName, options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return loads.Load() == 2*nodeCount }, 5*time.Second, 50*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	_, err = client.FunctionDeleteWithRoute(context.Background(), libName, options.RouteOption{Route: config.AllPrimaries})
+	assert.NoError(t, err)
+}
+
+func (suite *GlideTestSuite) TestReplicationStatusCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	statuses, err := client.ReplicationStatus(context.Background())
+	require.NoError(t, err)
+	primaries, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	assert.Len(t, statuses, len(primaries.MultiValue()))
+	for _, status := range statuses {
+		assert.True(t, status.IsPrimary())
+		for _, replica := range status.Replicas {
+			assert.NotEmpty(t, replica.Address)
+			assert.LessOrEqual(t, replica.Offset, status.ReplicationOffset)
+		}
+	}
+}
+
+func (suite *GlideTestSuite) TestReadPipeline() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	ctx := context.Background()
+	key := "{" + uuid.NewString() + "}"
+
+	_, err := client.HSet(ctx, key+":hash", map[string]string{"field": "value"})
+	require.NoError(t, err)
+	_, err = client.Set(ctx, key+":string", "value")
+	require.NoError(t, err)
+	_, err = client.Wait(ctx, 1, 1000*time.Millisecond)
+	require.NoError(t, err)
+
+	// The batches read the same values from a replica as from the primary
+	readPipeline := client.NewReadPipeline(*pipeline.NewReadPipelineOptions().WithMaxLag(10 * time.Second))
+	for _, isAtomic := range []bool{true, false} {
+		batch := pipeline.NewClusterBatch(isAtomic).HGet(key+":hash", "field").Get(key + ":string")
+		results, err := readPipeline.Exec(ctx, key, *batch, true)
+		require.NoError(t, err)
+		assert.Equal(t, []any{"value", "value"}, results)
+	}
+
+	// The batches are executed on the primary when no replica is within the thresholds
+	readPipeline = client.NewReadPipeline(*pipeline.NewReadPipelineOptions().WithMaxLag(-time.Second))
+	batch := pipeline.NewClusterBatch(false).Get(key + ":string")
+	results, err := readPipeline.Exec(ctx, key, *batch, true)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"value"}, results)
+}
+
+func (suite *GlideTestSuite) TestConfigSetPerNode() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	fanOutOptions := options.NewFanOutOptions().SetNodes(config.AllNodes)
+	err := client.ConfigSetPerNode(context.Background(), map[string]string{"timeout": "1000"}, *fanOutOptions)
+	require.NoError(t, err)
+	timeouts, err := client.ConfigGetWithOptions(
+		context.Background(),
+		[]string{"timeout"},
+		options.RouteOption{Route: config.AllNodes},
+	)
+	require.NoError(t, err)
+	for _, timeout := range timeouts.MultiValue() {
+		assert.Equal(t, "1000", timeout["timeout"])
+	}
+
+	err = client.ConfigSetPerNode(context.Background(), map[string]string{"unknown-parameter": "1"}, *fanOutOptions)
+	var nodeErrs *glide.NodeErrors
+	require.ErrorAs(t, err, &nodeErrs)
+	assert.Len(t, nodeErrs.Errors, len(timeouts.MultiValue()))
+}
+
+func (suite *GlideTestSuite) TestFlushAllPerNode() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	keys := []string{"{a}" + uuid.NewString(), "{b}" + uuid.NewString(), "{c}" + uuid.NewString()}
+	for _, key := range keys {
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+	}
+
+	err := client.FlushAllPerNode(context.Background(), options.SYNC, *options.NewFanOutOptions())
+	require.NoError(t, err)
+	for _, key := range keys {
+		value, err := client.Get(context.Background(), key)
+		require.NoError(t, err)
+		assert.True(t, value.IsNil())
+	}
+
+	// Replicas reject writes
+	primaries, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	allNodes, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+	if len(allNodes.MultiValue()) == len(primaries.MultiValue()) {
+		return
+	}
+	fanOutOptions := options.NewFanOutOptions().SetNodes(config.AllNodes)
+	err = client.FlushAllPerNode(context.Background(), options.ASYNC, *fanOutOptions)
+	var nodeErrs *glide.NodeErrors
+	require.ErrorAs(t, err, &nodeErrs)
+	assert.Len(t, nodeErrs.Errors, len(allNodes.MultiValue())-len(primaries.MultiValue()))
+	for _, err := range nodeErrs.Errors {
+		assert.ErrorContains(t, err, "READONLY")
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/cmd"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestCommandBuilder() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		key := uuid.NewString()
+
+		set := cmd.New("SET").Key(key).Arg("value").Flag("PX").Millis(time.Minute).FlagIf(true, "NX")
+		args, err := set.ToArgs()
+		require.NoError(t, err)
+		switch c := client.(type) {
+		case *glide.Client:
+			_, err = c.CustomCommand(ctx, args)
+		case *glide.ClusterClient:
+			_, err = c.CustomCommandWithRoute(ctx, args, set.Route())
+		}
+		require.NoError(t, err)
+
+		value, err := client.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, "value", value.Value())
+		ttl, err := client.PTTL(ctx, key)
+		require.NoError(t, err)
+		assert.Greater(t, ttl, int64(0))
+		assert.LessOrEqual(t, ttl, time.Minute.Milliseconds())
+	})
+}
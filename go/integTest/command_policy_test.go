@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+func (suite *GlideTestSuite) TestCommandPolicy() {
+	t := suite.T()
+	ctx := context.Background()
+	client, err := suite.client(suite.defaultClientConfig().WithCommandPolicy(nil, []string{config.DangerousCommandsTag}))
+	require.NoError(t, err)
+	defer client.Close()
+	clusterClient, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithCommandPolicy([]string{"GET", "SET"}, nil),
+	)
+	require.NoError(t, err)
+	defer clusterClient.Close()
+	key := uuid.NewString()
+
+	// The permitted commands are sent
+	_, err = client.Set(ctx, key, "value")
+	assert.NoError(t, err)
+	_, err = clusterClient.Get(ctx, key)
+	assert.NoError(t, err)
+
+	// The other commands fail without being sent, whether typed, custom or batched
+	var policyErr *glide.PolicyError
+	_, err = client.FlushAll(ctx)
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, "FLUSHALL", policyErr.Command)
+	_, err = client.CustomCommand(ctx, []string{"keys", "*"})
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, "KEYS", policyErr.Command)
+	batch := pipeline.NewStandaloneBatch(false).Get(key).CustomCommand([]string{"DEBUG", "SLEEP", "0"})
+	_, err = client.Exec(ctx, *batch, true)
+	assert.ErrorAs(t, err, &policyErr)
+	_, err = clusterClient.Del(ctx, []string{key})
+	assert.ErrorAs(t, err, &policyErr)
+
+	value, err := client.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+}
+
+func (suite *GlideTestSuite) TestCommandPolicy_Subcommands() {
+	t := suite.T()
+	ctx := context.Background()
+	client, err := suite.client(
+		suite.defaultClientConfig().WithCommandPolicy(nil, []string{config.DangerousCommandsTag, "XINFO GROUPS"}),
+	)
+	require.NoError(t, err)
+	defer client.Close()
+	clusterClient, err := suite.clusterClient(suite.defaultClusterClientConfig().WithCommandPolicy(nil, []string{"scan"}))
+	require.NoError(t, err)
+	defer clusterClient.Close()
+
+	// The typed commands of the container commands are denied by the tag of their container command
+	var policyErr *glide.PolicyError
+	_, err = client.ConfigSet(ctx, map[string]string{"timeout": "1000"})
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, "CONFIG SET", policyErr.Command)
+	_, err = client.ClientId(ctx)
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, "CLIENT ID", policyErr.Command)
+
+	// The custom commands are matched with their subcommand, the typed commands by their container command
+	_, err = client.CustomCommand(ctx, []string{"config", "get", "timeout"})
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, "CONFIG", policyErr.Command)
+	_, err = client.CustomCommand(ctx, []string{"XINFO", "groups", "stream"})
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, "XINFO GROUPS", policyErr.Command)
+	_, err = client.CustomCommand(ctx, []string{"XINFO", "HELP"})
+	assert.NoError(t, err)
+
+	// The cluster scans are checked as `SCAN`
+	_, err = clusterClient.Scan(ctx, models.NewClusterScanCursor())
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, "SCAN", policyErr.Command)
+}
@@ -0,0 +1,50 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/compare"
+)
+
+func (suite *GlideTestSuite) TestCompareKeyspaces() {
+	t := suite.T()
+	ctx := context.Background()
+	standalone := suite.defaultClient()
+	cluster := suite.defaultClusterClient()
+	prefix := uuid.NewString() + ":"
+
+	for _, client := range []interface {
+		Set(ctx context.Context, key string, value string) (string, error)
+		HSet(ctx context.Context, key string, values map[string]string) (int64, error)
+	}{standalone, cluster} {
+		_, err := client.Set(ctx, prefix+"same", "value")
+		require.NoError(t, err)
+		_, err = client.HSet(ctx, prefix+"hash", map[string]string{"field": "value"})
+		require.NoError(t, err)
+	}
+	_, err := standalone.Set(ctx, prefix+"only-standalone", "value")
+	require.NoError(t, err)
+	_, err = cluster.HSet(ctx, prefix+"hash", map[string]string{"other": "value"})
+	require.NoError(t, err)
+	_, err = cluster.PExpire(ctx, prefix+"same", time.Hour)
+	require.NoError(t, err)
+
+	comparison := compare.Keyspaces(ctx, standalone, cluster, compare.Options{Pattern: prefix + "*"})
+	kinds := map[string]compare.DifferenceKind{}
+	for difference := range comparison.Differences() {
+		kinds[difference.Key] = difference.Kind
+	}
+	require.NoError(t, comparison.Err())
+	assert.Equal(t, map[string]compare.DifferenceKind{
+		prefix + "only-standalone": compare.OnlyInA,
+		prefix + "hash":            compare.ValueMismatch,
+		prefix + "same":            compare.TTLMismatch,
+	}, kinds)
+	assert.Equal(t, int64(3), comparison.Compared())
+}
@@ -3,6 +3,8 @@
 package integTest
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -525,6 +527,53 @@ func (suite *GlideTestSuite) TestCompressionBackendMismatch() {
 	zstdClient.Del(context.Background(), []string{key})
 }
 
+func (suite *GlideTestSuite) TestCompressionAutoDecompression() {
+	// A value compressed with gzip by another producer, without the header of the values compressed by Glide
+	plainClient := suite.defaultClient()
+	defer plainClient.Close()
+
+	compressionConfig := config.NewCompressionConfiguration().WithAutoDecompression(config.Gzip)
+	client, err := suite.client(suite.defaultClientConfig().WithCompressionConfiguration(compressionConfig))
+	assert.NoError(suite.T(), err)
+	defer client.Close()
+
+	t := suite.T()
+
+	key := fmt.Sprintf("auto_decompression_%s", randomString(8))
+	value := generateCompressibleText(1024)
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, err = writer.Write([]byte(value))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	_, err = plainClient.Set(context.Background(), key, compressed.String())
+	assert.NoError(t, err)
+
+	statsBefore := client.GetStatistics()
+	retrieved, err := client.Get(context.Background(), key)
+	assert.NoError(t, err)
+	assert.Equal(t, value, retrieved.Value())
+	assert.Equal(t, statsBefore["total_values_decompressed"]+1, client.GetStatistics()["total_values_decompressed"])
+
+	// Without auto-decompression, the compressed value is returned as is
+	otherClient := suite.compressionClient()
+	defer otherClient.Close()
+	retrieved, err = otherClient.Get(context.Background(), key)
+	assert.NoError(t, err)
+	assert.Equal(t, compressed.String(), retrieved.Value())
+
+	// The values compressed by the client are still decompressed
+	otherKey := fmt.Sprintf("auto_decompression_%s", randomString(8))
+	_, err = client.Set(context.Background(), otherKey, value)
+	assert.NoError(t, err)
+	retrieved, err = client.Get(context.Background(), otherKey)
+	assert.NoError(t, err)
+	assert.Equal(t, value, retrieved.Value())
+
+	client.Del(context.Background(), []string{key, otherKey})
+}
+
 // ============================================================================
 // Compatibility Tests
 // ============================================================================
@@ -0,0 +1,76 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connectionEventsRecorder records the connection lifecycle events of a client.
+type connectionEventsRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (recorder *connectionEventsRecorder) record(event string) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.events = append(recorder.events, event)
+}
+
+func (recorder *connectionEventsRecorder) count(event string) int {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	count := 0
+	for _, recorded := range recorder.events {
+		if recorded == event {
+			count++
+		}
+	}
+	return count
+}
+
+func (recorder *connectionEventsRecorder) onConnect(address string) {
+	recorder.record("connect")
+}
+
+func (recorder *connectionEventsRecorder) onDisconnect(address string, err error) {
+	recorder.record("disconnect")
+}
+
+func (recorder *connectionEventsRecorder) onReconnectAttempt(address string, attempt int, err error) {
+	recorder.record("reconnect attempt")
+}
+
+func (suite *GlideTestSuite) TestConnectionLifecycleHooks() {
+	t := suite.T()
+	ctx := context.Background()
+	recorder := &connectionEventsRecorder{}
+	client, err := suite.client(suite.defaultClientConfig().WithConnectionLifecycleHooks(
+		recorder.onConnect,
+		recorder.onDisconnect,
+		recorder.onReconnectAttempt,
+	))
+	require.NoError(t, err)
+	defer client.Close()
+
+	assert.Eventually(t, func() bool { return recorder.count("connect") > 0 }, 5*time.Second, 50*time.Millisecond)
+	connected := recorder.count("connect")
+
+	// The client kills its own connection, and reconnects
+	id, err := client.CustomCommand(ctx, []string{"CLIENT", "ID"})
+	require.NoError(t, err)
+	_, _ = client.CustomCommand(ctx, []string{"CLIENT", "KILL", "ID", fmt.Sprint(id)})
+	assert.Eventually(t, func() bool { return recorder.count("disconnect") == 1 }, 5*time.Second, 50*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		_, err := client.Ping(ctx)
+		return err == nil && recorder.count("connect") == connected+1
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Zero(t, recorder.count("reconnect attempt"))
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	glide "github.com/valkey-io/valkey-glide/go/v2"
@@ -404,6 +405,39 @@ func (suite *GlideTestSuite) TestTcpNoDelayConfiguration() {
 	})
 }
 
+func (suite *GlideTestSuite) TestTcpSocketOptions() {
+	// The default configurations hold the TLS certificates in their advanced configuration, which is replaced here
+	skipIfTlsEnabled(suite)
+
+	standaloneClient, err := suite.client(suite.defaultClientConfig().WithAdvancedConfiguration(
+		config.NewAdvancedClientConfiguration().
+			WithTcpKeepAliveInterval(10 * time.Second).
+			WithTcpSendBufferSize(256 * 1024).
+			WithTcpRecvBufferSize(256 * 1024),
+	))
+	require.NoError(suite.T(), err)
+	defer standaloneClient.Close()
+	assertConnected(suite.T(), standaloneClient)
+
+	clusterClient, err := suite.clusterClient(suite.defaultClusterClientConfig().WithAdvancedConfiguration(
+		config.NewAdvancedClusterClientConfiguration().
+			WithTcpKeepAliveInterval(10 * time.Second).
+			WithTcpSendBufferSize(256 * 1024).
+			WithTcpRecvBufferSize(256 * 1024),
+	))
+	require.NoError(suite.T(), err)
+	defer clusterClient.Close()
+	assertConnected(suite.T(), clusterClient)
+
+	// Values larger than the socket buffers are transferred with the configured buffers
+	key := uuid.NewString()
+	value := strings.Repeat("x", 1024*1024)
+	suite.verifyOK(clusterClient.Set(context.Background(), key, value))
+	result, err := clusterClient.Get(context.Background(), key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), value, result.Value())
+}
+
 // TestConnectWithIPv4AddressSucceeds_Standalone tests non-TLS connection with IPv4 address
 func (suite *GlideTestSuite) TestConnectWithIPv4AddressSucceeds_Standalone() {
 	// See 'tls_test.go' for corresponding TLS-enabled test.
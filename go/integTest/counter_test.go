@@ -0,0 +1,61 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/counter"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestCounter() {
+	suite.SkipIfServerVersionLowerThan("6.2.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		hits := counter.New(client, uuid.New().String()).WithExpiry(time.Minute)
+
+		require.NoError(t, hits.Add(context.Background(), 10))
+		require.NoError(t, hits.Add(context.Background(), -3))
+		value, err := hits.Get(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), value)
+
+		ttl, err := client.TTL(context.Background(), hits.Keys()[0])
+		require.NoError(t, err)
+		assert.Greater(t, ttl, int64(0))
+
+		value, err = hits.Reset(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), value)
+		value, err = hits.Get(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, value)
+	})
+}
+
+func (suite *GlideTestSuite) TestCounter_Sharded() {
+	suite.SkipIfServerVersionLowerThan("6.2.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		hits := counter.New(client, uuid.New().String()).WithShards(8)
+
+		for i := 0; i < 50; i++ {
+			require.NoError(t, hits.Add(context.Background(), 2))
+		}
+		value, err := hits.Get(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), value)
+
+		value, err = hits.Reset(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), value)
+		exists, err := client.Exists(context.Background(), hits.Keys())
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+}
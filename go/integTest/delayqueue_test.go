@@ -0,0 +1,48 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/delayqueue"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestDelayQueue() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		queue := uuid.NewString()
+		scheduler := delayqueue.New(client)
+
+		require.NoError(t, scheduler.Schedule(ctx, queue, "job:2", time.Now().Add(-time.Second)))
+		require.NoError(t, scheduler.Schedule(ctx, queue, "job:1", time.Now().Add(-time.Minute)))
+		require.NoError(t, scheduler.Schedule(ctx, queue, "job:3", time.Now().Add(time.Hour)))
+
+		items, err := scheduler.Poll(ctx, queue, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"job:1", "job:2"}, items)
+		items, err = scheduler.Poll(ctx, queue, 10)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+
+		length, err := scheduler.Len(ctx, queue)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), length)
+		processing, err := client.LRange(ctx, delayqueue.ProcessingKey(queue), 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"job:1", "job:2"}, processing)
+
+		acked, err := scheduler.Ack(ctx, queue, "job:1")
+		require.NoError(t, err)
+		assert.True(t, acked)
+		processing, err = client.LRange(ctx, delayqueue.ProcessingKey(queue), 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"job:2"}, processing)
+	})
+}
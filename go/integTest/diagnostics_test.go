@@ -0,0 +1,28 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/diagnostics"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+func (suite *GlideTestSuite) TestCheckClockDrift() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		drifts, err := diagnostics.CheckClockDrift(context.Background(), client)
+		require.NoError(t, err)
+		require.NotEmpty(t, drifts)
+		// The servers of the tests run on the local host, with the same clock
+		for node, drift := range drifts {
+			assert.Equal(t, node, drift.Node)
+			assert.Less(t, drift.Drift.Abs(), time.Second)
+			assert.Positive(t, drift.RoundTrip)
+		}
+	})
+}
@@ -3,12 +3,15 @@
 package integTest
 
 import (
+	"context"
 	"os"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	glide "github.com/valkey-io/valkey-glide/go/v2"
 	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
 
 // DNS resolution tests.
@@ -16,6 +19,9 @@ import (
 
 const hostnameInvalid = "nonexistent.invalid"
 
+// The hostnames are re-resolved along with the periodic connection checks, every 3 seconds.
+const dnsReResolutionWait = 4 * time.Second
+
 // Skips the current test if DNS tests are not enabled or if
 // the TLS configuration does not match the test requirements.
 func skipIfNotEnabled(suite *GlideTestSuite, useTLS bool) {
@@ -149,3 +155,44 @@ func (suite *GlideTestSuite) TestDnsTlsWithHostnameNotInCertificateFails_Cluster
 	_, err := suite.buildClusterClient(HostnameNoTLS, true)
 	assert.Error(suite.T(), err)
 }
+
+func (suite *GlideTestSuite) TestDnsReResolutionKeepsConnectionToSameIp_Standalone() {
+	skipIfNotEnabled(suite, false)
+
+	address := config.NodeAddress{Host: HostnameNoTLS, Port: suite.standaloneHosts[0].Port}
+	dnsResolution := config.NewDnsResolutionConfiguration().WithTTL(time.Millisecond).WithPreferHostname(true)
+	client, err := glide.NewClient(defaultClientConfig().WithAddress(&address).WithDnsResolution(dnsResolution))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	clientId, err := client.ClientId(context.Background())
+	require.NoError(suite.T(), err)
+
+	// The hostname still resolves to the IP of the connection, which is not re-established
+	time.Sleep(dnsReResolutionWait)
+	newClientId, err := client.ClientId(context.Background())
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), clientId, newClientId)
+}
+
+func (suite *GlideTestSuite) TestDnsReResolutionKeepsConnectionToSameIp_Cluster() {
+	skipIfNotEnabled(suite, false)
+
+	address := config.NodeAddress{Host: HostnameNoTLS, Port: suite.clusterHosts[0].Port}
+	dnsResolution := config.NewDnsResolutionConfiguration().WithTTL(time.Millisecond).WithPreferHostname(true)
+	client, err := glide.NewClusterClient(
+		defaultClusterClientConfig().WithAddress(&address).WithDnsResolution(dnsResolution),
+	)
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	route := options.RouteOption{Route: config.AllPrimaries}
+	clientIds, err := client.ClientIdWithOptions(context.Background(), route)
+	require.NoError(suite.T(), err)
+
+	// The hostname still resolves to the IP of the connections, which are not re-established
+	time.Sleep(dnsReResolutionWait)
+	newClientIds, err := client.ClientIdWithOptions(context.Background(), route)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), clientIds.MultiValue(), newClientIds.MultiValue())
+}
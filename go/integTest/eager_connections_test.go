@@ -0,0 +1,37 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// refusedAddress is a local port without a server, whose connections are refused at once.
+var refusedAddress = config.NodeAddress{Host: "127.0.0.1", Port: 1}
+
+func (suite *GlideTestSuite) TestEagerConnections_Standalone() {
+	t := suite.T()
+	client, err := suite.client(suite.defaultClientConfig().WithEagerConnections(true))
+	require.NoError(t, err)
+	_, err = client.Set(context.Background(), "eager-connections", "value")
+	assert.NoError(t, err)
+
+	// Without eager connections, the client is created and the address is connected in the background
+	_, err = suite.client(suite.defaultClientConfig().WithAddress(&refusedAddress))
+	require.NoError(t, err)
+	_, err = suite.client(suite.defaultClientConfig().WithAddress(&refusedAddress).WithEagerConnections(true))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "127.0.0.1:1")
+}
+
+func (suite *GlideTestSuite) TestEagerConnections_Cluster() {
+	t := suite.T()
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithEagerConnections(true))
+	require.NoError(t, err)
+	_, err = client.Set(context.Background(), "eager-connections", "value")
+	assert.NoError(t, err)
+}
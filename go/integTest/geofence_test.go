@@ -0,0 +1,60 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/geofence"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func (suite *GlideTestSuite) TestGeofence() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		index := geofence.New(client, uuid.New().String())
+
+		added, err := index.AddPlace(ctx, "Palermo", 38.115556, 13.361389)
+		require.NoError(t, err)
+		assert.True(t, added)
+		count, err := index.AddPlaces(ctx, map[string]options.GeospatialData{
+			"Catania": {Latitude: 37.502669, Longitude: 15.087269},
+			"Rome":    {Latitude: 41.902782, Longitude: 12.496366},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		// Palermo is about 166 km from Catania
+		places, err := index.Nearby(ctx, 37.5, 15.08, 200_000, 0)
+		require.NoError(t, err)
+		require.Len(t, places, 2)
+		assert.Equal(t, "Catania", places[0].Name)
+		assert.Less(t, places[0].Distance, float64(1000))
+		assert.InDelta(t, 37.502669, places[0].Latitude, 0.001)
+		assert.Equal(t, "Palermo", places[1].Name)
+
+		places, err = index.Nearby(ctx, 37.5, 15.08, 200_000, 1)
+		require.NoError(t, err)
+		require.Len(t, places, 1)
+		assert.Equal(t, "Catania", places[0].Name)
+
+		places, err = index.Within(ctx, []geofence.Circle{
+			{Latitude: 41.9, Longitude: 12.5, Radius: 10_000},
+			{Latitude: 38.1, Longitude: 13.4, Radius: 10_000},
+			{Latitude: 38.1, Longitude: 13.3, Radius: 10_000},
+		})
+		require.NoError(t, err)
+		require.Len(t, places, 2)
+		names := []string{places[0].Name, places[1].Name}
+		assert.ElementsMatch(t, []string{"Palermo", "Rome"}, names)
+
+		removed, err := index.RemovePlaces(ctx, "Rome")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), removed)
+	})
+}
@@ -4,16 +4,13 @@ package integTest
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"math"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"slices"
-	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -26,10 +23,9 @@ import (
 	"github.com/stretchr/testify/suite"
 	glide "github.com/valkey-io/valkey-glide/go/v2"
 	"github.com/valkey-io/valkey-glide/go/v2/config"
-	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/glidetestkit"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
-	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
 
 type ClientTypeFlag uint
@@ -134,169 +130,72 @@ func (suite *GlideTestSuite) SetupSuite() {
 }
 
 func parseHosts(suite *GlideTestSuite, addresses string) []config.NodeAddress {
-	var result []config.NodeAddress
-
-	addressList := strings.Split(addresses, ",")
-	for _, address := range addressList {
-		parts := strings.Split(address, ":")
-		port, err := strconv.Atoi(parts[1])
-		if err != nil {
-			suite.T().Fatalf("Failed to parse port from string %s: %s", parts[1], err.Error())
-		}
-
-		result = append(result, config.NodeAddress{Host: parts[0], Port: port})
+	result, err := glidetestkit.ParseAddresses(addresses)
+	if err != nil {
+		suite.T().Fatalf("Failed to parse addresses %s: %s", addresses, err.Error())
 	}
 	return result
 }
 
 func extractClusterFolder(suite *GlideTestSuite, output string) string {
-	lines := strings.Split(output, "\n")
-	foundFolder := false
-	clusterFolder := ""
-
-	for _, line := range lines {
-		if strings.Contains(line, "CLUSTER_FOLDER=") {
-			parts := strings.SplitN(line, "CLUSTER_FOLDER=", 2)
-			if len(parts) != 2 {
-				suite.T().Fatalf("invalid CLUSTER_FOLDER line format: %s", line)
-			}
-			clusterFolder = strings.TrimSpace(parts[1])
-			foundFolder = true
-		}
-	}
-
-	if !foundFolder {
-		suite.T().Fatalf("missing required output fields")
+	clusterFolder, _, err := glidetestkit.ParseStartOutput(output)
+	if err != nil {
+		suite.T().Fatalf("missing required output fields: %s", err.Error())
 	}
 	return clusterFolder
 }
 
 func extractAddresses(suite *GlideTestSuite, output string) []config.NodeAddress {
-	for _, line := range strings.Split(output, "\n") {
-		if !strings.HasPrefix(line, "CLUSTER_NODES=") {
-			continue
-		}
-
-		addresses := strings.Split(line, "=")[1]
-		return parseHosts(suite, addresses)
+	_, addresses, err := glidetestkit.ParseStartOutput(output)
+	if err != nil {
+		suite.T().Fatalf("Failed to parse port from cluster_manager.py output: %s", err.Error())
 	}
-
-	suite.T().Fatalf("Failed to parse port from cluster_manager.py output")
-	return []config.NodeAddress{}
+	return addresses
 }
 
 func runClusterManager(suite *GlideTestSuite, args []string, ignoreExitCode bool) string {
-	pythonArgs := append([]string{"../../utils/cluster_manager.py"}, args...)
-	output, err := exec.Command("python3", pythonArgs...).CombinedOutput()
+	output, err := glidetestkit.NewClusterManager("../../utils/cluster_manager.py").Run(context.Background(), args...)
 	if len(output) > 0 && !ignoreExitCode {
-		suite.T().Logf("cluster_manager.py output:\n====\n%s\n====\n", string(output))
+		suite.T().Logf("cluster_manager.py output:\n====\n%s\n====\n", output)
 	}
-
-	if err != nil {
-		var exitError *exec.ExitError
-		isExitError := errors.As(err, &exitError)
-		if !isExitError {
-			suite.T().Fatalf("Unexpected error while executing cluster_manager.py: %s", err.Error())
-		}
-
-		if len(exitError.Stderr) > 0 {
-			suite.T().Logf("cluster_manager.py stderr:\n====\n%s\n====\n", string(exitError.Stderr))
-		}
-
-		if !ignoreExitCode {
-			suite.T().Fatalf("cluster_manager.py script failed: %s", exitError.Error())
-		}
+	if err != nil && !ignoreExitCode {
+		suite.T().Fatalf("cluster_manager.py script failed: %s", err.Error())
 	}
-
-	return string(output)
+	return output
 }
 
 func getServerVersion(suite *GlideTestSuite) string {
-	var err error = nil
+	deployments := []*glidetestkit.Deployment{}
 	if len(suite.standaloneHosts) > 0 {
-		clientConfig := config.NewClientConfiguration().
-			WithAddress(&suite.standaloneHosts[0]).
-			WithUseTLS(suite.tls).
-			WithRequestTimeout(5 * time.Second)
+		deployments = append(deployments, &glidetestkit.Deployment{Addresses: suite.standaloneHosts[:1], TLS: suite.tls})
+	}
+	if len(suite.clusterHosts) > 0 {
+		deployments = append(
+			deployments,
+			&glidetestkit.Deployment{Addresses: suite.clusterHosts[:1], ClusterMode: true, TLS: suite.tls},
+		)
+	}
+	if len(deployments) == 0 {
+		suite.T().Fatal("No server hosts configured")
+	}
 
+	var err error
+	for _, deployment := range deployments {
 		// If TLS is enabled, try to load custom certificates
 		if suite.tls {
 			if certData, certErr := loadCaCertificateForTests(); certErr == nil {
-				tlsConfig := config.NewTlsConfiguration().WithRootCertificates(certData)
-				advancedConfig := config.NewAdvancedClientConfiguration().WithTlsConfiguration(tlsConfig)
-				clientConfig = clientConfig.WithAdvancedConfiguration(advancedConfig)
+				deployment.RootCertificates = certData
 			}
 		}
-
-		client, err := glide.NewClient(clientConfig)
-		if err == nil && client != nil {
-			defer client.Close()
-			info, _ := client.InfoWithOptions(
-				context.Background(),
-				options.InfoOptions{Sections: []constants.Section{constants.Server}},
-			)
-			return extractServerVersion(suite, info)
+		var version string
+		if version, err = deployment.ServerVersion(context.Background()); err == nil {
+			return version
 		}
 	}
-	if len(suite.clusterHosts) == 0 {
-		if err != nil {
-			suite.T().Fatalf("No cluster hosts configured, standalone failed with %s", err.Error())
-		}
-		suite.T().Fatal("No server hosts configured")
-	}
-
-	clientConfig := config.NewClusterClientConfiguration().
-		WithAddress(&suite.clusterHosts[0]).
-		WithUseTLS(suite.tls).
-		WithRequestTimeout(5 * time.Second)
-
-	// If TLS is enabled, try to load custom certificates
-	if suite.tls {
-		if certData, certErr := loadCaCertificateForTests(); certErr == nil {
-			tlsConfig := config.NewTlsConfiguration().WithRootCertificates(certData)
-			advancedConfig := config.NewAdvancedClusterClientConfiguration().WithTlsConfiguration(tlsConfig)
-			clientConfig = clientConfig.WithAdvancedConfiguration(advancedConfig)
-		}
-	}
-
-	client, err := glide.NewClusterClient(clientConfig)
-	if err == nil && client != nil {
-		defer client.Close()
-
-		info, _ := client.InfoWithOptions(
-			context.Background(),
-			options.ClusterInfoOptions{
-				InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Server}},
-				RouteOption: &options.RouteOption{Route: config.RandomRoute},
-			},
-		)
-		return extractServerVersion(suite, info.SingleValue())
-	}
 	suite.T().Fatalf("Can't connect to any server to get version: %s", err.Error())
 	return ""
 }
 
-func extractServerVersion(suite *GlideTestSuite, output string) string {
-	// output format:
-	//   # Server
-	//   redis_version:7.2.3
-	//	 ...
-	// It can contain `redis_version` or `valkey_version` key or both. If both, `valkey_version` should be taken
-	for _, line := range strings.Split(output, "\r\n") {
-		if strings.Contains(line, "valkey_version") {
-			return strings.Split(line, ":")[1]
-		}
-	}
-
-	for _, line := range strings.Split(output, "\r\n") {
-		if strings.Contains(line, "redis_version") {
-			return strings.Split(line, ":")[1]
-		}
-	}
-	suite.T().Fatalf("Can't read server version from INFO command output: %s", output)
-	return ""
-}
-
 func TestGlideTestSuite(t *testing.T) {
 	suite.Run(t, new(GlideTestSuite))
 }
@@ -553,9 +452,7 @@ func (suite *GlideTestSuite) verifyOK(result string, err error) {
 }
 
 func (suite *GlideTestSuite) SkipIfServerVersionLowerThan(version string, t *testing.T) {
-	if suite.serverVersion < version {
-		t.Skipf("This feature is added in version %s", version)
-	}
+	glidetestkit.SkipIfServerVersionLowerThan(t, suite.serverVersion, version)
 }
 
 func (suite *GlideTestSuite) GenerateLargeUuid() string {
@@ -0,0 +1,44 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/glidetestkit"
+)
+
+func (suite *GlideTestSuite) TestGlideTestkitObjectEncoding() {
+	t := suite.T()
+	ctx := context.Background()
+	servers := glidetestkit.NewClusterManager("../../utils/cluster_manager.py").
+		WithTLS(suite.tls).
+		StartForTest(t, false, 0)
+	if certificates, err := loadCaCertificateForTests(); err == nil {
+		servers.RootCertificates = certificates
+	}
+	serverVersion := servers.ServerVersionForTest(t)
+	assert.Equal(t, suite.serverVersion, serverVersion)
+
+	client, err := glide.NewClient(servers.ClientConfig())
+	require.NoError(t, err)
+	defer client.Close()
+
+	suite.verifyOK(client.Set(ctx, "number", "12345"))
+	encoding, err := client.ObjectEncoding(ctx, "number")
+	require.NoError(t, err)
+	assert.Equal(t, "int", encoding.Value())
+
+	_, err = client.HSet(ctx, "hash", map[string]string{"field": "value"})
+	require.NoError(t, err)
+	encoding, err = client.ObjectEncoding(ctx, "hash")
+	require.NoError(t, err)
+	if glidetestkit.CompareVersions(serverVersion, "7.0.0") < 0 {
+		assert.Equal(t, "ziplist", encoding.Value())
+	} else {
+		assert.Equal(t, "listpack", encoding.Value())
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// getCache is an interceptor caching the responses of GET, and counting the requests sent to the server.
+type getCache struct {
+	mu        sync.Mutex
+	responses map[string]any
+	sent      []models.Command
+}
+
+func (cache *getCache) intercept(ctx context.Context, cmd models.Command, next config.Invoker) (any, error) {
+	if cmd.Name == "GET" {
+		cache.mu.Lock()
+		response, ok := cache.responses[cmd.Args[0]]
+		cache.mu.Unlock()
+		if ok {
+			return response, nil
+		}
+	}
+	response, err := next(ctx, cmd)
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.sent = append(cache.sent, cmd)
+	if cmd.Name == "GET" && err == nil {
+		cache.responses[cmd.Args[0]] = response
+	}
+	return response, err
+}
+
+// upperCaseValues is an interceptor rewriting the values of SET.
+func upperCaseValues(ctx context.Context, cmd models.Command, next config.Invoker) (any, error) {
+	if cmd.Name == "SET" {
+		cmd.Args[1] = "VALUE"
+	}
+	return next(ctx, cmd)
+}
+
+func (suite *GlideTestSuite) TestInterceptors() {
+	cache := &getCache{responses: map[string]any{}}
+	client, err := suite.client(
+		suite.defaultClientConfig().WithInterceptor(cache.intercept).WithInterceptor(upperCaseValues),
+	)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	for range 2 {
+		value, err := client.Get(ctx, key)
+		suite.NoError(err)
+		suite.Equal("VALUE", value.Value())
+	}
+	suite.Len(cache.sent, 2)
+
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*options.NewScript("return KEYS[1]"),
+		*options.NewScriptOptions().WithKeys([]string{key}),
+	)
+	suite.NoError(err)
+	suite.Equal(key, result)
+	suite.Equal("EVALSHA", cache.sent[2].Name)
+
+	batch := pipeline.NewStandaloneBatch(true).Set(key, "value").CustomCommand([]string{"get", key})
+	results, err := client.Exec(ctx, *batch, true)
+	suite.NoError(err)
+	suite.Equal([]any{"OK", "value"}, results)
+	sent := cache.sent[3]
+	suite.Equal("Batch", sent.Name)
+	suite.True(sent.Atomic)
+	suite.Equal([]models.Command{{Name: "SET", Args: []string{key, "value"}}, {Name: "GET", Args: []string{key}}}, sent.Batch)
+}
+
+func (suite *GlideTestSuite) TestInterceptorsCluster() {
+	cache := &getCache{responses: map[string]any{}}
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithInterceptor(cache.intercept))
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	for range 2 {
+		value, err := client.Get(ctx, key)
+		suite.NoError(err)
+		suite.Equal("value", value.Value())
+	}
+	suite.Len(cache.sent, 2)
+}
@@ -0,0 +1,57 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/chaos"
+	"github.com/valkey-io/valkey-glide/go/v2/journal"
+)
+
+func (suite *GlideTestSuite) TestJournal() {
+	t := suite.T()
+	ctx := context.Background()
+	writes, err := journal.Open(filepath.Join(t.TempDir(), "writes.journal"))
+	require.NoError(t, err)
+	defer writes.Close()
+
+	// The injected faults reach the journal as real connection losses would
+	key := "journal:" + uuid.NewString()
+	injector := chaos.New().Add(chaos.InjectMovedRedirects(1).ForCommands("HSET")).Add(
+		chaos.ResetConnections(1).ForCommands("SADD"),
+	)
+	client, err := suite.client(suite.defaultClientConfig().
+		WithInterceptor(writes.Interceptor()).
+		WithInterceptor(injector.Interceptor()))
+	require.NoError(t, err)
+	defer client.Close()
+
+	// The connection reset is journaled, the redirect is not
+	_, err = client.SAdd(journal.WithIdempotencyKey(ctx, "add-1"), key, []string{"1"})
+	assert.ErrorIs(t, err, journal.ErrJournaled)
+	_, err = client.SAdd(journal.WithIdempotencyKey(ctx, "add-1"), key, []string{"1"})
+	assert.ErrorIs(t, err, journal.ErrJournaled)
+	_, err = client.SAdd(journal.WithReplay(ctx), key, []string{"2"})
+	assert.ErrorIs(t, err, journal.ErrJournaled)
+	_, err = client.HSet(journal.WithReplay(ctx), uuid.NewString(), map[string]string{"field": "value"})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, journal.ErrJournaled)
+	assert.Len(t, writes.Pending(), 2)
+
+	// The journaled writes are applied once the connection is restored
+	_, err = client.Del(ctx, []string{key})
+	require.NoError(t, err)
+	injector.SetEnabled(false)
+	replayed, err := writes.Replay(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, 2, replayed)
+	assert.Empty(t, writes.Pending())
+	members, err := client.SMembers(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"1": {}, "2": {}}, members)
+}
@@ -0,0 +1,89 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+// setKeyspaceEvents sets the `notify-keyspace-events` configuration on all the nodes of `client`.
+func setKeyspaceEvents(ctx context.Context, client interfaces.BaseClientCommands, events string) error {
+	parameters := map[string]string{"notify-keyspace-events": events}
+	switch client := client.(type) {
+	case *glide.Client:
+		_, err := client.ConfigSet(ctx, parameters)
+		return err
+	case *glide.ClusterClient:
+		_, err := client.ConfigSet(ctx, parameters)
+		return err
+	}
+	return nil
+}
+
+// watchChanges calls WatchChanges on `client`.
+func watchChanges(ctx context.Context, client interfaces.BaseClientCommands, keys ...string) (<-chan string, error) {
+	switch client := client.(type) {
+	case *glide.Client:
+		return client.WatchChanges(ctx, keys...)
+	case *glide.ClusterClient:
+		return client.WatchChanges(ctx, keys...)
+	}
+	return nil, nil
+}
+
+// receiveChange returns the next key received from `changes`, or fails the test after a timeout.
+func (suite *GlideTestSuite) receiveChange(changes <-chan string) string {
+	select {
+	case key := <-changes:
+		return key
+	case <-time.After(5 * time.Second):
+		require.FailNow(suite.T(), "the change was not received")
+		return ""
+	}
+}
+
+func (suite *GlideTestSuite) TestWatchChanges() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		require.NoError(t, setKeyspaceEvents(ctx, client, "KA"))
+		defer setKeyspaceEvents(context.Background(), client, "")
+
+		tag := "{" + uuid.NewString() + "}"
+		changes, err := watchChanges(ctx, client, tag+":a", tag+":b")
+		require.NoError(t, err)
+
+		_, err = client.Set(ctx, tag+":b", "value")
+		require.NoError(t, err)
+		assert.Equal(t, tag+":b", suite.receiveChange(changes))
+		_, err = client.Del(ctx, []string{tag + ":b"})
+		require.NoError(t, err)
+		assert.Equal(t, tag+":b", suite.receiveChange(changes))
+
+		// The changes of the keys that are not watched are not reported
+		_, err = client.Set(ctx, tag+":c", "value")
+		require.NoError(t, err)
+		_, err = client.Set(ctx, tag+":a", "value")
+		require.NoError(t, err)
+		assert.Equal(t, tag+":a", suite.receiveChange(changes))
+
+		cancel()
+		for range changes {
+		}
+	})
+}
+
+func (suite *GlideTestSuite) TestWatchChanges_KeyWithoutHashTag() {
+	client := suite.defaultClusterClient()
+	_, err := client.WatchChanges(context.Background(), uuid.NewString())
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,95 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func (suite *GlideTestSuite) TestKeyPrefix() {
+	t := suite.T()
+	ctx := context.Background()
+	prefix := uuid.NewString() + ":"
+	tenant, err := suite.client(suite.defaultClientConfig().WithKeyPrefix(prefix))
+	require.NoError(t, err)
+	other, err := suite.client(suite.defaultClientConfig().WithKeyPrefix(uuid.NewString() + ":"))
+	require.NoError(t, err)
+	unprefixed := suite.defaultClient()
+
+	key := uuid.NewString()
+	_, err = tenant.Set(ctx, key, "value")
+	require.NoError(t, err)
+
+	value, err := tenant.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+	value, err = unprefixed.Get(ctx, prefix+key)
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+	// The keys of the other tenants are not visible
+	value, err = other.Get(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, value.IsNil())
+
+	// SCAN only returns the keys of the tenant, without the prefix
+	var keys []string
+	cursor := models.NewCursor()
+	for !cursor.IsFinished() {
+		result, err := tenant.Scan(ctx, cursor)
+		require.NoError(t, err)
+		keys = append(keys, result.Data...)
+		cursor = result.Cursor
+	}
+	assert.Equal(t, []string{key}, keys)
+
+	randomKey, err := tenant.RandomKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, key, randomKey.Value())
+
+	list := uuid.NewString()
+	_, err = tenant.LPush(ctx, list, []string{"element"})
+	require.NoError(t, err)
+	popped, err := tenant.BLPop(ctx, []string{uuid.NewString(), list}, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, []string{list, "element"}, popped)
+}
+
+func (suite *GlideTestSuite) TestKeyPrefix_Cluster() {
+	t := suite.T()
+	ctx := context.Background()
+	prefix := uuid.NewString() + ":"
+	tenant, err := suite.clusterClient(suite.defaultClusterClientConfig().WithKeyPrefix(prefix))
+	require.NoError(t, err)
+	unprefixed := suite.defaultClusterClient()
+
+	// The keys are routed by their prefixed names
+	keys := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	for _, key := range keys {
+		_, err = tenant.Set(ctx, key, key)
+		require.NoError(t, err)
+		value, err := unprefixed.Get(ctx, prefix+key)
+		require.NoError(t, err)
+		assert.Equal(t, key, value.Value())
+	}
+	values, err := tenant.MGet(ctx, keys)
+	require.NoError(t, err)
+	for i, value := range values {
+		assert.Equal(t, keys[i], value.Value())
+	}
+
+	var scanned []string
+	cursor := models.NewClusterScanCursor()
+	for !cursor.IsFinished() {
+		result, err := tenant.Scan(ctx, cursor)
+		require.NoError(t, err)
+		scanned = append(scanned, result.Keys...)
+		cursor = result.Cursor
+	}
+	assert.ElementsMatch(t, keys, scanned)
+}
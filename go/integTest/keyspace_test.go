@@ -0,0 +1,66 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/keyspace"
+)
+
+func (suite *GlideTestSuite) TestKeyspaceExportImport() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		prefix := uuid.New().String() + ":"
+		_, err := client.Set(ctx, prefix+"string", "value")
+		require.NoError(t, err)
+		_, err = client.HSet(ctx, prefix+"hash", map[string]string{"field": "value"})
+		require.NoError(t, err)
+		_, err = client.PExpire(ctx, prefix+"hash", time.Hour)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		exported, err := keyspace.Export(ctx, client, prefix+"*", &buf)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), exported)
+
+		// Importing existing keys fails unless they are replaced
+		_, err = keyspace.Import(ctx, client, bytes.NewReader(buf.Bytes()))
+		assert.Error(t, err)
+
+		_, err = client.Del(ctx, []string{prefix + "string", prefix + "hash"})
+		require.NoError(t, err)
+		imported, err := keyspace.Import(ctx, client, bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), imported)
+
+		value, err := client.Get(ctx, prefix+"string")
+		require.NoError(t, err)
+		assert.Equal(t, "value", value.Value())
+		fields, err := client.HGetAll(ctx, prefix+"hash")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"field": "value"}, fields)
+		ttl, err := client.TTL(ctx, prefix+"hash")
+		require.NoError(t, err)
+		assert.Greater(t, ttl, int64(3500))
+		ttl, err = client.TTL(ctx, prefix+"string")
+		require.NoError(t, err)
+		assert.Equal(t, int64(-1), ttl)
+
+		imported, err = keyspace.ImportWithOptions(
+			ctx,
+			client,
+			bytes.NewReader(buf.Bytes()),
+			keyspace.ImportOptions{Replace: true},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), imported)
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+)
+
+func (suite *GlideTestSuite) TestLatencyBudgetShedding() {
+	t := suite.T()
+	client, err := suite.client(suite.defaultClientConfig().WithLatencyBudgetShedding(0.5))
+	require.NoError(t, err)
+	clusterClient, err := suite.clusterClient(suite.defaultClusterClientConfig().WithLatencyBudgetShedding(0.5))
+	require.NoError(t, err)
+	key := uuid.NewString()
+
+	for _, get := range []func(ctx context.Context) error{
+		func(ctx context.Context) error { _, err := client.Get(ctx, key); return err },
+		func(ctx context.Context) error { _, err := clusterClient.Get(ctx, key); return err },
+	} {
+		ctx, cancel := glide.WithLatencyBudget(context.Background(), 5*time.Second)
+		assert.NoError(t, get(ctx))
+		cancel()
+
+		ctx, cancel = glide.WithLatencyBudget(context.Background(), 200*time.Millisecond)
+		time.Sleep(150 * time.Millisecond)
+		err := get(ctx)
+		var budgetErr *glide.BudgetExceededError
+		assert.True(t, errors.As(err, &budgetErr), "unexpected error: %v", err)
+		cancel()
+
+		// Without a budget, the request is sent
+		assert.NoError(t, get(context.Background()))
+	}
+}
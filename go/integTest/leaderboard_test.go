@@ -0,0 +1,112 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/leaderboard"
+)
+
+func (suite *GlideTestSuite) TestLeaderboard() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		scores := leaderboard.New(client, uuid.New().String())
+
+		added, err := scores.SetScores(ctx, map[string]float64{"a": 10, "b": 20, "c": 30, "d": 40, "e": 50})
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), added)
+		score, err := scores.AddScore(ctx, "a", 35)
+		require.NoError(t, err)
+		assert.Equal(t, float64(45), score)
+
+		top, err := scores.Top(ctx, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []leaderboard.Entry{
+			{Member: "e", Score: 50, Rank: 0},
+			{Member: "a", Score: 45, Rank: 1},
+			{Member: "d", Score: 40, Rank: 2},
+		}, top)
+
+		page, err := scores.Page(ctx, 3, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []leaderboard.Entry{
+			{Member: "c", Score: 30, Rank: 3},
+			{Member: "b", Score: 20, Rank: 4},
+		}, page)
+
+		rank, err := scores.Rank(ctx, "d")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), rank.Value())
+		rank, err = scores.Rank(ctx, "missing")
+		require.NoError(t, err)
+		assert.True(t, rank.IsNil())
+
+		around, err := scores.Around(ctx, "e", 1)
+		require.NoError(t, err)
+		assert.Equal(t, []leaderboard.Entry{
+			{Member: "e", Score: 50, Rank: 0},
+			{Member: "a", Score: 45, Rank: 1},
+		}, around)
+		around, err = scores.Around(ctx, "missing", 1)
+		require.NoError(t, err)
+		assert.Empty(t, around)
+
+		percentile, err := scores.Percentile(ctx, "d")
+		require.NoError(t, err)
+		assert.Equal(t, float64(50), percentile.Value())
+	})
+}
+
+func (suite *GlideTestSuite) TestLeaderboard_Ascending() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		times := leaderboard.New(client, uuid.New().String()).WithAscending()
+
+		_, err := times.SetScores(ctx, map[string]float64{"a": 12.5, "b": 9.8, "c": 11})
+		require.NoError(t, err)
+
+		top, err := times.Top(ctx, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []leaderboard.Entry{
+			{Member: "b", Score: 9.8, Rank: 0},
+			{Member: "c", Score: 11, Rank: 1},
+		}, top)
+
+		percentile, err := times.Percentile(ctx, "a")
+		require.NoError(t, err)
+		assert.Equal(t, float64(0), percentile.Value())
+
+		removed, err := times.Remove(ctx, "a", "missing")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), removed)
+		size, err := times.Size(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), size)
+	})
+}
+
+func (suite *GlideTestSuite) TestLeaderboard_Get() {
+	suite.SkipIfServerVersionLowerThan("7.2.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		scores := leaderboard.New(client, uuid.New().String())
+
+		_, err := scores.SetScores(ctx, map[string]float64{"a": 1, "b": 2})
+		require.NoError(t, err)
+		entry, found, err := scores.Get(ctx, "a")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, leaderboard.Entry{Member: "a", Score: 1, Rank: 1}, entry)
+		_, found, err = scores.Get(ctx, "missing")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func (suite *GlideTestSuite) TestMaxResponseSize() {
+	t := suite.T()
+	client, err := suite.client(suite.defaultClientConfig().WithMaxResponseSize(1024))
+	require.NoError(t, err)
+	clusterClient, err := suite.clusterClient(suite.defaultClusterClientConfig().WithMaxResponseSize(1024))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, commands := range []struct {
+		set func(key, value string) error
+		get func(key string) (models.Result[string], error)
+	}{
+		{
+			set: func(key, value string) error { _, err := client.Set(ctx, key, value); return err },
+			get: func(key string) (models.Result[string], error) { return client.Get(ctx, key) },
+		},
+		{
+			set: func(key, value string) error { _, err := clusterClient.Set(ctx, key, value); return err },
+			get: func(key string) (models.Result[string], error) { return clusterClient.Get(ctx, key) },
+		},
+	} {
+		smallKey, largeKey := uuid.NewString(), uuid.NewString()
+		require.NoError(t, commands.set(smallKey, "value"))
+		require.NoError(t, commands.set(largeKey, strings.Repeat("x", 64*1024)))
+
+		_, err := commands.get(largeKey)
+		var tooLargeErr *glide.ResponseTooLargeError
+		require.True(t, errors.As(err, &tooLargeErr), "unexpected error: %v", err)
+		assert.Equal(t, uint64(1024), tooLargeErr.Limit)
+
+		// The client recovers from the aborted response
+		assert.Eventually(t, func() bool {
+			value, err := commands.get(smallKey)
+			return err == nil && value.Value() == "value"
+		}, 5*time.Second, 100*time.Millisecond)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/debug"
+)
+
+func (suite *GlideTestSuite) TestMonitor() {
+	t := suite.T()
+	client := suite.defaultClient()
+	key := uuid.NewString()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	var seen []debug.MonitorLine
+	go func() {
+		done <- debug.Monitor(ctx, suite.defaultClientConfig(), func(line debug.MonitorLine) {
+			if slices.Contains(line.Args, key) {
+				seen = append(seen, line)
+				cancel()
+			}
+		}, debug.MonitorOptions{EnableDangerousCommands: true})
+	}()
+
+	// Keep sending the command until the monitoring connection reports it.
+	for ctx.Err() == nil {
+		_, err := client.Get(context.Background(), key)
+		require.NoError(t, err)
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.ErrorIs(t, <-done, context.Canceled)
+	require.NotEmpty(t, seen)
+	require.Len(t, seen[0].Args, 2)
+	assert.True(t, strings.EqualFold("GET", seen[0].Args[0]))
+	assert.Equal(t, key, seen[0].Args[1])
+	assert.WithinDuration(t, time.Now(), seen[0].Time, time.Minute)
+
+	err := debug.Monitor(context.Background(), suite.defaultClientConfig(), func(debug.MonitorLine) {}, debug.MonitorOptions{})
+	assert.Error(t, err)
+}
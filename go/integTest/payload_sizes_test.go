@@ -0,0 +1,40 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/monitoring"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+func (suite *GlideTestSuite) TestPayloadSizes() {
+	t := suite.T()
+	ctx := context.Background()
+	sizes := monitoring.NewPayloadSizes(nil)
+	client, err := suite.client(suite.defaultClientConfig().WithInterceptor(sizes.Interceptor()))
+	require.NoError(t, err)
+	defer client.Close()
+
+	key := "sizes:" + uuid.NewString()
+	value := strings.Repeat("x", 5000)
+	_, err = client.Set(ctx, key, value)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, key)
+	require.NoError(t, err)
+	_, err = client.HSet(ctx, key+":hash", map[string]string{"field": value})
+	require.NoError(t, err)
+	_, err = client.Exec(ctx, *pipeline.NewStandaloneBatch(false).HGetAll(key + ":hash"), true)
+	require.NoError(t, err)
+
+	snapshot := sizes.Snapshot()
+	assert.Equal(t, uint64(2), snapshot["string"].Requests.Count)
+	assert.Equal(t, int64(len(value)), snapshot["string"].Responses.Max)
+	assert.Equal(t, uint64(2), snapshot["hash"].Responses.Count)
+	assert.Equal(t, int64(len("field")+len(value)), snapshot["hash"].Responses.Max)
+}
@@ -0,0 +1,66 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func (suite *GlideTestSuite) TestPubSubBackpressure_DropOldest() {
+	t := suite.T()
+	ctx := context.Background()
+	channel := "pubsub_backpressure_" + t.Name()
+	receiver, err := suite.client(suite.defaultClientConfig().WithPubSubBackpressure(2, config.DropOldest))
+	require.NoError(t, err)
+	require.NoError(t, receiver.Subscribe(ctx, []string{channel}, 5000))
+	queue, err := receiver.GetQueue()
+	require.NoError(t, err)
+
+	publisher := suite.defaultClient()
+	for i := 1; i <= 5; i++ {
+		_, err := publisher.Publish(ctx, channel, strconv.Itoa(i))
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		return receiver.GetStatistics()["pubsub_dropped_messages_count"] == 3
+	}, 5*time.Second, 50*time.Millisecond)
+
+	var messages []string
+	for message := queue.Pop(); message != nil; message = queue.Pop() {
+		messages = append(messages, message.Message)
+	}
+	assert.Equal(t, []string{"4", "5"}, messages)
+}
+
+func (suite *GlideTestSuite) TestPubSubBackpressure_BlockPublisherEvents() {
+	t := suite.T()
+	ctx := context.Background()
+	channel := "pubsub_backpressure_" + t.Name()
+	receiver, err := suite.client(suite.defaultClientConfig().WithPubSubBackpressure(1, config.BlockPublisherEvents))
+	require.NoError(t, err)
+	require.NoError(t, receiver.Subscribe(ctx, []string{channel}, 5000))
+	queue, err := receiver.GetQueue()
+	require.NoError(t, err)
+
+	publisher := suite.defaultClient()
+	for i := 1; i <= 3; i++ {
+		_, err := publisher.Publish(ctx, channel, strconv.Itoa(i))
+		require.NoError(t, err)
+	}
+	// Every message is delivered, in order, as the queue is consumed
+	for i := 1; i <= 3; i++ {
+		select {
+		case message := <-queue.WaitForMessage():
+			assert.Equal(t, strconv.Itoa(i), message.Message)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "message not received", "message %d", i)
+		}
+	}
+	assert.Zero(t, receiver.GetStatistics()["pubsub_dropped_messages_count"])
+}
@@ -0,0 +1,58 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func (suite *GlideTestSuite) TestPubSubClient() {
+	t := suite.T()
+	ctx := context.Background()
+
+	standalone, err := glide.NewPubSubClient(suite.defaultClientConfig())
+	require.NoError(t, err)
+	defer standalone.Close()
+	cluster, err := glide.NewClusterPubSubClient(suite.defaultClusterClientConfig())
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	publishers := []interfaces.BaseClientCommands{suite.defaultClient(), suite.defaultClusterClient()}
+	for i, subscriber := range []*glide.PubSubClient{standalone, cluster} {
+		channel := uuid.NewString()
+		require.NoError(t, subscriber.Subscribe(ctx, []string{channel}, 5000))
+		state, err := subscriber.GetSubscriptions(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, state.ActualSubscriptions[models.Exact], channel)
+
+		queue, err := subscriber.GetQueue()
+		require.NoError(t, err)
+		switch publisher := publishers[i].(type) {
+		case *glide.Client:
+			_, err = publisher.Publish(ctx, channel, "message")
+		case *glide.ClusterClient:
+			_, err = publisher.Publish(ctx, channel, "message", false)
+		}
+		require.NoError(t, err)
+		select {
+		case message := <-queue.WaitForMessage():
+			assert.Equal(t, channel, message.Channel)
+			assert.Equal(t, "message", message.Message)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "the message was not received")
+		}
+
+		require.NoError(t, subscriber.Unsubscribe(ctx, nil, 5000))
+	}
+
+	// Sharded channels require a cluster
+	assert.Error(t, standalone.SSubscribeLazy(ctx, []string{"channel"}))
+}
@@ -0,0 +1,52 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/pubsubgroup"
+)
+
+func (suite *GlideTestSuite) TestPubSubGroup() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		channel := uuid.NewString()
+		billing := pubsubgroup.New(client, channel, "billing").WithClaimTimeout(100 * time.Millisecond)
+		shipping := pubsubgroup.New(client, channel, "shipping")
+		require.NoError(t, billing.Register(ctx))
+		require.NoError(t, shipping.Register(ctx))
+
+		first, err := billing.Publish(ctx, "order:1")
+		require.NoError(t, err)
+		second, err := billing.Publish(ctx, "order:2")
+		require.NoError(t, err)
+
+		// Every message is handed out to a single member of each group
+		messages, err := billing.Claim(ctx, "worker-1", 1)
+		require.NoError(t, err)
+		assert.Equal(t, []pubsubgroup.Message{{ID: first, Payload: "order:1"}}, messages)
+		messages, err = billing.Claim(ctx, "worker-2", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []pubsubgroup.Message{{ID: second, Payload: "order:2"}}, messages)
+		messages, err = shipping.Claim(ctx, "worker-1", 10)
+		require.NoError(t, err)
+		assert.Len(t, messages, 2)
+
+		acked, err := billing.Ack(ctx, second)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), acked)
+
+		// The message not acknowledged within the claim timeout is handed out again
+		time.Sleep(200 * time.Millisecond)
+		messages, err = billing.Claim(ctx, "worker-2", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []pubsubgroup.Message{{ID: first, Payload: "order:1"}}, messages)
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// unreachableAddress is a non-routable address, whose connections hang until they time out.
+var unreachableAddress = config.NodeAddress{Host: "10.255.255.1", Port: 6379}
+
+func (suite *GlideTestSuite) TestRaceDialing_Standalone() {
+	t := suite.T()
+	started := time.Now()
+	client, err := suite.client(suite.defaultClientConfig().WithAddress(&unreachableAddress).WithRaceDialing(true))
+	require.NoError(t, err)
+	// The connection timeout of the unreachable address is 10 seconds
+	assert.Less(t, time.Since(started), 5*time.Second)
+
+	_, err = client.Set(context.Background(), "race-dialing", "value")
+	assert.NoError(t, err)
+}
+
+func (suite *GlideTestSuite) TestRaceDialing_Cluster() {
+	t := suite.T()
+	started := time.Now()
+	client, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithAddress(&unreachableAddress).WithRaceDialing(true),
+	)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(started), 5*time.Second)
+
+	_, err = client.Set(context.Background(), "race-dialing", "value")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,62 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func (suite *GlideTestSuite) TestRandomKeySample() {
+	client := suite.defaultClient()
+	t := suite.T()
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		_, err := client.Set(ctx, uuid.NewString(), "value")
+		require.NoError(t, err)
+	}
+
+	keys, err := client.RandomKeySample(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, keys, 10)
+	assert.ElementsMatch(t, keys, distinct(keys))
+
+	keys, err = client.RandomKeySample(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func (suite *GlideTestSuite) TestClusterRandomKeySample() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	ctx := context.Background()
+	for i := 0; i < 50; i++ {
+		_, err := client.Set(ctx, uuid.NewString(), "value")
+		require.NoError(t, err)
+	}
+
+	for _, route := range []config.Route{nil, config.AllPrimaries, config.AllNodes, config.RandomRoute} {
+		keys, err := client.RandomKeySample(ctx, 10, options.RouteOption{Route: route})
+		require.NoError(t, err)
+		assert.NotEmpty(t, keys)
+		assert.LessOrEqual(t, len(keys), 10)
+		assert.ElementsMatch(t, keys, distinct(keys))
+	}
+}
+
+func distinct(values []string) []string {
+	seen := map[string]struct{}{}
+	result := []string{}
+	for _, value := range values {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			result = append(result, value)
+		}
+	}
+	return result
+}
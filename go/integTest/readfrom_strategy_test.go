@@ -9,11 +9,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
 )
 
 func (suite *GlideTestSuite) TestRoutingWithAzAffinityStrategyTo1Replica() {
@@ -295,3 +297,95 @@ func (suite *GlideTestSuite) TestAzAffinityReplicasAndPrimaryRoutesToPrimary() {
 
 	clientForTestingAz.Close()
 }
+
+func (suite *GlideTestSuite) TestCustomCommandRoutingToReplicas() {
+	t := suite.T()
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithReadFrom(config.PreferReplica))
+	require.NoError(t, err)
+	defer client.Close()
+	allNodesStats := options.ClusterInfoOptions{
+		InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Replication, constants.Commandstats}},
+		RouteOption: &options.RouteOption{Route: config.AllNodes},
+	}
+
+	suite.verifyOK(client.Set(context.Background(), "foo", "bar"))
+	_, err = client.ConfigResetStatWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+
+	// Classified as read-only with the command table
+	_, err = client.CustomCommand(context.Background(), []string{"GET", "foo"})
+	require.NoError(t, err)
+	// Hinted as read-only
+	_, err = client.CustomCommandWithOptions(
+		context.Background(),
+		[]string{"STRLEN", "foo"},
+		*options.NewCustomCommandOptions().WithReadOnlyHint(),
+	)
+	require.NoError(t, err)
+	// Not read-only
+	_, err = client.CustomCommand(context.Background(), []string{"APPEND", "foo", "baz"})
+	require.NoError(t, err)
+
+	info, err := client.InfoWithOptions(context.Background(), allNodesStats)
+	require.NoError(t, err)
+	var replicaReads, primaryReads, primaryWrites int64
+	for _, value := range info.MultiValue() {
+		reads := commandCalls(value, "get") + commandCalls(value, "strlen")
+		if strings.Contains(value, "role:slave") {
+			replicaReads += reads
+			assert.Zero(t, commandCalls(value, "append"))
+		} else {
+			primaryReads += reads
+			primaryWrites += commandCalls(value, "append")
+		}
+	}
+	assert.Equal(t, int64(2), replicaReads)
+	assert.Zero(t, primaryReads)
+	assert.Equal(t, int64(1), primaryWrites)
+}
+
+func (suite *GlideTestSuite) TestSortReadOnlyRoutingToReplicas() {
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	t := suite.T()
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithReadFrom(config.PreferReplica))
+	require.NoError(t, err)
+	defer client.Close()
+	allNodesStats := options.ClusterInfoOptions{
+		InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Replication, constants.Commandstats}},
+		RouteOption: &options.RouteOption{Route: config.AllNodes},
+	}
+
+	key := "{sort}" + uuid.NewString()
+	_, err = client.RPush(context.Background(), key, []string{"3", "1", "2"})
+	require.NoError(t, err)
+	_, err = client.ConfigResetStatWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+
+	_, err = client.SortReadOnly(context.Background(), key)
+	require.NoError(t, err)
+	// The read-only commands of non-atomic batches are routed to the replicas too
+	batch := pipeline.NewClusterBatch(false).
+		SortReadOnly(key).
+		SortReadOnlyWithOptions(key, *options.NewSortOptions().SetOrderBy(options.DESC))
+	_, err = client.Exec(context.Background(), *batch, true)
+	require.NoError(t, err)
+	// SORT may store its result, so it is sent to the primary
+	_, err = client.Sort(context.Background(), key)
+	require.NoError(t, err)
+
+	info, err := client.InfoWithOptions(context.Background(), allNodesStats)
+	require.NoError(t, err)
+	var replicaReads, primaryReads, primarySorts int64
+	for _, value := range info.MultiValue() {
+		if strings.Contains(value, "role:slave") {
+			replicaReads += commandCalls(value, "sort_ro")
+			assert.Zero(t, commandCalls(value, "sort"))
+		} else {
+			primaryReads += commandCalls(value, "sort_ro")
+			primarySorts += commandCalls(value, "sort")
+		}
+	}
+	assert.Equal(t, int64(3), replicaReads)
+	assert.Zero(t, primaryReads)
+	assert.Equal(t, int64(1), primarySorts)
+}
@@ -0,0 +1,65 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// recordingReplicaSelector selects the replicas in turn, and records the replicas of the completed reads.
+type recordingReplicaSelector struct {
+	config.ReplicaSelector
+	mu   sync.Mutex
+	done []config.Replica
+}
+
+func (s *recordingReplicaSelector) Done(replica config.Replica, latency time.Duration, err error) {
+	s.mu.Lock()
+	s.done = append(s.done, replica)
+	s.mu.Unlock()
+	s.ReplicaSelector.Done(replica, latency, err)
+}
+
+func (s *recordingReplicaSelector) reads() []config.Replica {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]config.Replica(nil), s.done...)
+}
+
+func (suite *GlideTestSuite) TestReplicaSelector() {
+	t := suite.T()
+	ctx := context.Background()
+	selector := &recordingReplicaSelector{ReplicaSelector: config.NewRoundRobinReplicaSelector()}
+	client, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithReadFrom(config.PreferReplica).WithReplicaSelector(selector),
+	)
+	require.NoError(t, err)
+	key := "{replica-selector}" + suite.T().Name()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	_, err = client.Wait(ctx, 1, 1000*time.Millisecond)
+	require.NoError(t, err)
+
+	// The replicas are listed in the background, and the reads are routed by ReadFrom meanwhile
+	require.Eventually(t, func() bool {
+		value, err := client.Get(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, "value", value.Value())
+		return len(selector.reads()) > 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// The commands sent without a key, or writes, are not routed by the selector
+	reads := len(selector.reads())
+	_, err = client.Ping(ctx)
+	assert.NoError(t, err)
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	assert.Len(t, selector.reads(), reads)
+	for _, replica := range selector.reads() {
+		assert.NotEmpty(t, replica.Address)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+type auditRecorder struct {
+	mu     sync.Mutex
+	audits []models.RequestAudit
+}
+
+func (recorder *auditRecorder) hook(ctx context.Context, audit models.RequestAudit) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.audits = append(recorder.audits, audit)
+}
+
+func (recorder *auditRecorder) last() models.RequestAudit {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	return recorder.audits[len(recorder.audits)-1]
+}
+
+func (suite *GlideTestSuite) TestAuditHook() {
+	recorder := &auditRecorder{}
+	client, err := suite.client(suite.defaultClientConfig().WithAuditHook(recorder.hook))
+	require.NoError(suite.T(), err)
+	ctx := glide.WithRequestMetadata(context.Background(), "trace_id", "4bf92f35")
+	ctx = glide.WithRequestMetadata(ctx, "tenant", "acme")
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	audit := recorder.last()
+	suite.Equal("SET", audit.Command)
+	suite.Equal(map[string]string{"trace_id": "4bf92f35", "tenant": "acme"}, audit.Metadata)
+	suite.Equal("/* tenant=acme trace_id=4bf92f35 */", audit.Annotation())
+	suite.Positive(audit.Duration)
+	suite.NoError(audit.Err)
+
+	_, err = client.CustomCommand(context.Background(), []string{"lpush", key, "value"})
+	suite.Error(err)
+	audit = recorder.last()
+	suite.Equal("LPUSH", audit.Command)
+	suite.Nil(audit.Metadata)
+	suite.Equal(err, audit.Err)
+
+	batch := pipeline.NewStandaloneBatch(false).Get(key)
+	_, err = client.Exec(ctx, *batch, true)
+	suite.NoError(err)
+	suite.Equal("Batch", recorder.last().Command)
+}
+
+func (suite *GlideTestSuite) TestAuditHookCluster() {
+	recorder := &auditRecorder{}
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithAuditHook(recorder.hook))
+	require.NoError(suite.T(), err)
+	ctx := glide.WithRequestMetadata(context.Background(), "tenant", "acme")
+
+	_, err = client.Get(ctx, uuid.NewString())
+	suite.NoError(err)
+	audit := recorder.last()
+	suite.Equal("GET", audit.Command)
+	suite.Equal("/* tenant=acme */", audit.Annotation())
+
+	_, err = client.CustomCommandWithRoute(ctx, []string{"ping"}, config.AllPrimaries)
+	suite.NoError(err)
+	suite.Equal("PING", recorder.last().Command)
+}
@@ -0,0 +1,64 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/scripts"
+)
+
+func (suite *GlideTestSuite) TestScriptsRegistry() {
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		key := uuid.New().String()
+		libraryName := "lib" + strings.ReplaceAll(uuid.New().String(), "-", "")
+		functionName := "func" + strings.ReplaceAll(uuid.New().String(), "-", "")
+		libraryCode := fmt.Sprintf(
+			"#!lua name=%s\nserver.register_function{function_name='%s', callback=function(keys, args) "+
+				"return server.call('GET', keys[1]) end, flags={'no-writes'}}",
+			libraryName,
+			functionName,
+		)
+
+		registry := scripts.New(client)
+		defer registry.Close()
+		require.NoError(t, registry.RegisterScript("set", "v1", "return server.call('SET', KEYS[1], ARGV[1])"))
+		require.NoError(t, registry.RegisterFunction("get", "v1", libraryCode, functionName, true))
+
+		result, err := registry.Invoke(ctx, "set", []string{key}, []string{"value"})
+		require.NoError(t, err)
+		assert.Equal(t, "OK", result)
+		result, err = registry.Invoke(ctx, "get", []string{key}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "value", result)
+
+		// The scripts and the libraries are loaded again once flushed
+		_, err = client.ScriptFlush(ctx)
+		require.NoError(t, err)
+		_, err = client.FunctionFlushSync(ctx)
+		require.NoError(t, err)
+		result, err = registry.Invoke(ctx, "set", []string{key}, []string{"other"})
+		require.NoError(t, err)
+		assert.Equal(t, "OK", result)
+		result, err = registry.Invoke(ctx, "get", []string{key}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "other", result)
+
+		// A new version replaces the script
+		require.NoError(t, registry.RegisterScript("set", "v2", "return server.call('SET', KEYS[1], ARGV[1] .. '2')"))
+		_, err = registry.Invoke(ctx, "set", []string{key}, []string{"value"})
+		require.NoError(t, err)
+		result, err = registry.Invoke(ctx, "get", []string{key}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "value2", result)
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/session"
+)
+
+func (suite *GlideTestSuite) TestSessionStore() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		store := session.New(client, "session:")
+		sessionID := uuid.New().String()
+		key := store.Key(sessionID)
+
+		require.NoError(t, store.Save(ctx, sessionID, map[string]string{"user": "alice"}, time.Minute))
+		require.NoError(t, store.Save(ctx, sessionID, map[string]string{"role": "admin"}, time.Minute))
+		data, found, err := store.Load(ctx, sessionID)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, map[string]string{"user": "alice", "role": "admin"}, data)
+
+		found, err = store.Touch(ctx, sessionID, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, found)
+		if suite.serverVersion >= "9.0.0" {
+			ttls, err := client.HTtl(ctx, key, []string{"user", "role"})
+			require.NoError(t, err)
+			for _, ttl := range ttls {
+				assert.Greater(t, ttl, int64(60))
+			}
+		} else {
+			ttl, err := client.TTL(ctx, key)
+			require.NoError(t, err)
+			assert.Greater(t, ttl, int64(60))
+		}
+
+		destroyed, err := store.Destroy(ctx, sessionID)
+		require.NoError(t, err)
+		assert.True(t, destroyed)
+		_, found, err = store.Load(ctx, sessionID)
+		require.NoError(t, err)
+		assert.False(t, found)
+		found, err = store.Touch(ctx, sessionID, time.Minute)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func (suite *GlideTestSuite) TestSessionStore_Expires() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		store := session.New(client, "session:")
+		sessionID := uuid.New().String()
+
+		require.NoError(t, store.Save(ctx, sessionID, map[string]string{"user": "alice"}, 200*time.Millisecond))
+		time.Sleep(400 * time.Millisecond)
+		_, found, err := store.Load(ctx, sessionID)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
@@ -4,12 +4,15 @@ package integTest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/config"
@@ -18,6 +21,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -62,6 +66,33 @@ func (suite *GlideTestSuite) TestSetWithOptions_ReturnOldValue() {
 	})
 }
 
+func (suite *GlideTestSuite) TestSetWithOptions_ExpiryJitter() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		opts := options.NewSetOptions().SetExpiry(options.NewExpiryIn(time.Hour).SetJitter(10))
+		for range 10 {
+			key := uuid.New().String()
+			result, err := client.SetWithOptions(context.Background(), key, initialValue, *opts)
+			suite.NoError(err)
+			assert.Equal(suite.T(), "OK", result.Value())
+
+			ttl, err := client.TTL(context.Background(), key)
+			suite.NoError(err)
+			assert.GreaterOrEqual(suite.T(), ttl, int64(3200))
+			assert.LessOrEqual(suite.T(), ttl, int64(3960))
+		}
+
+		key := uuid.New().String()
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
+		expired, err := client.Expire(context.Background(), key, options.WithExpiryJitter(time.Hour, 10))
+		suite.NoError(err)
+		assert.True(suite.T(), expired)
+		ttl, err := client.TTL(context.Background(), key)
+		suite.NoError(err)
+		assert.GreaterOrEqual(suite.T(), ttl, int64(3200))
+		assert.LessOrEqual(suite.T(), ttl, int64(3960))
+	})
+}
+
 func (suite *GlideTestSuite) TestSetWithOptions_OnlyIfExists_overwrite() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -247,6 +278,39 @@ func (suite *GlideTestSuite) TestGetExWithOptions_PersistKey() {
 	})
 }
 
+func (suite *GlideTestSuite) TestGetExWithOptions_ExpiryAt() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
+
+		opts := options.NewGetExOptions().SetExpiryAtUnixMillis(time.Now().Add(time.Minute))
+		result, err := client.GetExWithOptions(context.Background(), key, *opts)
+		suite.NoError(err)
+		assert.Equal(suite.T(), initialValue, result.Value())
+		ttl, err := client.PTTL(context.Background(), key)
+		suite.NoError(err)
+		assert.Greater(suite.T(), ttl, int64(50000))
+		assert.LessOrEqual(suite.T(), ttl, int64(60000))
+
+		// A timestamp in the past is rejected before the command is sent
+		opts = options.NewGetExOptions().SetExpiryAtUnixSeconds(time.Now().Add(-time.Minute))
+		_, err = client.GetExWithOptions(context.Background(), key, *opts)
+		var pastErr *options.PastExpiryError
+		suite.ErrorAs(err, &pastErr)
+		result, err = client.Get(context.Background(), key)
+		suite.NoError(err)
+		assert.Equal(suite.T(), initialValue, result.Value())
+
+		// Unless it is allowed, deleting the key
+		result, err = client.GetExWithOptions(context.Background(), key, *opts.SetAllowPast(true))
+		suite.NoError(err)
+		assert.Equal(suite.T(), initialValue, result.Value())
+		result, err = client.Get(context.Background(), key)
+		suite.NoError(err)
+		assert.True(suite.T(), result.IsNil())
+	})
+}
+
 func (suite *GlideTestSuite) TestGetExWithOptions_UpdateExpiry() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -306,6 +370,88 @@ func (suite *GlideTestSuite) TestMSetAndMGet_existingAndNonExistingKeys() {
 	})
 }
 
+func (suite *GlideTestSuite) TestBulkLoad() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		prefix := uuid.New().String()
+		pairs := map[string]string{}
+		keys := []string{}
+		for i := 0; i < 250; i++ {
+			key := fmt.Sprintf("%s:%d", prefix, i)
+			pairs[key] = strconv.Itoa(i)
+			keys = append(keys, key)
+		}
+		var progress []int64
+		opts := options.NewBulkLoadOptions().SetBatchSize(100).SetConcurrency(2).SetOnProgress(func(loaded, failed int64) {
+			progress = append(progress, loaded)
+		})
+
+		result, err := client.BulkLoad(context.Background(), models.NewMapIterator(pairs), *opts)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(250), result.Loaded)
+		assert.Empty(suite.T(), result.Failed)
+		assert.Len(suite.T(), progress, 3)
+		assert.Equal(suite.T(), int64(250), progress[len(progress)-1])
+
+		values, err := client.MGet(context.Background(), keys)
+		require.NoError(suite.T(), err)
+		for i, value := range values {
+			assert.Equal(suite.T(), strconv.Itoa(i), value.Value())
+		}
+	})
+}
+
+func (suite *GlideTestSuite) TestBulkLoad_WithExpiry() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		opts := options.NewBulkLoadOptions().SetExpiry(time.Minute)
+		result, err := client.BulkLoad(context.Background(), models.NewMapIterator(map[string]string{key: "value"}), *opts)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), result.Loaded)
+		ttl, err := client.TTL(context.Background(), key)
+		require.NoError(suite.T(), err)
+		assert.Greater(suite.T(), ttl, int64(0))
+	})
+}
+
+func (suite *GlideTestSuite) TestMGetWithOptions() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		prefix := uuid.New().String()
+		pairs := map[string]string{}
+		keys := []string{}
+		for i := 0; i < 50; i++ {
+			key := fmt.Sprintf("%s:%d", prefix, i)
+			keys = append(keys, key)
+			if i%2 == 0 {
+				pairs[key] = strconv.Itoa(i)
+			}
+		}
+		_, err := client.MSet(ctx, pairs)
+		require.NoError(suite.T(), err)
+		// The same key can be got several times
+		keys = append(keys, keys[0])
+
+		for _, failureMode := range []options.MGetFailureMode{options.MGetFailFast, options.MGetBestEffort} {
+			opts := options.NewMGetOptions().SetFailureMode(failureMode)
+			values, err := client.MGetWithOptions(ctx, keys, *opts)
+			require.NoError(suite.T(), err)
+			require.Len(suite.T(), values, len(keys))
+			for i, value := range values[:50] {
+				if i%2 == 0 {
+					assert.Equal(suite.T(), strconv.Itoa(i), value.Value())
+				} else {
+					assert.True(suite.T(), value.IsNil())
+				}
+			}
+			assert.Equal(suite.T(), "0", values[50].Value())
+		}
+
+		values, err := client.MGetWithOptions(ctx, []string{}, *options.NewMGetOptions())
+		require.NoError(suite.T(), err)
+		assert.Empty(suite.T(), values)
+	})
+}
+
 func (suite *GlideTestSuite) TestMSetNXAndMGet_nonExistingKey_valuesSet() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key1 := "{key}" + uuid.New().String()
@@ -855,6 +1001,55 @@ func (suite *GlideTestSuite) TestHGet_WithNotExistingField() {
 	})
 }
 
+func (suite *GlideTestSuite) TestHGetInto() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		_, err := client.HSet(context.Background(), key, map[string]string{"field1": "value1", "empty": ""})
+		suite.NoError(err)
+
+		buf := make([]byte, 16)
+		n, err := client.HGetInto(context.Background(), key, "field1", buf)
+		suite.NoError(err)
+		assert.Equal(suite.T(), "value1", string(buf[:n]))
+
+		n, err = client.HGetInto(context.Background(), key, "empty", buf)
+		suite.NoError(err)
+		assert.Equal(suite.T(), 0, n)
+
+		n, err = client.HGetInto(context.Background(), key, "foo", buf)
+		suite.NoError(err)
+		assert.Equal(suite.T(), -1, n)
+
+		n, err = client.HGetInto(context.Background(), uuid.NewString(), "field1", buf)
+		suite.NoError(err)
+		assert.Equal(suite.T(), -1, n)
+	})
+}
+
+func (suite *GlideTestSuite) TestHGetHedged() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		_, err := client.HSet(context.Background(), key, map[string]string{"field1": "value1"})
+		suite.NoError(err)
+
+		result, err := client.HGetHedged(context.Background(), key, "field1", *options.NewHedgeOptions())
+		suite.NoError(err)
+		assert.Equal(suite.T(), "value1", result.Value())
+
+		// A tiny delay hedges every read
+		opts := options.NewHedgeOptions().SetDelay(time.Nanosecond)
+		for i := 0; i < 20; i++ {
+			result, err = client.HGetHedged(context.Background(), key, "field1", *opts)
+			suite.NoError(err)
+			assert.Equal(suite.T(), "value1", result.Value())
+		}
+
+		result, err = client.HGetHedged(context.Background(), key, "foo", *opts)
+		suite.NoError(err)
+		assert.True(suite.T(), result.IsNil())
+	})
+}
+
 func (suite *GlideTestSuite) TestHGetAll_WithExistingKey() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
@@ -880,6 +1075,58 @@ func (suite *GlideTestSuite) TestHGetAll_WithNotExistingKey() {
 	})
 }
 
+func (suite *GlideTestSuite) TestHGetAllOrdered() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		fields := []models.FieldValue{
+			{Field: "field3", Value: "value3"},
+			{Field: "field1", Value: "value1"},
+			{Field: "field2", Value: ""},
+		}
+
+		// The fields of a small hash are returned in insertion order
+		for _, field := range fields {
+			res, err := client.HSet(context.Background(), key, map[string]string{field.Field: field.Value})
+			suite.NoError(err)
+			assert.Equal(suite.T(), int64(1), res)
+		}
+
+		res, err := client.HGetAllOrdered(context.Background(), key)
+		suite.NoError(err)
+		assert.Equal(suite.T(), fields, res)
+
+		res, err = client.HGetAllOrdered(context.Background(), uuid.NewString())
+		suite.NoError(err)
+		assert.Empty(suite.T(), res)
+
+		stringKey := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), stringKey, "value"))
+		_, err = client.HGetAllOrdered(context.Background(), stringKey)
+		suite.Error(err)
+	})
+}
+
+func (suite *GlideTestSuite) TestHGetAllSorted() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		res, err := client.HSet(context.Background(), key, map[string]string{"b": "2", "c": "3", "a": "1"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), int64(3), res)
+
+		sorted, err := client.HGetAllSorted(context.Background(), key)
+		suite.NoError(err)
+		expected := models.OrderedMap{{Field: "a", Value: "1"}, {Field: "b", Value: "2"}, {Field: "c", Value: "3"}}
+		assert.Equal(suite.T(), expected, sorted)
+		data, err := json.Marshal(sorted)
+		suite.NoError(err)
+		assert.Equal(suite.T(), `{"a":"1","b":"2","c":"3"}`, string(data))
+
+		sorted, err = client.HGetAllSorted(context.Background(), uuid.NewString())
+		suite.NoError(err)
+		assert.Empty(suite.T(), sorted)
+	})
+}
+
 func (suite *GlideTestSuite) TestHMGet() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
@@ -2142,6 +2389,38 @@ func (suite *GlideTestSuite) TestLPosCount_withOptions() {
 	})
 }
 
+func (suite *GlideTestSuite) TestLPosMany() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		_, err := client.RPush(context.Background(), key, []string{"a", "a", "b", "c", "a", "b"})
+		suite.NoError(err)
+
+		positions, err := client.LPosMany(context.Background(), key, []string{"a", "b", "d", "a"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), map[string][]int64{"a": {0, 1, 4}, "b": {2, 5}, "d": {}}, positions)
+
+		// reverse traversal
+		positions, err = client.LPosManyWithOptions(
+			context.Background(),
+			key,
+			[]string{"a", "c"},
+			*options.NewLPosOptions().SetRank(-1),
+		)
+		suite.NoError(err)
+		assert.Equal(suite.T(), map[string][]int64{"a": {4, 1, 0}, "c": {3}}, positions)
+
+		positions, err = client.LPosMany(context.Background(), uuid.NewString(), []string{"a"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), map[string][]int64{"a": {}}, positions)
+
+		// The errors of the commands are returned
+		stringKey := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), stringKey, "value"))
+		_, err = client.LPosMany(context.Background(), stringKey, []string{"a"})
+		suite.Error(err)
+	})
+}
+
 func (suite *GlideTestSuite) TestRPush() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		list := []string{"value1", "value2", "value3", "value4"}
@@ -2685,6 +2964,34 @@ func (suite *GlideTestSuite) TestSInterCardLimit() {
 	})
 }
 
+func (suite *GlideTestSuite) TestSInterCard_CompatibilityFallbacks() {
+	// SINTERCARD is emulated on the servers older than 7.0, and sent as is on the others
+	t := suite.T()
+	client, err := suite.client(suite.defaultClientConfig().WithCompatibilityFallbacks(true))
+	require.NoError(t, err)
+	clusterClient, err := suite.clusterClient(suite.defaultClusterClientConfig().WithCompatibilityFallbacks(true))
+	require.NoError(t, err)
+
+	for _, client := range []interfaces.BaseClientCommands{client, clusterClient} {
+		key1 := "{key}-1-" + uuid.NewString()
+		key2 := "{key}-2-" + uuid.NewString()
+		_, err := client.SAdd(context.Background(), key1, []string{"one", "two", "three", "four"})
+		require.NoError(t, err)
+		_, err = client.SAdd(context.Background(), key2, []string{"two", "three", "four", "five"})
+		require.NoError(t, err)
+
+		result, err := client.SInterCard(context.Background(), []string{key1, key2})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), result)
+		result, err = client.SInterCardLimit(context.Background(), []string{key1, key2}, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result)
+		result, err = client.SInterCard(context.Background(), []string{key1, "{key}-missing"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), result)
+	}
+}
+
 func (suite *GlideTestSuite) TestSRandMember() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.NewString()
@@ -4382,6 +4689,63 @@ func (suite *GlideTestSuite) TestPExpireAtWithOptions_NewExpiryLessThanCurrent()
 	})
 }
 
+func (suite *GlideTestSuite) TestExpireWithStatus() {
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.NewString()
+
+		status, err := client.ExpireWithStatus(context.Background(), key, 100*time.Second, constants.HasExistingExpiry)
+		require.NoError(t, err)
+		assert.Equal(t, models.ExpireKeyMissing, status)
+
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+		// The key has no expiry yet
+		status, err = client.ExpireWithStatus(context.Background(), key, 100*time.Second, constants.HasExistingExpiry)
+		require.NoError(t, err)
+		assert.Equal(t, models.ExpireConditionNotMet, status)
+
+		status, err = client.ExpireWithStatus(context.Background(), key, 100*time.Second, constants.HasNoExpiry)
+		require.NoError(t, err)
+		assert.Equal(t, models.ExpireSet, status)
+
+		status, err = client.PExpireWithStatus(
+			context.Background(), key, 200*time.Second, constants.NewExpiryLessThanCurrent,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, models.ExpireConditionNotMet, status)
+
+		status, err = client.ExpireAtWithStatus(
+			context.Background(), key, time.Now().Add(200*time.Second), constants.NewExpiryGreaterThanCurrent,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, models.ExpireSet, status)
+
+		status, err = client.PExpireAtWithStatus(
+			context.Background(), key, time.Now().Add(50*time.Second), constants.NewExpiryLessThanCurrent,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, models.ExpireSet, status)
+		ttl, err := client.TTL(context.Background(), key)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, ttl, int64(50))
+
+		// A timestamp in the past deletes the key
+		status, err = client.PExpireAtWithStatus(
+			context.Background(), key, time.Now().Add(-time.Second), constants.NewExpiryLessThanCurrent,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, models.ExpireSet, status)
+		exists, err := client.Exists(context.Background(), []string{key})
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+
+		_, err = client.ExpireWithStatus(context.Background(), key, time.Second, "invalid")
+		assert.Error(t, err)
+	})
+}
+
 func (suite *GlideTestSuite) TestExpireTime() {
 	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
@@ -4524,6 +4888,34 @@ func (suite *GlideTestSuite) TestTTL_WithExpiredKey() {
 	})
 }
 
+func (suite *GlideTestSuite) TestTTLs() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key1 := uuid.New().String()
+		key2 := uuid.New().String()
+		missing := uuid.New().String()
+		suite.verifyOK(client.Set(context.Background(), key1, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key2, initialValue))
+		_, err := client.Expire(context.Background(), key1, 100*time.Second)
+		require.NoError(suite.T(), err)
+
+		ttls, err := client.TTLs(context.Background(), []string{key1, key2, missing, key1})
+		require.NoError(suite.T(), err)
+		assert.Len(suite.T(), ttls, 3)
+		assert.Greater(suite.T(), ttls[key1], int64(90))
+		assert.Equal(suite.T(), int64(-1), ttls[key2])
+		assert.Equal(suite.T(), int64(-2), ttls[missing])
+
+		pttls, err := client.PTTLs(context.Background(), []string{key1, key2})
+		require.NoError(suite.T(), err)
+		assert.Greater(suite.T(), pttls[key1], int64(90000))
+		assert.Equal(suite.T(), int64(-1), pttls[key2])
+
+		ttls, err = client.TTLs(context.Background(), nil)
+		require.NoError(suite.T(), err)
+		assert.Empty(suite.T(), ttls)
+	})
+}
+
 func (suite *GlideTestSuite) TestPTTL_WithValidKey() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -5737,6 +6129,44 @@ func (suite *GlideTestSuite) TestXGroupSetId() {
 	})
 }
 
+func (suite *GlideTestSuite) TestZAddBulk() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		members := make([]models.MemberAndScore, 0, 2500)
+		for i := 0; i < 2500; i++ {
+			members = append(members, models.MemberAndScore{Member: strconv.Itoa(i), Score: float64(i)})
+		}
+
+		result, err := client.ZAddBulk(context.Background(), key, models.NewMemberAndScoreIterator(members), 1500)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), models.ZAddBulkResult{Written: 2500, Added: 2500}, result)
+		card, err := client.ZCard(context.Background(), key)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(2500), card)
+		score, err := client.ZScore(context.Background(), key, "1234")
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), float64(1234), score.Value())
+
+		// The scores of the existing members are updated
+		updates := []models.MemberAndScore{{Member: "0", Score: 10}, {Member: "new", Score: 1}}
+		result, err = client.ZAddBulk(context.Background(), key, models.NewMemberAndScoreIterator(updates), 0)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), models.ZAddBulkResult{Written: 2, Added: 1}, result)
+		score, err = client.ZScore(context.Background(), key, "0")
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), float64(10), score.Value())
+
+		// The commands on a key of another type fail without stopping the load
+		stringKey := uuid.New().String()
+		suite.verifyOK(client.Set(context.Background(), stringKey, "value"))
+		result, err = client.ZAddBulk(context.Background(), stringKey, models.NewMemberAndScoreIterator(members), 1000)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(0), result.Written)
+		assert.Equal(suite.T(), int64(2500), result.Failed)
+		assert.Len(suite.T(), result.Errors, 3)
+	})
+}
+
 func (suite *GlideTestSuite) TestZAddAndZAddIncr() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -6725,6 +7155,174 @@ func (suite *GlideTestSuite) Test_XDel() {
 	})
 }
 
+func (suite *GlideTestSuite) Test_XDelEx() {
+	suite.SkipIfServerVersionLowerThan("8.2.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key1 := uuid.NewString()
+		key2 := uuid.NewString()
+		group := uuid.NewString()
+		consumer := uuid.NewString()
+		t := suite.T()
+
+		for _, id := range []string{"0-1", "0-2", "0-3"} {
+			_, err := client.XAddWithOptions(context.Background(),
+				key1,
+				[]models.FieldValue{{Field: "f1", Value: "foo1"}},
+				*options.NewXAddOptions().SetId(id),
+			)
+			assert.NoError(t, err)
+		}
+		_, err := client.XGroupCreate(context.Background(), key1, group, "0")
+		assert.NoError(t, err)
+		_, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key1: ">"})
+		assert.NoError(t, err)
+
+		// The pending entries are only deleted once acknowledged with the ACKED policy
+		xDelExResult, err := client.XDelExWithPolicy(context.Background(), key1, []string{"0-1", "0-4"}, constants.AckedPolicy)
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			[]models.StreamEntryDeletionStatus{models.StreamEntryReferenced, models.StreamEntryNotFound},
+			xDelExResult,
+		)
+
+		xDelExResult, err = client.XDelEx(context.Background(), key1, []string{"0-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []models.StreamEntryDeletionStatus{models.StreamEntryDeleted}, xDelExResult)
+
+		// The reference to the deleted entry is kept with the default policy, and removed with the DELREF policy
+		pending, err := client.XPending(context.Background(), key1, group)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), pending.NumOfMessages)
+
+		xDelExResult, err = client.XDelExWithPolicy(context.Background(), key1, []string{"0-2"}, constants.DelRefPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, []models.StreamEntryDeletionStatus{models.StreamEntryDeleted}, xDelExResult)
+		pending, err = client.XPending(context.Background(), key1, group)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), pending.NumOfMessages)
+
+		xDelExResult, err = client.XDelEx(context.Background(), key2, []string{"0-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []models.StreamEntryDeletionStatus{models.StreamEntryNotFound}, xDelExResult)
+
+		_, err = client.XDelEx(context.Background(), key1, []string{})
+		suite.Error(err)
+
+		// Throws error: Key exists - but it is not a stream
+		_, err = client.Set(context.Background(), key2, "xdelextest")
+		assert.NoError(t, err)
+		_, err = client.XDelEx(context.Background(), key2, []string{"0-1"})
+		suite.Error(err)
+	})
+}
+
+func (suite *GlideTestSuite) Test_XAckDel() {
+	suite.SkipIfServerVersionLowerThan("8.2.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		group1 := uuid.NewString()
+		group2 := uuid.NewString()
+		consumer := uuid.NewString()
+		t := suite.T()
+
+		for _, id := range []string{"0-1", "0-2"} {
+			_, err := client.XAddWithOptions(context.Background(),
+				key,
+				[]models.FieldValue{{Field: "f1", Value: "foo1"}},
+				*options.NewXAddOptions().SetId(id),
+			)
+			assert.NoError(t, err)
+		}
+		for _, group := range []string{group1, group2} {
+			_, err := client.XGroupCreate(context.Background(), key, group, "0")
+			assert.NoError(t, err)
+			_, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
+			assert.NoError(t, err)
+		}
+
+		// The entry is acknowledged, but kept while pending in the other group with the ACKED policy
+		xAckDelResult, err := client.XAckDelWithPolicy(
+			context.Background(), key, group1, []string{"0-1", "0-3"}, constants.AckedPolicy,
+		)
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			[]models.StreamEntryDeletionStatus{models.StreamEntryReferenced, models.StreamEntryNotFound},
+			xAckDelResult,
+		)
+
+		xAckDelResult, err = client.XAckDel(context.Background(), key, group2, []string{"0-1", "0-2"})
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			[]models.StreamEntryDeletionStatus{models.StreamEntryDeleted, models.StreamEntryDeleted},
+			xAckDelResult,
+		)
+
+		xLenResult, err := client.XLen(context.Background(), key)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), xLenResult)
+
+		_, err = client.XAckDel(context.Background(), key, group1, []string{})
+		suite.Error(err)
+	})
+}
+
+func (suite *GlideTestSuite) TestXSetId() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		t := suite.T()
+
+		_, err := client.XAddWithOptions(context.Background(),
+			key,
+			[]models.FieldValue{{Field: "f1", Value: "v1"}},
+			*options.NewXAddOptions().SetId("1-1"),
+		)
+		require.NoError(t, err)
+
+		suite.verifyOK(client.XSetId(context.Background(), key, "5-0"))
+		info, err := client.XInfoStream(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, "5-0", info.LastGeneratedID)
+
+		// New entries must have greater IDs than the last generated ID
+		_, err = client.XAddWithOptions(context.Background(),
+			key,
+			[]models.FieldValue{{Field: "f2", Value: "v2"}},
+			*options.NewXAddOptions().SetId("4-0"),
+		)
+		assert.Error(t, err)
+
+		// The last generated ID can't be lower than the ID of the last entry
+		_, err = client.XSetId(context.Background(), key, "0-1")
+		assert.Error(t, err)
+
+		// XSETID on a missing key is an error
+		_, err = client.XSetId(context.Background(), uuid.NewString(), "1-0")
+		assert.Error(t, err)
+
+		if suite.serverVersion >= "7.0.0" {
+			opts := options.NewXSetIdOptions().SetEntriesAdded(10).SetMaxDeletedId("3-0")
+			suite.verifyOK(client.XSetIdWithOptions(context.Background(), key, "6-0", *opts))
+			info, err = client.XInfoStream(context.Background(), key)
+			require.NoError(t, err)
+			assert.Equal(t, "6-0", info.LastGeneratedID)
+			assert.Equal(t, int64(10), info.EntriesAdded.Value())
+			assert.Equal(t, "3-0", info.MaxDeletedEntryID.Value())
+
+			// ENTRIESADDED can't be lower than the length of the stream
+			_, err = client.XSetIdWithOptions(
+				context.Background(),
+				key,
+				"7-0",
+				*options.NewXSetIdOptions().SetEntriesAdded(0),
+			)
+			assert.Error(t, err)
+		}
+	})
+}
+
 func (suite *GlideTestSuite) TestZScan() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key1 := uuid.New().String()
@@ -8684,6 +9282,45 @@ func (suite *GlideTestSuite) TestSetBit_SetAndCheckPreviousBit() {
 	})
 }
 
+func (suite *GlideTestSuite) TestBitSetManyAndBitGetMany() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+
+		previous, err := client.BitSetMany(context.Background(), key, []int64{0, 9, 100}, true)
+		suite.NoError(err)
+		assert.Equal(suite.T(), []bool{false, false, false}, previous)
+
+		bit, err := client.GetBit(context.Background(), key, 9)
+		suite.NoError(err)
+		assert.Equal(suite.T(), int64(1), bit)
+
+		previous, err = client.BitSetMany(context.Background(), key, []int64{9, 10}, false)
+		suite.NoError(err)
+		assert.Equal(suite.T(), []bool{true, false}, previous)
+
+		bits, err := client.BitGetMany(context.Background(), key, []int64{0, 9, 10, 100, 1 << 20})
+		suite.NoError(err)
+		assert.Equal(suite.T(), []bool{true, false, false, true, false}, bits)
+
+		bits, err = client.BitGetMany(context.Background(), uuid.New().String(), []int64{0, 1})
+		suite.NoError(err)
+		assert.Equal(suite.T(), []bool{false, false}, bits)
+
+		bits, err = client.BitGetMany(context.Background(), key, nil)
+		suite.NoError(err)
+		assert.Empty(suite.T(), bits)
+
+		_, err = client.BitSetMany(context.Background(), key, []int64{-1}, true)
+		suite.Error(err)
+
+		listKey := uuid.New().String()
+		_, err = client.LPush(context.Background(), listKey, []string{"value"})
+		suite.NoError(err)
+		_, err = client.BitGetMany(context.Background(), listKey, []int64{0})
+		suite.Error(err)
+	})
+}
+
 func (suite *GlideTestSuite) TestSetBit_SetMultipleBits() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -8724,6 +9361,47 @@ func (suite *GlideTestSuite) TestWait() {
 	})
 }
 
+func (suite *GlideTestSuite) TestSetAndWait() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		acknowledged, err := client.SetAndWait(context.Background(), key, "test", 1, 2000*time.Millisecond)
+		require.NoError(suite.T(), err)
+		assert.GreaterOrEqual(suite.T(), acknowledged, int64(1))
+		value, err := client.Get(context.Background(), key)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "test", value.Value())
+	})
+}
+
+func (suite *GlideTestSuite) TestWithDurability() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		acknowledged, err := client.WithDurability(
+			context.Background(),
+			key,
+			*options.NewDurabilityOptions(1, 2000*time.Millisecond),
+			func(ctx context.Context) error {
+				_, err := client.LPush(ctx, key, []string{"a", "b"})
+				return err
+			},
+		)
+		require.NoError(suite.T(), err)
+		assert.GreaterOrEqual(suite.T(), acknowledged, int64(1))
+
+		// The error of the write is returned without waiting
+		_, err = client.WithDurability(
+			context.Background(),
+			key,
+			*options.NewDurabilityOptions(1, 2000*time.Millisecond),
+			func(ctx context.Context) error {
+				_, err := client.Incr(ctx, key)
+				return err
+			},
+		)
+		assert.Error(suite.T(), err)
+	})
+}
+
 func (suite *GlideTestSuite) TestGetBit_ExistingKey_ValidOffset() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -8837,6 +9515,28 @@ func (suite *GlideTestSuite) TestBitCountWithOptions_StartEndBit() {
 	})
 }
 
+func (suite *GlideTestSuite) TestBitCountWithOptions_InvalidBitmapIndexType() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+
+		opts := options.NewBitCountOptions().
+			SetStart(1).
+			SetEnd(5).
+			SetBitmapIndexType(options.BitmapIndexType("WORD"))
+
+		_, err := client.BitCountWithOptions(context.Background(), key, *opts)
+		assert.ErrorContains(suite.T(), err, "invalid bitmap index type")
+
+		posOpts := options.NewBitPosOptions().
+			SetStart(1).
+			SetEnd(5).
+			SetBitmapIndexType(options.BitmapIndexType("WORD"))
+
+		_, err = client.BitPosWithOptions(context.Background(), key, 1, *posOpts)
+		assert.ErrorContains(suite.T(), err, "invalid bitmap index type")
+	})
+}
+
 func (suite *GlideTestSuite) TestBitOp_AND() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		bitopkey1 := "{bitop_test}" + uuid.New().String()
@@ -8944,6 +9644,15 @@ func (suite *GlideTestSuite) TestBitOp_InvalidArguments() {
 
 		_, err = client.BitOp(context.Background(), options.NOT, destKey, []string{key1, key2})
 		assert.NotNil(suite.T(), err)
+
+		var argErr *options.BitOpArgumentError
+		assert.ErrorAs(suite.T(), err, &argErr)
+		assert.Equal(suite.T(), options.NOT, argErr.Operation)
+		assert.Equal(suite.T(), 2, argErr.KeyCount)
+
+		_, err = client.BitOp(context.Background(), options.NOT, destKey, []string{})
+		assert.ErrorAs(suite.T(), err, &argErr)
+		assert.Equal(suite.T(), 0, argErr.KeyCount)
 	})
 }
 
@@ -9632,6 +10341,61 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 	})
 }
 
+func (suite *GlideTestSuite) TestXRangeInto() {
+	type order struct {
+		ID       string `glide:",id"`
+		Item     string `glide:"item"`
+		Quantity int    `glide:"quantity,default=1"`
+		Price    *float64
+	}
+
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		stringKey := uuid.New().String()
+		positiveInfinity := options.NewInfiniteStreamBoundary(constants.PositiveInfinity)
+		negativeInfinity := options.NewInfiniteStreamBoundary(constants.NegativeInfinity)
+
+		streamId1, err := client.XAdd(context.Background(), key, []models.FieldValue{
+			{Field: "item", Value: "apple"}, {Field: "quantity", Value: "3"}, {Field: "Price", Value: "0.5"},
+		})
+		require.NoError(suite.T(), err)
+		streamId2, err := client.XAdd(context.Background(), key, []models.FieldValue{{Field: "item", Value: "pear"}})
+		require.NoError(suite.T(), err)
+
+		var orders []order
+		err = client.XRangeInto(context.Background(), key, negativeInfinity, positiveInfinity, &orders)
+		require.NoError(suite.T(), err)
+		require.Len(suite.T(), orders, 2)
+		price := 0.5
+		assert.Equal(suite.T(), order{ID: streamId1, Item: "apple", Quantity: 3, Price: &price}, orders[0])
+		assert.Equal(suite.T(), order{ID: streamId2, Item: "pear", Quantity: 1}, orders[1])
+
+		var reversed []*order
+		err = client.XRevRangeWithOptionsInto(
+			context.Background(),
+			key,
+			positiveInfinity,
+			negativeInfinity,
+			*options.NewXRangeOptions().SetCount(1),
+			&reversed,
+		)
+		require.NoError(suite.T(), err)
+		require.Len(suite.T(), reversed, 1)
+		assert.Equal(suite.T(), "pear", reversed[0].Item)
+
+		// an entry that does not fit the struct fails to decode
+		_, err = client.XAdd(context.Background(), key, []models.FieldValue{{Field: "quantity", Value: "many"}})
+		require.NoError(suite.T(), err)
+		err = client.XRangeInto(context.Background(), key, negativeInfinity, positiveInfinity, &orders)
+		assert.ErrorContains(suite.T(), err, `field "quantity"`)
+
+		// the errors of the command are returned as is
+		suite.verifyOK(client.Set(context.Background(), stringKey, "value"))
+		err = client.XRangeInto(context.Background(), stringKey, negativeInfinity, positiveInfinity, &orders)
+		suite.ErrorContains(err, "WRONGTYPE")
+	})
+}
+
 func (suite *GlideTestSuite) TestBitField_GetAndIncrBy() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -11275,6 +12039,131 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 	})
 }
 
+func (suite *GlideTestSuite) TestGeoSearchIterator() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		origin := &options.GeoCoordOrigin{GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37}}
+		shape := options.NewCircleSearchShape(200, constants.GeoUnitKilometers)
+
+		// A symmetric grid around the origin, so that many members share the same distance.
+		membersToCoordinates := map[string]options.GeospatialData{}
+		for x := -5; x <= 5; x++ {
+			for y := -5; y <= 5; y++ {
+				membersToCoordinates[fmt.Sprintf("member_%d_%d", x, y)] = options.GeospatialData{
+					Longitude: 15 + float64(x)*0.2,
+					Latitude:  37 + float64(y)*0.2,
+				}
+			}
+		}
+		_, err := client.GeoAdd(context.Background(), key, membersToCoordinates)
+		suite.NoError(err)
+
+		expected, err := client.GeoSearch(context.Background(), key, origin, *shape)
+		suite.NoError(err)
+
+		iter, err := glide.NewGeoSearchIterator(client, key, origin, *shape, 7)
+		suite.NoError(err)
+
+		seen := map[string]bool{}
+		lastDist := math.MaxFloat64
+		for iter.HasNext() {
+			page, err := iter.Next(context.Background())
+			suite.NoError(err)
+			assert.LessOrEqual(suite.T(), len(page), 7)
+			for _, location := range page {
+				assert.False(suite.T(), seen[location.Name], "member %s returned twice", location.Name)
+				seen[location.Name] = true
+				assert.LessOrEqual(suite.T(), location.Dist, lastDist)
+				lastDist = location.Dist
+			}
+		}
+		assert.Len(suite.T(), seen, len(expected))
+		for _, member := range expected {
+			assert.True(suite.T(), seen[member])
+		}
+
+		// non-existing key
+		iter, err = glide.NewGeoSearchIterator(client, uuid.New().String(), origin, *shape, 7)
+		suite.NoError(err)
+		page, err := iter.Next(context.Background())
+		suite.NoError(err)
+		assert.Empty(suite.T(), page)
+		assert.False(suite.T(), iter.HasNext())
+
+		// invalid arguments
+		_, err = glide.NewGeoSearchIterator(
+			client, key, origin, *options.NewBoxSearchShape(10, 10, constants.GeoUnitKilometers), 7,
+		)
+		suite.Error(err)
+		_, err = glide.NewGeoSearchIterator(client, key, origin, *shape, 0)
+		suite.Error(err)
+	})
+}
+
+func (suite *GlideTestSuite) TestZRangeWithScoresIterator() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		// Many members share the same score, so that the pages by score split groups of ties.
+		membersScores := map[string]float64{}
+		for i := 0; i < 50; i++ {
+			membersScores[fmt.Sprintf("member_%02d", i)] = float64(i / 7)
+		}
+		_, err := client.ZAdd(context.Background(), key, membersScores)
+		suite.NoError(err)
+
+		collect := func(query options.ZRangeQueryWithScores, pageSize int64) []models.MemberAndScore {
+			iter, err := glide.NewZRangeWithScoresIterator(client, key, query, pageSize)
+			suite.NoError(err)
+			all := []models.MemberAndScore{}
+			for iter.HasNext() {
+				page, err := iter.Next(context.Background())
+				suite.NoError(err)
+				assert.LessOrEqual(suite.T(), int64(len(page)), pageSize)
+				all = append(all, page...)
+			}
+			return all
+		}
+
+		queries := []options.ZRangeQueryWithScores{
+			options.NewRangeByIndexQuery(0, -1),
+			options.NewRangeByIndexQuery(-20, 40).SetReverse(),
+			options.NewRangeByScoreQuery(
+				options.NewInfiniteScoreBoundary(constants.NegativeInfinity),
+				options.NewInfiniteScoreBoundary(constants.PositiveInfinity),
+			),
+			options.NewRangeByScoreQuery(
+				options.NewInclusiveScoreBoundary(5),
+				options.NewScoreBoundary(1, false),
+			).SetReverse(),
+			options.NewRangeByScoreQuery(
+				options.NewInclusiveScoreBoundary(1),
+				options.NewInfiniteScoreBoundary(constants.PositiveInfinity),
+			).SetLimit(3, 25),
+		}
+		for _, query := range queries {
+			expected, err := client.ZRangeWithScores(context.Background(), key, query)
+			suite.NoError(err)
+			for _, pageSize := range []int64{1, 4, 7, 100} {
+				assert.Equal(suite.T(), expected, collect(query, pageSize))
+			}
+		}
+
+		// non-existing key
+		iter, err := glide.NewZRangeWithScoresIterator(
+			client, uuid.New().String(), options.NewRangeByIndexQuery(0, -1), 10,
+		)
+		suite.NoError(err)
+		page, err := iter.Next(context.Background())
+		suite.NoError(err)
+		assert.Empty(suite.T(), page)
+		assert.False(suite.T(), iter.HasNext())
+
+		// invalid page size
+		_, err = glide.NewZRangeWithScoresIterator(client, key, options.NewRangeByIndexQuery(0, -1), 0)
+		suite.Error(err)
+	})
+}
+
 func (suite *GlideTestSuite) TestGeoSearchStore() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		sourceKey := "{key}-1-" + uuid.New().String()
@@ -11448,28 +12337,39 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 }
 
 func (suite *GlideTestSuite) TestBZPopMax() {
-	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
-
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
-		key1 := "{key}-1" + uuid.NewString()
+		key1 := "{zset}-1-" + uuid.NewString()
+		key2 := "{zset}-2-" + uuid.NewString()
+		key3 := "{zset}-3-" + uuid.NewString()
 
 		res1, err := client.BZPopMax(context.Background(), []string{key1}, 100*time.Millisecond)
 		suite.NoError(err)
 		assert.True(suite.T(), res1.IsNil())
 
-		membersScoreMap := map[string]float64{
-			"one":   1.0,
-			"two":   2.0,
-			"three": 3.0,
-		}
-
-		res2, err := client.ZAdd(context.Background(), key1, membersScoreMap)
+		res2, err := client.ZAdd(context.Background(), key1, map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 		suite.NoError(err)
 		assert.Equal(suite.T(), int64(3), res2)
+		res2, err = client.ZAdd(context.Background(), key2, map[string]float64{"four": 4.0})
+		suite.NoError(err)
+		assert.Equal(suite.T(), int64(1), res2)
 
-		res3, err := client.BZPopMax(context.Background(), []string{key1}, 100*time.Millisecond)
+		// The keys are checked in the order they are provided, and are left as they are
+		keys := make([]string, 2, 3)
+		keys[0], keys[1] = key1, key2
+		res3, err := client.BZPopMax(context.Background(), keys, 100*time.Millisecond)
 		suite.NoError(err)
 		assert.Equal(suite.T(), models.KeyWithMemberAndScore{Key: key1, Member: "three", Score: 3.0}, res3.Value())
+		assert.Equal(suite.T(), []string{key1, key2, ""}, keys[:3])
+
+		res4, err := client.BZPopMax(context.Background(), []string{key3, key2}, 100*time.Millisecond)
+		suite.NoError(err)
+		assert.Equal(suite.T(), models.KeyWithMemberAndScore{Key: key2, Member: "four", Score: 4.0}, res4.Value())
+
+		// Set key3 to a non-sorted set value
+		suite.verifyOK(client.Set(context.Background(), key3, "value"))
+
+		_, err = client.BZPopMax(context.Background(), []string{key3}, 100*time.Millisecond)
+		suite.Error(err)
 	})
 }
 
@@ -11752,3 +12652,538 @@ func (suite *GlideTestSuite) TestRegisterClientNameAndVersion() {
 		assert.Regexp(suite.T(), "lib-ver=unknown|lib-ver=v", infoStr, "lib-ver not found or incorrect")
 	})
 }
+
+func (suite *GlideTestSuite) TestBufferPool() {
+	// A tiny pool forces both pooled and oversized buffers to be used
+	bufferPool := config.NewBufferPoolConfiguration().WithMaxArgs(4).WithMaxBytes(8)
+	standalone, err := suite.client(suite.defaultClientConfig().WithBufferPool(bufferPool))
+	require.NoError(suite.T(), err)
+	defer standalone.Close()
+	cluster, err := suite.clusterClient(suite.defaultClusterClientConfig().WithBufferPool(bufferPool))
+	require.NoError(suite.T(), err)
+	defer cluster.Close()
+	disabled := config.NewBufferPoolConfiguration().WithEnabled(false)
+	unpooled, err := suite.client(suite.defaultClientConfig().WithBufferPool(disabled))
+	require.NoError(suite.T(), err)
+	defer unpooled.Close()
+
+	clients := []interfaces.BaseClientCommands{standalone, cluster, unpooled}
+	suite.runWithClients(clients, func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := "{bufferpool}" + uuid.NewString()
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				field := strconv.Itoa(i)
+				_, err := client.HSet(context.Background(), key, map[string]string{field: field, field + "x": field})
+				assert.NoError(t, err)
+				values, err := client.HMGet(context.Background(), key, []string{field, field + "x"})
+				assert.NoError(t, err)
+				assert.Equal(
+					t,
+					[]models.Result[string]{models.CreateStringResult(field), models.CreateStringResult(field)},
+					values,
+				)
+			}(i)
+		}
+		wg.Wait()
+
+		length, err := client.HLen(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, int64(40), length)
+	})
+
+	// Routed commands encode their route in a pooled buffer
+	for i := 0; i < 5; i++ {
+		result, err := cluster.PingWithOptions(context.Background(), options.ClusterPingOptions{
+			PingOptions: &options.PingOptions{Message: "pool"},
+			RouteOption: &options.RouteOption{Route: config.RandomRoute},
+		})
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "pool", result)
+	}
+}
+
+func (suite *GlideTestSuite) TestResp2Protocol() {
+	standalone, err := suite.client(suite.defaultClientConfig().WithProtocol(config.RESP2))
+	require.NoError(suite.T(), err)
+	defer standalone.Close()
+	cluster, err := suite.clusterClient(suite.defaultClusterClientConfig().WithProtocol(config.RESP2))
+	require.NoError(suite.T(), err)
+	defer cluster.Close()
+
+	suite.runWithClients([]interfaces.BaseClientCommands{standalone, cluster}, func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		var hello models.HelloResponse
+		switch c := client.(type) {
+		case *glide.Client:
+			hello, err = c.Hello(context.Background())
+		case *glide.ClusterClient:
+			hello, err = c.Hello(context.Background())
+		}
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), hello.Proto)
+
+		// Replies that are maps or sets with RESP3 are converted to the same types with RESP2
+		key := "{resp2}" + uuid.NewString()
+		_, err = client.HSet(context.Background(), key+"hash", map[string]string{"field1": "value1", "field2": "value2"})
+		require.NoError(t, err)
+		hash, err := client.HGetAll(context.Background(), key+"hash")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"field1": "value1", "field2": "value2"}, hash)
+
+		_, err = client.SAdd(context.Background(), key+"set", []string{"a", "b"})
+		require.NoError(t, err)
+		members, err := client.SMembers(context.Background(), key+"set")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]struct{}{"a": {}, "b": {}}, members)
+
+		_, err = client.ZAdd(context.Background(), key+"zset", map[string]float64{"one": 1.5, "two": 2})
+		require.NoError(t, err)
+		score, err := client.ZScore(context.Background(), key+"zset", "one")
+		require.NoError(t, err)
+		assert.Equal(t, 1.5, score.Value())
+		withScores, err := client.ZRangeWithScores(context.Background(), key+"zset", options.NewRangeByIndexQuery(0, -1))
+		require.NoError(t, err)
+		assert.Equal(t, []models.MemberAndScore{{Member: "one", Score: 1.5}, {Member: "two", Score: 2}}, withScores)
+	})
+}
+
+func (suite *GlideTestSuite) TestGetInto() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.NewString()
+		value := strings.Repeat("abc\x00", 1000)
+		suite.verifyOK(client.Set(context.Background(), key, value))
+
+		buf := make([]byte, 8192)
+		n, err := client.GetInto(context.Background(), key, buf)
+		require.NoError(t, err)
+		assert.Equal(t, value, string(buf[:n]))
+
+		// The buffer is reused, only the first n bytes hold the new value
+		suite.verifyOK(client.Set(context.Background(), key, "short"))
+		n, err = client.GetInto(context.Background(), key, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "short", string(buf[:n]))
+
+		// Too small, nothing is copied
+		small := []byte("xx")
+		n, err = client.GetInto(context.Background(), key, small)
+		assert.ErrorIs(t, err, io.ErrShortBuffer)
+		assert.Equal(t, 5, n)
+		assert.Equal(t, "xx", string(small))
+
+		n, err = client.GetInto(context.Background(), uuid.NewString(), buf)
+		require.NoError(t, err)
+		assert.Equal(t, -1, n)
+
+		// Not a string
+		listKey := uuid.NewString()
+		_, err = client.LPush(context.Background(), listKey, []string{"a"})
+		require.NoError(t, err)
+		_, err = client.GetInto(context.Background(), listKey, buf)
+		assert.Error(t, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestGetHedged() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+		result, err := client.GetHedged(context.Background(), key, *options.NewHedgeOptions())
+		require.NoError(t, err)
+		assert.Equal(t, "value", result.Value())
+
+		// A tiny delay hedges every read
+		opts := options.NewHedgeOptions().SetDelay(time.Nanosecond)
+		for i := 0; i < 20; i++ {
+			result, err = client.GetHedged(context.Background(), key, *opts)
+			require.NoError(t, err)
+			assert.Equal(t, "value", result.Value())
+		}
+
+		result, err = client.GetHedged(context.Background(), uuid.NewString(), *opts)
+		require.NoError(t, err)
+		assert.True(t, result.IsNil())
+
+		// Not a string, both reads fail
+		listKey := uuid.NewString()
+		_, err = client.LPush(context.Background(), listKey, []string{"a"})
+		require.NoError(t, err)
+		_, err = client.GetHedged(context.Background(), listKey, *opts)
+		assert.Error(t, err)
+
+		// A cancelled context cancels the hedged reads
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = client.GetHedged(ctx, key, *opts)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func (suite *GlideTestSuite) TestGetWithVersionAndSetIfVersion() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.NewString()
+
+		// A missing key has an empty version
+		missing, err := client.GetWithVersion(context.Background(), key)
+		require.NoError(t, err)
+		assert.True(t, missing.Value.IsNil())
+		assert.Empty(t, missing.Version)
+
+		// An empty version creates the key only if it does not exist
+		set, err := client.SetIfVersion(context.Background(), key, "v1", "")
+		require.NoError(t, err)
+		assert.True(t, set)
+		set, err = client.SetIfVersion(context.Background(), key, "v1", "")
+		require.NoError(t, err)
+		assert.False(t, set)
+
+		first, err := client.GetWithVersion(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", first.Value.Value())
+		assert.NotEmpty(t, first.Version)
+
+		// A concurrent write invalidates the version
+		suite.verifyOK(client.Set(context.Background(), key, "v2"))
+		set, err = client.SetIfVersion(context.Background(), key, "v3", first.Version)
+		require.NoError(t, err)
+		assert.False(t, set)
+
+		second, err := client.GetWithVersion(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, "v2", second.Value.Value())
+		assert.NotEqual(t, first.Version, second.Version)
+
+		// The TTL of the key is retained
+		_, err = client.Expire(context.Background(), key, 100*time.Second)
+		require.NoError(t, err)
+		set, err = client.SetIfVersion(context.Background(), key, "v3", second.Version)
+		require.NoError(t, err)
+		assert.True(t, set)
+		ttl, err := client.TTL(context.Background(), key)
+		require.NoError(t, err)
+		assert.Greater(t, ttl, int64(0))
+
+		value, err := client.Get(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, "v3", value.Value())
+
+		// Only strings are supported
+		listKey := uuid.NewString()
+		_, err = client.LPush(context.Background(), listKey, []string{"a"})
+		require.NoError(t, err)
+		_, err = client.GetWithVersion(context.Background(), listKey)
+		assert.Error(t, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestSetIfVersion_ABA() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "A"))
+		stale, err := client.GetWithVersion(context.Background(), key)
+		require.NoError(t, err)
+
+		// The value is changed and restored to its original content in the meantime
+		current := stale
+		for _, value := range []string{"B", "A"} {
+			set, err := client.SetIfVersion(context.Background(), key, value, current.Version)
+			require.NoError(t, err)
+			require.True(t, set)
+			current, err = client.GetWithVersion(context.Background(), key)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, "A", current.Value.Value())
+		assert.NotEqual(t, stale.Version, current.Version)
+		set, err := client.SetIfVersion(context.Background(), key, "C", stale.Version)
+		require.NoError(t, err)
+		assert.False(t, set)
+
+		// The version keeps increasing when the key expires with its version
+		_, err = client.PExpire(context.Background(), key, 100*time.Millisecond)
+		require.NoError(t, err)
+		set, err = client.SetIfVersion(context.Background(), key, "A", current.Version)
+		require.NoError(t, err)
+		require.True(t, set)
+		time.Sleep(200 * time.Millisecond)
+		set, err = client.SetIfVersion(context.Background(), key, "A", "")
+		require.NoError(t, err)
+		require.True(t, set)
+		recreated, err := client.GetWithVersion(context.Background(), key)
+		require.NoError(t, err)
+		assert.NotEqual(t, current.Version, recreated.Version)
+	})
+}
+
+func (suite *GlideTestSuite) TestMSetIfVersion() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		first := "{" + uuid.NewString() + "}:first"
+		second := strings.Replace(first, ":first", ":second", 1)
+		suite.verifyOK(client.Set(context.Background(), first, "1"))
+
+		values, err := client.MGetWithVersion(context.Background(), []string{first, second})
+		require.NoError(t, err)
+		require.Len(t, values, 2)
+		assert.Equal(t, "1", values[0].Value.Value())
+		assert.NotEmpty(t, values[0].Version)
+		assert.True(t, values[1].Value.IsNil())
+		assert.Empty(t, values[1].Version)
+		versions := map[string]string{first: values[0].Version, second: values[1].Version}
+
+		// None of the keys is set if any of them was modified
+		suite.verifyOK(client.Set(context.Background(), second, "2"))
+		set, err := client.MSetIfVersion(context.Background(), map[string]string{first: "3", second: "4"}, versions)
+		require.NoError(t, err)
+		assert.False(t, set)
+		value, err := client.Get(context.Background(), first)
+		require.NoError(t, err)
+		assert.Equal(t, "1", value.Value())
+
+		values, err = client.MGetWithVersion(context.Background(), []string{first, second})
+		require.NoError(t, err)
+		versions = map[string]string{first: values[0].Version, second: values[1].Version}
+		set, err = client.MSetIfVersion(context.Background(), map[string]string{first: "3", second: "4"}, versions)
+		require.NoError(t, err)
+		assert.True(t, set)
+		values, err = client.MGetWithVersion(context.Background(), []string{first, second})
+		require.NoError(t, err)
+		assert.Equal(t, "3", values[0].Value.Value())
+		assert.Equal(t, "4", values[1].Value.Value())
+		assert.NotEqual(t, versions[first], values[0].Version)
+	})
+}
+
+func (suite *GlideTestSuite) TestDelIfType() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.NewString()
+
+		// A missing key is not deleted
+		deleted, err := client.DelIfType(context.Background(), key, constants.ObjectTypeString)
+		require.NoError(t, err)
+		assert.False(t, deleted)
+
+		// A key of another type is kept
+		_, err = client.LPush(context.Background(), key, []string{"a"})
+		require.NoError(t, err)
+		deleted, err = client.DelIfType(context.Background(), key, constants.ObjectTypeString)
+		require.NoError(t, err)
+		assert.False(t, deleted)
+		exists, err := client.Exists(context.Background(), []string{key})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), exists)
+
+		deleted, err = client.DelIfType(context.Background(), key, constants.ObjectTypeList)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		exists, err = client.Exists(context.Background(), []string{key})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), exists)
+
+		_, err = client.DelIfType(context.Background(), key, "")
+		assert.Error(t, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestUpdateString() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		key := uuid.NewString()
+
+		// A missing key is passed as a nil result, and created
+		updated, err := client.UpdateString(ctx, key, func(old models.Result[string]) (string, bool) {
+			assert.True(t, old.IsNil())
+			return "0", false
+		})
+		require.NoError(t, err)
+		assert.True(t, updated)
+
+		// The concurrent updates are retried until none is lost
+		_, err = client.Expire(ctx, key, 100*time.Second)
+		require.NoError(t, err)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				updated, err := client.UpdateString(ctx, key, func(old models.Result[string]) (string, bool) {
+					count, err := strconv.Atoi(old.Value())
+					if err != nil {
+						return "", true
+					}
+					return strconv.Itoa(count + 1), false
+				})
+				assert.NoError(t, err)
+				assert.True(t, updated)
+			}()
+		}
+		wg.Wait()
+		value, err := client.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, "10", value.Value())
+
+		// The TTL of the key is retained
+		ttl, err := client.TTL(ctx, key)
+		require.NoError(t, err)
+		assert.Greater(t, ttl, int64(0))
+
+		// An aborted update leaves the key unchanged
+		updated, err = client.UpdateString(ctx, key, func(old models.Result[string]) (string, bool) {
+			return "aborted", true
+		})
+		require.NoError(t, err)
+		assert.False(t, updated)
+		value, err = client.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, "10", value.Value())
+
+		// Only strings are supported
+		listKey := uuid.NewString()
+		_, err = client.LPush(ctx, listKey, []string{"a"})
+		require.NoError(t, err)
+		_, err = client.UpdateString(ctx, listKey, func(old models.Result[string]) (string, bool) {
+			return "value", false
+		})
+		assert.Error(t, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestCommandInfo() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		info, err := client.CommandInfo(context.Background(), []string{"GET", "config", "nonexistentcommand"})
+		require.NoError(t, err)
+		assert.Len(t, info, 2)
+		assert.NotContains(t, info, "nonexistentcommand")
+
+		get := info["get"]
+		assert.Equal(t, "get", get.Name)
+		assert.Equal(t, int64(2), get.Arity)
+		assert.Contains(t, get.Flags, "readonly")
+		assert.Equal(t, int64(1), get.FirstKey)
+		assert.Equal(t, int64(1), get.LastKey)
+		assert.Equal(t, int64(1), get.Step)
+		assert.Contains(t, get.AclCategories, "@read")
+		assert.Empty(t, get.Subcommands)
+
+		if suite.serverVersion >= "7.0.0" {
+			var subcommands []string
+			for _, subcommand := range info["config"].Subcommands {
+				subcommands = append(subcommands, subcommand.Name)
+			}
+			assert.Contains(t, subcommands, "config|get")
+		}
+	})
+}
+
+// commandCalls returns the number of calls of `command` reported by `INFO COMMANDSTATS`.
+func commandCalls(commandStats string, command string) int64 {
+	prefix := "cmdstat_" + command + ":calls="
+	for _, line := range strings.Split(commandStats, "\n") {
+		if value, found := strings.CutPrefix(strings.TrimSpace(line), prefix); found {
+			calls, _ := strconv.ParseInt(strings.Split(value, ",")[0], 10, 64)
+			return calls
+		}
+	}
+	return 0
+}
+
+func (suite *GlideTestSuite) TestIntrospectionCache() {
+	t := suite.T()
+	cacheConfig := config.NewIntrospectionCacheConfiguration(time.Minute)
+	client, err := suite.client(suite.defaultClientConfig().WithIntrospectionCache(cacheConfig))
+	require.NoError(t, err)
+	defer client.Close()
+	commandStats := options.InfoOptions{Sections: []constants.Section{constants.Commandstats}}
+	server := options.InfoOptions{Sections: []constants.Section{constants.Server}}
+
+	suite.verifyOK(client.ConfigResetStat(context.Background()))
+	for i := 0; i < 3; i++ {
+		info, err := client.CommandInfo(context.Background(), []string{"get"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), info["get"].Arity)
+		// Modifying a result does not modify the cached one
+		info["get"] = models.CommandInfo{}
+	}
+	stats, err := client.InfoWithOptions(context.Background(), commandStats)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), commandCalls(stats, "command|info"))
+
+	// The uptime would have changed if the second call reached the server
+	first, err := client.InfoWithOptions(context.Background(), server)
+	require.NoError(t, err)
+	time.Sleep(1100 * time.Millisecond)
+	second, err := client.InfoWithOptions(context.Background(), server)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	client.InvalidateIntrospectionCache()
+	_, err = client.CommandInfo(context.Background(), []string{"get"})
+	require.NoError(t, err)
+	stats, err = client.InfoWithOptions(context.Background(), commandStats)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), commandCalls(stats, "command|info"))
+
+	// Without the cache, every call reaches the server
+	uncached := suite.defaultClient()
+	suite.verifyOK(uncached.ConfigResetStat(context.Background()))
+	for i := 0; i < 3; i++ {
+		_, err = uncached.CommandInfo(context.Background(), []string{"get"})
+		require.NoError(t, err)
+	}
+	stats, err = uncached.InfoWithOptions(context.Background(), commandStats)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), commandCalls(stats, "command|info"))
+}
+
+func (suite *GlideTestSuite) TestIntrospectionCacheCluster() {
+	t := suite.T()
+	cacheConfig := config.NewIntrospectionCacheConfiguration(time.Minute)
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithIntrospectionCache(cacheConfig))
+	require.NoError(t, err)
+	defer client.Close()
+
+	suite.verifyOK(client.ConfigResetStat(context.Background()))
+	for i := 0; i < 3; i++ {
+		_, err := client.CommandInfo(context.Background(), []string{"get"})
+		require.NoError(t, err)
+	}
+	stats, err := client.InfoWithOptions(context.Background(), options.ClusterInfoOptions{
+		InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Commandstats}},
+		RouteOption: &options.RouteOption{Route: config.AllNodes},
+	})
+	require.NoError(t, err)
+	var calls int64
+	for _, nodeStats := range stats.MultiValue() {
+		calls += commandCalls(nodeStats, "command|info")
+	}
+	assert.Equal(t, int64(1), calls)
+
+	if suite.serverVersion >= "7.0.0" {
+		shards, err := client.ClusterShards(context.Background())
+		require.NoError(t, err)
+		// Modifying a result does not modify the cached one
+		shards[0]["slots"] = nil
+		cached, err := client.ClusterShards(context.Background())
+		require.NoError(t, err)
+		assert.NotNil(t, cached[0]["slots"])
+	}
+
+	server := options.ClusterInfoOptions{InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Server}}}
+	first, err := client.InfoWithOptions(context.Background(), server)
+	require.NoError(t, err)
+	time.Sleep(1100 * time.Millisecond)
+	second, err := client.InfoWithOptions(context.Background(), server)
+	require.NoError(t, err)
+	assert.Equal(t, first.MultiValue(), second.MultiValue())
+}
@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/debug"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+func (suite *GlideTestSuite) TestSlotVerifier() {
+	t := suite.T()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var mismatches []debug.SlotMismatch
+	verifier := debug.NewSlotVerifier(1, func(mismatch debug.SlotMismatch) { mismatches = append(mismatches, mismatch) })
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithInterceptor(verifier.Interceptor()))
+	require.NoError(t, err)
+	defer client.Close()
+	done := make(chan error, 1)
+	go func() { done <- verifier.Run(ctx, client) }()
+
+	// Hash tags, empty hash tags and braces without hash tag
+	tag := uuid.NewString()
+	keys := []string{"{" + tag + "}:a", "{}" + tag, "}" + tag + "{", "{" + tag + "}}{x}", tag}
+	for _, key := range keys {
+		_, err = client.Set(ctx, key, "value")
+		require.NoError(t, err)
+	}
+	_, err = client.Exec(ctx, *pipeline.NewClusterBatch(false).Get(keys[0]).Get(keys[1]), false)
+	require.NoError(t, err)
+
+	require.Eventually(
+		t,
+		func() bool { return verifier.Verified() >= int64(len(keys)+2) },
+		10*time.Second,
+		10*time.Millisecond,
+	)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Empty(t, mismatches)
+}
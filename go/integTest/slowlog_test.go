@@ -0,0 +1,77 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/monitoring"
+)
+
+// setSlowLogThreshold sets the `slowlog-log-slower-than` configuration on all the nodes of `client`.
+func setSlowLogThreshold(ctx context.Context, client interfaces.BaseClientCommands, micros string) error {
+	parameters := map[string]string{"slowlog-log-slower-than": micros}
+	switch client := client.(type) {
+	case *glide.Client:
+		_, err := client.ConfigSet(ctx, parameters)
+		return err
+	case *glide.ClusterClient:
+		_, err := client.ConfigSet(ctx, parameters)
+		return err
+	}
+	return nil
+}
+
+func (suite *GlideTestSuite) TestWatchSlowLog() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// Log every command
+		require.NoError(t, setSlowLogThreshold(ctx, client, "0"))
+		defer setSlowLogThreshold(context.Background(), client, "10000")
+
+		key := uuid.NewString()
+		entries := make(chan monitoring.SlowLogEntry)
+		done := make(chan error, 1)
+		go func() {
+			done <- monitoring.WatchSlowLog(ctx, client, 50*time.Millisecond, func(entry monitoring.SlowLogEntry) {
+				select {
+				case entries <- entry:
+				case <-ctx.Done():
+				}
+			})
+		}()
+
+		// The entries logged before the watch started are not reported, so the command is sent until it is reported
+		deadline := time.After(10 * time.Second)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+	wait:
+		for {
+			select {
+			case entry := <-entries:
+				if slices.Contains(entry.Args, key) {
+					assert.Equal(t, []string{"SET", key, "value"}, entry.Args)
+					break wait
+				}
+			case <-ticker.C:
+				_, err := client.Set(ctx, key, "value")
+				require.NoError(t, err)
+			case <-deadline:
+				require.FailNow(t, "the command was not reported")
+			}
+		}
+
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+	})
+}
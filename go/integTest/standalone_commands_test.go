@@ -418,6 +418,20 @@ func (suite *GlideTestSuite) TestInfoStandalone() {
 	}
 }
 
+func (suite *GlideTestSuite) TestReplicationStatusStandalone() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	status, err := client.ReplicationStatus(context.Background())
+	require.NoError(t, err)
+	assert.True(t, status.IsPrimary())
+	assert.NotEmpty(t, status.Fields["master_replid"])
+	for _, replica := range status.Replicas {
+		assert.NotEmpty(t, replica.Address)
+		assert.GreaterOrEqual(t, replica.OffsetLag, int64(0))
+	}
+}
+
 func (suite *GlideTestSuite) TestDBSize() {
 	client := suite.defaultClient()
 	result, err := client.DBSize(context.Background())
@@ -778,6 +792,57 @@ func (suite *GlideTestSuite) TestUpdateConnectionPassword_ImmediateAuthWrongPass
 	suite.NoError(err)
 }
 
+func (suite *GlideTestSuite) TestUpdateConnectionCredentials() {
+	ctx := context.Background()
+	username := "rotation_user_" + uuid.NewString()
+	oldPwd := uuid.NewString()
+	newPwd := uuid.NewString()
+
+	adminClient := suite.defaultClient()
+	defer adminClient.Close()
+
+	_, err := adminClient.AclSetUser(ctx, username, []string{"on", ">" + oldPwd, "~*", "+@all"})
+	suite.NoError(err)
+	defer adminClient.AclDelUser(ctx, []string{username})
+
+	testClient := suite.defaultClient()
+	defer testClient.Close()
+
+	result, err := testClient.UpdateConnectionCredentials(ctx, username, oldPwd)
+	suite.NoError(err)
+	suite.Equal("OK", result)
+	whoami, err := testClient.AclWhoAmI(ctx)
+	suite.NoError(err)
+	suite.Equal(username, whoami)
+
+	// A wrong password is rejected and the client keeps its credentials
+	_, err = testClient.UpdateConnectionCredentials(ctx, username, uuid.NewString())
+	suite.Error(err)
+	whoami, err = testClient.AclWhoAmI(ctx)
+	suite.NoError(err)
+	suite.Equal(username, whoami)
+
+	// An empty password is rejected
+	_, err = testClient.UpdateConnectionCredentials(ctx, username, "")
+	suite.Error(err)
+
+	// Rotate the password: add the new one, switch the client to it, then revoke the old one
+	_, err = adminClient.AclSetUser(ctx, username, []string{">" + newPwd})
+	suite.NoError(err)
+	_, err = testClient.UpdateConnectionCredentials(ctx, username, newPwd)
+	suite.NoError(err)
+	_, err = adminClient.AclSetUser(ctx, username, []string{"<" + oldPwd})
+	suite.NoError(err)
+
+	// Kill the connections of the user, the client reconnects with the new password
+	_, err = adminClient.CustomCommand(ctx, []string{"CLIENT", "KILL", "USER", username})
+	suite.NoError(err)
+	assert.Eventually(suite.T(), func() bool {
+		whoami, err := testClient.AclWhoAmI(ctx)
+		return err == nil && whoami == username
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
 func (suite *GlideTestSuite) TestLolwutWithOptions_WithVersion() {
 	client := suite.defaultClient()
 	options := options.NewLolwutOptions(8)
@@ -849,6 +914,90 @@ func (suite *GlideTestSuite) TestClientId() {
 	assert.Greater(suite.T(), result, int64(0))
 }
 
+func (suite *GlideTestSuite) TestClientInfo() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	id, err := client.ClientId(context.Background())
+	require.NoError(t, err)
+	suite.verifyOK(client.ClientSetName(context.Background(), "info-client"))
+
+	info, err := client.ClientInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, id, info.Id)
+	assert.Equal(t, "info-client", info.Name)
+	assert.Equal(t, "client|info", info.Cmd)
+	assert.NotEmpty(t, info.Addr)
+	if suite.serverVersion >= "7.2.0" {
+		assert.Equal(t, "GlideGo", info.LibName)
+		assert.NotEmpty(t, info.LibVer)
+	}
+}
+
+func (suite *GlideTestSuite) TestClientInfo_WithLibName() {
+	suite.SkipIfServerVersionLowerThan("7.2.0", suite.T())
+	client, err := suite.client(suite.defaultClientConfig().WithLibName("GlideGo(test)"))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	info, err := client.ClientInfo(context.Background())
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "GlideGo(test)", info.LibName)
+}
+
+func (suite *GlideTestSuite) TestClientTrackingInfo() {
+	suite.SkipIfServerVersionLowerThan("6.2.0", suite.T())
+	client := suite.defaultClient()
+	t := suite.T()
+
+	info, err := client.ClientTrackingInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"off"}, info.Flags)
+	assert.Equal(t, int64(-1), info.Redirect)
+	assert.Empty(t, info.Prefixes)
+
+	_, err = client.CustomCommand(context.Background(), []string{"CLIENT", "TRACKING", "ON", "BCAST", "PREFIX", "tracked:"})
+	require.NoError(t, err)
+	defer client.CustomCommand(context.Background(), []string{"CLIENT", "TRACKING", "OFF"})
+
+	info, err = client.ClientTrackingInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, info.HasFlag("on"))
+	assert.True(t, info.HasFlag("bcast"))
+	assert.Equal(t, []string{"tracked:"}, info.Prefixes)
+}
+
+func (suite *GlideTestSuite) TestHello() {
+	client, err := suite.client(suite.defaultClientConfig())
+	require.NoError(suite.T(), err)
+	defer client.Close()
+	t := suite.T()
+
+	id, err := client.ClientId(context.Background())
+	require.NoError(t, err)
+
+	hello, err := client.Hello(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), hello.Proto)
+	assert.Equal(t, id, hello.Id)
+	assert.Equal(t, "standalone", hello.Mode)
+	assert.Equal(t, "master", hello.Role)
+	assert.NotEmpty(t, hello.Version)
+
+	hello, err = client.HelloWithOptions(
+		context.Background(),
+		*options.NewHelloOptions().SetProtocol(config.RESP2).SetClientName("hello-client"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), hello.Proto)
+	name, err := client.ClientGetName(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "hello-client", name.Value())
+
+	_, err = client.HelloWithOptions(context.Background(), *options.NewHelloOptions().SetClientName("no-protocol"))
+	assert.ErrorContains(t, err, "protocol version")
+}
+
 func (suite *GlideTestSuite) TestLastSave() {
 	client := suite.defaultClient()
 	t := suite.T()
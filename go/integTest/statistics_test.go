@@ -24,6 +24,17 @@ func (suite *GlideTestSuite) TestGetStatistics() {
 		"compression_skipped_count",
 		"subscription_out_of_sync_count",
 		"subscription_last_sync_timestamp",
+		"moved_redirect_count",
+		"ask_redirect_count",
+		"circuit_breaker_opened_count",
+		"circuit_breaker_half_opened_count",
+		"circuit_breaker_closed_count",
+		"circuit_breaker_rejected_count",
+		"slot_refresh_count",
+		"slot_refresh_unchanged_count",
+		"slot_refresh_total_duration_ms",
+		"slot_refresh_last_duration_ms",
+		"incremental_slot_update_count",
 	}
 
 	for _, key := range expectedKeys {
@@ -55,6 +66,17 @@ func (suite *GlideTestSuite) TestGetStatisticsCluster() {
 		"compression_skipped_count",
 		"subscription_out_of_sync_count",
 		"subscription_last_sync_timestamp",
+		"moved_redirect_count",
+		"ask_redirect_count",
+		"circuit_breaker_opened_count",
+		"circuit_breaker_half_opened_count",
+		"circuit_breaker_closed_count",
+		"circuit_breaker_rejected_count",
+		"slot_refresh_count",
+		"slot_refresh_unchanged_count",
+		"slot_refresh_total_duration_ms",
+		"slot_refresh_last_duration_ms",
+		"incremental_slot_update_count",
 	}
 
 	for _, key := range expectedKeys {
@@ -66,4 +88,6 @@ func (suite *GlideTestSuite) TestGetStatisticsCluster() {
 	// Verify we have at least one connection and one client
 	assert.GreaterOrEqual(suite.T(), stats["total_connections"], uint64(1), "Should have at least 1 connection")
 	assert.GreaterOrEqual(suite.T(), stats["total_clients"], uint64(1), "Should have at least 1 client")
+	// The slots are refreshed when the cluster client connects
+	assert.GreaterOrEqual(suite.T(), stats["slot_refresh_count"], uint64(1), "Should have at least 1 slot refresh")
 }
@@ -0,0 +1,96 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/stream"
+)
+
+func (suite *GlideTestSuite) TestStreamLagReport() {
+	suite.SkipIfServerVersionLowerThan("7.2.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		key := uuid.New().String()
+		for i := 0; i < 10; i++ {
+			_, err := client.XAdd(ctx, key, []models.FieldValue{{Field: "order", Value: "42"}})
+			require.NoError(t, err)
+		}
+		_, err := client.XGroupCreate(ctx, key, "billing", "0")
+		require.NoError(t, err)
+		_, err = client.XGroupCreate(ctx, key, "audit", "$")
+		require.NoError(t, err)
+		_, err = client.XReadGroupWithOptions(
+			ctx,
+			"billing",
+			"worker-1",
+			map[string]string{key: ">"},
+			*options.NewXReadGroupOptions().SetCount(4),
+		)
+		require.NoError(t, err)
+
+		report, err := stream.LagReport(ctx, client, key)
+		require.NoError(t, err)
+		assert.Equal(t, key, report.Key)
+		assert.Equal(t, int64(10), report.Length)
+		require.Len(t, report.Groups, 2)
+		groups := map[string]stream.GroupLag{}
+		for _, group := range report.Groups {
+			groups[group.Name] = group
+		}
+		assert.Equal(t, int64(6), groups["billing"].Lag)
+		assert.Equal(t, int64(4), groups["billing"].EntriesRead)
+		assert.Equal(t, int64(4), groups["billing"].Pending)
+		require.Len(t, groups["billing"].Consumers, 1)
+		assert.Equal(t, "worker-1", groups["billing"].Consumers[0].Name)
+		assert.Equal(t, int64(4), groups["billing"].Consumers[0].Pending)
+		assert.GreaterOrEqual(t, groups["billing"].Consumers[0].Inactive, time.Duration(0))
+		assert.Equal(t, int64(0), groups["audit"].Lag)
+		assert.Empty(t, groups["audit"].Consumers)
+
+		alerts := report.Alerts(stream.Thresholds{Lag: 5, ConsumerPending: 3})
+		assert.ElementsMatch(t, []stream.Alert{
+			{Key: key, Group: "billing", Kind: stream.LagExceeded, Value: 6, Threshold: 5},
+			{Key: key, Group: "billing", Consumer: "worker-1", Kind: stream.ConsumerPendingExceeded, Value: 4, Threshold: 3},
+		}, alerts)
+
+		_, err = stream.LagReport(ctx, client, uuid.New().String())
+		assert.Error(t, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestStreamWatchLag() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		key := uuid.New().String()
+		_, err := client.XAdd(context.Background(), key, []models.FieldValue{{Field: "order", Value: "42"}})
+		require.NoError(t, err)
+		_, err = client.XGroupCreate(context.Background(), key, "billing", "0")
+		require.NoError(t, err)
+		_, err = client.XReadGroup(context.Background(), "billing", "worker-1", map[string]string{key: ">"})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		var alerts []stream.Alert
+		thresholds := stream.Thresholds{Idle: time.Millisecond}
+		onReport := func(report stream.Report, exceeded []stream.Alert) {
+			alerts = exceeded
+			cancel()
+		}
+		err = stream.WatchLag(ctx, client, key, thresholds, 10*time.Millisecond, onReport)
+		assert.ErrorIs(t, err, context.Canceled)
+		require.Len(t, alerts, 1)
+		assert.Equal(t, stream.IdleExceeded, alerts[0].Kind)
+		assert.Equal(t, "worker-1", alerts[0].Consumer)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/stream"
+)
+
+func (suite *GlideTestSuite) TestStreamTrimmer() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		key := uuid.New().String()
+		// 5 entries from 2 hours ago, and 5 recent entries
+		old := time.Now().Add(-2 * time.Hour).UnixMilli()
+		recent := time.Now().UnixMilli()
+		for i := 0; i < 10; i++ {
+			timestamp := old
+			if i >= 5 {
+				timestamp = recent
+			}
+			id := strconv.FormatInt(timestamp, 10) + "-" + strconv.Itoa(i)
+			_, err := client.XAddWithOptions(
+				ctx,
+				key,
+				[]models.FieldValue{{Field: "event", Value: strconv.Itoa(i)}},
+				*options.NewXAddOptions().SetId(id),
+			)
+			require.NoError(t, err)
+		}
+
+		retention := stream.Retention{Key: key, MaxAge: time.Hour, MaxLen: 3}
+		results := stream.NewTrimmer(client, retention).WithDryRun(true).Trim(ctx)
+		assert.Equal(t, []stream.TrimResult{{Key: key, Trimmed: 7, DryRun: true}}, results)
+		length, err := client.XLen(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), length)
+
+		results = stream.NewTrimmer(client, retention).Trim(ctx)
+		assert.Equal(t, []stream.TrimResult{{Key: key, Trimmed: 7}}, results)
+		length, err = client.XLen(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), length)
+	})
+}
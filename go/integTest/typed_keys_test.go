@@ -0,0 +1,74 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+type typedKeyJob struct {
+	ID       int      `json:"id"`
+	Payloads []string `json:"payloads"`
+}
+
+func (suite *GlideTestSuite) TestTypedKeys() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+
+		greeting := glide.StringKey(uuid.NewString())
+		require.NoError(t, greeting.Set(ctx, client, "hello"))
+		length, err := greeting.Append(ctx, client, " world")
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), length)
+		value, err := greeting.Get(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", value.Value())
+
+		scores := glide.HashKey[int](uuid.NewString())
+		added, err := scores.Set(ctx, client, map[string]int{"alice": 3, "bob": 5})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), added)
+		score, err := scores.Get(ctx, client, "bob")
+		require.NoError(t, err)
+		assert.Equal(t, 5, score.Value())
+		all, err := scores.GetAll(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"alice": 3, "bob": 5}, all)
+		deleted, err := scores.DeleteFields(ctx, client, "alice", "carol")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+
+		jobs := glide.ListKey[typedKeyJob](uuid.NewString())
+		length, err = jobs.PushRight(ctx, client, typedKeyJob{ID: 1, Payloads: []string{"a"}}, typedKeyJob{ID: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), length)
+		remaining, err := jobs.Range(ctx, client, 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []typedKeyJob{{ID: 1, Payloads: []string{"a"}}, {ID: 2}}, remaining)
+		job, err := jobs.PopLeft(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, 1, job.Value().ID)
+
+		// The generic key commands apply to the keys of any type
+		ok, err := jobs.Expire(ctx, client, time.Minute)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = jobs.Delete(ctx, client)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = jobs.Exists(ctx, client)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		job, err = jobs.PopLeft(ctx, client)
+		require.NoError(t, err)
+		assert.True(t, job.IsNil())
+	})
+}
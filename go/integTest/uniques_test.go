@@ -0,0 +1,51 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/uniques"
+)
+
+func (suite *GlideTestSuite) TestUniques() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		fake := clock.NewFake(time.Now())
+		visitors := uniques.New(client, uuid.New().String()).WithRetention(time.Hour).WithClock(fake)
+
+		for i := 0; i < 100; i++ {
+			require.NoError(t, visitors.AddEvent(ctx, fmt.Sprintf("user:%d", i)))
+		}
+		fake.Advance(time.Minute)
+		require.NoError(t, visitors.AddEvents(ctx, []string{"user:0", "user:100"}))
+
+		count, err := visitors.CountLast(ctx, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		// The counts are estimates
+		count, err = visitors.CountLast(ctx, 2*time.Minute)
+		require.NoError(t, err)
+		assert.InDelta(t, 101, count, 3)
+
+		// The buckets expire once they are out of the retention
+		ttl, err := client.TTL(ctx, visitors.BucketKey(fake.Now()))
+		require.NoError(t, err)
+		assert.Greater(t, ttl, int64(time.Hour.Seconds()))
+		assert.LessOrEqual(t, ttl, int64((time.Hour + 2*time.Minute).Seconds()))
+
+		all := visitors.SameSlotKey(":all")
+		require.NoError(t, visitors.MergeLast(ctx, time.Hour, all))
+		count, err = client.PfCount(ctx, []string{all})
+		require.NoError(t, err)
+		assert.InDelta(t, 101, count, 3)
+	})
+}
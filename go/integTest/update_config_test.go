@@ -0,0 +1,109 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Note: test may cause others to fail, because DEBUG command locks the server
+func (suite *GlideTestSuite) TestUpdateConfigRequestTimeout() {
+	ctx := context.Background()
+	client := suite.defaultClient()
+	defer client.Close()
+
+	suite.verifyOK(client.UpdateConfig(ctx, config.NewConfigUpdate().WithRequestTimeout(100*time.Millisecond)))
+	_, err := client.CustomCommand(ctx, []string{"DEBUG", "sleep", "0.5"})
+	suite.IsType(&glide.TimeoutError{}, err)
+
+	time.Sleep(1 * time.Second)
+
+	// The connections are kept, and the next requests use the new timeout
+	suite.verifyOK(client.UpdateConfig(ctx, config.NewConfigUpdate().WithRequestTimeout(2*time.Second)))
+	result, err := client.CustomCommand(ctx, []string{"DEBUG", "sleep", "0.5"})
+	suite.NoError(err)
+	suite.Equal("OK", result)
+
+	_, err = client.UpdateConfig(ctx, config.NewConfigUpdate().WithRequestTimeout(0))
+	suite.Error(err)
+}
+
+// Note: test may cause others to fail, because DEBUG command locks the server
+func (suite *GlideTestSuite) TestUpdateConfigRequestTimeoutCluster() {
+	ctx := context.Background()
+	client := suite.defaultClusterClient()
+	defer client.Close()
+	route := config.RandomRoute
+
+	suite.verifyOK(client.UpdateConfig(ctx, config.NewConfigUpdate().WithRequestTimeout(100*time.Millisecond)))
+	_, err := client.CustomCommandWithRoute(ctx, []string{"DEBUG", "sleep", "0.5"}, route)
+	suite.IsType(&glide.TimeoutError{}, err)
+
+	time.Sleep(1 * time.Second)
+
+	suite.verifyOK(client.UpdateConfig(ctx, config.NewConfigUpdate().WithRequestTimeout(2*time.Second)))
+	result, err := client.CustomCommandWithRoute(ctx, []string{"DEBUG", "sleep", "0.5"}, route)
+	suite.NoError(err)
+	suite.Equal("OK", result.SingleValue())
+}
+
+func (suite *GlideTestSuite) TestUpdateConfigReadFromCluster() {
+	ctx := context.Background()
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithRequestTimeout(2 * time.Second))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	suite.verifyOK(client.UpdateConfig(ctx, config.NewConfigUpdate().WithReadFrom(config.PreferReplica)))
+	suite.verifyOK(client.ConfigResetStat(ctx))
+	for range 5 {
+		_, err = client.Get(ctx, "foo")
+		assert.NoError(suite.T(), err)
+	}
+
+	infoResult, err := client.InfoWithOptions(ctx,
+		options.ClusterInfoOptions{
+			InfoOptions: &options.InfoOptions{
+				Sections: []constants.Section{constants.Replication, constants.Commandstats},
+			},
+			RouteOption: &options.RouteOption{Route: config.AllNodes},
+		},
+	)
+	assert.NoError(suite.T(), err)
+	// The reads are sent to the replicas, and the primaries received none of them
+	for _, value := range infoResult.MultiValue() {
+		if strings.Contains(value, "role:master") {
+			assert.NotContains(suite.T(), value, "cmdstat_get:")
+		}
+	}
+
+	// The AZ of the nodes is only discovered by clients created with an AZ affinity strategy
+	_, err = client.UpdateConfig(ctx, config.NewConfigUpdate().WithReadFrom(config.AzAffinity).WithClientAZ("us-east-1a"))
+	suite.Error(err)
+	_, err = client.UpdateConfig(ctx, config.NewConfigUpdate().WithReadFrom(config.AzAffinity))
+	suite.ErrorContains(err, "client AZ must be set")
+}
+
+func (suite *GlideTestSuite) TestUpdateConfigReconnectStrategy() {
+	ctx := context.Background()
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		update := config.NewConfigUpdate().WithReconnectStrategy(config.NewBackoffStrategy(3, 10, 2).WithJitterPercent(10))
+		suite.verifyOK(client.UpdateConfig(ctx, update))
+
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(ctx, key, "value"))
+		value, err := client.Get(ctx, key)
+		suite.NoError(err)
+		suite.Equal("value", value.Value())
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func (suite *GlideTestSuite) TestWriteCoalescingWindow() {
+	t := suite.T()
+	client, err := suite.client(suite.defaultClientConfig().WithWriteCoalescingWindow(200 * time.Microsecond))
+	require.NoError(t, err)
+	clusterClient, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithWriteCoalescingWindow(200 * time.Microsecond),
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, commands := range []struct {
+		set func(key, value string) error
+		get func(key string) (models.Result[string], error)
+	}{
+		{
+			set: func(key, value string) error { _, err := client.Set(ctx, key, value); return err },
+			get: func(key string) (models.Result[string], error) { return client.Get(ctx, key) },
+		},
+		{
+			set: func(key, value string) error { _, err := clusterClient.Set(ctx, key, value); return err },
+			get: func(key string) (models.Result[string], error) { return clusterClient.Get(ctx, key) },
+		},
+	} {
+		// The concurrent requests are written together, and each of them still gets its own response
+		prefix := uuid.NewString()
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				key, value := fmt.Sprintf("%s-%d", prefix, i), fmt.Sprintf("value-%d", i)
+				if !assert.NoError(t, commands.set(key, value)) {
+					return
+				}
+				result, err := commands.get(key)
+				if assert.NoError(t, err) {
+					assert.Equal(t, value, result.Value())
+				}
+			}(i)
+		}
+		wg.Wait()
+	}
+}
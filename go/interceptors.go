@@ -0,0 +1,237 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"slices"
+	"strconv"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// interceptedResponse is the response of a command or a script returned by the invokers of the interceptors. The
+// interceptors may return it more than once, e.g. from a cache, so it is freed by the garbage collector, and the response
+// handlers, which free the responses they convert, are passed copies of it.
+type interceptedResponse struct {
+	value *C.struct_CommandResponse
+}
+
+func newInterceptedResponse(value *C.struct_CommandResponse, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	response := &interceptedResponse{value: value}
+	runtime.SetFinalizer(response, func(response *interceptedResponse) { C.free_command_response(response.value) })
+	return response, nil
+}
+
+// responseOf returns a copy of the response returned by the interceptors, to be freed by its response handler.
+func responseOf(result any, err error) (*C.struct_CommandResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+	response, ok := result.(*interceptedResponse)
+	if !ok {
+		return nil, fmt.Errorf("an interceptor returned %T instead of a response returned by an invoker", result)
+	}
+	defer runtime.KeepAlive(response)
+	return C.clone_command_response(response.value), nil
+}
+
+// ResponseSize returns the size in bytes of the strings of a response passed to an interceptor, e.g. to account for the
+// size of the values read: the values, fields, members and error messages of the response, but not the framing of the
+// protocol nor the numbers. The response of a batch is the sum of the sizes of the results of its commands.
+//
+// Parameters:
+//
+//	response - A response returned by an [config.Invoker], or one of the results of a batch.
+//
+// Return value:
+//
+//	The size of the strings of the response, or 0 if it has none.
+func ResponseSize(response any) int64 {
+	if response, ok := response.(*interceptedResponse); ok {
+		defer runtime.KeepAlive(response)
+		return commandResponseSize(response.value)
+	}
+	return valueSize(reflect.ValueOf(response))
+}
+
+// commandResponseSize returns the size of the strings of `response`, including the ones of its elements.
+func commandResponseSize(response *C.struct_CommandResponse) int64 {
+	if response == nil {
+		return 0
+	}
+	size := int64(response.string_value_len)
+	size += commandResponseSize(response.map_key) + commandResponseSize(response.map_value)
+	elements := unsafe.Slice(response.array_value, response.array_value_len)
+	for i := range elements {
+		size += commandResponseSize(&elements[i])
+	}
+	members := unsafe.Slice(response.sets_value, response.sets_value_len)
+	for i := range members {
+		size += commandResponseSize(&members[i])
+	}
+	return size
+}
+
+// valueSize returns the size of the strings of a converted result, including the ones of its elements and fields.
+func valueSize(value reflect.Value) int64 {
+	switch value.Kind() {
+	case reflect.String:
+		return int64(value.Len())
+	case reflect.Interface, reflect.Pointer:
+		if value.IsNil() {
+			return 0
+		}
+		return valueSize(value.Elem())
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return int64(value.Len())
+		}
+		var size int64
+		for i := range value.Len() {
+			size += valueSize(value.Index(i))
+		}
+		return size
+	case reflect.Map:
+		var size int64
+		for entries := value.MapRange(); entries.Next(); {
+			size += valueSize(entries.Key()) + valueSize(entries.Value())
+		}
+		return size
+	case reflect.Struct:
+		var size int64
+		for i := range value.NumField() {
+			size += valueSize(value.Field(i))
+		}
+		return size
+	}
+	return 0
+}
+
+// intercept passes `cmd` through the interceptors of the client, the innermost one calling `send`.
+func (client *baseClient) intercept(ctx context.Context, cmd models.Command, send config.Invoker) (any, error) {
+	next := send
+	for i := len(client.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := client.interceptors[i], next
+		next = func(ctx context.Context, cmd models.Command) (any, error) { return interceptor(ctx, cmd, inner) }
+	}
+	return next(ctx, cmd)
+}
+
+// interceptedCommand describes a command of type `requestType` for the interceptors. The arguments are copied, so that
+// the interceptors can change them without changing the ones of the caller.
+func interceptedCommand(requestType C.RequestType, args []string) models.Command {
+	name := commandName(requestType, args)
+	if requestType == C.CustomCommand && len(args) > 0 {
+		args = args[1:]
+	}
+	return models.Command{Name: name, Args: slices.Clone(args)}
+}
+
+// sentArgs returns the arguments to send for a command of type `requestType`, with the arguments `cmdArgs` of the
+// intercepted command. The name of a custom command is its first argument, as passed by the caller.
+func sentArgs(requestType C.RequestType, args []string, cmdArgs []string) []string {
+	if requestType == C.CustomCommand && len(args) > 0 {
+		return append([]string{args[0]}, cmdArgs...)
+	}
+	return cmdArgs
+}
+
+// executeCommandWithRoute sends a command through the interceptors of the client, if any.
+func (client *baseClient) executeCommandWithRoute(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if len(client.interceptors) == 0 {
+		return client.sendCommandWithRoute(ctx, requestType, args, route)
+	}
+	cmd := interceptedCommand(requestType, args)
+	return responseOf(client.intercept(ctx, cmd, func(ctx context.Context, cmd models.Command) (any, error) {
+		return newInterceptedResponse(
+			client.sendCommandWithRoute(ctx, requestType, sentArgs(requestType, args, cmd.Args), route),
+		)
+	}))
+}
+
+// executeScriptWithRoute sends a script through the interceptors of the client, if any, see sendScriptWithRoute.
+func (client *baseClient) executeScriptWithRoute(
+	ctx context.Context,
+	hash string,
+	keys []string,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if len(client.interceptors) == 0 {
+		return client.sendScriptWithRoute(ctx, hash, keys, args, route)
+	}
+	cmdArgs := make([]string, 0, 2+len(keys)+len(args))
+	cmdArgs = append(cmdArgs, hash, strconv.Itoa(len(keys)))
+	cmdArgs = append(append(cmdArgs, keys...), args...)
+	cmd := models.Command{Name: "EVALSHA", Args: cmdArgs}
+	return responseOf(client.intercept(ctx, cmd, func(ctx context.Context, cmd models.Command) (any, error) {
+		if len(cmd.Args) < 2 {
+			return nil, errors.New("an interceptor removed the hash or the number of keys of a script")
+		}
+		numKeys, err := strconv.Atoi(cmd.Args[1])
+		if err != nil || numKeys < 0 || numKeys > len(cmd.Args)-2 {
+			return nil, fmt.Errorf("an interceptor set an invalid number of keys for a script: %q", cmd.Args[1])
+		}
+		keys, args := cmd.Args[2:2+numKeys], cmd.Args[2+numKeys:]
+		return newInterceptedResponse(client.sendScriptWithRoute(ctx, cmd.Args[0], keys, args, route))
+	}))
+}
+
+// executeBatch sends a batch through the interceptors of the client, if any.
+func (client *baseClient) executeBatch(
+	ctx context.Context,
+	batch internal.Batch,
+	raiseOnError bool,
+	options *internal.BatchOptions,
+) ([]any, error) {
+	if len(client.interceptors) == 0 {
+		return client.sendBatch(ctx, batch, raiseOnError, options)
+	}
+	cmd := models.Command{Name: "Batch", Batch: make([]models.Command, len(batch.Commands)), Atomic: batch.IsAtomic}
+	for i, batchCmd := range batch.Commands {
+		cmd.Batch[i] = interceptedCommand(C.RequestType(batchCmd.RequestType), batchCmd.Args)
+	}
+	result, err := client.intercept(ctx, cmd, func(ctx context.Context, cmd models.Command) (any, error) {
+		if len(cmd.Batch) != len(batch.Commands) {
+			return nil, errors.New("an interceptor changed the number of commands of a batch")
+		}
+		sent := batch
+		sent.Commands = make([]internal.Cmd, len(batch.Commands))
+		for i, batchCmd := range batch.Commands {
+			batchCmd.Args = sentArgs(C.RequestType(batchCmd.RequestType), batchCmd.Args, cmd.Batch[i].Args)
+			sent.Commands[i] = batchCmd
+		}
+		results, err := client.sendBatch(ctx, sent, raiseOnError, options)
+		if err != nil {
+			return nil, err
+		}
+		return results, nil
+	})
+	if err != nil || result == nil {
+		return nil, err
+	}
+	results, ok := result.([]any)
+	if !ok {
+		return nil, fmt.Errorf("an interceptor returned %T instead of the results of a batch", result)
+	}
+	return results, nil
+}
@@ -0,0 +1,65 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestIntercept(t *testing.T) {
+	var calls []string
+	recorder := func(name string) config.Interceptor {
+		return func(ctx context.Context, cmd models.Command, next config.Invoker) (any, error) {
+			calls = append(calls, name)
+			cmd.Args = append(cmd.Args, name)
+			return next(ctx, cmd)
+		}
+	}
+	client := &baseClient{interceptors: []config.Interceptor{recorder("outer"), recorder("inner")}}
+
+	result, err := client.intercept(
+		context.Background(),
+		models.Command{Name: "GET", Args: []string{"key"}},
+		func(ctx context.Context, cmd models.Command) (any, error) {
+			calls = append(calls, "send")
+			return cmd.Args, nil
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key", "outer", "inner"}, result)
+	assert.Equal(t, []string{"outer", "inner", "send"}, calls)
+}
+
+func TestIntercept_ShortCircuit(t *testing.T) {
+	failure := errors.New("rejected")
+	client := &baseClient{interceptors: []config.Interceptor{
+		func(ctx context.Context, cmd models.Command, next config.Invoker) (any, error) {
+			return nil, failure
+		},
+	}}
+	_, err := client.intercept(
+		context.Background(),
+		models.Command{Name: "GET"},
+		func(ctx context.Context, cmd models.Command) (any, error) {
+			t.Fatal("the request must not be sent")
+			return nil, nil
+		},
+	)
+	assert.ErrorIs(t, err, failure)
+}
+
+func TestResponseSize(t *testing.T) {
+	assert.Equal(t, int64(5), ResponseSize("value"))
+	assert.Equal(t, int64(0), ResponseSize(int64(42)))
+	assert.Equal(t, int64(0), ResponseSize(nil))
+	assert.Equal(t, int64(3), ResponseSize([]byte("abc")))
+	assert.Equal(t, int64(12), ResponseSize([]any{"a", []any{"bc", nil}, map[string]any{"key": "value", "n": int64(1)}}))
+	assert.Equal(t, int64(5), ResponseSize(models.CreateStringResult("value")))
+	assert.Equal(t, int64(8), ResponseSize([]models.FieldValue{{Field: "f", Value: "v"}, {Field: "ab", Value: "cdef"}}))
+}
@@ -161,3 +161,24 @@ func BuildHTTLAndExpireTimeArgs(key string, fields []string) ([]string, error) {
 	args = append(args, buildFieldsArgs(fields)...)
 	return args, nil
 }
+
+// Stream entry deletion command argument builders
+
+// BuildStreamDeletionArgs builds arguments for XDELEX and XACKDEL commands, from `args`, the key and the group if any,
+// the deletion `policy`, omitted if empty, and the entry `ids`.
+func BuildStreamDeletionArgs(args []string, ids []string, policy constants.StreamDeletionPolicy) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("ids array cannot be empty")
+	}
+
+	switch policy {
+	case "":
+	case constants.KeepRefPolicy, constants.DelRefPolicy, constants.AckedPolicy:
+		args = append(args, string(policy))
+	default:
+		return nil, errors.New("invalid stream deletion policy")
+	}
+	args = append(args, constants.IdsKeyword, utils.IntToString(int64(len(ids))))
+	args = append(args, ids...)
+	return args, nil
+}
@@ -593,6 +593,19 @@ func ConvertRankAndScoreResponse(data any) (any, error) {
 	return models.RankAndScore{Rank: arr[0].(int64), Score: arr[1].(float64)}, nil
 }
 
+// XDelEx and XAckDel
+func ConvertStreamEntryDeletionStatuses(data any) (any, error) {
+	values, err := ConvertArrayOf[int64](data)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]models.StreamEntryDeletionStatus, 0, len(values.([]int64)))
+	for _, value := range values.([]int64) {
+		statuses = append(statuses, models.StreamEntryDeletionStatus(value))
+	}
+	return statuses, nil
+}
+
 // XInfoStream
 func ConvertXInfoStreamResponse(data any) (any, error) {
 	infoMap := data.(map[string]any)
@@ -0,0 +1,173 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package fakeclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Node is a node of a [ClusterClient].
+type Node struct {
+	// The offset of the clock of the node from the clock of the client.
+	ClockOffset time.Duration
+	// The error the commands routed to the node fail with, e.g. to fake an unreachable node.
+	Err error
+}
+
+// ClusterClient is an in-memory fake of a cluster client, for the commands about the nodes and the slots of the
+// cluster. It does not store keys.
+//
+// Calling the other commands panics, as with [Client]. The fields may be read and written directly by the tests, as
+// long as no command runs concurrently.
+type ClusterClient struct {
+	interfaces.GlideClusterClientCommands
+
+	mu    sync.Mutex
+	clock clock.Clock
+
+	// The nodes, by address.
+	Nodes map[string]*Node
+	// The slots returned by `CLUSTER KEYSLOT` for some keys, e.g. to fake a server hashing them unlike the client. The
+	// slots of the other keys are computed like in the client.
+	KeySlots map[string]int64
+	// The command infos returned by `COMMAND INFO`, by command name in lowercase.
+	CommandInfos map[string]models.CommandInfo
+	// The errors the commands fail with, by command name, as in [Client.Failures].
+	Failures map[string]error
+	// The names of the commands run, in order, including the failed ones.
+	Commands []string
+}
+
+// NewCluster creates a [ClusterClient] with a node for every address, "<host>:<port>", reading the current time from
+// the system clock.
+func NewCluster(addresses ...string) *ClusterClient {
+	f := &ClusterClient{
+		clock:        clock.System(),
+		Nodes:        map[string]*Node{},
+		KeySlots:     map[string]int64{},
+		CommandInfos: map[string]models.CommandInfo{},
+		Failures:     map[string]error{},
+	}
+	for _, address := range addresses {
+		f.Nodes[address] = &Node{}
+	}
+	return f
+}
+
+// WithClock sets the clock the times of the nodes are computed from.
+func (f *ClusterClient) WithClock(clk clock.Clock) *ClusterClient {
+	f.clock = clk
+	return f
+}
+
+// lock records `command` and locks the client to run it, unless the command is set to fail in
+// [ClusterClient.Failures].
+func (f *ClusterClient) lock(command string) error {
+	f.mu.Lock()
+	f.Commands = append(f.Commands, command)
+	if err := f.Failures[command]; err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// node returns the node `route` routes to, or the error of the node.
+func (f *ClusterClient) node(route config.Route) (*Node, error) {
+	byAddress, ok := route.(*config.ByAddressRoute)
+	if !ok {
+		panic(fmt.Sprintf("fakeclient: unsupported route %T", route))
+	}
+	node := f.Nodes[net.JoinHostPort(byAddress.Host, strconv.Itoa(int(byAddress.Port)))]
+	if node == nil {
+		return nil, errors.New("no node with the address of the route")
+	}
+	return node, node.Err
+}
+
+// ForEachNode calls `fn` for every node one at a time, in the order of their addresses, whatever the nodes selected by
+// `opts`. The errors of the failed nodes are joined.
+func (f *ClusterClient) ForEachNode(
+	ctx context.Context,
+	opts options.FanOutOptions,
+	fn func(ctx context.Context, address string, route config.Route) error,
+) error {
+	f.mu.Lock()
+	addresses := sortedKeys(f.Nodes)
+	f.mu.Unlock()
+	var errs []string
+	for _, address := range addresses {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			panic(fmt.Sprintf("fakeclient: invalid node address %q", address))
+		}
+		portNumber, _ := strconv.Atoi(port)
+		if err := fn(ctx, address, config.NewByAddressRoute(host, int32(portNumber))); err != nil {
+			errs = append(errs, address+": "+err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%d nodes failed: %s", len(errs), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// TimeWithOptions returns the time of the clock of the client shifted by the clock offset of the node. Only the routes
+// by address are supported.
+func (f *ClusterClient) TimeWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (models.ClusterValue[[]string], error) {
+	if err := f.lock("TIME"); err != nil {
+		return models.CreateEmptyClusterValue[[]string](), err
+	}
+	defer f.mu.Unlock()
+	node, err := f.node(routeOption.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]string](), err
+	}
+	now := f.clock.Now().Add(node.ClockOffset)
+	return models.CreateClusterSingleValue([]string{
+		strconv.FormatInt(now.Unix(), 10),
+		strconv.Itoa(now.Nanosecond() / int(time.Microsecond)),
+	}), nil
+}
+
+func (f *ClusterClient) CommandInfo(ctx context.Context, commandNames []string) (map[string]models.CommandInfo, error) {
+	if err := f.lock("COMMAND"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	infos := map[string]models.CommandInfo{}
+	for _, name := range commandNames {
+		if info, ok := f.CommandInfos[strings.ToLower(name)]; ok {
+			infos[name] = info
+		}
+	}
+	return infos, nil
+}
+
+func (f *ClusterClient) ClusterKeySlot(ctx context.Context, key string) (int64, error) {
+	if err := f.lock("CLUSTER"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if slot, ok := f.KeySlots[key]; ok {
+		return slot, nil
+	}
+	return int64(utils.KeySlot(key)), nil
+}
@@ -0,0 +1,294 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package fakeclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// hset sets the fields of the hash `key`, removing their expiry, and returns the number of fields added.
+func (f *Client) hset(key string, values map[string]string) int64 {
+	if f.Hashes[key] == nil {
+		f.Hashes[key] = map[string]string{}
+	}
+	var added int64
+	for field, value := range values {
+		if _, ok := f.Hashes[key][field]; !ok {
+			added++
+		}
+		f.Hashes[key][field] = value
+		delete(f.FieldExpiries[key], field)
+	}
+	return added
+}
+
+func (f *Client) HSet(ctx context.Context, key string, values map[string]string) (int64, error) {
+	if err := f.lock("HSET"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeHash); err != nil {
+		return 0, err
+	}
+	return f.hset(key, values), nil
+}
+
+func (f *Client) HSetEx(
+	ctx context.Context,
+	key string,
+	fieldsAndValues map[string]string,
+	opts options.HSetExOptions,
+) (int64, error) {
+	if err := f.lock("HSETEX"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeHash); err != nil {
+		return 0, err
+	}
+	for field := range fieldsAndValues {
+		_, exists := f.Hashes[key][field]
+		if exists && opts.ConditionalSet == constants.OnlyIfFieldsDoNotExist ||
+			!exists && opts.ConditionalSet == constants.OnlyIfAllFieldsExist {
+			return 0, nil
+		}
+	}
+	expiries := map[string]time.Time{}
+	if opts.Expiry != nil && opts.Expiry.Type == constants.KeepExisting {
+		for field := range fieldsAndValues {
+			if expireTime, ok := f.FieldExpiries[key][field]; ok {
+				expiries[field] = expireTime
+			}
+		}
+	} else if opts.Expiry != nil && opts.Expiry.Type != constants.Persist {
+		for field := range fieldsAndValues {
+			expiries[field] = f.expiry(opts.Expiry)
+		}
+	}
+	f.hset(key, fieldsAndValues)
+	if len(expiries) > 0 && f.FieldExpiries[key] == nil {
+		f.FieldExpiries[key] = map[string]time.Time{}
+	}
+	for field, expireTime := range expiries {
+		f.FieldExpiries[key][field] = expireTime
+	}
+	return 1, nil
+}
+
+func (f *Client) HGet(ctx context.Context, key string, field string) (models.Result[string], error) {
+	if err := f.lock("HGET"); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeHash); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	value, ok := f.Hashes[key][field]
+	if !ok {
+		return models.CreateNilStringResult(), nil
+	}
+	return models.CreateStringResult(value), nil
+}
+
+func (f *Client) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	if err := f.lock("HGETALL"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeHash); err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(f.Hashes[key]))
+	for field, value := range f.Hashes[key] {
+		values[field] = value
+	}
+	return values, nil
+}
+
+// HKeys returns the fields of the hash sorted, unlike the server.
+func (f *Client) HKeys(ctx context.Context, key string) ([]string, error) {
+	if err := f.lock("HKEYS"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeHash); err != nil {
+		return nil, err
+	}
+	return sortedKeys(f.Hashes[key]), nil
+}
+
+// HPExpire sets the expiry of the fields of the hash. The conditions of `opts` are not supported.
+func (f *Client) HPExpire(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	fields []string,
+	opts options.HExpireOptions,
+) ([]int64, error) {
+	if opts.ExpireCondition != "" {
+		panic("fakeclient: the conditions of HPEXPIRE are not supported")
+	}
+	if err := f.lock("HPEXPIRE"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeHash); err != nil {
+		return nil, err
+	}
+	results := make([]int64, len(fields))
+	for i, field := range fields {
+		if _, ok := f.Hashes[key][field]; !ok {
+			results[i] = -2
+			continue
+		}
+		if expireTime <= 0 {
+			delete(f.Hashes[key], field)
+			delete(f.FieldExpiries[key], field)
+			results[i] = 2
+			continue
+		}
+		if f.FieldExpiries[key] == nil {
+			f.FieldExpiries[key] = map[string]time.Time{}
+		}
+		f.FieldExpiries[key][field] = f.clock.Now().Add(expireTime)
+		results[i] = 1
+	}
+	if len(f.Hashes[key]) == 0 {
+		f.delete(key)
+	}
+	return results, nil
+}
+
+func (f *Client) LRem(ctx context.Context, key string, count int64, element string) (int64, error) {
+	if err := f.lock("LREM"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeList); err != nil {
+		return 0, err
+	}
+	list := f.Lists[key]
+	// A negative count removes the elements from the tail
+	indexes := make([]int, len(list))
+	for i := range indexes {
+		indexes[i] = i
+		if count < 0 {
+			indexes[i] = len(list) - 1 - i
+		}
+	}
+	removed := map[int]bool{}
+	for _, i := range indexes {
+		if list[i] == element && (count == 0 || int64(len(removed)) < max(count, -count)) {
+			removed[i] = true
+		}
+	}
+	var kept []string
+	for i, item := range list {
+		if !removed[i] {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) == 0 {
+		f.delete(key)
+	} else {
+		f.Lists[key] = kept
+	}
+	return int64(len(removed)), nil
+}
+
+func (f *Client) ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) (int64, error) {
+	if err := f.lock("ZADD"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeSortedSet); err != nil {
+		return 0, err
+	}
+	if f.SortedSets[key] == nil {
+		f.SortedSets[key] = map[string]float64{}
+	}
+	var added int64
+	for member, score := range membersScoreMap {
+		if _, ok := f.SortedSets[key][member]; !ok {
+			added++
+		}
+		f.SortedSets[key][member] = score
+	}
+	return added, nil
+}
+
+func (f *Client) ZCard(ctx context.Context, key string) (int64, error) {
+	if err := f.lock("ZCARD"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeSortedSet); err != nil {
+		return 0, err
+	}
+	return int64(len(f.SortedSets[key])), nil
+}
+
+func (f *Client) PfAdd(ctx context.Context, key string, elements []string) (bool, error) {
+	if err := f.lock("PFADD"); err != nil {
+		return false, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeHyperLogLog); err != nil {
+		return false, err
+	}
+	set, ok := f.HyperLogLogs[key]
+	if !ok {
+		set = map[string]struct{}{}
+		f.HyperLogLogs[key] = set
+	}
+	changed := !ok
+	for _, element := range elements {
+		if _, ok := set[element]; !ok {
+			set[element] = struct{}{}
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// PfCount returns the exact number of elements added to the HyperLogLogs.
+func (f *Client) PfCount(ctx context.Context, keys []string) (int64, error) {
+	if err := f.lock("PFCOUNT"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	union, err := f.pfUnion(keys)
+	return int64(len(union)), err
+}
+
+func (f *Client) PfMerge(ctx context.Context, destination string, sourceKeys []string) (string, error) {
+	if err := f.lock("PFMERGE"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	union, err := f.pfUnion(append([]string{destination}, sourceKeys...))
+	if err != nil {
+		return "", err
+	}
+	f.HyperLogLogs[destination] = union
+	return "OK", nil
+}
+
+// pfUnion returns the union of the elements added to the HyperLogLogs `keys`.
+func (f *Client) pfUnion(keys []string) (map[string]struct{}, error) {
+	union := map[string]struct{}{}
+	for _, key := range keys {
+		if err := f.checkType(key, typeHyperLogLog); err != nil {
+			return nil, err
+		}
+		for element := range f.HyperLogLogs[key] {
+			union[element] = struct{}{}
+		}
+	}
+	return union, nil
+}
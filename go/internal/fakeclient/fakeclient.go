@@ -0,0 +1,527 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package fakeclient provides an in-memory fake of a client, for the unit tests of the packages built on the commands of
+// the clients.
+package fakeclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc64"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+var errWrongType = errors.New("WRONGTYPE Operation against a key holding the wrong kind of value")
+
+// The types of the keys, as reported by `TYPE`. The HyperLogLogs are reported as strings, like by the server.
+const (
+	typeNone        = "none"
+	typeString      = "string"
+	typeHash        = "hash"
+	typeList        = "list"
+	typeSortedSet   = "zset"
+	typeStream      = "stream"
+	typeHyperLogLog = "hyperloglog"
+)
+
+// dumpFooterLength is the length of the footer of a `DUMP` serialization: the version of the RDB format, on 2 bytes, and
+// a checksum, on 8 bytes.
+const dumpFooterLength = 10
+
+// ScriptFunc runs a script invoked with `InvokeScript`, with the keys and the arguments it was invoked with.
+type ScriptFunc func(keys []string, args []string) (any, error)
+
+// Client is an in-memory fake of a standalone client. It stores strings, hashes, lists, sorted sets, HyperLogLogs and
+// streams, along with the expiry of the keys and of the fields of the hashes, and runs the scripts and the functions with
+// the functions set with [Client.WithScript] and [Client.WithFunction]. The HyperLogLogs are stored as exact sets. The
+// keys and the fields do not expire: their expiry is only recorded, relative to the clock of the client.
+//
+// Calling the other commands panics. The tests needing them, or recording the calls of some commands, embed the client
+// in their own fake. The fields may be read and written directly by the tests, as long as no command runs concurrently.
+type Client struct {
+	interfaces.GlideClientCommands
+
+	mu        sync.Mutex
+	clock     clock.Clock
+	script    ScriptFunc
+	functions map[string]ScriptFunc
+	// Held while a script runs, so that the scripts run one at a time.
+	scriptMu sync.Mutex
+
+	// The values of the strings, by key.
+	Strings map[string]string
+	// The fields and values of the hashes, by key.
+	Hashes map[string]map[string]string
+	// The elements of the lists, by key.
+	Lists map[string][]string
+	// The members and scores of the sorted sets, by key.
+	SortedSets map[string]map[string]float64
+	// The elements added to the HyperLogLogs, by key.
+	HyperLogLogs map[string]map[string]struct{}
+	// The streams, by key.
+	Streams map[string]*Stream
+	// The expiry of the keys, by key. The keys without expiry are missing.
+	Expiries map[string]time.Time
+	// The expiry of the fields of the hashes, by key and field. The fields without expiry are missing.
+	FieldExpiries map[string]map[string]time.Time
+	// The code of the libraries loaded with `FUNCTION LOAD`, by library name.
+	Libraries map[string]string
+	// The errors the commands fail with, by command name, e.g. "HSETEX" to fake a server not knowing the command. The
+	// name of a command with subcommands is the name of its container, e.g. "FUNCTION".
+	Failures map[string]error
+	// The names of the commands run, in order, including the failed ones.
+	Commands []string
+	// The version of the RDB format in the serializations returned by `DUMP`, on 2 bytes.
+	RDBVersion string
+}
+
+// New creates an empty [Client], reading the current time from the system clock.
+func New() *Client {
+	return &Client{
+		clock:         clock.System(),
+		Strings:       map[string]string{},
+		Hashes:        map[string]map[string]string{},
+		Lists:         map[string][]string{},
+		SortedSets:    map[string]map[string]float64{},
+		HyperLogLogs:  map[string]map[string]struct{}{},
+		Streams:       map[string]*Stream{},
+		Expiries:      map[string]time.Time{},
+		FieldExpiries: map[string]map[string]time.Time{},
+		Libraries:     map[string]string{},
+		Failures:      map[string]error{},
+		RDBVersion:    "\x0b\x00",
+	}
+}
+
+// WithClock sets the clock the expiries and the IDs of the stream entries are computed with.
+func (f *Client) WithClock(clk clock.Clock) *Client {
+	f.clock = clk
+	return f
+}
+
+// WithScript sets the function running the scripts. It is called without the lock of the client, so that it may call
+// the commands of the client: the scripts run one at a time, but concurrently with the other commands. Calling
+// `InvokeScript` without it panics.
+func (f *Client) WithScript(script ScriptFunc) *Client {
+	f.script = script
+	return f
+}
+
+// WithFunction sets the function running the server function `name` called with `FCALL` and `FCALL_RO`, once a library
+// registering it is loaded. It is called without the lock of the client, like the function set with
+// [Client.WithScript].
+func (f *Client) WithFunction(name string, function ScriptFunc) *Client {
+	if f.functions == nil {
+		f.functions = map[string]ScriptFunc{}
+	}
+	f.functions[name] = function
+	return f
+}
+
+// lock records `command` and locks the client to run it, unless the command is set to fail in [Client.Failures].
+func (f *Client) lock(command string) error {
+	f.mu.Lock()
+	f.Commands = append(f.Commands, command)
+	if err := f.Failures[command]; err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// keyType returns the type of `key`, [typeHyperLogLog] for the HyperLogLogs, or [typeNone] if it does not exist.
+func (f *Client) keyType(key string) string {
+	if _, ok := f.Strings[key]; ok {
+		return typeString
+	}
+	if _, ok := f.Hashes[key]; ok {
+		return typeHash
+	}
+	if _, ok := f.Lists[key]; ok {
+		return typeList
+	}
+	if _, ok := f.SortedSets[key]; ok {
+		return typeSortedSet
+	}
+	if _, ok := f.HyperLogLogs[key]; ok {
+		return typeHyperLogLog
+	}
+	if _, ok := f.Streams[key]; ok {
+		return typeStream
+	}
+	return typeNone
+}
+
+// checkType returns an error if `key` exists and is not of type `keyType`.
+func (f *Client) checkType(key string, keyType string) error {
+	if actual := f.keyType(key); actual != typeNone && actual != keyType {
+		return errWrongType
+	}
+	return nil
+}
+
+// delete deletes `key`, and returns whether it existed.
+func (f *Client) delete(key string) bool {
+	existed := f.keyType(key) != typeNone
+	delete(f.Strings, key)
+	delete(f.Hashes, key)
+	delete(f.Lists, key)
+	delete(f.SortedSets, key)
+	delete(f.HyperLogLogs, key)
+	delete(f.Streams, key)
+	delete(f.Expiries, key)
+	delete(f.FieldExpiries, key)
+	return existed
+}
+
+// expireAt sets the expiry of `key` to `expireTime`, or deletes it if the time is not in the future, and returns whether
+// it exists.
+func (f *Client) expireAt(key string, expireTime time.Time) bool {
+	if f.keyType(key) == typeNone {
+		return false
+	}
+	if !expireTime.After(f.clock.Now()) {
+		f.delete(key)
+		return true
+	}
+	f.Expiries[key] = expireTime
+	return true
+}
+
+// expiry returns the time `expiry` ends at.
+func (f *Client) expiry(expiry *options.Expiry) time.Time {
+	switch expiry.Type {
+	case constants.Seconds:
+		return f.clock.Now().Add(time.Duration(expiry.Duration) * time.Second)
+	case constants.Milliseconds:
+		return f.clock.Now().Add(time.Duration(expiry.Duration) * time.Millisecond)
+	case constants.UnixSeconds, constants.UnixMilliseconds:
+		return expiry.Timestamp
+	}
+	panic(fmt.Sprintf("fakeclient: unsupported expiry type %q", expiry.Type))
+}
+
+func (f *Client) Del(ctx context.Context, keys []string) (int64, error) {
+	if err := f.lock("DEL"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	var deleted int64
+	for _, key := range keys {
+		if f.delete(key) {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (f *Client) Unlink(ctx context.Context, keys []string) (int64, error) {
+	if err := f.lock("UNLINK"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	var deleted int64
+	for _, key := range keys {
+		if f.delete(key) {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (f *Client) Exists(ctx context.Context, keys []string) (int64, error) {
+	if err := f.lock("EXISTS"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	var exists int64
+	for _, key := range keys {
+		if f.keyType(key) != typeNone {
+			exists++
+		}
+	}
+	return exists, nil
+}
+
+func (f *Client) Type(ctx context.Context, key string) (string, error) {
+	if err := f.lock("TYPE"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	if keyType := f.keyType(key); keyType != typeHyperLogLog {
+		return keyType, nil
+	}
+	return typeString, nil
+}
+
+func (f *Client) PExpire(ctx context.Context, key string, expireTime time.Duration) (bool, error) {
+	if err := f.lock("PEXPIRE"); err != nil {
+		return false, err
+	}
+	defer f.mu.Unlock()
+	return f.expireAt(key, f.clock.Now().Add(expireTime)), nil
+}
+
+func (f *Client) PExpireAt(ctx context.Context, key string, expireTime time.Time) (bool, error) {
+	if err := f.lock("PEXPIREAT"); err != nil {
+		return false, err
+	}
+	defer f.mu.Unlock()
+	return f.expireAt(key, expireTime), nil
+}
+
+func (f *Client) PTTL(ctx context.Context, key string) (int64, error) {
+	if err := f.lock("PTTL"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if f.keyType(key) == typeNone {
+		return -2, nil
+	}
+	expireTime, ok := f.Expiries[key]
+	if !ok {
+		return -1, nil
+	}
+	return max(expireTime.Sub(f.clock.Now()).Milliseconds(), 0), nil
+}
+
+// ScanWithOptions returns the keys in lexicographic order, up to `COUNT` keys per page, 10 by default. The cursors are
+// the last key returned, hex-encoded, so that the keys deleted during a scan do not make it skip others.
+func (f *Client) ScanWithOptions(
+	ctx context.Context,
+	cursor models.Cursor,
+	opts options.ScanOptions,
+) (models.ScanResult, error) {
+	if err := f.lock("SCAN"); err != nil {
+		return models.ScanResult{}, err
+	}
+	defer f.mu.Unlock()
+	var after string
+	if cursor.GetCursor() != "0" {
+		last, err := hex.DecodeString(cursor.GetCursor())
+		if err != nil {
+			return models.ScanResult{}, errors.New("ERR invalid cursor")
+		}
+		after = string(last)
+	}
+	var matching []string
+	for _, key := range f.keys() {
+		if cursor.GetCursor() != "0" && key <= after {
+			continue
+		}
+		if opts.Match != "" {
+			if ok, _ := path.Match(opts.Match, key); !ok {
+				continue
+			}
+		}
+		if opts.Type != "" && f.keyType(key) != string(opts.Type) {
+			continue
+		}
+		matching = append(matching, key)
+	}
+	count := 10
+	if opts.Count > 0 {
+		count = int(opts.Count)
+	}
+	if len(matching) <= count {
+		return models.ScanResult{Cursor: models.NewCursorFromString("0"), Data: matching}, nil
+	}
+	next := hex.EncodeToString([]byte(matching[count-1]))
+	return models.ScanResult{Cursor: models.NewCursorFromString(next), Data: matching[:count]}, nil
+}
+
+func (f *Client) Scan(ctx context.Context, cursor models.Cursor) (models.ScanResult, error) {
+	return f.ScanWithOptions(ctx, cursor, *options.NewScanOptions())
+}
+
+// keys returns all the keys, sorted.
+func (f *Client) keys() []string {
+	var keys []string
+	for _, values := range []map[string]struct{}{
+		keySet(f.Strings),
+		keySet(f.Hashes),
+		keySet(f.Lists),
+		keySet(f.SortedSets),
+		keySet(f.HyperLogLogs),
+		keySet(f.Streams),
+	} {
+		for key := range values {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func keySet[V any](values map[string]V) map[string]struct{} {
+	keys := make(map[string]struct{}, len(values))
+	for key := range values {
+		keys[key] = struct{}{}
+	}
+	return keys
+}
+
+// dumped is the value of a key, as serialized by `DUMP`. The fields and the elements are sorted, so that equal values
+// have the same serialization.
+type dumped struct {
+	Type   string
+	String string
+	// The fields and values of a hash, alternated.
+	Fields []string
+	// The elements of a list, or the members of a sorted set or of a HyperLogLog.
+	Elements []string
+	// The scores of the members of a sorted set.
+	Scores  []float64
+	Entries []models.StreamEntry
+}
+
+// Dump serializes the value of the key with `encoding/gob`, followed by the RDB version of the client and a checksum,
+// as the server does.
+func (f *Client) Dump(ctx context.Context, key string) (models.Result[string], error) {
+	if err := f.lock("DUMP"); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer f.mu.Unlock()
+	value := dumped{Type: f.keyType(key)}
+	switch value.Type {
+	case typeNone:
+		return models.CreateNilStringResult(), nil
+	case typeString:
+		value.String = f.Strings[key]
+	case typeHash:
+		for _, field := range sortedKeys(f.Hashes[key]) {
+			value.Fields = append(value.Fields, field, f.Hashes[key][field])
+		}
+	case typeList:
+		value.Elements = f.Lists[key]
+	case typeSortedSet:
+		value.Elements = sortedKeys(f.SortedSets[key])
+		for _, member := range value.Elements {
+			value.Scores = append(value.Scores, f.SortedSets[key][member])
+		}
+	case typeHyperLogLog:
+		value.Elements = sortedKeys(f.HyperLogLogs[key])
+	case typeStream:
+		value.Entries = f.Streams[key].Entries
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	buf.WriteString(f.RDBVersion)
+	buf.Write(binary.LittleEndian.AppendUint64(nil, crc64.Checksum(buf.Bytes(), crc64.MakeTable(crc64.ECMA))))
+	return models.CreateStringResult(buf.String()), nil
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (f *Client) Restore(ctx context.Context, key string, ttl time.Duration, value string) (string, error) {
+	return f.RestoreWithOptions(ctx, key, ttl, value, *options.NewRestoreOptions())
+}
+
+// RestoreWithOptions restores a serialization returned by [Client.Dump], of any RDB version. The eviction options are
+// ignored.
+func (f *Client) RestoreWithOptions(
+	ctx context.Context,
+	key string,
+	ttl time.Duration,
+	value string,
+	opts options.RestoreOptions,
+) (string, error) {
+	if err := f.lock("RESTORE"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	errPayload := errors.New("ERR DUMP payload version or checksum are wrong")
+	if len(value) < dumpFooterLength {
+		return "", errPayload
+	}
+	// The checksum covers the serialization and the RDB version
+	checked := len(value) - dumpFooterLength + 2
+	checksum := binary.LittleEndian.Uint64([]byte(value[checked:]))
+	if checksum != crc64.Checksum([]byte(value[:checked]), crc64.MakeTable(crc64.ECMA)) {
+		return "", errPayload
+	}
+	var restored dumped
+	if err := gob.NewDecoder(strings.NewReader(value[:len(value)-dumpFooterLength])).Decode(&restored); err != nil {
+		return "", errPayload
+	}
+	if f.keyType(key) != typeNone && !opts.Replace {
+		return "", errors.New("BUSYKEY Target key name already exists.")
+	}
+	f.delete(key)
+	switch restored.Type {
+	case typeString:
+		f.Strings[key] = restored.String
+	case typeHash:
+		f.Hashes[key] = map[string]string{}
+		for i := 0; i+1 < len(restored.Fields); i += 2 {
+			f.Hashes[key][restored.Fields[i]] = restored.Fields[i+1]
+		}
+	case typeList:
+		f.Lists[key] = restored.Elements
+	case typeSortedSet:
+		f.SortedSets[key] = map[string]float64{}
+		for i, member := range restored.Elements {
+			f.SortedSets[key][member] = restored.Scores[i]
+		}
+	case typeHyperLogLog:
+		f.HyperLogLogs[key] = map[string]struct{}{}
+		for _, element := range restored.Elements {
+			f.HyperLogLogs[key][element] = struct{}{}
+		}
+	case typeStream:
+		f.Streams[key] = &Stream{Entries: restored.Entries}
+	}
+	if ttl > 0 && opts.AbsTTL {
+		f.expireAt(key, time.UnixMilli(ttl.Milliseconds()))
+	} else if ttl > 0 {
+		f.expireAt(key, f.clock.Now().Add(ttl))
+	}
+	return "OK", nil
+}
+
+func (f *Client) InvokeScript(ctx context.Context, script options.Script) (any, error) {
+	return f.InvokeScriptWithOptions(ctx, script, *options.NewScriptOptions())
+}
+
+// InvokeScriptWithOptions runs the script with the function set with [Client.WithScript], whatever the script is.
+func (f *Client) InvokeScriptWithOptions(
+	ctx context.Context,
+	script options.Script,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	if err := f.lock("EVALSHA"); err != nil {
+		return nil, err
+	}
+	run := f.script
+	f.mu.Unlock()
+	if run == nil {
+		panic("fakeclient: no function running the scripts, see WithScript")
+	}
+	f.scriptMu.Lock()
+	defer f.scriptMu.Unlock()
+	return run(scriptOptions.Keys, scriptOptions.Args)
+}
@@ -0,0 +1,336 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package fakeclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func TestClient_TypesAndFailures(t *testing.T) {
+	ctx := context.Background()
+	client := New()
+	_, err := client.HSet(ctx, "hash", map[string]string{"field": "value"})
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, "hash")
+	assert.ErrorContains(t, err, "WRONGTYPE")
+	keyType, err := client.Type(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, "hash", keyType)
+	_, err = client.PfAdd(ctx, "hll", []string{"a"})
+	require.NoError(t, err)
+	keyType, err = client.Type(ctx, "hll")
+	require.NoError(t, err)
+	assert.Equal(t, "string", keyType)
+
+	// SET replaces a value of any type
+	_, err = client.Set(ctx, "hash", "value")
+	require.NoError(t, err)
+	assert.NotContains(t, client.Hashes, "hash")
+
+	client.Failures["GET"] = errors.New("ERR unknown command 'GET'")
+	_, err = client.Get(ctx, "hash")
+	assert.ErrorContains(t, err, "unknown command")
+}
+
+func TestClient_Expiry(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	client := New().WithClock(fake)
+	_, err := client.Set(ctx, "key", "value")
+	require.NoError(t, err)
+
+	set, err := client.PExpire(ctx, "key", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, set)
+	ttl, err := client.PTTL(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, int64(60_000), ttl)
+
+	// The keys do not expire, but an expiry in the past deletes them
+	fake.Advance(time.Hour)
+	assert.Contains(t, client.Strings, "key")
+	set, err = client.PExpireAt(ctx, "key", fake.Now().Add(-time.Second))
+	require.NoError(t, err)
+	assert.True(t, set)
+	ttl, err = client.PTTL(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), ttl)
+}
+
+func TestClient_ScanWhileDeleting(t *testing.T) {
+	ctx := context.Background()
+	client := New()
+	for i := 0; i < 25; i++ {
+		client.Strings[fmt.Sprintf("key:%02d", i)] = "value"
+	}
+	client.Lists["list"] = []string{"a"}
+
+	var scanned []string
+	cursor := models.NewCursor()
+	for {
+		result, err := client.ScanWithOptions(ctx, cursor, *options.NewScanOptions().SetMatch("key:*").SetCount(10))
+		require.NoError(t, err)
+		scanned = append(scanned, result.Data...)
+		_, err = client.Del(ctx, result.Data)
+		require.NoError(t, err)
+		cursor = result.Cursor
+		if cursor.IsFinished() {
+			break
+		}
+	}
+	assert.Len(t, scanned, 25)
+	assert.Empty(t, client.Strings)
+}
+
+func TestClient_DumpAndRestore(t *testing.T) {
+	ctx := context.Background()
+	source := New()
+	source.Strings["string"] = "\x00binary\xff"
+	source.Hashes["hash"] = map[string]string{"a": "1", "b": "2"}
+	source.SortedSets["zset"] = map[string]float64{"a": 1.5}
+	destination := New()
+	destination.RDBVersion = "\x0c\x00"
+
+	for _, key := range []string{"string", "hash", "zset"} {
+		dumped, err := source.Dump(ctx, key)
+		require.NoError(t, err)
+		_, err = destination.Restore(ctx, key, 0, dumped.Value())
+		require.NoError(t, err)
+		_, err = destination.Restore(ctx, key, 0, dumped.Value())
+		assert.ErrorContains(t, err, "BUSYKEY")
+
+		// The serializations of equal values only differ by their footer
+		redumped, err := destination.Dump(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, dumped.Value()[:len(dumped.Value())-8-2], redumped.Value()[:len(redumped.Value())-8-2])
+	}
+	assert.Equal(t, source.Strings, destination.Strings)
+	assert.Equal(t, source.Hashes, destination.Hashes)
+	assert.Equal(t, source.SortedSets, destination.SortedSets)
+
+	_, err := destination.Restore(ctx, "corrupted", 0, "not a serialization")
+	assert.ErrorContains(t, err, "checksum")
+}
+
+func TestClient_Streams(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.UnixMilli(1000))
+	client := New().WithClock(fake)
+	for i := 0; i < 3; i++ {
+		_, err := client.XAdd(ctx, "stream", []models.FieldValue{{Field: "i", Value: fmt.Sprint(i)}})
+		require.NoError(t, err)
+	}
+	fake.Advance(time.Millisecond)
+	id, err := client.XAdd(ctx, "stream", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "1001-0", id)
+
+	entries, err := client.XRangeWithOptions(
+		ctx,
+		"stream",
+		options.NewStreamBoundary("1000-0", false),
+		options.NewStreamBoundary("1001", true),
+		*options.NewXRangeOptions(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1000-1", "1000-2", "1001-0"}, streamIDs(entries))
+
+	_, err = client.XGroupCreateWithOptions(ctx, "stream", "group", "0", *options.NewXGroupCreateOptions())
+	require.NoError(t, err)
+	read, err := client.XReadGroupWithOptions(
+		ctx,
+		"group",
+		"consumer-1",
+		map[string]string{"stream": ">"},
+		*options.NewXReadGroupOptions().SetCount(2),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1000-0", "1000-1"}, streamIDs(read["stream"].Entries))
+
+	// The entries trimmed while pending are reported as deleted when claimed
+	trimmed, err := client.XTrim(ctx, "stream", *options.NewXTrimOptionsWithMinId("1000-1"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), trimmed)
+	fake.Advance(time.Minute)
+	claimed, err := client.XAutoClaimWithOptions(ctx, "stream", "group", "consumer-2", time.Minute, "0-0",
+		*options.NewXAutoClaimOptions())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1000-1"}, streamIDs(claimed.ClaimedEntries))
+	assert.Equal(t, []string{"1000-0"}, claimed.DeletedMessages)
+	assert.Equal(t, "consumer-2", client.Streams["stream"].Groups["group"].Pending["1000-1"].Consumer)
+
+	acked, err := client.XAck(ctx, "stream", "group", []string{"1000-1", "1000-2"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), acked)
+
+	// The IDs keep increasing once the last entries are trimmed
+	_, err = client.XTrim(ctx, "stream", *options.NewXTrimOptionsWithMaxLen(0))
+	require.NoError(t, err)
+	fake.Set(time.UnixMilli(0))
+	id, err = client.XAdd(ctx, "stream", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "1001-1", id)
+}
+
+func TestClient_StreamInfo(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.UnixMilli(1000))
+	client := New().WithClock(fake)
+	for i := 0; i < 3; i++ {
+		_, err := client.XAdd(ctx, "stream", nil)
+		require.NoError(t, err)
+	}
+	_, err := client.XGroupCreateWithOptions(ctx, "stream", "group", "0", *options.NewXGroupCreateOptions())
+	require.NoError(t, err)
+	_, err = client.XGroupCreateWithOptions(ctx, "stream", "late", "1000-1", *options.NewXGroupCreateOptions())
+	require.NoError(t, err)
+	_, err = client.XReadGroupWithOptions(ctx, "group", "consumer-1", map[string]string{"stream": ">"},
+		*options.NewXReadGroupOptions().SetCount(2))
+	require.NoError(t, err)
+	fake.Advance(time.Second)
+	_, err = client.XReadGroupWithOptions(ctx, "group", "consumer-2", map[string]string{"stream": "0"},
+		*options.NewXReadGroupOptions())
+	require.NoError(t, err)
+
+	groups, err := client.XInfoGroups(ctx, "stream")
+	require.NoError(t, err)
+	assert.Equal(t, []models.XInfoGroupInfo{
+		{
+			Name:            "group",
+			Consumers:       2,
+			Pending:         2,
+			LastDeliveredId: "1000-1",
+			EntriesRead:     models.CreateInt64Result(2),
+			Lag:             models.CreateInt64Result(1),
+		},
+		{
+			Name:            "late",
+			LastDeliveredId: "1000-1",
+			EntriesRead:     models.CreateNilInt64Result(),
+			Lag:             models.CreateNilInt64Result(),
+		},
+	}, groups)
+	consumers, err := client.XInfoConsumers(ctx, "stream", "group")
+	require.NoError(t, err)
+	assert.Equal(t, []models.XInfoConsumerInfo{
+		{Name: "consumer-1", Pending: 2, Idle: 1000, Inactive: models.CreateInt64Result(1000)},
+		{Name: "consumer-2", Inactive: models.CreateNilInt64Result()},
+	}, consumers)
+
+	_, err = client.XInfoGroups(ctx, "missing")
+	assert.ErrorContains(t, err, "no such key")
+	_, err = client.XInfoConsumers(ctx, "stream", "missing")
+	assert.ErrorContains(t, err, "NOGROUP")
+}
+
+func TestClient_Geo(t *testing.T) {
+	ctx := context.Background()
+	client := New()
+	added, err := client.GeoAdd(ctx, "places", map[string]options.GeospatialData{
+		"palermo": {Longitude: 13.361389, Latitude: 38.115556},
+		"catania": {Longitude: 15.087269, Latitude: 37.502669},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), added)
+	keyType, err := client.Type(ctx, "places")
+	require.NoError(t, err)
+	assert.Equal(t, "zset", keyType)
+
+	// The distances are the ones of the example of the documentation of GEOSEARCH
+	locations, err := client.GeoSearchWithFullOptions(
+		ctx,
+		"places",
+		&options.GeoCoordOrigin{GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37}},
+		*options.NewCircleSearchShape(200, constants.GeoUnitKilometers),
+		*options.NewGeoSearchResultOptions().SetSortOrder(options.DESC),
+		*options.NewGeoSearchInfoOptions().SetWithDist(true).SetWithCoord(true),
+	)
+	require.NoError(t, err)
+	require.Len(t, locations, 2)
+	assert.Equal(t, "palermo", locations[0].Name)
+	assert.InDelta(t, 190.4424, locations[0].Dist, 1e-3)
+	assert.InDelta(t, 38.115556, locations[0].Coord.Latitude, 1e-5)
+	assert.Equal(t, "catania", locations[1].Name)
+	assert.InDelta(t, 56.4413, locations[1].Dist, 1e-3)
+
+	locations, err = client.GeoSearchWithFullOptions(
+		ctx,
+		"places",
+		&options.GeoMemberOrigin{Member: "palermo"},
+		*options.NewBoxSearchShape(400, 400, constants.GeoUnitKilometers),
+		*options.NewGeoSearchResultOptions().SetCount(1),
+		*options.NewGeoSearchInfoOptions(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []options.Location{{Name: "palermo"}}, locations)
+}
+
+func TestClient_Functions(t *testing.T) {
+	ctx := context.Background()
+	client := New().WithFunction("echo", func(keys []string, args []string) (any, error) { return args[0], nil })
+	_, err := client.FCallWithKeysAndArgs(ctx, "echo", nil, []string{"hello"})
+	assert.ErrorContains(t, err, "Function not found")
+
+	code := "#!lua name=lib\nserver.register_function{function_name='echo', callback=function(keys, args) end}"
+	name, err := client.FunctionLoad(ctx, code, false)
+	require.NoError(t, err)
+	assert.Equal(t, "lib", name)
+	_, err = client.FunctionLoad(ctx, code, false)
+	assert.ErrorContains(t, err, "already exists")
+	result, err := client.FCallReadOnlyWithKeysAndArgs(ctx, "echo", nil, []string{"hello"})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", result)
+	assert.Equal(t, []string{"FCALL", "FUNCTION", "FUNCTION", "FCALL_RO"}, client.Commands)
+}
+
+func TestClusterClient(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Unix(1_700_000_000, 250_000_000))
+	client := NewCluster("127.0.0.1:7000", "127.0.0.1:7001").WithClock(fake)
+	client.Nodes["127.0.0.1:7000"].ClockOffset = time.Second
+	client.Nodes["127.0.0.1:7001"].Err = errors.New("connection refused")
+
+	times := map[string][]string{}
+	timeOf := func(ctx context.Context, address string, route config.Route) error {
+		reply, err := client.TimeWithOptions(ctx, options.RouteOption{Route: route})
+		times[address] = reply.SingleValue()
+		return err
+	}
+	err := client.ForEachNode(ctx, *options.NewFanOutOptions(), timeOf)
+	assert.ErrorContains(t, err, "127.0.0.1:7001: connection refused")
+	assert.Equal(t, []string{"1700000001", "250000"}, times["127.0.0.1:7000"])
+
+	client.KeySlots["{user}:2"] = 42
+	slot, err := client.ClusterKeySlot(ctx, "{user}:2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), slot)
+	slot, err = client.ClusterKeySlot(ctx, "{user}:1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5474), slot)
+
+	client.CommandInfos["get"] = models.CommandInfo{Name: "get", FirstKey: 1, LastKey: 1, Step: 1}
+	infos, err := client.CommandInfo(ctx, []string{"GET", "unknown"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]models.CommandInfo{"GET": client.CommandInfos["get"]}, infos)
+}
+
+func streamIDs(entries []models.StreamEntry) []string {
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+	return ids
+}
@@ -0,0 +1,72 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package fakeclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var (
+	libraryNamePattern = regexp.MustCompile(`^#!lua name=(\S+)`)
+	// Matches the registrations of the functions, either `register_function('name', ...)` or
+	// `register_function{function_name='name', ...}`.
+	registrationPattern = regexp.MustCompile(`register_function\s*[({]\s*(?:function_name\s*=\s*)?['"]([^'"]+)['"]`)
+)
+
+// FunctionLoad loads the library, reading its name from its shebang and the names of its functions from their
+// registrations. The code is not run.
+func (f *Client) FunctionLoad(ctx context.Context, libraryCode string, replace bool) (string, error) {
+	if err := f.lock("FUNCTION"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	match := libraryNamePattern.FindStringSubmatch(libraryCode)
+	if match == nil {
+		return "", errors.New("ERR Missing library metadata")
+	}
+	if _, ok := f.Libraries[match[1]]; ok && !replace {
+		return "", fmt.Errorf("ERR Library '%s' already exists", match[1])
+	}
+	f.Libraries[match[1]] = libraryCode
+	return match[1], nil
+}
+
+func (f *Client) FCallWithKeysAndArgs(ctx context.Context, function string, keys []string, args []string) (any, error) {
+	return f.fcall("FCALL", function, keys, args)
+}
+
+func (f *Client) FCallReadOnlyWithKeysAndArgs(
+	ctx context.Context,
+	function string,
+	keys []string,
+	args []string,
+) (any, error) {
+	return f.fcall("FCALL_RO", function, keys, args)
+}
+
+// fcall runs `function` with the function set with [Client.WithFunction], if a loaded library registers it.
+func (f *Client) fcall(command string, function string, keys []string, args []string) (any, error) {
+	if err := f.lock(command); err != nil {
+		return nil, err
+	}
+	registered := false
+	for _, code := range f.Libraries {
+		for _, match := range registrationPattern.FindAllStringSubmatch(code, -1) {
+			registered = registered || match[1] == function
+		}
+	}
+	run := f.functions[function]
+	f.mu.Unlock()
+	if !registered {
+		return nil, errors.New("ERR Function not found")
+	}
+	if run == nil {
+		panic(fmt.Sprintf("fakeclient: no function running %q, see WithFunction", function))
+	}
+	f.scriptMu.Lock()
+	defer f.scriptMu.Unlock()
+	return run(keys, args)
+}
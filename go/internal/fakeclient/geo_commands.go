@@ -0,0 +1,181 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package fakeclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// The limits of the coordinates, and the precision of their geohashes, as in the server.
+const (
+	geoLatitudeLimit  = 85.05112878
+	geoLongitudeLimit = 180
+	geoStep           = 26
+	// The radius of the Earth used to compute the distances, in meters.
+	earthRadius = 6372797.560856
+)
+
+// geohash encodes `location` into the score of its member, interleaving the bits of its latitude, at the even positions,
+// and of its longitude.
+func geohash(location options.GeospatialData) float64 {
+	latitude := uint64((location.Latitude + geoLatitudeLimit) / (2 * geoLatitudeLimit) * (1 << geoStep))
+	longitude := uint64((location.Longitude + geoLongitudeLimit) / (2 * geoLongitudeLimit) * (1 << geoStep))
+	var hash uint64
+	for i := 0; i < geoStep; i++ {
+		hash |= (latitude>>i&1)<<(2*i) | (longitude>>i&1)<<(2*i+1)
+	}
+	return float64(hash)
+}
+
+// geodecode decodes the score of a member into the center of the cell of its geohash.
+func geodecode(score float64) options.GeospatialData {
+	hash := uint64(score)
+	var latitude, longitude uint64
+	for i := 0; i < geoStep; i++ {
+		latitude |= (hash >> (2 * i) & 1) << i
+		longitude |= (hash >> (2*i + 1) & 1) << i
+	}
+	return options.GeospatialData{
+		Latitude:  (float64(latitude)+0.5)/(1<<geoStep)*2*geoLatitudeLimit - geoLatitudeLimit,
+		Longitude: (float64(longitude)+0.5)/(1<<geoStep)*2*geoLongitudeLimit - geoLongitudeLimit,
+	}
+}
+
+// geoDistance returns the distance between two locations in meters, with the haversine formula.
+func geoDistance(from options.GeospatialData, to options.GeospatialData) float64 {
+	radians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	u := math.Sin((radians(to.Latitude) - radians(from.Latitude)) / 2)
+	v := math.Sin((radians(to.Longitude) - radians(from.Longitude)) / 2)
+	a := u*u + math.Cos(radians(from.Latitude))*math.Cos(radians(to.Latitude))*v*v
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+// geoUnit returns the number of meters in `unit`.
+func geoUnit(unit constants.GeoUnit) float64 {
+	switch unit {
+	case constants.GeoUnitKilometers:
+		return 1000
+	case constants.GeoUnitMiles:
+		return 1609.34
+	case constants.GeoUnitFeet:
+		return 0.3048
+	}
+	return 1
+}
+
+// GeoAdd stores the locations in a sorted set, scored by their geohash like in the server.
+func (f *Client) GeoAdd(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+) (int64, error) {
+	if err := f.lock("GEOADD"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeSortedSet); err != nil {
+		return 0, err
+	}
+	for _, location := range membersToGeospatialData {
+		if math.Abs(location.Latitude) > geoLatitudeLimit || math.Abs(location.Longitude) > geoLongitudeLimit {
+			return 0, fmt.Errorf("ERR invalid longitude,latitude pair %f,%f", location.Longitude, location.Latitude)
+		}
+	}
+	if f.SortedSets[key] == nil {
+		f.SortedSets[key] = map[string]float64{}
+	}
+	var added int64
+	for member, location := range membersToGeospatialData {
+		if _, ok := f.SortedSets[key][member]; !ok {
+			added++
+		}
+		f.SortedSets[key][member] = geohash(location)
+	}
+	return added, nil
+}
+
+// GeoSearchWithFullOptions searches the members of the sorted set by their distance to the origin, computed from the
+// centers of the cells of their geohashes like in the server. The distances are not rounded.
+func (f *Client) GeoSearchWithFullOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	if err := f.lock("GEOSEARCH"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeSortedSet); err != nil {
+		return nil, err
+	}
+	var origin options.GeospatialData
+	switch from := searchFrom.(type) {
+	case *options.GeoCoordOrigin:
+		origin = from.GeospatialData
+	case *options.GeoMemberOrigin:
+		score, ok := f.SortedSets[key][from.Member]
+		if !ok {
+			return nil, errors.New("ERR could not decode requested zset member")
+		}
+		origin = geodecode(score)
+	default:
+		panic(fmt.Sprintf("fakeclient: unsupported GEOSEARCH origin %T", searchFrom))
+	}
+	unit := geoUnit(searchByShape.Unit)
+	var locations []options.Location
+	for _, member := range sortedKeys(f.SortedSets[key]) {
+		score := f.SortedSets[key][member]
+		coord := geodecode(score)
+		distance := geoDistance(origin, coord)
+		switch searchByShape.Shape {
+		case constants.BYRADIUS:
+			if distance > searchByShape.Radius*unit {
+				continue
+			}
+		case constants.BYBOX:
+			height := geoDistance(origin, options.GeospatialData{Latitude: coord.Latitude, Longitude: origin.Longitude})
+			width := geoDistance(options.GeospatialData{Latitude: coord.Latitude, Longitude: origin.Longitude}, coord)
+			if height > searchByShape.Height*unit/2 || width > searchByShape.Width*unit/2 {
+				continue
+			}
+		}
+		location := options.Location{Name: member, Dist: distance / unit}
+		if infoOptions.WithCoord {
+			location.Coord = coord
+		}
+		if infoOptions.WithHash {
+			location.Hash = int64(score)
+		}
+		locations = append(locations, location)
+		if resultOptions.IsAny && int64(len(locations)) == resultOptions.Count {
+			break
+		}
+	}
+	if resultOptions.SortOrder != "" || resultOptions.Count > 0 && !resultOptions.IsAny {
+		sort.SliceStable(locations, func(i, j int) bool {
+			if resultOptions.SortOrder == options.DESC {
+				return locations[i].Dist > locations[j].Dist
+			}
+			return locations[i].Dist < locations[j].Dist
+		})
+	}
+	if resultOptions.Count > 0 && int64(len(locations)) > resultOptions.Count {
+		locations = locations[:resultOptions.Count]
+	}
+	if !infoOptions.WithDist {
+		for i := range locations {
+			locations[i].Dist = 0
+		}
+	}
+	return locations, nil
+}
@@ -0,0 +1,531 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package fakeclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Stream is a stream of a [Client]. The IDs of its entries are "<milliseconds>-<sequence number>".
+type Stream struct {
+	// The entries, in ascending order of ID.
+	Entries []models.StreamEntry
+	// The consumer groups, by name.
+	Groups map[string]*Group
+	// The ID of the last entry added, which may have been trimmed since, or empty to use the ID of the last entry.
+	LastID string
+}
+
+// Group is a consumer group of a [Stream].
+type Group struct {
+	// The ID of the last entry handed out by `XREADGROUP`.
+	LastDeliveredID string
+	// The number of entries handed out by `XREADGROUP`, or -1 if it is unknown, as for the groups created at an ID other
+	// than "0" and "$".
+	EntriesRead int64
+	// The entries handed out and not acknowledged yet, by ID.
+	Pending map[string]PendingEntry
+	// The consumers which read or claimed entries, by name.
+	Consumers map[string]*Consumer
+}
+
+// Consumer is a consumer of a [Group].
+type Consumer struct {
+	// The time of the last read or claim of the consumer.
+	SeenAt time.Time
+	// The time of the last read or claim handing out entries to the consumer, or zero if none did.
+	ActiveAt time.Time
+}
+
+// PendingEntry is an entry handed out to a consumer and not acknowledged yet.
+type PendingEntry struct {
+	Consumer    string
+	DeliveredAt time.Time
+}
+
+// streamID is the ID of a stream entry.
+type streamID struct {
+	milliseconds uint64
+	sequence     uint64
+}
+
+// parseStreamID parses the ID `id`, whose sequence number is `sequence` if it is omitted.
+func parseStreamID(id string, sequence uint64) (streamID, error) {
+	milliseconds, sequenceNumber, found := strings.Cut(id, "-")
+	var parsed streamID
+	var err error
+	if parsed.milliseconds, err = strconv.ParseUint(milliseconds, 10, 64); err != nil {
+		return streamID{}, errors.New("ERR Invalid stream ID specified as stream command argument")
+	}
+	parsed.sequence = sequence
+	if found {
+		if parsed.sequence, err = strconv.ParseUint(sequenceNumber, 10, 64); err != nil {
+			return streamID{}, errors.New("ERR Invalid stream ID specified as stream command argument")
+		}
+	}
+	return parsed, nil
+}
+
+// mustParseStreamID parses the ID of an entry stored by the client.
+func mustParseStreamID(id string) streamID {
+	parsed, err := parseStreamID(id, 0)
+	if err != nil {
+		panic(fmt.Sprintf("fakeclient: invalid stream ID %q", id))
+	}
+	return parsed
+}
+
+func (id streamID) less(other streamID) bool {
+	return id.milliseconds < other.milliseconds || id.milliseconds == other.milliseconds && id.sequence < other.sequence
+}
+
+func (id streamID) String() string {
+	return fmt.Sprintf("%d-%d", id.milliseconds, id.sequence)
+}
+
+// lastID returns the ID of the last entry added to the stream.
+func (s *Stream) lastID() streamID {
+	var last streamID
+	if s.LastID != "" {
+		last = mustParseStreamID(s.LastID)
+	}
+	if len(s.Entries) > 0 {
+		if id := mustParseStreamID(s.Entries[len(s.Entries)-1].ID); last.less(id) {
+			last = id
+		}
+	}
+	return last
+}
+
+// stream returns the stream `key`, or nil if it does not exist.
+func (f *Client) stream(key string) (*Stream, error) {
+	if err := f.checkType(key, typeStream); err != nil {
+		return nil, err
+	}
+	return f.Streams[key], nil
+}
+
+// XAdd adds an entry with an ID generated from the clock of the client.
+func (f *Client) XAdd(ctx context.Context, key string, values []models.FieldValue) (string, error) {
+	if err := f.lock("XADD"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	stream, err := f.stream(key)
+	if err != nil {
+		return "", err
+	}
+	if stream == nil {
+		stream = &Stream{}
+		f.Streams[key] = stream
+	}
+	id := streamID{milliseconds: uint64(f.clock.Now().UnixMilli())}
+	if last := stream.lastID(); !last.less(id) {
+		id = streamID{milliseconds: last.milliseconds, sequence: last.sequence + 1}
+	}
+	stream.Entries = append(stream.Entries, models.StreamEntry{ID: id.String(), Fields: values})
+	stream.LastID = id.String()
+	return id.String(), nil
+}
+
+func (f *Client) XLen(ctx context.Context, key string) (int64, error) {
+	if err := f.lock("XLEN"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	stream, err := f.stream(key)
+	if err != nil || stream == nil {
+		return 0, err
+	}
+	return int64(len(stream.Entries)), nil
+}
+
+// inRange returns whether `id` is within `boundary`, the start of a range if `start` is set or else its end, with the
+// syntax of `XRANGE`.
+func inRange(id streamID, boundary options.StreamBoundary, start bool) (bool, error) {
+	if boundary == "-" || boundary == "+" {
+		return true, nil
+	}
+	value, exclusive := strings.CutPrefix(string(boundary), "(")
+	sequence := uint64(0)
+	if !start {
+		sequence = math.MaxUint64
+	}
+	bound, err := parseStreamID(value, sequence)
+	if err != nil {
+		return false, err
+	}
+	if start {
+		return bound.less(id) || !exclusive && bound == id, nil
+	}
+	return id.less(bound) || !exclusive && bound == id, nil
+}
+
+func (f *Client) XRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+) ([]models.StreamEntry, error) {
+	if err := f.lock("XRANGE"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	stream, err := f.stream(key)
+	if err != nil || stream == nil {
+		return nil, err
+	}
+	var entries []models.StreamEntry
+	for _, entry := range stream.Entries {
+		if opts.Count > 0 && len(entries) == int(opts.Count) {
+			break
+		}
+		id := mustParseStreamID(entry.ID)
+		afterStart, err := inRange(id, start, true)
+		if err != nil {
+			return nil, err
+		}
+		beforeEnd, err := inRange(id, end, false)
+		if err != nil {
+			return nil, err
+		}
+		if afterStart && beforeEnd {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// XTrim trims the stream exactly, even if `opts` allows an approximate trim.
+func (f *Client) XTrim(ctx context.Context, key string, opts options.XTrimOptions) (int64, error) {
+	if err := f.lock("XTRIM"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	stream, err := f.stream(key)
+	if err != nil || stream == nil {
+		return 0, err
+	}
+	trimmed := 0
+	if opts.Method == "MINID" {
+		threshold, err := parseStreamID(opts.Threshold, 0)
+		if err != nil {
+			return 0, err
+		}
+		for trimmed < len(stream.Entries) && mustParseStreamID(stream.Entries[trimmed].ID).less(threshold) {
+			trimmed++
+		}
+	} else {
+		maxLen, err := strconv.Atoi(opts.Threshold)
+		if err != nil {
+			return 0, errors.New("ERR value is not an integer or out of range")
+		}
+		trimmed = max(len(stream.Entries)-maxLen, 0)
+	}
+	stream.LastID = stream.lastID().String()
+	stream.Entries = stream.Entries[trimmed:]
+	return int64(trimmed), nil
+}
+
+func (f *Client) XGroupCreateWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	id string,
+	opts options.XGroupCreateOptions,
+) (string, error) {
+	if err := f.lock("XGROUP"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	stream, err := f.stream(key)
+	if err != nil {
+		return "", err
+	}
+	if stream == nil && !opts.MkStream {
+		return "", errors.New(
+			"ERR The XGROUP subcommand requires the key to exist. Note that for CREATE you may want to use the MKSTREAM " +
+				"option to create an empty stream automatically.",
+		)
+	}
+	if stream == nil {
+		stream = &Stream{}
+		f.Streams[key] = stream
+	}
+	if _, ok := stream.Groups[group]; ok {
+		return "", errors.New("BUSYGROUP Consumer Group name already exists")
+	}
+	entriesRead := int64(-1)
+	switch id {
+	case "0", "0-0":
+		entriesRead = 0
+	case "$":
+		id = stream.lastID().String()
+		entriesRead = int64(len(stream.Entries))
+	}
+	if stream.Groups == nil {
+		stream.Groups = map[string]*Group{}
+	}
+	stream.Groups[group] = &Group{
+		LastDeliveredID: id,
+		EntriesRead:     entriesRead,
+		Pending:         map[string]PendingEntry{},
+		Consumers:       map[string]*Consumer{},
+	}
+	return "OK", nil
+}
+
+// XInfoGroups reports the lag of the groups whose number of entries read is known, counting the entries after the last
+// entry handed out.
+func (f *Client) XInfoGroups(ctx context.Context, key string) ([]models.XInfoGroupInfo, error) {
+	if err := f.lock("XINFO"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	stream, err := f.stream(key)
+	if err != nil {
+		return nil, err
+	}
+	if stream == nil {
+		return nil, errors.New("ERR no such key")
+	}
+	infos := []models.XInfoGroupInfo{}
+	for _, name := range sortedKeys(stream.Groups) {
+		g := stream.Groups[name]
+		info := models.XInfoGroupInfo{
+			Name:            name,
+			Consumers:       int64(len(g.consumerNames())),
+			Pending:         int64(len(g.Pending)),
+			LastDeliveredId: g.LastDeliveredID,
+			EntriesRead:     models.CreateNilInt64Result(),
+			Lag:             models.CreateNilInt64Result(),
+		}
+		if g.EntriesRead >= 0 {
+			lastDelivered := mustParseStreamID(g.LastDeliveredID)
+			var lag int64
+			for _, entry := range stream.Entries {
+				if lastDelivered.less(mustParseStreamID(entry.ID)) {
+					lag++
+				}
+			}
+			info.EntriesRead = models.CreateInt64Result(g.EntriesRead)
+			info.Lag = models.CreateInt64Result(lag)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (f *Client) XInfoConsumers(ctx context.Context, key string, group string) ([]models.XInfoConsumerInfo, error) {
+	if err := f.lock("XINFO"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	_, g, err := f.group(key, group, "XINFO CONSUMERS")
+	if err != nil {
+		return nil, err
+	}
+	now := f.clock.Now()
+	infos := []models.XInfoConsumerInfo{}
+	for _, name := range g.consumerNames() {
+		info := models.XInfoConsumerInfo{Name: name, Inactive: models.CreateNilInt64Result()}
+		for _, pending := range g.Pending {
+			if pending.Consumer == name {
+				info.Pending++
+			}
+		}
+		if consumer := g.Consumers[name]; consumer != nil {
+			info.Idle = now.Sub(consumer.SeenAt).Milliseconds()
+			if !consumer.ActiveAt.IsZero() {
+				info.Inactive = models.CreateInt64Result(now.Sub(consumer.ActiveAt).Milliseconds())
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// consumerNames returns the names of the consumers of the group, and of the consumers of its pending entries, sorted.
+func (g *Group) consumerNames() []string {
+	names := keySet(g.Consumers)
+	for _, pending := range g.Pending {
+		names[pending.Consumer] = struct{}{}
+	}
+	return sortedKeys(names)
+}
+
+// see records a read or a claim of `consumer`, handing out entries if `active` is set.
+func (g *Group) see(consumer string, now time.Time, active bool) {
+	if g.Consumers == nil {
+		g.Consumers = map[string]*Consumer{}
+	}
+	if g.Consumers[consumer] == nil {
+		g.Consumers[consumer] = &Consumer{}
+	}
+	g.Consumers[consumer].SeenAt = now
+	if active {
+		g.Consumers[consumer].ActiveAt = now
+	}
+}
+
+// group returns the consumer group `group` of the stream `key`.
+func (f *Client) group(key string, group string, command string) (*Stream, *Group, error) {
+	stream, err := f.stream(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if stream == nil || stream.Groups[group] == nil {
+		return nil, nil, fmt.Errorf("NOGROUP No such key '%s' or consumer group '%s' in %s", key, group, command)
+	}
+	return stream, stream.Groups[group], nil
+}
+
+// entry returns the entry `id` of the stream, or false if it was trimmed.
+func (s *Stream) entry(id string) (models.StreamEntry, bool) {
+	for _, entry := range s.Entries {
+		if entry.ID == id {
+			return entry, true
+		}
+	}
+	return models.StreamEntry{}, false
+}
+
+// pendingIDs returns the IDs of the pending entries of the group, in ascending order.
+func (g *Group) pendingIDs() []string {
+	ids := sortedKeys(g.Pending)
+	sort.Slice(ids, func(i, j int) bool { return mustParseStreamID(ids[i]).less(mustParseStreamID(ids[j])) })
+	return ids
+}
+
+// XReadGroupWithOptions hands out the entries never delivered to the group for the ID ">", or else the entries pending
+// for `consumer` after the ID. It does not block.
+func (f *Client) XReadGroupWithOptions(
+	ctx context.Context,
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+	opts options.XReadGroupOptions,
+) (map[string]models.StreamResponse, error) {
+	if err := f.lock("XREADGROUP"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	responses := map[string]models.StreamResponse{}
+	for key, id := range keysAndIds {
+		stream, g, err := f.group(key, group, "XREADGROUP with GROUP option")
+		if err != nil {
+			return nil, err
+		}
+		var entries []models.StreamEntry
+		full := func() bool { return opts.Count > 0 && len(entries) == int(opts.Count) }
+		if id == ">" {
+			lastDelivered := mustParseStreamID(g.LastDeliveredID)
+			for _, entry := range stream.Entries {
+				if !full() && lastDelivered.less(mustParseStreamID(entry.ID)) {
+					entries = append(entries, entry)
+					g.LastDeliveredID = entry.ID
+					if g.EntriesRead >= 0 {
+						g.EntriesRead++
+					}
+					if !opts.NoAck {
+						g.Pending[entry.ID] = PendingEntry{Consumer: consumer, DeliveredAt: f.clock.Now()}
+					}
+				}
+			}
+		} else {
+			after, err := parseStreamID(id, 0)
+			if err != nil {
+				return nil, err
+			}
+			for _, pendingID := range g.pendingIDs() {
+				if !full() && g.Pending[pendingID].Consumer == consumer && after.less(mustParseStreamID(pendingID)) {
+					entry, _ := stream.entry(pendingID)
+					entries = append(entries, models.StreamEntry{ID: pendingID, Fields: entry.Fields})
+				}
+			}
+		}
+		g.see(consumer, f.clock.Now(), len(entries) > 0)
+		if len(entries) > 0 {
+			responses[key] = models.StreamResponse{Entries: entries}
+		}
+	}
+	return responses, nil
+}
+
+func (f *Client) XAutoClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime time.Duration,
+	start string,
+	opts options.XAutoClaimOptions,
+) (models.XAutoClaimResponse, error) {
+	if err := f.lock("XAUTOCLAIM"); err != nil {
+		return models.XAutoClaimResponse{}, err
+	}
+	defer f.mu.Unlock()
+	stream, g, err := f.group(key, group, "XAUTOCLAIM")
+	if err != nil {
+		return models.XAutoClaimResponse{}, err
+	}
+	from, err := parseStreamID(start, 0)
+	if err != nil {
+		return models.XAutoClaimResponse{}, err
+	}
+	count := 100
+	if opts.Count > 0 {
+		count = int(opts.Count)
+	}
+	response := models.XAutoClaimResponse{NextEntry: "0-0"}
+	now := f.clock.Now()
+	for _, id := range g.pendingIDs() {
+		if mustParseStreamID(id).less(from) || now.Sub(g.Pending[id].DeliveredAt) < minIdleTime {
+			continue
+		}
+		if len(response.ClaimedEntries)+len(response.DeletedMessages) == count {
+			response.NextEntry = id
+			break
+		}
+		entry, ok := stream.entry(id)
+		if !ok {
+			delete(g.Pending, id)
+			response.DeletedMessages = append(response.DeletedMessages, id)
+			continue
+		}
+		g.Pending[id] = PendingEntry{Consumer: consumer, DeliveredAt: now}
+		response.ClaimedEntries = append(response.ClaimedEntries, entry)
+	}
+	g.see(consumer, now, len(response.ClaimedEntries) > 0)
+	return response, nil
+}
+
+func (f *Client) XAck(ctx context.Context, key string, group string, ids []string) (int64, error) {
+	if err := f.lock("XACK"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	stream, err := f.stream(key)
+	if err != nil || stream == nil || stream.Groups[group] == nil {
+		return 0, err
+	}
+	var acked int64
+	for _, id := range ids {
+		if _, ok := stream.Groups[group].Pending[id]; ok {
+			delete(stream.Groups[group].Pending, id)
+			acked++
+		}
+	}
+	return acked, nil
+}
@@ -0,0 +1,164 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package fakeclient
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// get returns the value of the string `key`, or a nil [models.Result] if it does not exist.
+func (f *Client) get(key string) (models.Result[string], error) {
+	if err := f.checkType(key, typeString); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	value, ok := f.Strings[key]
+	if !ok {
+		return models.CreateNilStringResult(), nil
+	}
+	return models.CreateStringResult(value), nil
+}
+
+// set sets the string `key` to `value`, whatever its type and expiry were.
+func (f *Client) set(key string, value string) {
+	f.delete(key)
+	f.Strings[key] = value
+}
+
+func (f *Client) Get(ctx context.Context, key string) (models.Result[string], error) {
+	if err := f.lock("GET"); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer f.mu.Unlock()
+	return f.get(key)
+}
+
+func (f *Client) Set(ctx context.Context, key string, value string) (string, error) {
+	if err := f.lock("SET"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	f.set(key, value)
+	return "OK", nil
+}
+
+func (f *Client) MGet(ctx context.Context, keys []string) ([]models.Result[string], error) {
+	if err := f.lock("MGET"); err != nil {
+		return nil, err
+	}
+	defer f.mu.Unlock()
+	results := make([]models.Result[string], len(keys))
+	for i, key := range keys {
+		// The keys of other types are nil, rather than failing the command
+		results[i], _ = f.get(key)
+	}
+	return results, nil
+}
+
+func (f *Client) MSet(ctx context.Context, keyValueMap map[string]string) (string, error) {
+	if err := f.lock("MSET"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	for key, value := range keyValueMap {
+		f.set(key, value)
+	}
+	return "OK", nil
+}
+
+func (f *Client) GetDel(ctx context.Context, key string) (models.Result[string], error) {
+	if err := f.lock("GETDEL"); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer f.mu.Unlock()
+	result, err := f.get(key)
+	if err == nil {
+		f.delete(key)
+	}
+	return result, err
+}
+
+func (f *Client) IncrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	if err := f.lock("INCRBY"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeString); err != nil {
+		return 0, err
+	}
+	var value int64
+	if current, ok := f.Strings[key]; ok {
+		var err error
+		if value, err = strconv.ParseInt(current, 10, 64); err != nil {
+			return 0, errors.New("ERR value is not an integer or out of range")
+		}
+	}
+	// The expiry of the key is retained
+	f.Strings[key] = strconv.FormatInt(value+amount, 10)
+	return value + amount, nil
+}
+
+func (f *Client) Append(ctx context.Context, key string, value string) (int64, error) {
+	if err := f.lock("APPEND"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeString); err != nil {
+		return 0, err
+	}
+	f.Strings[key] += value
+	return int64(len(f.Strings[key])), nil
+}
+
+func (f *Client) GetRange(ctx context.Context, key string, start int, end int) (string, error) {
+	if err := f.lock("GETRANGE"); err != nil {
+		return "", err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeString); err != nil {
+		return "", err
+	}
+	value := f.Strings[key]
+	if start < 0 {
+		start = max(start+len(value), 0)
+	}
+	if end < 0 {
+		end += len(value)
+	}
+	end = min(end, len(value)-1)
+	if start > end {
+		return "", nil
+	}
+	return value[start : end+1], nil
+}
+
+func (f *Client) SetRange(ctx context.Context, key string, offset int, value string) (int64, error) {
+	if err := f.lock("SETRANGE"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeString); err != nil {
+		return 0, err
+	}
+	current := f.Strings[key]
+	if len(current) < offset+len(value) {
+		current += strings.Repeat("\x00", offset+len(value)-len(current))
+	}
+	f.Strings[key] = current[:offset] + value + current[offset+len(value):]
+	return int64(len(f.Strings[key])), nil
+}
+
+func (f *Client) Strlen(ctx context.Context, key string) (int64, error) {
+	if err := f.lock("STRLEN"); err != nil {
+		return 0, err
+	}
+	defer f.mu.Unlock()
+	if err := f.checkType(key, typeString); err != nil {
+		return 0, err
+	}
+	return int64(len(f.Strings[key])), nil
+}
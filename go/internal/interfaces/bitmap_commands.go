@@ -19,6 +19,10 @@ type BitmapCommands interface {
 
 	GetBit(ctx context.Context, key string, offset int64) (int64, error)
 
+	BitSetMany(ctx context.Context, key string, offsets []int64, value bool) ([]bool, error)
+
+	BitGetMany(ctx context.Context, key string, offsets []int64) ([]bool, error)
+
 	BitCount(ctx context.Context, key string) (int64, error)
 
 	BitCountWithOptions(ctx context.Context, key string, options options.BitCountOptions) (int64, error)
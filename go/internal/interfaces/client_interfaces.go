@@ -5,6 +5,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
 )
@@ -26,6 +27,11 @@ type BaseClientCommands interface {
 	Watch(ctx context.Context, keys []string) (string, error)
 	Unwatch(ctx context.Context) (string, error)
 
+	CommandInfo(ctx context.Context, commandNames []string) (map[string]models.CommandInfo, error)
+
+	// InvalidateIntrospectionCache removes all the results from the introspection cache.
+	InvalidateIntrospectionCache()
+
 	// Close terminates the client by closing all associated resources.
 	Close()
 }
@@ -46,6 +52,11 @@ type GlideClientCommands interface {
 		raiseOnError bool,
 		options pipeline.StandaloneBatchOptions,
 	) ([]any, error)
+	ExecTransaction(
+		ctx context.Context,
+		batch pipeline.StandaloneBatch,
+		options pipeline.StandaloneBatchOptions,
+	) (models.TransactionResult, error)
 }
 
 type GlideClusterClientCommands interface {
@@ -65,4 +76,9 @@ type GlideClusterClientCommands interface {
 		raiseOnError bool,
 		options pipeline.ClusterBatchOptions,
 	) ([]any, error)
+	ExecTransaction(
+		ctx context.Context,
+		batch pipeline.ClusterBatch,
+		options pipeline.ClusterBatchOptions,
+	) (models.TransactionResult, error)
 }
@@ -5,6 +5,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
@@ -232,4 +233,139 @@ type ClusterManagementCommands interface {
 	//
 	// [valkey.io]: https://valkey.io/commands/cluster-links/
 	ClusterLinksWithRoute(ctx context.Context, route options.RouteOption) (models.ClusterValue[[]map[string]any], error)
+
+	// ClusterFailover forces the replica the command is routed to to start a manual failover of its primary.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//   ctx - The context for controlling the command execution.
+	//   route - Specifies the routing configuration for the command.
+	//
+	// Return value:
+	//   `"OK"` if the failover was accepted.
+	//
+	// [valkey.io]: https://valkey.io/commands/cluster-failover/
+	ClusterFailover(ctx context.Context, route options.RouteOption) (string, error)
+
+	// ClusterFailoverWithMode forces the replica the command is routed to to start a manual failover of its primary, using
+	// the given failover mode.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//   ctx - The context for controlling the command execution.
+	//   mode - Either FORCE or TAKEOVER.
+	//   route - Specifies the routing configuration for the command.
+	//
+	// Return value:
+	//   `"OK"` if the failover was accepted.
+	//
+	// [valkey.io]: https://valkey.io/commands/cluster-failover/
+	ClusterFailoverWithMode(ctx context.Context, mode options.FailoverMode, route options.RouteOption) (string, error)
+
+	// ClusterSetSlot alters the state of a hash slot in the node the command is routed to.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//   ctx - The context for controlling the command execution.
+	//   slot - The hash slot number (0-16383).
+	//   subcommand - The new state of the slot.
+	//   route - Specifies the routing configuration for the command.
+	//
+	// Return value:
+	//   `"OK"` on success.
+	//
+	// [valkey.io]: https://valkey.io/commands/cluster-setslot/
+	ClusterSetSlot(
+		ctx context.Context,
+		slot int64,
+		subcommand options.ClusterSetSlotSubcommand,
+		route options.RouteOption,
+	) (string, error)
+
+	// ClusterMeet connects the node the command is routed to with the node at the given address.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//   ctx - The context for controlling the command execution.
+	//   host - The IP address or hostname of the node to meet.
+	//   port - The port of the node to meet.
+	//   route - Specifies the routing configuration for the command.
+	//
+	// Return value:
+	//   `"OK"` if the handshake was started.
+	//
+	// [valkey.io]: https://valkey.io/commands/cluster-meet/
+	ClusterMeet(ctx context.Context, host string, port int64, route options.RouteOption) (string, error)
+
+	// ClusterForget removes the node with the given ID from the node table of the node the command is routed to.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//   ctx - The context for controlling the command execution.
+	//   nodeId - The ID of the node to forget.
+	//   route - Specifies the routing configuration for the command.
+	//
+	// Return value:
+	//   `"OK"` on success.
+	//
+	// [valkey.io]: https://valkey.io/commands/cluster-forget/
+	ClusterForget(ctx context.Context, nodeId string, route options.RouteOption) (string, error)
+
+	// ClusterAddSlots assigns the given hash slots to the node the command is routed to.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//   ctx - The context for controlling the command execution.
+	//   slots - The hash slot numbers (0-16383) to assign.
+	//   route - Specifies the routing configuration for the command.
+	//
+	// Return value:
+	//   `"OK"` on success.
+	//
+	// [valkey.io]: https://valkey.io/commands/cluster-addslots/
+	ClusterAddSlots(ctx context.Context, slots []int64, route options.RouteOption) (string, error)
+
+	// ClusterDelSlots makes the node the command is routed to forget which primary serves the given hash slots.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//   ctx - The context for controlling the command execution.
+	//   slots - The hash slot numbers (0-16383) to unassign.
+	//   route - Specifies the routing configuration for the command.
+	//
+	// Return value:
+	//   `"OK"` on success.
+	//
+	// [valkey.io]: https://valkey.io/commands/cluster-delslots/
+	ClusterDelSlots(ctx context.Context, slots []int64, route options.RouteOption) (string, error)
+
+	// MigrateSlot moves a hash slot, together with all of its keys, from the `source` primary to the `target` primary.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//   ctx - The context for controlling the command execution.
+	//   slot - The hash slot number (0-16383).
+	//   source - The address of the primary currently serving the slot.
+	//   target - The address of the primary that should serve the slot.
+	//   opts - The migration options, see [options.MigrateSlotOptions].
+	//
+	// Return value:
+	//   The total number of migrated keys.
+	//
+	// [valkey.io]: https://valkey.io/topics/cluster-spec/#live-resharding
+	MigrateSlot(
+		ctx context.Context,
+		slot int64,
+		source config.ByAddressRoute,
+		target config.ByAddressRoute,
+		opts options.MigrateSlotOptions,
+	) (int64, error)
 }
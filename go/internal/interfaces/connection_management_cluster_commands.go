@@ -27,6 +27,28 @@ type ConnectionManagementClusterCommands interface {
 
 	ClientIdWithOptions(ctx context.Context, routeOptions options.RouteOption) (models.ClusterValue[int64], error)
 
+	ClientInfo(ctx context.Context) (models.ClientInfo, error)
+
+	ClientInfoWithOptions(
+		ctx context.Context,
+		routeOptions options.RouteOption,
+	) (models.ClusterValue[models.ClientInfo], error)
+
+	ClientTrackingInfo(ctx context.Context) (models.ClientTrackingInfo, error)
+
+	ClientTrackingInfoWithOptions(
+		ctx context.Context,
+		routeOptions options.RouteOption,
+	) (models.ClusterValue[models.ClientTrackingInfo], error)
+
+	Hello(ctx context.Context) (models.HelloResponse, error)
+
+	HelloWithOptions(
+		ctx context.Context,
+		helloOptions options.HelloOptions,
+		routeOptions options.RouteOption,
+	) (models.ClusterValue[models.HelloResponse], error)
+
 	ClientSetName(ctx context.Context, connectionName string) (string, error)
 
 	ClientSetNameWithOptions(
@@ -23,6 +23,14 @@ type ConnectionManagementCommands interface {
 
 	ClientId(ctx context.Context) (int64, error)
 
+	ClientInfo(ctx context.Context) (models.ClientInfo, error)
+
+	ClientTrackingInfo(ctx context.Context) (models.ClientTrackingInfo, error)
+
+	Hello(ctx context.Context) (models.HelloResponse, error)
+
+	HelloWithOptions(ctx context.Context, helloOptions options.HelloOptions) (models.HelloResponse, error)
+
 	ClientGetName(ctx context.Context) (models.Result[string], error)
 
 	ClientSetName(ctx context.Context, connectionName string) (string, error)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -19,6 +20,8 @@ import (
 type GenericBaseCommands interface {
 	Del(ctx context.Context, keys []string) (int64, error)
 
+	DelIfType(ctx context.Context, key string, objectType constants.ObjectType) (bool, error)
+
 	Exists(ctx context.Context, keys []string) (int64, error)
 
 	Expire(ctx context.Context, key string, expireTime time.Duration) (bool, error)
@@ -57,6 +60,34 @@ type GenericBaseCommands interface {
 		expireCondition constants.ExpireCondition,
 	) (bool, error)
 
+	ExpireWithStatus(
+		ctx context.Context,
+		key string,
+		expireTime time.Duration,
+		expireCondition constants.ExpireCondition,
+	) (models.ExpireStatus, error)
+
+	ExpireAtWithStatus(
+		ctx context.Context,
+		key string,
+		expireTime time.Time,
+		expireCondition constants.ExpireCondition,
+	) (models.ExpireStatus, error)
+
+	PExpireWithStatus(
+		ctx context.Context,
+		key string,
+		expireTime time.Duration,
+		expireCondition constants.ExpireCondition,
+	) (models.ExpireStatus, error)
+
+	PExpireAtWithStatus(
+		ctx context.Context,
+		key string,
+		expireTime time.Time,
+		expireCondition constants.ExpireCondition,
+	) (models.ExpireStatus, error)
+
 	ExpireTime(ctx context.Context, key string) (int64, error)
 
 	PExpireTime(ctx context.Context, key string) (int64, error)
@@ -65,6 +96,10 @@ type GenericBaseCommands interface {
 
 	PTTL(ctx context.Context, key string) (int64, error)
 
+	TTLs(ctx context.Context, keys []string) (map[string]int64, error)
+
+	PTTLs(ctx context.Context, keys []string) (map[string]int64, error)
+
 	Unlink(ctx context.Context, keys []string) (int64, error)
 
 	Touch(ctx context.Context, keys []string) (int64, error)
@@ -111,6 +146,15 @@ type GenericBaseCommands interface {
 
 	Wait(ctx context.Context, numberOfReplicas int64, timeout time.Duration) (int64, error)
 
+	SetAndWait(ctx context.Context, key string, value string, numReplicas int64, timeout time.Duration) (int64, error)
+
+	WithDurability(
+		ctx context.Context,
+		key string,
+		opts options.DurabilityOptions,
+		write func(ctx context.Context) error,
+	) (int64, error)
+
 	Copy(ctx context.Context, source string, destination string) (bool, error)
 
 	CopyWithOptions(ctx context.Context, source string, destination string, option options.CopyOptions) (bool, error)
@@ -118,4 +162,6 @@ type GenericBaseCommands interface {
 	UpdateConnectionPassword(ctx context.Context, password string, immediateAuth bool) (string, error)
 
 	ResetConnectionPassword(ctx context.Context) (string, error)
+
+	UpdateConfig(ctx context.Context, update *config.ConfigUpdate) (string, error)
 }
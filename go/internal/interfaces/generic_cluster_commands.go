@@ -18,6 +18,12 @@ import (
 type GenericClusterCommands interface {
 	CustomCommand(ctx context.Context, args []string) (models.ClusterValue[any], error)
 
+	CustomCommandWithOptions(
+		ctx context.Context,
+		args []string,
+		opts options.CustomCommandOptions,
+	) (models.ClusterValue[any], error)
+
 	CustomCommandWithRoute(ctx context.Context, args []string, route config.Route) (models.ClusterValue[any], error)
 
 	Scan(ctx context.Context, cursor models.ClusterScanCursor) (models.ClusterScanResult, error)
@@ -31,4 +37,6 @@ type GenericClusterCommands interface {
 	RandomKey(ctx context.Context) (models.Result[string], error)
 
 	RandomKeyWithRoute(ctx context.Context, opts options.RouteOption) (models.Result[string], error)
+
+	RandomKeySample(ctx context.Context, n int, routeOption options.RouteOption) ([]string, error)
 }
@@ -17,6 +17,8 @@ import (
 type GenericCommands interface {
 	CustomCommand(ctx context.Context, args []string) (any, error)
 
+	CustomCommandWithOptions(ctx context.Context, args []string, opts options.CustomCommandOptions) (any, error)
+
 	Move(ctx context.Context, key string, dbIndex int64) (bool, error)
 
 	Scan(ctx context.Context, cursor models.Cursor) (models.ScanResult, error)
@@ -24,4 +26,6 @@ type GenericCommands interface {
 	ScanWithOptions(ctx context.Context, cursor models.Cursor, scanOptions options.ScanOptions) (models.ScanResult, error)
 
 	RandomKey(ctx context.Context) (models.Result[string], error)
+
+	RandomKeySample(ctx context.Context, n int) ([]string, error)
 }
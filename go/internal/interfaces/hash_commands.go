@@ -18,8 +18,16 @@ import (
 type HashCommands interface {
 	HGet(ctx context.Context, key string, field string) (models.Result[string], error)
 
+	HGetInto(ctx context.Context, key string, field string, dst []byte) (int, error)
+
+	HGetHedged(ctx context.Context, key string, field string, opts options.HedgeOptions) (models.Result[string], error)
+
 	HGetAll(ctx context.Context, key string) (map[string]string, error)
 
+	HGetAllOrdered(ctx context.Context, key string) ([]models.FieldValue, error)
+
+	HGetAllSorted(ctx context.Context, key string) (models.OrderedMap, error)
+
 	HMGet(ctx context.Context, key string, fields []string) ([]models.Result[string], error)
 
 	HSet(ctx context.Context, key string, values map[string]string) (int64, error)
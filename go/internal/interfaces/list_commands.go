@@ -37,6 +37,15 @@ type ListCommands interface {
 		options options.LPosOptions,
 	) ([]int64, error)
 
+	LPosMany(ctx context.Context, key string, elements []string) (map[string][]int64, error)
+
+	LPosManyWithOptions(
+		ctx context.Context,
+		key string,
+		elements []string,
+		options options.LPosOptions,
+	) (map[string][]int64, error)
+
 	RPush(ctx context.Context, key string, elements []string) (int64, error)
 
 	LRange(ctx context.Context, key string, start int64, end int64) ([]string, error)
@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
@@ -26,6 +27,29 @@ type ScriptingAndFunctionClusterCommands interface {
 
 	FunctionFlushAsyncWithRoute(ctx context.Context, route options.RouteOption) (string, error)
 
+	FunctionLoadPerNode(
+		ctx context.Context,
+		libraryCode string,
+		replace bool,
+		fanOutOptions options.FanOutOptions,
+	) (map[string]models.LibraryStatus, error)
+
+	FunctionVerifyPerNode(
+		ctx context.Context,
+		libraryCode string,
+		fanOutOptions options.FanOutOptions,
+	) (map[string]models.LibraryStatus, error)
+
+	FunctionFlushPerNode(ctx context.Context, mode options.FlushMode, fanOutOptions options.FanOutOptions) error
+
+	WatchLibraries(
+		ctx context.Context,
+		libraryCodes []string,
+		pollInterval time.Duration,
+		fanOutOptions options.FanOutOptions,
+		handler func(address string, libraryName string, err error),
+	) error
+
 	FCallWithRoute(ctx context.Context, function string, route options.RouteOption) (models.ClusterValue[any], error)
 
 	FCallReadOnlyWithRoute(ctx context.Context, function string, route options.RouteOption) (models.ClusterValue[any], error)
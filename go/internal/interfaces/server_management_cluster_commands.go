@@ -5,6 +5,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
@@ -21,6 +22,14 @@ type ServerManagementClusterCommands interface {
 
 	InfoWithOptions(ctx context.Context, options options.ClusterInfoOptions) (models.ClusterValue[string], error)
 
+	InfoPerNode(
+		ctx context.Context,
+		infoOptions options.InfoOptions,
+		fanOutOptions options.FanOutOptions,
+	) (map[string]string, error)
+
+	ReplicationStatus(ctx context.Context) (map[string]models.ReplicationStatus, error)
+
 	TimeWithOptions(ctx context.Context, routeOption options.RouteOption) (models.ClusterValue[[]string], error)
 
 	DBSizeWithOptions(ctx context.Context, routeOption options.RouteOption) (int64, error)
@@ -29,6 +38,8 @@ type ServerManagementClusterCommands interface {
 
 	FlushAllWithOptions(ctx context.Context, options options.FlushClusterOptions) (string, error)
 
+	FlushAllPerNode(ctx context.Context, mode options.FlushMode, fanOutOptions options.FanOutOptions) error
+
 	FlushDB(ctx context.Context) (string, error)
 
 	FlushDBWithOptions(ctx context.Context, options options.FlushClusterOptions) (string, error)
@@ -49,8 +60,18 @@ type ServerManagementClusterCommands interface {
 
 	ConfigSetWithOptions(ctx context.Context, parameters map[string]string, routeOption options.RouteOption) (string, error)
 
+	ConfigSetPerNode(ctx context.Context, parameters map[string]string, fanOutOptions options.FanOutOptions) error
+
+	ForEachNode(
+		ctx context.Context,
+		opts options.FanOutOptions,
+		fn func(ctx context.Context, address string, route config.Route) error,
+	) error
+
 	ConfigGet(ctx context.Context, parameters []string) (map[string]string, error)
 
+	ConfigGetSorted(ctx context.Context, parameters []string) (models.OrderedMap, error)
+
 	ConfigGetWithOptions(
 		ctx context.Context,
 		parameters []string,
@@ -5,6 +5,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
 
@@ -18,12 +19,16 @@ type ServerManagementCommands interface {
 
 	ConfigGet(ctx context.Context, args []string) (map[string]string, error)
 
+	ConfigGetSorted(ctx context.Context, args []string) (models.OrderedMap, error)
+
 	ConfigSet(ctx context.Context, parameters map[string]string) (string, error)
 
 	Info(ctx context.Context) (string, error)
 
 	InfoWithOptions(ctx context.Context, options options.InfoOptions) (string, error)
 
+	ReplicationStatus(ctx context.Context) (models.ReplicationStatus, error)
+
 	DBSize(ctx context.Context) (int64, error)
 
 	Time(ctx context.Context) ([]string, error)
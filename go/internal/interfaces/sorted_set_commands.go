@@ -19,6 +19,13 @@ import (
 type SortedSetCommands interface {
 	ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) (int64, error)
 
+	ZAddBulk(
+		ctx context.Context,
+		key string,
+		iterator models.MemberAndScoreIterator,
+		batchSize int,
+	) (models.ZAddBulkResult, error)
+
 	ZAddWithOptions(
 		ctx context.Context,
 		key string,
@@ -6,6 +6,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
@@ -92,6 +93,19 @@ type StreamCommands interface {
 
 	XDel(ctx context.Context, key string, ids []string) (int64, error)
 
+	XDelEx(ctx context.Context, key string, ids []string) ([]models.StreamEntryDeletionStatus, error)
+
+	XDelExWithPolicy(
+		ctx context.Context,
+		key string,
+		ids []string,
+		policy constants.StreamDeletionPolicy,
+	) ([]models.StreamEntryDeletionStatus, error)
+
+	XSetId(ctx context.Context, key string, lastId string) (string, error)
+
+	XSetIdWithOptions(ctx context.Context, key string, lastId string, opts options.XSetIdOptions) (string, error)
+
 	XPending(ctx context.Context, key string, group string) (models.XPendingSummary, error)
 
 	XPendingWithOptions(
@@ -129,6 +143,16 @@ type StreamCommands interface {
 
 	XAck(ctx context.Context, key string, group string, ids []string) (int64, error)
 
+	XAckDel(ctx context.Context, key string, group string, ids []string) ([]models.StreamEntryDeletionStatus, error)
+
+	XAckDelWithPolicy(
+		ctx context.Context,
+		key string,
+		group string,
+		ids []string,
+		policy constants.StreamDeletionPolicy,
+	) ([]models.StreamEntryDeletionStatus, error)
+
 	XClaim(
 		ctx context.Context,
 		key string,
@@ -207,4 +231,25 @@ type StreamCommands interface {
 		end options.StreamBoundary,
 		options options.XRangeOptions,
 	) ([]models.StreamEntry, error)
+	XRangeInto(ctx context.Context, key string, start options.StreamBoundary, end options.StreamBoundary, dst any) error
+
+	XRangeWithOptionsInto(
+		ctx context.Context,
+		key string,
+		start options.StreamBoundary,
+		end options.StreamBoundary,
+		options options.XRangeOptions,
+		dst any,
+	) error
+
+	XRevRangeInto(ctx context.Context, key string, start options.StreamBoundary, end options.StreamBoundary, dst any) error
+
+	XRevRangeWithOptionsInto(
+		ctx context.Context,
+		key string,
+		start options.StreamBoundary,
+		end options.StreamBoundary,
+		options options.XRangeOptions,
+		dst any,
+	) error
 }
@@ -19,16 +19,42 @@ type StringCommands interface {
 
 	SetWithOptions(ctx context.Context, key string, value string, options options.SetOptions) (models.Result[string], error)
 
+	GetWithVersion(ctx context.Context, key string) (models.VersionedValue, error)
+
+	SetIfVersion(ctx context.Context, key string, value string, version string) (bool, error)
+
+	MGetWithVersion(ctx context.Context, keys []string) ([]models.VersionedValue, error)
+
+	MSetIfVersion(ctx context.Context, keyValueMap map[string]string, versions map[string]string) (bool, error)
+
+	UpdateString(
+		ctx context.Context,
+		key string,
+		update func(old models.Result[string]) (value string, abort bool),
+	) (bool, error)
+
 	Get(ctx context.Context, key string) (models.Result[string], error)
 
+	GetInto(ctx context.Context, key string, dst []byte) (int, error)
+
+	GetHedged(ctx context.Context, key string, opts options.HedgeOptions) (models.Result[string], error)
+
 	GetEx(ctx context.Context, key string) (models.Result[string], error)
 
 	GetExWithOptions(ctx context.Context, key string, options options.GetExOptions) (models.Result[string], error)
 
 	MSet(ctx context.Context, keyValueMap map[string]string) (string, error)
 
+	BulkLoad(
+		ctx context.Context,
+		iterator models.KeyValueIterator,
+		opts options.BulkLoadOptions,
+	) (models.BulkLoadResult, error)
+
 	MGet(ctx context.Context, keys []string) ([]models.Result[string], error)
 
+	MGetWithOptions(ctx context.Context, keys []string, opts options.MGetOptions) ([]models.Result[string], error)
+
 	MSetNX(ctx context.Context, keyValueMap map[string]string) (bool, error)
 
 	Incr(ctx context.Context, key string) (int64, error)
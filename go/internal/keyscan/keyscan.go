@@ -0,0 +1,57 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package keyscan lists the keys of a standalone server or of a cluster with `SCAN`.
+package keyscan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Scan calls `fn` with every page of the keys matching `pattern`, requesting `count` keys from every `SCAN` call. The keys
+// are listed with `SCAN`, or with a cluster scan of all the primaries in cluster mode. Stops at the first error returned
+// by `fn`.
+func Scan(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	pattern string,
+	count int64,
+	fn func(keys []string) error,
+) error {
+	switch client := client.(type) {
+	case interfaces.GenericClusterCommands:
+		opts := options.NewClusterScanOptions().SetMatch(pattern).SetCount(count)
+		cursor := models.NewClusterScanCursor()
+		for !cursor.IsFinished() {
+			result, err := client.ScanWithOptions(ctx, cursor, *opts)
+			if err != nil {
+				return err
+			}
+			if err := fn(result.Keys); err != nil {
+				return err
+			}
+			cursor = result.Cursor
+		}
+		return nil
+	case interfaces.GenericCommands:
+		opts := options.NewScanOptions()
+		opts.SetMatch(pattern).SetCount(count)
+		cursor := models.NewCursor()
+		for !cursor.IsFinished() {
+			result, err := client.ScanWithOptions(ctx, cursor, *opts)
+			if err != nil {
+				return err
+			}
+			if err := fn(result.Data); err != nil {
+				return err
+			}
+			cursor = result.Cursor
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported client type %T, expected a glide.Client or a glide.ClusterClient", client)
+}
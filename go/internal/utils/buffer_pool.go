@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import "sync"
+
+// SlicePool is a pool of reusable slices, used to avoid allocating a new buffer for every command.
+//
+// Slices with a capacity above `maxCap` are not returned to the pool, so that a single large command does not pin a large
+// buffer for the lifetime of the client. A nil or disabled pool allocates a new slice on every call to Get.
+type SlicePool[T any] struct {
+	pool   sync.Pool
+	maxCap int
+}
+
+// NewSlicePool creates a [SlicePool] that retains slices with a capacity of up to `maxCap` elements. If `maxCap` is not
+// positive, pooling is disabled.
+func NewSlicePool[T any](maxCap int) *SlicePool[T] {
+	return &SlicePool[T]{maxCap: maxCap}
+}
+
+// Get returns a slice of length `length`. The content of the slice is undefined.
+func (p *SlicePool[T]) Get(length int) *[]T {
+	if p == nil || p.maxCap <= 0 || length > p.maxCap {
+		buf := make([]T, length)
+		return &buf
+	}
+	// A pooled slice that is too small is dropped, the larger slice allocated instead takes its place on Put.
+	if buf, ok := p.pool.Get().(*[]T); ok && cap(*buf) >= length {
+		*buf = (*buf)[:length]
+		return buf
+	}
+	buf := make([]T, length, max(length, min(p.maxCap, 16)))
+	return &buf
+}
+
+// Put returns a slice obtained from Get to the pool. The slice must not be used afterwards.
+func (p *SlicePool[T]) Put(buf *[]T) {
+	if p == nil || buf == nil || p.maxCap <= 0 || cap(*buf) > p.maxCap {
+		return
+	}
+	// Drop any reference held by the elements so that pooled buffers do not keep them alive.
+	clear((*buf)[:cap(*buf)])
+	*buf = (*buf)[:0]
+	p.pool.Put(buf)
+}
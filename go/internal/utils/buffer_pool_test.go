@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlicePool_GetReturnsRequestedLength(t *testing.T) {
+	pool := NewSlicePool[uintptr](64)
+	for _, length := range []int{0, 1, 10, 64, 65, 1000} {
+		buf := pool.Get(length)
+		assert.Len(t, *buf, length)
+		pool.Put(buf)
+	}
+}
+
+func TestSlicePool_PutClearsAndResetsSlice(t *testing.T) {
+	pool := NewSlicePool[*int](8)
+	value := 5
+	buf := pool.Get(4)
+	for i := range *buf {
+		(*buf)[i] = &value
+	}
+	pool.Put(buf)
+	assert.Len(t, *buf, 0)
+	for _, element := range (*buf)[:cap(*buf)] {
+		assert.Nil(t, element)
+	}
+}
+
+func TestSlicePool_DoesNotRetainLargeSlices(t *testing.T) {
+	pool := NewSlicePool[byte](16)
+	large := make([]byte, 0, 32)
+	pool.Put(&large)
+	buf := pool.Get(8)
+	assert.LessOrEqual(t, cap(*buf), 16)
+}
+
+func TestSlicePool_Disabled(t *testing.T) {
+	for _, pool := range []*SlicePool[byte]{nil, NewSlicePool[byte](0)} {
+		buf := pool.Get(3)
+		assert.Len(t, *buf, 3)
+		pool.Put(buf)
+	}
+}
+
+var benchmarkArgs = []string{"SET", "benchmark:key:0001", "some value that is not too short", "EX", "100"}
+
+func encodeArgs(cArgs []uintptr, lengths []uint64) {
+	for i, arg := range benchmarkArgs {
+		cArgs[i] = uintptr(len(arg))
+		lengths[i] = uint64(len(arg))
+	}
+}
+
+func BenchmarkEncodeArgs_NoPool(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			cArgs := make([]uintptr, len(benchmarkArgs))
+			lengths := make([]uint64, len(benchmarkArgs))
+			encodeArgs(cArgs, lengths)
+		}
+	})
+}
+
+func BenchmarkEncodeArgs_Pool(b *testing.B) {
+	argsPool := NewSlicePool[uintptr](1024)
+	lengthsPool := NewSlicePool[uint64](1024)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			cArgs := argsPool.Get(len(benchmarkArgs))
+			lengths := lengthsPool.Get(len(benchmarkArgs))
+			encodeArgs(*cArgs, *lengths)
+			argsPool.Put(cArgs)
+			lengthsPool.Put(lengths)
+		}
+	})
+}
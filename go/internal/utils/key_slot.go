@@ -0,0 +1,52 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import "strings"
+
+// SlotCount is the number of hash slots of a cluster.
+const SlotCount = 16384
+
+// KeySlot returns the hash slot of `key` in a cluster. Only the hash tag of the key is hashed, i.e. the substring between
+// the first "{" and the next "}", if it is not empty.
+func KeySlot(key string) uint16 {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	return crc16(key) % SlotCount
+}
+
+// SameSlotKey returns the key made of `key` followed by `suffix`, in the same slot as `key` in a cluster. If `key` has no
+// hash tag, it is wrapped in one, e.g. "{user:1}:lock" for "user:1" and ":lock". The keys that contain a "}" but no hash
+// tag cannot be wrapped, so the returned key may be in another slot.
+func SameSlotKey(key string, suffix string) string {
+	if !HasHashTag(key) && !strings.Contains(key, "}") {
+		key = "{" + key + "}"
+	}
+	return key + suffix
+}
+
+// HasHashTag returns true if only a part of `key` is hashed to compute its slot, i.e. if it has a non-empty substring
+// between its first "{" and the next "}".
+func HasHashTag(key string) bool {
+	start := strings.IndexByte(key, '{')
+	return start >= 0 && strings.IndexByte(key[start+1:], '}') > 0
+}
+
+// crc16 computes the CRC-16/XMODEM checksum of `data`, the one used for the hash slots.
+func crc16(data string) uint16 {
+	var crc uint16
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,30 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeySlot(t *testing.T) {
+	assert.Equal(t, uint16(12739), KeySlot("123456789"))
+	assert.Equal(t, uint16(12182), KeySlot("foo"))
+	assert.Equal(t, KeySlot("user1000"), KeySlot("{user1000}.following"))
+	assert.Equal(t, KeySlot("user1000"), KeySlot("foo{user1000}{bar}"))
+	// An empty hash tag is not a hash tag
+	assert.Equal(t, crc16("foo{}{bar}")%SlotCount, KeySlot("foo{}{bar}"))
+	assert.Equal(t, crc16("foo{bar")%SlotCount, KeySlot("foo{bar"))
+}
+
+func TestSameSlotKey(t *testing.T) {
+	assert.Equal(t, "{user:1}:lock", SameSlotKey("user:1", ":lock"))
+	assert.Equal(t, "{user}:1:lock", SameSlotKey("{user}:1", ":lock"))
+	// A key with a "}" but no hash tag cannot be wrapped
+	assert.Equal(t, "{}user:1:lock", SameSlotKey("{}user:1", ":lock"))
+	assert.Equal(t, "user}:1:lock", SameSlotKey("user}:1", ":lock"))
+	for _, key := range []string{"user:1", "{user}:1", "{user:1"} {
+		assert.Equal(t, KeySlot(key), KeySlot(SameSlotKey(key, ":lock")), key)
+	}
+}
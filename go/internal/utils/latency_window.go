@@ -0,0 +1,71 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// LatencyWindow keeps the most recent latencies observed by a client, to compute their percentiles. It is safe for
+// concurrent use.
+type LatencyWindow struct {
+	mu sync.Mutex
+	// A ring buffer of the most recent latencies.
+	samples []time.Duration
+	next    int
+	full    bool
+	// The samples sorted, recomputed lazily after `staleAfter` new samples, so that computing a percentile does not sort
+	// the window on every call.
+	sorted     []time.Duration
+	newSamples int
+	staleAfter int
+	minSamples int
+}
+
+// NewLatencyWindow creates a [LatencyWindow] keeping the last `size` latencies. Percentiles are only available once
+// `minSamples` latencies were observed.
+func NewLatencyWindow(size int, minSamples int) *LatencyWindow {
+	return &LatencyWindow{
+		samples:    make([]time.Duration, size),
+		staleAfter: max(size/16, 1),
+		minSamples: minSamples,
+	}
+}
+
+// Record adds an observed latency to the window, replacing the oldest one if the window is full.
+func (w *LatencyWindow) Record(latency time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.samples[w.next] = latency
+	w.next++
+	if w.next == len(w.samples) {
+		w.next = 0
+		w.full = true
+	}
+	w.newSamples++
+}
+
+// Percentile returns the given percentile, between 0 and 100, of the latencies in the window, and false if not enough
+// latencies were observed yet.
+func (w *LatencyWindow) Percentile(percentile float64) (time.Duration, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	count := w.next
+	if w.full {
+		count = len(w.samples)
+	}
+	if count == 0 || count < w.minSamples {
+		return 0, false
+	}
+	if w.sorted == nil || w.newSamples >= w.staleAfter {
+		w.sorted = slices.Clone(w.samples[:count])
+		slices.Sort(w.sorted)
+		w.newSamples = 0
+	}
+	percentile = min(max(percentile, 0), 100)
+	index := int(math.Ceil(percentile/100*float64(len(w.sorted)))) - 1
+	return w.sorted[max(index, 0)], true
+}
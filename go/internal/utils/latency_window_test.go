@@ -0,0 +1,44 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatencyWindow_Percentile(t *testing.T) {
+	window := NewLatencyWindow(100, 10)
+	for i := 1; i <= 9; i++ {
+		window.Record(time.Duration(i) * time.Millisecond)
+	}
+	_, ok := window.Percentile(99)
+	assert.False(t, ok)
+
+	window.Record(10 * time.Millisecond)
+	p50, ok := window.Percentile(50)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Millisecond, p50)
+	p99, _ := window.Percentile(99)
+	assert.Equal(t, 10*time.Millisecond, p99)
+	p0, _ := window.Percentile(0)
+	assert.Equal(t, time.Millisecond, p0)
+}
+
+func TestLatencyWindow_KeepsRecentSamples(t *testing.T) {
+	window := NewLatencyWindow(16, 1)
+	for i := 0; i < 16; i++ {
+		window.Record(time.Second)
+	}
+	p100, _ := window.Percentile(100)
+	assert.Equal(t, time.Second, p100)
+
+	// The old samples are replaced, and the sorted samples are recomputed
+	for i := 0; i < 16; i++ {
+		window.Record(time.Millisecond)
+	}
+	p100, _ = window.Percentile(100)
+	assert.Equal(t, time.Millisecond, p100)
+}
@@ -0,0 +1,161 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// LRUCache is a size-bounded cache whose entries expire after a fixed time to live. When full, the least recently used
+// entry is evicted. It is safe for concurrent use.
+//
+// A nil cache is a valid, always empty cache.
+type LRUCache[K comparable, V any] struct {
+	mu         sync.Mutex
+	maxEntries int
+	ttl        time.Duration
+	entries    map[K]*list.Element
+	// The most recently used entry is at the front.
+	order *list.List
+	// Replaced in tests.
+	now func() time.Time
+}
+
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// NewLRUCache creates an [LRUCache] holding up to `maxEntries` entries, each expiring `ttl` after it was stored.
+func NewLRUCache[K comparable, V any](maxEntries int, ttl time.Duration) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		entries:    map[K]*list.Element{},
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// Get returns the value stored for `key`, if any and not expired.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	var zero V
+	if c == nil {
+		return zero, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := element.Value.(*lruEntry[K, V])
+	if !c.now().Before(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Put stores `value` for `key`, evicting the least recently used entry if the cache is full.
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	if c == nil || c.maxEntries <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := c.now().Add(c.ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*lruEntry[K, V])
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+// Delete removes the entry of `key`, if any.
+func (c *LRUCache[K, V]) Delete(key K) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.order.Remove(element)
+		delete(c.entries, key)
+	}
+}
+
+// Clear removes all the entries.
+func (c *LRUCache[K, V]) Clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[K]*list.Element{}
+	c.order.Init()
+}
+
+// Len returns the number of entries, including the expired entries not evicted yet.
+func (c *LRUCache[K, V]) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// DeepCopy returns a copy of a value decoded from a server response, copying the nested maps and slices, so that the copy
+// can be modified without affecting the original.
+func DeepCopy(value any) any {
+	switch v := value.(type) {
+	case []any:
+		result := make([]any, len(v))
+		for i, element := range v {
+			result[i] = DeepCopy(element)
+		}
+		return result
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, element := range v {
+			result[key] = DeepCopy(element)
+		}
+		return result
+	case []map[string]any:
+		result := make([]map[string]any, len(v))
+		for i, element := range v {
+			result[i] = DeepCopy(element).(map[string]any)
+		}
+		return result
+	case map[string]struct{}:
+		result := make(map[string]struct{}, len(v))
+		for key := range v {
+			result[key] = struct{}{}
+		}
+		return result
+	case []string:
+		return append([]string(nil), v...)
+	case map[string]string:
+		result := make(map[string]string, len(v))
+		for key, element := range v {
+			result[key] = element
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache_GetPut(t *testing.T) {
+	cache := NewLRUCache[string, int](2, time.Minute)
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	// "b" is the least recently used entry
+	cache.Put("c", 3)
+	assert.Equal(t, 2, cache.Len())
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	value, ok = cache.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+
+	cache.Put("c", 4)
+	value, _ = cache.Get("c")
+	assert.Equal(t, 4, value)
+
+	cache.Clear()
+	assert.Equal(t, 0, cache.Len())
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestLRUCache_Delete(t *testing.T) {
+	cache := NewLRUCache[string, int](2, time.Minute)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Delete("a")
+	cache.Delete("missing")
+	assert.Equal(t, 1, cache.Len())
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+
+	// The deleted entry no longer counts towards the maximum number of entries
+	cache.Put("c", 3)
+	value, ok := cache.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+}
+
+func TestLRUCache_Expiration(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cache := NewLRUCache[string, int](10, time.Second)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("a", 1)
+	now = now.Add(999 * time.Millisecond)
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+
+	now = now.Add(time.Millisecond)
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestLRUCache_Disabled(t *testing.T) {
+	var nilCache *LRUCache[string, int]
+	nilCache.Put("a", 1)
+	_, ok := nilCache.Get("a")
+	assert.False(t, ok)
+	nilCache.Clear()
+	assert.Equal(t, 0, nilCache.Len())
+
+	empty := NewLRUCache[string, int](0, time.Second)
+	empty.Put("a", 1)
+	_, ok = empty.Get("a")
+	assert.False(t, ok)
+}
+
+func TestDeepCopy(t *testing.T) {
+	original := []map[string]any{
+		{
+			"slots": []any{int64(0), int64(5460)},
+			"nodes": []any{map[string]any{"id": "abc", "flags": map[string]struct{}{"master": {}}}},
+		},
+	}
+	copied := DeepCopy(original).([]map[string]any)
+	assert.Equal(t, original, copied)
+
+	copied[0]["slots"].([]any)[0] = int64(1)
+	copied[0]["nodes"].([]any)[0].(map[string]any)["id"] = "def"
+	copied[0]["nodes"].([]any)[0].(map[string]any)["flags"].(map[string]struct{})["replica"] = struct{}{}
+	assert.Equal(t, int64(0), original[0]["slots"].([]any)[0])
+	assert.Equal(t, "abc", original[0]["nodes"].([]any)[0].(map[string]any)["id"])
+	assert.Len(t, original[0]["nodes"].([]any)[0].(map[string]any)["flags"], 1)
+
+	assert.Equal(t, "value", DeepCopy("value"))
+	assert.Nil(t, DeepCopy(nil))
+}
@@ -22,10 +22,27 @@ func IntToString(value int64) string {
 	return strconv.FormatInt(value, 10 /*base*/)
 }
 
+// IntsToStrings converts each element of `values` to its base 10 string representation.
+func IntsToStrings(values []int64) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = IntToString(value)
+	}
+	return result
+}
+
 func FloatToString(value float64) string {
 	return strconv.FormatFloat(value, 'g', -1 /*precision*/, 64 /*bit*/)
 }
 
+// KeysAndTimeout returns the arguments of a blocking command taking `keys` followed by `timeout` in seconds, such as
+// `BZPOPMAX`, without modifying the backing array of `keys`.
+func KeysAndTimeout(keys []string, timeout time.Duration) []string {
+	args := make([]string, 0, len(keys)+1)
+	args = append(args, keys...)
+	return append(args, FloatToString(timeout.Seconds()))
+}
+
 // ConvertMapToKeyValueStringArray converts a map of string keys and values to a slice of the initial key followed by the
 // key-value pairs.
 func ConvertMapToKeyValueStringArray(key string, args map[string]string) []string {
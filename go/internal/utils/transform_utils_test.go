@@ -4,6 +4,7 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -99,3 +100,20 @@ func TestConcat(t *testing.T) {
 		})
 	}
 }
+
+func TestIntsToStrings(t *testing.T) {
+	assert.Equal(t, []string{"0", "-1", "16383"}, IntsToStrings([]int64{0, -1, 16383}))
+	assert.Equal(t, []string{}, IntsToStrings([]int64{}))
+	assert.Equal(t, []string{}, IntsToStrings(nil))
+}
+
+func TestKeysAndTimeout(t *testing.T) {
+	assert.Equal(t, []string{"key1", "key2", "0.5"}, KeysAndTimeout([]string{"key1", "key2"}, 500*time.Millisecond))
+	assert.Equal(t, []string{"0"}, KeysAndTimeout(nil, 0))
+
+	// The backing array of the keys is not modified
+	keys := make([]string, 1, 2)
+	keys[0] = "key"
+	assert.Equal(t, []string{"key", "1"}, KeysAndTimeout(keys, time.Second))
+	assert.Equal(t, []string{"key", ""}, keys[:2])
+}
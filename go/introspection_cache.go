@@ -0,0 +1,91 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// Keys of the introspection cache. Arguments are separated with a character that cannot appear in a command name.
+const (
+	commandInfoCacheKey   = "COMMAND INFO"
+	clusterShardsCacheKey = "CLUSTER SHARDS"
+	infoServerCacheKey    = "INFO SERVER"
+)
+
+// CommandInfo returns details about the given commands.
+//
+// If the introspection cache is enabled with [config.ClientConfiguration.WithIntrospectionCache] or
+// [config.ClusterClientConfiguration.WithIntrospectionCache], the result may be served from the cache.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	commandNames - The names of the commands, e.g. "get" or "config|get" for a subcommand.
+//
+// Return value:
+//
+//	The details of every known command, by lowercase command name. Unknown commands are omitted.
+//
+// [valkey.io]: https://valkey.io/commands/command-info/
+func (client *baseClient) CommandInfo(ctx context.Context, commandNames []string) (map[string]models.CommandInfo, error) {
+	cacheKey := commandInfoCacheKey + "\x00" + strings.ToLower(strings.Join(commandNames, "\x00"))
+	if data, ok := client.introspectionCache.Get(cacheKey); ok {
+		return convertCommandInfoResponse(data)
+	}
+	result, err := client.executeCommand(ctx, C.CommandInfo, commandNames)
+	if err != nil {
+		return nil, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	// The raw reply is cached and converted on every call, so that callers cannot modify the cached value.
+	info, err := convertCommandInfoResponse(data)
+	if err != nil {
+		return nil, err
+	}
+	client.introspectionCache.Put(cacheKey, data)
+	return info, nil
+}
+
+// InvalidateIntrospectionCache removes all the results from the introspection cache, so that the next introspection
+// commands are sent to the server. Does nothing if the cache is not enabled.
+//
+// See [config.IntrospectionCacheConfiguration] for details.
+func (client *baseClient) InvalidateIntrospectionCache() {
+	client.introspectionCache.Clear()
+}
+
+// isServerSectionOnly returns whether `INFO` is called for the "server" section only, the only cached section since the
+// other sections hold statistics that change all the time.
+func isServerSectionOnly(sections []constants.Section) bool {
+	return len(sections) == 1 && strings.EqualFold(string(sections[0]), string(constants.Server))
+}
+
+// infoServerCacheKeyForRoute returns the cache key of `INFO SERVER` sent with `route`, and false if the route is
+// not cacheable.
+func infoServerCacheKeyForRoute(route config.Route) (string, bool) {
+	switch route := route.(type) {
+	case nil:
+		return infoServerCacheKey, true
+	case config.SimpleMultiNodeRoute:
+		if route == config.AllNodes {
+			return infoServerCacheKey + "\x00AllNodes", true
+		}
+		return infoServerCacheKey + "\x00AllPrimaries", true
+	default:
+		return "", false
+	}
+}
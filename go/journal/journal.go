@@ -0,0 +1,385 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package journal persists the writes that failed because the connection to the server was lost to a local
+// write-ahead journal, and replays them once the connection is restored. It is meant for the applications that tolerate
+// the eventual application of their writes, e.g. metrics or counters pipelines, rather than failing or buffering them in
+// memory, where they would be lost with the process.
+//
+// The writes are journaled by an interceptor of the client, see [config.Interceptor], and only the requests whose context
+// is marked with [WithReplay] or [WithIdempotencyKey] are journaled. The writes are applied at least once: a write whose
+// response was lost with the connection may have been applied by the server, and is applied again when replayed. The
+// journaled writes should therefore tolerate being applied twice, e.g. `SET`, `HSET` or `SADD`.
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ErrJournaled is wrapped, along with the error of the request, by the errors of the journaled requests, so that the
+// callers can tell a write that will be replayed from a failed one with `errors.Is`.
+var ErrJournaled = errors.New("the write was journaled to be replayed")
+
+// Entry is a journaled write.
+type Entry struct {
+	// The identifier of the entry, increasing in journal order.
+	ID uint64 `json:"id"`
+	// The command, its name followed by its arguments, as passed to `CustomCommand`.
+	Args []string `json:"args"`
+	// The idempotency key of the write, if any, see [WithIdempotencyKey].
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+	// When the write was journaled.
+	Time time.Time `json:"time"`
+}
+
+// record is a line of the journal file: a journaled entry, or the identifier of an entry that was replayed or dropped.
+type record struct {
+	Entry *Entry `json:"entry,omitempty"`
+	Done  uint64 `json:"done,omitempty"`
+}
+
+// replayKey marks the contexts of the requests to journal, with their idempotency key.
+type replayKey struct{}
+
+// WithReplay returns a copy of `ctx` marking the requests sent with it to be journaled and replayed if they fail because
+// the connection was lost.
+func WithReplay(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replayKey{}, "")
+}
+
+// WithIdempotencyKey returns a copy of `ctx` marking the requests sent with it to be journaled and replayed, like
+// [WithReplay], as the write identified by `key`. A write is journaled once while it is pending, however many times it
+// fails, so that the writes retried by the application are replayed once.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, replayKey{}, key)
+}
+
+// Journal journals the writes that failed because the connection was lost to a file, and replays them.
+//
+// Example:
+//
+//	writes, err := journal.Open("/var/lib/app/writes.journal")
+//	if err != nil {
+//		return err
+//	}
+//	defer writes.Close()
+//	client, err := glide.NewClient(config.NewClientConfiguration().
+//		WithAddress(&config.NodeAddress{Host: "localhost", Port: 6379}).
+//		WithInterceptor(writes.Interceptor()))
+//	if err != nil {
+//		return err
+//	}
+//	go writes.Run(ctx, client, time.Second)
+//
+//	_, err = client.HSet(journal.WithReplay(ctx), "metrics:cpu", map[string]string{"host-1": "0.42"})
+//	if errors.Is(err, journal.ErrJournaled) {
+//		// Replayed once the connection is restored
+//	}
+type Journal struct {
+	mu   sync.Mutex
+	file *os.File
+	// The entries that were not replayed yet, in journal order.
+	pending []Entry
+	// The idempotency keys of the pending entries.
+	keys   map[string]struct{}
+	nextID uint64
+	// Held while replaying, so that the entries are replayed once.
+	replaying sync.Mutex
+	onDropped func(Entry, error)
+}
+
+// Open opens the journal file at `path`, creating it if it does not exist. The entries journaled by a previous process
+// and not replayed yet are kept, to be replayed by the new one.
+func Open(path string) (*Journal, error) {
+	j := &Journal{keys: map[string]struct{}{}, nextID: 1}
+	if err := j.load(path); err != nil {
+		return nil, err
+	}
+	if err := j.rewrite(path); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	j.file = file
+	return j, nil
+}
+
+// OnDropped sets the handler called with the entries that are dropped when replayed because the server rejected them,
+// e.g. with a `WRONGTYPE` error, since replaying them again would fail the same way.
+func (j *Journal) OnDropped(handler func(Entry, error)) *Journal {
+	j.onDropped = handler
+	return j
+}
+
+// load reads the pending entries of the journal file at `path`, if it exists. A truncated last line, written by a process
+// that stopped while journaling, is ignored.
+func (j *Journal) load(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	done := map[uint64]struct{}{}
+	var entries []Entry
+	lines := bytes.Split(bytes.TrimSuffix(data, []byte("\n")), []byte("\n"))
+	for i, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+		var r record
+		if err := json.Unmarshal(line, &r); err != nil {
+			if i == len(lines)-1 && !bytes.HasSuffix(data, []byte("\n")) {
+				break
+			}
+			return fmt.Errorf("invalid journal line %d: %w", i+1, err)
+		}
+		if r.Entry != nil {
+			entries = append(entries, *r.Entry)
+			j.nextID = max(j.nextID, r.Entry.ID+1)
+		} else {
+			done[r.Done] = struct{}{}
+		}
+	}
+	for _, entry := range entries {
+		if _, ok := done[entry.ID]; !ok {
+			j.pending = append(j.pending, entry)
+			if entry.IdempotencyKey != "" {
+				j.keys[entry.IdempotencyKey] = struct{}{}
+			}
+		}
+	}
+	return nil
+}
+
+// rewrite replaces the journal file at `path` with the pending entries only.
+func (j *Journal) rewrite(path string) error {
+	tmp := path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(file)
+	for i := range j.pending {
+		if err = writeRecord(writer, record{Entry: &j.pending[i]}); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// writeRecord writes `r` as a line to `writer`.
+func writeRecord(writer io.Writer, r record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(append(line, '\n'))
+	return err
+}
+
+// append writes `r` to the journal file and syncs it, so that it survives the process. Called with the lock held.
+func (j *Journal) append(r record) error {
+	if err := writeRecord(j.file, r); err != nil {
+		return err
+	}
+	return j.file.Sync()
+}
+
+// Close closes the journal file. The pending entries are kept in the file.
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.file.Close()
+}
+
+// Pending returns the entries that were not replayed yet, in journal order.
+func (j *Journal) Pending() []Entry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return slices.Clone(j.pending)
+}
+
+// Interceptor returns the interceptor journaling the failed writes, to be added to the configuration of the client with
+// `WithInterceptor`. The requests marked with [WithReplay] or [WithIdempotencyKey] that fail with a
+// [glide.ConnectionError], a [glide.DisconnectError] or a [glide.TimeoutError], as the requests sent while the client
+// reconnects time out, are journaled, and fail with an error wrapping [ErrJournaled] and the error of the request. The
+// batches are not journaled.
+func (j *Journal) Interceptor() config.Interceptor {
+	return func(ctx context.Context, cmd models.Command, next config.Invoker) (any, error) {
+		result, err := next(ctx, cmd)
+		idempotencyKey, marked := ctx.Value(replayKey{}).(string)
+		if err == nil || !marked || cmd.Batch != nil || !isConnectionLoss(err) {
+			return result, err
+		}
+		if journalErr := j.add(cmd, idempotencyKey); journalErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to journal the write: %w", journalErr))
+		}
+		return nil, fmt.Errorf("%w: %w", ErrJournaled, err)
+	}
+}
+
+// add journals `cmd`, unless a pending entry has the same idempotency key.
+func (j *Journal) add(cmd models.Command, idempotencyKey string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, ok := j.keys[idempotencyKey]; ok && idempotencyKey != "" {
+		return nil
+	}
+	entry := Entry{
+		ID:             j.nextID,
+		Args:           append(strings.Fields(cmd.Name), cmd.Args...),
+		IdempotencyKey: idempotencyKey,
+		Time:           time.Now(),
+	}
+	if err := j.append(record{Entry: &entry}); err != nil {
+		return err
+	}
+	j.nextID++
+	j.pending = append(j.pending, entry)
+	if idempotencyKey != "" {
+		j.keys[idempotencyKey] = struct{}{}
+	}
+	return nil
+}
+
+// isConnectionLoss returns whether `err` is the error of a request that failed because the connection was lost, or
+// that may have, so that the request should be sent again once the connection is restored.
+func isConnectionLoss(err error) bool {
+	var connectionErr *glide.ConnectionError
+	var disconnectErr *glide.DisconnectError
+	var timeoutErr *glide.TimeoutError
+	return errors.As(err, &connectionErr) || errors.As(err, &disconnectErr) || errors.As(err, &timeoutErr)
+}
+
+// Replay sends the pending entries to the server with `client`, in journal order, until they are all replayed or the
+// connection is lost again. The entries the server rejects are dropped, see [Journal.OnDropped].
+//
+// Parameters:
+//
+//	ctx - The context for controlling the replay.
+//	client - The client sending the entries, a [glide.Client] or a [glide.ClusterClient].
+//
+// Return value:
+//
+//	The number of entries replayed, and the error that stopped the replay, if any, in which case the remaining entries
+//	are replayed by the next call.
+func (j *Journal) Replay(ctx context.Context, client interfaces.BaseClientCommands) (int, error) {
+	switch client := client.(type) {
+	case *glide.Client:
+		return j.replay(ctx, func(ctx context.Context, args []string) error {
+			_, err := client.CustomCommand(ctx, args)
+			return err
+		})
+	case *glide.ClusterClient:
+		return j.replay(ctx, func(ctx context.Context, args []string) error {
+			_, err := client.CustomCommand(ctx, args)
+			return err
+		})
+	}
+	return 0, fmt.Errorf("unsupported client type %T", client)
+}
+
+// replay replays the pending entries with `send`. The replayed requests are not journaled again, as the journal keeps the
+// entries until they are replayed.
+func (j *Journal) replay(ctx context.Context, send func(ctx context.Context, args []string) error) (int, error) {
+	ctx = context.WithValue(ctx, replayKey{}, nil)
+	j.replaying.Lock()
+	defer j.replaying.Unlock()
+	replayed := 0
+	for {
+		j.mu.Lock()
+		if len(j.pending) == 0 {
+			j.mu.Unlock()
+			return replayed, nil
+		}
+		entry := j.pending[0]
+		j.mu.Unlock()
+
+		err := send(ctx, entry.Args)
+		if err != nil && (isConnectionLoss(err) || ctx.Err() != nil) {
+			return replayed, err
+		}
+		if doneErr := j.done(entry); doneErr != nil {
+			return replayed, doneErr
+		}
+		if err != nil {
+			if j.onDropped != nil {
+				j.onDropped(entry, err)
+			}
+			continue
+		}
+		replayed++
+	}
+}
+
+// done removes `entry`, the first pending entry, from the journal. The journal file is emptied once no entry is pending.
+func (j *Journal) done(entry Entry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if err := j.append(record{Done: entry.ID}); err != nil {
+		return err
+	}
+	j.pending = j.pending[1:]
+	delete(j.keys, entry.IdempotencyKey)
+	if len(j.pending) == 0 {
+		return j.file.Truncate(0)
+	}
+	return nil
+}
+
+// Run replays the pending entries with `client` every `interval`, until `ctx` is cancelled, so that the journaled writes
+// are applied once the connection is restored.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the replays. Cancelling it stops them.
+//	client - The client sending the entries, a [glide.Client] or a [glide.ClusterClient].
+//	interval - How often the pending entries are replayed.
+//
+// Return value:
+//
+//	The error of `ctx` once it is cancelled.
+func (j *Journal) Run(ctx context.Context, client interfaces.BaseClientCommands, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			_, _ = j.Replay(ctx, client)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package journal
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// failing is an invoker failing the requests with `err`.
+func failing(err error) func(ctx context.Context, cmd models.Command) (any, error) {
+	return func(ctx context.Context, cmd models.Command) (any, error) { return nil, err }
+}
+
+func TestJournal_Interceptor(t *testing.T) {
+	j, err := Open(filepath.Join(t.TempDir(), "writes.journal"))
+	require.NoError(t, err)
+	defer j.Close()
+	ctx := context.Background()
+	interceptor := j.Interceptor()
+	hset := models.Command{Name: "HSET", Args: []string{"metrics", "cpu", "0.42"}}
+	lost := failing(glide.NewDisconnectError("connection lost"))
+
+	// The marked requests failing because the connection was lost are journaled
+	_, err = interceptor(WithReplay(ctx), hset, lost)
+	assert.ErrorIs(t, err, ErrJournaled)
+	var disconnectErr *glide.DisconnectError
+	assert.ErrorAs(t, err, &disconnectErr)
+
+	// The other requests are not
+	_, err = interceptor(ctx, hset, lost)
+	assert.NotErrorIs(t, err, ErrJournaled)
+	_, err = interceptor(WithReplay(ctx), hset, failing(errors.New("WRONGTYPE")))
+	assert.NotErrorIs(t, err, ErrJournaled)
+	batch := models.Command{Name: "Batch", Batch: []models.Command{hset}}
+	_, err = interceptor(WithReplay(ctx), batch, lost)
+	assert.NotErrorIs(t, err, ErrJournaled)
+	result, err := interceptor(WithReplay(ctx), hset, func(ctx context.Context, cmd models.Command) (any, error) {
+		return "OK", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	// The writes with the same idempotency key are journaled once
+	xgroup := models.Command{Name: "XGROUP CREATE", Args: []string{"events", "workers", "$"}}
+	for range 2 {
+		_, err = interceptor(WithIdempotencyKey(ctx, "create-workers"), xgroup, failing(glide.NewTimeoutError("timeout")))
+		assert.ErrorIs(t, err, ErrJournaled)
+	}
+
+	pending := j.Pending()
+	require.Len(t, pending, 2)
+	assert.Equal(t, uint64(1), pending[0].ID)
+	assert.Equal(t, []string{"HSET", "metrics", "cpu", "0.42"}, pending[0].Args)
+	assert.Equal(t, uint64(2), pending[1].ID)
+	assert.Equal(t, []string{"XGROUP", "CREATE", "events", "workers", "$"}, pending[1].Args)
+	assert.Equal(t, "create-workers", pending[1].IdempotencyKey)
+}
+
+func TestJournal_Replay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "writes.journal")
+	j, err := Open(path)
+	require.NoError(t, err)
+	ctx := WithReplay(context.Background())
+	lost := failing(glide.NewConnectionError("connection refused"))
+	for _, key := range []string{"a", "b", "c"} {
+		_, err = j.Interceptor()(ctx, models.Command{Name: "SET", Args: []string{key, "1"}}, lost)
+		require.ErrorIs(t, err, ErrJournaled)
+	}
+	require.NoError(t, j.Close())
+
+	// The pending entries survive the process
+	j, err = Open(path)
+	require.NoError(t, err)
+	defer j.Close()
+	assert.Len(t, j.Pending(), 3)
+	var dropped []Entry
+	j.OnDropped(func(entry Entry, err error) { dropped = append(dropped, entry) })
+
+	// The replay stops when the connection is lost again, and resumes from the first entry that was not replayed
+	var sent []string
+	send := func(ctx context.Context, args []string) error {
+		if ctx.Value(replayKey{}) != nil {
+			return errors.New("replayed with a context marked to be journaled")
+		}
+		sent = append(sent, args[1])
+		switch {
+		case args[1] == "b" && len(sent) == 2:
+			return glide.NewDisconnectError("connection lost")
+		case args[1] == "c":
+			return errors.New("WRONGTYPE")
+		}
+		return nil
+	}
+	replayed, err := j.replay(ctx, send)
+	var disconnectErr *glide.DisconnectError
+	assert.ErrorAs(t, err, &disconnectErr)
+	assert.Equal(t, 1, replayed)
+	assert.Len(t, j.Pending(), 2)
+
+	// The entries rejected by the server are dropped
+	replayed, err = j.replay(ctx, send)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, replayed)
+	assert.Equal(t, []string{"a", "b", "b", "c"}, sent)
+	require.Len(t, dropped, 1)
+	assert.Equal(t, []string{"SET", "c", "1"}, dropped[0].Args)
+	assert.Empty(t, j.Pending())
+
+	// The journal file is emptied once every entry is replayed, and the new entries are appended to it
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Empty(t, data)
+	_, err = j.Interceptor()(ctx, models.Command{Name: "SET", Args: []string{"d", "1"}}, lost)
+	require.ErrorIs(t, err, ErrJournaled)
+	pending := j.Pending()
+	require.Len(t, pending, 1)
+	assert.Equal(t, uint64(4), pending[0].ID)
+}
+
+func TestOpen_TruncatedJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "writes.journal")
+	data := `{"entry":{"id":1,"args":["SET","a","1"],"time":"2024-01-01T00:00:00Z"}}
+{"entry":{"id":2,"args":["SET","b","1"],"time":"2024-01-01T00:00:00Z"}}
+{"done":1}
+{"entry":{"id":3,"args":["SET",`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	// The last line, written by a process that stopped while journaling, is ignored
+	j, err := Open(path)
+	require.NoError(t, err)
+	defer j.Close()
+	pending := j.Pending()
+	require.Len(t, pending, 1)
+	assert.Equal(t, []string{"SET", "b", "1"}, pending[0].Args)
+
+	// The other invalid lines are reported
+	require.NoError(t, os.WriteFile(path, []byte("not json\n"), 0o600))
+	_, err = Open(path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,227 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// keyspacePatternPrefix prefixes the patterns of the keyspace notifications of a key, in any database.
+const keyspacePatternPrefix = "__keyspace@*__:"
+
+// keyWatchers routes the keyspace notifications of the watched keys to their watchers. The notifications are received
+// with a pattern subscription per key, shared by the watchers of the key.
+type keyWatchers struct {
+	mu sync.Mutex
+	// The watched keys by keyspace pattern.
+	keys map[string]*watchedKey
+}
+
+// watchedKey is a key and its watchers.
+type watchedKey struct {
+	key      string
+	watchers map[*keyWatcher]struct{}
+}
+
+// keyWatcher collects the changed keys of a watch until they are delivered, so that no change is lost while the receiver
+// is busy, and the repeated changes of a key are delivered once.
+type keyWatcher struct {
+	mu      sync.Mutex
+	changed []string
+	// Signalled when a key is added to `changed`.
+	signal chan struct{}
+}
+
+func newKeyWatchers() *keyWatchers {
+	return &keyWatchers{keys: map[string]*watchedKey{}}
+}
+
+// keyspacePattern returns the pattern of the keyspace notifications of `serverKey`, the key as stored on the server, in
+// any database.
+func keyspacePattern(serverKey string) string {
+	var pattern strings.Builder
+	pattern.WriteString(keyspacePatternPrefix)
+	for _, char := range serverKey {
+		if strings.ContainsRune(`*?[]\`, char) {
+			pattern.WriteByte('\\')
+		}
+		pattern.WriteRune(char)
+	}
+	return pattern.String()
+}
+
+// add registers `watcher` for the keys of `patterns`, given by pattern, and returns the patterns that were not watched
+// before, to subscribe to.
+func (w *keyWatchers) add(watcher *keyWatcher, patterns map[string]string) []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var added []string
+	for pattern, key := range patterns {
+		watched, ok := w.keys[pattern]
+		if !ok {
+			watched = &watchedKey{key: key, watchers: map[*keyWatcher]struct{}{}}
+			w.keys[pattern] = watched
+			added = append(added, pattern)
+		}
+		watched.watchers[watcher] = struct{}{}
+	}
+	slices.Sort(added)
+	return added
+}
+
+// remove unregisters `watcher` from `patterns`, and returns the patterns that are no longer watched, to unsubscribe from.
+func (w *keyWatchers) remove(watcher *keyWatcher, patterns map[string]string) []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var removed []string
+	for pattern := range patterns {
+		watched, ok := w.keys[pattern]
+		if !ok {
+			continue
+		}
+		delete(watched.watchers, watcher)
+		if len(watched.watchers) == 0 {
+			delete(w.keys, pattern)
+			removed = append(removed, pattern)
+		}
+	}
+	slices.Sort(removed)
+	return removed
+}
+
+// notify passes `message` to the watchers of its pattern, and returns whether it is a keyspace notification of a watched
+// key, in which case it is not delivered to the subscription callback or queue of the client.
+func (w *keyWatchers) notify(message *models.PubSubMessage) bool {
+	if w == nil || message.Pattern.IsNil() {
+		return false
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	watched, ok := w.keys[message.Pattern.Value()]
+	if !ok {
+		return false
+	}
+	for watcher := range watched.watchers {
+		watcher.add(watched.key)
+	}
+	return true
+}
+
+func newKeyWatcher() *keyWatcher {
+	return &keyWatcher{signal: make(chan struct{}, 1)}
+}
+
+// add records that `key` changed.
+func (watcher *keyWatcher) add(key string) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	if !slices.Contains(watcher.changed, key) {
+		watcher.changed = append(watcher.changed, key)
+	}
+	select {
+	case watcher.signal <- struct{}{}:
+	default:
+	}
+}
+
+// take returns the keys that changed since the previous call, in the order of their first change.
+func (watcher *keyWatcher) take() []string {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	changed := watcher.changed
+	watcher.changed = nil
+	return changed
+}
+
+// deliver sends the changed keys to `changes` until `ctx` is done, and then closes it.
+func (watcher *keyWatcher) deliver(ctx context.Context, changes chan<- string) {
+	defer close(changes)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-watcher.signal:
+		}
+		for _, key := range watcher.take() {
+			select {
+			case changes <- key:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// WatchChanges watches `keys` for changes, and returns a channel receiving a key every time it changes. A change is what
+// aborts a transaction watching the key with `WATCH`: a command modifying or deleting the key, and its expiration or
+// eviction. It is meant for the caches that keep copies of the values, to drop a copy when its key changes instead of
+// polling the key.
+//
+// The changes are received as keyspace notifications, which the server only publishes if enabled by its
+// `notify-keyspace-events` configuration, e.g. "KA" for all the changes. The notifications are received with a pattern
+// subscription per key, on the connections of the client: the client can run commands and other subscriptions, but the
+// notifications of the watched keys are not delivered to the subscription callback or queue of the client. To keep the
+// notifications off the connections running the commands, watch the keys with a [PubSubClient].
+//
+// A key is watched in every database. In cluster mode, the keyspace notifications are published by the node owning the
+// key only, so every key must be in the same slot as the channel of its notifications, i.e. have a hash tag, e.g.
+// "{user:42}" or "{user:42}:profile" rather than "user:42".
+//
+// The changes that happen before WatchChanges returns are not reported: read the values after watching their keys. The
+// changes of a key that happen while its previous change is not received yet are reported once.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the watch. WatchChanges waits for the server to confirm the subscriptions until
+//	  `ctx` is done, and the watch stops, and the channel is closed, once it is done.
+//	keys - The keys to watch.
+//
+// Return value:
+//
+//	The channel receiving the changed keys, closed when `ctx` is done.
+func (client *baseClient) WatchChanges(ctx context.Context, keys ...string) (<-chan string, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one key must be watched")
+	}
+	patterns := make(map[string]string, len(keys))
+	for _, key := range keys {
+		serverKey := client.keyPrefix + key
+		pattern := keyspacePattern(serverKey)
+		if client.clusterMode && utils.KeySlot(pattern) != utils.KeySlot(serverKey) {
+			return nil, fmt.Errorf(
+				"key %q must have a hash tag in cluster mode, so that its keyspace notifications are received", key,
+			)
+		}
+		patterns[pattern] = key
+	}
+
+	watcher := newKeyWatcher()
+	if added := client.keyWatchers.add(watcher, patterns); len(added) > 0 {
+		if err := client.PSubscribe(ctx, added, 0); err != nil {
+			client.unwatch(watcher, patterns)
+			return nil, err
+		}
+	}
+
+	changes := make(chan string)
+	go func() {
+		watcher.deliver(ctx, changes)
+		client.unwatch(watcher, patterns)
+	}()
+	return changes, nil
+}
+
+// unwatch unregisters `watcher`, and unsubscribes from the patterns no longer watched.
+func (client *baseClient) unwatch(watcher *keyWatcher, patterns map[string]string) {
+	if removed := client.keyWatchers.remove(watcher, patterns); len(removed) > 0 {
+		_ = client.PUnsubscribeLazy(context.Background(), removed)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func keyspaceMessage(pattern string, key string) *models.PubSubMessage {
+	return models.NewPubSubMessageWithPattern("set", "__keyspace@0__:"+key, models.CreateStringResult(pattern))
+}
+
+func TestKeyspacePattern(t *testing.T) {
+	assert.Equal(t, "__keyspace@*__:user:1", keyspacePattern("user:1"))
+	assert.Equal(t, `__keyspace@*__:a\*b\?\[c\]\\`, keyspacePattern(`a*b?[c]\`))
+
+	// The patterns of the keys with a hash tag are in the slot of the keys
+	assert.Equal(t, utils.KeySlot("{user:1}:profile"), utils.KeySlot(keyspacePattern("{user:1}:profile")))
+	assert.NotEqual(t, utils.KeySlot("user:1"), utils.KeySlot(keyspacePattern("user:1")))
+}
+
+func TestKeyWatchers(t *testing.T) {
+	watchers := newKeyWatchers()
+	first, second := newKeyWatcher(), newKeyWatcher()
+	a, b := keyspacePattern("a"), keyspacePattern("b")
+
+	// The patterns are subscribed to once, and unsubscribed from when no longer watched
+	assert.Equal(t, []string{a, b}, watchers.add(first, map[string]string{a: "a", b: "b"}))
+	assert.Empty(t, watchers.add(second, map[string]string{a: "a"}))
+
+	assert.True(t, watchers.notify(keyspaceMessage(a, "a")))
+	assert.True(t, watchers.notify(keyspaceMessage(b, "b")))
+	assert.True(t, watchers.notify(keyspaceMessage(a, "a")))
+	assert.False(t, watchers.notify(keyspaceMessage("news.*", "news.1")))
+	assert.False(t, watchers.notify(models.NewPubSubMessage("hello", "news")))
+	assert.Equal(t, []string{"a", "b"}, first.take())
+	assert.Equal(t, []string{"a"}, second.take())
+
+	assert.Empty(t, watchers.remove(first, map[string]string{a: "a"}))
+	assert.Equal(t, []string{a}, watchers.remove(second, map[string]string{a: "a"}))
+	assert.Equal(t, []string{b}, watchers.remove(first, map[string]string{b: "b"}))
+	assert.False(t, watchers.notify(keyspaceMessage(a, "a")))
+	assert.False(t, (*keyWatchers)(nil).notify(keyspaceMessage(a, "a")))
+}
+
+func TestKeyWatcher_Deliver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	watcher := newKeyWatcher()
+	changes := make(chan string)
+
+	// The changes are not lost while the receiver is busy, and the repeated changes are coalesced
+	watcher.add("a")
+	watcher.add("b")
+	watcher.add("a")
+	go watcher.deliver(ctx, changes)
+	assert.Equal(t, "a", <-changes)
+	assert.Equal(t, "b", <-changes)
+	select {
+	case key := <-changes:
+		assert.Fail(t, "unexpected change", key)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cancel()
+	_, ok := <-changes
+	assert.False(t, ok)
+}
@@ -0,0 +1,107 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// TTLs returns the remaining time to live in seconds of several keys, like [Client.TTL] for every key, in a single
+// non-atomic pipeline. In cluster mode, the pipeline is split by node, and the keys may belong to different slots.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys to return the time to live of.
+//
+// Return value:
+//
+//	The time to live in seconds of every key. -1 if the key exists but has no associated expiration, -2 if the key does
+//	not exist.
+//
+// [valkey.io]: https://valkey.io/commands/ttl/
+func (client *baseClient) TTLs(ctx context.Context, keys []string) (map[string]int64, error) {
+	return client.executeTTLs(ctx, keys, false)
+}
+
+// PTTLs returns the remaining time to live in milliseconds of several keys, like [Client.PTTL] for every key, in a single
+// non-atomic pipeline. In cluster mode, the pipeline is split by node, and the keys may belong to different slots.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys to return the time to live of.
+//
+// Return value:
+//
+//	The time to live in milliseconds of every key. -1 if the key exists but has no associated expiration, -2 if the key
+//	does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/pttl/
+func (client *baseClient) PTTLs(ctx context.Context, keys []string) (map[string]int64, error) {
+	return client.executeTTLs(ctx, keys, true)
+}
+
+func (client *baseClient) executeTTLs(ctx context.Context, keys []string, milliseconds bool) (map[string]int64, error) {
+	unique := make([]string, 0, len(keys))
+	seen := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			unique = append(unique, key)
+		}
+	}
+	if len(unique) == 0 {
+		return map[string]int64{}, nil
+	}
+
+	var batch internal.Batch
+	if client.clusterMode {
+		b := pipeline.NewClusterBatch(false)
+		addTTLCommands(&b.BaseBatch, unique, milliseconds)
+		batch = b.Batch
+	} else {
+		b := pipeline.NewStandaloneBatch(false)
+		addTTLCommands(&b.BaseBatch, unique, milliseconds)
+		batch = b.Batch
+	}
+	results, err := client.executeBatch(ctx, batch, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ttls := make(map[string]int64, len(unique))
+	for i, result := range results {
+		switch result := result.(type) {
+		case int64:
+			ttls[unique[i]] = result
+		case error:
+			return nil, fmt.Errorf("failed to get the TTL of key %q: %w", unique[i], result)
+		default:
+			return nil, fmt.Errorf("unexpected TTL response type for key %q: %T", unique[i], result)
+		}
+	}
+	return ttls, nil
+}
+
+func addTTLCommands[T pipeline.StandaloneBatch | pipeline.ClusterBatch](
+	batch *pipeline.BaseBatch[T],
+	keys []string,
+	milliseconds bool,
+) {
+	for _, key := range keys {
+		if milliseconds {
+			batch.PTTL(key)
+		} else {
+			batch.TTL(key)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package keyspace exports the keys of a server to a stream, and imports them back into the same or another server, e.g.
+// to back up a keyspace or to clone it from a standalone server to a cluster.
+//
+// The keys are serialized with `DUMP` and deserialized with `RESTORE`, so that any data type is supported. Their expiry
+// is preserved as an absolute time: a key imported after it expired is skipped. The serialized values are specific to the
+// server version, and can only be imported into a server of the same or a newer version.
+package keyspace
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/keyscan"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// The stream starts with a header identifying the format and its version, followed by a record per key, and ends with an
+// end marker. A key record holds the key, its expiry as a Unix time in milliseconds or 0 if it does not expire, and its
+// value serialized by `DUMP`, each prefixed by its length as a varint.
+var header = []byte("GLIDEKS\x01")
+
+const (
+	recordKey byte = 1
+	recordEnd byte = 0xFF
+)
+
+// scanCount is the number of keys requested from every `SCAN` call.
+const scanCount = 1000
+
+// ImportOptions are the optional arguments of [ImportWithOptions].
+type ImportOptions struct {
+	// Replace the keys that already exist. By default, importing a key that already exists fails.
+	Replace bool
+	// The clock the expiry of the keys is compared to, to skip the expired keys, e.g. a [clock.Fake] in tests. The clock of
+	// the system by default.
+	Clock clock.Clock
+}
+
+// Export writes the keys matching `pattern` to `w`, along with their expiry. The keys are listed with `SCAN`, or with a
+// cluster scan of all the primaries in cluster mode. A key deleted during the export may be skipped.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to export the keys from, either a [glide.Client] or a [glide.ClusterClient].
+//	pattern - The glob-style pattern of the keys to export, e.g. "*" for all the keys.
+//	w - The writer to write the exported keys to.
+//
+// Return value:
+//
+//	The number of keys exported. If an error is returned, the stream is incomplete and cannot be imported.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func Export(ctx context.Context, client interfaces.BaseClientCommands, pattern string, w io.Writer) (int64, error) {
+	writer := bufio.NewWriter(w)
+	if _, err := writer.Write(header); err != nil {
+		return 0, err
+	}
+	var exported int64
+	err := keyscan.Scan(ctx, client, pattern, scanCount, func(keys []string) error {
+		for _, key := range keys {
+			ok, err := exportKey(ctx, client, key, writer)
+			if err != nil {
+				return err
+			}
+			if ok {
+				exported++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return exported, err
+	}
+	if err := writer.WriteByte(recordEnd); err != nil {
+		return exported, err
+	}
+	return exported, writer.Flush()
+}
+
+// Import restores the keys exported by [Export] from `r`, failing on the keys that already exist. See [ImportWithOptions]
+// for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to import the keys into, either a [glide.Client] or a [glide.ClusterClient].
+//	r - The reader to read the exported keys from.
+//
+// Return value:
+//
+//	The number of keys imported.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func Import(ctx context.Context, client interfaces.BaseClientCommands, r io.Reader) (int64, error) {
+	return ImportWithOptions(ctx, client, r, ImportOptions{})
+}
+
+// ImportWithOptions restores the keys exported by [Export] from `r` with `RESTORE`, along with their expiry. The keys
+// that expired since the export are skipped.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to import the keys into, either a [glide.Client] or a [glide.ClusterClient].
+//	r - The reader to read the exported keys from.
+//	opts - Whether to replace the existing keys, see [ImportOptions].
+//
+// Return value:
+//
+//	The number of keys imported. If an error is returned, the keys imported before the error are kept.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func ImportWithOptions(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	r io.Reader,
+	opts ImportOptions,
+) (int64, error) {
+	reader := bufio.NewReader(r)
+	actualHeader := make([]byte, len(header))
+	if _, err := io.ReadFull(reader, actualHeader); err != nil || !bytes.Equal(actualHeader, header) {
+		return 0, errors.New("not a keyspace export, or an export of an unsupported version")
+	}
+	clk := clock.System()
+	if opts.Clock != nil {
+		clk = opts.Clock
+	}
+	restoreOptions := options.NewRestoreOptions().SetABSTTL()
+	if opts.Replace {
+		restoreOptions.SetReplace()
+	}
+
+	var imported int64
+	for {
+		recordType, err := reader.ReadByte()
+		if err != nil {
+			return imported, truncated(err)
+		}
+		if recordType == recordEnd {
+			return imported, nil
+		}
+		if recordType != recordKey {
+			return imported, fmt.Errorf("malformed keyspace export: unknown record type %d", recordType)
+		}
+		key, expireAt, value, err := readKey(reader)
+		if err != nil {
+			return imported, err
+		}
+		ttl := time.Duration(expireAt) * time.Millisecond
+		if expireAt != 0 && clk.Now().UnixMilli() >= expireAt {
+			continue
+		}
+		if _, err := client.RestoreWithOptions(ctx, key, ttl, value, *restoreOptions); err != nil {
+			return imported, fmt.Errorf("failed to restore key %q: %w", key, err)
+		}
+		imported++
+	}
+}
+
+// exportKey writes the record of `key`, and returns false if the key no longer exists.
+func exportKey(ctx context.Context, client interfaces.BaseClientCommands, key string, w *bufio.Writer) (bool, error) {
+	value, err := client.Dump(ctx, key)
+	if err != nil || value.IsNil() {
+		return false, err
+	}
+	ttl, err := client.PTTL(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	var expireAt int64
+	switch {
+	case ttl == -2:
+		// Deleted or expired since DUMP.
+		return false, nil
+	case ttl >= 0:
+		expireAt = time.Now().UnixMilli() + max(ttl, 1)
+	}
+
+	buf := make([]byte, 0, 1+3*binary.MaxVarintLen64+len(key)+len(value.Value()))
+	buf = append(buf, recordKey)
+	buf = binary.AppendUvarint(buf, uint64(len(key)))
+	buf = append(buf, key...)
+	buf = binary.AppendVarint(buf, expireAt)
+	buf = binary.AppendUvarint(buf, uint64(len(value.Value())))
+	buf = append(buf, value.Value()...)
+	_, err = w.Write(buf)
+	return err == nil, err
+}
+
+// readKey reads the fields of a key record.
+func readKey(r *bufio.Reader) (key string, expireAt int64, value string, err error) {
+	if key, err = readString(r); err != nil {
+		return "", 0, "", err
+	}
+	if expireAt, err = binary.ReadVarint(r); err != nil {
+		return "", 0, "", truncated(err)
+	}
+	if value, err = readString(r); err != nil {
+		return "", 0, "", err
+	}
+	return key, expireAt, value, nil
+}
+
+func readString(r *bufio.Reader) (string, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", truncated(err)
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", truncated(err)
+	}
+	return string(buf), nil
+}
+
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errors.New("malformed keyspace export: truncated stream")
+	}
+	return err
+}
@@ -0,0 +1,75 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package keyspace
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+func TestExportImport(t *testing.T) {
+	expireAt := time.Now().Add(time.Hour).UnixMilli()
+	source := fakeclient.New()
+	source.Strings["user:1"] = "\x00\x01binary\xff"
+	source.Strings["user:2"] = "two"
+	source.Expiries["user:2"] = time.UnixMilli(expireAt)
+	source.Strings["session"] = "skipped"
+
+	var buf bytes.Buffer
+	exported, err := Export(context.Background(), source, "user:*", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), exported)
+
+	// The existing keys are replaced, and the expiries restored as Unix times
+	destination := fakeclient.New()
+	destination.Strings["user:1"] = "old"
+	_, err = Import(context.Background(), destination, bytes.NewReader(buf.Bytes()))
+	assert.ErrorContains(t, err, "BUSYKEY")
+	imported, err := ImportWithOptions(context.Background(), destination, bytes.NewReader(buf.Bytes()), ImportOptions{
+		Replace: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), imported)
+	assert.Equal(t, "\x00\x01binary\xff", destination.Strings["user:1"])
+	assert.NotContains(t, destination.Expiries, "user:1")
+	assert.Equal(t, "two", destination.Strings["user:2"])
+	assert.InDelta(t, expireAt, destination.Expiries["user:2"].UnixMilli(), 1000)
+}
+
+func TestImport_SkipsExpiredKeys(t *testing.T) {
+	source := fakeclient.New()
+	source.Strings["key"] = "value"
+	source.Expiries["key"] = time.Now().Add(20 * time.Millisecond)
+	var buf bytes.Buffer
+	_, err := Export(context.Background(), source, "*", &buf)
+	require.NoError(t, err)
+
+	destination := fakeclient.New()
+	imported, err := ImportWithOptions(context.Background(), destination, &buf, ImportOptions{
+		Clock: clock.NewFake(time.Now().Add(time.Minute)),
+	})
+	require.NoError(t, err)
+	assert.Zero(t, imported)
+	assert.Empty(t, destination.Strings)
+}
+
+func TestImport_Malformed(t *testing.T) {
+	destination := fakeclient.New()
+	_, err := Import(context.Background(), destination, bytes.NewReader([]byte("not an export")))
+	assert.ErrorContains(t, err, "not a keyspace export")
+
+	source := fakeclient.New()
+	source.Strings["key"] = "value"
+	var buf bytes.Buffer
+	_, err = Export(context.Background(), source, "*", &buf)
+	require.NoError(t, err)
+	_, err = Import(context.Background(), destination, bytes.NewReader(buf.Bytes()[:buf.Len()-3]))
+	assert.ErrorContains(t, err, "truncated")
+}
@@ -0,0 +1,66 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type latencyBudgetKey struct{}
+
+// latencyBudget is the latency budget attached to a context by WithLatencyBudget.
+type latencyBudget struct {
+	start  time.Time
+	budget time.Duration
+}
+
+// WithLatencyBudget returns a copy of `ctx` that is cancelled once `budget` elapsed, like [context.WithTimeout], and that
+// carries the budget, so that the requests sent with it can be shed once they used too much of it.
+//
+// The budget typically covers the whole handling of an incoming request, including the time spent waiting in the queues
+// of the application and the previous requests sent with the same context. If a client is configured with
+// [config.ClientConfiguration.WithLatencyBudgetShedding] and a request is sent after the given fraction of its budget
+// elapsed, the request fails fast with a [BudgetExceededError] instead of being sent: it is unlikely to complete in time,
+// and would only add to the load of a server that is already slow.
+//
+// Example:
+//
+//	ctx, cancel := glide.WithLatencyBudget(ctx, 100*time.Millisecond)
+//	defer cancel()
+//	value, err := client.Get(ctx, "key")
+//	var budgetErr *glide.BudgetExceededError
+//	if errors.As(err, &budgetErr) {
+//	    // serve a degraded response
+//	}
+func WithLatencyBudget(ctx context.Context, budget time.Duration) (context.Context, context.CancelFunc) {
+	ctx = context.WithValue(ctx, latencyBudgetKey{}, latencyBudget{start: time.Now(), budget: budget})
+	return context.WithTimeout(ctx, budget)
+}
+
+// checkLatencyBudget returns a [BudgetExceededError] if the client sheds requests and the request sent with `ctx` already
+// used more than the shedding fraction of its latency budget.
+func (client *baseClient) checkLatencyBudget(ctx context.Context) error {
+	if client.sheddingFraction == 0 {
+		return nil
+	}
+	budget, ok := ctx.Value(latencyBudgetKey{}).(latencyBudget)
+	if !ok {
+		return nil
+	}
+	elapsed := time.Since(budget.start)
+	if float64(elapsed) <= client.sheddingFraction*float64(budget.budget) {
+		return nil
+	}
+	return NewBudgetExceededError(
+		fmt.Sprintf(
+			"request shed: %v of its %v latency budget elapsed before it was sent, more than the shedding fraction %v",
+			elapsed,
+			budget.budget,
+			client.sheddingFraction,
+		),
+		budget.budget,
+		elapsed,
+	)
+}
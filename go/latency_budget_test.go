@@ -0,0 +1,39 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckLatencyBudget(t *testing.T) {
+	client := &baseClient{sheddingFraction: 0.5}
+
+	// Requests without a budget are never shed
+	assert.NoError(t, client.checkLatencyBudget(context.Background()))
+
+	ctx, cancel := WithLatencyBudget(context.Background(), time.Hour)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Minute)
+	assert.NoError(t, client.checkLatencyBudget(ctx))
+
+	ctx, cancel = WithLatencyBudget(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	time.Sleep(15 * time.Millisecond)
+	err := client.checkLatencyBudget(ctx)
+	var budgetErr *BudgetExceededError
+	require.True(t, errors.As(err, &budgetErr))
+	assert.Equal(t, 20*time.Millisecond, budgetErr.Budget)
+	assert.GreaterOrEqual(t, budgetErr.Elapsed, 15*time.Millisecond)
+
+	// Clients without shedding never shed
+	assert.NoError(t, (&baseClient{}).checkLatencyBudget(ctx))
+}
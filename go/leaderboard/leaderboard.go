@@ -0,0 +1,274 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package leaderboard provides leaderboards stored in Valkey sorted sets, ranking members by their score.
+package leaderboard
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Entry is a member of a leaderboard along with its score and rank.
+type Entry struct {
+	Member string
+	Score  float64
+	// The rank of the member, starting at 0 for the first member of the leaderboard.
+	Rank int64
+}
+
+// Leaderboard ranks members by their score, stored in a sorted set.
+//
+// By default, the member with the highest score is ranked first. With [Leaderboard.WithAscending], the member with the
+// lowest score is ranked first instead, e.g. to rank the players of a race by their time. Members with the same score are
+// ranked in lexicographical order of the members, in reverse for a descending leaderboard.
+//
+// Example:
+//
+//	scores := leaderboard.New(client, "game:scores")
+//	_, err := scores.AddScore(ctx, "alice", 50)
+//	...
+//	top, err := scores.Top(ctx, 10)
+//	neighbours, err := scores.Around(ctx, "alice", 2)
+type Leaderboard struct {
+	client    interfaces.BaseClientCommands
+	key       string
+	ascending bool
+}
+
+// New creates a descending [Leaderboard] stored in the sorted set at `key`.
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the sorted set.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands, key string) *Leaderboard {
+	return &Leaderboard{client: client, key: key}
+}
+
+// WithAscending ranks the member with the lowest score first.
+func (l *Leaderboard) WithAscending() *Leaderboard {
+	l.ascending = true
+	return l
+}
+
+// Key returns the key of the sorted set of the leaderboard.
+func (l *Leaderboard) Key() string {
+	return l.key
+}
+
+// AddScore adds `score` to the score of `member`, adding the member with a score of `score` if it is not in the
+// leaderboard. A negative `score` decreases the score of the member.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member whose score to increment.
+//	score - The amount to add to the score of the member.
+//
+// Return value:
+//
+//	The new score of the member.
+func (l *Leaderboard) AddScore(ctx context.Context, member string, score float64) (float64, error) {
+	return l.client.ZIncrBy(ctx, l.key, score, member)
+}
+
+// SetScores sets the scores of members, adding the ones that are not in the leaderboard.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	scores - The scores of the members.
+//
+// Return value:
+//
+//	The number of members added to the leaderboard.
+func (l *Leaderboard) SetScores(ctx context.Context, scores map[string]float64) (int64, error) {
+	return l.client.ZAdd(ctx, l.key, scores)
+}
+
+// Remove removes members from the leaderboard.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	members - The members to remove.
+//
+// Return value:
+//
+//	The number of members removed, not counting the ones that were not in the leaderboard.
+func (l *Leaderboard) Remove(ctx context.Context, members ...string) (int64, error) {
+	return l.client.ZRem(ctx, l.key, members)
+}
+
+// Size returns the number of members in the leaderboard.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The number of members in the leaderboard, 0 if it does not exist.
+func (l *Leaderboard) Size(ctx context.Context) (int64, error) {
+	return l.client.ZCard(ctx, l.key)
+}
+
+// Top returns the first `n` members of the leaderboard. Same as `Page(ctx, 0, n)`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	n - The number of members to return.
+//
+// Return value:
+//
+//	The first members of the leaderboard, in rank order. Fewer than `n` if the leaderboard is smaller.
+func (l *Leaderboard) Top(ctx context.Context, n int64) ([]Entry, error) {
+	return l.Page(ctx, 0, n)
+}
+
+// Page returns `count` members of the leaderboard starting at rank `offset`, e.g. `Page(ctx, 20, 10)` returns the
+// members ranked 20 to 29, the third page of 10 members.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	offset - The rank of the first member to return, starting at 0.
+//	count - The number of members to return.
+//
+// Return value:
+//
+//	The members of the page, in rank order. Empty if the page is past the end of the leaderboard.
+func (l *Leaderboard) Page(ctx context.Context, offset int64, count int64) ([]Entry, error) {
+	if offset < 0 || count <= 0 {
+		return []Entry{}, nil
+	}
+	return l.rangeByRank(ctx, offset, offset+count-1)
+}
+
+// Rank returns the rank of `member`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member whose rank to return.
+//
+// Return value:
+//
+//	The rank of the member, starting at 0 for the first member. A nil [models.Result] if the member is not in the
+//	leaderboard.
+func (l *Leaderboard) Rank(ctx context.Context, member string) (models.Result[int64], error) {
+	if l.ascending {
+		return l.client.ZRank(ctx, l.key, member)
+	}
+	return l.client.ZRevRank(ctx, l.key, member)
+}
+
+// Get returns the score and rank of `member`.
+//
+// Since:
+//
+//	Valkey 7.2.0 and above.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member to return.
+//
+// Return value:
+//
+//	The entry of the member, and false if the member is not in the leaderboard.
+func (l *Leaderboard) Get(ctx context.Context, member string) (Entry, bool, error) {
+	var result models.Result[models.RankAndScore]
+	var err error
+	if l.ascending {
+		result, err = l.client.ZRankWithScore(ctx, l.key, member)
+	} else {
+		result, err = l.client.ZRevRankWithScore(ctx, l.key, member)
+	}
+	if err != nil || result.IsNil() {
+		return Entry{}, false, err
+	}
+	return Entry{Member: member, Score: result.Value().Score, Rank: result.Value().Rank}, true, nil
+}
+
+// Around returns the members ranked within `radius` of `member`, including the member itself, e.g. with a radius of 2,
+// the 2 members ranked right before the member, the member, and the 2 members ranked right after it. Fewer members are
+// returned if the member is close to the start or the end of the leaderboard.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member to return the neighbours of.
+//	radius - The number of members to return on each side of the member.
+//
+// Return value:
+//
+//	The members around `member`, in rank order. Empty if the member is not in the leaderboard.
+func (l *Leaderboard) Around(ctx context.Context, member string, radius int64) ([]Entry, error) {
+	rank, err := l.Rank(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+	if rank.IsNil() {
+		return []Entry{}, nil
+	}
+	return l.rangeByRank(ctx, max(rank.Value()-max(radius, 0), 0), rank.Value()+max(radius, 0))
+}
+
+// Percentile returns the percentage of the other members of the leaderboard that `member` is ranked before, from 0 for
+// the last member to 100 for the first one. The only member of a leaderboard is at the 100th percentile.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member whose percentile to return.
+//
+// Return value:
+//
+//	The percentile of the member. A nil [models.Result] if the member is not in the leaderboard.
+func (l *Leaderboard) Percentile(ctx context.Context, member string) (models.Result[float64], error) {
+	rank, err := l.Rank(ctx, member)
+	if err != nil || rank.IsNil() {
+		return models.CreateNilFloat64Result(), err
+	}
+	size, err := l.Size(ctx)
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	return models.CreateFloat64Result(percentile(rank.Value(), size)), nil
+}
+
+// percentile returns the percentage of the other members of a leaderboard of `size` members that the member at `rank` is
+// ranked before.
+func percentile(rank int64, size int64) float64 {
+	if size <= 1 {
+		return 100
+	}
+	// Members may have been removed by a concurrent update since the rank was read.
+	rank = min(rank, size-1)
+	return float64(size-1-rank) / float64(size-1) * 100
+}
+
+// rangeByRank returns the members ranked from `start` to `end`, both inclusive.
+func (l *Leaderboard) rangeByRank(ctx context.Context, start int64, end int64) ([]Entry, error) {
+	query := options.NewRangeByIndexQuery(start, end)
+	if !l.ascending {
+		query.SetReverse()
+	}
+	members, err := l.client.ZRangeWithScores(ctx, l.key, query)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, len(members))
+	for i, member := range members {
+		entries[i] = Entry{Member: member.Member, Score: member.Score, Rank: start + int64(i)}
+	}
+	return entries, nil
+}
@@ -0,0 +1,19 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package leaderboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, float64(100), percentile(0, 1))
+	assert.Equal(t, float64(100), percentile(0, 5))
+	assert.Equal(t, float64(75), percentile(1, 5))
+	assert.Equal(t, float64(0), percentile(4, 5))
+	// The leaderboard shrank after the rank was read
+	assert.Equal(t, float64(0), percentile(6, 5))
+	assert.Equal(t, float64(100), percentile(3, 0))
+}
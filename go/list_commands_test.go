@@ -243,6 +243,37 @@ func ExampleClusterClient_LPosCountWithOptions() {
 	// [5 6]
 }
 
+func ExampleClient_LPosMany() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "a", "b", "a"})
+	result1, err := client.LPosMany(context.Background(), "my_list", []string{"a", "c", "d"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	fmt.Println(result1)
+
+	// Output:
+	// 6
+	// map[a:[0 3 5] c:[2] d:[]]
+}
+
+func ExampleClusterClient_LPosMany() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "a", "b", "a"})
+	result1, err := client.LPosManyWithOptions(context.Background(), "my_list", []string{"a", "c"},
+		*options.NewLPosOptions().SetRank(2))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	fmt.Println(result1)
+
+	// Output:
+	// 6
+	// map[a:[3 5] c:[]]
+}
+
 func ExampleClient_RPush() {
 	var client *Client = getExampleClient() // example helper function
 	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
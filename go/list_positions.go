@@ -0,0 +1,113 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// LPosMany returns the indices of all the occurrences of several elements within a list, like [Client.LPosCount] with a
+// count of 0 for every element, in a single non-atomic pipeline.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx      - The context for controlling the command execution.
+//	key      - The name of the list.
+//	elements - The values to search for within the list.
+//
+// Return value:
+//
+//	The indices of the occurrences of every element within the list, in ascending order. An element that is not in the
+//	list is mapped to an empty array.
+//
+// [valkey.io]: https://valkey.io/commands/lpos/
+func (client *baseClient) LPosMany(ctx context.Context, key string, elements []string) (map[string][]int64, error) {
+	return client.LPosManyWithOptions(ctx, key, elements, options.LPosOptions{})
+}
+
+// LPosManyWithOptions returns the indices of the occurrences of several elements within a list based on the given
+// options, like [Client.LPosCountWithOptions] with a count of 0 for every element, in a single non-atomic pipeline.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx      - The context for controlling the command execution.
+//	key      - The name of the list.
+//	elements - The values to search for within the list.
+//	opts     - The LPos options, applied to the search of every element.
+//
+// Return value:
+//
+//	The indices of the occurrences of every element within the list, in the order they were found. An element that is
+//	not in the list is mapped to an empty array.
+//
+// [valkey.io]: https://valkey.io/commands/lpos/
+func (client *baseClient) LPosManyWithOptions(
+	ctx context.Context,
+	key string,
+	elements []string,
+	opts options.LPosOptions,
+) (map[string][]int64, error) {
+	if _, err := opts.ToArgs(); err != nil {
+		return nil, err
+	}
+	unique := make([]string, 0, len(elements))
+	seen := make(map[string]struct{}, len(elements))
+	for _, element := range elements {
+		if _, ok := seen[element]; !ok {
+			seen[element] = struct{}{}
+			unique = append(unique, element)
+		}
+	}
+	if len(unique) == 0 {
+		return map[string][]int64{}, nil
+	}
+
+	var batch internal.Batch
+	if client.clusterMode {
+		b := pipeline.NewClusterBatch(false)
+		addLPosCommands(&b.BaseBatch, key, unique, opts)
+		batch = b.Batch
+	} else {
+		b := pipeline.NewStandaloneBatch(false)
+		addLPosCommands(&b.BaseBatch, key, unique, opts)
+		batch = b.Batch
+	}
+	results, err := client.executeBatch(ctx, batch, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string][]int64, len(unique))
+	for i, result := range results {
+		switch result := result.(type) {
+		case []int64:
+			positions[unique[i]] = result
+		case error:
+			return nil, fmt.Errorf("failed to get the positions of element %q: %w", unique[i], result)
+		default:
+			return nil, fmt.Errorf("unexpected LPOS response type for element %q: %T", unique[i], result)
+		}
+	}
+	return positions, nil
+}
+
+func addLPosCommands[T pipeline.StandaloneBatch | pipeline.ClusterBatch](
+	batch *pipeline.BaseBatch[T],
+	key string,
+	elements []string,
+	opts options.LPosOptions,
+) {
+	for _, element := range elements {
+		// A count of 0 returns all the matches
+		batch.LPosCountWithOptions(key, element, 0, opts)
+	}
+}
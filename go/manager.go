@@ -0,0 +1,217 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+// Manager holds named clients, e.g. "cache" and "sessions", so that they can be created and closed together and
+// looked up by name. It is meant to be provided by dependency injection frameworks: [NewManager] is a constructor taking
+// a declarative [config.ManagerConfig], and [Manager.StartAll] and [Manager.CloseAll] match the signature of lifecycle
+// hooks, e.g.:
+//
+//	fx.Provide(func(cfg config.ManagerConfig, lc fx.Lifecycle) (*glide.Manager, error) {
+//		manager, err := glide.NewManager(cfg)
+//		if err == nil {
+//			lc.Append(fx.Hook{OnStart: manager.StartAll, OnStop: manager.CloseAll})
+//		}
+//		return manager, err
+//	})
+//
+// A Manager is safe for concurrent use.
+type Manager struct {
+	mu      sync.RWMutex
+	configs map[string]config.NamedClientConfig
+	clients map[string]interfaces.BaseClientCommands
+}
+
+// NewManager returns a [Manager] of the clients of `cfg`. The configurations are validated, but the clients are only
+// created by [Manager.StartAll].
+func NewManager(cfg config.ManagerConfig) (*Manager, error) {
+	manager := &Manager{
+		configs: make(map[string]config.NamedClientConfig, len(cfg.Clients)),
+		clients: make(map[string]interfaces.BaseClientCommands, len(cfg.Clients)),
+	}
+	for name, clientConfig := range cfg.Clients {
+		if name == "" {
+			return nil, NewConfigurationError("client name must not be empty")
+		}
+		if err := validateNamedClientConfig(clientConfig); err != nil {
+			return nil, NewConfigurationError(fmt.Sprintf("invalid configuration of client %q: %v", name, err))
+		}
+		manager.configs[name] = clientConfig
+	}
+	return manager, nil
+}
+
+// Register adds a client created by the caller, e.g. with settings that [config.NamedClientConfig] does not expose. The
+// client is closed by [Manager.CloseAll].
+func (manager *Manager) Register(name string, client interfaces.BaseClientCommands) error {
+	if name == "" {
+		return NewConfigurationError("client name must not be empty")
+	}
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	if _, ok := manager.configs[name]; ok {
+		return NewConfigurationError(fmt.Sprintf("client %q is already registered", name))
+	}
+	if _, ok := manager.clients[name]; ok {
+		return NewConfigurationError(fmt.Sprintf("client %q is already registered", name))
+	}
+	manager.clients[name] = client
+	return nil
+}
+
+// StartAll creates the clients that are not created yet, in the order of their names. If a client cannot be created, the
+// clients created by this call are closed, and the error is returned.
+//
+// Calling StartAll again after a failure or after [Manager.CloseAll] creates the missing clients again.
+func (manager *Manager) StartAll(ctx context.Context) error {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	var started []string
+	for _, name := range manager.configNames() {
+		if _, ok := manager.clients[name]; ok {
+			continue
+		}
+		client, err := startNamedClient(ctx, manager.configs[name])
+		if err != nil {
+			for _, startedName := range started {
+				manager.clients[startedName].Close()
+				delete(manager.clients, startedName)
+			}
+			return fmt.Errorf("failed to start client %q: %w", name, err)
+		}
+		manager.clients[name] = client
+		started = append(started, name)
+	}
+	return nil
+}
+
+// CloseAll closes all the clients, including the registered ones. The clients created from the configuration are
+// created again by the next [Manager.StartAll], while the registered clients are removed.
+//
+// The error is always nil, so that CloseAll can be used as a lifecycle hook.
+func (manager *Manager) CloseAll(ctx context.Context) error {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	for name, client := range manager.clients {
+		client.Close()
+		delete(manager.clients, name)
+	}
+	return nil
+}
+
+// Names returns the names of all the clients, sorted, whether they are started or not.
+func (manager *Manager) Names() []string {
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+	names := manager.configNames()
+	for name := range manager.clients {
+		if _, ok := manager.configs[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the client named `name`, either a [Client] or a [ClusterClient]. An error is returned if there is no such
+// client, or if it is not started.
+func (manager *Manager) Get(name string) (interfaces.BaseClientCommands, error) {
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+	if client, ok := manager.clients[name]; ok {
+		return client, nil
+	}
+	if _, ok := manager.configs[name]; ok {
+		return nil, NewClosingError(fmt.Sprintf("client %q is not started", name))
+	}
+	return nil, NewConfigurationError(fmt.Sprintf("unknown client %q", name))
+}
+
+// Client returns the standalone client named `name`. An error is returned if there is no such client, if it is not
+// started, or if it is a cluster client.
+func (manager *Manager) Client(name string) (*Client, error) {
+	client, err := manager.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	standaloneClient, ok := client.(*Client)
+	if !ok {
+		return nil, NewConfigurationError(fmt.Sprintf("client %q is not a standalone client", name))
+	}
+	return standaloneClient, nil
+}
+
+// ClusterClient returns the cluster client named `name`. An error is returned if there is no such client, if it is not
+// started, or if it is a standalone client.
+func (manager *Manager) ClusterClient(name string) (*ClusterClient, error) {
+	client, err := manager.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	clusterClient, ok := client.(*ClusterClient)
+	if !ok {
+		return nil, NewConfigurationError(fmt.Sprintf("client %q is not a cluster client", name))
+	}
+	return clusterClient, nil
+}
+
+func validateNamedClientConfig(clientConfig config.NamedClientConfig) error {
+	if clientConfig.Cluster {
+		clusterConfig, err := clientConfig.ToClusterClientConfiguration()
+		if err == nil {
+			_, err = clusterConfig.ToProtobuf()
+		}
+		return err
+	}
+	standaloneConfig, err := clientConfig.ToClientConfiguration()
+	if err == nil {
+		_, err = standaloneConfig.ToProtobuf()
+	}
+	return err
+}
+
+func startNamedClient(ctx context.Context, clientConfig config.NamedClientConfig) (interfaces.BaseClientCommands, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if clientConfig.Cluster {
+		clusterConfig, err := clientConfig.ToClusterClientConfiguration()
+		if err != nil {
+			return nil, err
+		}
+		client, err := NewClusterClient(clusterConfig)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+	standaloneConfig, err := clientConfig.ToClientConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	client, err := NewClient(standaloneConfig)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// configNames returns the sorted names of the clients created from the configuration.
+func (manager *Manager) configNames() []string {
+	names := make([]string, 0, len(manager.configs))
+	for name := range manager.configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,78 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestNewManager_InvalidConfig(t *testing.T) {
+	for name, clientConfig := range map[string]config.NamedClientConfig{
+		"no address":   {},
+		"bad port":     {Addresses: []string{"localhost:port"}},
+		"bad readFrom": {Addresses: []string{"localhost"}, ReadFrom: "nearest"},
+		"no client AZ": {Addresses: []string{"localhost"}, Cluster: true, ReadFrom: "azAffinity"},
+	} {
+		_, err := NewManager(config.ManagerConfig{Clients: map[string]config.NamedClientConfig{"cache": clientConfig}})
+		assert.IsType(t, &ConfigurationError{}, err, name)
+		assert.ErrorContains(t, err, `"cache"`, name)
+	}
+
+	_, err := NewManager(config.ManagerConfig{
+		Clients: map[string]config.NamedClientConfig{"": {Addresses: []string{"localhost"}}},
+	})
+	assert.IsType(t, &ConfigurationError{}, err)
+}
+
+func TestManager_GetBeforeStart(t *testing.T) {
+	manager, err := NewManager(config.ManagerConfig{
+		Clients: map[string]config.NamedClientConfig{
+			"sessions": {Addresses: []string{"localhost:6379"}},
+			"cache":    {Addresses: []string{"localhost:7000"}, Cluster: true},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cache", "sessions"}, manager.Names())
+
+	_, err = manager.Get("cache")
+	assert.IsType(t, &ClosingError{}, err)
+	_, err = manager.Get("unknown")
+	assert.IsType(t, &ConfigurationError{}, err)
+}
+
+func TestManager_Register(t *testing.T) {
+	manager, err := NewManager(config.ManagerConfig{
+		Clients: map[string]config.NamedClientConfig{"cache": {Addresses: []string{"localhost"}}},
+	})
+	require.NoError(t, err)
+	client := &Client{}
+	require.NoError(t, manager.Register("sessions", client))
+	assert.Equal(t, []string{"cache", "sessions"}, manager.Names())
+	assert.IsType(t, &ConfigurationError{}, manager.Register("cache", client))
+	assert.IsType(t, &ConfigurationError{}, manager.Register("sessions", client))
+
+	standaloneClient, err := manager.Client("sessions")
+	require.NoError(t, err)
+	assert.Same(t, client, standaloneClient)
+	_, err = manager.ClusterClient("sessions")
+	assert.IsType(t, &ConfigurationError{}, err)
+}
+
+func TestManager_StartAllCanceled(t *testing.T) {
+	manager, err := NewManager(config.ManagerConfig{
+		Clients: map[string]config.NamedClientConfig{"cache": {Addresses: []string{"localhost"}}},
+	})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = manager.StartAll(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, `"cache"`)
+	_, err = manager.Get("cache")
+	assert.IsType(t, &ClosingError{}, err)
+}
@@ -0,0 +1,43 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import "io"
+
+// KeyValueIterator provides the key/value pairs loaded by `BulkLoad`.
+type KeyValueIterator interface {
+	// Next returns the next key/value pair, or [io.EOF] once all the pairs were returned. Any other error stops the load.
+	Next() (key string, value string, err error)
+}
+
+type mapIterator struct {
+	keys   []string
+	values []string
+}
+
+// NewMapIterator returns a [KeyValueIterator] over the pairs of a map, in no particular order.
+func NewMapIterator(pairs map[string]string) KeyValueIterator {
+	iterator := &mapIterator{keys: make([]string, 0, len(pairs)), values: make([]string, 0, len(pairs))}
+	for key, value := range pairs {
+		iterator.keys = append(iterator.keys, key)
+		iterator.values = append(iterator.values, value)
+	}
+	return iterator
+}
+
+func (it *mapIterator) Next() (string, string, error) {
+	if len(it.keys) == 0 {
+		return "", "", io.EOF
+	}
+	key, value := it.keys[0], it.values[0]
+	it.keys, it.values = it.keys[1:], it.values[1:]
+	return key, value, nil
+}
+
+// BulkLoadResult reports the outcome of a `BulkLoad`.
+type BulkLoadResult struct {
+	// The number of key/value pairs written.
+	Loaded int64
+	// The error of every key that could not be written, by key.
+	Failed map[string]error
+}
@@ -0,0 +1,154 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ClientInfo describes a client connection, as returned by `CLIENT INFO`.
+//
+// See [valkey.io] for the meaning of every field.
+//
+// [valkey.io]: https://valkey.io/commands/client-list/
+type ClientInfo struct {
+	// A unique 64-bit client ID.
+	Id int64
+	// The address and port of the client.
+	Addr string
+	// The address and port of the local server the client is connected to.
+	LocalAddr string
+	// The file descriptor of the socket.
+	Fd int64
+	// The name set by the client with `CLIENT SETNAME`.
+	Name string
+	// The total duration of the connection in seconds.
+	Age int64
+	// The idle time of the connection in seconds.
+	Idle int64
+	// The client flags, e.g. `"N"` for a normal client or `"t"` for a client with tracking enabled.
+	Flags string
+	// The current database ID.
+	Db int64
+	// The number of channel subscriptions.
+	Sub int64
+	// The number of pattern matching subscriptions.
+	Psub int64
+	// The number of commands in a `MULTI`/`EXEC` context, or -1 outside of a transaction.
+	Multi int64
+	// The last command played.
+	Cmd string
+	// The authenticated username of the client.
+	User string
+	// The client ID of the current client tracking redirection, or -1 if there is none.
+	Redir int64
+	// The RESP protocol version used by the client.
+	Resp int64
+	// The name of the client library, set with `CLIENT SETINFO`. Since Valkey 7.2.
+	LibName string
+	// The version of the client library, set with `CLIENT SETINFO`. Since Valkey 7.2.
+	LibVer string
+	// All the fields reported by the server, including the ones without a dedicated struct field.
+	Fields map[string]string
+}
+
+// ParseClientInfo parses a line of `CLIENT INFO` or `CLIENT LIST` output into a [ClientInfo].
+//
+// Fields that are missing from `info` are left at their zero value, and unknown fields are only available through
+// [ClientInfo.Fields].
+func ParseClientInfo(info string) (ClientInfo, error) {
+	result := ClientInfo{Fields: map[string]string{}}
+	for _, field := range strings.Fields(info) {
+		name, value, found := strings.Cut(field, "=")
+		if !found {
+			return ClientInfo{}, fmt.Errorf("malformed client info field: %q", field)
+		}
+		result.Fields[name] = value
+	}
+
+	var err error
+	intField := func(name string) int64 {
+		value, ok := result.Fields[name]
+		if !ok || err != nil {
+			return 0
+		}
+		var parsed int64
+		parsed, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("malformed client info field %s=%s: %w", name, value, err)
+		}
+		return parsed
+	}
+
+	result.Id = intField("id")
+	result.Addr = result.Fields["addr"]
+	result.LocalAddr = result.Fields["laddr"]
+	result.Fd = intField("fd")
+	result.Name = result.Fields["name"]
+	result.Age = intField("age")
+	result.Idle = intField("idle")
+	result.Flags = result.Fields["flags"]
+	result.Db = intField("db")
+	result.Sub = intField("sub")
+	result.Psub = intField("psub")
+	result.Multi = intField("multi")
+	result.Cmd = result.Fields["cmd"]
+	result.User = result.Fields["user"]
+	result.Redir = intField("redir")
+	result.Resp = intField("resp")
+	result.LibName = result.Fields["lib-name"]
+	result.LibVer = result.Fields["lib-ver"]
+	if err != nil {
+		return ClientInfo{}, err
+	}
+	return result, nil
+}
+
+// ClientTrackingInfo describes the client-side caching state of a connection, as returned by `CLIENT TRACKINGINFO`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/client-trackinginfo/
+type ClientTrackingInfo struct {
+	// The tracking flags, e.g. `"off"`, `"on"`, `"bcast"`, `"optin"`, `"optout"`, `"noloop"` or `"broken_redirect"`.
+	Flags []string
+	// The client ID used for redirecting invalidation messages, 0 if tracking is on without redirection and -1 if
+	// tracking is off.
+	Redirect int64
+	// The key prefixes registered for broadcasting mode.
+	Prefixes []string
+}
+
+// HasFlag returns `true` if `flag` is one of the tracking flags.
+func (info ClientTrackingInfo) HasFlag(flag string) bool {
+	for _, f := range info.Flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// HelloResponse describes the server and the connection, as returned by `HELLO`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/hello/
+type HelloResponse struct {
+	// The server name, e.g. "valkey" or "redis".
+	Server string
+	// The server version.
+	Version string
+	// The protocol version used by the connection, 2 or 3.
+	Proto int64
+	// The ID of the connection.
+	Id int64
+	// The server mode, "standalone", "sentinel" or "cluster".
+	Mode string
+	// The role of the server, "master" or "replica".
+	Role string
+	// The modules loaded by the server, each described by its "name" and "ver" fields.
+	Modules []map[string]any
+}
@@ -0,0 +1,55 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClientInfo(t *testing.T) {
+	info, err := ParseClientInfo(
+		"id=3 addr=127.0.0.1:50188 laddr=127.0.0.1:6379 fd=8 name=worker age=12 idle=0 flags=N db=2 sub=1 psub=0 " +
+			"ssub=0 multi=-1 watch=0 qbuf=26 qbuf-free=20448 argv-mem=10 multi-mem=0 rbs=1024 rbp=0 obl=0 oll=0 omem=0 " +
+			"tot-mem=22426 events=r cmd=client|info user=default redir=-1 resp=3 lib-name=GlideGo lib-ver=v2.1.0\n",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), info.Id)
+	assert.Equal(t, "127.0.0.1:50188", info.Addr)
+	assert.Equal(t, "127.0.0.1:6379", info.LocalAddr)
+	assert.Equal(t, int64(8), info.Fd)
+	assert.Equal(t, "worker", info.Name)
+	assert.Equal(t, int64(12), info.Age)
+	assert.Equal(t, "N", info.Flags)
+	assert.Equal(t, int64(2), info.Db)
+	assert.Equal(t, int64(1), info.Sub)
+	assert.Equal(t, int64(-1), info.Multi)
+	assert.Equal(t, "client|info", info.Cmd)
+	assert.Equal(t, "default", info.User)
+	assert.Equal(t, int64(-1), info.Redir)
+	assert.Equal(t, int64(3), info.Resp)
+	assert.Equal(t, "GlideGo", info.LibName)
+	assert.Equal(t, "v2.1.0", info.LibVer)
+	assert.Equal(t, "22426", info.Fields["tot-mem"])
+
+	// Servers older than 7.2 don't report the library fields
+	info, err = ParseClientInfo("id=5 addr=127.0.0.1:1 name= cmd=client|info")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), info.Id)
+	assert.Empty(t, info.Name)
+	assert.Empty(t, info.LibName)
+
+	_, err = ParseClientInfo("id=5 garbage")
+	assert.ErrorContains(t, err, "malformed")
+
+	_, err = ParseClientInfo("id=five")
+	assert.ErrorContains(t, err, "malformed")
+}
+
+func TestClientTrackingInfo_HasFlag(t *testing.T) {
+	info := ClientTrackingInfo{Flags: []string{"on", "bcast"}, Redirect: 0, Prefixes: []string{"user:"}}
+	assert.True(t, info.HasFlag("bcast"))
+	assert.False(t, info.HasFlag("off"))
+}
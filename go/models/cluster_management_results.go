@@ -0,0 +1,13 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// SlotMigrationProgress reports the progress of a slot migration started with `ClusterClient.MigrateSlot`.
+type SlotMigrationProgress struct {
+	// The slot being migrated.
+	Slot int64
+	// The number of keys migrated by the last batch.
+	BatchKeys int64
+	// The total number of keys migrated so far.
+	MigratedKeys int64
+}
@@ -0,0 +1,17 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// Command describes a request passed to the interceptors of a client, see `config.ClientConfiguration.WithInterceptor`.
+type Command struct {
+	// The name of the command in uppercase, e.g. "GET". Scripts are named "EVALSHA" and batches "Batch". The name is
+	// informational: the interceptors cannot change the command that is sent.
+	Name string
+	// The arguments of the command, following its name. The arguments of a script are the hash of the script, the number
+	// of keys, the keys and the arguments, as sent with `EVALSHA`. Empty for batches.
+	Args []string
+	// The commands of a batch, in order, or nil if the request is not a batch.
+	Batch []Command
+	// Whether the batch is a transaction. False if the request is not a batch.
+	Atomic bool
+}
@@ -0,0 +1,27 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// CommandInfo describes a command, as returned by `COMMAND INFO`.
+//
+// See [valkey.io] for the meaning of every field.
+//
+// [valkey.io]: https://valkey.io/commands/command/
+type CommandInfo struct {
+	// The command name, in lowercase. Subcommands are named "container|subcommand", e.g. "config|get".
+	Name string
+	// The number of arguments, including the command name. A negative arity means "at least" that many arguments.
+	Arity int64
+	// The command flags, e.g. "readonly", "write" or "fast".
+	Flags []string
+	// The position of the first key in the arguments, or 0 if the command takes no keys.
+	FirstKey int64
+	// The position of the last key in the arguments. A negative value counts from the end of the arguments.
+	LastKey int64
+	// The step between the positions of the keys.
+	Step int64
+	// The ACL categories of the command, e.g. "@read" or "@string".
+	AclCategories []string
+	// The subcommands of a container command, e.g. "config|get" for "config".
+	Subcommands []CommandInfo
+}
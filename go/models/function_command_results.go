@@ -65,3 +65,15 @@ type LibraryInfo struct {
 	Functions []FunctionInfo
 	Code      string
 }
+
+// LibraryStatus is the state of a function library on a node, reported by `FunctionLoadPerNode` and
+// `FunctionVerifyPerNode`.
+type LibraryStatus struct {
+	// The SHA1 digest of the code of the library loaded on the node, in hexadecimal, or empty if the library is not loaded
+	// on the node.
+	Digest string
+	// Whether the code of the library loaded on the node is the expected code.
+	Verified bool
+	// Whether the library was loaded on the node by the call, rather than being loaded with the expected code already.
+	Loaded bool
+}
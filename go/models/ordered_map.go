@@ -0,0 +1,126 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// OrderedMap is a map of strings whose entries keep their order, so that it is iterated and serialized deterministically,
+// e.g. to compare the JSON snapshots of the results in tests. It is marshaled to a JSON object with the fields in the
+// order of its entries.
+//
+// The results sorted by field, such as the ones of `HGetAllSorted` and `ConfigGetSorted`, are returned as OrderedMap, and
+// a map can be sorted with [NewOrderedMap]. The fields of a [StreamEntry], in the order they were added, and the fields
+// returned by `HGetAllOrdered`, in the order returned by the server, can be converted with `models.OrderedMap(fields)`.
+type OrderedMap []FieldValue
+
+// NewOrderedMap returns the entries of `m` sorted by field.
+func NewOrderedMap(m map[string]string) OrderedMap {
+	ordered := make(OrderedMap, 0, len(m))
+	for field, value := range m {
+		ordered = append(ordered, FieldValue{Field: field, Value: value})
+	}
+	return ordered.Sorted()
+}
+
+// Get returns the value of the first entry of `field`, and whether there is one.
+func (m OrderedMap) Get(field string) (string, bool) {
+	for _, entry := range m {
+		if entry.Field == field {
+			return entry.Value, true
+		}
+	}
+	return "", false
+}
+
+// Keys returns the fields of the entries, in order.
+func (m OrderedMap) Keys() []string {
+	keys := make([]string, len(m))
+	for i, entry := range m {
+		keys[i] = entry.Field
+	}
+	return keys
+}
+
+// Sorted returns a copy of the entries sorted by field. The entries of a same field keep their order.
+func (m OrderedMap) Sorted() OrderedMap {
+	sorted := slices.Clone(m)
+	slices.SortStableFunc(sorted, func(a, b FieldValue) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+	return sorted
+}
+
+// ToMap returns the entries as a map. The last entry of a field wins.
+func (m OrderedMap) ToMap() map[string]string {
+	converted := make(map[string]string, len(m))
+	for _, entry := range m {
+		converted[entry.Field] = entry.Value
+	}
+	return converted
+}
+
+// MarshalJSON marshals the entries to a JSON object with the fields in order, or to null if the map is nil.
+func (m OrderedMap) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, entry := range m {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		field, err := json.Marshal(entry.Field)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(entry.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(field)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON unmarshals a JSON object of strings, keeping the fields in the order of the object.
+func (m *OrderedMap) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		*m = nil
+		return nil
+	}
+	if token != json.Delim('{') {
+		return fmt.Errorf("cannot unmarshal %v into an OrderedMap, expected an object", token)
+	}
+	ordered := OrderedMap{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		var value string
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		ordered = append(ordered, FieldValue{Field: token.(string), Value: value})
+	}
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	*m = ordered
+	return nil
+}
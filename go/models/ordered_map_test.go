@@ -0,0 +1,60 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrderedMap(t *testing.T) {
+	ordered := NewOrderedMap(map[string]string{"maxmemory": "0", "appendonly": "no", "port": "6379"})
+	assert.Equal(t, []string{"appendonly", "maxmemory", "port"}, ordered.Keys())
+	value, ok := ordered.Get("port")
+	assert.True(t, ok)
+	assert.Equal(t, "6379", value)
+	_, ok = ordered.Get("bind")
+	assert.False(t, ok)
+	assert.Equal(t, map[string]string{"maxmemory": "0", "appendonly": "no", "port": "6379"}, ordered.ToMap())
+	assert.Empty(t, NewOrderedMap(nil))
+}
+
+func TestOrderedMap_Sorted(t *testing.T) {
+	fields := []FieldValue{{Field: "b", Value: "1"}, {Field: "a", Value: "2"}, {Field: "b", Value: "3"}}
+	ordered := OrderedMap(fields)
+	assert.Equal(t, OrderedMap{{Field: "a", Value: "2"}, {Field: "b", Value: "1"}, {Field: "b", Value: "3"}}, ordered.Sorted())
+	// The entries are not sorted in place
+	assert.Equal(t, "b", fields[0].Field)
+	assert.Equal(t, map[string]string{"a": "2", "b": "3"}, ordered.ToMap())
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	ordered := OrderedMap{{Field: "zeta", Value: "1"}, {Field: "alpha", Value: "quote \" and <tag>"}}
+	data, err := json.Marshal(struct {
+		Hash OrderedMap `json:"hash"`
+		None OrderedMap `json:"none"`
+	}{Hash: ordered})
+	require.NoError(t, err)
+	assert.Equal(t, `{"hash":{"zeta":"1","alpha":"quote \" and \u003ctag\u003e"},"none":null}`, string(data))
+
+	var decoded struct {
+		Hash OrderedMap `json:"hash"`
+		None OrderedMap `json:"none"`
+	}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, ordered, decoded.Hash)
+	assert.Nil(t, decoded.None)
+
+	var empty OrderedMap
+	require.NoError(t, json.Unmarshal([]byte(`{}`), &empty))
+	assert.Equal(t, OrderedMap{}, empty)
+	data, err = json.Marshal(empty)
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`["a"]`), &empty))
+	assert.Error(t, json.Unmarshal([]byte(`{"a":1}`), &empty))
+}
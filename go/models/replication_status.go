@@ -0,0 +1,169 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ReplicationStatus describes the replication state of a node, as returned by the "replication" section of `INFO`.
+//
+// A primary reports its replicas along with their offset, so that their lag can be computed. A replica reports its link
+// to its primary and its own offset.
+//
+// See [valkey.io] for the meaning of every field.
+//
+// [valkey.io]: https://valkey.io/commands/info/
+type ReplicationStatus struct {
+	// The role of the node, "master" for a primary or "slave" for a replica.
+	Role string
+	// The replication offset of the node. On a primary, the offset its replicas catch up with.
+	ReplicationOffset int64
+	// The replicas connected to a primary. Empty on a replica.
+	Replicas []ReplicaStatus
+	// The host of the primary of a replica. Empty on a primary.
+	PrimaryHost string
+	// The port of the primary of a replica. 0 on a primary.
+	PrimaryPort int64
+	// The status of the link of a replica to its primary, "up" or "down". Empty on a primary.
+	PrimaryLinkStatus string
+	// The number of seconds since a replica last interacted with its primary. 0 on a primary.
+	PrimaryLastIOSecondsAgo int64
+	// Whether a replica is synchronizing with its primary.
+	SyncInProgress bool
+	// All the fields of the section, including the ones without a dedicated struct field.
+	Fields map[string]string
+}
+
+// ReplicaStatus describes a replica connected to a primary, as reported by the primary.
+type ReplicaStatus struct {
+	// The address of the replica, as "host:port".
+	Address string
+	// The replication state of the replica, e.g. "online" once it synchronized with the primary.
+	State string
+	// The replication offset the replica acknowledged.
+	Offset int64
+	// The number of seconds since the replica last acknowledged the replication stream.
+	Lag int64
+	// The number of bytes of the replication stream the replica has not acknowledged yet, i.e. the replication offset of
+	// the primary minus the offset of the replica.
+	OffsetLag int64
+}
+
+// IsPrimary returns true if the node is a primary.
+func (status ReplicationStatus) IsPrimary() bool {
+	return status.Role == "master"
+}
+
+// MaxReplicaLag returns the largest lag in seconds and the largest offset lag in bytes of the replicas of a primary,
+// and false if no replica is connected.
+func (status ReplicationStatus) MaxReplicaLag() (lag int64, offsetLag int64, ok bool) {
+	for _, replica := range status.Replicas {
+		lag = max(lag, replica.Lag)
+		offsetLag = max(offsetLag, replica.OffsetLag)
+	}
+	return lag, offsetLag, len(status.Replicas) > 0
+}
+
+// LeastLaggingReplica returns the online replica of a primary with the lowest offset lag, among the ones that last
+// acknowledged the replication stream at most `maxLag` seconds ago and, if `maxOffsetLag` is not negative, whose offset
+// lag is at most `maxOffsetLag` bytes. It returns false if no replica qualifies.
+func (status ReplicationStatus) LeastLaggingReplica(maxLag int64, maxOffsetLag int64) (ReplicaStatus, bool) {
+	var least ReplicaStatus
+	found := false
+	for _, replica := range status.Replicas {
+		if replica.State != "online" || replica.Lag > maxLag || (maxOffsetLag >= 0 && replica.OffsetLag > maxOffsetLag) {
+			continue
+		}
+		if !found || replica.OffsetLag < least.OffsetLag {
+			least, found = replica, true
+		}
+	}
+	return least, found
+}
+
+// ParseReplicationStatus parses the "replication" section of `INFO` output into a [ReplicationStatus].
+//
+// Fields that are missing from `info` are left at their zero value, and unknown fields are only available through
+// [ReplicationStatus.Fields].
+func ParseReplicationStatus(info string) (ReplicationStatus, error) {
+	result := ReplicationStatus{Fields: map[string]string{}}
+	for _, line := range strings.Split(info, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			return ReplicationStatus{}, fmt.Errorf("malformed replication info line: %q", line)
+		}
+		result.Fields[name] = value
+	}
+
+	var err error
+	intField := func(name string) int64 {
+		value, ok := result.Fields[name]
+		if !ok || err != nil {
+			return 0
+		}
+		var parsed int64
+		parsed, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("malformed replication info field %s:%s: %w", name, value, err)
+		}
+		return parsed
+	}
+
+	result.Role = result.Fields["role"]
+	result.ReplicationOffset = intField("master_repl_offset")
+	result.PrimaryHost = result.Fields["master_host"]
+	result.PrimaryPort = intField("master_port")
+	result.PrimaryLinkStatus = result.Fields["master_link_status"]
+	result.PrimaryLastIOSecondsAgo = intField("master_last_io_seconds_ago")
+	result.SyncInProgress = result.Fields["master_sync_in_progress"] == "1"
+	if err != nil {
+		return ReplicationStatus{}, err
+	}
+
+	replicas := intField("connected_slaves")
+	if err != nil {
+		return ReplicationStatus{}, err
+	}
+	for i := range replicas {
+		line, ok := result.Fields["slave"+strconv.FormatInt(i, 10)]
+		if !ok {
+			continue
+		}
+		replica, err := parseReplicaStatus(line)
+		if err != nil {
+			return ReplicationStatus{}, err
+		}
+		replica.OffsetLag = max(result.ReplicationOffset-replica.Offset, 0)
+		result.Replicas = append(result.Replicas, replica)
+	}
+	return result, nil
+}
+
+// parseReplicaStatus parses a replica line of the "replication" section of `INFO`, e.g.
+// "ip=127.0.0.1,port=6380,state=online,offset=1234,lag=0".
+func parseReplicaStatus(line string) (ReplicaStatus, error) {
+	fields := map[string]string{}
+	for _, field := range strings.Split(line, ",") {
+		name, value, found := strings.Cut(field, "=")
+		if !found {
+			return ReplicaStatus{}, fmt.Errorf("malformed replica info field: %q", field)
+		}
+		fields[name] = value
+	}
+	replica := ReplicaStatus{Address: fields["ip"] + ":" + fields["port"], State: fields["state"]}
+	var err error
+	if replica.Offset, err = strconv.ParseInt(fields["offset"], 10, 64); err != nil {
+		return ReplicaStatus{}, fmt.Errorf("malformed replica offset %q: %w", fields["offset"], err)
+	}
+	if replica.Lag, err = strconv.ParseInt(fields["lag"], 10, 64); err != nil {
+		return ReplicaStatus{}, fmt.Errorf("malformed replica lag %q: %w", fields["lag"], err)
+	}
+	return replica, nil
+}
@@ -0,0 +1,81 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReplicationStatus_Primary(t *testing.T) {
+	status, err := ParseReplicationStatus(
+		"# Replication\r\nrole:master\r\nconnected_slaves:2\r\n" +
+			"slave0:ip=127.0.0.1,port=6380,state=online,offset=1200,lag=0\r\n" +
+			"slave1:ip=127.0.0.1,port=6381,state=wait_bgsave,offset=0,lag=3\r\n" +
+			"master_failover_state:no-failover\r\nmaster_replid:8b9f6e0c\r\nmaster_repl_offset:1234\r\n",
+	)
+	require.NoError(t, err)
+	assert.True(t, status.IsPrimary())
+	assert.Equal(t, int64(1234), status.ReplicationOffset)
+	assert.Equal(t, []ReplicaStatus{
+		{Address: "127.0.0.1:6380", State: "online", Offset: 1200, Lag: 0, OffsetLag: 34},
+		{Address: "127.0.0.1:6381", State: "wait_bgsave", Offset: 0, Lag: 3, OffsetLag: 1234},
+	}, status.Replicas)
+	assert.Equal(t, "no-failover", status.Fields["master_failover_state"])
+
+	lag, offsetLag, ok := status.MaxReplicaLag()
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), lag)
+	assert.Equal(t, int64(1234), offsetLag)
+}
+
+func TestParseReplicationStatus_Replica(t *testing.T) {
+	status, err := ParseReplicationStatus(
+		"# Replication\r\nrole:slave\r\nmaster_host:127.0.0.1\r\nmaster_port:6379\r\nmaster_link_status:up\r\n" +
+			"master_last_io_seconds_ago:1\r\nmaster_sync_in_progress:0\r\nslave_repl_offset:1200\r\n" +
+			"connected_slaves:0\r\nmaster_repl_offset:1200\r\n",
+	)
+	require.NoError(t, err)
+	assert.False(t, status.IsPrimary())
+	assert.Equal(t, "127.0.0.1", status.PrimaryHost)
+	assert.Equal(t, int64(6379), status.PrimaryPort)
+	assert.Equal(t, "up", status.PrimaryLinkStatus)
+	assert.Equal(t, int64(1), status.PrimaryLastIOSecondsAgo)
+	assert.False(t, status.SyncInProgress)
+	assert.Equal(t, int64(1200), status.ReplicationOffset)
+	assert.Empty(t, status.Replicas)
+
+	_, _, ok := status.MaxReplicaLag()
+	assert.False(t, ok)
+}
+
+func TestParseReplicationStatus_Malformed(t *testing.T) {
+	_, err := ParseReplicationStatus("role:master\r\nmaster_repl_offset:abc\r\n")
+	assert.Error(t, err)
+	_, err = ParseReplicationStatus("role:master\r\nconnected_slaves:1\r\nslave0:ip=127.0.0.1,port=6380,offset=x,lag=0\r\n")
+	assert.Error(t, err)
+}
+
+func TestReplicationStatus_LeastLaggingReplica(t *testing.T) {
+	status := ReplicationStatus{Role: "master", Replicas: []ReplicaStatus{
+		{Address: "127.0.0.1:6380", State: "online", Lag: 1, OffsetLag: 300},
+		{Address: "127.0.0.1:6381", State: "wait_bgsave", Lag: 0, OffsetLag: 0},
+		{Address: "127.0.0.1:6382", State: "online", Lag: 0, OffsetLag: 100},
+		{Address: "127.0.0.1:6383", State: "online", Lag: 5, OffsetLag: 0},
+	}}
+
+	replica, ok := status.LeastLaggingReplica(1, -1)
+	assert.True(t, ok)
+	assert.Equal(t, "127.0.0.1:6382", replica.Address)
+	replica, ok = status.LeastLaggingReplica(5, -1)
+	assert.True(t, ok)
+	assert.Equal(t, "127.0.0.1:6383", replica.Address)
+
+	// The replicas are only read from within both thresholds
+	_, ok = status.LeastLaggingReplica(1, 50)
+	assert.False(t, ok)
+	_, ok = ReplicationStatus{Role: "master"}.LeastLaggingReplica(1, -1)
+	assert.False(t, ok)
+}
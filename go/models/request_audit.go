@@ -0,0 +1,52 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// RequestAudit describes a completed request, as passed to the audit hook of a client, see
+// `config.ClientConfiguration.WithAuditHook`.
+type RequestAudit struct {
+	// The name of the command in uppercase, e.g. "GET". Batches are named "Batch".
+	Command string
+	// The request metadata attached to the context of the request with `glide.WithRequestMetadata`, or nil.
+	Metadata map[string]string
+	// The time from sending the request to receiving its response, including the time the request waited for a
+	// connection or was retried.
+	Duration time.Duration
+	// The error of the request, or nil if it succeeded.
+	Err error
+}
+
+// Annotation returns the metadata of the request as a `/* key=value ... */` comment, with the keys sorted, e.g. to append
+// it to the log line of a slow request, and correlate it with the entries of `SLOWLOG GET`. Returns an empty string if
+// the request has no metadata.
+//
+// The "*/" sequences and the line breaks of the keys and values are replaced, so that the comment cannot be closed early.
+func (audit RequestAudit) Annotation() string {
+	if len(audit.Metadata) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(audit.Metadata))
+	for key := range audit.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	builder.WriteString("/*")
+	for _, key := range keys {
+		builder.WriteByte(' ')
+		builder.WriteString(annotationEscaper.Replace(key))
+		builder.WriteByte('=')
+		builder.WriteString(annotationEscaper.Replace(audit.Metadata[key]))
+	}
+	builder.WriteString(" */")
+	return builder.String()
+}
+
+var annotationEscaper = strings.NewReplacer("*/", "* /", "\n", " ", "\r", " ")
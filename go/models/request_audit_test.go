@@ -0,0 +1,22 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestAuditAnnotation(t *testing.T) {
+	audit := RequestAudit{
+		Command:  "GET",
+		Metadata: map[string]string{"trace_id": "4bf92f35", "tenant": "acme"},
+	}
+	assert.Equal(t, "/* tenant=acme trace_id=4bf92f35 */", audit.Annotation())
+
+	assert.Equal(t, "", RequestAudit{Command: "GET"}.Annotation())
+
+	audit.Metadata = map[string]string{"tenant": "a */ b\nc"}
+	assert.Equal(t, "/* tenant=a * / b c */", audit.Annotation())
+}
@@ -2,6 +2,8 @@
 
 package models
 
+import "fmt"
+
 // A value to return alongside with error in case if command failed
 var (
 	DefaultFloatResponse  float64
@@ -478,3 +480,66 @@ type LCSPosition struct {
 	// End is the ending index of the match.
 	End int64
 }
+
+// VersionedValue is a string value along with the version used for an optimistic compare-and-set with
+// `SetIfVersion`, as returned by `GetWithVersion`.
+type VersionedValue struct {
+	// The value, or a nil [Result] if the key does not exist.
+	Value Result[string]
+	// The version of the value. Empty if the key does not exist.
+	Version string
+}
+
+// ExpireStatus is the outcome of setting a timeout on a key with a condition, as returned by `ExpireWithStatus` and its
+// variants. Unlike the boolean returned by `ExpireWithOptions`, it tells a missing key from a condition that is not met.
+type ExpireStatus int
+
+const (
+	// ExpireSet means that the timeout was set, or that the key was deleted as the expiry time was in the past.
+	ExpireSet ExpireStatus = iota
+	// ExpireKeyMissing means that the timeout was not set because the key does not exist.
+	ExpireKeyMissing
+	// ExpireConditionNotMet means that the timeout was not set because the key exists but the condition was not met.
+	ExpireConditionNotMet
+)
+
+func (status ExpireStatus) String() string {
+	switch status {
+	case ExpireSet:
+		return "Set"
+	case ExpireKeyMissing:
+		return "KeyMissing"
+	case ExpireConditionNotMet:
+		return "ConditionNotMet"
+	default:
+		return fmt.Sprintf("ExpireStatus(%d)", int(status))
+	}
+}
+
+// StreamEntryDeletionStatus is the outcome of deleting a stream entry, as returned for every entry ID by `XDelEx` and
+// `XAckDel`.
+type StreamEntryDeletionStatus int64
+
+const (
+	// StreamEntryNotFound means that the entry does not exist in the stream, or, for `XAckDel`, that it is not pending in
+	// the consumer group.
+	StreamEntryNotFound StreamEntryDeletionStatus = -1
+	// StreamEntryDeleted means that the entry was deleted, after being acknowledged for `XAckDel`.
+	StreamEntryDeleted StreamEntryDeletionStatus = 1
+	// StreamEntryReferenced means that the entry was not deleted, as it is still pending in a consumer group and the
+	// `ACKED` policy was used. It was acknowledged for `XAckDel`.
+	StreamEntryReferenced StreamEntryDeletionStatus = 2
+)
+
+func (status StreamEntryDeletionStatus) String() string {
+	switch status {
+	case StreamEntryNotFound:
+		return "NotFound"
+	case StreamEntryDeleted:
+		return "Deleted"
+	case StreamEntryReferenced:
+		return "Referenced"
+	default:
+		return fmt.Sprintf("StreamEntryDeletionStatus(%d)", int64(status))
+	}
+}
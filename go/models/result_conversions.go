@@ -0,0 +1,48 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import "database/sql"
+
+// Ptr returns a pointer to a copy of the value of the result, or nil if the result is nil, e.g. to set an optional
+// field of a JSON struct.
+func (result Result[T]) Ptr() *T {
+	if result.isNil {
+		return nil
+	}
+	val := result.val
+	return &val
+}
+
+// Null converts the result to a [sql.Null], which is not valid if the result is nil.
+func (result Result[T]) Null() sql.Null[T] {
+	return sql.Null[T]{V: result.val, Valid: !result.isNil}
+}
+
+// ToNullString converts `result` to a [sql.NullString], which is not valid if `result` is nil.
+func ToNullString(result Result[string]) sql.NullString {
+	return sql.NullString{String: result.val, Valid: !result.isNil}
+}
+
+// ToNullInt64 converts `result` to a [sql.NullInt64], which is not valid if `result` is nil.
+func ToNullInt64(result Result[int64]) sql.NullInt64 {
+	return sql.NullInt64{Int64: result.val, Valid: !result.isNil}
+}
+
+// ToNullFloat64 converts `result` to a [sql.NullFloat64], which is not valid if `result` is nil.
+func ToNullFloat64(result Result[float64]) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: result.val, Valid: !result.isNil}
+}
+
+// ToNullBool converts `result` to a [sql.NullBool], which is not valid if `result` is nil.
+func ToNullBool(result Result[bool]) sql.NullBool {
+	return sql.NullBool{Bool: result.val, Valid: !result.isNil}
+}
+
+// CreateResultFromPtr creates a result holding the value `ptr` points to, or a nil result if `ptr` is nil.
+func CreateResultFromPtr[T any](ptr *T) Result[T] {
+	if ptr == nil {
+		return CreateNilResultOf[T]()
+	}
+	return CreateResultOf(*ptr)
+}
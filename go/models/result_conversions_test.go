@@ -0,0 +1,52 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResult_Ptr(t *testing.T) {
+	result := CreateStringResult("value")
+	ptr := result.Ptr()
+	require.NotNil(t, ptr)
+	assert.Equal(t, "value", *ptr)
+	*ptr = "changed"
+	assert.Equal(t, "value", result.Value())
+
+	assert.Nil(t, CreateNilStringResult().Ptr())
+
+	// The optional fields of JSON structs are omitted when the result is nil
+	type user struct {
+		Name *string `json:"name,omitempty"`
+		Age  *int64  `json:"age,omitempty"`
+	}
+	encoded, err := json.Marshal(user{Name: CreateStringResult("alice").Ptr(), Age: CreateNilInt64Result().Ptr()})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"alice"}`, string(encoded))
+}
+
+func TestResult_Null(t *testing.T) {
+	assert.Equal(t, sql.Null[int64]{V: 42, Valid: true}, CreateInt64Result(42).Null())
+	assert.Equal(t, sql.Null[int64]{}, CreateNilInt64Result().Null())
+
+	assert.Equal(t, sql.NullString{String: "value", Valid: true}, ToNullString(CreateStringResult("value")))
+	assert.Equal(t, sql.NullString{}, ToNullString(CreateNilStringResult()))
+	assert.Equal(t, sql.NullInt64{Int64: 42, Valid: true}, ToNullInt64(CreateInt64Result(42)))
+	assert.Equal(t, sql.NullInt64{}, ToNullInt64(CreateNilInt64Result()))
+	assert.Equal(t, sql.NullFloat64{Float64: 1.5, Valid: true}, ToNullFloat64(CreateFloat64Result(1.5)))
+	assert.Equal(t, sql.NullFloat64{}, ToNullFloat64(CreateNilFloat64Result()))
+	assert.Equal(t, sql.NullBool{Bool: true, Valid: true}, ToNullBool(CreateResultOf(true)))
+	assert.Equal(t, sql.NullBool{}, ToNullBool(CreateNilResultOf[bool]()))
+}
+
+func TestCreateResultFromPtr(t *testing.T) {
+	value := "value"
+	assert.Equal(t, CreateStringResult("value"), CreateResultFromPtr(&value))
+	assert.Equal(t, CreateNilStringResult(), CreateResultFromPtr[string](nil))
+}
@@ -0,0 +1,206 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// streamField is an exported field of a struct that the fields of stream entries are decoded into.
+type streamField struct {
+	index int
+	// The name of the stream field the struct field is decoded from.
+	name string
+	// Whether the struct field is set to the ID of the entry instead.
+	isID bool
+	// The value decoded if the entry has no field named `name`, or nil if the struct field is then left unchanged.
+	defaultValue *string
+}
+
+// streamFields parses the `glide` tags of the struct type `t`.
+func streamFields(t reflect.Type) ([]streamField, error) {
+	var fields []streamField
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag, tagged := structField.Tag.Lookup("glide")
+		if !structField.IsExported() || tag == "-" {
+			continue
+		}
+		field := streamField{index: i, name: structField.Name}
+		if tagged {
+			name, options, _ := strings.Cut(tag, ",")
+			if name != "" {
+				field.name = name
+			}
+			for options != "" {
+				var option string
+				option, options, _ = strings.Cut(options, ",")
+				switch {
+				case option == "id":
+					if structField.Type.Kind() != reflect.String {
+						return nil, fmt.Errorf("the ID field %s must be a string", structField.Name)
+					}
+					field.isID = true
+				case strings.HasPrefix(option, "default="):
+					// The default value is the rest of the tag, so that it may contain commas.
+					defaultValue := strings.TrimPrefix(option, "default=")
+					if options != "" {
+						defaultValue += "," + options
+						options = ""
+					}
+					field.defaultValue = &defaultValue
+				default:
+					return nil, fmt.Errorf("unknown option %q in the tag of the field %s", option, structField.Name)
+				}
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// Decode stores the ID and the fields of the entry in the struct pointed to by `dst`.
+//
+// The stream fields are decoded into the exported struct fields of the same name, or of the name set by their `glide`
+// tag. The struct fields tagged with `glide:"-"` are skipped, and a string field tagged with the `id` option, e.g.
+// `glide:",id"`, is set to the ID of the entry. The values are converted to the types of the struct fields: strings,
+// byte slices, integers, floating-point numbers, booleans, the types implementing [encoding.TextUnmarshaler], and the
+// pointers to these types. The struct fields without a matching stream field are set to the value of their `default`
+// option, e.g. `glide:"amount,default=0"`, or left unchanged. The stream fields without a matching struct field are
+// ignored.
+//
+// Example:
+//
+//	type Event struct {
+//	    ID     string  `glide:",id"`
+//	    Kind   string  `glide:"kind"`
+//	    Amount float64 `glide:"amount,default=0"`
+//	}
+//	var event Event
+//	err := entry.Decode(&event)
+func (entry StreamEntry) Decode(dst any) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("the destination must be a non-nil pointer to a struct, got %T", dst)
+	}
+	fields, err := streamFields(value.Elem().Type())
+	if err != nil {
+		return err
+	}
+	return entry.decode(value.Elem(), fields)
+}
+
+// DecodeStreamEntries stores `entries` in the slice pointed to by `dst`, a `*[]T` or a `*[]*T` where `T` is a struct, see
+// [StreamEntry.Decode]. The slice is replaced by a slice of the decoded entries, in order.
+func DecodeStreamEntries(entries []StreamEntry, dst any) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("the destination must be a non-nil pointer to a slice, got %T", dst)
+	}
+	sliceType := value.Elem().Type()
+	elemType := sliceType.Elem()
+	structType := elemType
+	if elemType.Kind() == reflect.Pointer {
+		structType = elemType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("the destination must point to a slice of structs or of pointers to structs, got %T", dst)
+	}
+	fields, err := streamFields(structType)
+	if err != nil {
+		return err
+	}
+
+	decoded := reflect.MakeSlice(sliceType, len(entries), len(entries))
+	for i, entry := range entries {
+		elem := decoded.Index(i)
+		if elemType.Kind() == reflect.Pointer {
+			elem.Set(reflect.New(structType))
+			elem = elem.Elem()
+		}
+		if err := entry.decode(elem, fields); err != nil {
+			return err
+		}
+	}
+	value.Elem().Set(decoded)
+	return nil
+}
+
+func (entry StreamEntry) decode(dst reflect.Value, fields []streamField) error {
+	values := make(map[string]string, len(entry.Fields))
+	for _, fieldValue := range entry.Fields {
+		values[fieldValue.Field] = fieldValue.Value
+	}
+	for _, field := range fields {
+		if field.isID {
+			dst.Field(field.index).SetString(entry.ID)
+			continue
+		}
+		value, ok := values[field.name]
+		if !ok {
+			if field.defaultValue == nil {
+				continue
+			}
+			value = *field.defaultValue
+		}
+		if err := setStreamField(dst.Field(field.index), value); err != nil {
+			return fmt.Errorf("stream entry %s: field %q: %w", entry.ID, field.name, err)
+		}
+	}
+	return nil
+}
+
+// setStreamField converts `value` to the type of `field` and stores it.
+func setStreamField(field reflect.Value, value string) error {
+	if field.Kind() == reflect.Pointer {
+		elem := reflect.New(field.Type().Elem())
+		if err := setStreamField(elem.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+	}
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(value))
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(number)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(number)
+	case reflect.Float32, reflect.Float64:
+		number, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(number)
+	case reflect.Bool:
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(boolean)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported type %s", field.Type())
+		}
+		field.SetBytes([]byte(value))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
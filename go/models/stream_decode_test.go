@@ -0,0 +1,101 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type decodedEvent struct {
+	ID       string `glide:",id"`
+	Kind     string `glide:"kind"`
+	Count    int32
+	Ratio    float64  `glide:"ratio,default=1.5"`
+	Enabled  bool     `glide:"enabled"`
+	Limit    *uint16  `glide:"limit"`
+	Payload  []byte   `glide:"payload"`
+	Address  net.IP   `glide:"address"`
+	Tags     string   `glide:"tags,default=a,b"`
+	Ignored  string   `glide:"-"`
+	Optional *float32 `glide:"optional"`
+}
+
+func TestStreamEntryDecode(t *testing.T) {
+	entry := StreamEntry{
+		ID: "1-0",
+		Fields: []FieldValue{
+			{Field: "kind", Value: "deposit"},
+			{Field: "Count", Value: "-7"},
+			{Field: "enabled", Value: "true"},
+			{Field: "limit", Value: "300"},
+			{Field: "payload", Value: "bytes"},
+			{Field: "address", Value: "10.0.0.1"},
+			{Field: "-", Value: "ignored"},
+			{Field: "unknown", Value: "ignored"},
+		},
+	}
+	var event decodedEvent
+	require.NoError(t, entry.Decode(&event))
+	assert.Equal(t, "1-0", event.ID)
+	assert.Equal(t, "deposit", event.Kind)
+	assert.Equal(t, int32(-7), event.Count)
+	assert.Equal(t, 1.5, event.Ratio)
+	assert.True(t, event.Enabled)
+	require.NotNil(t, event.Limit)
+	assert.Equal(t, uint16(300), *event.Limit)
+	assert.Equal(t, []byte("bytes"), event.Payload)
+	assert.Equal(t, "10.0.0.1", event.Address.String())
+	assert.Equal(t, "a,b", event.Tags)
+	assert.Empty(t, event.Ignored)
+	assert.Nil(t, event.Optional)
+}
+
+func TestStreamEntryDecode_Errors(t *testing.T) {
+	entry := StreamEntry{ID: "1-0", Fields: []FieldValue{{Field: "Count", Value: "many"}}}
+	assert.ErrorContains(t, entry.Decode(&decodedEvent{}), `stream entry 1-0: field "Count"`)
+
+	entry = StreamEntry{ID: "1-0", Fields: []FieldValue{{Field: "limit", Value: "70000"}}}
+	assert.Error(t, entry.Decode(&decodedEvent{}))
+
+	assert.Error(t, entry.Decode(decodedEvent{}))
+	assert.Error(t, entry.Decode(&struct {
+		ID int `glide:",id"`
+	}{}))
+	assert.Error(t, entry.Decode(&struct {
+		Kind string `glide:"kind,unknown"`
+	}{}))
+	assert.Error(t, StreamEntry{Fields: []FieldValue{{Field: "Map", Value: "x"}}}.Decode(&struct {
+		Map map[string]string
+	}{}))
+}
+
+func TestDecodeStreamEntries(t *testing.T) {
+	entries := []StreamEntry{
+		{ID: "1-0", Fields: []FieldValue{{Field: "kind", Value: "a"}}},
+		{ID: "2-0", Fields: []FieldValue{{Field: "kind", Value: "b"}, {Field: "ratio", Value: "0.25"}}},
+	}
+
+	var events []decodedEvent
+	require.NoError(t, DecodeStreamEntries(entries, &events))
+	require.Len(t, events, 2)
+	assert.Equal(t, "1-0", events[0].ID)
+	assert.Equal(t, 1.5, events[0].Ratio)
+	assert.Equal(t, "b", events[1].Kind)
+	assert.Equal(t, 0.25, events[1].Ratio)
+
+	var pointers []*decodedEvent
+	require.NoError(t, DecodeStreamEntries(entries, &pointers))
+	require.Len(t, pointers, 2)
+	assert.Equal(t, "2-0", pointers[1].ID)
+
+	events = []decodedEvent{{Kind: "stale"}, {}, {}}
+	require.NoError(t, DecodeStreamEntries(nil, &events))
+	assert.Empty(t, events)
+
+	assert.Error(t, DecodeStreamEntries(entries, events))
+	assert.Error(t, DecodeStreamEntries(entries, &[]string{}))
+}
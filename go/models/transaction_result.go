@@ -0,0 +1,98 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import "fmt"
+
+// CommandResult is the outcome of a command of a transaction.
+type CommandResult struct {
+	// The index of the command in the transaction, starting at 0.
+	Index int
+	// The name of the command in uppercase, as queued, e.g. "INCR".
+	Command string
+	// The result of the command, converted as by `Exec`, or nil if the command failed.
+	Value any
+	// The error of the command, or nil if it succeeded.
+	Err error
+}
+
+// CommandError is the error of a command of a transaction, returned by [TransactionResult.Err]. It wraps the error
+// returned by the server for the command.
+type CommandError struct {
+	// The index of the command in the transaction, starting at 0.
+	Index int
+	// The name of the command in uppercase, e.g. "INCR".
+	Command string
+	// The error returned by the server for the command.
+	Err error
+}
+
+func (e *CommandError) Error() string {
+	return fmt.Sprintf("command %d (%s) of the transaction failed: %v", e.Index, e.Command, e.Err)
+}
+
+func (e *CommandError) Unwrap() error { return e.Err }
+
+// TransactionResult is the outcome of a transaction, returned by `ExecTransaction`: the result or the error of every
+// command, along with its index and name, so that a failed command can be told apart from the others.
+//
+// The commands of a transaction are executed even if some of them fail, e.g. an `INCR` on a key holding a non-numeric
+// string: the server does not roll back the transaction.
+type TransactionResult struct {
+	// The outcome of every command, in the order they were queued. Empty if the transaction was aborted.
+	Results []CommandResult
+	// Whether the transaction was aborted, because a key watched with `WATCH` was modified. None of its commands were
+	// executed.
+	Aborted bool
+}
+
+// Failed returns the outcome of the commands that failed, in the order they were queued.
+func (r TransactionResult) Failed() []CommandResult {
+	var failed []CommandResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns a [CommandError] for the first command that failed, or nil if every command succeeded.
+func (r TransactionResult) Err() error {
+	for _, result := range r.Results {
+		if result.Err != nil {
+			return &CommandError{Index: result.Index, Command: result.Command, Err: result.Err}
+		}
+	}
+	return nil
+}
+
+// Values returns the results of the commands, in the order they were queued, with nil for the commands that failed.
+func (r TransactionResult) Values() []any {
+	values := make([]any, len(r.Results))
+	for i, result := range r.Results {
+		values[i] = result.Value
+	}
+	return values
+}
+
+// NewTransactionResult builds the [TransactionResult] of the commands named `commands`, from the results of the
+// transaction, in which the failed commands have an error instead of a result. `results` is nil if the transaction was
+// aborted.
+func NewTransactionResult(commands []string, results []any) TransactionResult {
+	if results == nil {
+		return TransactionResult{Aborted: true}
+	}
+	transactionResult := TransactionResult{Results: make([]CommandResult, len(results))}
+	for i, value := range results {
+		result := CommandResult{Index: i, Value: value}
+		if i < len(commands) {
+			result.Command = commands[i]
+		}
+		if err, ok := value.(error); ok {
+			result.Value, result.Err = nil, err
+		}
+		transactionResult.Results[i] = result
+	}
+	return transactionResult
+}
@@ -0,0 +1,48 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransactionResult(t *testing.T) {
+	wrongType := errors.New("WRONGTYPE Operation against a key holding the wrong kind of value")
+	notInteger := errors.New("ERR value is not an integer or out of range")
+	result := NewTransactionResult(
+		[]string{"SET", "LPUSH", "INCR", "GET"},
+		[]any{"OK", wrongType, notInteger, "value"},
+	)
+
+	assert.False(t, result.Aborted)
+	assert.Equal(t, []any{"OK", nil, nil, "value"}, result.Values())
+	assert.Equal(t, []CommandResult{
+		{Index: 1, Command: "LPUSH", Err: wrongType},
+		{Index: 2, Command: "INCR", Err: notInteger},
+	}, result.Failed())
+
+	err := result.Err()
+	var commandErr *CommandError
+	require.ErrorAs(t, err, &commandErr)
+	assert.Equal(t, 1, commandErr.Index)
+	assert.Equal(t, "LPUSH", commandErr.Command)
+	assert.ErrorIs(t, err, wrongType)
+	assert.Equal(t, "command 1 (LPUSH) of the transaction failed: "+wrongType.Error(), err.Error())
+}
+
+func TestNewTransactionResult_Succeeded(t *testing.T) {
+	result := NewTransactionResult([]string{"SET", "GET"}, []any{"OK", nil})
+	assert.NoError(t, result.Err())
+	assert.Empty(t, result.Failed())
+	assert.Equal(t, []CommandResult{{Index: 0, Command: "SET", Value: "OK"}, {Index: 1, Command: "GET"}}, result.Results)
+
+	// An aborted transaction has no results
+	result = NewTransactionResult([]string{"SET"}, nil)
+	assert.True(t, result.Aborted)
+	assert.Empty(t, result.Results)
+	assert.NoError(t, result.Err())
+}
@@ -0,0 +1,42 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import "io"
+
+// MemberAndScoreIterator provides the members added by `ZAddBulk`.
+type MemberAndScoreIterator interface {
+	// Next returns the next member and its score, or [io.EOF] once all the members were returned. Any other error stops
+	// the load.
+	Next() (MemberAndScore, error)
+}
+
+type sliceMemberAndScoreIterator struct {
+	members []MemberAndScore
+}
+
+// NewMemberAndScoreIterator returns a [MemberAndScoreIterator] over the members of a slice, in order.
+func NewMemberAndScoreIterator(members []MemberAndScore) MemberAndScoreIterator {
+	return &sliceMemberAndScoreIterator{members: members}
+}
+
+func (it *sliceMemberAndScoreIterator) Next() (MemberAndScore, error) {
+	if len(it.members) == 0 {
+		return MemberAndScore{}, io.EOF
+	}
+	member := it.members[0]
+	it.members = it.members[1:]
+	return member, nil
+}
+
+// ZAddBulkResult reports the outcome of a `ZAddBulk`.
+type ZAddBulkResult struct {
+	// The number of members of the iterator written, whether they were added or their score was updated.
+	Written int64
+	// The number of members added to the sorted set, as counted by `ZADD`.
+	Added int64
+	// The number of members of the iterator that could not be written.
+	Failed int64
+	// The errors of the `ZADD` commands that failed, in no particular order.
+	Errors []error
+}
@@ -0,0 +1,234 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package monitoring
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// PayloadSizeBounds are the upper bounds, in bytes, of the buckets of a [SizeHistogram], from 64 bytes to 64 MiB.
+var PayloadSizeBounds = []int64{
+	64, 256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 4 << 20, 16 << 20, 64 << 20,
+}
+
+// OtherFamily is the family of the commands that are not in the families of [CommandFamily].
+const OtherFamily = "other"
+
+// commandFamilies are the families of the commands, by name in uppercase.
+var commandFamilies = map[string]string{}
+
+func init() {
+	families := map[string][]string{
+		"string": {
+			"APPEND", "DECR", "DECRBY", "GET", "GETDEL", "GETEX", "GETRANGE", "GETSET", "INCR", "INCRBY", "INCRBYFLOAT",
+			"LCS", "MGET", "MSET", "MSETNX", "PSETEX", "SET", "SETEX", "SETNX", "SETRANGE", "STRLEN", "SUBSTR",
+		},
+		"hash": {
+			"HDEL", "HEXISTS", "HEXPIRE", "HGET", "HGETALL", "HGETDEL", "HGETEX", "HINCRBY", "HINCRBYFLOAT", "HKEYS", "HLEN",
+			"HMGET", "HMSET", "HPERSIST", "HRANDFIELD", "HSCAN", "HSET", "HSETEX", "HSETNX", "HSTRLEN", "HTTL", "HVALS",
+		},
+		"list": {
+			"BLMOVE", "BLMPOP", "BLPOP", "BRPOP", "BRPOPLPUSH", "LINDEX", "LINSERT", "LLEN", "LMOVE", "LMPOP", "LPOP", "LPOS",
+			"LPUSH", "LPUSHX", "LRANGE", "LREM", "LSET", "LTRIM", "RPOP", "RPOPLPUSH", "RPUSH", "RPUSHX",
+		},
+		"set": {
+			"SADD", "SCARD", "SDIFF", "SDIFFSTORE", "SINTER", "SINTERCARD", "SINTERSTORE", "SISMEMBER", "SMEMBERS",
+			"SMISMEMBER", "SMOVE", "SPOP", "SRANDMEMBER", "SREM", "SSCAN", "SUNION", "SUNIONSTORE",
+		},
+		"sorted-set": {
+			"BZMPOP", "BZPOPMAX", "BZPOPMIN", "ZADD", "ZCARD", "ZCOUNT", "ZDIFF", "ZDIFFSTORE", "ZINCRBY", "ZINTER",
+			"ZINTERCARD", "ZINTERSTORE", "ZLEXCOUNT", "ZMPOP", "ZMSCORE", "ZPOPMAX", "ZPOPMIN", "ZRANDMEMBER", "ZRANGE",
+			"ZRANGEBYLEX", "ZRANGEBYSCORE", "ZRANGESTORE", "ZRANK", "ZREM", "ZREMRANGEBYLEX", "ZREMRANGEBYRANK",
+			"ZREMRANGEBYSCORE", "ZREVRANGE", "ZREVRANGEBYLEX", "ZREVRANGEBYSCORE", "ZREVRANK", "ZSCAN", "ZSCORE", "ZUNION",
+			"ZUNIONSTORE",
+		},
+		"stream": {
+			"XACK", "XACKDEL", "XADD", "XAUTOCLAIM", "XCLAIM", "XDEL", "XDELEX", "XLEN", "XPENDING", "XRANGE", "XREAD",
+			"XREADGROUP", "XREVRANGE", "XTRIM",
+		},
+		"geo":         {"GEOADD", "GEODIST", "GEOHASH", "GEOPOS", "GEOSEARCH", "GEOSEARCHSTORE"},
+		"bitmap":      {"BITCOUNT", "BITFIELD", "BITFIELD_RO", "BITOP", "BITPOS", "GETBIT", "SETBIT"},
+		"hyperloglog": {"PFADD", "PFCOUNT", "PFMERGE"},
+		"scripting":   {"EVAL", "EVALSHA", "EVALSHA_RO", "EVAL_RO", "FCALL", "FCALL_RO"},
+		"pubsub":      {"PUBLISH", "SPUBLISH"},
+	}
+	for family, commands := range families {
+		for _, command := range commands {
+			commandFamilies[command] = family
+		}
+	}
+}
+
+// CommandFamily returns the family of a command, by the type of the values it reads or writes: "string", "hash", "list",
+// "set", "sorted-set", "stream", "geo", "bitmap", "hyperloglog", "scripting", "pubsub", or [OtherFamily] for the other
+// commands, such as the generic commands on the keys and the server commands.
+//
+// The family of a module command is its prefix in lowercase, e.g. "json" for "JSON.GET".
+func CommandFamily(command string) string {
+	command = strings.ToUpper(command)
+	if family, ok := commandFamilies[command]; ok {
+		return family
+	}
+	if module, _, ok := strings.Cut(command, "."); ok {
+		return strings.ToLower(module)
+	}
+	return OtherFamily
+}
+
+// PayloadSize is the size of the payloads of a command, recorded by [PayloadSizes].
+type PayloadSize struct {
+	// The name of the command, e.g. "GET".
+	Command string
+	// The family of the command, as returned by [CommandFamily].
+	Family string
+	// The size of the name and the arguments of the command, in bytes.
+	Request int64
+	// The size of the strings of the response of the command, in bytes, as returned by [glide.ResponseSize].
+	Response int64
+}
+
+// SizeHistogram is a histogram of payload sizes, with the buckets of [PayloadSizeBounds].
+type SizeHistogram struct {
+	// The number of sizes of every bucket: Counts[i] is the number of sizes no greater than PayloadSizeBounds[i] and
+	// greater than the previous bound, and the last count is the number of sizes greater than the last bound.
+	Counts []uint64
+	// The number of sizes.
+	Count uint64
+	// The sum of the sizes, in bytes.
+	Sum int64
+	// The greatest size, in bytes.
+	Max int64
+}
+
+// FamilyPayloadSizes are the histograms of the payload sizes of the commands of a family.
+type FamilyPayloadSizes struct {
+	Requests  SizeHistogram
+	Responses SizeHistogram
+}
+
+// PayloadSizes records the sizes of the requests and responses of a client into histograms, by command family, e.g. to
+// spot the unexpectedly large values and tune the `maxmemory` configuration of the servers.
+//
+// The sizes are recorded by its [PayloadSizes.Interceptor], for the commands and scripts that succeed, and for every
+// command of the batches that succeed. The histograms are read with [PayloadSizes.Snapshot], and every size is also
+// passed to the hook given to [NewPayloadSizes], to export it to the histograms of a metrics library.
+//
+// Example:
+//
+//	sizes := monitoring.NewPayloadSizes(func(size monitoring.PayloadSize) {
+//	    responseSizes.WithLabelValues(size.Family).Observe(float64(size.Response))
+//	})
+//	cfg := config.NewClientConfiguration().
+//	    WithAddress(&config.NodeAddress{Host: "localhost", Port: 6379}).
+//	    WithInterceptor(sizes.Interceptor())
+type PayloadSizes struct {
+	hook func(PayloadSize)
+
+	mu       sync.Mutex
+	families map[string]*FamilyPayloadSizes
+}
+
+// NewPayloadSizes creates a [PayloadSizes] recorder.
+//
+// Parameters:
+//
+//	hook - Called with the sizes of every command, from the goroutine sending it, or nil. It should not block.
+//
+// Return value:
+//
+//	A new PayloadSizes recorder, with empty histograms.
+func NewPayloadSizes(hook func(PayloadSize)) *PayloadSizes {
+	return &PayloadSizes{hook: hook, families: map[string]*FamilyPayloadSizes{}}
+}
+
+// Interceptor returns the interceptor recording the payload sizes of the requests of a client.
+func (s *PayloadSizes) Interceptor() config.Interceptor {
+	return func(ctx context.Context, cmd models.Command, next config.Invoker) (any, error) {
+		response, err := next(ctx, cmd)
+		if err != nil {
+			return response, err
+		}
+		if cmd.Batch == nil {
+			s.record(cmd, response)
+			return response, nil
+		}
+		if results, ok := response.([]any); ok && len(results) == len(cmd.Batch) {
+			for i, batchCmd := range cmd.Batch {
+				s.record(batchCmd, results[i])
+			}
+		}
+		return response, nil
+	}
+}
+
+// record records the sizes of `cmd` and its `response`.
+func (s *PayloadSizes) record(cmd models.Command, response any) {
+	size := PayloadSize{
+		Command:  cmd.Name,
+		Family:   CommandFamily(cmd.Name),
+		Request:  requestSize(cmd),
+		Response: glide.ResponseSize(response),
+	}
+	s.mu.Lock()
+	family, ok := s.families[size.Family]
+	if !ok {
+		family = &FamilyPayloadSizes{Requests: newSizeHistogram(), Responses: newSizeHistogram()}
+		s.families[size.Family] = family
+	}
+	family.Requests.add(size.Request)
+	family.Responses.add(size.Response)
+	s.mu.Unlock()
+	if s.hook != nil {
+		s.hook(size)
+	}
+}
+
+// Snapshot returns a copy of the histograms of the payload sizes recorded so far, by command family.
+func (s *PayloadSizes) Snapshot() map[string]FamilyPayloadSizes {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := make(map[string]FamilyPayloadSizes, len(s.families))
+	for name, family := range s.families {
+		snapshot[name] = FamilyPayloadSizes{Requests: family.Requests.clone(), Responses: family.Responses.clone()}
+	}
+	return snapshot
+}
+
+// requestSize returns the size of the name and the arguments of `cmd`.
+func requestSize(cmd models.Command) int64 {
+	size := int64(len(cmd.Name))
+	for _, arg := range cmd.Args {
+		size += int64(len(arg))
+	}
+	return size
+}
+
+func newSizeHistogram() SizeHistogram {
+	return SizeHistogram{Counts: make([]uint64, len(PayloadSizeBounds)+1)}
+}
+
+// add records `size` into the histogram.
+func (h *SizeHistogram) add(size int64) {
+	bucket := len(PayloadSizeBounds)
+	for i, bound := range PayloadSizeBounds {
+		if size <= bound {
+			bucket = i
+			break
+		}
+	}
+	h.Counts[bucket]++
+	h.Count++
+	h.Sum += size
+	h.Max = max(h.Max, size)
+}
+
+func (h SizeHistogram) clone() SizeHistogram {
+	h.Counts = append([]uint64(nil), h.Counts...)
+	return h
+}
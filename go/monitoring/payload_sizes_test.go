@@ -0,0 +1,67 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestCommandFamily(t *testing.T) {
+	assert.Equal(t, "string", CommandFamily("GET"))
+	assert.Equal(t, "hash", CommandFamily("hgetall"))
+	assert.Equal(t, "sorted-set", CommandFamily("ZADD"))
+	assert.Equal(t, "json", CommandFamily("JSON.GET"))
+	assert.Equal(t, OtherFamily, CommandFamily("DEL"))
+	assert.Equal(t, OtherFamily, CommandFamily("XGROUP CREATE"))
+}
+
+func TestPayloadSizes(t *testing.T) {
+	ctx := context.Background()
+	var hooked []PayloadSize
+	sizes := NewPayloadSizes(func(size PayloadSize) { hooked = append(hooked, size) })
+	interceptor := sizes.Interceptor()
+	reply := func(response any, err error) func(ctx context.Context, cmd models.Command) (any, error) {
+		return func(ctx context.Context, cmd models.Command) (any, error) { return response, err }
+	}
+
+	// The commands are recorded by family, and the commands of the batches individually
+	_, err := interceptor(ctx, models.Command{Name: "SET", Args: []string{"key", "value"}}, reply("OK", nil))
+	require.NoError(t, err)
+	batch := models.Command{Name: "Batch", Batch: []models.Command{
+		{Name: "GET", Args: []string{"key"}},
+		{Name: "HGETALL", Args: []string{"hash"}},
+	}}
+	large := string(make([]byte, 1000))
+	_, err = interceptor(ctx, batch, reply([]any{"value", map[string]any{"field": large}}, nil))
+	require.NoError(t, err)
+
+	// The failed requests are not
+	_, err = interceptor(ctx, models.Command{Name: "GET", Args: []string{"key"}}, reply(nil, errors.New("WRONGTYPE")))
+	require.Error(t, err)
+
+	assert.Equal(t, []PayloadSize{
+		{Command: "SET", Family: "string", Request: 11, Response: 2},
+		{Command: "GET", Family: "string", Request: 6, Response: 5},
+		{Command: "HGETALL", Family: "hash", Request: 11, Response: 1005},
+	}, hooked)
+
+	snapshot := sizes.Snapshot()
+	require.Len(t, snapshot, 2)
+	strings := snapshot["string"]
+	assert.Equal(t, uint64(2), strings.Requests.Count)
+	assert.Equal(t, int64(17), strings.Requests.Sum)
+	assert.Equal(t, uint64(2), strings.Responses.Counts[0])
+	hashes := snapshot["hash"]
+	assert.Equal(t, int64(1005), hashes.Responses.Max)
+	assert.Equal(t, uint64(1), hashes.Responses.Counts[2])
+
+	// The snapshots are copies
+	hashes.Responses.Counts[2] = 0
+	assert.Equal(t, uint64(1), sizes.Snapshot()["hash"].Responses.Counts[2])
+}
@@ -0,0 +1,202 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package monitoring provides lightweight tools to observe the servers a client is connected to, such as a watcher of
+// their slow log, meant to feed the alerting of the services.
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+)
+
+// SlowLogEntry is a command logged by the slow log of a server, as reported by `SLOWLOG GET`.
+type SlowLogEntry struct {
+	// The address of the node that logged the command, e.g. "127.0.0.1:7000", or empty for a standalone client.
+	Node string
+	// The identifier of the entry, increasing with every entry logged by the node.
+	ID int64
+	// The time the command was processed at, with a second precision.
+	Time time.Time
+	// The time the server spent executing the command, excluding the I/O.
+	Duration time.Duration
+	// The name and the arguments of the command, possibly truncated by the server.
+	Args []string
+	// The address of the client that sent the command.
+	ClientAddress string
+	// The name of the client that sent the command, as set with `CLIENT SETNAME`, or empty.
+	ClientName string
+}
+
+// WatchSlowLog polls the slow log of the servers every `pollInterval` and calls `handler` with the entries logged since
+// the previous poll, until `ctx` is cancelled or a poll fails.
+//
+// With a [glide.Client], the slow log of the server the commands are sent to is polled. With a [glide.ClusterClient], the
+// slow log of every node, primaries and replicas, is polled: the entries are identified by their node and identifier, so
+// that every entry is reported once, although the nodes number their entries independently. The entries logged before
+// the first poll are not reported. Entries may be missed if the slow log of a node overflows between two polls, see the
+// `slowlog-max-len` configuration of the server.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the watch. Cancelling it stops the watch.
+//	client - The [glide.Client] or [glide.ClusterClient] connected to the servers to watch.
+//	pollInterval - The interval between the polls of the slow log.
+//	handler - Called with every new entry, in the order of the identifiers of every node, from the goroutine calling
+//	  WatchSlowLog.
+//
+// Return value:
+//
+//	The error that stopped the watch: the error of `ctx` once it is cancelled, or the error of the poll.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func WatchSlowLog(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	pollInterval time.Duration,
+	handler func(SlowLogEntry),
+) error {
+	if pollInterval <= 0 {
+		return errors.New("the poll interval must be positive")
+	}
+	var poll func(ctx context.Context) (map[string]any, error)
+	switch client := client.(type) {
+	case *glide.Client:
+		poll = func(ctx context.Context) (map[string]any, error) {
+			reply, err := client.CustomCommand(ctx, slowLogGet)
+			return map[string]any{"": reply}, err
+		}
+	case *glide.ClusterClient:
+		poll = func(ctx context.Context) (map[string]any, error) {
+			reply, err := client.CustomCommandWithRoute(ctx, slowLogGet, config.AllNodes)
+			return reply.MultiValue(), err
+		}
+	default:
+		return fmt.Errorf("unsupported client type %T", client)
+	}
+
+	watcher := newSlowLogWatcher()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		replies, err := poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to get the slow log: %w", err)
+		}
+		entries, err := watcher.update(replies)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			handler(entry)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// slowLogGet gets all the entries of the slow log, which is bounded by `slowlog-max-len` on the server.
+var slowLogGet = []string{"SLOWLOG", "GET", "-1"}
+
+// slowLogWatcher tracks the last entry reported for every node.
+type slowLogWatcher struct {
+	// The identifier of the last entry reported for every node polled before.
+	lastIDs map[string]int64
+}
+
+func newSlowLogWatcher() *slowLogWatcher {
+	return &slowLogWatcher{lastIDs: map[string]int64{}}
+}
+
+// update returns the new entries of the slow log replies of the nodes, and records them as reported. The first reply of a
+// node only sets the baseline of the node.
+func (w *slowLogWatcher) update(replies map[string]any) ([]SlowLogEntry, error) {
+	nodes := make([]string, 0, len(replies))
+	for node := range replies {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	var entries []SlowLogEntry
+	for _, node := range nodes {
+		logged, err := parseSlowLog(node, replies[node])
+		if err != nil {
+			return nil, err
+		}
+		// The entries are returned from the newest to the oldest
+		slices.Reverse(logged)
+		latest := int64(-1)
+		if len(logged) > 0 {
+			latest = logged[len(logged)-1].ID
+		}
+		lastID, polled := w.lastIDs[node]
+		if !polled {
+			w.lastIDs[node] = latest
+			continue
+		}
+		if latest < lastID {
+			// The identifiers restarted from 0, after a restart of the node
+			lastID = -1
+		}
+		for _, entry := range logged {
+			if entry.ID > lastID {
+				entries = append(entries, entry)
+			}
+		}
+		w.lastIDs[node] = max(latest, lastID)
+	}
+	return entries, nil
+}
+
+// parseSlowLog parses the reply of `SLOWLOG GET` of `node`.
+func parseSlowLog(node string, reply any) ([]SlowLogEntry, error) {
+	rawEntries, ok := reply.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected slow log reply of type %T from node %q", reply, node)
+	}
+	entries := make([]SlowLogEntry, 0, len(rawEntries))
+	for _, raw := range rawEntries {
+		fields, ok := raw.([]any)
+		if !ok || len(fields) < 4 {
+			return nil, fmt.Errorf("unexpected slow log entry %v from node %q", raw, node)
+		}
+		id, ok1 := fields[0].(int64)
+		timestamp, ok2 := fields[1].(int64)
+		micros, ok3 := fields[2].(int64)
+		rawArgs, ok4 := fields[3].([]any)
+		if !ok1 || !ok2 || !ok3 || !ok4 {
+			return nil, fmt.Errorf("unexpected slow log entry %v from node %q", raw, node)
+		}
+		entry := SlowLogEntry{
+			Node:     node,
+			ID:       id,
+			Time:     time.Unix(timestamp, 0),
+			Duration: time.Duration(micros) * time.Microsecond,
+			Args:     make([]string, 0, len(rawArgs)),
+		}
+		for _, arg := range rawArgs {
+			entry.Args = append(entry.Args, fmt.Sprint(arg))
+		}
+		// The client fields were added in Redis 4.0
+		if len(fields) >= 6 {
+			entry.ClientAddress, _ = fields[4].(string)
+			entry.ClientName, _ = fields[5].(string)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,75 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package monitoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowLogReply builds a reply of `SLOWLOG GET` with the entries of identifiers `ids`, from the newest to the oldest.
+func slowLogReply(ids ...int64) []any {
+	reply := make([]any, 0, len(ids))
+	for _, id := range ids {
+		reply = append(reply, []any{id, int64(1700000000), int64(15000), []any{"KEYS", "*"}, "127.0.0.1:50000", "worker"})
+	}
+	return reply
+}
+
+func ids(entries []SlowLogEntry) []int64 {
+	result := []int64{}
+	for _, entry := range entries {
+		result = append(result, entry.ID)
+	}
+	return result
+}
+
+func TestParseSlowLog(t *testing.T) {
+	entries, err := parseSlowLog("node:7000", slowLogReply(3))
+	require.NoError(t, err)
+	assert.Equal(t, []SlowLogEntry{{
+		Node:          "node:7000",
+		ID:            3,
+		Time:          time.Unix(1700000000, 0),
+		Duration:      15 * time.Millisecond,
+		Args:          []string{"KEYS", "*"},
+		ClientAddress: "127.0.0.1:50000",
+		ClientName:    "worker",
+	}}, entries)
+
+	_, err = parseSlowLog("node:7000", "OK")
+	assert.Error(t, err)
+	_, err = parseSlowLog("node:7000", []any{[]any{"3"}})
+	assert.Error(t, err)
+}
+
+func TestSlowLogWatcher(t *testing.T) {
+	watcher := newSlowLogWatcher()
+
+	// The first poll only sets the baseline
+	entries, err := watcher.update(map[string]any{"a:7000": slowLogReply(2, 1), "b:7001": slowLogReply()})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// The new entries are reported once, in order, although the nodes use the same identifiers
+	entries, err = watcher.update(map[string]any{"a:7000": slowLogReply(4, 3, 2, 1), "b:7001": slowLogReply(1, 0)})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4, 0, 1}, ids(entries))
+	assert.Equal(t, "b:7001", entries[2].Node)
+	entries, err = watcher.update(map[string]any{"a:7000": slowLogReply(4, 3, 2, 1), "b:7001": slowLogReply(1, 0)})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// A node polled for the first time, e.g. a new replica, sets its baseline
+	entries, err = watcher.update(map[string]any{"a:7000": slowLogReply(5, 4), "c:7002": slowLogReply(9)})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5}, ids(entries))
+
+	// The identifiers restart after a restart of the node
+	entries, err = watcher.update(map[string]any{"a:7000": slowLogReply(1, 0)})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0, 1}, ids(entries))
+}
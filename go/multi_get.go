@@ -0,0 +1,135 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// MGetWithOptions retrieves the values of multiple keys, like [Client.MGet], reporting the keys that could not be got as
+// set by `opts.FailureMode`.
+//
+// In cluster mode, the keys are split into one `MGET` per hash slot, sent in a non-atomic pipeline: the `MGET`s of the
+// slots of a node are pipelined to the node, and the nodes are sent their pipelines concurrently. The values are returned
+// in the order of `keys`, whatever the nodes they were got from. A failing slot or node does not fail the other keys.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - A list of keys to retrieve values for.
+//	opts - How the keys that could not be got are reported, see [options.MGetOptions].
+//
+// Return value:
+//
+//	An array of [models.Result[string]] values corresponding to the provided keys, with a nil result for the keys that do
+//	not exist. With [options.MGetFailFast], the first error, in the order of the keys, if some keys could not be got. With
+//	[options.MGetBestEffort], the results of the keys that could not be got are nil too, and their errors are returned in a
+//	[KeyErrors] together with the values.
+//
+// [valkey.io]: https://valkey.io/commands/mget/
+func (client *baseClient) MGetWithOptions(
+	ctx context.Context,
+	keys []string,
+	opts options.MGetOptions,
+) ([]models.Result[string], error) {
+	values := make([]models.Result[string], len(keys))
+	for i := range values {
+		values[i] = models.CreateNilStringResult()
+	}
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	var batch internal.Batch
+	var commandIndexes [][]int
+	if client.clusterMode {
+		b := pipeline.NewClusterBatch(false)
+		commandIndexes = addMGetCommands(&b.BaseBatch, keys, true)
+		batch = b.Batch
+	} else {
+		b := pipeline.NewStandaloneBatch(false)
+		commandIndexes = addMGetCommands(&b.BaseBatch, keys, false)
+		batch = b.Batch
+	}
+
+	results, err := client.executeBatch(ctx, batch, false, nil)
+	if err != nil {
+		if opts.FailureMode == options.MGetBestEffort {
+			failed := make(map[string]error, len(keys))
+			for _, key := range keys {
+				failed[key] = err
+			}
+			return values, NewKeyErrors(failed)
+		}
+		return nil, err
+	}
+
+	// The error of every key, by index, so that the first error in the order of the keys is returned with MGetFailFast
+	errs := make([]error, len(keys))
+	for i, result := range results {
+		indexes := commandIndexes[i]
+		if err, ok := result.(error); ok {
+			for _, index := range indexes {
+				errs[index] = err
+			}
+			continue
+		}
+		for j, value := range result.([]models.Result[string]) {
+			values[indexes[j]] = value
+		}
+	}
+	failed := map[string]error{}
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if opts.FailureMode != options.MGetBestEffort {
+			return nil, err
+		}
+		failed[keys[i]] = err
+	}
+	if len(failed) > 0 {
+		return values, NewKeyErrors(failed)
+	}
+	return values, nil
+}
+
+// addMGetCommands adds the `MGET`s of `keys` to `batch`, one per slot if `bySlot`, and returns the indexes in `keys` of the
+// keys of every command.
+func addMGetCommands[T pipeline.StandaloneBatch | pipeline.ClusterBatch](
+	batch *pipeline.BaseBatch[T],
+	keys []string,
+	bySlot bool,
+) [][]int {
+	var slots []uint16
+	bySlotIndexes := map[uint16][]int{}
+	for i, key := range keys {
+		var slot uint16
+		if bySlot {
+			slot = utils.KeySlot(key)
+		}
+		if _, ok := bySlotIndexes[slot]; !ok {
+			slots = append(slots, slot)
+		}
+		bySlotIndexes[slot] = append(bySlotIndexes[slot], i)
+	}
+	commandIndexes := make([][]int, len(slots))
+	for i, slot := range slots {
+		indexes := bySlotIndexes[slot]
+		slotKeys := make([]string, len(indexes))
+		for j, index := range indexes {
+			slotKeys[j] = keys[index]
+		}
+		batch.MGet(slotKeys)
+		commandIndexes[i] = indexes
+	}
+	return commandIndexes
+}
@@ -0,0 +1,27 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+func TestAddMGetCommands(t *testing.T) {
+	keys := []string{"{a}1", "{b}1", "{a}2", "{a}1"}
+
+	batch := pipeline.NewClusterBatch(false)
+	indexes := addMGetCommands(&batch.BaseBatch, keys, true)
+	assert.Len(t, batch.Batch.Commands, 2)
+	assert.Equal(t, []string{"{a}1", "{a}2", "{a}1"}, batch.Batch.Commands[0].Args)
+	assert.Equal(t, []string{"{b}1"}, batch.Batch.Commands[1].Args)
+	assert.Equal(t, [][]int{{0, 2, 3}, {1}}, indexes)
+
+	standalone := pipeline.NewStandaloneBatch(false)
+	indexes = addMGetCommands(&standalone.BaseBatch, keys, false)
+	assert.Len(t, standalone.Batch.Commands, 1)
+	assert.Equal(t, keys, standalone.Batch.Commands[0].Args)
+	assert.Equal(t, [][]int{{0, 1, 2, 3}}, indexes)
+}
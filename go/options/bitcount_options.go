@@ -3,16 +3,35 @@
 package options
 
 import (
+	"fmt"
+
 	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
 )
 
+// BitmapIndexType defines the unit of the `start` and `end` offsets used by `BitCount` and `BitPos`.
+//
+// Since Valkey 7.0 and above.
 type BitmapIndexType string
 
 const (
+	// The offsets are byte indexes. This is the server default when no unit is given.
 	BYTE BitmapIndexType = "BYTE"
-	BIT  BitmapIndexType = "BIT"
+	// The offsets are bit indexes.
+	BIT BitmapIndexType = "BIT"
 )
 
+// toArgs returns the unit argument, or no argument if the unit was not set.
+func (indexType BitmapIndexType) toArgs() ([]string, error) {
+	switch indexType {
+	case "":
+		return []string{}, nil
+	case BIT, BYTE:
+		return []string{string(indexType)}, nil
+	default:
+		return nil, fmt.Errorf("invalid bitmap index type %q: must be BYTE or BIT", string(indexType))
+	}
+}
+
 // Optional arguments to `BitCount` in [BitMapCommands]
 type BitCountOptions struct {
 	Start           int64
@@ -36,7 +55,9 @@ func (options *BitCountOptions) SetEnd(end int64) *BitCountOptions {
 	return options
 }
 
-// SetBitmapIndexType to specify start and end are in BYTE or BIT
+// SetBitmapIndexType to specify start and end are in BYTE or BIT.
+//
+// Since Valkey 7.0 and above.
 func (options *BitCountOptions) SetBitmapIndexType(bitMapIndexType BitmapIndexType) *BitCountOptions {
 	options.BitMapIndexType = bitMapIndexType
 	return options
@@ -46,9 +67,10 @@ func (options *BitCountOptions) SetBitmapIndexType(bitMapIndexType BitmapIndexTy
 func (opts *BitCountOptions) ToArgs() ([]string, error) {
 	args := []string{utils.IntToString(opts.Start), utils.IntToString(opts.End)}
 
-	if opts.BitMapIndexType == BIT || opts.BitMapIndexType == BYTE {
-		args = append(args, string(opts.BitMapIndexType))
+	unit, err := opts.BitMapIndexType.toArgs()
+	if err != nil {
+		return nil, err
 	}
 
-	return args, nil
+	return append(args, unit...), nil
 }
@@ -3,7 +3,7 @@
 package options
 
 import (
-	"errors"
+	"fmt"
 )
 
 type BitOpType string
@@ -15,6 +15,22 @@ const (
 	NOT BitOpType = "NOT"
 )
 
+// BitOpArgumentError is returned when the number of source keys passed to BITOP is invalid for the requested
+// operation. `NOT` takes exactly one source key, all other operations take at least two.
+type BitOpArgumentError struct {
+	// The requested bitwise operation.
+	Operation BitOpType
+	// The number of source keys that were supplied.
+	KeyCount int
+}
+
+func (e *BitOpArgumentError) Error() string {
+	if e.Operation == NOT {
+		return fmt.Sprintf("BITOP NOT requires exactly 1 source key, got %d", e.KeyCount)
+	}
+	return fmt.Sprintf("BITOP %s requires at least 2 source keys, got %d", e.Operation, e.KeyCount)
+}
+
 // BitOp represents a BITOP operation.
 type BitOp struct {
 	Operation BitOpType
@@ -22,15 +38,16 @@ type BitOp struct {
 	SrcKeys   []string
 }
 
-// NewBitOp validates and creates a new BitOp command.
+// NewBitOp validates and creates a new BitOp command. A [BitOpArgumentError] is returned if the number of source keys
+// does not match the requirements of the operation.
 func NewBitOp(operation BitOpType, destKey string, srcKeys []string) (*BitOp, error) {
 	if operation == NOT {
 		if len(srcKeys) != 1 {
-			return nil, errors.New("BITOP NOT requires exactly 1 source key")
+			return nil, &BitOpArgumentError{Operation: operation, KeyCount: len(srcKeys)}
 		}
 	} else {
 		if len(srcKeys) < 2 {
-			return nil, errors.New("BITOP requires at least 2 source keys")
+			return nil, &BitOpArgumentError{Operation: operation, KeyCount: len(srcKeys)}
 		}
 	}
 
@@ -29,7 +29,9 @@ func (options *BitPosOptions) SetEnd(end int64) *BitPosOptions {
 	return options
 }
 
-// SetBitmapIndexType to specify start and end are in BYTE or BIT
+// SetBitmapIndexType to specify start and end are in BYTE or BIT.
+//
+// Since Valkey 7.0 and above.
 func (options *BitPosOptions) SetBitmapIndexType(bitMapIndexType BitmapIndexType) *BitPosOptions {
 	options.BitMapIndexType = bitMapIndexType
 	return options
@@ -39,9 +41,10 @@ func (options *BitPosOptions) SetBitmapIndexType(bitMapIndexType BitmapIndexType
 func (opts *BitPosOptions) ToArgs() ([]string, error) {
 	args := []string{utils.IntToString(opts.Start), utils.IntToString(opts.End)}
 
-	if opts.BitMapIndexType == BIT || opts.BitMapIndexType == BYTE {
-		args = append(args, string(opts.BitMapIndexType))
+	unit, err := opts.BitMapIndexType.toArgs()
+	if err != nil {
+		return nil, err
 	}
 
-	return args, nil
+	return append(args, unit...), nil
 }
@@ -0,0 +1,52 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "time"
+
+// The defaults of [BulkLoadOptions].
+const (
+	DefaultBulkLoadBatchSize   = 1000
+	DefaultBulkLoadConcurrency = 4
+)
+
+// BulkLoadOptions are the optional arguments of `BulkLoad`.
+type BulkLoadOptions struct {
+	// The number of key/value pairs sent in a single pipeline. Defaults to [DefaultBulkLoadBatchSize] if not positive.
+	BatchSize int
+	// The maximum number of pipelines in flight. Defaults to [DefaultBulkLoadConcurrency] if not positive.
+	Concurrency int
+	// The time to live of the loaded keys. If not positive, the keys do not expire.
+	Expiry time.Duration
+	// Called after every pipeline with the number of keys loaded and failed so far. The calls are not concurrent.
+	OnProgress func(loaded int64, failed int64)
+}
+
+// NewBulkLoadOptions returns [BulkLoadOptions] with the default batch size and concurrency, and no expiry.
+func NewBulkLoadOptions() *BulkLoadOptions {
+	return &BulkLoadOptions{BatchSize: DefaultBulkLoadBatchSize, Concurrency: DefaultBulkLoadConcurrency}
+}
+
+// SetBatchSize sets the number of key/value pairs sent in a single pipeline.
+func (opts *BulkLoadOptions) SetBatchSize(batchSize int) *BulkLoadOptions {
+	opts.BatchSize = batchSize
+	return opts
+}
+
+// SetConcurrency sets the maximum number of pipelines in flight.
+func (opts *BulkLoadOptions) SetConcurrency(concurrency int) *BulkLoadOptions {
+	opts.Concurrency = concurrency
+	return opts
+}
+
+// SetExpiry sets the time to live of the loaded keys.
+func (opts *BulkLoadOptions) SetExpiry(expiry time.Duration) *BulkLoadOptions {
+	opts.Expiry = expiry
+	return opts
+}
+
+// SetOnProgress sets the function called after every pipeline with the number of keys loaded and failed so far.
+func (opts *BulkLoadOptions) SetOnProgress(onProgress func(loaded int64, failed int64)) *BulkLoadOptions {
+	opts.OnProgress = onProgress
+	return opts
+}
@@ -0,0 +1,134 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// FailoverMode defines how a replica takes over its primary in `CLUSTER FAILOVER`.
+type FailoverMode string
+
+const (
+	// FailoverForce starts a failover without the handshake with the primary, which may be unreachable. The replica still
+	// needs the agreement of the majority of the primaries.
+	FailoverForce FailoverMode = "FORCE"
+	// FailoverTakeover starts a failover without the agreement of the other primaries. The replica generates a new
+	// configuration epoch on its own and takes over the slots of its primary.
+	FailoverTakeover FailoverMode = "TAKEOVER"
+)
+
+// ClusterSetSlotState defines the state to assign to a slot with `CLUSTER SETSLOT`.
+type ClusterSetSlotState string
+
+const (
+	// SetSlotImporting marks the slot as being imported from the given source node.
+	SetSlotImporting ClusterSetSlotState = "IMPORTING"
+	// SetSlotMigrating marks the slot as being migrated to the given destination node.
+	SetSlotMigrating ClusterSetSlotState = "MIGRATING"
+	// SetSlotNode assigns the slot to the given node.
+	SetSlotNode ClusterSetSlotState = "NODE"
+	// SetSlotStable clears any importing or migrating state from the slot.
+	SetSlotStable ClusterSetSlotState = "STABLE"
+)
+
+// ClusterSetSlotSubcommand is the subcommand for `CLUSTER SETSLOT`, built with [NewSetSlotImporting],
+// [NewSetSlotMigrating], [NewSetSlotNode] or [NewSetSlotStable].
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-setslot/
+type ClusterSetSlotSubcommand struct {
+	State  ClusterSetSlotState
+	NodeId string
+}
+
+// NewSetSlotImporting creates a `CLUSTER SETSLOT <slot> IMPORTING <sourceNodeId>` subcommand.
+func NewSetSlotImporting(sourceNodeId string) *ClusterSetSlotSubcommand {
+	return &ClusterSetSlotSubcommand{State: SetSlotImporting, NodeId: sourceNodeId}
+}
+
+// NewSetSlotMigrating creates a `CLUSTER SETSLOT <slot> MIGRATING <destinationNodeId>` subcommand.
+func NewSetSlotMigrating(destinationNodeId string) *ClusterSetSlotSubcommand {
+	return &ClusterSetSlotSubcommand{State: SetSlotMigrating, NodeId: destinationNodeId}
+}
+
+// NewSetSlotNode creates a `CLUSTER SETSLOT <slot> NODE <nodeId>` subcommand.
+func NewSetSlotNode(nodeId string) *ClusterSetSlotSubcommand {
+	return &ClusterSetSlotSubcommand{State: SetSlotNode, NodeId: nodeId}
+}
+
+// NewSetSlotStable creates a `CLUSTER SETSLOT <slot> STABLE` subcommand.
+func NewSetSlotStable() *ClusterSetSlotSubcommand {
+	return &ClusterSetSlotSubcommand{State: SetSlotStable}
+}
+
+// ToArgs converts the subcommand to a list of arguments.
+func (cmd *ClusterSetSlotSubcommand) ToArgs() ([]string, error) {
+	switch cmd.State {
+	case SetSlotImporting, SetSlotMigrating, SetSlotNode:
+		if cmd.NodeId == "" {
+			return nil, errors.New("CLUSTER SETSLOT " + string(cmd.State) + " requires a node ID")
+		}
+		return []string{string(cmd.State), cmd.NodeId}, nil
+	case SetSlotStable:
+		return []string{string(cmd.State)}, nil
+	default:
+		return nil, errors.New("invalid CLUSTER SETSLOT state: " + string(cmd.State))
+	}
+}
+
+// MigrateSlotOptions are the optional arguments of `ClusterClient.MigrateSlot`.
+type MigrateSlotOptions struct {
+	// The maximum number of keys fetched with `CLUSTER GETKEYSINSLOT` and moved with a single `MIGRATE`. Defaults to 100.
+	BatchSize int64
+	// The timeout of every `MIGRATE` command. Defaults to 5 seconds.
+	Timeout time.Duration
+	// Replace keys that already exist on the target node instead of failing the migration.
+	Replace bool
+	// Credentials used by the source node to authenticate with the target node. Leave empty if the target node does not
+	// require authentication. If only Password is set, the "default" user is used.
+	Username string
+	Password string
+	// Called after every migrated batch. May be nil.
+	OnProgress func(progress models.SlotMigrationProgress)
+}
+
+// NewMigrateSlotOptions returns [MigrateSlotOptions] with the default settings.
+func NewMigrateSlotOptions() *MigrateSlotOptions {
+	return &MigrateSlotOptions{BatchSize: 100, Timeout: 5 * time.Second}
+}
+
+// SetBatchSize sets the maximum number of keys moved with a single `MIGRATE`.
+func (opts *MigrateSlotOptions) SetBatchSize(batchSize int64) *MigrateSlotOptions {
+	opts.BatchSize = batchSize
+	return opts
+}
+
+// SetTimeout sets the timeout of every `MIGRATE` command.
+func (opts *MigrateSlotOptions) SetTimeout(timeout time.Duration) *MigrateSlotOptions {
+	opts.Timeout = timeout
+	return opts
+}
+
+// SetReplace replaces keys that already exist on the target node.
+func (opts *MigrateSlotOptions) SetReplace(replace bool) *MigrateSlotOptions {
+	opts.Replace = replace
+	return opts
+}
+
+// SetCredentials sets the credentials used by the source node to authenticate with the target node.
+func (opts *MigrateSlotOptions) SetCredentials(username string, password string) *MigrateSlotOptions {
+	opts.Username = username
+	opts.Password = password
+	return opts
+}
+
+// SetOnProgress sets the callback invoked after every migrated batch.
+func (opts *MigrateSlotOptions) SetOnProgress(onProgress func(progress models.SlotMigrationProgress)) *MigrateSlotOptions {
+	opts.OnProgress = onProgress
+	return opts
+}
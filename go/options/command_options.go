@@ -4,6 +4,7 @@ package options
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -106,7 +107,9 @@ func (opts *SetOptions) ToArgs() ([]string, error) {
 	if opts.Expiry != nil {
 		switch opts.Expiry.Type {
 		case constants.Seconds, constants.Milliseconds, constants.UnixSeconds, constants.UnixMilliseconds:
-			args = append(args, string(opts.Expiry.Type), strconv.FormatUint(opts.Expiry.GetTime(), 10))
+			var timeArgs []string
+			timeArgs, err = opts.Expiry.timeArgs()
+			args = append(args, timeArgs...)
 		case constants.KeepExisting:
 			args = append(args, string(opts.Expiry.Type))
 		default:
@@ -126,6 +129,20 @@ type GetExOptions struct {
 	// If not set, no expiry time will be set for the value.
 	// Supported ExpiryTypes ("EX", "PX", "EXAT", "PXAT", "PERSIST")
 	Expiry *Expiry
+	// Whether an absolute expiry ("EXAT" or "PXAT") may be in the past, deleting the key immediately. If not set, such an
+	// expiry is rejected with a [PastExpiryError] before the command is sent.
+	AllowPast bool
+}
+
+// PastExpiryError is returned when the absolute expiry of [GetExOptions] is in the past, which would delete the key
+// immediately, unless [GetExOptions.SetAllowPast] is set.
+type PastExpiryError struct {
+	// The expiry timestamp.
+	Timestamp time.Time
+}
+
+func (e *PastExpiryError) Error() string {
+	return fmt.Sprintf("the expiry timestamp %s is in the past", e.Timestamp.Format(time.RFC3339Nano))
 }
 
 func NewGetExOptions() *GetExOptions {
@@ -137,14 +154,41 @@ func (getExOptions *GetExOptions) SetExpiry(expiry *Expiry) *GetExOptions {
 	return getExOptions
 }
 
+// SetExpiryAtUnixSeconds sets the expiry of the key to `timestamp`, truncated to the second ("EXAT").
+func (getExOptions *GetExOptions) SetExpiryAtUnixSeconds(timestamp time.Time) *GetExOptions {
+	getExOptions.Expiry = &Expiry{Type: constants.UnixSeconds, Timestamp: timestamp}
+	return getExOptions
+}
+
+// SetExpiryAtUnixMillis sets the expiry of the key to `timestamp`, truncated to the millisecond ("PXAT").
+func (getExOptions *GetExOptions) SetExpiryAtUnixMillis(timestamp time.Time) *GetExOptions {
+	getExOptions.Expiry = &Expiry{Type: constants.UnixMilliseconds, Timestamp: timestamp}
+	return getExOptions
+}
+
+// SetAllowPast sets whether an absolute expiry may be in the past, see [GetExOptions.AllowPast].
+func (getExOptions *GetExOptions) SetAllowPast(allowPast bool) *GetExOptions {
+	getExOptions.AllowPast = allowPast
+	return getExOptions
+}
+
 func (opts *GetExOptions) ToArgs() ([]string, error) {
 	args := []string{}
 	var err error
 
 	if opts.Expiry != nil {
 		switch opts.Expiry.Type {
-		case constants.Seconds, constants.Milliseconds, constants.UnixSeconds, constants.UnixMilliseconds:
-			args = append(args, string(opts.Expiry.Type), strconv.FormatUint(opts.Expiry.GetTime(), 10))
+		case constants.UnixSeconds, constants.UnixMilliseconds:
+			if !opts.AllowPast && opts.Expiry.Timestamp.Before(time.Now()) {
+				return nil, &PastExpiryError{Timestamp: opts.Expiry.Timestamp}
+			}
+			var timeArgs []string
+			timeArgs, err = opts.Expiry.timeArgs()
+			args = append(args, timeArgs...)
+		case constants.Seconds, constants.Milliseconds:
+			var timeArgs []string
+			timeArgs, err = opts.Expiry.timeArgs()
+			args = append(args, timeArgs...)
 		case constants.Persist:
 			args = append(args, string(opts.Expiry.Type))
 		default:
@@ -160,6 +204,8 @@ type Expiry struct {
 	Type      constants.ExpiryType
 	Duration  uint64
 	Timestamp time.Time
+	// The percentage within which a relative expiry is randomized, see [Expiry.SetJitter]. Zero by default.
+	JitterPercent int
 }
 
 // isExpiryTypeSeconds checks if the expiry type should be in seconds
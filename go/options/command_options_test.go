@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExOptions_ExpiryAt(t *testing.T) {
+	timestamp := time.Now().Add(time.Hour)
+
+	args, err := NewGetExOptions().SetExpiryAtUnixMillis(timestamp).ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PXAT", strconv.FormatInt(timestamp.UnixMilli(), 10)}, args)
+
+	args, err = NewGetExOptions().SetExpiryAtUnixSeconds(timestamp).ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EXAT", strconv.FormatInt(timestamp.Unix(), 10)}, args)
+}
+
+func TestGetExOptions_PastExpiry(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	// The past timestamps are rejected, however the expiry is set
+	_, err := NewGetExOptions().SetExpiryAtUnixMillis(past).ToArgs()
+	var pastErr *PastExpiryError
+	require.ErrorAs(t, err, &pastErr)
+	assert.True(t, past.Equal(pastErr.Timestamp))
+	_, err = NewGetExOptions().SetExpiry(NewExpiryAt(past)).ToArgs()
+	assert.ErrorAs(t, err, &pastErr)
+
+	// Unless they are allowed
+	args, err := NewGetExOptions().SetExpiryAtUnixSeconds(past).SetAllowPast(true).ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EXAT", strconv.FormatInt(past.Unix(), 10)}, args)
+
+	// The relative expiries are not timestamps
+	args, err = NewGetExOptions().SetExpiry(NewExpiryIn(time.Second)).ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EX", "1"}, args)
+}
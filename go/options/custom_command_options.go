@@ -0,0 +1,23 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// CustomCommandOptions are the optional arguments of `CustomCommandWithOptions`.
+type CustomCommandOptions struct {
+	// Whether the command only reads data, so that it is routed according to the `ReadFrom` strategy of the client. If
+	// false, the client classifies the command with the flags the server returns for it in the command table, and only
+	// routes it to a replica if it is flagged as "readonly".
+	ReadOnlyHint bool
+}
+
+// NewCustomCommandOptions returns [CustomCommandOptions] classifying the command with the command table of the server.
+func NewCustomCommandOptions() *CustomCommandOptions {
+	return &CustomCommandOptions{}
+}
+
+// WithReadOnlyHint marks the command as a read-only command, without looking it up in the command table of the server.
+// Use it for read commands the server does not flag as "readonly", e.g. some module commands.
+func (opts *CustomCommandOptions) WithReadOnlyHint() *CustomCommandOptions {
+	opts.ReadOnlyHint = true
+	return opts
+}
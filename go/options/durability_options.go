@@ -0,0 +1,23 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "time"
+
+// DurabilityOptions are the optional arguments of the writes that wait for their replication, such as `SetAndWait`.
+//
+// After the write, `WAIT` is sent to the primary that performed it, which blocks until the replicas acknowledged the
+// write or the timeout is reached. The client has a single connection to every node, so `WAIT` also covers the other
+// writes the client sent to that primary before it.
+type DurabilityOptions struct {
+	// The number of replicas that must acknowledge the write.
+	NumReplicas int64
+	// How long to wait for the replicas to acknowledge the write. 0 waits indefinitely.
+	Timeout time.Duration
+}
+
+// NewDurabilityOptions returns [DurabilityOptions] waiting up to `timeout` for `numReplicas` replicas to acknowledge a
+// write.
+func NewDurabilityOptions(numReplicas int64, timeout time.Duration) *DurabilityOptions {
+	return &DurabilityOptions{NumReplicas: numReplicas, Timeout: timeout}
+}
@@ -0,0 +1,58 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+)
+
+// WithExpiryJitter returns `expiry` randomized within `percent` percent, e.g. between 54 and 66 minutes for a 10% jitter
+// on an hour, so that the keys written in bulk with the same time to live do not all expire at the same time. Pass the
+// result to `Expire` or `PExpire`, and use [Expiry.SetJitter] with `SetWithOptions`.
+//
+// `percent` is clamped between 0 and 100. The result is a whole number of seconds if `expiry` is, so that it can be
+// passed to `Expire`, and is never lower than a second, or than a millisecond if `expiry` is not a whole number of
+// seconds.
+func WithExpiryJitter(expiry time.Duration, percent int) time.Duration {
+	percent = min(max(percent, 0), 100)
+	unit := time.Millisecond
+	if isExpiryTypeSeconds(expiry) {
+		unit = time.Second
+	}
+	band := expiry / 100 * time.Duration(percent) / unit
+	if band <= 0 {
+		return expiry
+	}
+	jittered := expiry + time.Duration(rand.Int63n(2*int64(band)+1)-int64(band))*unit
+	return max(jittered, unit)
+}
+
+// SetJitter randomizes a relative expiry within `percent` percent of its duration, see [WithExpiryJitter]. A new duration
+// is drawn every time the options are converted to arguments, so that the keys written in bulk with the same options do
+// not all expire at the same time. Must be between 0 and 100. Ignored for the other expiry types.
+func (ex *Expiry) SetJitter(percent int) *Expiry {
+	ex.JitterPercent = percent
+	return ex
+}
+
+// timeArgs returns the type and the time arguments of an expiry with a duration or a timestamp, with the jitter applied to
+// a duration.
+func (ex *Expiry) timeArgs() ([]string, error) {
+	if ex.JitterPercent == 0 || (ex.Type != constants.Seconds && ex.Type != constants.Milliseconds) {
+		return []string{string(ex.Type), strconv.FormatUint(ex.GetTime(), 10)}, nil
+	}
+	if ex.JitterPercent < 0 || ex.JitterPercent > 100 {
+		return nil, fmt.Errorf("expiry jitter must be between 0 and 100 percent, got %d", ex.JitterPercent)
+	}
+	unit := time.Millisecond
+	if ex.Type == constants.Seconds {
+		unit = time.Second
+	}
+	jittered := WithExpiryJitter(time.Duration(ex.Duration)*unit, ex.JitterPercent)
+	return []string{string(ex.Type), strconv.FormatInt(int64(jittered/unit), 10)}, nil
+}
@@ -0,0 +1,51 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithExpiryJitter(t *testing.T) {
+	for range 100 {
+		expiry := WithExpiryJitter(time.Hour, 10)
+		assert.GreaterOrEqual(t, expiry, 54*time.Minute)
+		assert.LessOrEqual(t, expiry, 66*time.Minute)
+		assert.Zero(t, expiry%time.Second)
+
+		expiry = WithExpiryJitter(1500*time.Millisecond, 100)
+		assert.GreaterOrEqual(t, expiry, time.Millisecond)
+		assert.LessOrEqual(t, expiry, 3*time.Second)
+	}
+	assert.Equal(t, time.Hour, WithExpiryJitter(time.Hour, 0))
+	assert.Equal(t, time.Hour, WithExpiryJitter(time.Hour, -10))
+	assert.Equal(t, 5*time.Second, WithExpiryJitter(5*time.Second, 10))
+}
+
+func TestSetOptions_ExpiryJitter(t *testing.T) {
+	opts := NewSetOptions().SetExpiry(NewExpiryIn(time.Hour).SetJitter(10))
+	for range 100 {
+		args, err := opts.ToArgs()
+		require.NoError(t, err)
+		require.Len(t, args, 2)
+		assert.Equal(t, "EX", args[0])
+		seconds, err := strconv.Atoi(args[1])
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, seconds, 3240)
+		assert.LessOrEqual(t, seconds, 3960)
+	}
+
+	args, err := NewSetOptions().SetExpiry(NewExpiryIn(1500 * time.Millisecond).SetJitter(0)).ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PX", "1500"}, args)
+
+	_, err = NewSetOptions().SetExpiry(NewExpiryIn(time.Hour).SetJitter(101)).ToArgs()
+	assert.Error(t, err)
+	_, err = NewGetExOptions().SetExpiry(NewExpiryIn(time.Hour).SetJitter(-1)).ToArgs()
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "github.com/valkey-io/valkey-glide/go/v2/config"
+
+// DefaultFanOutConcurrency is the default maximum number of nodes a per-node command is sent to concurrently.
+const DefaultFanOutConcurrency = 16
+
+// FanOutOptions are the optional arguments of the per-node helpers of `ClusterClient`, such as `ForEachNode`.
+type FanOutOptions struct {
+	// The nodes to send the command to, [config.AllPrimaries] or [config.AllNodes]. [NewFanOutOptions] defaults to
+	// [config.AllPrimaries].
+	Nodes config.SimpleMultiNodeRoute
+	// The maximum number of nodes the command is sent to concurrently. Defaults to [DefaultFanOutConcurrency]. If not
+	// positive, the command is sent to all nodes at once.
+	Concurrency int
+}
+
+// NewFanOutOptions returns [FanOutOptions] targeting all primaries with the default concurrency.
+func NewFanOutOptions() *FanOutOptions {
+	return &FanOutOptions{Nodes: config.AllPrimaries, Concurrency: DefaultFanOutConcurrency}
+}
+
+// SetNodes sets the nodes to send the command to.
+func (opts *FanOutOptions) SetNodes(nodes config.SimpleMultiNodeRoute) *FanOutOptions {
+	opts.Nodes = nodes
+	return opts
+}
+
+// SetConcurrency sets the maximum number of nodes the command is sent to concurrently.
+func (opts *FanOutOptions) SetConcurrency(concurrency int) *FanOutOptions {
+	opts.Concurrency = concurrency
+	return opts
+}
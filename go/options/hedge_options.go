@@ -0,0 +1,44 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "time"
+
+// DefaultHedgePercentile is the default percentile of the latencies of the hedged reads used as hedging delay.
+const DefaultHedgePercentile = 99.0
+
+// DefaultHedgeDelay is the hedging delay used until the client observed enough hedged reads to compute the percentile of
+// their latencies.
+const DefaultHedgeDelay = 10 * time.Millisecond
+
+// HedgeOptions are the optional arguments of the hedged read commands, such as `GetHedged`.
+//
+// A hedged read is sent like a regular read. If it has not completed after the hedging delay, a duplicate is sent to
+// another replica, and the first successful response is returned while the other request is cancelled. Hedging trades a
+// few duplicate reads for a lower tail latency, when a node is slow because of a long command, a network hiccup or a
+// failover.
+type HedgeOptions struct {
+	// The hedging delay. If not positive, the delay is the `Percentile` of the latencies of the hedged reads recently
+	// observed by the client, or [DefaultHedgeDelay] until enough reads were observed.
+	Delay time.Duration
+	// The percentile of the observed latencies used as hedging delay when `Delay` is not set, between 0 and 100.
+	// [NewHedgeOptions] defaults to [DefaultHedgePercentile].
+	Percentile float64
+}
+
+// NewHedgeOptions returns [HedgeOptions] using the 99th percentile of the observed latencies as hedging delay.
+func NewHedgeOptions() *HedgeOptions {
+	return &HedgeOptions{Percentile: DefaultHedgePercentile}
+}
+
+// SetDelay sets a fixed hedging delay instead of a percentile of the observed latencies.
+func (opts *HedgeOptions) SetDelay(delay time.Duration) *HedgeOptions {
+	opts.Delay = delay
+	return opts
+}
+
+// SetPercentile sets the percentile of the observed latencies used as hedging delay, between 0 and 100.
+func (opts *HedgeOptions) SetPercentile(percentile float64) *HedgeOptions {
+	opts.Percentile = percentile
+	return opts
+}
@@ -0,0 +1,72 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// Optional arguments for `Hello`.
+//
+// The client keeps track of the protocol, credentials and client name set with `HELLO`, and uses them when it reconnects.
+type HelloOptions struct {
+	protocol   *config.ProtocolVersion
+	username   string
+	password   string
+	clientName string
+}
+
+func NewHelloOptions() *HelloOptions {
+	return &HelloOptions{}
+}
+
+// SetProtocol switches the connection to the given protocol. Required when credentials or a client name are set.
+func (opts *HelloOptions) SetProtocol(protocol config.ProtocolVersion) *HelloOptions {
+	opts.protocol = &protocol
+	return opts
+}
+
+// SetAuth authenticates the connection with the given username and password.
+func (opts *HelloOptions) SetAuth(username string, password string) *HelloOptions {
+	opts.username = username
+	opts.password = password
+	return opts
+}
+
+// SetClientName sets the name of the connection, like `CLIENT SETNAME`.
+func (opts *HelloOptions) SetClientName(clientName string) *HelloOptions {
+	opts.clientName = clientName
+	return opts
+}
+
+func (opts *HelloOptions) ToArgs() ([]string, error) {
+	if opts == nil {
+		return []string{}, nil
+	}
+	args := []string{}
+	if opts.protocol != nil {
+		switch *opts.protocol {
+		case config.RESP2:
+			args = append(args, "2")
+		case config.RESP3:
+			args = append(args, "3")
+		default:
+			return nil, errors.New("invalid protocol version")
+		}
+	} else if opts.password != "" || opts.clientName != "" {
+		return nil, errors.New("HELLO requires a protocol version when AUTH or SETNAME is set")
+	}
+	if opts.password != "" {
+		username := opts.username
+		if username == "" {
+			username = "default"
+		}
+		args = append(args, "AUTH", username, opts.password)
+	}
+	if opts.clientName != "" {
+		args = append(args, "SETNAME", opts.clientName)
+	}
+	return args, nil
+}
@@ -0,0 +1,32 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// MGetFailureMode is how `MGetWithOptions` reports the keys it could not get, e.g. the keys of a node that failed.
+type MGetFailureMode int
+
+const (
+	// The call fails with the first error, in the order of the keys, as [MGet] does.
+	//
+	// [MGet]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client.MGet
+	MGetFailFast MGetFailureMode = iota
+	// The values of the keys that could not be got are nil, and their errors are returned along with the values.
+	MGetBestEffort
+)
+
+// MGetOptions are the optional arguments of `MGetWithOptions`.
+type MGetOptions struct {
+	// How the keys that could not be got are reported. Defaults to [MGetFailFast].
+	FailureMode MGetFailureMode
+}
+
+// NewMGetOptions returns [MGetOptions] failing with the first error.
+func NewMGetOptions() *MGetOptions {
+	return &MGetOptions{FailureMode: MGetFailFast}
+}
+
+// SetFailureMode sets how the keys that could not be got are reported.
+func (opts *MGetOptions) SetFailureMode(failureMode MGetFailureMode) *MGetOptions {
+	opts.FailureMode = failureMode
+	return opts
+}
@@ -340,6 +340,46 @@ func (xgsio *XGroupSetIdOptions) ToArgs() ([]string, error) {
 	return args, nil
 }
 
+// Optional arguments for `XSetId` in [StreamCommands]
+type XSetIdOptions struct {
+	EntriesAdded int64
+	MaxDeletedId string
+}
+
+// Create new empty `XSetIdOptions`
+func NewXSetIdOptions() *XSetIdOptions {
+	return &XSetIdOptions{EntriesAdded: -1}
+}
+
+// The total number of entries ever added to the stream. Must be greater than or equal to the current length of the stream.
+//
+// Since Valkey version 7.0.0.
+func (xsio *XSetIdOptions) SetEntriesAdded(entriesAdded int64) *XSetIdOptions {
+	xsio.EntriesAdded = entriesAdded
+	return xsio
+}
+
+// The maximal ID among the entries ever deleted from the stream. Must not be greater than the new last ID of the stream.
+//
+// Since Valkey version 7.0.0.
+func (xsio *XSetIdOptions) SetMaxDeletedId(maxDeletedId string) *XSetIdOptions {
+	xsio.MaxDeletedId = maxDeletedId
+	return xsio
+}
+
+func (xsio *XSetIdOptions) ToArgs() ([]string, error) {
+	var args []string
+
+	if xsio.EntriesAdded > -1 {
+		args = append(args, constants.EntriesAddedKeyword, utils.IntToString(xsio.EntriesAdded))
+	}
+	if xsio.MaxDeletedId != "" {
+		args = append(args, constants.MaxDeletedIdKeyword, xsio.MaxDeletedId)
+	}
+
+	return args, nil
+}
+
 // Optional arguments for `XClaim` in [StreamCommands]
 type XClaimOptions struct {
 	IdleTime     int64
@@ -3399,7 +3399,7 @@ func (b *BaseBatch[T]) ZCard(key string) *T {
 func (b *BaseBatch[T]) BZPopMin(keys []string, timeout time.Duration) *T {
 	return b.addCmdAndConverter(
 		C.BZPopMin,
-		append(keys, utils.FloatToString(timeout.Seconds())),
+		utils.KeysAndTimeout(keys, timeout),
 		reflect.Slice,
 		true,
 		internal.ConvertKeyWithMemberAndScore,
@@ -3983,6 +3983,59 @@ func (b *BaseBatch[T]) XDel(key string, ids []string) *T {
 	return b.addCmdAndTypeChecker(C.XDel, append([]string{key}, ids...), reflect.Int64, false)
 }
 
+// Removes the specified entries by id from a stream, like `XDel`, and returns the outcome for every entry.
+//
+// Since:
+//
+//	Valkey 8.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the stream.
+//	ids - An array of entry ids.
+//
+// Command Response:
+//
+//	The outcome of the deletion of every entry of `ids`, in order: [models.StreamEntryDeleted] if it was deleted, or
+//	[models.StreamEntryNotFound] if it does not exist in the stream.
+//
+// [valkey.io]: https://valkey.io/commands/xdelex/
+func (b *BaseBatch[T]) XDelEx(key string, ids []string) *T {
+	return b.XDelExWithPolicy(key, ids, "")
+}
+
+// Removes the specified entries by id from a stream, and returns the outcome for every entry. The policy defines how the
+// references to the entries in the pending entries lists of the consumer groups are handled.
+//
+// Since:
+//
+//	Valkey 8.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key    - The key of the stream.
+//	ids    - An array of entry ids.
+//	policy - The deletion policy, see [constants.StreamDeletionPolicy].
+//
+// Command Response:
+//
+//	The outcome of the deletion of every entry of `ids`, in order: [models.StreamEntryDeleted] if it was deleted,
+//	[models.StreamEntryNotFound] if it does not exist in the stream, or [models.StreamEntryReferenced] if it was not
+//	deleted as it is still pending in a consumer group, with [constants.AckedPolicy].
+//
+// [valkey.io]: https://valkey.io/commands/xdelex/
+func (b *BaseBatch[T]) XDelExWithPolicy(key string, ids []string, policy constants.StreamDeletionPolicy) *T {
+	args, err := internal.BuildStreamDeletionArgs([]string{key}, ids, policy)
+	if err != nil {
+		return b.addError("XDelExWithPolicy", err)
+	}
+	return b.addCmdAndConverter(C.XDelEx, args, reflect.Slice, false, internal.ConvertStreamEntryDeletionStatuses)
+}
+
 // Returns the score of `member` in the sorted set stored at `key`.
 //
 // See [valkey.io] for details.
@@ -4301,6 +4354,45 @@ func (b *BaseBatch[T]) XGroupSetIdWithOptions(key string, group string, id strin
 	return b.addCmdAndTypeChecker(C.XGroupSetId, args, reflect.String, false)
 }
 
+// Sets the last generated ID of a stream.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the stream.
+//	lastId - The new last generated ID of the stream. Must not be lower than the ID of the last entry of the stream.
+//
+// Command Response:
+//
+//	"OK".
+//
+// [valkey.io]: https://valkey.io/commands/xsetid/
+func (b *BaseBatch[T]) XSetId(key string, lastId string) *T {
+	return b.XSetIdWithOptions(key, lastId, *options.NewXSetIdOptions())
+}
+
+// Sets the last generated ID of a stream with options.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the stream.
+//	lastId - The new last generated ID of the stream. Must not be lower than the ID of the last entry of the stream.
+//	opts - The options for the command. See [options.XSetIdOptions] for details.
+//
+// Command Response:
+//
+//	"OK".
+//
+// [valkey.io]: https://valkey.io/commands/xsetid/
+func (b *BaseBatch[T]) XSetIdWithOptions(key string, lastId string, opts options.XSetIdOptions) *T {
+	optionArgs, _ := opts.ToArgs()
+	args := append([]string{key, lastId}, optionArgs...)
+	return b.addCmdAndTypeChecker(C.XSetId, args, reflect.String, false)
+}
+
 // Removes all elements in the sorted set stored at `key` with a lexicographical order
 // between `rangeQuery.Start` and `rangeQuery.End`.
 //
@@ -4755,6 +4847,68 @@ func (b *BaseBatch[T]) XAck(key string, group string, ids []string) *T {
 	return b.addCmdAndTypeChecker(C.XAck, append([]string{key, group}, ids...), reflect.Int64, false)
 }
 
+// Acknowledges the specified entries in the consumer group of a stream, like `XAck`, deletes them from the stream, and
+// returns the outcome for every entry.
+//
+// Since:
+//
+//	Valkey 8.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key   - The key of the stream.
+//	group - The consumer group name.
+//	ids   - Stream entry IDs to acknowledge and delete.
+//
+// Command Response:
+//
+//	The outcome of every entry of `ids`, in order: [models.StreamEntryDeleted] if it was acknowledged and deleted, or
+//	[models.StreamEntryNotFound] if it is not pending in the consumer group.
+//
+// [valkey.io]: https://valkey.io/commands/xackdel/
+func (b *BaseBatch[T]) XAckDel(key string, group string, ids []string) *T {
+	return b.XAckDelWithPolicy(key, group, ids, "")
+}
+
+// Acknowledges the specified entries in the consumer group of a stream, deletes them from the stream, and returns the
+// outcome for every entry. The policy defines how the references to the entries in the pending entries lists of the
+// other consumer groups are handled.
+//
+// Since:
+//
+//	Valkey 8.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key    - The key of the stream.
+//	group  - The consumer group name.
+//	ids    - Stream entry IDs to acknowledge and delete.
+//	policy - The deletion policy, see [constants.StreamDeletionPolicy].
+//
+// Command Response:
+//
+//	The outcome of every entry of `ids`, in order: [models.StreamEntryDeleted] if it was acknowledged and deleted,
+//	[models.StreamEntryNotFound] if it is not pending in the consumer group, or [models.StreamEntryReferenced] if it was
+//	acknowledged but not deleted as it is still pending in another consumer group, with [constants.AckedPolicy].
+//
+// [valkey.io]: https://valkey.io/commands/xackdel/
+func (b *BaseBatch[T]) XAckDelWithPolicy(
+	key string,
+	group string,
+	ids []string,
+	policy constants.StreamDeletionPolicy,
+) *T {
+	args, err := internal.BuildStreamDeletionArgs([]string{key, group}, ids, policy)
+	if err != nil {
+		return b.addError("XAckDelWithPolicy", err)
+	}
+	return b.addCmdAndConverter(C.XAckDel, args, reflect.Slice, false, internal.ConvertStreamEntryDeletionStatuses)
+}
+
 // Sets or clears the bit at offset in the string value stored at key.
 // The offset is a zero-based index, with `0` being the first element of
 // the list, `1` being the next element, and so on. The offset must be
@@ -5941,8 +6095,9 @@ func (b *BaseBatch[T]) ZLexCount(key string, rangeQuery options.RangeByLex) *T {
 //
 // Parameters:
 //
-//	keys - An array of keys to check for elements.
-//	timeout - The maximum duration to block (`0` blocks indefinitely).
+//	keys - The keys of the sorted sets.
+//	timeout - The duration to wait for a blocking operation to complete. A value of
+//	  `0` will block indefinitely.
 //
 // Command Response:
 //
@@ -5952,8 +6107,13 @@ func (b *BaseBatch[T]) ZLexCount(key string, rangeQuery options.RangeByLex) *T {
 // [valkey.io]: https://valkey.io/commands/bzpopmax/
 // [Blocking Commands]: https://glide.valkey.io/how-to/connection-management/#blocking-commands
 func (b *BaseBatch[T]) BZPopMax(keys []string, timeout time.Duration) *T {
-	args := append(keys, utils.FloatToString(timeout.Seconds()))
-	return b.addCmdAndConverter(C.BZPopMax, args, reflect.Slice, true, internal.ConvertKeyWithMemberAndScore)
+	return b.addCmdAndConverter(
+		C.BZPopMax,
+		utils.KeysAndTimeout(keys, timeout),
+		reflect.Slice,
+		true,
+		internal.ConvertKeyWithMemberAndScore,
+	)
 }
 
 // Adds geospatial members with their positions to the specified sorted set stored at `key`.
@@ -0,0 +1,81 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package pipeline
+
+import "time"
+
+const (
+	// DefaultReadPipelineMaxLag is the default maximum replication lag of the replicas a read pipeline reads from.
+	DefaultReadPipelineMaxLag = time.Second
+	// DefaultReplicationStatusTTL is the default duration a read pipeline reuses the replication status of a shard for.
+	DefaultReplicationStatusTTL = time.Second
+)
+
+// ReadPipelineOptions contains the options of a read pipeline, which executes the batches of read-only commands of a
+// shard on one of its replicas. A replica is only read from if it lags behind its primary less than the thresholds, as
+// reported by the primary in the "replication" section of `INFO`. Otherwise, the batches are executed on the primary.
+type ReadPipelineOptions struct {
+	// The maximum time since a replica last acknowledged the replication stream, in whole seconds. The replicas
+	// acknowledge the stream every second.
+	MaxLag time.Duration
+	// The maximum number of bytes of the replication stream a replica has not acknowledged. Negative to not check it.
+	MaxOffsetLag int64
+	// How long the replication status of a shard is reused before being fetched again.
+	StatusTTL time.Duration
+}
+
+// Create new options for read pipelines, with a maximum lag of [DefaultReadPipelineMaxLag], no maximum offset lag, and
+// the replication status reused for [DefaultReplicationStatusTTL].
+//
+// Returns:
+//
+//	A new ReadPipelineOptions instance.
+func NewReadPipelineOptions() *ReadPipelineOptions {
+	return &ReadPipelineOptions{
+		MaxLag:       DefaultReadPipelineMaxLag,
+		MaxOffsetLag: -1,
+		StatusTTL:    DefaultReplicationStatusTTL,
+	}
+}
+
+// Set the maximum time since a replica last acknowledged the replication stream.
+//
+// Parameters:
+//
+//	maxLag - The maximum lag, in whole seconds.
+//
+// Returns:
+//
+//	The updated ReadPipelineOptions instance.
+func (rpo *ReadPipelineOptions) WithMaxLag(maxLag time.Duration) *ReadPipelineOptions {
+	rpo.MaxLag = maxLag
+	return rpo
+}
+
+// Set the maximum number of bytes of the replication stream a replica has not acknowledged.
+//
+// Parameters:
+//
+//	maxOffsetLag - The maximum offset lag, in bytes. Negative to not check it.
+//
+// Returns:
+//
+//	The updated ReadPipelineOptions instance.
+func (rpo *ReadPipelineOptions) WithMaxOffsetLag(maxOffsetLag int64) *ReadPipelineOptions {
+	rpo.MaxOffsetLag = maxOffsetLag
+	return rpo
+}
+
+// Set how long the replication status of a shard is reused before being fetched again.
+//
+// Parameters:
+//
+//	statusTTL - The duration the status is reused for. Not positive to fetch it for every batch.
+//
+// Returns:
+//
+//	The updated ReadPipelineOptions instance.
+func (rpo *ReadPipelineOptions) WithStatusTTL(statusTTL time.Duration) *ReadPipelineOptions {
+	rpo.StatusTTL = statusTTL
+	return rpo
+}
@@ -0,0 +1,180 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+)
+
+// placeholderPattern matches the placeholders of the parameters of a template, e.g. "{{page}}".
+var placeholderPattern = regexp.MustCompile(`\{\{(\w+)\}\}`)
+
+// Param returns the placeholder of the parameter `name` of a template, e.g. "{{page}}" for "page", to be passed to the
+// commands of the template in place of a key or an argument, alone or within a string, e.g. "views:" + Param("page").
+// `name` is made of letters, digits and underscores.
+func Param(name string) string {
+	return "{{" + name + "}}"
+}
+
+// Template is a named sequence of batch commands whose keys and arguments can be parameters, built once and instantiated
+// with the values of its parameters for every execution, e.g. for the batches sent on the hot paths:
+//
+//	template, err := pipeline.NewStandaloneTemplate("view", false, func(batch *pipeline.StandaloneBatch) {
+//		batch.Get(pipeline.Param("page")).
+//			Touch([]string{pipeline.Param("page")}).
+//			Incr("views:" + pipeline.Param("page"))
+//	})
+//	...
+//	batch, err := template.Instantiate(map[string]string{"page": "home"})
+//	...
+//	result, err := client.Exec(ctx, *batch, true)
+//
+// The commands are built once, with their options and the conversions of their responses, and only their keys and
+// arguments are substituted when the template is instantiated. Only the string keys and arguments can be parameters: the
+// numeric arguments, e.g. the increment of [BaseBatch.IncrBy], are fixed when the template is built.
+//
+// A template is safe for concurrent use, and the batches it instantiates are independent of each other.
+type Template[T StandaloneBatch | ClusterBatch] struct {
+	name     string
+	commands []templateCommand
+	isAtomic bool
+	params   []string
+	newBatch func(batch internal.Batch) *T
+}
+
+// templateCommand is a command of a template, with the indexes of its arguments holding placeholders.
+type templateCommand struct {
+	internal.Cmd
+	parameterized []int
+}
+
+// NewStandaloneTemplate creates a [Template] of standalone batches named `name`, with the commands added by `build`.
+//
+// Parameters:
+//
+//	name - The name of the template, reported in its errors.
+//	isAtomic - Whether the batches of the template are executed as transactions, see [NewStandaloneBatch].
+//	build - Called once to add the commands of the template to a batch, with [Param] in place of the keys and the
+//	  arguments that are parameters.
+//
+// Return value:
+//
+//	The template, or the error of an invalid command of `build`.
+func NewStandaloneTemplate(
+	name string,
+	isAtomic bool,
+	build func(batch *StandaloneBatch),
+) (*Template[StandaloneBatch], error) {
+	batch := NewStandaloneBatch(isAtomic)
+	build(batch)
+	return newTemplate(name, batch.Batch, func(b internal.Batch) *StandaloneBatch {
+		batch := NewStandaloneBatch(b.IsAtomic)
+		batch.Batch = b
+		return batch
+	})
+}
+
+// NewClusterTemplate creates a [Template] of cluster batches named `name`, with the commands added by `build`.
+//
+// Parameters:
+//
+//	name - The name of the template, reported in its errors.
+//	isAtomic - Whether the batches of the template are executed as transactions, see [NewClusterBatch]. The keys of an
+//	  atomic batch must map to the same hash slot, e.g. with a hash tag in their parameter.
+//	build - Called once to add the commands of the template to a batch, with [Param] in place of the keys and the
+//	  arguments that are parameters.
+//
+// Return value:
+//
+//	The template, or the error of an invalid command of `build`.
+func NewClusterTemplate(
+	name string,
+	isAtomic bool,
+	build func(batch *ClusterBatch),
+) (*Template[ClusterBatch], error) {
+	batch := NewClusterBatch(isAtomic)
+	build(batch)
+	return newTemplate(name, batch.Batch, func(b internal.Batch) *ClusterBatch {
+		batch := NewClusterBatch(b.IsAtomic)
+		batch.Batch = b
+		return batch
+	})
+}
+
+func newTemplate[T StandaloneBatch | ClusterBatch](
+	name string,
+	batch internal.Batch,
+	newBatch func(batch internal.Batch) *T,
+) (*Template[T], error) {
+	if len(batch.Errors) > 0 {
+		return nil, fmt.Errorf("invalid command in template %q: %w", name, batch.Errors[0])
+	}
+	template := &Template[T]{name: name, isAtomic: batch.IsAtomic, newBatch: newBatch}
+	for _, cmd := range batch.Commands {
+		command := templateCommand{Cmd: cmd}
+		for i, arg := range cmd.Args {
+			matches := placeholderPattern.FindAllStringSubmatch(arg, -1)
+			if len(matches) == 0 {
+				continue
+			}
+			command.parameterized = append(command.parameterized, i)
+			for _, match := range matches {
+				if !slices.Contains(template.params, match[1]) {
+					template.params = append(template.params, match[1])
+				}
+			}
+		}
+		template.commands = append(template.commands, command)
+	}
+	return template, nil
+}
+
+// Name returns the name of the template.
+func (t *Template[T]) Name() string {
+	return t.name
+}
+
+// Params returns the names of the parameters of the template, in the order of their first use.
+func (t *Template[T]) Params() []string {
+	return slices.Clone(t.params)
+}
+
+// Instantiate returns a new batch with the commands of the template, whose parameters are replaced with `values`. More
+// commands can be added to the batch before it is executed.
+//
+// Parameters:
+//
+//	values - The values of the parameters, by parameter name. Every parameter must have a value.
+//
+// Return value:
+//
+//	The batch, or an error if a parameter has no value.
+func (t *Template[T]) Instantiate(values map[string]string) (*T, error) {
+	replacements := make([]string, 0, 2*len(t.params))
+	for _, param := range t.params {
+		value, ok := values[param]
+		if !ok {
+			return nil, fmt.Errorf("no value for parameter %q of template %q", param, t.name)
+		}
+		replacements = append(replacements, Param(param), value)
+	}
+	replacer := strings.NewReplacer(replacements...)
+
+	batch := internal.Batch{IsAtomic: t.isAtomic, Commands: make([]internal.Cmd, len(t.commands))}
+	for i, command := range t.commands {
+		cmd := command.Cmd
+		if len(command.parameterized) > 0 {
+			cmd.Args = slices.Clone(cmd.Args)
+			for _, index := range command.parameterized {
+				cmd.Args[index] = replacer.Replace(cmd.Args[index])
+			}
+		}
+		batch.Commands[i] = cmd
+	}
+	return t.newBatch(batch), nil
+}
@@ -0,0 +1,182 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// PubSubClient is a client dedicated to Pub/Sub subscriptions. Use [NewPubSubClient] or [NewClusterPubSubClient] to
+// request a client.
+//
+// A [Client] or a [ClusterClient] receives the messages of its subscriptions on the same connections as the responses to
+// its commands, so a burst of messages delays the responses queued behind them. A `PubSubClient` maintains its own
+// connections, created from the same configuration as the client running the commands, so heavy subscription traffic
+// does not add to the latency of the commands. It only exposes the subscription commands, so that no command is sent on
+// its connections by mistake.
+//
+// The messages are received through the callback of the subscription configuration, or through [PubSubClient.GetQueue]
+// if there is no callback. Configure the subscriptions known upfront on the configuration of the `PubSubClient` only,
+// otherwise the client running the commands subscribes too.
+//
+// Example:
+//
+//	cfg := config.NewClientConfiguration().WithAddress(&config.NodeAddress{Host: "localhost", Port: 6379})
+//	client, err := glide.NewClient(cfg)
+//	...
+//	subscriber, err := glide.NewPubSubClient(cfg)
+//	...
+//	err = subscriber.Subscribe(ctx, []string{"events"}, 5000)
+//	queue, err := subscriber.GetQueue()
+//	message := <-queue.WaitForMessage()
+type PubSubClient struct {
+	client *baseClient
+	// Nil unless the client is connected to a cluster.
+	cluster *ClusterClient
+}
+
+// NewPubSubClient creates a [PubSubClient] connected to a standalone server, with its own connections.
+//
+// Parameters:
+//
+//	config - The configuration of the client, typically the same as the one of the [Client] running the commands,
+//	    with the Pub/Sub subscriptions to establish upon connection, if any.
+//
+// Return value:
+//
+//	A connected [PubSubClient].
+func NewPubSubClient(config *config.ClientConfiguration) (*PubSubClient, error) {
+	client, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &PubSubClient{client: &client.baseClient}, nil
+}
+
+// NewClusterPubSubClient creates a [PubSubClient] connected to a cluster, with its own connections. Unlike a client
+// created by [NewPubSubClient], it supports sharded channels.
+//
+// Parameters:
+//
+//	config - The configuration of the client, typically the same as the one of the [ClusterClient] running the commands,
+//	    with the Pub/Sub subscriptions to establish upon connection, if any.
+//
+// Return value:
+//
+//	A connected [PubSubClient].
+func NewClusterPubSubClient(config *config.ClusterClientConfiguration) (*PubSubClient, error) {
+	client, err := NewClusterClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &PubSubClient{client: &client.baseClient, cluster: client}, nil
+}
+
+// Close terminates the client and its connections, dropping its subscriptions.
+func (client *PubSubClient) Close() {
+	client.client.Close()
+}
+
+// GetQueue returns the queue of the received messages. Returns an error if the client is configured with a callback.
+func (client *PubSubClient) GetQueue() (*PubSubMessageQueue, error) {
+	return client.client.GetQueue()
+}
+
+// Subscribe subscribes the client to `channels`, and waits for the server confirmation, see [Client.Subscribe].
+func (client *PubSubClient) Subscribe(ctx context.Context, channels []string, timeoutMs int) error {
+	return client.client.Subscribe(ctx, channels, timeoutMs)
+}
+
+// SubscribeLazy subscribes the client to `channels` in the background, see [Client.SubscribeLazy].
+func (client *PubSubClient) SubscribeLazy(ctx context.Context, channels []string) error {
+	return client.client.SubscribeLazy(ctx, channels)
+}
+
+// PSubscribe subscribes the client to `patterns`, and waits for the server confirmation, see [Client.PSubscribe].
+func (client *PubSubClient) PSubscribe(ctx context.Context, patterns []string, timeoutMs int) error {
+	return client.client.PSubscribe(ctx, patterns, timeoutMs)
+}
+
+// PSubscribeLazy subscribes the client to `patterns` in the background, see [Client.PSubscribeLazy].
+func (client *PubSubClient) PSubscribeLazy(ctx context.Context, patterns []string) error {
+	return client.client.PSubscribeLazy(ctx, patterns)
+}
+
+// SSubscribe subscribes the client to the sharded `channels`, and waits for the server confirmation, see
+// [ClusterClient.SSubscribe]. Returns an error if the client is not connected to a cluster.
+func (client *PubSubClient) SSubscribe(ctx context.Context, channels []string, timeoutMs int) error {
+	if client.cluster == nil {
+		return errShardedPubSubRequiresCluster()
+	}
+	return client.cluster.SSubscribe(ctx, channels, timeoutMs)
+}
+
+// SSubscribeLazy subscribes the client to the sharded `channels` in the background, see [ClusterClient.SSubscribeLazy].
+// Returns an error if the client is not connected to a cluster.
+func (client *PubSubClient) SSubscribeLazy(ctx context.Context, channels []string) error {
+	if client.cluster == nil {
+		return errShardedPubSubRequiresCluster()
+	}
+	return client.cluster.SSubscribeLazy(ctx, channels)
+}
+
+// Unsubscribe unsubscribes the client from `channels`, or from all channels if nil, and waits for the server
+// confirmation, see [Client.Unsubscribe].
+func (client *PubSubClient) Unsubscribe(ctx context.Context, channels []string, timeoutMs int) error {
+	return client.client.Unsubscribe(ctx, channels, timeoutMs)
+}
+
+// UnsubscribeLazy unsubscribes the client from `channels`, or from all channels if nil, in the background, see
+// [Client.UnsubscribeLazy].
+func (client *PubSubClient) UnsubscribeLazy(ctx context.Context, channels []string) error {
+	return client.client.UnsubscribeLazy(ctx, channels)
+}
+
+// PUnsubscribe unsubscribes the client from `patterns`, or from all patterns if nil, and waits for the server
+// confirmation, see [Client.PUnsubscribe].
+func (client *PubSubClient) PUnsubscribe(ctx context.Context, patterns []string, timeoutMs int) error {
+	return client.client.PUnsubscribe(ctx, patterns, timeoutMs)
+}
+
+// PUnsubscribeLazy unsubscribes the client from `patterns`, or from all patterns if nil, in the background, see
+// [Client.PUnsubscribeLazy].
+func (client *PubSubClient) PUnsubscribeLazy(ctx context.Context, patterns []string) error {
+	return client.client.PUnsubscribeLazy(ctx, patterns)
+}
+
+// SUnsubscribe unsubscribes the client from the sharded `channels`, or from all sharded channels if nil, and waits for
+// the server confirmation, see [ClusterClient.SUnsubscribe]. Returns an error if the client is not connected to a
+// cluster.
+func (client *PubSubClient) SUnsubscribe(ctx context.Context, channels []string, timeoutMs int) error {
+	if client.cluster == nil {
+		return errShardedPubSubRequiresCluster()
+	}
+	return client.cluster.SUnsubscribe(ctx, channels, timeoutMs)
+}
+
+// SUnsubscribeLazy unsubscribes the client from the sharded `channels`, or from all sharded channels if nil, in the
+// background, see [ClusterClient.SUnsubscribeLazy]. Returns an error if the client is not connected to a cluster.
+func (client *PubSubClient) SUnsubscribeLazy(ctx context.Context, channels []string) error {
+	if client.cluster == nil {
+		return errShardedPubSubRequiresCluster()
+	}
+	return client.cluster.SUnsubscribeLazy(ctx, channels)
+}
+
+// GetSubscriptions returns the desired and the actual subscriptions of the client, see [Client.GetSubscriptions].
+func (client *PubSubClient) GetSubscriptions(ctx context.Context) (*models.PubSubState, error) {
+	return client.client.GetSubscriptions(ctx)
+}
+
+// WatchChanges watches `keys` for changes, and returns a channel receiving a key every time it changes, see
+// [Client.WatchChanges].
+func (client *PubSubClient) WatchChanges(ctx context.Context, keys ...string) (<-chan string, error) {
+	return client.client.WatchChanges(ctx, keys...)
+}
+
+func errShardedPubSubRequiresCluster() error {
+	return NewConfigurationError("sharded channels are only supported by a PubSubClient connected to a cluster")
+}
@@ -81,27 +81,89 @@ type PubSubMessageQueue struct {
 	waiters                 []chan *models.PubSubMessage
 	nextMessageReadyCh      chan struct{}
 	nextMessageReadySignals []chan struct{}
+	// The maximum number of queued messages, or zero if the queue is not bounded, see
+	// [config.ClientConfiguration.WithPubSubBackpressure].
+	capacity int
+	policy   config.PubSubBackpressurePolicy
+	// Signaled when a message is removed from the queue, or when the queue is released.
+	notFull *sync.Cond
+	// Set once the client is closed, after which the pushes are no longer blocked.
+	released bool
+	dropped  uint64
 }
 
 func NewPubSubMessageQueue() *PubSubMessageQueue {
-	return &PubSubMessageQueue{
+	queue := &PubSubMessageQueue{
 		messages:                make([]*models.PubSubMessage, 0),
 		waiters:                 make([]chan *models.PubSubMessage, 0),
 		nextMessageReadyCh:      make(chan struct{}, 1),
 		nextMessageReadySignals: make([]chan struct{}, 0),
 	}
+	queue.notFull = sync.NewCond(&queue.mu)
+	return queue
+}
+
+// setBackpressure bounds the queue to `capacity` messages, handling the messages pushed while it is full with `policy`.
+// A `capacity` of zero or less leaves the queue unbounded.
+func (queue *PubSubMessageQueue) setBackpressure(capacity int, policy config.PubSubBackpressurePolicy) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	queue.capacity = max(capacity, 0)
+	queue.policy = policy
+}
+
+// isBounded returns whether the number of queued messages is bounded.
+func (queue *PubSubMessageQueue) isBounded() bool {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.capacity > 0
+}
+
+// release stops blocking the pushes to the full queue, which drop their messages from then on, so that the client can be
+// closed.
+func (queue *PubSubMessageQueue) release() {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	queue.released = true
+	queue.notFull.Broadcast()
+}
+
+// DroppedCount returns the number of messages dropped because the queue was full.
+func (queue *PubSubMessageQueue) DroppedCount() uint64 {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.dropped
 }
 
 func (queue *PubSubMessageQueue) Push(message *models.PubSubMessage) {
 	queue.mu.Lock()
 	defer queue.mu.Unlock()
 
-	// If there's a waiter, deliver the message directly
-	if len(queue.waiters) > 0 {
-		waiterCh := queue.waiters[0]
-		queue.waiters = queue.waiters[1:]
-		waiterCh <- message
-		return
+	for {
+		// If there's a waiter, deliver the message directly
+		if len(queue.waiters) > 0 {
+			waiterCh := queue.waiters[0]
+			queue.waiters = queue.waiters[1:]
+			waiterCh <- message
+			return
+		}
+		if queue.capacity == 0 || len(queue.messages) < queue.capacity {
+			break
+		}
+		// The queue is full, wait for room unless the messages are dropped
+		if queue.policy != config.BlockPublisherEvents || queue.released {
+			break
+		}
+		queue.notFull.Wait()
+	}
+	if queue.capacity > 0 && len(queue.messages) >= queue.capacity {
+		// The pushes of a released queue drop their messages like DropNewest
+		queue.dropped++
+		if queue.policy != config.DropOldest {
+			return
+		}
+		queue.messages[0] = nil
+		queue.messages = queue.messages[1:]
 	}
 
 	// Otherwise, add to the queue
@@ -134,6 +196,7 @@ func (queue *PubSubMessageQueue) Pop() *models.PubSubMessage {
 
 	message := queue.messages[0]
 	queue.messages = queue.messages[1:]
+	queue.notFull.Signal()
 	return message
 }
 
@@ -146,6 +209,7 @@ func (queue *PubSubMessageQueue) WaitForMessage() <-chan *models.PubSubMessage {
 		messageCh := make(chan *models.PubSubMessage, 1)
 		message := queue.messages[0]
 		queue.messages = queue.messages[1:]
+		queue.notFull.Signal()
 		messageCh <- message
 		return messageCh
 	}
@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func pushMessages(queue *PubSubMessageQueue, messages ...string) {
+	for _, message := range messages {
+		queue.Push(models.NewPubSubMessage(message, "channel"))
+	}
+}
+
+func popMessages(queue *PubSubMessageQueue) []string {
+	var messages []string
+	for message := queue.Pop(); message != nil; message = queue.Pop() {
+		messages = append(messages, message.Message)
+	}
+	return messages
+}
+
+func TestPubSubMessageQueue_Unbounded(t *testing.T) {
+	queue := NewPubSubMessageQueue()
+	pushMessages(queue, "1", "2", "3")
+	assert.Equal(t, []string{"1", "2", "3"}, popMessages(queue))
+	assert.Zero(t, queue.DroppedCount())
+}
+
+func TestPubSubMessageQueue_DropOldest(t *testing.T) {
+	queue := NewPubSubMessageQueue()
+	queue.setBackpressure(2, config.DropOldest)
+	pushMessages(queue, "1", "2", "3", "4")
+	assert.Equal(t, []string{"3", "4"}, popMessages(queue))
+	assert.Equal(t, uint64(2), queue.DroppedCount())
+}
+
+func TestPubSubMessageQueue_DropNewest(t *testing.T) {
+	queue := NewPubSubMessageQueue()
+	queue.setBackpressure(2, config.DropNewest)
+	pushMessages(queue, "1", "2", "3", "4")
+	assert.Equal(t, []string{"1", "2"}, popMessages(queue))
+	assert.Equal(t, uint64(2), queue.DroppedCount())
+
+	// The messages delivered to a waiter are not queued
+	waiter := queue.WaitForMessage()
+	pushMessages(queue, "5")
+	assert.Equal(t, "5", (<-waiter).Message)
+	assert.Equal(t, uint64(2), queue.DroppedCount())
+}
+
+func TestPubSubMessageQueue_BlockPublisherEvents(t *testing.T) {
+	queue := NewPubSubMessageQueue()
+	queue.setBackpressure(1, config.BlockPublisherEvents)
+	pushMessages(queue, "1")
+
+	pushed := make(chan struct{})
+	go func() {
+		pushMessages(queue, "2")
+		close(pushed)
+	}()
+	select {
+	case <-pushed:
+		assert.Fail(t, "the push to the full queue did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, "1", queue.Pop().Message)
+	<-pushed
+	assert.Equal(t, []string{"2"}, popMessages(queue))
+
+	// Once released, the pushes to the full queue drop their messages
+	pushMessages(queue, "3")
+	queue.release()
+	pushMessages(queue, "4")
+	assert.Equal(t, []string{"3"}, popMessages(queue))
+	assert.Equal(t, uint64(1), queue.DroppedCount())
+}
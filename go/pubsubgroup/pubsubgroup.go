@@ -0,0 +1,332 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package pubsubgroup emulates consumer groups over Pub/Sub: every message published to a group is handled by exactly
+// one of its members, while the members are woken up by the Pub/Sub messages instead of polling.
+package pubsubgroup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+const (
+	// DefaultMaxLen is the default approximate number of messages kept in the ledger of a channel.
+	DefaultMaxLen = 10000
+	// DefaultClaimTimeout is the default time after which a message claimed by a member and not acknowledged is handed
+	// out to another member.
+	DefaultClaimTimeout = 30 * time.Second
+	// DefaultPollInterval is the default interval at which a member looks for messages when it is not notified of any.
+	DefaultPollInterval = time.Second
+)
+
+// claimCount is the maximum number of messages claimed by a single [Group.Claim] from [Member.Run].
+const claimCount = 100
+
+// payloadField is the field of the ledger entries holding the payload of the messages.
+const payloadField = "payload"
+
+// publishScript appends the message ARGV[2] to the ledger KEYS[1], trimmed to about ARGV[3] entries, and publishes its ID
+// to the channel ARGV[1].
+var publishScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+local id = redis.call('XADD', KEYS[1], 'MAXLEN', '~', ARGV[3], '*', 'payload', ARGV[2])
+redis.call('PUBLISH', ARGV[1], id)
+return id
+`)
+})
+
+// Message is a message published to a group.
+type Message struct {
+	// The ID of the message in the ledger of the channel.
+	ID string
+	// The payload of the message.
+	Payload string
+}
+
+// Group is a group of members sharing the messages published to a channel, each message being handled by a single
+// member.
+//
+// The messages are appended to a ledger, a stream read by the group as a stream consumer group, and their IDs are
+// published to the channel. A member subscribed to the channel is notified of every message, and claims the pending
+// messages from the ledger: the stream consumer group hands out every message to a single member. A message claimed by a
+// member that fails before acknowledging it is handed out to another member after the claim timeout. Since Pub/Sub does
+// not guarantee the delivery of the notifications, the members also look for messages at the poll interval.
+//
+// Several groups can share a channel, each of them handling every message once.
+//
+// In cluster mode, the ledger is in the slot of the channel, see [LedgerKey].
+//
+// Example:
+//
+//	group := pubsubgroup.New(client, "orders", "billing")
+//	member := group.Member("worker-1")
+//	// Notify the member of the messages of the channel, e.g. from the callback of a Pub/Sub subscription
+//	callback := func(message *models.PubSubMessage, ctx any) { member.Notify() }
+//	...
+//	go member.Run(ctx, func(ctx context.Context, message pubsubgroup.Message) error {
+//		return bill(message.Payload)
+//	})
+//	...
+//	_, err := group.Publish(ctx, `{"order":"42"}`)
+type Group struct {
+	client       interfaces.BaseClientCommands
+	channel      string
+	name         string
+	maxLen       int64
+	claimTimeout time.Duration
+	pollInterval time.Duration
+}
+
+// New creates a [Group].
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	channel - The channel the messages are published to.
+//	name - The name of the group.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands, channel string, name string) *Group {
+	return &Group{
+		client:       client,
+		channel:      channel,
+		name:         name,
+		maxLen:       DefaultMaxLen,
+		claimTimeout: DefaultClaimTimeout,
+		pollInterval: DefaultPollInterval,
+	}
+}
+
+// WithMaxLen sets the approximate number of messages kept in the ledger, [DefaultMaxLen] by default. The oldest messages
+// are trimmed first, even if they were not handled yet, so the ledger must be long enough to hold the messages published
+// while the members are busy or down.
+func (g *Group) WithMaxLen(maxLen int64) *Group {
+	g.maxLen = maxLen
+	return g
+}
+
+// WithClaimTimeout sets the time after which a message claimed by a member and not acknowledged is handed out to another
+// member, [DefaultClaimTimeout] by default. It must be longer than the time it takes to handle a message.
+func (g *Group) WithClaimTimeout(claimTimeout time.Duration) *Group {
+	g.claimTimeout = claimTimeout
+	return g
+}
+
+// WithPollInterval sets the interval at which the members look for messages when they are not notified of any,
+// [DefaultPollInterval] by default.
+func (g *Group) WithPollInterval(pollInterval time.Duration) *Group {
+	g.pollInterval = pollInterval
+	return g
+}
+
+// Channel returns the channel the messages of the group are published to, to which the members subscribe.
+func (g *Group) Channel() string {
+	return g.channel
+}
+
+// LedgerKey returns the key of the ledger of `channel`, in the slot of `channel`, e.g. "{orders}:ledger" for "orders".
+func LedgerKey(channel string) string {
+	return utils.SameSlotKey(channel, ":ledger")
+}
+
+// Publish appends `payload` to the ledger of the channel, and notifies the subscribers of the channel.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The payload of the message.
+//
+// Return value:
+//
+//	The ID of the message.
+func (g *Group) Publish(ctx context.Context, payload string) (string, error) {
+	result, err := g.client.InvokeScriptWithOptions(
+		ctx,
+		*publishScript(),
+		*options.NewScriptOptions().
+			WithKeys([]string{LedgerKey(g.channel)}).
+			WithArgs([]string{g.channel, payload, strconv.FormatInt(g.maxLen, 10)}),
+	)
+	if err != nil {
+		return "", err
+	}
+	id, ok := result.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected response: %v", result)
+	}
+	return id, nil
+}
+
+// Register creates the group on the ledger of the channel, if it does not exist yet. The group handles the messages
+// published from its creation on. [Member.Run] registers the group, so calling Register is only needed to start
+// collecting the messages before the members run.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An error if the group could not be created.
+func (g *Group) Register(ctx context.Context) error {
+	_, err := g.client.XGroupCreateWithOptions(
+		ctx,
+		LedgerKey(g.channel),
+		g.name,
+		"$",
+		*options.NewXGroupCreateOptions().SetMakeStream(),
+	)
+	if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	return nil
+}
+
+// Claim hands out up to `count` messages to `member`: first the messages claimed by other members and not acknowledged
+// within the claim timeout, then the messages never handed out, in the order they were published. The messages must be
+// acknowledged with [Group.Ack] once handled.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The name of the member.
+//	count - The maximum number of messages to hand out.
+//
+// Return value:
+//
+//	The messages handed out to `member`, or an empty slice if there is none.
+func (g *Group) Claim(ctx context.Context, member string, count int64) ([]Message, error) {
+	key := LedgerKey(g.channel)
+	stale, err := g.client.XAutoClaimWithOptions(
+		ctx,
+		key,
+		g.name,
+		member,
+		g.claimTimeout,
+		"0-0",
+		*options.NewXAutoClaimOptions().SetCount(count),
+	)
+	if err != nil {
+		return nil, err
+	}
+	messages := toMessages(stale.ClaimedEntries)
+	if int64(len(messages)) >= count {
+		return messages, nil
+	}
+
+	streams, err := g.client.XReadGroupWithOptions(
+		ctx,
+		g.name,
+		member,
+		map[string]string{key: ">"},
+		*options.NewXReadGroupOptions().SetCount(count - int64(len(messages))),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return append(messages, toMessages(streams[key].Entries)...), nil
+}
+
+// Ack acknowledges the messages handled by a member, so that they are not handed out again.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	ids - The IDs of the messages.
+//
+// Return value:
+//
+//	The number of messages acknowledged.
+func (g *Group) Ack(ctx context.Context, ids ...string) (int64, error) {
+	return g.client.XAck(ctx, LedgerKey(g.channel), g.name, ids)
+}
+
+func toMessages(entries []models.StreamEntry) []Message {
+	messages := make([]Message, 0, len(entries))
+	for _, entry := range entries {
+		message := Message{ID: entry.ID}
+		for _, field := range entry.Fields {
+			if field.Field == payloadField {
+				message.Payload = field.Value
+			}
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// Member is a member of a [Group], handling the messages handed out to it.
+type Member struct {
+	group    *Group
+	name     string
+	notified chan struct{}
+}
+
+// Member returns the member of the group named `name`. The names must be unique within the group.
+func (g *Group) Member(name string) *Member {
+	return &Member{group: g, name: name, notified: make(chan struct{}, 1)}
+}
+
+// Notify wakes up [Member.Run] to claim the pending messages. Call it for every Pub/Sub message received on the channel
+// of the group, e.g. from the callback of the subscription. It never blocks.
+func (m *Member) Notify() {
+	select {
+	case m.notified <- struct{}{}:
+	default:
+		// A notification is already pending
+	}
+}
+
+// Run registers the group, and handles the messages handed out to the member with `handler` until `ctx` is done. A
+// message is acknowledged once `handler` returns nil; if it returns an error, the message is handed out again after the
+// claim timeout, to this member or to another one.
+//
+// Parameters:
+//
+//	ctx - The context controlling the member; Run returns once it is done.
+//	handler - The function handling the messages.
+//
+// Return value:
+//
+//	The error of `ctx`, or the error of the commands claiming the messages.
+func (m *Member) Run(ctx context.Context, handler func(ctx context.Context, message Message) error) error {
+	if err := m.group.Register(ctx); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(m.group.pollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			messages, err := m.group.Claim(ctx, m.name, claimCount)
+			if err != nil {
+				return err
+			}
+			for _, message := range messages {
+				if handler(ctx, message) == nil {
+					if _, err := m.group.Ack(ctx, message.ID); err != nil {
+						return err
+					}
+				}
+			}
+			if len(messages) < claimCount {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.notified:
+		case <-ticker.C:
+		}
+	}
+}
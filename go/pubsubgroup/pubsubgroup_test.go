@@ -0,0 +1,128 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package pubsubgroup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// fakeClient runs the publish script natively, and records the channels it publishes to.
+type fakeClient struct {
+	*fakeclient.Client
+	published []string
+}
+
+func newFakeClient(clk clock.Clock) *fakeClient {
+	f := &fakeClient{Client: fakeclient.New().WithClock(clk)}
+	f.WithScript(func(keys []string, args []string) (any, error) {
+		id, err := f.XAdd(context.Background(), keys[0], []models.FieldValue{{Field: payloadField, Value: args[1]}})
+		if err != nil {
+			return nil, err
+		}
+		f.published = append(f.published, args[0])
+		return id, nil
+	})
+	return f
+}
+
+func TestLedgerKey(t *testing.T) {
+	assert.Equal(t, "{orders}:ledger", LedgerKey("orders"))
+	assert.Equal(t, "{tenant:1}:orders:ledger", LedgerKey("{tenant:1}:orders"))
+}
+
+func TestGroup_ClaimAndAck(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	client := newFakeClient(fake)
+	group := New(client, "orders", "billing").WithClaimTimeout(time.Minute)
+	require.NoError(t, group.Register(ctx))
+	require.NoError(t, group.Register(ctx))
+
+	for i := 1; i <= 3; i++ {
+		_, err := group.Publish(ctx, fmt.Sprintf("order:%d", i))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"orders", "orders", "orders"}, client.published)
+
+	// Every message is handed out to a single member
+	messages, err := group.Claim(ctx, "worker-1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []Message{
+		{ID: "1700000000000-0", Payload: "order:1"},
+		{ID: "1700000000000-1", Payload: "order:2"},
+	}, messages)
+	messages, err = group.Claim(ctx, "worker-2", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []Message{{ID: "1700000000000-2", Payload: "order:3"}}, messages)
+	messages, err = group.Claim(ctx, "worker-2", 10)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+
+	acked, err := group.Ack(ctx, "1700000000000-0", "1700000000000-2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), acked)
+
+	// The message not acknowledged within the claim timeout is handed out to another member
+	fake.Advance(time.Minute)
+	messages, err = group.Claim(ctx, "worker-2", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []Message{{ID: "1700000000000-1", Payload: "order:2"}}, messages)
+	pending := client.Streams[LedgerKey("orders")].Groups["billing"].Pending
+	assert.Equal(t, "worker-2", pending["1700000000000-1"].Consumer)
+}
+
+func TestMember_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newFakeClient(clock.System())
+	group := New(client, "orders", "billing").WithPollInterval(time.Hour)
+
+	var mu sync.Mutex
+	handled := map[string][]string{}
+	var wg sync.WaitGroup
+	members := []*Member{group.Member("worker-1"), group.Member("worker-2")}
+	require.NoError(t, group.Register(ctx))
+	for _, member := range members {
+		wg.Add(1)
+		go func(member *Member) {
+			defer wg.Done()
+			err := member.Run(ctx, func(ctx context.Context, message Message) error {
+				mu.Lock()
+				defer mu.Unlock()
+				handled[message.Payload] = append(handled[message.Payload], member.name)
+				return nil
+			})
+			assert.ErrorIs(t, err, context.Canceled)
+		}(member)
+	}
+
+	for i := 1; i <= 20; i++ {
+		_, err := group.Publish(ctx, fmt.Sprintf("order:%d", i))
+		require.NoError(t, err)
+		for _, member := range members {
+			member.Notify()
+		}
+	}
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(handled) == 20
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	for payload, handlers := range handled {
+		assert.Len(t, handlers, 1, payload)
+	}
+	assert.Empty(t, client.Streams[LedgerKey("orders")].Groups["billing"].Pending)
+}
@@ -0,0 +1,247 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+const (
+	// randomKeySampleAttempts is the number of `RANDOMKEY` sent per key of a sample at most, which bounds the attempts on
+	// the databases with barely more keys than the sample, where `RANDOMKEY` keeps picking the keys already sampled.
+	randomKeySampleAttempts = 4
+	// randomKeyBatchSize is the number of `RANDOMKEY` sent in a pipeline at most.
+	randomKeyBatchSize = 1000
+)
+
+// RandomKeySample returns up to `n` distinct random keys from the currently selected database, sending `RANDOMKEY` in
+// non-atomic pipelines, e.g. to check the quality of the data without scanning the whole keyspace. Unlike `SCAN`, the
+// sample is not biased towards the keys at the beginning of the keyspace.
+//
+// The sample has fewer than `n` keys if the database has fewer keys, or if `RANDOMKEY` kept picking the keys already
+// sampled, which is likely when the database has barely more keys than `n`: at most `4 * n` keys are picked.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	n - The number of keys to sample.
+//
+// Return value:
+//
+//	The distinct keys sampled, in the order they were picked.
+//
+// [valkey.io]: https://valkey.io/commands/randomkey/
+func (client *Client) RandomKeySample(ctx context.Context, n int) ([]string, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("the number of keys to sample must not be negative, got %d", n)
+	}
+	return client.sampleRandomKeys(ctx, n, nil)
+}
+
+// RandomKeySample returns up to `n` distinct random keys from the nodes of `routeOption`, sending `RANDOMKEY` in
+// non-atomic pipelines, e.g. to check the quality of the data without scanning the whole keyspace. Unlike `SCAN`, the
+// sample is not biased towards the keys at the beginning of the keyspace.
+//
+// With a multi-node route, the number of keys sampled from every node is proportional to its number of keys, as reported
+// by `DBSIZE`, so that every key of the cluster is as likely to be sampled. The nodes are sampled concurrently, at most
+// [options.DefaultFanOutConcurrency] at a time; a node that fails does not fail the others, see
+// [ClusterClient.ForEachNode].
+//
+// The sample has fewer than `n` keys if the nodes have fewer keys, or if `RANDOMKEY` kept picking the keys already
+// sampled, which is likely when a node has barely more keys than its share of `n`: at most 4 times its share of keys are
+// picked from a node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	n - The number of keys to sample.
+//	routeOption - The nodes to sample the keys from: a single node, [config.AllPrimaries], or [config.AllNodes], whose
+//	  replicas hold the same keys as their primaries. If not set, the keys are sampled from all the primaries.
+//
+// Return value:
+//
+//	The distinct keys sampled. If some nodes could not be sampled, their errors are returned in a [NodeErrors] together
+//	with the keys sampled from the other nodes.
+//
+// [valkey.io]: https://valkey.io/commands/randomkey/
+func (client *ClusterClient) RandomKeySample(
+	ctx context.Context,
+	n int,
+	routeOption options.RouteOption,
+) ([]string, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("the number of keys to sample must not be negative, got %d", n)
+	}
+	route := routeOption.Route
+	if route == nil {
+		route = config.AllPrimaries
+	}
+	if !route.IsMultiNode() {
+		return client.sampleRandomKeys(ctx, n, route)
+	}
+	nodes, ok := route.(config.SimpleMultiNodeRoute)
+	if !ok {
+		return nil, errors.New("the keys can only be sampled from a single node, all the primaries, or all the nodes")
+	}
+
+	sizes, err := fanOut(
+		ctx,
+		client,
+		options.FanOutOptions{Nodes: nodes, Concurrency: options.DefaultFanOutConcurrency},
+		func(ctx context.Context, _ string, route config.Route) (int64, error) {
+			return client.DBSizeWithOptions(ctx, options.RouteOption{Route: route})
+		},
+	)
+	var nodeErrs *NodeErrors
+	if err != nil && !errors.As(err, &nodeErrs) {
+		return nil, err
+	}
+	quotas := randomKeyQuotas(sizes, n)
+	addresses := make([]string, 0, len(quotas))
+	for address := range quotas {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	samples, err := fanOutTo(
+		ctx,
+		addresses,
+		options.DefaultFanOutConcurrency,
+		func(ctx context.Context, address string, route config.Route) ([]string, error) {
+			return client.sampleRandomKeys(ctx, quotas[address], route)
+		},
+	)
+	var sampleErrs *NodeErrors
+	if err != nil && !errors.As(err, &sampleErrs) {
+		return nil, err
+	}
+
+	// The replicas sampled with AllNodes can return the keys of their primaries
+	keys := []string{}
+	seen := map[string]struct{}{}
+	for _, address := range addresses {
+		for _, key := range samples[address] {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+	failed := map[string]error{}
+	for _, errs := range []*NodeErrors{nodeErrs, sampleErrs} {
+		if errs != nil {
+			for address, err := range errs.Errors {
+				failed[address] = err
+			}
+		}
+	}
+	if len(failed) > 0 {
+		return keys, NewNodeErrors(failed)
+	}
+	return keys, nil
+}
+
+// sampleRandomKeys returns up to `n` distinct random keys from the node of `route`, or from the node the commands are
+// routed to by default if `route` is nil.
+func (client *baseClient) sampleRandomKeys(ctx context.Context, n int, route config.Route) ([]string, error) {
+	var batchOptions *internal.BatchOptions
+	if route != nil {
+		batchOptions = &internal.BatchOptions{Route: route}
+	}
+	keys := []string{}
+	seen := map[string]struct{}{}
+	for attempts := 0; len(keys) < n && attempts < randomKeySampleAttempts*n; {
+		size := min(n-len(keys), randomKeyBatchSize)
+		var batch internal.Batch
+		if client.clusterMode {
+			b := pipeline.NewClusterBatch(false)
+			addRandomKeyCommands(&b.BaseBatch, size)
+			batch = b.Batch
+		} else {
+			b := pipeline.NewStandaloneBatch(false)
+			addRandomKeyCommands(&b.BaseBatch, size)
+			batch = b.Batch
+		}
+		results, err := client.executeBatch(ctx, batch, true, batchOptions)
+		if err != nil {
+			return nil, err
+		}
+		attempts += size
+		for _, result := range results {
+			if result == nil {
+				// The database is empty
+				return keys, nil
+			}
+			key, ok := result.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected RANDOMKEY response type: %T", result)
+			}
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+func addRandomKeyCommands[T pipeline.StandaloneBatch | pipeline.ClusterBatch](batch *pipeline.BaseBatch[T], count int) {
+	for i := 0; i < count; i++ {
+		batch.RandomKey()
+	}
+}
+
+// randomKeyQuotas splits the `n` keys of a sample between the nodes, proportionally to their number of keys in `sizes`,
+// by node address. The nodes without a share of the sample are omitted.
+func randomKeyQuotas(sizes map[string]int64, n int) map[string]int {
+	var total int64
+	addresses := make([]string, 0, len(sizes))
+	for address, size := range sizes {
+		total += size
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	quotas := map[string]int{}
+	if total <= int64(n) {
+		// Every key is sampled
+		for _, address := range addresses {
+			if sizes[address] > 0 {
+				quotas[address] = int(sizes[address])
+			}
+		}
+		return quotas
+	}
+
+	// The largest remainder method, so that the quotas add up to n
+	remainders := make(map[string]int64, len(addresses))
+	left := n
+	for _, address := range addresses {
+		share := int64(n) * sizes[address]
+		quotas[address] = int(share / total)
+		remainders[address] = share % total
+		left -= quotas[address]
+	}
+	sort.SliceStable(addresses, func(i, j int) bool {
+		return remainders[addresses[i]] > remainders[addresses[j]]
+	})
+	for _, address := range addresses[:left] {
+		quotas[address]++
+	}
+	for address, quota := range quotas {
+		if quota == 0 {
+			delete(quotas, address)
+		}
+	}
+	return quotas
+}
@@ -0,0 +1,26 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomKeyQuotas(t *testing.T) {
+	// The quotas are proportional to the number of keys, and add up to the size of the sample
+	quotas := randomKeyQuotas(map[string]int64{"a:7000": 500, "b:7001": 300, "c:7002": 200}, 10)
+	assert.Equal(t, map[string]int{"a:7000": 5, "b:7001": 3, "c:7002": 2}, quotas)
+	quotas = randomKeyQuotas(map[string]int64{"a:7000": 100, "b:7001": 100, "c:7002": 100}, 10)
+	assert.Equal(t, map[string]int{"a:7000": 4, "b:7001": 3, "c:7002": 3}, quotas)
+
+	// The nodes without a share are omitted
+	quotas = randomKeyQuotas(map[string]int64{"a:7000": 1000, "b:7001": 1, "c:7002": 0}, 10)
+	assert.Equal(t, map[string]int{"a:7000": 10}, quotas)
+
+	// Every key is sampled from the nodes with fewer keys than the sample
+	quotas = randomKeyQuotas(map[string]int64{"a:7000": 3, "b:7001": 0, "c:7002": 4}, 10)
+	assert.Equal(t, map[string]int{"a:7000": 3, "c:7002": 4}, quotas)
+	assert.Empty(t, randomKeyQuotas(map[string]int64{}, 10))
+}
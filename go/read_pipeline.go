@@ -0,0 +1,176 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// ReadPipeline executes the batches of read-only commands of a shard on a single replica of the shard, so that all the
+// commands of a batch read the same snapshot of the data, as replicated to that replica. The replica lagging the least
+// behind the primary is picked, among the ones within the lag thresholds of the [pipeline.ReadPipelineOptions]. If no
+// replica is within the thresholds, the batch is executed on the primary.
+//
+// Use [ClusterClient.NewReadPipeline] to create a ReadPipeline.
+type ReadPipeline struct {
+	client *ClusterClient
+	opts   pipeline.ReadPipelineOptions
+
+	mu sync.Mutex
+	// The replication status of the shards, by slot of the keys of their batches.
+	statuses map[uint16]fetchedReplicationStatus
+	// The addresses of the replicas the client was allowed to read from with `READONLY`.
+	readOnly map[string]struct{}
+}
+
+// fetchedReplicationStatus is the replication status of a shard and when it was fetched.
+type fetchedReplicationStatus struct {
+	status  models.ReplicationStatus
+	fetched time.Time
+}
+
+// NewReadPipeline creates a [ReadPipeline] executing read-only batches with the client.
+//
+// Parameters:
+//
+//	opts - The lag thresholds of the replicas to read from. See [pipeline.NewReadPipelineOptions] for the defaults.
+//
+// Return value:
+//
+//	A new ReadPipeline.
+func (client *ClusterClient) NewReadPipeline(opts pipeline.ReadPipelineOptions) *ReadPipeline {
+	return &ReadPipeline{
+		client:   client,
+		opts:     opts,
+		statuses: map[uint16]fetchedReplicationStatus{},
+		readOnly: map[string]struct{}{},
+	}
+}
+
+// Exec executes `batch` on a replica of the shard owning `key`, or on its primary if no replica is within the lag
+// thresholds. The batch must only contain read-only commands, on keys in the same slot as `key`: the replicas reject the
+// writes, and the commands are not redirected to the other shards.
+//
+// The replication status of the shard is fetched from its primary with `INFO replication`, and reused for the
+// `StatusTTL` of the options. The client is allowed to read from a replica by sending `READONLY` to it, unless it already
+// reads from the replicas with its [config.ReadFrom] strategy. A replica reconnected since redirects the batch to the
+// primary.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the execution.
+//	key - A key of the batch, identifying the shard to read from.
+//	batch - The batch of read-only commands to execute.
+//	raiseOnError - Determines how errors are handled within the batch response. When set to `true`, the first
+//	  encountered error in the batch will be raised as an error after all retries and reconnections have been executed.
+//	  When set to `false`, errors will be included as part of the batch response array.
+//
+// Return value:
+//
+//	A slice of results, where each entry corresponds to a command's execution result.
+func (p *ReadPipeline) Exec(ctx context.Context, key string, batch pipeline.ClusterBatch, raiseOnError bool) ([]any, error) {
+	route, err := p.route(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return p.client.ExecWithOptions(ctx, batch, raiseOnError, *pipeline.NewClusterBatchOptions().WithRoute(route))
+}
+
+// route returns the route of the batches on `key`: the replica of its shard lagging the least within the thresholds, or
+// the primary of the shard.
+func (p *ReadPipeline) route(ctx context.Context, key string) (config.SingleNodeRoute, error) {
+	primary := config.NewSlotKeyRoute(config.SlotTypePrimary, key)
+	status, err := p.replicationStatus(ctx, key, primary)
+	if err != nil {
+		return nil, err
+	}
+	replica, ok := status.LeastLaggingReplica(int64(p.opts.MaxLag/time.Second), p.opts.MaxOffsetLag)
+	if !ok {
+		return primary, nil
+	}
+	host, port, err := splitAddress(replica.Address)
+	if err != nil {
+		return nil, err
+	}
+	route := config.NewByAddressRoute(host, port)
+	if err := p.allowReads(ctx, replica.Address, route); err != nil {
+		return nil, err
+	}
+	return route, nil
+}
+
+// splitAddress splits the "host:port" address of a replica, as reported by `INFO replication`.
+func splitAddress(address string) (string, int32, error) {
+	separator := strings.LastIndex(address, ":")
+	if separator < 0 {
+		return "", 0, fmt.Errorf("invalid replica address %q", address)
+	}
+	port, err := strconv.ParseInt(address[separator+1:], 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid replica address %q", address)
+	}
+	return address[:separator], int32(port), nil
+}
+
+// replicationStatus returns the replication status of the shard owning `key`, fetched from its primary unless fetched
+// within the status TTL.
+func (p *ReadPipeline) replicationStatus(
+	ctx context.Context,
+	key string,
+	primary config.SingleNodeRoute,
+) (models.ReplicationStatus, error) {
+	slot := utils.KeySlot(p.client.keyPrefix + key)
+	p.mu.Lock()
+	fetched, ok := p.statuses[slot]
+	p.mu.Unlock()
+	if ok && time.Since(fetched.fetched) < p.opts.StatusTTL {
+		return fetched.status, nil
+	}
+
+	response, err := p.client.executeCommandWithRoute(ctx, C.Info, []string{string(constants.Replication)}, primary)
+	if err != nil {
+		return models.ReplicationStatus{}, err
+	}
+	status, err := handleReplicationStatusResponse(response)
+	if err != nil {
+		return models.ReplicationStatus{}, err
+	}
+	p.mu.Lock()
+	p.statuses[slot] = fetchedReplicationStatus{status: status, fetched: time.Now()}
+	p.mu.Unlock()
+	return status, nil
+}
+
+// allowReads sends `READONLY` to the replica at `address`, once, unless the client already reads from the replicas.
+func (p *ReadPipeline) allowReads(ctx context.Context, address string, route config.SingleNodeRoute) error {
+	if p.client.readFromReplica.Load() {
+		return nil
+	}
+	p.mu.Lock()
+	_, ok := p.readOnly[address]
+	p.mu.Unlock()
+	if ok {
+		return nil
+	}
+	if _, err := p.client.CustomCommandWithRoute(ctx, []string{"READONLY"}, route); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.readOnly[address] = struct{}{}
+	p.mu.Unlock()
+	return nil
+}
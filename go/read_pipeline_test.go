@@ -0,0 +1,26 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitAddress(t *testing.T) {
+	host, port, err := splitAddress("10.0.0.2:6380")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.2", host)
+	assert.Equal(t, int32(6380), port)
+
+	host, port, err = splitAddress("::1:6380")
+	assert.NoError(t, err)
+	assert.Equal(t, "::1", host)
+	assert.Equal(t, int32(6380), port)
+
+	_, _, err = splitAddress("10.0.0.2")
+	assert.Error(t, err)
+	_, _, err = splitAddress("10.0.0.2:port")
+	assert.Error(t, err)
+}
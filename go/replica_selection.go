@@ -0,0 +1,284 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// replicaTopologyTTL is how long the replicas of the shards are used before they are listed again.
+const replicaTopologyTTL = 10 * time.Second
+
+// replicaSelectionLookup marks the context of the commands sent to select a replica, so that they are not routed by the
+// selector themselves.
+type replicaSelectionLookup struct{}
+
+// replicaSelection routes the read-only commands to the replicas picked by the selector of
+// [config.ClusterClientConfiguration.WithReplicaSelector].
+type replicaSelection struct {
+	client   *ClusterClient
+	selector config.ReplicaSelector
+
+	mu sync.Mutex
+	// The replicas of the shards, or nil until they are first listed.
+	topology   *replicaTopology
+	fetched    time.Time
+	refreshing bool
+}
+
+// replicaTopology is the shard serving every slot of the cluster, and the replicas of the shards.
+type replicaTopology struct {
+	// The index in shards of the shard serving every slot, or -1 if no shard with a replica serves it.
+	slots  []int32
+	shards []replicaShard
+}
+
+// replicaShard is the replicas of a shard, and the routes to them.
+type replicaShard struct {
+	replicas []config.Replica
+	routes   []config.Route
+}
+
+func newReplicaSelection(client *ClusterClient, selector config.ReplicaSelector) *replicaSelection {
+	return &replicaSelection{client: client, selector: selector}
+}
+
+// selectReplica returns the replica the command `args` of `requestType`, sent with `route`, is routed to, the route to
+// the replica, and true, or false if the command is routed as usual. Only the read-only commands sent without a route,
+// whose keys map to a single slot, are routed to the replica picked by the selector, and only while the client reads
+// from replicas.
+func (client *baseClient) selectReplica(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (config.Replica, config.Route, bool) {
+	selection := client.replicaSelection
+	if selection == nil || !client.readFromReplica.Load() || ctx.Value(replicaSelectionLookup{}) != nil {
+		return config.Replica{}, nil, false
+	}
+	hinted := false
+	switch route.(type) {
+	case nil:
+	case readOnlyRoute:
+		hinted = true
+	default:
+		return config.Replica{}, nil, false
+	}
+	// The keys of the subcommands are not located
+	name := commandName(requestType, args)
+	if name == "" || strings.ContainsAny(name, " |") {
+		return config.Replica{}, nil, false
+	}
+	command := args
+	if requestType != C.CustomCommand {
+		command = append([]string{name}, args...)
+	}
+	topology := selection.currentTopology()
+	if topology == nil {
+		return config.Replica{}, nil, false
+	}
+
+	info, ok := client.cachedCommandInfo(context.WithValue(ctx, replicaSelectionLookup{}, true), name)
+	if !ok || (!hinted && !isReadOnlyCommand(info, command)) ||
+		len(info.Subcommands) > 0 || slices.Contains(info.Flags, "movablekeys") {
+		return config.Replica{}, nil, false
+	}
+	shard, ok := topology.shardOf(client.keyPrefix, locateKeys(info, command))
+	if !ok {
+		return config.Replica{}, nil, false
+	}
+	index := selection.selector.Select(shard.replicas)
+	if index < 0 || index >= len(shard.replicas) {
+		return config.Replica{}, nil, false
+	}
+	return shard.replicas[index], shard.routes[index], true
+}
+
+// currentTopology returns the replicas of the shards, or nil if they were not listed yet. They are listed again in the
+// background once they are older than [replicaTopologyTTL], so that the commands never wait for them.
+func (s *replicaSelection) currentTopology() *replicaTopology {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.refreshing && time.Since(s.fetched) > replicaTopologyTTL {
+		s.refreshing = true
+		go s.refresh()
+	}
+	return s.topology
+}
+
+// refresh lists the replicas of the shards with `CLUSTER NODES`, and their availability zones. If the replicas cannot be
+// listed, the previous ones are kept until the next attempt.
+func (s *replicaSelection) refresh() {
+	ctx := context.WithValue(context.Background(), replicaSelectionLookup{}, true)
+	var topology *replicaTopology
+	if nodes, err := s.client.ClusterNodes(ctx); err == nil {
+		topology = parseReplicaTopology(nodes)
+		s.fetchAvailabilityZones(ctx, topology)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if topology != nil {
+		s.topology = topology
+	}
+	s.fetched = time.Now()
+	s.refreshing = false
+}
+
+// fetchAvailabilityZones sets the availability zones of the replicas of `topology`. The zones of the replicas that fail
+// to report it are left empty.
+func (s *replicaSelection) fetchAvailabilityZones(ctx context.Context, topology *replicaTopology) {
+	var addresses []string
+	for _, shard := range topology.shards {
+		for _, replica := range shard.replicas {
+			addresses = append(addresses, replica.Address)
+		}
+	}
+	zones, _ := fanOutTo(
+		ctx,
+		addresses,
+		options.DefaultFanOutConcurrency,
+		func(ctx context.Context, _ string, route config.Route) (string, error) {
+			reply, err := s.client.ConfigGetWithOptions(ctx, []string{"availability-zone"}, options.RouteOption{Route: route})
+			if err != nil {
+				return "", err
+			}
+			return reply.SingleValue()["availability-zone"], nil
+		},
+	)
+	for _, shard := range topology.shards {
+		for i := range shard.replicas {
+			shard.replicas[i].AvailabilityZone = zones[shard.replicas[i].Address]
+		}
+	}
+}
+
+// parseReplicaTopology parses the reply of `CLUSTER NODES` into the shard serving every slot and its replicas, except the
+// replicas that are failing, without an address, or still joining the cluster.
+func parseReplicaTopology(nodes string) *replicaTopology {
+	topology := &replicaTopology{slots: make([]int32, utils.SlotCount)}
+	for slot := range topology.slots {
+		topology.slots[slot] = -1
+	}
+	var lines [][]string
+	for _, line := range strings.Split(nodes, "\n") {
+		if fields := strings.Fields(line); len(fields) >= 8 {
+			lines = append(lines, fields)
+		}
+	}
+
+	// The replicas, by the ID of their primary
+	replicas := map[string][]string{}
+	for _, fields := range lines {
+		if fields[3] != "-" && nodeAddress(fields) != "" {
+			replicas[fields[3]] = append(replicas[fields[3]], nodeAddress(fields))
+		}
+	}
+	for _, fields := range lines {
+		if fields[3] != "-" || len(fields) == 8 {
+			continue
+		}
+		var shard replicaShard
+		for _, address := range replicas[fields[0]] {
+			route, err := config.NewByAddressRouteWithHost(address)
+			if err != nil {
+				continue
+			}
+			shard.replicas = append(shard.replicas, config.Replica{Address: address})
+			shard.routes = append(shard.routes, route)
+		}
+		if len(shard.replicas) == 0 {
+			continue
+		}
+		index := int32(len(topology.shards))
+		topology.shards = append(topology.shards, shard)
+		for _, slots := range fields[8:] {
+			// The slots being imported or migrated, e.g. "[93->-<node ID>]", are served by their owner
+			if strings.HasPrefix(slots, "[") {
+				continue
+			}
+			first, last, _ := strings.Cut(slots, "-")
+			if last == "" {
+				last = first
+			}
+			from, err := strconv.Atoi(first)
+			if err != nil {
+				continue
+			}
+			to, err := strconv.Atoi(last)
+			if err != nil {
+				continue
+			}
+			for slot := max(from, 0); slot <= to && slot < utils.SlotCount; slot++ {
+				topology.slots[slot] = index
+			}
+		}
+	}
+	return topology
+}
+
+// nodeAddress returns the address of the node of the `fields` of a line of `CLUSTER NODES`, or the empty string if the
+// node is failing, without an address, or still joining the cluster.
+func nodeAddress(fields []string) string {
+	flags := strings.Split(fields[2], ",")
+	if slices.Contains(flags, "fail") || slices.Contains(flags, "noaddr") || slices.Contains(flags, "handshake") {
+		return ""
+	}
+	// The address is followed by the cluster bus port, and optionally the hostname, e.g. "10.0.0.1:6379@16379,host"
+	address, _, _ := strings.Cut(fields[1], "@")
+	if strings.HasPrefix(address, ":") {
+		return ""
+	}
+	return address
+}
+
+// shardOf returns the shard serving `keys`, stored with the prefix `keyPrefix`, and false if there are no keys, if they
+// map to different slots, or if no shard with a replica serves their slot.
+func (t *replicaTopology) shardOf(keyPrefix string, keys []string) (replicaShard, bool) {
+	if len(keys) == 0 {
+		return replicaShard{}, false
+	}
+	slot := utils.KeySlot(keyPrefix + keys[0])
+	for _, key := range keys[1:] {
+		if utils.KeySlot(keyPrefix+key) != slot {
+			return replicaShard{}, false
+		}
+	}
+	index := t.slots[slot]
+	if index < 0 {
+		return replicaShard{}, false
+	}
+	return t.shards[index], true
+}
+
+// locateKeys returns the keys of the command `args`, including the command name, located by the first key, the last key
+// and the step of its command table entry `info`.
+func locateKeys(info models.CommandInfo, args []string) []string {
+	if info.FirstKey <= 0 || info.Step <= 0 {
+		return nil
+	}
+	// The positions count the command name
+	last := info.LastKey
+	if last < 0 {
+		last += int64(len(args))
+	}
+	var keys []string
+	for position := info.FirstKey; position <= last && position < int64(len(args)); position += info.Step {
+		keys = append(keys, args[position])
+	}
+	return keys
+}
@@ -0,0 +1,62 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestParseReplicaTopology(t *testing.T) {
+	nodes := "aaaa 127.0.0.1:30001@31001,host1 myself,master - 0 0 1 connected 0-5460 [5461->-bbbb]\n" +
+		"bbbb 127.0.0.1:30002@31002 master - 0 0 2 connected 5461-10922\n" +
+		"cccc 127.0.0.1:30003@31003 master - 0 0 3 connected 10923-16382 16383\n" +
+		"dddd 127.0.0.1:30004@31004 slave aaaa 0 0 4 connected\n" +
+		"eeee 127.0.0.1:30005@31005 slave aaaa 0 0 5 connected\n" +
+		"ffff 127.0.0.1:30006@31006 slave,fail cccc 0 0 6 disconnected\n" +
+		"gggg 127.0.0.1:30007@31007 slave cccc 0 0 7 connected\n"
+	topology := parseReplicaTopology(nodes)
+
+	if !assert.Len(t, topology.shards, 2) {
+		return
+	}
+	assert.Equal(t, []config.Replica{{Address: "127.0.0.1:30004"}, {Address: "127.0.0.1:30005"}}, topology.shards[0].replicas)
+	assert.Len(t, topology.shards[0].routes, 2)
+	assert.Equal(t, []config.Replica{{Address: "127.0.0.1:30007"}}, topology.shards[1].replicas)
+	assert.Equal(t, int32(0), topology.slots[0])
+	assert.Equal(t, int32(0), topology.slots[5460])
+	// The shard without replicas
+	assert.Equal(t, int32(-1), topology.slots[5461])
+	assert.Equal(t, int32(1), topology.slots[10923])
+	assert.Equal(t, int32(1), topology.slots[16383])
+
+	// "foo" maps to slot 12182, "bar" to slot 5061
+	shard, ok := topology.shardOf("", []string{"foo", "{foo}1"})
+	assert.True(t, ok)
+	assert.Equal(t, topology.shards[1].replicas, shard.replicas)
+	_, ok = topology.shardOf("", []string{"foo", "bar"})
+	assert.False(t, ok)
+	_, ok = topology.shardOf("", nil)
+	assert.False(t, ok)
+	shard, ok = topology.shardOf("{bar}", []string{"foo"})
+	assert.True(t, ok)
+	assert.Equal(t, topology.shards[0].replicas, shard.replicas)
+}
+
+func TestLocateKeys(t *testing.T) {
+	get := models.CommandInfo{FirstKey: 1, LastKey: 1, Step: 1}
+	assert.Equal(t, []string{"key"}, locateKeys(get, []string{"GET", "key"}))
+
+	mget := models.CommandInfo{FirstKey: 1, LastKey: -1, Step: 1}
+	assert.Equal(t, []string{"a", "b", "c"}, locateKeys(mget, []string{"MGET", "a", "b", "c"}))
+
+	mset := models.CommandInfo{FirstKey: 1, LastKey: -1, Step: 2}
+	assert.Equal(t, []string{"a", "b"}, locateKeys(mset, []string{"MSET", "a", "1", "b", "2"}))
+
+	ping := models.CommandInfo{}
+	assert.Empty(t, locateKeys(ping, []string{"PING"}))
+}
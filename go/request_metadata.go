@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+type requestMetadataKey struct{}
+
+// WithRequestMetadata returns a copy of `ctx` carrying the request metadata `key`=`value`, e.g. a trace ID or a tenant,
+// in addition to the metadata already attached to `ctx`.
+//
+// The metadata is not sent to the server: the connections are shared by concurrent requests, so per-connection settings
+// such as `CLIENT SETINFO` cannot hold per-request values. Instead, it is passed to the audit hook of the client, see
+// [config.AuditHook], which can log it with [models.RequestAudit.Annotation] to correlate the requests with application
+// traces and with the entries of `SLOWLOG GET`.
+//
+// Example:
+//
+//	ctx = glide.WithRequestMetadata(ctx, "trace_id", traceID)
+//	ctx = glide.WithRequestMetadata(ctx, "tenant", tenant)
+//	value, err := client.Get(ctx, "key") // audited as "/* tenant=... trace_id=... */"
+func WithRequestMetadata(ctx context.Context, key string, value string) context.Context {
+	metadata := RequestMetadataFromContext(ctx)
+	if metadata == nil {
+		metadata = make(map[string]string, 1)
+	}
+	metadata[key] = value
+	return context.WithValue(ctx, requestMetadataKey{}, metadata)
+}
+
+// RequestMetadataFromContext returns a copy of the request metadata attached to `ctx` by [WithRequestMetadata], or nil if
+// there is none.
+func RequestMetadataFromContext(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+	metadata, _ := ctx.Value(requestMetadataKey{}).(map[string]string)
+	return maps.Clone(metadata)
+}
+
+// The names of the commands of the request types, cached to call into the core only once per request type.
+var commandNames sync.Map
+
+func commandName(requestType C.RequestType, args []string) string {
+	if requestType == C.CustomCommand {
+		if len(args) == 0 {
+			return ""
+		}
+		return strings.ToUpper(args[0])
+	}
+	if name, ok := commandNames.Load(requestType); ok {
+		return name.(string)
+	}
+	cName := C.get_command_name(uint32(requestType))
+	if cName == nil {
+		return ""
+	}
+	defer C.free_c_string(cName)
+	name := C.GoString(cName)
+	commandNames.Store(requestType, name)
+	return name
+}
+
+// audit calls the audit hook of the client, if any, with a request started at `start`.
+func (client *baseClient) audit(ctx context.Context, command string, start time.Time, err error) {
+	client.auditHook(ctx, models.RequestAudit{
+		Command:  command,
+		Metadata: RequestMetadataFromContext(ctx),
+		Duration: time.Since(start),
+		Err:      err,
+	})
+}
@@ -0,0 +1,28 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRequestMetadata(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, RequestMetadataFromContext(ctx))
+
+	traced := WithRequestMetadata(ctx, "trace_id", "4bf92f35")
+	tenant := WithRequestMetadata(traced, "tenant", "acme")
+	assert.Equal(t, map[string]string{"trace_id": "4bf92f35"}, RequestMetadataFromContext(traced))
+	assert.Equal(t, map[string]string{"trace_id": "4bf92f35", "tenant": "acme"}, RequestMetadataFromContext(tenant))
+
+	overridden := WithRequestMetadata(tenant, "tenant", "globex")
+	assert.Equal(t, "globex", RequestMetadataFromContext(overridden)["tenant"])
+	assert.Equal(t, "acme", RequestMetadataFromContext(tenant)["tenant"])
+
+	// The returned map is a copy.
+	RequestMetadataFromContext(tenant)["tenant"] = "changed"
+	assert.Equal(t, "acme", RequestMetadataFromContext(tenant)["tenant"])
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 )
 
 func TestSimpleNodeRoute(t *testing.T) {
@@ -59,6 +60,19 @@ func TestSlotKeyRoute(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestPrefixRoute(t *testing.T) {
+	client := &baseClient{keyPrefix: "tenant1:"}
+	route := client.prefixRoute(config.NewSlotKeyRoute(config.SlotTypeReplica, "key"))
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypeReplica, "tenant1:key"), route)
+	assert.Equal(t, config.AllPrimaries, client.prefixRoute(config.AllPrimaries))
+	assert.Nil(t, client.prefixRoute(nil))
+
+	// The routes are unchanged if the keys are not prefixed
+	client = &baseClient{}
+	route = client.prefixRoute(config.NewSlotKeyRoute(config.SlotTypePrimary, "key"))
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "key"), route)
+}
+
 func TestByAddressRoute(t *testing.T) {
 	route := config.NewByAddressRoute(config.DefaultHost, config.DefaultPort)
 	expected := &protobuf.Routes{
@@ -98,3 +112,34 @@ func TestByAddressRoute_InvalidHost(t *testing.T) {
 	_, err := config.NewByAddressRouteWithHost(config.DefaultHost)
 	assert.NotNil(t, err)
 }
+
+func TestReadOnlyRoute(t *testing.T) {
+	expected := &protobuf.Routes{ReadOnly: true}
+
+	result, err := routeToProtobuf(readOnlyRoute{})
+
+	assert.Equal(t, expected, result)
+	assert.Nil(t, err)
+}
+
+func TestIsReadOnlyCommand(t *testing.T) {
+	get := models.CommandInfo{Name: "get", Flags: []string{"readonly", "fast"}}
+	set := models.CommandInfo{Name: "set", Flags: []string{"write", "denyoom"}}
+	configInfo := models.CommandInfo{
+		Name: "config",
+		Subcommands: []models.CommandInfo{
+			{Name: "config|get", Flags: []string{"admin", "noscript", "loading", "stale"}},
+		},
+	}
+	object := models.CommandInfo{
+		Name:        "object",
+		Subcommands: []models.CommandInfo{{Name: "object|encoding", Flags: []string{"readonly"}}},
+	}
+
+	assert.True(t, isReadOnlyCommand(get, []string{"GET", "key"}))
+	assert.False(t, isReadOnlyCommand(set, []string{"SET", "key", "value"}))
+	assert.False(t, isReadOnlyCommand(configInfo, []string{"CONFIG", "GET", "maxmemory"}))
+	assert.True(t, isReadOnlyCommand(object, []string{"OBJECT", "ENCODING", "key"}))
+	// Unknown commands
+	assert.False(t, isReadOnlyCommand(models.CommandInfo{}, []string{"MODULE.CMD", "key"}))
+}
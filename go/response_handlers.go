@@ -8,6 +8,7 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"sort"
 	"strconv"
@@ -355,6 +356,29 @@ func handleStringOrNilResponse(response *C.struct_CommandResponse) (models.Resul
 	return convertCharArrayToString(response, true)
 }
 
+// handleStringIntoResponse copies a bulk string reply straight from the response into `dst`, skipping the intermediate
+// Go string. Returns -1 for a nil reply, and the length of the value with io.ErrShortBuffer if it does not fit.
+func handleStringIntoResponse(response *C.struct_CommandResponse, dst []byte) (int, error) {
+	defer C.free_command_response(response)
+
+	typeErr := checkResponseType(response, C.String, true)
+	if typeErr != nil {
+		return 0, typeErr
+	}
+
+	if response.string_value == nil {
+		return -1, nil
+	}
+	length := int(response.string_value_len)
+	if length > len(dst) {
+		return length, io.ErrShortBuffer
+	}
+	if length > 0 {
+		copy(dst, unsafe.Slice((*byte)(unsafe.Pointer(response.string_value)), length))
+	}
+	return length, nil
+}
+
 func handleOkResponse(response *C.struct_CommandResponse) (string, error) {
 	defer C.free_command_response(response)
 
@@ -549,6 +573,21 @@ func handleIntArrayResponse(response *C.struct_CommandResponse) ([]int64, error)
 	return slice, nil
 }
 
+func handleStreamEntryDeletionStatusArrayResponse(
+	response *C.struct_CommandResponse,
+) ([]models.StreamEntryDeletionStatus, error) {
+	values, err := handleIntArrayResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]models.StreamEntryDeletionStatus, 0, len(values))
+	for _, value := range values {
+		statuses = append(statuses, models.StreamEntryDeletionStatus(value))
+	}
+	return statuses, nil
+}
+
 func handleIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.Result[int64], error) {
 	defer C.free_command_response(response)
 
@@ -738,6 +777,32 @@ func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[st
 	return result, nil
 }
 
+func handleFieldValueArrayResponse(response *C.struct_CommandResponse) ([]models.FieldValue, error) {
+	defer C.free_command_response(response)
+
+	typeErr := checkResponseType(response, C.Map, false)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+
+	entries := make([]models.FieldValue, 0, response.array_value_len)
+	if response.array_value == nil {
+		return entries, nil
+	}
+	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
+		field, err := convertCharArrayToString(v.map_key, false)
+		if err != nil {
+			return nil, err
+		}
+		value, err := convertCharArrayToString(v.map_value, false)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, models.FieldValue{Field: field.Value(), Value: value.Value()})
+	}
+	return entries, nil
+}
+
 func handleStringToStringOrNilMapResponse(response *C.struct_CommandResponse) (map[string]models.Result[string], error) {
 	defer C.free_command_response(response)
 
@@ -1799,3 +1864,260 @@ func handleStringToArrayOfMapsMapResponse(
 
 	return resultMap, nil
 }
+
+func handleClientInfoResponse(response *C.struct_CommandResponse) (models.ClientInfo, error) {
+	info, err := handleStringResponse(response)
+	if err != nil {
+		return models.ClientInfo{}, err
+	}
+	return models.ParseClientInfo(info)
+}
+
+func handleClientInfoMapResponse(response *C.struct_CommandResponse) (map[string]models.ClientInfo, error) {
+	infos, err := handleStringToStringMapResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]models.ClientInfo, len(infos))
+	for node, info := range infos {
+		if result[node], err = models.ParseClientInfo(info); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func handleReplicationStatusResponse(response *C.struct_CommandResponse) (models.ReplicationStatus, error) {
+	info, err := handleStringResponse(response)
+	if err != nil {
+		return models.ReplicationStatus{}, err
+	}
+	return models.ParseReplicationStatus(info)
+}
+
+func handleReplicationStatusMapResponse(
+	response *C.struct_CommandResponse,
+) (map[string]models.ReplicationStatus, error) {
+	infos, err := handleStringToStringMapResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]models.ReplicationStatus, len(infos))
+	for node, info := range infos {
+		if result[node], err = models.ParseReplicationStatus(info); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func handleClientTrackingInfoResponse(response *C.struct_CommandResponse) (models.ClientTrackingInfo, error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return models.ClientTrackingInfo{}, err
+	}
+	return convertClientTrackingInfo(data)
+}
+
+func handleClientTrackingInfoMapResponse(
+	response *C.struct_CommandResponse,
+) (map[string]models.ClientTrackingInfo, error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type: %T", data)
+	}
+	result := make(map[string]models.ClientTrackingInfo, len(nodes))
+	for node, info := range nodes {
+		if result[node], err = convertClientTrackingInfo(info); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// convertClientTrackingInfo converts a `CLIENT TRACKINGINFO` reply.
+func convertClientTrackingInfo(data any) (models.ClientTrackingInfo, error) {
+	fields, err := anyToFieldMap(data)
+	if err != nil {
+		return models.ClientTrackingInfo{}, fmt.Errorf("unexpected CLIENT TRACKINGINFO response: %w", err)
+	}
+
+	result := models.ClientTrackingInfo{}
+	if result.Flags, err = anyToStringSlice(fields["flags"]); err != nil {
+		return models.ClientTrackingInfo{}, err
+	}
+	if result.Prefixes, err = anyToStringSlice(fields["prefixes"]); err != nil {
+		return models.ClientTrackingInfo{}, err
+	}
+	if redirect, ok := fields["redirect"].(int64); ok {
+		result.Redirect = redirect
+	} else {
+		return models.ClientTrackingInfo{}, fmt.Errorf("unexpected CLIENT TRACKINGINFO redirect: %v", fields["redirect"])
+	}
+	return result, nil
+}
+
+// anyToStringSlice converts a parsed array or set of strings. Set members are sorted since sets have no order.
+func anyToStringSlice(data any) ([]string, error) {
+	switch values := data.(type) {
+	case nil:
+		return []string{}, nil
+	case map[string]struct{}:
+		result := make([]string, 0, len(values))
+		for value := range values {
+			result = append(result, value)
+		}
+		sort.Strings(result)
+		return result, nil
+	case []any:
+		result := make([]string, 0, len(values))
+		for _, value := range values {
+			str, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected type of element: %T", value)
+			}
+			result = append(result, str)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unexpected type: %T", data)
+	}
+}
+
+// anyToFieldMap converts a reply that is a map with RESP3, and a flat array of field-value pairs with RESP2.
+func anyToFieldMap(data any) (map[string]any, error) {
+	if fields, ok := data.(map[string]any); ok {
+		return fields, nil
+	}
+	pairs, ok := data.([]any)
+	if !ok || len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("unexpected type: %T", data)
+	}
+	fields := make(map[string]any, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		name, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type of field name: %T", pairs[i])
+		}
+		fields[name] = pairs[i+1]
+	}
+	return fields, nil
+}
+
+func handleHelloResponse(response *C.struct_CommandResponse) (models.HelloResponse, error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return models.HelloResponse{}, err
+	}
+	return convertHelloResponse(data)
+}
+
+func handleHelloMapResponse(response *C.struct_CommandResponse) (map[string]models.HelloResponse, error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type: %T", data)
+	}
+	result := make(map[string]models.HelloResponse, len(nodes))
+	for node, hello := range nodes {
+		if result[node], err = convertHelloResponse(hello); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func convertHelloResponse(data any) (models.HelloResponse, error) {
+	fields, err := anyToFieldMap(data)
+	if err != nil {
+		return models.HelloResponse{}, fmt.Errorf("unexpected HELLO response: %w", err)
+	}
+
+	result := models.HelloResponse{}
+	result.Server, _ = fields["server"].(string)
+	result.Version, _ = fields["version"].(string)
+	result.Proto, _ = fields["proto"].(int64)
+	result.Id, _ = fields["id"].(int64)
+	result.Mode, _ = fields["mode"].(string)
+	result.Role, _ = fields["role"].(string)
+	modules, _ := fields["modules"].([]any)
+	result.Modules = make([]map[string]any, 0, len(modules))
+	for _, module := range modules {
+		moduleFields, err := anyToFieldMap(module)
+		if err != nil {
+			return models.HelloResponse{}, fmt.Errorf("unexpected HELLO module: %w", err)
+		}
+		result.Modules = append(result.Modules, moduleFields)
+	}
+	return result, nil
+}
+
+// convertCommandInfoResponse converts a `COMMAND INFO` reply, omitting the unknown commands which are nil.
+func convertCommandInfoResponse(data any) (map[string]models.CommandInfo, error) {
+	commands, ok := data.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type: %T", data)
+	}
+	result := make(map[string]models.CommandInfo, len(commands))
+	for _, command := range commands {
+		if command == nil {
+			continue
+		}
+		info, err := convertCommandInfo(command)
+		if err != nil {
+			return nil, err
+		}
+		result[info.Name] = info
+	}
+	return result, nil
+}
+
+func convertCommandInfo(data any) (models.CommandInfo, error) {
+	fields, ok := data.([]any)
+	if !ok || len(fields) < 6 {
+		return models.CommandInfo{}, fmt.Errorf("unexpected COMMAND INFO entry: %v", data)
+	}
+	var info models.CommandInfo
+	if info.Name, ok = fields[0].(string); !ok {
+		return models.CommandInfo{}, fmt.Errorf("unexpected COMMAND INFO name: %v", fields[0])
+	}
+	intFields := map[int]*int64{1: &info.Arity, 3: &info.FirstKey, 4: &info.LastKey, 5: &info.Step}
+	for index, target := range intFields {
+		if *target, ok = fields[index].(int64); !ok {
+			return models.CommandInfo{}, fmt.Errorf("unexpected COMMAND INFO field of %s: %v", info.Name, fields[index])
+		}
+	}
+	var err error
+	if info.Flags, err = anyToStringSlice(fields[2]); err != nil {
+		return models.CommandInfo{}, err
+	}
+	// The ACL categories are reported since Valkey 6.0, the subcommands since Valkey 7.0.
+	info.AclCategories = []string{}
+	if len(fields) > 6 {
+		if info.AclCategories, err = anyToStringSlice(fields[6]); err != nil {
+			return models.CommandInfo{}, err
+		}
+	}
+	info.Subcommands = []models.CommandInfo{}
+	if len(fields) > 9 {
+		subcommands, ok := fields[9].([]any)
+		if !ok {
+			return models.CommandInfo{}, fmt.Errorf("unexpected COMMAND INFO subcommands of %s: %v", info.Name, fields[9])
+		}
+		for _, subcommand := range subcommands {
+			subcommandInfo, err := convertCommandInfo(subcommand)
+			if err != nil {
+				return models.CommandInfo{}, err
+			}
+			info.Subcommands = append(info.Subcommands, subcommandInfo)
+		}
+	}
+	return info, nil
+}
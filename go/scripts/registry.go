@@ -0,0 +1,247 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package scripts provides a registry of named Lua scripts and functions with versions, loaded on the server on their
+// first use and loaded again after they were flushed or lost in a failover, so that applications with many scripts can
+// invoke them by name.
+package scripts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Registry holds named Lua scripts and functions, each with a version, and invokes them by name with [Registry.Invoke].
+//
+// A script is invoked with `EVALSHA`, and loaded with `SCRIPT LOAD` on the node that replies `NOSCRIPT`, e.g. on its first
+// use on the node, after `SCRIPT FLUSH`, or after a failover to a replica without the script.
+//
+// A function is invoked with `FCALL`, or `FCALL_RO` for a read-only function. Its library is loaded with
+// `FUNCTION LOAD ... REPLACE` on its first use, on every primary in cluster mode, and loaded again when a node replies that
+// the function is not found, e.g. after `FUNCTION FLUSH`, before the call is retried once.
+//
+// Registering a name again with another version replaces the script or the function, which is loaded on its next use.
+// Registering the same version with another code fails, so that a change of the code is not missed by the nodes that
+// loaded the previous code of a library.
+//
+// Example:
+//
+//	registry := scripts.New(client)
+//	defer registry.Close()
+//	err := registry.RegisterScript("rate-limit", "v2", rateLimitLua)
+//	...
+//	result, err := registry.Invoke(ctx, "rate-limit", []string{key}, []string{"10", "60"})
+type Registry struct {
+	client  interfaces.BaseClientCommands
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	version string
+	code    string
+	// The script, or nil for a function.
+	script   *options.Script
+	function string
+	readOnly bool
+	// Whether the library of the function was loaded since it was registered.
+	loaded bool
+}
+
+// New creates an empty [Registry].
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands) *Registry {
+	return &Registry{client: client, entries: map[string]*entry{}}
+}
+
+// RegisterScript registers the Lua script of `code` as `name`, at `version`.
+//
+// Parameters:
+//
+//	name - The name the script is invoked by.
+//	version - The version of the script, e.g. "v2". Registering `name` again with another version replaces the script.
+//	code - The source code of the script.
+//
+// Return value:
+//
+//	An error if `name` is already registered at `version` with another code, or with a function.
+func (r *Registry) RegisterScript(name, version, code string) error {
+	return r.register(name, &entry{version: version, code: code})
+}
+
+// RegisterFunction registers the function `function` of the library of `libraryCode` as `name`, at `version`.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Parameters:
+//
+//	name - The name the function is invoked by, which can differ from the name of the function in its library.
+//	version - The version of the function, e.g. "v2". Registering `name` again with another version replaces the function.
+//	libraryCode - The source code of the library of the function, starting with its name, e.g. `#!lua name=mylib`.
+//	function - The name of the function in its library.
+//	readOnly - Whether the function is invoked with `FCALL_RO`, which requires the `no-writes` flag in the library, and
+//	  can be routed to replicas.
+//
+// Return value:
+//
+//	An error if `name` is already registered at `version` with another code, or with a script.
+func (r *Registry) RegisterFunction(name, version, libraryCode, function string, readOnly bool) error {
+	if function == "" {
+		return errors.New("the name of the function must not be empty")
+	}
+	return r.register(name, &entry{version: version, code: libraryCode, function: function, readOnly: readOnly})
+}
+
+// register registers `e` as `name`, unless `name` is already registered with the same version and code.
+func (r *Registry) register(name string, e *entry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if previous, ok := r.entries[name]; ok {
+		if previous.version == e.version {
+			if previous.code != e.code || previous.function != e.function || previous.readOnly != e.readOnly {
+				return fmt.Errorf("%q is already registered at version %q with another code", name, e.version)
+			}
+			return nil
+		}
+		previous.close()
+	}
+	if e.function == "" {
+		e.script = options.NewScript(e.code)
+	}
+	r.entries[name] = e
+	return nil
+}
+
+// Unregister removes the script or the function registered as `name`, if any. The scripts and the libraries loaded on the
+// server are kept.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.entries[name]; ok {
+		e.close()
+		delete(r.entries, name)
+	}
+}
+
+// Version returns the version `name` is registered at, and whether it is registered.
+func (r *Registry) Version(name string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.entries[name]; ok {
+		return e.version, true
+	}
+	return "", false
+}
+
+// Names returns the names of the registered scripts and functions, in lexicographical order.
+func (r *Registry) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.entries))
+	for name := range r.entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Invoke invokes the script or the function registered as `name`, loading it on the server if needed.
+//
+// Note:
+//
+//	In cluster mode, all `keys` must map to the same hash slot.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	name - The name the script or the function is registered as.
+//	keys - The keys the script or the function accesses.
+//	args - The arguments of the script or the function.
+//
+// Return value:
+//
+//	The result of the script or the function. An error if `name` is not registered.
+func (r *Registry) Invoke(ctx context.Context, name string, keys []string, args []string) (any, error) {
+	r.mu.Lock()
+	e, ok := r.entries[name]
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%q is not registered", name)
+	}
+	if e.script != nil {
+		return r.client.InvokeScriptWithOptions(ctx, *e.script, options.ScriptOptions{Keys: keys, Args: args})
+	}
+
+	if !r.isLoaded(e) {
+		if err := r.load(ctx, e); err != nil {
+			return nil, err
+		}
+	}
+	result, err := r.call(ctx, e, keys, args)
+	if err != nil && isFunctionNotFound(err) {
+		if err := r.load(ctx, e); err != nil {
+			return nil, err
+		}
+		return r.call(ctx, e, keys, args)
+	}
+	return result, err
+}
+
+// Close unregisters all the scripts and the functions, and releases the scripts held by the client.
+func (r *Registry) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, e := range r.entries {
+		e.close()
+		delete(r.entries, name)
+	}
+}
+
+func (r *Registry) isLoaded(e *entry) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return e.loaded
+}
+
+// load loads the library of the function of `e`, replacing the library with the same name.
+func (r *Registry) load(ctx context.Context, e *entry) error {
+	if _, err := r.client.FunctionLoad(ctx, e.code, true); err != nil {
+		return fmt.Errorf("failed to load the library of function %q: %w", e.function, err)
+	}
+	r.mu.Lock()
+	e.loaded = true
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *Registry) call(ctx context.Context, e *entry, keys []string, args []string) (any, error) {
+	if e.readOnly {
+		return r.client.FCallReadOnlyWithKeysAndArgs(ctx, e.function, keys, args)
+	}
+	return r.client.FCallWithKeysAndArgs(ctx, e.function, keys, args)
+}
+
+func (e *entry) close() {
+	if e.script != nil {
+		e.script.Close()
+	}
+}
+
+// isFunctionNotFound returns whether `err` is the error of `FCALL` for a function that is not loaded on the node.
+func isFunctionNotFound(err error) bool {
+	return strings.Contains(err.Error(), "Function not found")
+}
@@ -0,0 +1,73 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package scripts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+const libraryCode = "#!lua name=mylib\nserver.register_function('myfunc', function(keys, args) return args[1] end)"
+
+// newFakeClient returns a client whose function "myfunc" returns its first argument.
+func newFakeClient() *fakeclient.Client {
+	return fakeclient.New().WithFunction("myfunc", func(keys []string, args []string) (any, error) { return args[0], nil })
+}
+
+func TestInvokeFunction(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	registry := New(client)
+	require.NoError(t, registry.RegisterFunction("echo", "v1", libraryCode, "myfunc", false))
+
+	// The library is loaded on the first use only
+	for i := 0; i < 2; i++ {
+		result, err := registry.Invoke(ctx, "echo", []string{"key"}, []string{"hello"})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", result)
+	}
+	assert.Equal(t, []string{"FUNCTION", "FCALL", "FCALL"}, client.Commands)
+
+	// The library is loaded again once flushed
+	clear(client.Libraries)
+	result, err := registry.Invoke(ctx, "echo", []string{"key"}, []string{"again"})
+	require.NoError(t, err)
+	assert.Equal(t, "again", result)
+	assert.Equal(t, []string{"FUNCTION", "FCALL", "FCALL", "FCALL", "FUNCTION", "FCALL"}, client.Commands)
+
+	// A new version is loaded on its next use
+	require.NoError(t, registry.RegisterFunction("echo", "v2", libraryCode+"\n", "myfunc", true))
+	_, err = registry.Invoke(ctx, "echo", []string{"key"}, []string{"v2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"FUNCTION", "FCALL_RO"}, client.Commands[6:])
+}
+
+func TestRegisterVersions(t *testing.T) {
+	registry := New(newFakeClient())
+	require.NoError(t, registry.RegisterFunction("b", "v1", libraryCode, "myfunc", false))
+	require.NoError(t, registry.RegisterFunction("a", "v1", libraryCode, "myfunc", false))
+	// Registering the same version with the same code is a no-op
+	require.NoError(t, registry.RegisterFunction("a", "v1", libraryCode, "myfunc", false))
+	assert.Error(t, registry.RegisterFunction("a", "v1", libraryCode+"\n", "myfunc", false))
+	assert.Error(t, registry.RegisterFunction("a", "v1", libraryCode, "myfunc", true))
+	assert.Error(t, registry.RegisterFunction("c", "v1", libraryCode, "", false))
+
+	require.NoError(t, registry.RegisterFunction("a", "v2", libraryCode+"\n", "myfunc", false))
+	version, ok := registry.Version("a")
+	assert.True(t, ok)
+	assert.Equal(t, "v2", version)
+	assert.Equal(t, []string{"a", "b"}, registry.Names())
+
+	registry.Unregister("a")
+	_, ok = registry.Version("a")
+	assert.False(t, ok)
+	_, err := registry.Invoke(context.Background(), "a", nil, nil)
+	assert.Error(t, err)
+
+	registry.Close()
+	assert.Empty(t, registry.Names())
+}
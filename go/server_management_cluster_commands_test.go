@@ -4,6 +4,7 @@ package glide
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -54,6 +55,23 @@ func ExampleClusterClient_InfoWithOptions() {
 	// Output: OK
 }
 
+func ExampleClusterClient_ReplicationStatus() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	statuses, err := client.ReplicationStatus(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, status := range statuses {
+		if !status.IsPrimary() {
+			fmt.Println("Unexpected replica")
+		}
+	}
+	fmt.Println(len(statuses) > 0)
+
+	// Output: true
+}
+
 func ExampleClusterClient_TimeWithOptions() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 	route := config.Route(config.RandomRoute)
@@ -271,6 +289,57 @@ func ExampleClusterClient_ConfigSetWithOptions() {
 	// OK
 }
 
+func ExampleClusterClient_ConfigSetPerNode() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	configParam := map[string]string{"timeout": "1000"}
+	// Set the parameter on every node, replicas included, on at most 4 nodes at a time
+	fanOutOptions := options.NewFanOutOptions().SetNodes(config.AllNodes).SetConcurrency(4)
+	err := client.ConfigSetPerNode(context.Background(), configParam, *fanOutOptions)
+	var nodeErrs *NodeErrors
+	if errors.As(err, &nodeErrs) {
+		for address, err := range nodeErrs.Errors {
+			fmt.Println("Failed to set the parameter on ", address, ": ", err)
+		}
+	} else if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(err == nil)
+
+	// Output:
+	// true
+}
+
+func ExampleClusterClient_InfoPerNode() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	infoOptions := options.InfoOptions{Sections: []constants.Section{constants.Server}}
+	result, err := client.InfoPerNode(context.Background(), infoOptions, *options.NewFanOutOptions())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, info := range result {
+		fmt.Println(strings.Contains(info, "# Server"))
+		break
+	}
+
+	// Output:
+	// true
+}
+
+func ExampleClusterClient_CommandInfo() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.CommandInfo(context.Background(), []string{"get", "nonexistentcommand"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	get := result["get"]
+	fmt.Println(len(result))
+	fmt.Println(get.Name, get.Arity, get.FirstKey, get.LastKey, get.Step)
+
+	// Output:
+	// 1
+	// get 2 1 1 1
+}
+
 func ExampleClusterClient_ConfigGet() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 	configParamSet := map[string]string{"timeout": "1000"}
@@ -286,6 +355,19 @@ func ExampleClusterClient_ConfigGet() {
 	// map[timeout:1000]
 }
 
+func ExampleClusterClient_ConfigGetSorted() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	client.ConfigSet(context.Background(), map[string]string{"timeout": "1000"})
+	result, err := client.ConfigGetSorted(context.Background(), []string{"timeout", "maxmemory"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Keys())
+
+	// Output:
+	// [maxmemory timeout]
+}
+
 func ExampleClusterClient_ConfigGetWithOptions() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 	opts := options.RouteOption{Route: config.RandomRoute}
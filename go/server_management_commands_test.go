@@ -27,6 +27,21 @@ func ExampleClient_Select() {
 	// Output: OK
 }
 
+func ExampleClient_CommandInfo() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.CommandInfo(context.Background(), []string{"get", "nonexistentcommand"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	get := result["get"]
+	fmt.Println(len(result))
+	fmt.Println(get.Name, get.Arity, get.FirstKey, get.LastKey, get.Step)
+
+	// Output:
+	// 1
+	// get 2 1 1 1
+}
+
 func ExampleClient_ConfigGet() {
 	var client *Client = getExampleClient()                                                          // example helper function
 	client.ConfigSet(context.Background(), map[string]string{"timeout": "1000", "maxmemory": "1GB"}) // example configuration
@@ -40,6 +55,22 @@ func ExampleClient_ConfigGet() {
 	// map[maxmemory:1073741824 timeout:1000]
 }
 
+func ExampleClient_ConfigGetSorted() {
+	var client *Client = getExampleClient()                                                          // example helper function
+	client.ConfigSet(context.Background(), map[string]string{"timeout": "1000", "maxmemory": "1GB"}) // example configuration
+	result, err := client.ConfigGetSorted(context.Background(), []string{"timeout", "maxmemory"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, parameter := range result {
+		fmt.Println(parameter.Field, parameter.Value)
+	}
+
+	// Output:
+	// maxmemory 1073741824
+	// timeout 1000
+}
+
 func ExampleClient_ConfigSet() {
 	var client *Client = getExampleClient() // example helper function
 	result, err := client.ConfigSet(
@@ -123,6 +154,18 @@ func ExampleClient_InfoWithOptions() {
 	// Output: response is of type string
 }
 
+func ExampleClient_ReplicationStatus() {
+	var client *Client = getExampleClient() // example helper function
+
+	status, err := client.ReplicationStatus(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(status.IsPrimary())
+
+	// Output: true
+}
+
 func ExampleClient_FlushAll() {
 	var client *Client = getExampleClient() // example helper function
 
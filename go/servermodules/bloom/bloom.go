@@ -0,0 +1,301 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package bloom provides the commands of the modules of probabilistic data structures, such as valkey-bloom: bloom
+// filters, cuckoo filters, count-min sketches and top-k.
+//
+// The commands are sent as custom commands, and work with both a [glide.Client] and a [glide.ClusterClient]. The read
+// commands, such as [BFExists], are hinted as read-only, so that they are routed according to the `ReadFrom` strategy of
+// the client.
+//
+// Note that valkey-bloom only provides the bloom filter commands (`BF.*`). The cuckoo filter (`CF.*`), count-min sketch
+// (`CMS.*`) and top-k (`TOPK.*`) commands require a module that provides them, such as RedisBloom.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+package bloom
+
+import (
+	"context"
+	"fmt"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+const (
+	bfAdd     = "BF.ADD"
+	bfMAdd    = "BF.MADD"
+	bfExists  = "BF.EXISTS"
+	bfMExists = "BF.MEXISTS"
+	bfReserve = "BF.RESERVE"
+	bfInsert  = "BF.INSERT"
+	bfInfo    = "BF.INFO"
+)
+
+// BloomFilterInfo describes a bloom filter, as returned by `BF.INFO`.
+type BloomFilterInfo struct {
+	// The number of items the filter can hold before it is full, across all its sub-filters.
+	Capacity int64
+	// The memory used by the filter, in bytes.
+	Size int64
+	// The number of sub-filters.
+	Filters int64
+	// The number of items added to the filter.
+	Items int64
+	// The factor by which the capacity grows when the filter is full, or 0 for a non-scaling filter.
+	ExpansionRate int64
+}
+
+// BFAdd adds an item to the bloom filter stored at `key`, creating the filter with the default capacity and error rate
+// of the server if it does not exist.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the bloom filter.
+//	item - The item to add.
+//
+// Return value:
+//
+//	`true` if the item was added, `false` if it may already have been added.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func BFAdd(ctx context.Context, client interfaces.BaseClientCommands, key string, item string) (bool, error) {
+	result, err := executeCommand(ctx, client, []string{bfAdd, key, item}, false)
+	if err != nil {
+		return false, err
+	}
+	return toBool(result)
+}
+
+// BFMAdd adds items to the bloom filter stored at `key`, creating the filter with the default capacity and error rate of
+// the server if it does not exist.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the bloom filter.
+//	items - The items to add.
+//
+// Return value:
+//
+//	For every item, `true` if it was added, `false` if it may already have been added.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func BFMAdd(ctx context.Context, client interfaces.BaseClientCommands, key string, items []string) ([]bool, error) {
+	result, err := executeCommand(ctx, client, append([]string{bfMAdd, key}, items...), false)
+	if err != nil {
+		return nil, err
+	}
+	return toBoolArray(result)
+}
+
+// BFExists checks whether an item may have been added to the bloom filter stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the bloom filter.
+//	item - The item to check.
+//
+// Return value:
+//
+//	`true` if the item may have been added, `false` if it was certainly not added or the filter does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func BFExists(ctx context.Context, client interfaces.BaseClientCommands, key string, item string) (bool, error) {
+	result, err := executeCommand(ctx, client, []string{bfExists, key, item}, true)
+	if err != nil {
+		return false, err
+	}
+	return toBool(result)
+}
+
+// BFMExists checks whether items may have been added to the bloom filter stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the bloom filter.
+//	items - The items to check.
+//
+// Return value:
+//
+//	For every item, `true` if it may have been added, `false` if it was certainly not added or the filter does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func BFMExists(ctx context.Context, client interfaces.BaseClientCommands, key string, items []string) ([]bool, error) {
+	result, err := executeCommand(ctx, client, append([]string{bfMExists, key}, items...), true)
+	if err != nil {
+		return nil, err
+	}
+	return toBoolArray(result)
+}
+
+// BFReserve creates an empty bloom filter at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the bloom filter.
+//	errorRate - The expected rate of false positives, between 0 and 1 exclusive.
+//	capacity - The number of items the filter is expected to hold.
+//
+// Return value:
+//
+//	`"OK"` if the filter was created. Fails if `key` already exists.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func BFReserve(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	errorRate float64,
+	capacity int64,
+) (string, error) {
+	return BFReserveWithOptions(ctx, client, key, errorRate, capacity, *NewBFReserveOptions())
+}
+
+// BFReserveWithOptions creates an empty bloom filter at `key`, with the expansion options of `opts`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the bloom filter.
+//	errorRate - The expected rate of false positives, between 0 and 1 exclusive.
+//	capacity - The number of items the filter is expected to hold.
+//	opts - The expansion of the filter, see [BFReserveOptions].
+//
+// Return value:
+//
+//	`"OK"` if the filter was created. Fails if `key` already exists.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func BFReserveWithOptions(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	errorRate float64,
+	capacity int64,
+	opts BFReserveOptions,
+) (string, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return "", err
+	}
+	args := append([]string{bfReserve, key, utils.FloatToString(errorRate), utils.IntToString(capacity)}, optionArgs...)
+	result, err := executeCommand(ctx, client, args, false)
+	if err != nil {
+		return "", err
+	}
+	return toString(result)
+}
+
+// BFInsert adds items to the bloom filter stored at `key`, creating the filter with the options of `opts` if it does not
+// exist.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the bloom filter.
+//	items - The items to add.
+//	opts - The options of the filter if it is created, see [BFInsertOptions].
+//
+// Return value:
+//
+//	For every item, `true` if it was added, `false` if it may already have been added.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func BFInsert(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	items []string,
+	opts BFInsertOptions,
+) ([]bool, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args := append(append([]string{bfInsert, key}, optionArgs...), itemsKeyword)
+	result, err := executeCommand(ctx, client, append(args, items...), false)
+	if err != nil {
+		return nil, err
+	}
+	return toBoolArray(result)
+}
+
+// BFInfo returns details about the bloom filter stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the bloom filter.
+//
+// Return value:
+//
+//	The details of the filter. Fails if the filter does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func BFInfo(ctx context.Context, client interfaces.BaseClientCommands, key string) (BloomFilterInfo, error) {
+	result, err := executeCommand(ctx, client, []string{bfInfo, key}, true)
+	if err != nil {
+		return BloomFilterInfo{}, err
+	}
+	fields, err := toInfoFields(result)
+	if err != nil {
+		return BloomFilterInfo{}, err
+	}
+	return BloomFilterInfo{
+		Capacity:      toInt64(fields["Capacity"]),
+		Size:          toInt64(fields["Size"]),
+		Filters:       toInt64(fields["Number of filters"]),
+		Items:         toInt64(fields["Number of items inserted"]),
+		ExpansionRate: toInt64(fields["Expansion rate"]),
+	}, nil
+}
+
+// executeCommand sends a command of the module with `client`. Read-only commands are hinted so, since the client does not
+// know the commands of the module.
+func executeCommand(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	args []string,
+	readOnly bool,
+) (any, error) {
+	opts := options.CustomCommandOptions{ReadOnlyHint: readOnly}
+	switch client := client.(type) {
+	case *glide.Client:
+		return client.CustomCommandWithOptions(ctx, args, opts)
+	case *glide.ClusterClient:
+		result, err := client.CustomCommandWithOptions(ctx, args, opts)
+		if err != nil {
+			return nil, err
+		}
+		if result.IsMultiValue() {
+			// A map reply of the node of the key, such as the reply of BF.INFO with RESP3
+			return result.MultiValue(), nil
+		}
+		return result.SingleValue(), nil
+	default:
+		return nil, fmt.Errorf("unsupported client type %T", client)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package bloom
+
+import (
+	"context"
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+const (
+	cmsInitByDim  = "CMS.INITBYDIM"
+	cmsInitByProb = "CMS.INITBYPROB"
+	cmsIncrBy     = "CMS.INCRBY"
+	cmsQuery      = "CMS.QUERY"
+	cmsMerge      = "CMS.MERGE"
+	cmsInfo       = "CMS.INFO"
+
+	weightsKeyword = "WEIGHTS"
+)
+
+// CMSIncrement is an increment of the count of an item of a count-min sketch, see [CMSIncrBy].
+type CMSIncrement struct {
+	Item      string
+	Increment int64
+}
+
+// CountMinSketchInfo describes a count-min sketch, as returned by `CMS.INFO`.
+type CountMinSketchInfo struct {
+	// The number of counters in each row.
+	Width int64
+	// The number of rows.
+	Depth int64
+	// The sum of all the increments.
+	Count int64
+}
+
+// CMSInitByDim creates an empty count-min sketch at `key` with the given dimensions.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the sketch.
+//	width - The number of counters in each row. A larger width reduces the overestimation of the counts.
+//	depth - The number of rows. A larger depth reduces the probability of overestimating a count.
+//
+// Return value:
+//
+//	`"OK"` if the sketch was created. Fails if `key` already exists.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CMSInitByDim(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	width int64,
+	depth int64,
+) (string, error) {
+	args := []string{cmsInitByDim, key, utils.IntToString(width), utils.IntToString(depth)}
+	result, err := executeCommand(ctx, client, args, false)
+	if err != nil {
+		return "", err
+	}
+	return toString(result)
+}
+
+// CMSInitByProb creates an empty count-min sketch at `key`, sized for the given error and probability of error.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the sketch.
+//	errorRate - The overestimation of the counts, as a fraction of the sum of all the increments, between 0 and 1
+//	  exclusive.
+//	probability - The probability of a count overestimated by more than `errorRate`, between 0 and 1 exclusive.
+//
+// Return value:
+//
+//	`"OK"` if the sketch was created. Fails if `key` already exists.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CMSInitByProb(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	errorRate float64,
+	probability float64,
+) (string, error) {
+	args := []string{cmsInitByProb, key, utils.FloatToString(errorRate), utils.FloatToString(probability)}
+	result, err := executeCommand(ctx, client, args, false)
+	if err != nil {
+		return "", err
+	}
+	return toString(result)
+}
+
+// CMSIncrBy increments the counts of items in the count-min sketch stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the sketch.
+//	increments - The items and their increments.
+//
+// Return value:
+//
+//	The estimated count of every item after the increments, in the order of `increments`. Fails if the sketch does not
+//	exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CMSIncrBy(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	increments []CMSIncrement,
+) ([]int64, error) {
+	args := make([]string, 0, 2+2*len(increments))
+	args = append(args, cmsIncrBy, key)
+	for _, increment := range increments {
+		args = append(args, increment.Item, utils.IntToString(increment.Increment))
+	}
+	result, err := executeCommand(ctx, client, args, false)
+	if err != nil {
+		return nil, err
+	}
+	return toInt64Array(result)
+}
+
+// CMSQuery returns the estimated counts of items in the count-min sketch stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the sketch.
+//	items - The items to query.
+//
+// Return value:
+//
+//	The estimated count of every item, which may be overestimated but never underestimated. Fails if the sketch does not
+//	exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CMSQuery(ctx context.Context, client interfaces.BaseClientCommands, key string, items []string) ([]int64, error) {
+	result, err := executeCommand(ctx, client, append([]string{cmsQuery, key}, items...), true)
+	if err != nil {
+		return nil, err
+	}
+	return toInt64Array(result)
+}
+
+// CMSMerge merges count-min sketches into the sketch stored at `destination`, which must exist and have the same
+// dimensions as the sources. The counts of the destination are replaced by the sums of the counts of the sources.
+//
+// Note:
+//
+//	In cluster mode, `destination` and `sources` must map to the same hash slot.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	destination - The key of the sketch to merge the sources into.
+//	sources - The keys of the sketches to merge.
+//
+// Return value:
+//
+//	`"OK"` if the sketches were merged.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CMSMerge(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	destination string,
+	sources []string,
+) (string, error) {
+	return CMSMergeWithWeights(ctx, client, destination, sources, nil)
+}
+
+// CMSMergeWithWeights merges count-min sketches into the sketch stored at `destination` like [CMSMerge], multiplying the
+// counts of every source by its weight.
+//
+// Note:
+//
+//	In cluster mode, `destination` and `sources` must map to the same hash slot.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	destination - The key of the sketch to merge the sources into.
+//	sources - The keys of the sketches to merge.
+//	weights - The weight of every source, in the order of `sources`. Must have the same length as `sources`, or be empty
+//	  for a weight of 1.
+//
+// Return value:
+//
+//	`"OK"` if the sketches were merged.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CMSMergeWithWeights(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	destination string,
+	sources []string,
+	weights []int64,
+) (string, error) {
+	if len(weights) != 0 && len(weights) != len(sources) {
+		return "", errors.New("count-min sketch merge weights must match the number of sources")
+	}
+	args := make([]string, 0, 4+2*len(sources))
+	args = append(args, cmsMerge, destination, utils.IntToString(int64(len(sources))))
+	args = append(args, sources...)
+	if len(weights) != 0 {
+		args = append(args, weightsKeyword)
+		for _, weight := range weights {
+			args = append(args, utils.IntToString(weight))
+		}
+	}
+	result, err := executeCommand(ctx, client, args, false)
+	if err != nil {
+		return "", err
+	}
+	return toString(result)
+}
+
+// CMSInfo returns details about the count-min sketch stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the sketch.
+//
+// Return value:
+//
+//	The details of the sketch. Fails if the sketch does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CMSInfo(ctx context.Context, client interfaces.BaseClientCommands, key string) (CountMinSketchInfo, error) {
+	result, err := executeCommand(ctx, client, []string{cmsInfo, key}, true)
+	if err != nil {
+		return CountMinSketchInfo{}, err
+	}
+	fields, err := toInfoFields(result)
+	if err != nil {
+		return CountMinSketchInfo{}, err
+	}
+	return CountMinSketchInfo{
+		Width: toInt64(fields["width"]),
+		Depth: toInt64(fields["depth"]),
+		Count: toInt64(fields["count"]),
+	}, nil
+}
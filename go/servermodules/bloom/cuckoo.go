@@ -0,0 +1,369 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package bloom
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+const (
+	cfAdd      = "CF.ADD"
+	cfAddNX    = "CF.ADDNX"
+	cfExists   = "CF.EXISTS"
+	cfMExists  = "CF.MEXISTS"
+	cfDel      = "CF.DEL"
+	cfCount    = "CF.COUNT"
+	cfReserve  = "CF.RESERVE"
+	cfInsert   = "CF.INSERT"
+	cfInsertNX = "CF.INSERTNX"
+	cfInfo     = "CF.INFO"
+)
+
+// The results of [CFInsertNX] for every item.
+const (
+	// The item was added.
+	CFItemAdded int64 = 1
+	// The item may already have been added, and was not added again.
+	CFItemExists int64 = 0
+	// The item was not added because the filter is full.
+	CFFilterFull int64 = -1
+)
+
+// CuckooFilterInfo describes a cuckoo filter, as returned by `CF.INFO`.
+type CuckooFilterInfo struct {
+	// The memory used by the filter, in bytes.
+	Size int64
+	// The number of buckets.
+	Buckets int64
+	// The number of sub-filters.
+	Filters int64
+	// The number of items added to the filter.
+	ItemsInserted int64
+	// The number of items deleted from the filter.
+	ItemsDeleted int64
+	// The number of items in each bucket.
+	BucketSize int64
+	// The factor by which the capacity grows when the filter is full.
+	ExpansionRate int64
+	// The number of attempts to swap items between buckets before the filter is considered full.
+	MaxIterations int64
+}
+
+// CFAdd adds an item to the cuckoo filter stored at `key`, creating the filter with the default capacity of the server if
+// it does not exist. An item can be added several times.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	item - The item to add.
+//
+// Return value:
+//
+//	`true` if the item was added.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFAdd(ctx context.Context, client interfaces.BaseClientCommands, key string, item string) (bool, error) {
+	result, err := executeCommand(ctx, client, []string{cfAdd, key, item}, false)
+	if err != nil {
+		return false, err
+	}
+	return toBool(result)
+}
+
+// CFAddNX adds an item to the cuckoo filter stored at `key` if it was not already added, creating the filter with the
+// default capacity of the server if it does not exist.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	item - The item to add.
+//
+// Return value:
+//
+//	`true` if the item was added, `false` if it may already have been added.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFAddNX(ctx context.Context, client interfaces.BaseClientCommands, key string, item string) (bool, error) {
+	result, err := executeCommand(ctx, client, []string{cfAddNX, key, item}, false)
+	if err != nil {
+		return false, err
+	}
+	return toBool(result)
+}
+
+// CFExists checks whether an item may have been added to the cuckoo filter stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	item - The item to check.
+//
+// Return value:
+//
+//	`true` if the item may have been added, `false` if it was certainly not added or the filter does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFExists(ctx context.Context, client interfaces.BaseClientCommands, key string, item string) (bool, error) {
+	result, err := executeCommand(ctx, client, []string{cfExists, key, item}, true)
+	if err != nil {
+		return false, err
+	}
+	return toBool(result)
+}
+
+// CFMExists checks whether items may have been added to the cuckoo filter stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	items - The items to check.
+//
+// Return value:
+//
+//	For every item, `true` if it may have been added, `false` if it was certainly not added or the filter does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFMExists(ctx context.Context, client interfaces.BaseClientCommands, key string, items []string) ([]bool, error) {
+	result, err := executeCommand(ctx, client, append([]string{cfMExists, key}, items...), true)
+	if err != nil {
+		return nil, err
+	}
+	return toBoolArray(result)
+}
+
+// CFDel deletes one occurrence of an item from the cuckoo filter stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	item - The item to delete.
+//
+// Return value:
+//
+//	`true` if the item was deleted, `false` if it was not found. Fails if the filter does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFDel(ctx context.Context, client interfaces.BaseClientCommands, key string, item string) (bool, error) {
+	result, err := executeCommand(ctx, client, []string{cfDel, key, item}, false)
+	if err != nil {
+		return false, err
+	}
+	return toBool(result)
+}
+
+// CFCount returns an estimate of the number of times an item was added to the cuckoo filter stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	item - The item to count.
+//
+// Return value:
+//
+//	The estimated number of occurrences of the item, or 0 if the filter does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFCount(ctx context.Context, client interfaces.BaseClientCommands, key string, item string) (int64, error) {
+	result, err := executeCommand(ctx, client, []string{cfCount, key, item}, true)
+	if err != nil {
+		return 0, err
+	}
+	return toInt64Strict(result)
+}
+
+// CFReserve creates an empty cuckoo filter at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	capacity - The number of items the filter is expected to hold.
+//
+// Return value:
+//
+//	`"OK"` if the filter was created. Fails if `key` already exists.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFReserve(ctx context.Context, client interfaces.BaseClientCommands, key string, capacity int64) (string, error) {
+	return CFReserveWithOptions(ctx, client, key, capacity, *NewCFReserveOptions())
+}
+
+// CFReserveWithOptions creates an empty cuckoo filter at `key`, with the bucket and expansion options of `opts`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	capacity - The number of items the filter is expected to hold.
+//	opts - The buckets and expansion of the filter, see [CFReserveOptions].
+//
+// Return value:
+//
+//	`"OK"` if the filter was created. Fails if `key` already exists.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFReserveWithOptions(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	capacity int64,
+	opts CFReserveOptions,
+) (string, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return "", err
+	}
+	args := append([]string{cfReserve, key, utils.IntToString(capacity)}, optionArgs...)
+	result, err := executeCommand(ctx, client, args, false)
+	if err != nil {
+		return "", err
+	}
+	return toString(result)
+}
+
+// CFInsert adds items to the cuckoo filter stored at `key`, creating the filter with the options of `opts` if it does not
+// exist.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	items - The items to add.
+//	opts - The options of the filter if it is created, see [CFInsertOptions].
+//
+// Return value:
+//
+//	For every item, `true` if it was added, `false` if the filter is full.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFInsert(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	items []string,
+	opts CFInsertOptions,
+) ([]bool, error) {
+	result, err := executeInsert(ctx, client, cfInsert, key, items, opts)
+	if err != nil {
+		return nil, err
+	}
+	// CF.INSERT returns -1 for the items that could not be added because the filter is full.
+	results, err := toInt64Array(result)
+	if err != nil {
+		return nil, err
+	}
+	added := make([]bool, len(results))
+	for i, result := range results {
+		added[i] = result == CFItemAdded
+	}
+	return added, nil
+}
+
+// CFInsertNX adds items that were not already added to the cuckoo filter stored at `key`, creating the filter with the
+// options of `opts` if it does not exist.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//	items - The items to add.
+//	opts - The options of the filter if it is created, see [CFInsertOptions].
+//
+// Return value:
+//
+//	For every item, [CFItemAdded], [CFItemExists] or [CFFilterFull].
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFInsertNX(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	items []string,
+	opts CFInsertOptions,
+) ([]int64, error) {
+	result, err := executeInsert(ctx, client, cfInsertNX, key, items, opts)
+	if err != nil {
+		return nil, err
+	}
+	return toInt64Array(result)
+}
+
+// CFInfo returns details about the cuckoo filter stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the cuckoo filter.
+//
+// Return value:
+//
+//	The details of the filter. Fails if the filter does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func CFInfo(ctx context.Context, client interfaces.BaseClientCommands, key string) (CuckooFilterInfo, error) {
+	result, err := executeCommand(ctx, client, []string{cfInfo, key}, true)
+	if err != nil {
+		return CuckooFilterInfo{}, err
+	}
+	fields, err := toInfoFields(result)
+	if err != nil {
+		return CuckooFilterInfo{}, err
+	}
+	return CuckooFilterInfo{
+		Size:          toInt64(fields["Size"]),
+		Buckets:       toInt64(fields["Number of buckets"]),
+		Filters:       toInt64(fields["Number of filters"]),
+		ItemsInserted: toInt64(fields["Number of items inserted"]),
+		ItemsDeleted:  toInt64(fields["Number of items deleted"]),
+		BucketSize:    toInt64(fields["Bucket size"]),
+		ExpansionRate: toInt64(fields["Expansion rate"]),
+		MaxIterations: toInt64(fields["Max iterations"]),
+	}, nil
+}
+
+func executeInsert(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	command string,
+	key string,
+	items []string,
+	opts CFInsertOptions,
+) (any, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args := append(append([]string{command, key}, optionArgs...), itemsKeyword)
+	return executeCommand(ctx, client, append(args, items...), false)
+}
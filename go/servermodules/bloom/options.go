@@ -0,0 +1,227 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package bloom
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+const (
+	capacityKeyword      = "CAPACITY"
+	errorKeyword         = "ERROR"
+	expansionKeyword     = "EXPANSION"
+	noCreateKeyword      = "NOCREATE"
+	nonScalingKeyword    = "NONSCALING"
+	bucketSizeKeyword    = "BUCKETSIZE"
+	maxIterationsKeyword = "MAXITERATIONS"
+	itemsKeyword         = "ITEMS"
+)
+
+// BFReserveOptions are the optional arguments of [BFReserveWithOptions].
+type BFReserveOptions struct {
+	// The factor by which the capacity grows when the filter is full and a new sub-filter is added. Uses the default
+	// of the server if zero.
+	Expansion int64
+	// Prevents the filter from growing: adding items to a full filter fails.
+	NonScaling bool
+}
+
+// NewBFReserveOptions creates a new [BFReserveOptions].
+func NewBFReserveOptions() *BFReserveOptions {
+	return &BFReserveOptions{}
+}
+
+// SetExpansion sets the factor by which the capacity grows when the filter is full.
+func (opts *BFReserveOptions) SetExpansion(expansion int64) *BFReserveOptions {
+	opts.Expansion = expansion
+	return opts
+}
+
+// SetNonScaling prevents the filter from growing when it is full.
+func (opts *BFReserveOptions) SetNonScaling(nonScaling bool) *BFReserveOptions {
+	opts.NonScaling = nonScaling
+	return opts
+}
+
+func (opts *BFReserveOptions) ToArgs() ([]string, error) {
+	if opts.Expansion != 0 && opts.NonScaling {
+		return nil, errors.New("bloom filter expansion cannot be set for a non-scaling filter")
+	}
+	args := []string{}
+	if opts.Expansion != 0 {
+		args = append(args, expansionKeyword, utils.IntToString(opts.Expansion))
+	}
+	if opts.NonScaling {
+		args = append(args, nonScalingKeyword)
+	}
+	return args, nil
+}
+
+// BFInsertOptions are the optional arguments of [BFInsert]. The capacity, error rate, expansion and non-scaling options
+// only apply when the filter is created by the command.
+type BFInsertOptions struct {
+	// The number of items the filter is expected to hold. Uses the default of the server if zero.
+	Capacity int64
+	// The expected rate of false positives, between 0 and 1 exclusive. Uses the default of the server if zero.
+	ErrorRate float64
+	// The factor by which the capacity grows when the filter is full. Uses the default of the server if zero.
+	Expansion int64
+	// Fails instead of creating the filter if it does not exist.
+	NoCreate bool
+	// Prevents the filter from growing: adding items to a full filter fails.
+	NonScaling bool
+}
+
+// NewBFInsertOptions creates a new [BFInsertOptions].
+func NewBFInsertOptions() *BFInsertOptions {
+	return &BFInsertOptions{}
+}
+
+// SetCapacity sets the number of items the filter is expected to hold when it is created.
+func (opts *BFInsertOptions) SetCapacity(capacity int64) *BFInsertOptions {
+	opts.Capacity = capacity
+	return opts
+}
+
+// SetErrorRate sets the expected rate of false positives of the filter when it is created.
+func (opts *BFInsertOptions) SetErrorRate(errorRate float64) *BFInsertOptions {
+	opts.ErrorRate = errorRate
+	return opts
+}
+
+// SetExpansion sets the factor by which the capacity grows when the filter is full.
+func (opts *BFInsertOptions) SetExpansion(expansion int64) *BFInsertOptions {
+	opts.Expansion = expansion
+	return opts
+}
+
+// SetNoCreate makes the command fail instead of creating the filter if it does not exist.
+func (opts *BFInsertOptions) SetNoCreate(noCreate bool) *BFInsertOptions {
+	opts.NoCreate = noCreate
+	return opts
+}
+
+// SetNonScaling prevents the filter from growing when it is full.
+func (opts *BFInsertOptions) SetNonScaling(nonScaling bool) *BFInsertOptions {
+	opts.NonScaling = nonScaling
+	return opts
+}
+
+func (opts *BFInsertOptions) ToArgs() ([]string, error) {
+	if opts.NoCreate && (opts.Capacity != 0 || opts.ErrorRate != 0) {
+		return nil, errors.New("bloom filter capacity and error rate cannot be set with NOCREATE")
+	}
+	if opts.Expansion != 0 && opts.NonScaling {
+		return nil, errors.New("bloom filter expansion cannot be set for a non-scaling filter")
+	}
+	if opts.ErrorRate < 0 || opts.ErrorRate >= 1 {
+		return nil, errors.New("bloom filter error rate must be between 0 and 1 exclusive")
+	}
+	args := []string{}
+	if opts.Capacity != 0 {
+		args = append(args, capacityKeyword, utils.IntToString(opts.Capacity))
+	}
+	if opts.ErrorRate != 0 {
+		args = append(args, errorKeyword, utils.FloatToString(opts.ErrorRate))
+	}
+	if opts.Expansion != 0 {
+		args = append(args, expansionKeyword, utils.IntToString(opts.Expansion))
+	}
+	if opts.NoCreate {
+		args = append(args, noCreateKeyword)
+	}
+	if opts.NonScaling {
+		args = append(args, nonScalingKeyword)
+	}
+	return args, nil
+}
+
+// CFReserveOptions are the optional arguments of [CFReserveWithOptions].
+type CFReserveOptions struct {
+	// The number of items in each bucket. Uses the default of the server if zero.
+	BucketSize int64
+	// The number of attempts to swap items between buckets before the filter is considered full. Uses the default of
+	// the server if zero.
+	MaxIterations int64
+	// The factor by which the capacity grows when the filter is full. Uses the default of the server if zero.
+	Expansion int64
+}
+
+// NewCFReserveOptions creates a new [CFReserveOptions].
+func NewCFReserveOptions() *CFReserveOptions {
+	return &CFReserveOptions{}
+}
+
+// SetBucketSize sets the number of items in each bucket.
+func (opts *CFReserveOptions) SetBucketSize(bucketSize int64) *CFReserveOptions {
+	opts.BucketSize = bucketSize
+	return opts
+}
+
+// SetMaxIterations sets the number of attempts to swap items between buckets before the filter is considered full.
+func (opts *CFReserveOptions) SetMaxIterations(maxIterations int64) *CFReserveOptions {
+	opts.MaxIterations = maxIterations
+	return opts
+}
+
+// SetExpansion sets the factor by which the capacity grows when the filter is full.
+func (opts *CFReserveOptions) SetExpansion(expansion int64) *CFReserveOptions {
+	opts.Expansion = expansion
+	return opts
+}
+
+func (opts *CFReserveOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if opts.BucketSize != 0 {
+		args = append(args, bucketSizeKeyword, utils.IntToString(opts.BucketSize))
+	}
+	if opts.MaxIterations != 0 {
+		args = append(args, maxIterationsKeyword, utils.IntToString(opts.MaxIterations))
+	}
+	if opts.Expansion != 0 {
+		args = append(args, expansionKeyword, utils.IntToString(opts.Expansion))
+	}
+	return args, nil
+}
+
+// CFInsertOptions are the optional arguments of [CFInsert] and [CFInsertNX].
+type CFInsertOptions struct {
+	// The number of items the filter is expected to hold, if the filter is created by the command. Uses the default of
+	// the server if zero.
+	Capacity int64
+	// Fails instead of creating the filter if it does not exist.
+	NoCreate bool
+}
+
+// NewCFInsertOptions creates a new [CFInsertOptions].
+func NewCFInsertOptions() *CFInsertOptions {
+	return &CFInsertOptions{}
+}
+
+// SetCapacity sets the number of items the filter is expected to hold when it is created.
+func (opts *CFInsertOptions) SetCapacity(capacity int64) *CFInsertOptions {
+	opts.Capacity = capacity
+	return opts
+}
+
+// SetNoCreate makes the command fail instead of creating the filter if it does not exist.
+func (opts *CFInsertOptions) SetNoCreate(noCreate bool) *CFInsertOptions {
+	opts.NoCreate = noCreate
+	return opts
+}
+
+func (opts *CFInsertOptions) ToArgs() ([]string, error) {
+	if opts.NoCreate && opts.Capacity != 0 {
+		return nil, errors.New("cuckoo filter capacity cannot be set with NOCREATE")
+	}
+	args := []string{}
+	if opts.Capacity != 0 {
+		args = append(args, capacityKeyword, utils.IntToString(opts.Capacity))
+	}
+	if opts.NoCreate {
+		args = append(args, noCreateKeyword)
+	}
+	return args, nil
+}
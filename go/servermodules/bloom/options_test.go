@@ -0,0 +1,74 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package bloom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBFReserveOptions(t *testing.T) {
+	args, err := NewBFReserveOptions().SetExpansion(4).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"EXPANSION", "4"}, args)
+
+	args, err = NewBFReserveOptions().SetNonScaling(true).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"NONSCALING"}, args)
+
+	_, err = NewBFReserveOptions().SetExpansion(4).SetNonScaling(true).ToArgs()
+	assert.Error(t, err)
+}
+
+func TestBFInsertOptions(t *testing.T) {
+	args, err := NewBFInsertOptions().SetCapacity(1000).SetErrorRate(0.01).SetExpansion(2).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CAPACITY", "1000", "ERROR", "0.01", "EXPANSION", "2"}, args)
+
+	args, err = NewBFInsertOptions().SetNoCreate(true).SetNonScaling(true).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"NOCREATE", "NONSCALING"}, args)
+
+	_, err = NewBFInsertOptions().SetNoCreate(true).SetCapacity(1000).ToArgs()
+	assert.Error(t, err)
+	_, err = NewBFInsertOptions().SetErrorRate(1).ToArgs()
+	assert.Error(t, err)
+}
+
+func TestCFOptions(t *testing.T) {
+	args, err := NewCFReserveOptions().SetBucketSize(4).SetMaxIterations(20).SetExpansion(2).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BUCKETSIZE", "4", "MAXITERATIONS", "20", "EXPANSION", "2"}, args)
+
+	args, err = NewCFInsertOptions().SetCapacity(1000).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CAPACITY", "1000"}, args)
+
+	_, err = NewCFInsertOptions().SetNoCreate(true).SetCapacity(1000).ToArgs()
+	assert.Error(t, err)
+}
+
+func TestToInfoFields(t *testing.T) {
+	expected := map[string]any{"Capacity": int64(100), "Expansion rate": nil}
+
+	fields, err := toInfoFields([]any{"Capacity", int64(100), "Expansion rate", nil})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, fields)
+
+	fields, err = toInfoFields(expected)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, fields)
+
+	_, err = toInfoFields([]any{"Capacity"})
+	assert.Error(t, err)
+}
+
+func TestTopKReserveOptions(t *testing.T) {
+	args, err := NewTopKReserveOptions(50, 5, 0.9).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"50", "5", "0.9"}, args)
+
+	_, err = NewTopKReserveOptions(50, 5, 1.5).ToArgs()
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package bloom
+
+import (
+	"fmt"
+)
+
+func toBool(result any) (bool, error) {
+	switch result := result.(type) {
+	case int64:
+		return result == 1, nil
+	case bool:
+		return result, nil
+	default:
+		return false, fmt.Errorf("unexpected response type %T, expected an integer", result)
+	}
+}
+
+func toBoolArray(result any) ([]bool, error) {
+	array, ok := result.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T, expected an array", result)
+	}
+	values := make([]bool, len(array))
+	for i, element := range array {
+		value, err := toBool(element)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func toInt64Strict(result any) (int64, error) {
+	value, ok := result.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected response type %T, expected an integer", result)
+	}
+	return value, nil
+}
+
+func toInt64Array(result any) ([]int64, error) {
+	array, ok := result.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T, expected an array", result)
+	}
+	values := make([]int64, len(array))
+	for i, element := range array {
+		value, err := toInt64Strict(element)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func toString(result any) (string, error) {
+	value, ok := result.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected response type %T, expected a string", result)
+	}
+	return value, nil
+}
+
+// toInt64 converts a field of an info reply, returning 0 for a missing or nil field, e.g. the expansion rate of a
+// non-scaling filter.
+func toInt64(field any) int64 {
+	switch field := field.(type) {
+	case int64:
+		return field
+	case float64:
+		return int64(field)
+	default:
+		return 0
+	}
+}
+
+// toInfoFields converts an info reply to a map of fields. The reply is a map with RESP3, and an array of alternating
+// field names and values with RESP2.
+func toInfoFields(result any) (map[string]any, error) {
+	switch result := result.(type) {
+	case map[string]any:
+		return result, nil
+	case []any:
+		if len(result)%2 != 0 {
+			return nil, fmt.Errorf("unexpected info response of odd length %d", len(result))
+		}
+		fields := make(map[string]any, len(result)/2)
+		for i := 0; i < len(result); i += 2 {
+			name, ok := result[i].(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected info field name type %T, expected a string", result[i])
+			}
+			fields[name] = result[i+1]
+		}
+		return fields, nil
+	default:
+		return nil, fmt.Errorf("unexpected response type %T, expected a map or an array", result)
+	}
+}
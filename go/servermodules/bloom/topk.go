@@ -0,0 +1,244 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package bloom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+const (
+	topKReserve = "TOPK.RESERVE"
+	topKAdd     = "TOPK.ADD"
+	topKQuery   = "TOPK.QUERY"
+	topKList    = "TOPK.LIST"
+
+	withCountKeyword = "WITHCOUNT"
+)
+
+// TopKReserveOptions are the optional arguments of [TopKReserveWithOptions]. The width, depth and decay must be set
+// together.
+type TopKReserveOptions struct {
+	// The number of counters in each row.
+	Width int64
+	// The number of rows.
+	Depth int64
+	// The probability of decrementing the counter of another item sharing a counter, between 0 and 1 inclusive.
+	Decay float64
+}
+
+// NewTopKReserveOptions creates a new [TopKReserveOptions] with the given width, depth and decay.
+func NewTopKReserveOptions(width int64, depth int64, decay float64) *TopKReserveOptions {
+	return &TopKReserveOptions{Width: width, Depth: depth, Decay: decay}
+}
+
+func (opts *TopKReserveOptions) ToArgs() ([]string, error) {
+	if opts.Decay < 0 || opts.Decay > 1 {
+		return nil, fmt.Errorf("top-k decay must be between 0 and 1 inclusive, got %v", opts.Decay)
+	}
+	return []string{utils.IntToString(opts.Width), utils.IntToString(opts.Depth), utils.FloatToString(opts.Decay)}, nil
+}
+
+// TopKItem is an item of a top-k list and its estimated count, see [TopKListWithCount].
+type TopKItem struct {
+	Item  string
+	Count int64
+}
+
+// TopKReserve creates an empty top-k list at `key`, keeping the `topK` most frequent items, with the default width, depth
+// and decay of the server.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the top-k list.
+//	topK - The number of most frequent items to keep.
+//
+// Return value:
+//
+//	`"OK"` if the list was created. Fails if `key` already exists.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func TopKReserve(ctx context.Context, client interfaces.BaseClientCommands, key string, topK int64) (string, error) {
+	result, err := executeCommand(ctx, client, []string{topKReserve, key, utils.IntToString(topK)}, false)
+	if err != nil {
+		return "", err
+	}
+	return toString(result)
+}
+
+// TopKReserveWithOptions creates an empty top-k list at `key` like [TopKReserve], with the width, depth and decay of
+// `opts`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the top-k list.
+//	topK - The number of most frequent items to keep.
+//	opts - The dimensions and decay of the list, see [TopKReserveOptions].
+//
+// Return value:
+//
+//	`"OK"` if the list was created. Fails if `key` already exists.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func TopKReserveWithOptions(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	topK int64,
+	opts TopKReserveOptions,
+) (string, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return "", err
+	}
+	args := append([]string{topKReserve, key, utils.IntToString(topK)}, optionArgs...)
+	result, err := executeCommand(ctx, client, args, false)
+	if err != nil {
+		return "", err
+	}
+	return toString(result)
+}
+
+// TopKAdd adds items to the top-k list stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the top-k list.
+//	items - The items to add.
+//
+// Return value:
+//
+//	For every item, the item it expelled from the list, or [models.CreateNilStringResult()] if no item was expelled.
+//	Fails if the list does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func TopKAdd(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	items []string,
+) ([]models.Result[string], error) {
+	result, err := executeCommand(ctx, client, append([]string{topKAdd, key}, items...), false)
+	if err != nil {
+		return nil, err
+	}
+	array, ok := result.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T, expected an array", result)
+	}
+	expelled := make([]models.Result[string], len(array))
+	for i, element := range array {
+		switch element := element.(type) {
+		case nil:
+			expelled[i] = models.CreateNilStringResult()
+		case string:
+			expelled[i] = models.CreateStringResult(element)
+		default:
+			return nil, fmt.Errorf("unexpected response element type %T, expected a string", element)
+		}
+	}
+	return expelled, nil
+}
+
+// TopKQuery checks whether items are in the top-k list stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the top-k list.
+//	items - The items to check.
+//
+// Return value:
+//
+//	For every item, `true` if it is one of the most frequent items. Fails if the list does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func TopKQuery(ctx context.Context, client interfaces.BaseClientCommands, key string, items []string) ([]bool, error) {
+	result, err := executeCommand(ctx, client, append([]string{topKQuery, key}, items...), true)
+	if err != nil {
+		return nil, err
+	}
+	return toBoolArray(result)
+}
+
+// TopKList returns the most frequent items of the top-k list stored at `key`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the top-k list.
+//
+// Return value:
+//
+//	The most frequent items, from the most to the least frequent. Fails if the list does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func TopKList(ctx context.Context, client interfaces.BaseClientCommands, key string) ([]string, error) {
+	result, err := executeCommand(ctx, client, []string{topKList, key}, true)
+	if err != nil {
+		return nil, err
+	}
+	array, ok := result.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T, expected an array", result)
+	}
+	items := make([]string, len(array))
+	for i, element := range array {
+		if items[i], err = toString(element); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// TopKListWithCount returns the most frequent items of the top-k list stored at `key`, with their estimated counts.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client to execute the command, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the top-k list.
+//
+// Return value:
+//
+//	The most frequent items and their counts, from the most to the least frequent. Fails if the list does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func TopKListWithCount(ctx context.Context, client interfaces.BaseClientCommands, key string) ([]TopKItem, error) {
+	result, err := executeCommand(ctx, client, []string{topKList, key, withCountKeyword}, true)
+	if err != nil {
+		return nil, err
+	}
+	array, ok := result.([]any)
+	if !ok || len(array)%2 != 0 {
+		return nil, fmt.Errorf("unexpected response %v, expected an array of items and counts", result)
+	}
+	items := make([]TopKItem, len(array)/2)
+	for i := range items {
+		if items[i].Item, err = toString(array[2*i]); err != nil {
+			return nil, err
+		}
+		if items[i].Count, err = toInt64Strict(array[2*i+1]); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
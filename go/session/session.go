@@ -0,0 +1,165 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package session provides a store of web sessions in Valkey, whose expiration slides every time a session is used.
+package session
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Store stores sessions in Valkey, each in a hash whose fields are the data of the session.
+//
+// On Valkey 9.0 and above, the time to live of a session is set on the fields of its hash with `HSETEX` and `HPEXPIRE`,
+// so that a field saved with a shorter time to live than the rest of the session expires on its own. Older servers do
+// not support the expiration of hash fields: the time to live is set on the key of the hash instead, and applies to the
+// whole session. The store detects which one the server supports on the first command that sets a time to live.
+//
+// Example:
+//
+//	store := session.New(client, "session:")
+//	err := store.Save(ctx, sessionID, map[string]string{"user": "alice"}, 30*time.Minute)
+//	...
+//	// On every request of the session, extend its expiration
+//	data, err := store.Load(ctx, sessionID)
+//	found, err := store.Touch(ctx, sessionID, 30*time.Minute)
+type Store struct {
+	client interfaces.BaseClientCommands
+	prefix string
+	// Set once the server rejected a command expiring hash fields.
+	keyExpiration atomic.Bool
+}
+
+// New creates a [Store] whose sessions are stored at `prefix` followed by their ID.
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	prefix - The prefix of the keys of the sessions, e.g. "session:".
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands, prefix string) *Store {
+	return &Store{client: client, prefix: prefix}
+}
+
+// Key returns the key a session is stored at.
+func (s *Store) Key(sessionID string) string {
+	return s.prefix + sessionID
+}
+
+// Save stores `data` in a session, and sets its time to live. The fields of `data` are merged into the session if it
+// already exists, the other fields of the session are left unchanged.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sessionID - The ID of the session.
+//	data - The fields to store in the session.
+//	ttl - The time to live of the session, at least a millisecond.
+//
+// Return value:
+//
+//	An error if the session could not be saved.
+func (s *Store) Save(ctx context.Context, sessionID string, data map[string]string, ttl time.Duration) error {
+	if len(data) == 0 {
+		_, err := s.Touch(ctx, sessionID, ttl)
+		return err
+	}
+	key := s.Key(sessionID)
+	if !s.keyExpiration.Load() {
+		_, err := s.client.HSetEx(ctx, key, data, options.NewHSetExOptions().SetExpiry(options.NewExpiryIn(ttl)))
+		if !s.isFieldExpirationUnsupported(err) {
+			return err
+		}
+	}
+	if _, err := s.client.HSet(ctx, key, data); err != nil {
+		return err
+	}
+	_, err := s.client.PExpire(ctx, key, ttl)
+	return err
+}
+
+// Load returns the data of a session.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sessionID - The ID of the session.
+//
+// Return value:
+//
+//	The fields of the session, and false if the session does not exist or expired.
+func (s *Store) Load(ctx context.Context, sessionID string) (map[string]string, bool, error) {
+	data, err := s.client.HGetAll(ctx, s.Key(sessionID))
+	if err != nil {
+		return nil, false, err
+	}
+	return data, len(data) > 0, nil
+}
+
+// Touch resets the time to live of a session, typically on every request of the session, so that it only expires once
+// it was not used for `ttl`. On Valkey 9.0 and above, the time to live of every field of the session is reset.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sessionID - The ID of the session.
+//	ttl - The time to live of the session, at least a millisecond.
+//
+// Return value:
+//
+//	false if the session does not exist or expired.
+func (s *Store) Touch(ctx context.Context, sessionID string, ttl time.Duration) (bool, error) {
+	key := s.Key(sessionID)
+	if !s.keyExpiration.Load() {
+		fields, err := s.client.HKeys(ctx, key)
+		if err != nil || len(fields) == 0 {
+			return false, err
+		}
+		results, err := s.client.HPExpire(ctx, key, ttl, fields, options.NewHExpireOptions())
+		if !s.isFieldExpirationUnsupported(err) {
+			if err != nil {
+				return false, err
+			}
+			// Fields that expired since HKEYS are reported as missing, with -2.
+			for _, result := range results {
+				if result != -2 {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+	}
+	return s.client.PExpire(ctx, key, ttl)
+}
+
+// Destroy deletes a session.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sessionID - The ID of the session.
+//
+// Return value:
+//
+//	false if the session did not exist.
+func (s *Store) Destroy(ctx context.Context, sessionID string) (bool, error) {
+	deleted, err := s.client.Del(ctx, []string{s.Key(sessionID)})
+	return deleted > 0, err
+}
+
+// isFieldExpirationUnsupported returns true if `err` reports that the server does not know a command expiring hash
+// fields, i.e. the time to live must be set on the key instead. The store then uses the key expiration from now on.
+func (s *Store) isFieldExpirationUnsupported(err error) bool {
+	if err == nil || !strings.Contains(strings.ToLower(err.Error()), "unknown command") {
+		return false
+	}
+	s.keyExpiration.Store(true)
+	return true
+}
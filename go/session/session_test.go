@@ -0,0 +1,95 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+// newFakeClient creates a fake client reading the time from `fake`. HSETEX and HPEXPIRE are rejected as unknown commands
+// if `noFieldExpiration` is set, as on servers older than 9.0.
+func newFakeClient(fake *clock.Fake, noFieldExpiration bool) *fakeclient.Client {
+	client := fakeclient.New().WithClock(fake)
+	if noFieldExpiration {
+		client.Failures["HSETEX"] = errors.New("ERR unknown command 'HSETEX'")
+		client.Failures["HPEXPIRE"] = errors.New("ERR unknown command 'HPEXPIRE'")
+	}
+	return client
+}
+
+func TestStore_FieldExpiration(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	client := newFakeClient(fake, false)
+	store := New(client, "session:")
+
+	require.NoError(t, store.Save(ctx, "abc", map[string]string{"user": "alice", "role": "admin"}, time.Minute))
+	expireAt := fake.Now().Add(time.Minute)
+	assert.Equal(t, map[string]time.Time{"user": expireAt, "role": expireAt}, client.FieldExpiries["session:abc"])
+	assert.Empty(t, client.Expiries)
+
+	data, found, err := store.Load(ctx, "abc")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, map[string]string{"user": "alice", "role": "admin"}, data)
+
+	found, err = store.Touch(ctx, "abc", 2*time.Minute)
+	require.NoError(t, err)
+	assert.True(t, found)
+	expireAt = fake.Now().Add(2 * time.Minute)
+	assert.Equal(t, map[string]time.Time{"user": expireAt, "role": expireAt}, client.FieldExpiries["session:abc"])
+
+	found, err = store.Touch(ctx, "missing", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	destroyed, err := store.Destroy(ctx, "abc")
+	require.NoError(t, err)
+	assert.True(t, destroyed)
+	_, found, err = store.Load(ctx, "abc")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStore_KeyExpirationFallback(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	client := newFakeClient(fake, true)
+	store := New(client, "session:")
+
+	require.NoError(t, store.Save(ctx, "abc", map[string]string{"user": "alice"}, time.Minute))
+	assert.True(t, store.keyExpiration.Load())
+	assert.Equal(t, map[string]string{"user": "alice"}, client.Hashes["session:abc"])
+	assert.Equal(t, fake.Now().Add(time.Minute), client.Expiries["session:abc"])
+
+	found, err := store.Touch(ctx, "abc", 2*time.Minute)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, fake.Now().Add(2*time.Minute), client.Expiries["session:abc"])
+
+	found, err = store.Touch(ctx, "missing", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStore_TouchDetectsKeyExpiration(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000))
+	client := newFakeClient(fake, true)
+	client.Hashes["session:abc"] = map[string]string{"user": "alice"}
+	store := New(client, "session:")
+
+	found, err := store.Touch(ctx, "abc", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, store.keyExpiration.Load())
+	assert.Equal(t, fake.Now().Add(time.Minute), client.Expiries["session:abc"])
+}
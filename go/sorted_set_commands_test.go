@@ -39,6 +39,38 @@ func ExampleClusterClient_ZAdd() {
 	// Output: 3
 }
 
+func ExampleClient_ZAddBulk() {
+	var client *Client = getExampleClient() // example helper function
+
+	members := make([]models.MemberAndScore, 0, 2500)
+	for i := 0; i < 2500; i++ {
+		members = append(members, models.MemberAndScore{Member: fmt.Sprintf("doc:%d", i), Score: float64(i)})
+	}
+	result, err := client.ZAddBulk(context.Background(), "index", models.NewMemberAndScoreIterator(members), 1000)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Written, result.Added, result.Failed)
+
+	// Output: 2500 2500 0
+}
+
+func ExampleClusterClient_ZAddBulk() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	members := make([]models.MemberAndScore, 0, 2500)
+	for i := 0; i < 2500; i++ {
+		members = append(members, models.MemberAndScore{Member: fmt.Sprintf("doc:%d", i), Score: float64(i)})
+	}
+	result, err := client.ZAddBulk(context.Background(), "index", models.NewMemberAndScoreIterator(members), 1000)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Written, result.Added, result.Failed)
+
+	// Output: 2500 2500 0
+}
+
 func ExampleClient_ZAddWithOptions() {
 	var client *Client = getExampleClient() // example helper function
 
@@ -491,6 +523,33 @@ func ExampleClusterClient_ZRangeWithScores() {
 	// [{two 2} {one 1}]
 }
 
+func ExampleZRangeWithScoresIterator() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0, "five": 5.0},
+	)
+	iter, err := NewZRangeWithScoresIterator(client, "key1", options.NewRangeByIndexQuery(0, -1), 2)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for iter.HasNext() {
+		page, err := iter.Next(context.Background())
+		if err != nil {
+			fmt.Println("Glide example failed with an error: ", err)
+			break
+		}
+		fmt.Println(page)
+	}
+
+	// Output:
+	// [{one 1} {two 2}]
+	// [{three 3} {four 4}]
+	// [{five 5}]
+}
+
 func ExampleClient_ZRangeStore() {
 	var client *Client = getExampleClient() // example helper function
 
@@ -1142,7 +1201,7 @@ func ExampleClient_ZMScore() {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
-	// If the key does not exist, an empty string is returned
+	// The score of a member that is not in the sorted set is a nil result
 	fmt.Println(result)
 
 	// Output: [{3 false} {2.5 false} {0 true}]
@@ -1157,7 +1216,7 @@ func ExampleClusterClient_ZMScore() {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
-	// If the key does not exist, an empty string is returned
+	// The score of a member that is not in the sorted set is a nil result
 	fmt.Println(result)
 
 	// Output: [{3 false} {2.5 false} {0 true}]
@@ -1719,7 +1778,7 @@ func ExampleClient_BZPopMax() {
 	client.ZAdd(context.Background(), "mySortedSet", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0})
 
 	// Pop the highest-score member
-	res, err := client.BZPopMax(context.Background(), []string{"mySortedSet"}, 1.0)
+	res, err := client.BZPopMax(context.Background(), []string{"mySortedSet"}, time.Second)
 	if err != nil {
 		fmt.Println("Glide example failed with an error:", err)
 		return
@@ -1736,7 +1795,7 @@ func ExampleClusterClient_BZPopMax() {
 
 	client.ZAdd(context.Background(), "{key}SortedSet", map[string]float64{"x": 5.0, "y": 6.0, "z": 7.0})
 
-	res, err := client.BZPopMax(context.Background(), []string{"{key}SortedSet"}, 1.0)
+	res, err := client.BZPopMax(context.Background(), []string{"{key}SortedSet"}, time.Second)
 	if err != nil {
 		fmt.Println("Glide example failed with an error:", err)
 		return
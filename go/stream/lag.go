@@ -0,0 +1,253 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package stream provides tools to operate streams and their consumer groups, such as a report of the lag of the groups
+// and their consumers, a watcher alerting when the lag exceeds thresholds, and a trimmer enforcing retention policies.
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// Report is the lag of the consumer groups of a stream, returned by [LagReport].
+type Report struct {
+	// The key of the stream.
+	Key string
+	// The number of entries of the stream.
+	Length int64
+	// The consumer groups of the stream, in the order returned by `XINFO GROUPS`.
+	Groups []GroupLag
+}
+
+// GroupLag is the lag of a consumer group.
+type GroupLag struct {
+	// The name of the group.
+	Name string
+	// The number of entries of the stream not delivered to the group yet, or -1 if the server cannot tell, e.g. before
+	// Valkey 7.0 or after entries were deleted from the stream.
+	Lag int64
+	// The number of entries delivered to the group, or -1 if the server cannot tell.
+	EntriesRead int64
+	// The ID of the last entry delivered to the group.
+	LastDeliveredID string
+	// The number of entries delivered to the consumers of the group but not acknowledged yet.
+	Pending int64
+	// The consumers of the group, in the order returned by `XINFO CONSUMERS`.
+	Consumers []ConsumerLag
+}
+
+// ConsumerLag is the state of a consumer of a group.
+type ConsumerLag struct {
+	// The name of the consumer.
+	Name string
+	// The number of entries delivered to the consumer but not acknowledged yet.
+	Pending int64
+	// The time since the last attempted interaction of the consumer, e.g. `XREADGROUP`.
+	Idle time.Duration
+	// The time since the last successful interaction of the consumer, or -1 before Valkey 7.2.
+	Inactive time.Duration
+}
+
+// LagReport reports the lag of the consumer groups of the stream at `key`: the entries not delivered to every group yet,
+// and the entries delivered to every consumer but not acknowledged yet, with `XLEN`, `XINFO GROUPS`, and `XINFO CONSUMERS`
+// for every group.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the stream.
+//
+// Return value:
+//
+//	The lag of the groups of the stream. An error if the stream does not exist.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func LagReport(ctx context.Context, client interfaces.BaseClientCommands, key string) (Report, error) {
+	groups, err := client.XInfoGroups(ctx, key)
+	if err != nil {
+		return Report{}, err
+	}
+	length, err := client.XLen(ctx, key)
+	if err != nil {
+		return Report{}, err
+	}
+	report := Report{Key: key, Length: length, Groups: make([]GroupLag, len(groups))}
+	for i, group := range groups {
+		consumers, err := client.XInfoConsumers(ctx, key, group.Name)
+		if err != nil {
+			return Report{}, fmt.Errorf("failed to get the consumers of group %q: %w", group.Name, err)
+		}
+		report.Groups[i] = GroupLag{
+			Name:            group.Name,
+			Lag:             valueOr(group.Lag, -1),
+			EntriesRead:     valueOr(group.EntriesRead, -1),
+			LastDeliveredID: group.LastDeliveredId,
+			Pending:         group.Pending,
+			Consumers:       make([]ConsumerLag, len(consumers)),
+		}
+		for j, consumer := range consumers {
+			inactive := time.Duration(-1)
+			if !consumer.Inactive.IsNil() {
+				inactive = time.Duration(consumer.Inactive.Value()) * time.Millisecond
+			}
+			report.Groups[i].Consumers[j] = ConsumerLag{
+				Name:     consumer.Name,
+				Pending:  consumer.Pending,
+				Idle:     time.Duration(consumer.Idle) * time.Millisecond,
+				Inactive: inactive,
+			}
+		}
+	}
+	return report, nil
+}
+
+func valueOr(result models.Result[int64], defaultValue int64) int64 {
+	if result.IsNil() {
+		return defaultValue
+	}
+	return result.Value()
+}
+
+// Thresholds are the limits of the lag of the consumer groups watched by [WatchLag]. A zero threshold is not checked.
+type Thresholds struct {
+	// The maximum number of entries not delivered to a group yet.
+	Lag int64
+	// The maximum number of entries delivered to the consumers of a group but not acknowledged yet.
+	Pending int64
+	// The maximum number of entries delivered to a consumer but not acknowledged yet.
+	ConsumerPending int64
+	// The maximum time since the last attempted interaction of a consumer with entries not acknowledged yet, e.g. a
+	// consumer that stopped while processing entries. The consumers without pending entries are not checked.
+	Idle time.Duration
+}
+
+// AlertKind is the threshold exceeded by an [Alert].
+type AlertKind int
+
+const (
+	// The lag of a group exceeds [Thresholds.Lag].
+	LagExceeded AlertKind = iota
+	// The pending entries of a group exceed [Thresholds.Pending].
+	PendingExceeded
+	// The pending entries of a consumer exceed [Thresholds.ConsumerPending].
+	ConsumerPendingExceeded
+	// A consumer with pending entries has been idle for longer than [Thresholds.Idle].
+	IdleExceeded
+)
+
+func (kind AlertKind) String() string {
+	switch kind {
+	case LagExceeded:
+		return "LagExceeded"
+	case PendingExceeded:
+		return "PendingExceeded"
+	case ConsumerPendingExceeded:
+		return "ConsumerPendingExceeded"
+	case IdleExceeded:
+		return "IdleExceeded"
+	}
+	return fmt.Sprintf("AlertKind(%d)", int(kind))
+}
+
+// Alert is a threshold exceeded by a consumer group or a consumer, reported by [WatchLag].
+type Alert struct {
+	// The key of the stream.
+	Key string
+	// The name of the group.
+	Group string
+	// The name of the consumer, or empty for the alerts of a group.
+	Consumer string
+	// The threshold exceeded.
+	Kind AlertKind
+	// The value exceeding the threshold: a number of entries, or a number of milliseconds for [IdleExceeded].
+	Value int64
+	// The threshold, in the unit of the value.
+	Threshold int64
+}
+
+// Alerts returns the thresholds exceeded by the groups and the consumers of the report.
+func (r Report) Alerts(thresholds Thresholds) []Alert {
+	var alerts []Alert
+	check := func(group, consumer string, kind AlertKind, value, threshold int64) {
+		if threshold > 0 && value > threshold {
+			alerts = append(alerts, Alert{
+				Key:       r.Key,
+				Group:     group,
+				Consumer:  consumer,
+				Kind:      kind,
+				Value:     value,
+				Threshold: threshold,
+			})
+		}
+	}
+	for _, group := range r.Groups {
+		check(group.Name, "", LagExceeded, group.Lag, thresholds.Lag)
+		check(group.Name, "", PendingExceeded, group.Pending, thresholds.Pending)
+		for _, consumer := range group.Consumers {
+			check(group.Name, consumer.Name, ConsumerPendingExceeded, consumer.Pending, thresholds.ConsumerPending)
+			if consumer.Pending > 0 {
+				check(group.Name, consumer.Name, IdleExceeded, consumer.Idle.Milliseconds(), thresholds.Idle.Milliseconds())
+			}
+		}
+	}
+	return alerts
+}
+
+// WatchLag reports the lag of the consumer groups of the stream at `key` every `pollInterval`, see [LagReport], and calls
+// `handler` with the thresholds exceeded, until `ctx` is cancelled or a report fails. A threshold exceeded for several
+// polls is reported at every poll, so that the handler can export the values as metrics while the lag lasts.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the watch. Cancelling it stops the watch.
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the stream.
+//	thresholds - The limits of the lag, see [Thresholds].
+//	pollInterval - The interval between the reports.
+//	handler - Called with the report and the thresholds it exceeds, from the goroutine calling WatchLag, at every poll
+//	  exceeding a threshold.
+//
+// Return value:
+//
+//	The error that stopped the watch: the error of `ctx` once it is cancelled, or the error of the report.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func WatchLag(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	key string,
+	thresholds Thresholds,
+	pollInterval time.Duration,
+	handler func(Report, []Alert),
+) error {
+	if pollInterval <= 0 {
+		return errors.New("the poll interval must be positive")
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		report, err := LagReport(ctx, client, key)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to report the lag of stream %q: %w", key, err)
+		}
+		if alerts := report.Alerts(thresholds); len(alerts) > 0 {
+			handler(report, alerts)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+// newLagClient returns a client holding the stream "orders" of 100 entries, read by the groups "billing" and "audit".
+func newLagClient() *fakeclient.Client {
+	now := time.UnixMilli(1_700_000_000_000)
+	timestamps := make([]int64, 100)
+	for i := range timestamps {
+		timestamps[i] = int64(i + 1)
+	}
+	orders := newStream(timestamps...)
+	billing := &fakeclient.Group{
+		LastDeliveredID: "50-0",
+		EntriesRead:     50,
+		Pending:         map[string]fakeclient.PendingEntry{},
+		Consumers: map[string]*fakeclient.Consumer{
+			"worker-1": {SeenAt: now.Add(-90 * time.Second), ActiveAt: now.Add(-90 * time.Second)},
+			"worker-2": {SeenAt: now.Add(-10 * time.Millisecond)},
+		},
+	}
+	for i := 1; i <= 30; i++ {
+		consumer := "worker-1"
+		if i > 25 {
+			consumer = "worker-2"
+		}
+		billing.Pending[fmt.Sprintf("%d-0", i)] = fakeclient.PendingEntry{Consumer: consumer}
+	}
+	orders.Groups = map[string]*fakeclient.Group{
+		"billing": billing,
+		"audit":   {LastDeliveredID: "0-0", EntriesRead: -1},
+	}
+	client := fakeclient.New().WithClock(clock.NewFake(now))
+	client.Streams["orders"] = orders
+	return client
+}
+
+func TestLagReport(t *testing.T) {
+	report, err := LagReport(context.Background(), newLagClient(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, Report{
+		Key:    "orders",
+		Length: 100,
+		Groups: []GroupLag{
+			{Name: "audit", Lag: -1, EntriesRead: -1, LastDeliveredID: "0-0", Consumers: []ConsumerLag{}},
+			{
+				Name:            "billing",
+				Lag:             50,
+				EntriesRead:     50,
+				LastDeliveredID: "50-0",
+				Pending:         30,
+				Consumers: []ConsumerLag{
+					{Name: "worker-1", Pending: 25, Idle: 90 * time.Second, Inactive: 90 * time.Second},
+					{Name: "worker-2", Pending: 5, Idle: 10 * time.Millisecond, Inactive: -1},
+				},
+			},
+		},
+	}, report)
+
+	_, err = LagReport(context.Background(), fakeclient.New(), "missing")
+	assert.Error(t, err)
+}
+
+func TestReport_Alerts(t *testing.T) {
+	report, err := LagReport(context.Background(), newLagClient(), "orders")
+	require.NoError(t, err)
+
+	assert.Empty(t, report.Alerts(Thresholds{}))
+	assert.Equal(t, []Alert{
+		{Key: "orders", Group: "billing", Kind: LagExceeded, Value: 50, Threshold: 10},
+		{Key: "orders", Group: "billing", Consumer: "worker-1", Kind: ConsumerPendingExceeded, Value: 25, Threshold: 20},
+		{Key: "orders", Group: "billing", Consumer: "worker-1", Kind: IdleExceeded, Value: 90000, Threshold: 60000},
+	}, report.Alerts(Thresholds{Lag: 10, Pending: 30, ConsumerPending: 20, Idle: time.Minute}))
+	assert.Equal(t, "IdleExceeded", IdleExceeded.String())
+}
+
+func TestWatchLag(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var polls int
+	handler := func(report Report, alerts []Alert) {
+		polls++
+		assert.Len(t, alerts, 1)
+		if polls == 3 {
+			cancel()
+		}
+	}
+	err := WatchLag(ctx, newLagClient(), "orders", Thresholds{Lag: 10}, time.Millisecond, handler)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 3, polls)
+
+	err = WatchLag(context.Background(), fakeclient.New(), "missing", Thresholds{}, time.Millisecond, nil)
+	assert.Error(t, err)
+	err = WatchLag(context.Background(), newLagClient(), "orders", Thresholds{}, 0, nil)
+	assert.Error(t, err)
+}
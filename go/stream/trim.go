@@ -0,0 +1,210 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// trimCountBatchSize is the number of entries read at once by `XRANGE` to count the entries a dry run would trim.
+const trimCountBatchSize = 1000
+
+// Retention is the retention policy of a stream, enforced by a [Trimmer]. A zero limit is not enforced.
+type Retention struct {
+	// The key of the stream.
+	Key string
+	// The maximum number of entries of the stream, the oldest entries being trimmed first.
+	MaxLen int64
+	// The maximum age of the entries of the stream, from the timestamp of their IDs, e.g. 1700000000000 for the ID
+	// "1700000000000-0". The entries added with explicit IDs not based on time are trimmed by their IDs all the same.
+	MaxAge time.Duration
+	// Whether the stream is trimmed approximately, with `~`, removing whole internal nodes only. It is more efficient, but
+	// keeps a few more entries than the limits.
+	Approximate bool
+}
+
+// TrimResult is the enforcement of the retention policy of a stream by a [Trimmer].
+type TrimResult struct {
+	// The key of the stream.
+	Key string
+	// The number of entries trimmed, or that would be trimmed by a dry run. For an approximate retention, a dry run
+	// reports the entries beyond the limits, of which the trimming removes only the ones in whole internal nodes.
+	Trimmed int64
+	// Whether the trimming was a dry run, which trimmed nothing.
+	DryRun bool
+	// The error that stopped the trimming of the stream, if any.
+	Err error
+}
+
+// Trimmer trims streams according to their retention policies, with `XTRIM MINID` for the maximum age of their entries
+// and `XTRIM MAXLEN` for their maximum length, on a schedule with [Trimmer.Run], or once with [Trimmer.Trim].
+//
+// Example:
+//
+//	trimmer := stream.NewTrimmer(client,
+//		stream.Retention{Key: "events", MaxAge: 24 * time.Hour},
+//		stream.Retention{Key: "audit", MaxLen: 1_000_000, Approximate: true},
+//	)
+//	go trimmer.Run(ctx, time.Minute, func(results []stream.TrimResult) {
+//		for _, result := range results {
+//			log.Printf("trimmed %d entries from %s, error: %v", result.Trimmed, result.Key, result.Err)
+//		}
+//	})
+type Trimmer struct {
+	client     interfaces.BaseClientCommands
+	retentions []Retention
+	dryRun     bool
+	now        func() time.Time
+}
+
+// NewTrimmer creates a [Trimmer] enforcing `retentions` with `client`, either a [glide.Client] or a
+// [glide.ClusterClient].
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func NewTrimmer(client interfaces.BaseClientCommands, retentions ...Retention) *Trimmer {
+	return &Trimmer{client: client, retentions: retentions, now: time.Now}
+}
+
+// WithDryRun sets whether the trimmer only counts the entries it would trim, with `XLEN` and `XRANGE`, without trimming
+// them, e.g. to check the retention policies before enforcing them. The entries older than the maximum age are read to
+// be counted.
+func (t *Trimmer) WithDryRun(dryRun bool) *Trimmer {
+	t.dryRun = dryRun
+	return t
+}
+
+// Trim enforces the retention policies of the streams once, one stream after the other. A stream that fails to be
+// trimmed does not stop the trimming of the others.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The result of every retention policy, in the order of the policies.
+func (t *Trimmer) Trim(ctx context.Context) []TrimResult {
+	results := make([]TrimResult, len(t.retentions))
+	for i, retention := range t.retentions {
+		var trimmed int64
+		var err error
+		if t.dryRun {
+			trimmed, err = t.countTrimmed(ctx, retention)
+		} else {
+			trimmed, err = t.trim(ctx, retention)
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to trim stream %q: %w", retention.Key, err)
+		}
+		results[i] = TrimResult{Key: retention.Key, Trimmed: trimmed, DryRun: t.dryRun, Err: err}
+	}
+	return results
+}
+
+// Run enforces the retention policies of the streams every `interval`, see [Trimmer.Trim], until `ctx` is cancelled. The
+// policies are first enforced when Run is called.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the trimming. Cancelling it stops it.
+//	interval - The interval between the trimmings.
+//	handler - Called with the results of every trimming, from the goroutine calling Run, or nil.
+//
+// Return value:
+//
+//	The error of `ctx` once it is cancelled.
+func (t *Trimmer) Run(ctx context.Context, interval time.Duration, handler func([]TrimResult)) error {
+	if interval <= 0 {
+		return errors.New("the interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		results := t.Trim(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if handler != nil {
+			handler(results)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// trim trims the stream of `retention`, first by age, then by length, and returns the number of entries trimmed.
+func (t *Trimmer) trim(ctx context.Context, retention Retention) (int64, error) {
+	var trimmed int64
+	if retention.MaxAge > 0 {
+		byAge := retention.trimOptions(options.NewXTrimOptionsWithMinId(t.minID(retention)))
+		count, err := t.client.XTrim(ctx, retention.Key, *byAge)
+		if err != nil {
+			return trimmed, err
+		}
+		trimmed += count
+	}
+	if retention.MaxLen > 0 {
+		byLength := retention.trimOptions(options.NewXTrimOptionsWithMaxLen(retention.MaxLen))
+		count, err := t.client.XTrim(ctx, retention.Key, *byLength)
+		if err != nil {
+			return trimmed, err
+		}
+		trimmed += count
+	}
+	return trimmed, nil
+}
+
+// countTrimmed returns the number of entries of the stream of `retention` beyond its limits, which [Trimmer.trim] would
+// trim, counting the entries older than the maximum age with `XRANGE`.
+func (t *Trimmer) countTrimmed(ctx context.Context, retention Retention) (int64, error) {
+	length, err := t.client.XLen(ctx, retention.Key)
+	if err != nil {
+		return 0, err
+	}
+	var trimmed int64
+	if retention.MaxAge > 0 {
+		end := options.NewStreamBoundary(t.minID(retention), false)
+		start := options.NewInfiniteStreamBoundary(constants.NegativeInfinity)
+		for {
+			entries, err := t.client.XRangeWithOptions(ctx, retention.Key, start, end,
+				*options.NewXRangeOptions().SetCount(trimCountBatchSize))
+			if err != nil {
+				return 0, err
+			}
+			trimmed += int64(len(entries))
+			if len(entries) < trimCountBatchSize {
+				break
+			}
+			start = options.NewStreamBoundary(entries[len(entries)-1].ID, false)
+		}
+	}
+	if retention.MaxLen > 0 && length-trimmed > retention.MaxLen {
+		trimmed = length - retention.MaxLen
+	}
+	return trimmed, nil
+}
+
+// minID returns the smallest ID of the entries of the stream of `retention` younger than its maximum age.
+func (t *Trimmer) minID(retention Retention) string {
+	return strconv.FormatInt(t.now().Add(-retention.MaxAge).UnixMilli(), 10) + "-0"
+}
+
+// trimOptions sets the approximate trimming of `trimOptions` if the retention is approximate.
+func (retention Retention) trimOptions(trimOptions *options.XTrimOptions) *options.XTrimOptions {
+	if retention.Approximate {
+		return trimOptions.SetNearlyExactTrimming()
+	}
+	return trimOptions
+}
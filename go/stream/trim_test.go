@@ -0,0 +1,117 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// fakeStreamClient holds streams whose entries have the IDs "<timestamp>-0", and records the successful trims.
+type fakeStreamClient struct {
+	*fakeclient.Client
+	trims []string
+}
+
+func (f *fakeStreamClient) XTrim(ctx context.Context, key string, opts options.XTrimOptions) (int64, error) {
+	trimmed, err := f.Client.XTrim(ctx, key, opts)
+	if err == nil {
+		args, _ := opts.ToArgs()
+		f.trims = append(f.trims, key+" "+strings.Join(args, " "))
+	}
+	return trimmed, err
+}
+
+// newStream returns a stream whose entries have the IDs "<timestamp>-0".
+func newStream(timestamps ...int64) *fakeclient.Stream {
+	stream := &fakeclient.Stream{}
+	for _, timestamp := range timestamps {
+		stream.Entries = append(stream.Entries, models.StreamEntry{ID: strconv.FormatInt(timestamp, 10) + "-0"})
+	}
+	return stream
+}
+
+func newFakeStreamClient() *fakeStreamClient {
+	events := make([]int64, 2500)
+	for i := range events {
+		events[i] = int64(i + 1)
+	}
+	client := fakeclient.New()
+	client.Streams["events"] = newStream(events...)
+	client.Streams["audit"] = newStream(1, 2, 3, 4, 5)
+	client.Strings["broken"] = "value"
+	return &fakeStreamClient{Client: client}
+}
+
+func newTestTrimmer(client interfaces.BaseClientCommands, retentions ...Retention) *Trimmer {
+	trimmer := NewTrimmer(client, retentions...)
+	trimmer.now = func() time.Time { return time.UnixMilli(3000) }
+	return trimmer
+}
+
+func TestTrimmer_Trim(t *testing.T) {
+	client := newFakeStreamClient()
+	trimmer := newTestTrimmer(client,
+		// The 1999 entries older than 3000 - 1000 ms, then the oldest 201 of the 501 left
+		Retention{Key: "events", MaxAge: time.Second, MaxLen: 300},
+		Retention{Key: "audit", MaxLen: 2, Approximate: true},
+		Retention{Key: "broken", MaxLen: 1},
+	)
+
+	results := trimmer.Trim(context.Background())
+	assert.Equal(t, []TrimResult{
+		{Key: "events", Trimmed: 2200},
+		{Key: "audit", Trimmed: 3},
+		{Key: "broken", Err: results[2].Err},
+	}, results)
+	assert.ErrorContains(t, results[2].Err, `failed to trim stream "broken": WRONGTYPE`)
+	assert.Equal(t, []string{"events MINID 2000-0", "events MAXLEN 300", "audit MAXLEN ~ 2"}, client.trims)
+	assert.Len(t, client.Streams["events"].Entries, 300)
+	assert.Equal(t, "2201-0", client.Streams["events"].Entries[0].ID)
+}
+
+func TestTrimmer_DryRun(t *testing.T) {
+	client := newFakeStreamClient()
+	trimmer := newTestTrimmer(client,
+		Retention{Key: "events", MaxAge: time.Second, MaxLen: 300},
+		Retention{Key: "events", MaxAge: time.Second},
+		Retention{Key: "events", MaxLen: 2000},
+		Retention{Key: "audit", MaxLen: 10},
+	).WithDryRun(true)
+
+	results := trimmer.Trim(context.Background())
+	assert.Equal(t, []TrimResult{
+		{Key: "events", Trimmed: 2200, DryRun: true},
+		{Key: "events", Trimmed: 1999, DryRun: true},
+		{Key: "events", Trimmed: 500, DryRun: true},
+		{Key: "audit", Trimmed: 0, DryRun: true},
+	}, results)
+	assert.Empty(t, client.trims)
+	assert.Len(t, client.Streams["events"].Entries, 2500)
+}
+
+func TestTrimmer_Run(t *testing.T) {
+	client := newFakeStreamClient()
+	trimmer := newTestTrimmer(client, Retention{Key: "audit", MaxLen: 2})
+	ctx, cancel := context.WithCancel(context.Background())
+	var rounds [][]TrimResult
+	err := trimmer.Run(ctx, time.Millisecond, func(results []TrimResult) {
+		rounds = append(rounds, results)
+		if len(rounds) == 2 {
+			cancel()
+		}
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, [][]TrimResult{{{Key: "audit", Trimmed: 3}}, {{Key: "audit", Trimmed: 0}}}, rounds)
+
+	assert.Error(t, trimmer.Run(context.Background(), 0, nil))
+}
@@ -814,6 +814,144 @@ func ExampleClusterClient_XDel() {
 	// Output: 1
 }
 
+func ExampleClient_XDelEx() {
+	// This command requires Valkey 8.2+
+	var client *Client = getExampleClient() // example helper function
+	key := uuid.NewString()
+
+	client.XAddWithOptions(context.Background(),
+		key,
+		[]models.FieldValue{{Field: "field1", Value: "value1"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+
+	result, err := client.XDelEx(context.Background(), key, []string{"0-1", "0-2"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [Deleted NotFound]
+}
+
+func ExampleClusterClient_XDelEx() {
+	// This command requires Valkey 8.2+
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	key := uuid.NewString()
+
+	client.XAddWithOptions(context.Background(),
+		key,
+		[]models.FieldValue{{Field: "field1", Value: "value1"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+
+	result, err := client.XDelEx(context.Background(), key, []string{"0-1", "0-2"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [Deleted NotFound]
+}
+
+func ExampleClient_XSetId() {
+	var client *Client = getExampleClient() // example helper function
+	key := uuid.NewString()
+
+	client.XAddWithOptions(context.Background(),
+		key,
+		[]models.FieldValue{{Field: "field1", Value: "value1"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+
+	result, err := client.XSetId(context.Background(), key, "5-0")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	info, _ := client.XInfoStream(context.Background(), key)
+	fmt.Println(info.LastGeneratedID)
+
+	// Output:
+	// OK
+	// 5-0
+}
+
+func ExampleClient_XSetIdWithOptions() {
+	var client *Client = getExampleClient() // example helper function
+	key := uuid.NewString()
+
+	client.XAddWithOptions(context.Background(),
+		key,
+		[]models.FieldValue{{Field: "field1", Value: "value1"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+
+	opts := options.NewXSetIdOptions().SetEntriesAdded(10).SetMaxDeletedId("0-1")
+	result, err := client.XSetIdWithOptions(context.Background(), key, "5-0", *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	info, _ := client.XInfoStream(context.Background(), key)
+	fmt.Println(info.EntriesAdded.Value())
+
+	// Output:
+	// OK
+	// 10
+}
+
+func ExampleClusterClient_XSetId() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	key := uuid.NewString()
+
+	client.XAddWithOptions(context.Background(),
+		key,
+		[]models.FieldValue{{Field: "field1", Value: "value1"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+
+	result, err := client.XSetId(context.Background(), key, "5-0")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	info, _ := client.XInfoStream(context.Background(), key)
+	fmt.Println(info.LastGeneratedID)
+
+	// Output:
+	// OK
+	// 5-0
+}
+
+func ExampleClusterClient_XSetIdWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	key := uuid.NewString()
+
+	client.XAddWithOptions(context.Background(),
+		key,
+		[]models.FieldValue{{Field: "field1", Value: "value1"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+
+	opts := options.NewXSetIdOptions().SetEntriesAdded(10).SetMaxDeletedId("0-1")
+	result, err := client.XSetIdWithOptions(context.Background(), key, "5-0", *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	info, _ := client.XInfoStream(context.Background(), key)
+	fmt.Println(info.EntriesAdded.Value())
+
+	// Output:
+	// OK
+	// 10
+}
+
 func ExampleClient_XPending() {
 	var client *Client = getExampleClient() // example helper function
 	key := "12345"
@@ -1426,6 +1564,56 @@ func ExampleClusterClient_XAck() {
 	// Output: 1
 }
 
+func ExampleClient_XAckDel() {
+	// This command requires Valkey 8.2+
+	var client *Client = getExampleClient() // example helper function
+	key := uuid.NewString()
+	group := "g12345"
+	consumer := "c12345"
+
+	streamId, _ := client.XAdd(context.Background(), key, []models.FieldValue{{Field: "field1", Value: "value1"}})
+	client.XGroupCreate(context.Background(), key, group, "0")
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
+
+	result, err := client.XAckDel(
+		context.Background(),
+		key,
+		group,
+		[]string{streamId},
+	) // ack the message and delete it from the stream
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [Deleted]
+}
+
+func ExampleClusterClient_XAckDel() {
+	// This command requires Valkey 8.2+
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	key := uuid.NewString()
+	group := "g12345"
+	consumer := "c12345"
+
+	streamId, _ := client.XAdd(context.Background(), key, []models.FieldValue{{Field: "field1", Value: "value1"}})
+	client.XGroupCreate(context.Background(), key, group, "0")
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
+
+	result, err := client.XAckDel(
+		context.Background(),
+		key,
+		group,
+		[]string{streamId},
+	) // ack the message and delete it from the stream
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [Deleted]
+}
+
 func ExampleClient_XClaim() {
 	var client *Client = getExampleClient() // example helper function
 	key := "12345"
@@ -1936,6 +2124,78 @@ func ExampleClusterClient_XRange() {
 	// Output: [{12345-1 [{field1 value1}]} {12345-2 [{field2 value2}]}]
 }
 
+func ExampleClient_XRangeInto() {
+	var client *Client = getExampleClient() // example helper function
+	key := uuid.NewString()
+
+	type Event struct {
+		ID     string  `glide:",id"`
+		Kind   string  `glide:"kind"`
+		Amount float64 `glide:"amount,default=0"`
+	}
+
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[]models.FieldValue{{Field: "kind", Value: "deposit"}, {Field: "amount", Value: "12.5"}},
+		*options.NewXAddOptions().SetId("1-1"),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[]models.FieldValue{{Field: "kind", Value: "audit"}},
+		*options.NewXAddOptions().SetId("1-2"),
+	)
+
+	var events []Event
+	err := client.XRangeInto(context.Background(), key,
+		options.NewInfiniteStreamBoundary(constants.NegativeInfinity),
+		options.NewInfiniteStreamBoundary(constants.PositiveInfinity),
+		&events)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(events)
+
+	// Output: [{1-1 deposit 12.5} {1-2 audit 0}]
+}
+
+func ExampleClusterClient_XRangeInto() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	key := uuid.NewString()
+
+	type Event struct {
+		ID     string  `glide:",id"`
+		Kind   string  `glide:"kind"`
+		Amount float64 `glide:"amount,default=0"`
+	}
+
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[]models.FieldValue{{Field: "kind", Value: "deposit"}, {Field: "amount", Value: "12.5"}},
+		*options.NewXAddOptions().SetId("1-1"),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[]models.FieldValue{{Field: "kind", Value: "audit"}},
+		*options.NewXAddOptions().SetId("1-2"),
+	)
+
+	var events []*Event
+	err := client.XRevRangeInto(context.Background(), key,
+		options.NewInfiniteStreamBoundary(constants.PositiveInfinity),
+		options.NewInfiniteStreamBoundary(constants.NegativeInfinity),
+		&events)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(*events[0], *events[1])
+
+	// Output: {1-2 audit 0} {1-1 deposit 12.5}
+}
+
 func ExampleClient_XRangeWithOptions() {
 	var client *Client = getExampleClient() // example helper function
 	key := "12345"
@@ -0,0 +1,124 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// XRangeInto returns the stream entries matching a given range of IDs, like `XRange`, decoded into the slice of structs
+// pointed to by `dst`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	start - The start position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	end   - The end position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	dst   - A pointer to a slice of structs, or of pointers to structs, e.g. `&[]MyEvent{}`, replaced by the decoded
+//	        entries in order. The fields of the entries are mapped to the fields of the structs by their `glide` tags, see
+//	        [models.StreamEntry.Decode].
+//
+// [valkey.io]: https://valkey.io/commands/xrange/
+func (client *baseClient) XRangeInto(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	dst any,
+) error {
+	return client.XRangeWithOptionsInto(ctx, key, start, end, *options.NewXRangeOptions(), dst)
+}
+
+// XRangeWithOptionsInto returns the stream entries matching a given range of IDs, like `XRangeWithOptions`, decoded into
+// the slice of structs pointed to by `dst`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	start - The start position.
+//	end   - The end position.
+//	opts  - Stream range options.
+//	dst   - A pointer to a slice of structs, or of pointers to structs, see `XRangeInto`.
+//
+// [valkey.io]: https://valkey.io/commands/xrange/
+func (client *baseClient) XRangeWithOptionsInto(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+	dst any,
+) error {
+	entries, err := client.XRangeWithOptions(ctx, key, start, end, opts)
+	if err != nil {
+		return err
+	}
+	return models.DecodeStreamEntries(entries, dst)
+}
+
+// XRevRangeInto returns the stream entries matching a given range of IDs in reverse order, like `XRevRange`, decoded
+// into the slice of structs pointed to by `dst`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	start - The start position.
+//	end   - The end position.
+//	dst   - A pointer to a slice of structs, or of pointers to structs, see `XRangeInto`.
+//
+// [valkey.io]: https://valkey.io/commands/xrevrange/
+func (client *baseClient) XRevRangeInto(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	dst any,
+) error {
+	return client.XRevRangeWithOptionsInto(ctx, key, start, end, *options.NewXRangeOptions(), dst)
+}
+
+// XRevRangeWithOptionsInto returns the stream entries matching a given range of IDs in reverse order, like
+// `XRevRangeWithOptions`, decoded into the slice of structs pointed to by `dst`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	start - The start position.
+//	end   - The end position.
+//	opts  - Stream range options.
+//	dst   - A pointer to a slice of structs, or of pointers to structs, see `XRangeInto`.
+//
+// [valkey.io]: https://valkey.io/commands/xrevrange/
+func (client *baseClient) XRevRangeWithOptionsInto(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+	dst any,
+) error {
+	entries, err := client.XRevRangeWithOptions(ctx, key, start, end, opts)
+	if err != nil {
+		return err
+	}
+	return models.DecodeStreamEntries(entries, dst)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
 
@@ -201,6 +202,23 @@ func ExampleClient_MSet() {
 	// Output: OK
 }
 
+func ExampleClient_BulkLoad() {
+	var client *Client = getExampleClient() // example helper function
+
+	pairs := map[string]string{}
+	for i := 0; i < 2500; i++ {
+		pairs[fmt.Sprintf("warmup:%d", i)] = "value"
+	}
+	opts := options.NewBulkLoadOptions().SetBatchSize(1000).SetExpiry(time.Hour)
+	result, err := client.BulkLoad(context.Background(), models.NewMapIterator(pairs), *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Loaded, len(result.Failed))
+
+	// Output: 2500 0
+}
+
 func ExampleClusterClient_MSet() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 
@@ -876,3 +894,227 @@ func ExampleClusterClient_LCSWithOptions_minmatchlen() {
 	//   "Len": 6
 	// }
 }
+
+func ExampleClient_GetWithVersion() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.Set(context.Background(), "my_counter", "10")
+	current, err := client.GetWithVersion(context.Background(), "my_counter")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(current.Value.Value())
+
+	// Only succeeds if "my_counter" was not modified since it was read
+	set, err := client.SetIfVersion(context.Background(), "my_counter", "11", current.Version)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(set)
+
+	// The version is now outdated
+	set, err = client.SetIfVersion(context.Background(), "my_counter", "12", current.Version)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(set)
+
+	// Output:
+	// 10
+	// true
+	// false
+}
+
+func ExampleClusterClient_GetWithVersion() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.Set(context.Background(), "my_counter", "10")
+	current, err := client.GetWithVersion(context.Background(), "my_counter")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(current.Value.Value())
+
+	// Only succeeds if "my_counter" was not modified since it was read
+	set, err := client.SetIfVersion(context.Background(), "my_counter", "11", current.Version)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(set)
+
+	// The version is now outdated
+	set, err = client.SetIfVersion(context.Background(), "my_counter", "12", current.Version)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(set)
+
+	// Output:
+	// 10
+	// true
+	// false
+}
+
+func ExampleClient_UpdateString() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.Set(context.Background(), "my_config", `{"retries":3}`)
+	updated, err := client.UpdateString(
+		context.Background(),
+		"my_config",
+		func(old models.Result[string]) (string, bool) {
+			var config map[string]int
+			if err := json.Unmarshal([]byte(old.Value()), &config); err != nil {
+				return "", true
+			}
+			config["retries"]++
+			value, _ := json.Marshal(config)
+			return string(value), false
+		},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(updated)
+	value, err := client.Get(context.Background(), "my_config")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(value.Value())
+
+	// Output:
+	// true
+	// {"retries":4}
+}
+
+func ExampleClusterClient_UpdateString() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.Set(context.Background(), "my_config", `{"retries":3}`)
+	updated, err := client.UpdateString(
+		context.Background(),
+		"my_config",
+		func(old models.Result[string]) (string, bool) {
+			var config map[string]int
+			if err := json.Unmarshal([]byte(old.Value()), &config); err != nil {
+				return "", true
+			}
+			config["retries"]++
+			value, _ := json.Marshal(config)
+			return string(value), false
+		},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(updated)
+	value, err := client.Get(context.Background(), "my_config")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(value.Value())
+
+	// Output:
+	// true
+	// {"retries":4}
+}
+
+func ExampleClient_GetInto() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "my_value")
+	buf := make([]byte, 64)
+	n, err := client.GetInto(context.Background(), "my_key", buf)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(string(buf[:n]))
+
+	n, err = client.GetInto(context.Background(), "non_existing_key", buf)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(n)
+
+	// The buffer is too small, the returned length is the size needed
+	n, err = client.GetInto(context.Background(), "my_key", buf[:2])
+	fmt.Println(n, err)
+
+	// Output:
+	// my_value
+	// -1
+	// 8 short buffer
+}
+
+func ExampleClusterClient_GetInto() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "my_value")
+	buf := make([]byte, 64)
+	n, err := client.GetInto(context.Background(), "my_key", buf)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(string(buf[:n]))
+
+	n, err = client.GetInto(context.Background(), "non_existing_key", buf)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(n)
+
+	// The buffer is too small, the returned length is the size needed
+	n, err = client.GetInto(context.Background(), "my_key", buf[:2])
+	fmt.Println(n, err)
+
+	// Output:
+	// my_value
+	// -1
+	// 8 short buffer
+}
+
+func ExampleClient_GetHedged() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "my_value")
+	result, err := client.GetHedged(context.Background(), "my_key", *options.NewHedgeOptions())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+
+	// A fixed hedging delay instead of the 99th percentile of the observed latencies
+	opts := options.NewHedgeOptions().SetDelay(5 * time.Millisecond)
+	result, err = client.GetHedged(context.Background(), "non_existing_key", *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsNil())
+
+	// Output:
+	// my_value
+	// true
+}
+
+func ExampleClusterClient_GetHedged() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "my_value")
+	result, err := client.GetHedged(context.Background(), "my_key", *options.NewHedgeOptions())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+
+	// A fixed hedging delay instead of the 99th percentile of the observed latencies
+	opts := options.NewHedgeOptions().SetDelay(5 * time.Millisecond)
+	result, err = client.GetHedged(context.Background(), "non_existing_key", *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsNil())
+
+	// Output:
+	// my_value
+	// true
+}
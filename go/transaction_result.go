@@ -0,0 +1,34 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// executeTransaction executes the atomic `batch`, and reports the outcome of every command of the transaction.
+func (client *baseClient) executeTransaction(
+	ctx context.Context,
+	batch internal.Batch,
+	options *internal.BatchOptions,
+) (models.TransactionResult, error) {
+	if !batch.IsAtomic {
+		return models.TransactionResult{}, errors.New("only atomic batches (transactions) can be executed as a transaction")
+	}
+	results, err := client.executeBatch(ctx, batch, false, options)
+	if err != nil {
+		return models.TransactionResult{}, err
+	}
+	commands := make([]string, len(batch.Commands))
+	for i, cmd := range batch.Commands {
+		commands[i] = commandName(C.RequestType(cmd.RequestType), cmd.Args)
+	}
+	return models.NewTransactionResult(commands, results), nil
+}
@@ -0,0 +1,304 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ValueCodec converts the values of type T held by a typed key, see [HashKey] and [ListKey], to the strings stored on the
+// server, and back.
+type ValueCodec[T any] interface {
+	// Encode returns the string stored on the server for `value`.
+	Encode(value T) (string, error)
+	// Decode returns the value stored on the server as `data`.
+	Decode(data string) (T, error)
+}
+
+// JSONCodec is a [ValueCodec] storing the values as JSON, with [json.Marshal] and [json.Unmarshal].
+type JSONCodec[T any] struct{}
+
+// Encode returns the JSON encoding of `value`.
+func (JSONCodec[T]) Encode(value T) (string, error) {
+	data, err := json.Marshal(value)
+	return string(data), err
+}
+
+// Decode returns the value encoded as JSON in `data`.
+func (JSONCodec[T]) Decode(data string) (T, error) {
+	var value T
+	err := json.Unmarshal([]byte(data), &value)
+	return value, err
+}
+
+// StringCodec is a [ValueCodec] storing the strings as they are.
+type StringCodec struct{}
+
+// Encode returns `value`.
+func (StringCodec) Encode(value string) (string, error) {
+	return value, nil
+}
+
+// Decode returns `data`.
+func (StringCodec) Decode(data string) (string, error) {
+	return data, nil
+}
+
+// defaultCodec returns the codec of the typed keys holding values of type T: the strings are stored as they are, and the
+// other types as JSON.
+func defaultCodec[T any]() ValueCodec[T] {
+	if codec, ok := any(StringCodec{}).(ValueCodec[T]); ok {
+		return codec
+	}
+	return JSONCodec[T]{}
+}
+
+// TypedKey is the name of a key, bound to the commands of its data type by [TypedStringKey], [TypedHashKey] and
+// [TypedListKey], so that running a command against a key of the wrong type, which fails with a `WRONGTYPE` error, does
+// not compile. TypedKey only provides the commands applying to the keys of any type.
+type TypedKey struct {
+	name string
+}
+
+// Name returns the name of the key.
+func (k TypedKey) Name() string {
+	return k.name
+}
+
+// Exists returns whether the key exists.
+func (k TypedKey) Exists(ctx context.Context, client interfaces.GenericBaseCommands) (bool, error) {
+	count, err := client.Exists(ctx, []string{k.name})
+	return count == 1, err
+}
+
+// Delete deletes the key, and returns whether it existed.
+func (k TypedKey) Delete(ctx context.Context, client interfaces.GenericBaseCommands) (bool, error) {
+	count, err := client.Del(ctx, []string{k.name})
+	return count == 1, err
+}
+
+// Expire sets the time to live of the key, and returns whether the key exists.
+func (k TypedKey) Expire(ctx context.Context, client interfaces.GenericBaseCommands, ttl time.Duration) (bool, error) {
+	return client.Expire(ctx, k.name, ttl)
+}
+
+// TypedStringKey is a key holding a string value. See [StringKey].
+type TypedStringKey struct {
+	TypedKey
+}
+
+// StringKey returns the handle of the key `name` holding a string value, whose methods only run the string commands.
+//
+// Example:
+//
+//	greeting := glide.StringKey("greeting")
+//	err := greeting.Set(ctx, client, "hello")
+//	...
+//	value, err := greeting.Get(ctx, client)
+func StringKey(name string) TypedStringKey {
+	return TypedStringKey{TypedKey{name: name}}
+}
+
+// Get returns the value of the key with `GET`, or a nil result if the key does not exist.
+func (k TypedStringKey) Get(ctx context.Context, client interfaces.StringCommands) (models.Result[string], error) {
+	return client.Get(ctx, k.name)
+}
+
+// Set sets the value of the key with `SET`.
+func (k TypedStringKey) Set(ctx context.Context, client interfaces.StringCommands, value string) error {
+	_, err := client.Set(ctx, k.name, value)
+	return err
+}
+
+// Append appends `value` to the value of the key with `APPEND`, and returns the length of the value after the append.
+func (k TypedStringKey) Append(ctx context.Context, client interfaces.StringCommands, value string) (int64, error) {
+	return client.Append(ctx, k.name, value)
+}
+
+// Len returns the length of the value of the key with `STRLEN`, or 0 if the key does not exist.
+func (k TypedStringKey) Len(ctx context.Context, client interfaces.StringCommands) (int64, error) {
+	return client.Strlen(ctx, k.name)
+}
+
+// TypedHashKey is a key holding a hash whose field values are of type T. See [HashKey].
+type TypedHashKey[T any] struct {
+	TypedKey
+	codec ValueCodec[T]
+}
+
+// HashKey returns the handle of the key `name` holding a hash whose field values are of type T, whose methods only run
+// the hash commands. The strings are stored as they are, and the values of the other types as JSON, unless another codec
+// is set with [TypedHashKey.WithCodec].
+//
+// Example:
+//
+//	profiles := glide.HashKey[Profile]("profiles")
+//	_, err := profiles.Set(ctx, client, map[string]Profile{"alice": {Age: 30}})
+//	...
+//	profile, err := profiles.Get(ctx, client, "alice")
+func HashKey[T any](name string) TypedHashKey[T] {
+	return TypedHashKey[T]{TypedKey: TypedKey{name: name}, codec: defaultCodec[T]()}
+}
+
+// WithCodec returns the handle of the key converting the field values with `codec`.
+func (k TypedHashKey[T]) WithCodec(codec ValueCodec[T]) TypedHashKey[T] {
+	k.codec = codec
+	return k
+}
+
+// Get returns the value of `field` with `HGET`, or a nil result if the field or the key does not exist.
+func (k TypedHashKey[T]) Get(ctx context.Context, client interfaces.HashCommands, field string) (models.Result[T], error) {
+	data, err := client.HGet(ctx, k.name, field)
+	if err != nil || data.IsNil() {
+		return models.CreateNilResultOf[T](), err
+	}
+	value, err := k.codec.Decode(data.Value())
+	if err != nil {
+		return models.CreateNilResultOf[T](), fmt.Errorf("failed to decode field %q of key %q: %w", field, k.name, err)
+	}
+	return models.CreateResultOf(value), nil
+}
+
+// Set sets the fields of `values` with `HSET`, and returns the number of fields added, not counting the fields updated.
+func (k TypedHashKey[T]) Set(ctx context.Context, client interfaces.HashCommands, values map[string]T) (int64, error) {
+	encoded := make(map[string]string, len(values))
+	for field, value := range values {
+		data, err := k.codec.Encode(value)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encode field %q of key %q: %w", field, k.name, err)
+		}
+		encoded[field] = data
+	}
+	return client.HSet(ctx, k.name, encoded)
+}
+
+// GetAll returns all the fields of the hash with `HGETALL`, or an empty map if the key does not exist.
+func (k TypedHashKey[T]) GetAll(ctx context.Context, client interfaces.HashCommands) (map[string]T, error) {
+	encoded, err := client.HGetAll(ctx, k.name)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]T, len(encoded))
+	for field, data := range encoded {
+		value, err := k.codec.Decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode field %q of key %q: %w", field, k.name, err)
+		}
+		values[field] = value
+	}
+	return values, nil
+}
+
+// DeleteFields deletes `fields` with `HDEL`, and returns the number of fields deleted.
+func (k TypedHashKey[T]) DeleteFields(ctx context.Context, client interfaces.HashCommands, fields ...string) (int64, error) {
+	return client.HDel(ctx, k.name, fields)
+}
+
+// Len returns the number of fields of the hash with `HLEN`, or 0 if the key does not exist.
+func (k TypedHashKey[T]) Len(ctx context.Context, client interfaces.HashCommands) (int64, error) {
+	return client.HLen(ctx, k.name)
+}
+
+// TypedListKey is a key holding a list whose elements are of type T. See [ListKey].
+type TypedListKey[T any] struct {
+	TypedKey
+	codec ValueCodec[T]
+}
+
+// ListKey returns the handle of the key `name` holding a list whose elements are of type T, whose methods only run the
+// list commands. The strings are stored as they are, and the elements of the other types as JSON, unless another codec
+// is set with [TypedListKey.WithCodec].
+//
+// Example:
+//
+//	jobs := glide.ListKey[Job]("jobs")
+//	_, err := jobs.PushRight(ctx, client, Job{ID: 42})
+//	...
+//	job, err := jobs.PopLeft(ctx, client)
+func ListKey[T any](name string) TypedListKey[T] {
+	return TypedListKey[T]{TypedKey: TypedKey{name: name}, codec: defaultCodec[T]()}
+}
+
+// WithCodec returns the handle of the key converting the elements with `codec`.
+func (k TypedListKey[T]) WithCodec(codec ValueCodec[T]) TypedListKey[T] {
+	k.codec = codec
+	return k
+}
+
+func (k TypedListKey[T]) encode(elements []T) ([]string, error) {
+	encoded := make([]string, len(elements))
+	for i, element := range elements {
+		data, err := k.codec.Encode(element)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode element of key %q: %w", k.name, err)
+		}
+		encoded[i] = data
+	}
+	return encoded, nil
+}
+
+func (k TypedListKey[T]) decode(data models.Result[string], err error) (models.Result[T], error) {
+	if err != nil || data.IsNil() {
+		return models.CreateNilResultOf[T](), err
+	}
+	value, err := k.codec.Decode(data.Value())
+	if err != nil {
+		return models.CreateNilResultOf[T](), fmt.Errorf("failed to decode element of key %q: %w", k.name, err)
+	}
+	return models.CreateResultOf(value), nil
+}
+
+// PushLeft inserts `elements` at the head of the list with `LPUSH`, and returns the length of the list after the push.
+func (k TypedListKey[T]) PushLeft(ctx context.Context, client interfaces.ListCommands, elements ...T) (int64, error) {
+	encoded, err := k.encode(elements)
+	if err != nil {
+		return 0, err
+	}
+	return client.LPush(ctx, k.name, encoded)
+}
+
+// PushRight inserts `elements` at the tail of the list with `RPUSH`, and returns the length of the list after the push.
+func (k TypedListKey[T]) PushRight(ctx context.Context, client interfaces.ListCommands, elements ...T) (int64, error) {
+	encoded, err := k.encode(elements)
+	if err != nil {
+		return 0, err
+	}
+	return client.RPush(ctx, k.name, encoded)
+}
+
+// PopLeft removes and returns the first element of the list with `LPOP`, or a nil result if the key does not exist.
+func (k TypedListKey[T]) PopLeft(ctx context.Context, client interfaces.ListCommands) (models.Result[T], error) {
+	return k.decode(client.LPop(ctx, k.name))
+}
+
+// PopRight removes and returns the last element of the list with `RPOP`, or a nil result if the key does not exist.
+func (k TypedListKey[T]) PopRight(ctx context.Context, client interfaces.ListCommands) (models.Result[T], error) {
+	return k.decode(client.RPop(ctx, k.name))
+}
+
+// Range returns the elements of the list from `start` to `end` inclusive with `LRANGE`. The indexes may be negative,
+// -1 being the last element.
+func (k TypedListKey[T]) Range(ctx context.Context, client interfaces.ListCommands, start int64, end int64) ([]T, error) {
+	encoded, err := client.LRange(ctx, k.name, start, end)
+	if err != nil {
+		return nil, err
+	}
+	elements := make([]T, len(encoded))
+	for i, data := range encoded {
+		if elements[i], err = k.codec.Decode(data); err != nil {
+			return nil, fmt.Errorf("failed to decode element of key %q: %w", k.name, err)
+		}
+	}
+	return elements, nil
+}
+
+// Len returns the length of the list with `LLEN`, or 0 if the key does not exist.
+func (k TypedListKey[T]) Len(ctx context.Context, client interfaces.ListCommands) (int64, error) {
+	return client.LLen(ctx, k.name)
+}
@@ -0,0 +1,63 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+type typedKeyProfile struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestDefaultCodec(t *testing.T) {
+	data, err := defaultCodec[string]().Encode(`a "quoted" string`)
+	require.NoError(t, err)
+	assert.Equal(t, `a "quoted" string`, data)
+
+	data, err = defaultCodec[typedKeyProfile]().Encode(typedKeyProfile{Name: "alice", Age: 30})
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"alice","age":30}`, data)
+	profile, err := defaultCodec[typedKeyProfile]().Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, typedKeyProfile{Name: "alice", Age: 30}, profile)
+
+	_, err = JSONCodec[int]{}.Decode("not a number")
+	assert.Error(t, err)
+}
+
+func TestTypedHashKey(t *testing.T) {
+	ctx := context.Background()
+	client := fakeclient.New()
+	profiles := HashKey[typedKeyProfile]("profiles")
+	assert.Equal(t, "profiles", profiles.Name())
+
+	added, err := profiles.Set(ctx, client, map[string]typedKeyProfile{"alice": {Name: "alice", Age: 30}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), added)
+	assert.Equal(t, `{"name":"alice","age":30}`, client.Hashes["profiles"]["alice"])
+
+	profile, err := profiles.Get(ctx, client, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, typedKeyProfile{Name: "alice", Age: 30}, profile.Value())
+	profile, err = profiles.Get(ctx, client, "bob")
+	require.NoError(t, err)
+	assert.True(t, profile.IsNil())
+
+	all, err := profiles.GetAll(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]typedKeyProfile{"alice": {Name: "alice", Age: 30}}, all)
+
+	// A value that cannot be decoded fails with the field and the key
+	client.Hashes["profiles"]["bob"] = "not json"
+	_, err = profiles.Get(ctx, client, "bob")
+	assert.ErrorContains(t, err, `field "bob" of key "profiles"`)
+	_, err = profiles.GetAll(ctx, client)
+	assert.Error(t, err)
+}
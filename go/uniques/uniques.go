@@ -0,0 +1,215 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package uniques provides sliding-window unique counters stored in HyperLogLogs, e.g. to count the distinct users seen
+// in the last hour.
+package uniques
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// DefaultBucket is the default duration of the buckets of a [Counter].
+const DefaultBucket = time.Minute
+
+// DefaultRetention is the default duration the buckets of a [Counter] are kept for.
+const DefaultRetention = 24 * time.Hour
+
+// Counter counts the unique events of a sliding window, such as the distinct visitors of a page in the last 5 minutes.
+//
+// The events are added to the HyperLogLog of the bucket of the time they are added at, e.g. one HyperLogLog per minute,
+// and counted over the buckets of a window with `PFCOUNT`, which merges their HyperLogLogs like `PFMERGE`. The counts are
+// estimates, with a standard error of 0.81%, and the windows are rounded up to whole buckets.
+//
+// The buckets expire once they are older than the retention of the counter, so that the old buckets are pruned by the
+// server. They are stored at keys made of the key of the counter, in a hash tag so that all the buckets are in the same
+// slot in cluster mode, followed by the start of the bucket, e.g. "{visitors}:1700000000000" for "visitors".
+//
+// The bucket of an event is computed from the clock of the client adding it, so the clocks of the clients should be
+// synchronized. Tests can control the time with [Counter.WithClock].
+//
+// Example:
+//
+//	visitors := uniques.New(client, "visitors").WithBucket(time.Minute).WithRetention(time.Hour)
+//	err := visitors.AddEvent(ctx, userID)
+//	...
+//	count, err := visitors.CountLast(ctx, 5*time.Minute)
+type Counter struct {
+	client    interfaces.BaseClientCommands
+	key       string
+	bucket    time.Duration
+	retention time.Duration
+	clock     clock.Clock
+}
+
+// New creates a [Counter] stored at keys prefixed with `key`, with buckets of [DefaultBucket] kept for
+// [DefaultRetention].
+//
+// Parameters:
+//
+//	client - The client used to run the commands, either a [glide.Client] or a [glide.ClusterClient].
+//	key - The key of the counter, the prefix of the keys of its buckets.
+//
+// [glide.Client]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#Client
+// [glide.ClusterClient]: https://pkg.go.dev/github.com/valkey-io/valkey-glide/go/v2#ClusterClient
+func New(client interfaces.BaseClientCommands, key string) *Counter {
+	return &Counter{
+		client:    client,
+		key:       key,
+		bucket:    DefaultBucket,
+		retention: DefaultRetention,
+		clock:     clock.System(),
+	}
+}
+
+// WithBucket sets the duration of the buckets, rounded down to the millisecond. The windows are counted in whole buckets:
+// the smaller the buckets, the more accurate the windows, but the more keys are merged to count a window. The buckets
+// written with another duration are ignored, so the duration of the buckets of a counter should not change.
+func (c *Counter) WithBucket(bucket time.Duration) *Counter {
+	c.bucket = max(bucket.Truncate(time.Millisecond), time.Millisecond)
+	return c
+}
+
+// WithRetention sets how long the buckets are kept after they end, which is the longest window that can be counted.
+func (c *Counter) WithRetention(retention time.Duration) *Counter {
+	c.retention = retention
+	return c
+}
+
+// WithClock sets the clock the buckets are computed from, e.g. a [clock.Fake] in tests. The clock of the system by
+// default.
+func (c *Counter) WithClock(clk clock.Clock) *Counter {
+	c.clock = clk
+	return c
+}
+
+// BucketKey returns the key of the bucket containing the time `at`.
+func (c *Counter) BucketKey(at time.Time) string {
+	return c.bucketKey(c.bucketStart(at))
+}
+
+// AddEvent adds the event identified by `id` to the current bucket. Adding the same event several times during a window
+// counts it once.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	id - The identifier of the event, e.g. the ID of a user.
+//
+// Return value:
+//
+//	An error if the event could not be added.
+func (c *Counter) AddEvent(ctx context.Context, id string) error {
+	return c.AddEvents(ctx, []string{id})
+}
+
+// AddEvents adds the events identified by `ids` to the current bucket, in a single `PFADD`.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	ids - The identifiers of the events.
+//
+// Return value:
+//
+//	An error if the events could not be added.
+func (c *Counter) AddEvents(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	start := c.bucketStart(c.clock.Now())
+	key := c.bucketKey(start)
+	changed, err := c.client.PfAdd(ctx, key, ids)
+	if err != nil || !changed {
+		return err
+	}
+	// PFADD reports a change when it creates the bucket, which is then given its expiry. Setting it again on the later
+	// changes is harmless, and repairs a bucket whose expiry could not be set.
+	_, err = c.client.PExpireAt(ctx, key, start.Add(c.bucket+c.retention))
+	return err
+}
+
+// CountLast returns the estimated number of unique events of the last `window`, rounded up to whole buckets, including
+// the current bucket.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	window - The duration of the window, at most the retention of the counter.
+//
+// Return value:
+//
+//	The estimated number of unique events of the window.
+func (c *Counter) CountLast(ctx context.Context, window time.Duration) (int64, error) {
+	keys, err := c.windowKeys(window)
+	if err != nil {
+		return 0, err
+	}
+	return c.client.PfCount(ctx, keys)
+}
+
+// MergeLast merges the buckets of the last `window` into the HyperLogLog stored at `destination` with `PFMERGE`, e.g. to
+// keep the unique events of a day beyond the retention of the counter. The HyperLogLog already stored at `destination`,
+// if any, is merged too.
+//
+// In cluster mode, `destination` must be in the same slot as the buckets, see [Counter.SameSlotKey].
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	window - The duration of the window, at most the retention of the counter.
+//	destination - The key of the HyperLogLog to merge the buckets into.
+//
+// Return value:
+//
+//	An error if the buckets could not be merged.
+func (c *Counter) MergeLast(ctx context.Context, window time.Duration, destination string) error {
+	keys, err := c.windowKeys(window)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.PfMerge(ctx, destination, keys)
+	return err
+}
+
+// SameSlotKey returns the key made of the key of the counter followed by `suffix`, in the same slot as its buckets, e.g.
+// "{visitors}:daily" for ":daily".
+func (c *Counter) SameSlotKey(suffix string) string {
+	return utils.SameSlotKey(c.key, suffix)
+}
+
+// windowKeys returns the keys of the buckets of the last `window`.
+func (c *Counter) windowKeys(window time.Duration) ([]string, error) {
+	if window <= 0 {
+		return nil, errors.New("the window must be positive")
+	}
+	if window > c.retention {
+		return nil, errors.New("the window must not exceed the retention of the counter")
+	}
+	now := c.clock.Now()
+	first, last := c.bucketStart(now.Add(-window+1)), c.bucketStart(now)
+	keys := make([]string, 0, last.Sub(first)/c.bucket+1)
+	for start := first; !start.After(last); start = start.Add(c.bucket) {
+		keys = append(keys, c.bucketKey(start))
+	}
+	return keys, nil
+}
+
+// bucketStart returns the start of the bucket containing `at`.
+func (c *Counter) bucketStart(at time.Time) time.Time {
+	size := c.bucket.Milliseconds()
+	millis := at.UnixMilli()
+	// Round down the times before the epoch too.
+	millis -= ((millis % size) + size) % size
+	return time.UnixMilli(millis)
+}
+
+func (c *Counter) bucketKey(start time.Time) string {
+	return c.SameSlotKey(":" + strconv.FormatInt(start.UnixMilli(), 10))
+}
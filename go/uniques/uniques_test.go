@@ -0,0 +1,78 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package uniques
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/fakeclient"
+)
+
+func TestCounter(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.UnixMilli(1_700_000_000_000)) // 22:13:20 UTC
+	client := fakeclient.New().WithClock(fake)
+	visitors := New(client, "visitors").WithRetention(time.Hour).WithClock(fake)
+
+	require.NoError(t, visitors.AddEvents(ctx, []string{"alice", "bob"}))
+	fake.Advance(time.Minute)
+	require.NoError(t, visitors.AddEvent(ctx, "alice"))
+	require.NoError(t, visitors.AddEvent(ctx, "carol"))
+
+	// The buckets are in the same slot, and expire once they ended for the retention
+	assert.Equal(t, "{visitors}:1699999980000", visitors.BucketKey(time.UnixMilli(1_700_000_000_000)))
+	assert.Len(t, client.HyperLogLogs, 2)
+	assert.Equal(
+		t,
+		time.UnixMilli(1_700_000_100_000).Add(time.Hour),
+		client.Expiries[visitors.BucketKey(fake.Now())],
+	)
+
+	// The windows are rounded up to whole buckets
+	count, err := visitors.CountLast(ctx, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	count, err = visitors.CountLast(ctx, 2*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	// The old buckets are out of the window
+	fake.Advance(10 * time.Minute)
+	count, err = visitors.CountLast(ctx, 5*time.Minute)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	count, err = visitors.CountLast(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	daily := visitors.SameSlotKey(":daily")
+	assert.Equal(t, "{visitors}:daily", daily)
+	require.NoError(t, visitors.MergeLast(ctx, time.Hour, daily))
+	assert.Len(t, client.HyperLogLogs[daily], 3)
+
+	_, err = visitors.CountLast(ctx, 0)
+	assert.Error(t, err)
+	_, err = visitors.CountLast(ctx, 2*time.Hour)
+	assert.Error(t, err)
+}
+
+func TestCounter_WindowKeys(t *testing.T) {
+	fake := clock.NewFake(time.UnixMilli(150_000))
+	counter := New(nil, "c").WithBucket(time.Minute).WithClock(fake)
+
+	keys, err := counter.windowKeys(time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"{c}:60000", "{c}:120000"}, keys)
+
+	keys, err = counter.windowKeys(30 * time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"{c}:120000"}, keys)
+
+	// The times before the epoch are rounded down too
+	assert.Equal(t, "{c}:-60000", counter.BucketKey(time.UnixMilli(-1)))
+}
@@ -0,0 +1,40 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func ExampleClient_UpdateConfig() {
+	var client *Client = getExampleClient() // example helper function
+	update := config.NewConfigUpdate().
+		WithRequestTimeout(time.Second).
+		WithReadFrom(config.PreferReplica).
+		WithReconnectStrategy(config.NewBackoffStrategy(5, 100, 2))
+	response, err := client.UpdateConfig(context.Background(), update)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(response)
+
+	// Output: OK
+}
+
+func ExampleClusterClient_UpdateConfig() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	update := config.NewConfigUpdate().
+		WithRequestTimeout(10 * time.Second).
+		WithReadFrom(config.PreferReplica)
+	response, err := client.UpdateConfig(context.Background(), update)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(response)
+
+	// Output: OK
+}
@@ -50,3 +50,27 @@ func ExampleClusterClient_ResetConnectionPassword() {
 
 	// Output: OK
 }
+
+func ExampleClient_UpdateConnectionCredentials() {
+	var client *Client = getExampleClient() // example helper function
+	// The default user of the example server accepts any password
+	response, err := client.UpdateConnectionCredentials(context.Background(), "default", "new-password")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(response)
+
+	// Output: OK
+}
+
+func ExampleClusterClient_UpdateConnectionCredentials() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	// The default user of the example servers accepts any password
+	response, err := client.UpdateConnectionCredentials(context.Background(), "default", "new-password")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(response)
+
+	// Output: OK
+}
@@ -0,0 +1,128 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// updateStringAttempts is the number of times UpdateString reads and writes a value at most, when other clients keep
+// modifying it in between.
+const updateStringAttempts = 32
+
+// setIfEqualScript sets KEYS[1] to ARGV[1], retaining its time to live, only if its value is ARGV[2], like `SET IFEQ` on
+// the servers older than Valkey 8.1.
+var setIfEqualScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+if redis.call('GET', KEYS[1]) ~= ARGV[2] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'KEEPTTL')
+return 1
+`)
+})
+
+// UpdateString reads the value of `key`, computes its new value with `update`, and writes it only if the value was not
+// modified in the meantime, e.g. to update a JSON document or a configuration value without a lock. If the value was
+// modified, it is read again and `update` is called again with the new value, so `update` may be called several times
+// and must not have side effects. The time to live of the key is retained.
+//
+// The value is written with `SET IFEQ` on Valkey 8.1 and above, and with a Lua script comparing the value on the older
+// servers. Unlike `WATCH`, neither requires a dedicated connection: the connections of the client are shared by its
+// concurrent requests. Like `SET IFEQ`, a value that is modified and restored to its original content in the meantime is
+// not detected as modified.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to update.
+//	update - Called with the current value of `key`, a nil [models.Result] if it does not exist, to return its new value,
+//	  or `abort` set to leave the key unchanged.
+//
+// Return value:
+//
+//	`true` if the value was written, `false` if `update` aborted. An error is returned if the value kept being modified
+//	for 32 attempts.
+func (client *baseClient) UpdateString(
+	ctx context.Context,
+	key string,
+	update func(old models.Result[string]) (value string, abort bool),
+) (bool, error) {
+	for attempt := 0; attempt < updateStringAttempts; attempt++ {
+		// The value is read from the server, so that a value of the client-side cache that is not invalidated yet does not
+		// fail the attempt
+		result, err := client.executeCommand(ctx, C.Get, []string{key})
+		if err != nil {
+			return false, err
+		}
+		old, err := handleStringOrNilResponse(result)
+		if err != nil {
+			return false, err
+		}
+		value, abort := update(old)
+		if abort {
+			return false, nil
+		}
+		set, err := client.setIfUnchanged(ctx, key, value, old)
+		if err != nil || set {
+			return set, err
+		}
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+	}
+	return false, fmt.Errorf("the value of %q kept being modified during %d attempts to update it", key, updateStringAttempts)
+}
+
+// setIfUnchanged sets `key` to `value` only if its value is still `old`, or if it still does not exist if `old` is nil,
+// and returns whether it was set.
+func (client *baseClient) setIfUnchanged(
+	ctx context.Context,
+	key string,
+	value string,
+	old models.Result[string],
+) (bool, error) {
+	if old.IsNil() {
+		result, err := client.SetWithOptions(ctx, key, value, *options.NewSetOptions().SetOnlyIfDoesNotExist())
+		return !result.IsNil(), err
+	}
+	if !client.setIfEqualUnsupported.Load() {
+		setOptions := options.NewSetOptions().SetOnlyIfEquals(old.Value()).SetExpiry(options.NewExpiryKeepExisting())
+		result, err := client.SetWithOptions(ctx, key, value, *setOptions)
+		if err == nil {
+			return !result.IsNil(), nil
+		}
+		if !isSyntaxError(err) {
+			return false, err
+		}
+		// The servers older than Valkey 8.1 do not know IFEQ
+		client.setIfEqualUnsupported.Store(true)
+	}
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*setIfEqualScript(),
+		*options.NewScriptOptions().WithKeys([]string{key}).WithArgs([]string{value, old.Value()}),
+	)
+	if err != nil {
+		return false, err
+	}
+	set, ok := result.(int64)
+	if !ok {
+		return false, fmt.Errorf("unexpected response: %v", result)
+	}
+	return set == 1, nil
+}
+
+// isSyntaxError returns whether `err` reports that the server does not know the arguments of the command.
+func isSyntaxError(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "syntax error")
+}
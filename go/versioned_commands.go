@@ -0,0 +1,239 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// The suffix of the companion keys holding the version counters of the values.
+const versionKeySuffix = ":glide-version"
+
+// The version of a value is made of a counter, stored in a companion key in the same slot and incremented by every
+// `SetIfVersion`, and of the SHA1 digest of the value, which changes with the writes made by other commands. A counter is
+// created from the current time in microseconds, so that it keeps increasing if the companion key expires with its key.
+// KEYS holds the keys followed by their companion keys.
+var (
+	getWithVersionScript = sync.OnceValue(func() *options.Script {
+		return options.NewScript(`
+local n = #KEYS / 2
+local result = {}
+for i = 1, n do
+	local value = redis.call('GET', KEYS[i])
+	if value then
+		result[i] = {value, (redis.call('GET', KEYS[n + i]) or '0') .. ':' .. redis.sha1hex(value)}
+	else
+		result[i] = false
+	end
+end
+return result
+`)
+	})
+	setIfVersionScript = sync.OnceValue(func() *options.Script {
+		return options.NewScript(`
+local n = #KEYS / 2
+local counters = {}
+for i = 1, n do
+	local value = redis.call('GET', KEYS[i])
+	local counter = redis.call('GET', KEYS[n + i]) or '0'
+	counters[i] = tonumber(counter) or 0
+	local version = ''
+	if value then
+		version = counter .. ':' .. redis.sha1hex(value)
+	end
+	if version ~= ARGV[2 * i] then
+		return 0
+	end
+end
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+for i = 1, n do
+	redis.call('SET', KEYS[i], ARGV[2 * i - 1], 'KEEPTTL')
+	local counter = string.format('%d', math.max(counters[i] + 1, now))
+	local ttl = redis.call('PTTL', KEYS[i])
+	if ttl > 0 then
+		redis.call('SET', KEYS[n + i], counter, 'PX', ttl)
+	else
+		redis.call('SET', KEYS[n + i], counter)
+	end
+end
+return 1
+`)
+	})
+)
+
+// versionKey returns the companion key holding the version counter of `key`, in the same slot as `key` once both are
+// prefixed by the key prefix of the client.
+func (client *baseClient) versionKey(key string) (string, error) {
+	switch {
+	case utils.HasHashTag(client.keyPrefix + key):
+		return key + versionKeySuffix, nil
+	case client.keyPrefix == "":
+		return utils.SameSlotKey(key, versionKeySuffix), nil
+	case !client.clusterMode:
+		return key + versionKeySuffix, nil
+	}
+	return "", fmt.Errorf("the version of key %s cannot be stored in its slot: the prefixed keys need a hash tag", key)
+}
+
+// versionKeys returns `keys` followed by their companion keys.
+func (client *baseClient) versionKeys(keys []string) ([]string, error) {
+	scriptKeys := make([]string, 0, 2*len(keys))
+	scriptKeys = append(scriptKeys, keys...)
+	for _, key := range keys {
+		versionKey, err := client.versionKey(key)
+		if err != nil {
+			return nil, err
+		}
+		scriptKeys = append(scriptKeys, versionKey)
+	}
+	return scriptKeys, nil
+}
+
+// GetWithVersion gets the value of `key` along with its version, which can be passed to `SetIfVersion` to
+// update the value only if it was not modified in the meantime. Unlike `WATCH`, this does not require a dedicated
+// connection or a transaction.
+//
+// The version is made of a counter incremented by every `SetIfVersion`, so a value that is changed and later restored to
+// its original content has a new version. The counter is stored in a companion key in the same slot, `key` followed by
+// ":glide-version" or wrapped in a hash tag if it has none, e.g. "{user:1}:glide-version", and expires with `key`. The
+// writes made by other commands than `SetIfVersion` do not increment the counter: they change the version only if they
+// change the content of the value. When the keys are prefixed, see [config.ClientConfiguration.WithKeyPrefix], the keys
+// of a cluster client need a hash tag for their companion key to be in their slot.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to get.
+//
+// Return value:
+//
+//	The value and its version. If `key` does not exist, the value is a nil [models.Result] and the version is empty.
+func (client *baseClient) GetWithVersion(ctx context.Context, key string) (models.VersionedValue, error) {
+	values, err := client.MGetWithVersion(ctx, []string{key})
+	if err != nil {
+		return models.VersionedValue{}, err
+	}
+	return values[0], nil
+}
+
+// MGetWithVersion gets the values of `keys` along with their versions, like `GetWithVersion`, atomically. In cluster
+// mode, the keys must be in the same slot.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys to get.
+//
+// Return value:
+//
+//	The values and their versions, in the order of `keys`. The value of a key that does not exist is a nil
+//	[models.Result] and its version is empty.
+func (client *baseClient) MGetWithVersion(ctx context.Context, keys []string) ([]models.VersionedValue, error) {
+	scriptKeys, err := client.versionKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*getWithVersionScript(),
+		*options.NewScriptOptions().WithKeys(scriptKeys),
+	)
+	if err != nil {
+		return nil, err
+	}
+	entries, ok := result.([]any)
+	if !ok || len(entries) != len(keys) {
+		return nil, fmt.Errorf("unexpected response: %v", result)
+	}
+	values := make([]models.VersionedValue, len(keys))
+	for i, entry := range entries {
+		if entry == nil {
+			values[i] = models.VersionedValue{Value: models.CreateNilStringResult()}
+			continue
+		}
+		pair, ok := entry.([]any)
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("unexpected response: %v", result)
+		}
+		value, valueOk := pair[0].(string)
+		version, versionOk := pair[1].(string)
+		if !valueOk || !versionOk {
+			return nil, fmt.Errorf("unexpected response: %v", result)
+		}
+		values[i] = models.VersionedValue{Value: models.CreateStringResult(value), Version: version}
+	}
+	return values, nil
+}
+
+// SetIfVersion sets `key` to `value` only if the current version of its value is `version`, as returned by
+// `GetWithVersion`, and increments its version. Pass an empty `version` to set `key` only if it does not exist. The time to
+// live of the key is retained.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to set.
+//	value - The value to store.
+//	version - The expected version of the current value.
+//
+// Return value:
+//
+//	`true` if the value was set, `false` if the value was modified since `version` was read.
+func (client *baseClient) SetIfVersion(ctx context.Context, key string, value string, version string) (bool, error) {
+	return client.MSetIfVersion(ctx, map[string]string{key: value}, map[string]string{key: version})
+}
+
+// MSetIfVersion sets the keys of `keyValueMap` to their values only if the current versions of all their values are
+// the ones of `versions`, as returned by `MGetWithVersion` or `GetWithVersion`, atomically. A key missing from `versions`
+// is set only if it does not exist. In cluster mode, the keys must be in the same slot.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keyValueMap - The keys to set, with the values to store.
+//	versions - The expected versions of the current values, by key.
+//
+// Return value:
+//
+//	`true` if the values were set, `false` if any of them was modified since its version was read, in which case none
+//	is set.
+func (client *baseClient) MSetIfVersion(
+	ctx context.Context,
+	keyValueMap map[string]string,
+	versions map[string]string,
+) (bool, error) {
+	keys := make([]string, 0, len(keyValueMap))
+	for key := range keyValueMap {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	scriptKeys, err := client.versionKeys(keys)
+	if err != nil {
+		return false, err
+	}
+	args := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		args = append(args, keyValueMap[key], versions[key])
+	}
+	result, err := client.InvokeScriptWithOptions(
+		ctx,
+		*setIfVersionScript(),
+		*options.NewScriptOptions().WithKeys(scriptKeys).WithArgs(args),
+	)
+	if err != nil {
+		return false, err
+	}
+	set, ok := result.(int64)
+	if !ok {
+		return false, fmt.Errorf("unexpected response: %v", result)
+	}
+	return set == 1, nil
+}
@@ -0,0 +1,144 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+const (
+	// zAddBulkConcurrency is the number of pipelines of ZAddBulk in flight at most.
+	zAddBulkConcurrency = options.DefaultBulkLoadConcurrency
+	// zAddBulkCommandSize is the number of members of a `ZADD` of ZAddBulk at most, so that a command does not block the
+	// server for long.
+	zAddBulkCommandSize = 1000
+)
+
+// ZAddBulk adds the members of `iterator` to the sorted set stored at `key`, or updates their scores, e.g. to build an
+// index of millions of members without holding them all in a map. The members are grouped by `batchSize`, and every group
+// is sent as a non-atomic pipeline of `ZADD` commands of up to 1000 members, with at most 4 pipelines in flight: the
+// iterator is not read further until a pipeline completes. A `ZADD` that fails is reported in [models.ZAddBulkResult],
+// and does not stop the load.
+//
+// If a member is returned several times by `iterator`, its score is the last one written, which is not necessarily the
+// last one returned unless the occurrences are in the same group.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution. The load stops when it is done.
+//	key - The key of the sorted set.
+//	iterator - The members to add, with their scores.
+//	batchSize - The number of members sent in a single pipeline. Defaults to [options.DefaultBulkLoadBatchSize] if not
+//	  positive.
+//
+// Return value:
+//
+//	The number of members written and added, and the errors of the commands that failed. An error if `iterator` failed or
+//	`ctx` was done, along with the members written before.
+//
+// [valkey.io]: https://valkey.io/commands/zadd/
+func (client *baseClient) ZAddBulk(
+	ctx context.Context,
+	key string,
+	iterator models.MemberAndScoreIterator,
+	batchSize int,
+) (models.ZAddBulkResult, error) {
+	if batchSize <= 0 {
+		batchSize = options.DefaultBulkLoadBatchSize
+	}
+
+	var result models.ZAddBulkResult
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	groups := make(chan []models.MemberAndScore)
+	for range zAddBulkConcurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range groups {
+				groupResult := client.zAddGroup(ctx, key, group)
+				mu.Lock()
+				result.Written += groupResult.Written
+				result.Added += groupResult.Added
+				result.Failed += groupResult.Failed
+				result.Errors = append(result.Errors, groupResult.Errors...)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	err := readGroups(ctx, iterator.Next, batchSize, groups)
+	close(groups)
+	wg.Wait()
+	return result, err
+}
+
+// zAddGroup adds a group of members in a non-atomic pipeline, and returns the outcome of its commands.
+func (client *baseClient) zAddGroup(
+	ctx context.Context,
+	key string,
+	group []models.MemberAndScore,
+) models.ZAddBulkResult {
+	var batch internal.Batch
+	var commandSizes []int
+	if client.clusterMode {
+		b := pipeline.NewClusterBatch(false)
+		commandSizes = addZAddCommands(&b.BaseBatch, key, group)
+		batch = b.Batch
+	} else {
+		b := pipeline.NewStandaloneBatch(false)
+		commandSizes = addZAddCommands(&b.BaseBatch, key, group)
+		batch = b.Batch
+	}
+
+	var result models.ZAddBulkResult
+	results, err := client.executeBatch(ctx, batch, false, nil)
+	if err != nil {
+		result.Failed = int64(len(group))
+		result.Errors = []error{err}
+		return result
+	}
+	for i, reply := range results {
+		switch reply := reply.(type) {
+		case int64:
+			result.Written += int64(commandSizes[i])
+			result.Added += reply
+		case error:
+			result.Failed += int64(commandSizes[i])
+			result.Errors = append(result.Errors, reply)
+		default:
+			result.Failed += int64(commandSizes[i])
+			result.Errors = append(result.Errors, fmt.Errorf("unexpected ZADD response type: %T", reply))
+		}
+	}
+	return result
+}
+
+// addZAddCommands adds the `ZADD` commands adding `group` to the sorted set of `key` to `batch`, of up to
+// zAddBulkCommandSize members each, and returns the number of members of every command.
+func addZAddCommands[T pipeline.StandaloneBatch | pipeline.ClusterBatch](
+	batch *pipeline.BaseBatch[T],
+	key string,
+	group []models.MemberAndScore,
+) []int {
+	var commandSizes []int
+	for start := 0; start < len(group); start += zAddBulkCommandSize {
+		members := group[start:min(start+zAddBulkCommandSize, len(group))]
+		scores := make(map[string]float64, len(members))
+		for _, member := range members {
+			scores[member.Member] = member.Score
+		}
+		batch.ZAdd(key, scores)
+		commandSizes = append(commandSizes, len(members))
+	}
+	return commandSizes
+}
@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+func TestAddZAddCommands(t *testing.T) {
+	group := make([]models.MemberAndScore, 2500)
+	for i := range group {
+		group[i] = models.MemberAndScore{Member: strconv.Itoa(i), Score: float64(i)}
+	}
+
+	batch := pipeline.NewClusterBatch(false)
+	sizes := addZAddCommands(&batch.BaseBatch, "index", group)
+	assert.Equal(t, []int{1000, 1000, 500}, sizes)
+	require.Len(t, batch.Batch.Commands, 3)
+	for _, command := range batch.Batch.Commands {
+		assert.Equal(t, "index", command.Args[0])
+	}
+	assert.Len(t, batch.Batch.Commands[2].Args, 1+2*500)
+
+	standalone := pipeline.NewStandaloneBatch(false)
+	sizes = addZAddCommands(&standalone.BaseBatch, "index", group[:1])
+	assert.Equal(t, []int{1}, sizes)
+	assert.Equal(t, []string{"index", "0", "0"}, standalone.Batch.Commands[0].Args)
+}
+
+func TestReadGroups_MemberAndScoreIterator(t *testing.T) {
+	members := []models.MemberAndScore{{Member: "a", Score: 1}, {Member: "b", Score: 2}, {Member: "c", Score: 3}}
+	groups := make(chan []models.MemberAndScore, 10)
+	require.NoError(t, readGroups(context.Background(), models.NewMemberAndScoreIterator(members).Next, 2, groups))
+	close(groups)
+	var read [][]models.MemberAndScore
+	for group := range groups {
+		read = append(read, group)
+	}
+	assert.Equal(t, [][]models.MemberAndScore{members[:2], members[2:]}, read)
+}
@@ -0,0 +1,207 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// ZRangeWithScoresIterator streams the members of a sorted set within a range, with their scores, one page at a time.
+//
+// A single `ZRANGE ... WITHSCORES` over a large sorted set returns the whole range at once. The iterator instead requests
+// at most `pageSize` members per round trip, and returns them in the order of the range: by ascending score, or by
+// descending score if the query is reversed, and by lexicographical order of the members with the same score.
+//
+//   - For a range by index, created by [options.NewRangeByIndexQuery], the pages are consecutive index ranges. Negative
+//     indexes are resolved with `ZCARD` before the first page.
+//   - For a range by score, created by [options.NewRangeByScoreQuery], every page starts at the score of the last member
+//     returned so far, skipping the members with that score already returned, so that the server does not walk the
+//     members of the previous pages again. The limit of the query, if any, applies to the whole range.
+//
+// Note that the iterator does not provide a point-in-time view: members added, removed or rescored while iterating may or
+// may not be returned. For a range by index, they shift the following members to another page, which can then be returned
+// twice or skipped.
+//
+// Example:
+//
+//	iter, err := glide.NewZRangeWithScoresIterator(client, "leaderboard", options.NewRangeByIndexQuery(0, -1), 100)
+//	for iter.HasNext() {
+//	    page, err := iter.Next(ctx)
+//	    ...
+//	}
+type ZRangeWithScoresIterator struct {
+	client   interfaces.SortedSetCommands
+	key      string
+	pageSize int64
+	finished bool
+
+	// The range by index, with the next index to return.
+	byIndex  *options.RangeByIndex
+	resolved bool
+
+	// The range by score, starting at the score of the last member returned so far.
+	byScore *options.RangeByScore
+	// The number of members to skip before the first page, and the number of members left to return, or -1 if unlimited.
+	offset    int64
+	remaining int64
+	// Members already returned with the score the range starts at.
+	boundary map[string]float64
+}
+
+// NewZRangeWithScoresIterator creates a [ZRangeWithScoresIterator] over the members of the sorted set stored at `key`
+// within the range given by `rangeQuery`.
+//
+// Parameters:
+//
+//	client - The client used to run the `ZRANGE` commands, either a [Client] or a [ClusterClient].
+//	key - The key of the sorted set.
+//	rangeQuery - The range to iterate over, either an [options.RangeByIndex] or an [options.RangeByScore].
+//	pageSize - The maximum number of members to return per page. Must be positive.
+//
+// Return value:
+//
+//	A [ZRangeWithScoresIterator] positioned before the first page.
+func NewZRangeWithScoresIterator(
+	client interfaces.SortedSetCommands,
+	key string,
+	rangeQuery options.ZRangeQueryWithScores,
+	pageSize int64,
+) (*ZRangeWithScoresIterator, error) {
+	if pageSize <= 0 {
+		return nil, errors.New("zrange iterator page size must be positive")
+	}
+	iter := &ZRangeWithScoresIterator{client: client, key: key, pageSize: pageSize}
+	switch query := rangeQuery.(type) {
+	case *options.RangeByIndex:
+		byIndex := *query
+		iter.byIndex = &byIndex
+	case *options.RangeByScore:
+		byScore := *query
+		iter.remaining = -1
+		if byScore.Limit != nil {
+			if byScore.Limit.Offset < 0 {
+				return nil, errors.New("zrange iterator limit offset must not be negative")
+			}
+			iter.offset = byScore.Limit.Offset
+			if byScore.Limit.Count >= 0 {
+				iter.remaining = byScore.Limit.Count
+			}
+		}
+		iter.byScore = &byScore
+		iter.boundary = map[string]float64{}
+		iter.finished = iter.remaining == 0
+	default:
+		return nil, errors.New("zrange iterator only supports range queries by index or by score")
+	}
+	return iter, nil
+}
+
+// HasNext returns `false` once all members within the range have been returned.
+func (iter *ZRangeWithScoresIterator) HasNext() bool {
+	return !iter.finished
+}
+
+// Next fetches the next page of members with their scores, in the order of the range. The last page may be empty.
+//
+// If an error is returned the iterator is left unchanged, so `Next` may be called again to retry the same page.
+func (iter *ZRangeWithScoresIterator) Next(ctx context.Context) ([]models.MemberAndScore, error) {
+	if iter.finished {
+		return []models.MemberAndScore{}, nil
+	}
+	if iter.byIndex != nil {
+		return iter.nextByIndex(ctx)
+	}
+	return iter.nextByScore(ctx)
+}
+
+func (iter *ZRangeWithScoresIterator) nextByIndex(ctx context.Context) ([]models.MemberAndScore, error) {
+	start, end := iter.byIndex.Start, iter.byIndex.End
+	if !iter.resolved && (start < 0 || end < 0) {
+		card, err := iter.client.ZCard(ctx, iter.key)
+		if err != nil {
+			return nil, err
+		}
+		if start < 0 {
+			start = max(card+start, 0)
+		}
+		if end < 0 {
+			end = card + end
+		}
+	}
+	if start > end {
+		iter.finished = true
+		return []models.MemberAndScore{}, nil
+	}
+
+	pageEnd := end
+	if end-start >= iter.pageSize {
+		pageEnd = start + iter.pageSize - 1
+	}
+	page, err := iter.client.ZRangeWithScores(
+		ctx,
+		iter.key,
+		&options.RangeByIndex{Start: start, End: pageEnd, Reverse: iter.byIndex.Reverse},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	iter.resolved = true
+	iter.byIndex.Start, iter.byIndex.End = pageEnd+1, end
+	iter.finished = pageEnd == end || int64(len(page)) < pageEnd-start+1
+	return page, nil
+}
+
+func (iter *ZRangeWithScoresIterator) nextByScore(ctx context.Context) ([]models.MemberAndScore, error) {
+	wanted := iter.pageSize
+	if iter.remaining >= 0 {
+		wanted = min(wanted, iter.remaining)
+	}
+	count := wanted + int64(len(iter.boundary))
+	query := *iter.byScore
+	query.Limit = &options.Limit{Offset: iter.offset, Count: count}
+	members, err := iter.client.ZRangeWithScores(ctx, iter.key, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := make([]models.MemberAndScore, 0, len(members))
+	for _, member := range members {
+		if _, seen := iter.boundary[member.Member]; seen {
+			continue
+		}
+		page = append(page, member)
+	}
+	if iter.remaining >= 0 {
+		iter.remaining -= int64(len(page))
+	}
+	iter.offset = 0
+
+	if int64(len(members)) < count || iter.remaining == 0 {
+		iter.finished = true
+		iter.boundary = nil
+		return page, nil
+	}
+
+	// Anything not returned yet is after the last member in the order of the range, so the next page starts at its score.
+	last := members[len(members)-1].Score
+	iter.byScore.Start = options.NewInclusiveScoreBoundary(last)
+	boundary := map[string]float64{}
+	for member, score := range iter.boundary {
+		if score == last {
+			boundary[member] = score
+		}
+	}
+	for _, member := range page {
+		if member.Score == last {
+			boundary[member.Member] = member.Score
+		}
+	}
+	iter.boundary = boundary
+	return page, nil
+}
@@ -259,7 +259,7 @@ public final class AsyncRegistry {
 
     /**
      * Complete with error using a structured error code from native layer. Codes map to glide-core
-     * RequestErrorType: 0=Unspecified, 1=ExecAbort, 2=Timeout, 3=Disconnect.
+     * RequestErrorType: 0=Unspecified, 1=ExecAbort, 2=Timeout, 3=Disconnect, 4=TooManyRedirects.
      *
      * @param correlationId the correlation ID from register()
      * @param errorTypeCode error type code from native layer